@@ -0,0 +1,28 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	loyaltyante "tokenchain/x/loyalty/ante"
+)
+
+// setAnteHandler sets the ante handler of the SDK with the loyalty fee decorator
+// in place of the SDK fee decorator, so verified tokens can pay transaction fees.
+func (app *App) setAnteHandler() {
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(nil),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(app.AuthKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(app.AuthKeeper),
+		loyaltyante.NewFeeDecorator(app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.LoyaltyKeeper, nil),
+		ante.NewSetPubKeyDecorator(app.AuthKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(app.AuthKeeper),
+		ante.NewSigGasConsumeDecorator(app.AuthKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(app.AuthKeeper, app.txConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(app.AuthKeeper),
+	}
+	app.SetAnteHandler(sdk.ChainAnteDecorators(anteDecorators...))
+}
@@ -0,0 +1,171 @@
+package app
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	loyaltyante "tokenchain/x/loyalty/ante"
+	loyaltykeeper "tokenchain/x/loyalty/keeper"
+	loyaltytypes "tokenchain/x/loyalty/types"
+)
+
+func TestLoyaltyFeeTokens(t *testing.T) {
+	setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.App.(*App)
+	srv := loyaltykeeper.NewMsgServerImpl(app.LoyaltyKeeper)
+	denom := createIBCTestToken(t, chain, "brew", false, 10_000)
+	ctx := chain.GetContext()
+	authority := sdk.AccAddress(app.LoyaltyKeeper.GetAuthority())
+	sender := chain.SenderAccount.GetAddress()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	balance := func(addr sdk.AccAddress, denom string) sdkmath.Int {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).Amount
+	}
+
+	_, err := srv.SetFeeToken(ctx, &loyaltytypes.MsgSetFeeToken{
+		Creator:     authority.String(),
+		Denom:       denom,
+		TokenUnits:  2,
+		UtokenUnits: 1,
+		Enabled:     true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, authority, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	_, err = srv.FundGasPool(ctx, &loyaltytypes.MsgFundGasPool{Creator: authority.String(), Denom: denom, Amount: 1_000})
+	require.NoError(t, err)
+
+	// A signed transfer pays its fee in the token through the whole ante handler.
+	acc := app.AuthKeeper.GetAccount(ctx, sender)
+	send := &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: authority.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 10))}
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		app.txConfig,
+		[]sdk.Msg{send},
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 200)),
+		simtestutil.DefaultGenTxGas,
+		chain.ChainID,
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		chain.SenderPrivKey,
+	)
+	require.NoError(t, err)
+	collected := balance(feeCollector, sdk.DefaultBondDenom)
+	_, err = app.AnteHandler()(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(9_800), balance(sender, denom))
+	require.Equal(t, sdkmath.NewInt(200), balance(authority, denom))
+	require.Equal(t, collected.AddRaw(100), balance(feeCollector, sdk.DefaultBondDenom))
+
+	fee, err := loyaltykeeper.NewQueryServerImpl(app.LoyaltyKeeper).FeeToken(ctx, &loyaltytypes.QueryFeeTokenRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, loyaltytypes.GasPool{Denom: denom, Balance: 900, Sponsored: 100, SponsoredTxs: 1}, fee.GasPool)
+
+	// A fee grant in the token lets another account pay the fee.
+	decorator := loyaltyante.NewFeeDecorator(app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.LoyaltyKeeper, nil)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	app.AuthKeeper.SetAccount(ctx, app.AuthKeeper.NewAccountWithAddress(ctx, grantee))
+	require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, sender, grantee, &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(denom, 300)),
+	}))
+	buildTx := func(fee sdk.Coin, granter sdk.AccAddress, msgs ...sdk.Msg) sdk.Tx {
+		builder := app.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetFeeAmount(sdk.NewCoins(fee))
+		builder.SetFeePayer(grantee)
+		builder.SetFeeGranter(granter)
+		builder.SetGasLimit(simtestutil.DefaultGenTxGas)
+		return builder.GetTx()
+	}
+	granteeSend := &banktypes.MsgSend{FromAddress: grantee.String(), ToAddress: sender.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))}
+	_, err = decorator.AnteHandle(ctx, buildTx(sdk.NewInt64Coin(denom, 200), sender, granteeSend), false, next)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(9_600), balance(sender, denom))
+	_, err = decorator.AnteHandle(ctx, buildTx(sdk.NewInt64Coin(denom, 200), sender, granteeSend), false, next)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	// Fees of other messages, and fees without a grant, are not sponsored.
+	_, err = decorator.AnteHandle(ctx, buildTx(sdk.NewInt64Coin(denom, 20), sender, &loyaltytypes.MsgFundGasPool{Creator: grantee.String(), Denom: denom, Amount: 1}), false, next)
+	require.ErrorIs(t, err, loyaltytypes.ErrFeeNotSponsored)
+	_, err = decorator.AnteHandle(ctx, buildTx(sdk.NewInt64Coin(denom, 20), nil, granteeSend), false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// Other fees go through the SDK decorator, where the grant does not cover them.
+	_, err = decorator.AnteHandle(ctx, buildTx(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20), sender, granteeSend), false, next)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+}
+
+func TestClaimSponsorshipFees(t *testing.T) {
+	setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.App.(*App)
+	srv := loyaltykeeper.NewMsgServerImpl(app.LoyaltyKeeper)
+	denom := createIBCTestToken(t, chain, "claims", false, 10_000)
+	ctx := chain.GetContext()
+	authority := sdk.AccAddress(app.LoyaltyKeeper.GetAuthority())
+	sender := chain.SenderAccount.GetAddress()
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, authority, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+
+	_, err := srv.SetClaimSponsorship(ctx, &loyaltytypes.MsgSetClaimSponsorship{
+		Creator:     authority.String(),
+		Denom:       denom,
+		DailyBudget: 300,
+		PerUserCap:  200,
+		Enabled:     true,
+	})
+	require.NoError(t, err)
+
+	// Crediting new addresses grants them allowances from the token owner.
+	first := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	second := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	accrue := func(address sdk.AccAddress) {
+		_, err := srv.RecordRewardAccrual(ctx, &loyaltytypes.MsgRecordRewardAccrual{Creator: authority.String(), Address: address.String(), Denom: denom, Amount: 5})
+		require.NoError(t, err)
+	}
+	accrue(first)
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, authority, first)
+	require.NoError(t, err)
+
+	decorator := loyaltyante.NewFeeDecorator(app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.LoyaltyKeeper, nil)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	claim := func(grantee sdk.AccAddress, amount int64) error {
+		builder := app.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&loyaltytypes.MsgClaimReward{Creator: grantee.String(), Denom: denom}))
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		builder.SetFeePayer(grantee)
+		builder.SetFeeGranter(authority)
+		builder.SetGasLimit(simtestutil.DefaultGenTxGas)
+		_, err := decorator.AnteHandle(ctx, builder.GetTx(), false, next)
+		return err
+	}
+	require.NoError(t, claim(first, 150))
+
+	// The second allowance is bounded by what is left of the budget. Spending it
+	// refuses further sponsored fees, and EndBlock revokes the allowances that remain.
+	accrue(second)
+	res, err := loyaltykeeper.NewQueryServerImpl(app.LoyaltyKeeper).ClaimSponsorship(ctx, &loyaltytypes.QueryClaimSponsorshipRequest{Denom: denom, Address: second.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), res.RemainingBudget)
+	require.Equal(t, uint64(150), res.RemainingAllowance)
+	require.NoError(t, claim(second, 150))
+	require.ErrorIs(t, claim(first, 10), loyaltytypes.ErrFeeNotSponsored)
+	require.NoError(t, app.LoyaltyKeeper.RevokeSpentClaimSponsorships(ctx))
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, authority, first)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	require.ErrorIs(t, claim(first, 10), sdkerrors.ErrNotFound)
+}
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
@@ -42,6 +43,7 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
@@ -49,6 +51,8 @@ import (
 
 	"tokenchain/docs"
 	loyaltymodulekeeper "tokenchain/x/loyalty/keeper"
+	loyaltystream "tokenchain/x/loyalty/stream"
+	loyaltymoduletypes "tokenchain/x/loyalty/types"
 )
 
 const (
@@ -92,6 +96,7 @@ type App struct {
 	AuthzKeeper           authzkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
+	FeeGrantKeeper        feegrantkeeper.Keeper
 	ParamsKeeper          paramskeeper.Keeper
 
 	// ibc keepers
@@ -102,6 +107,8 @@ type App struct {
 	WasmKeeper          wasmkeeper.Keeper
 
 	LoyaltyKeeper loyaltymodulekeeper.Keeper
+	// EventStream streams loyalty events to node clients; nil unless enabled.
+	EventStream *loyaltystream.Service
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -180,6 +187,7 @@ func New(
 		&app.AuthzKeeper,
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.FeeGrantKeeper,
 		&app.ParamsKeeper,
 		&app.LoyaltyKeeper,
 	); err != nil {
@@ -200,6 +208,10 @@ func New(
 	if err := app.registerWasmModules(appOpts); err != nil {
 		panic(err)
 	}
+	app.setAnteHandler()
+	if err := app.registerEventStream(appOpts, logger); err != nil {
+		panic(err)
+	}
 
 	/****  Module Options ****/
 
@@ -211,6 +223,11 @@ func New(
 
 	app.sm.RegisterStoreDecoders()
 
+	app.setUpgradeHandlers()
+	if err := app.setUpgradeStoreLoaders(); err != nil {
+		panic(err)
+	}
+
 	// A custom InitChainer sets if extra pre-init-genesis logic is required.
 	// This is necessary for manually registered modules that do not support app wiring.
 	// Manually set the module version map as shown below.
@@ -264,6 +281,22 @@ func (app *App) TxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetBaseApp returns the App's BaseApp.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+// GetTxConfig returns App's TxConfig. It lets the app run under ibc-go's testing
+// package.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
+// GetIBCKeeper returns the IBC keeper.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 func (app *App) GetKey(storeKey string) *storetypes.KVStoreKey {
 	kvStoreKey, ok := app.UnsafeFindStoreKey(storeKey).(*storetypes.KVStoreKey)
@@ -289,6 +322,25 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 
 	// register app's OpenAPI routes.
 	docs.RegisterOpenAPIService(Name, apiSvr.Router)
+
+	if app.EventStream != nil {
+		app.EventStream.RegisterRoutes(apiSvr.Router, apiConfig.EnableUnsafeCORS)
+	}
+}
+
+// RegisterGRPCServer registers the gRPC services of the app and, when enabled,
+// the loyalty event stream.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.RegisterGRPCServerWithSkipCheckHeader(server, false)
+}
+
+// RegisterGRPCServerWithSkipCheckHeader is RegisterGRPCServer with the header
+// check of queries configurable.
+func (app *App) RegisterGRPCServerWithSkipCheckHeader(server gogogrpc.Server, skipCheckHeader bool) {
+	app.App.RegisterGRPCServerWithSkipCheckHeader(server, skipCheckHeader)
+	if app.EventStream != nil {
+		loyaltymoduletypes.RegisterEventStreamServer(server, app.EventStream)
+	}
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -78,6 +78,8 @@ import (
 
 const loyaltyAuthorityEnvVar = "TOKENCHAIN_LOYALTY_AUTHORITY"
 
+// loyaltyModuleAuthority returns the address that receives every loyalty role at genesis.
+// It is not consulted after InitChain.
 func loyaltyModuleAuthority() string {
 	return strings.TrimSpace(os.Getenv(loyaltyAuthorityEnvVar))
 }
@@ -95,6 +97,7 @@ var (
 		{Account: icatypes.ModuleName},
 		{Account: wasmtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: loyaltymoduletypes.ModuleName, Permissions: []string{authtypes.Minter}},
+		{Account: loyaltymoduletypes.GasPoolName},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -106,6 +109,7 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		loyaltymoduletypes.GasPoolName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -222,8 +226,9 @@ var (
 				Config: appconfig.WrapAny(&slashingmodulev1.Module{}),
 			},
 			{
-				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Name: "tx",
+				// The ante handler is set in app/ante.go so that verified tokens can pay fees.
+				Config: appconfig.WrapAny(&txconfigv1.Config{SkipAnteHandler: true}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -286,7 +291,7 @@ var (
 			},
 			{
 				Name:   loyaltymoduletypes.ModuleName,
-				Config: appconfig.WrapAny(&loyaltymoduletypes.Module{Authority: loyaltyModuleAuthority()}),
+				Config: appconfig.WrapAny(&loyaltymoduletypes.Module{}),
 			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
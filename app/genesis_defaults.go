@@ -8,11 +8,14 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	loyaltymoduletypes "tokenchain/x/loyalty/types"
 )
 
 // enforceNoInflationGenesis rewrites selected module defaults before init genesis:
 // - x/mint inflation fields are forced to zero
 // - x/wasm upload policy defaults to permissioned uploads
+// - x/loyalty roles are seeded from TOKENCHAIN_LOYALTY_AUTHORITY when genesis leaves them empty
 func enforceNoInflationGenesis(cdc codec.Codec, req *abci.RequestInitChain) error {
 	if len(req.AppStateBytes) == 0 {
 		return nil
@@ -29,6 +32,9 @@ func enforceNoInflationGenesis(cdc codec.Codec, req *abci.RequestInitChain) erro
 	if err := enforceWasmUploadPolicy(cdc, appState); err != nil {
 		return err
 	}
+	if err := seedLoyaltyAuthorities(cdc, appState, loyaltyModuleAuthority()); err != nil {
+		return err
+	}
 
 	updated, err := json.Marshal(appState)
 	if err != nil {
@@ -84,3 +90,30 @@ func enforceWasmUploadPolicy(cdc codec.Codec, appState map[string]json.RawMessag
 	appState[wasmtypes.ModuleName] = cdc.MustMarshalJSON(&wasmState)
 	return nil
 }
+
+// seedLoyaltyAuthorities grants every loyalty role to authority. It only runs at
+// InitChain: afterwards the role set lives in state and changes via MsgUpdateAuthorities,
+// so the environment variable can no longer make nodes disagree.
+func seedLoyaltyAuthorities(cdc codec.Codec, appState map[string]json.RawMessage, authority string) error {
+	if authority == "" {
+		return nil
+	}
+	loyaltyStateBz, ok := appState[loyaltymoduletypes.ModuleName]
+	if !ok {
+		return nil
+	}
+
+	var loyaltyState loyaltymoduletypes.GenesisState
+	if err := cdc.UnmarshalJSON(loyaltyStateBz, &loyaltyState); err != nil {
+		return err
+	}
+
+	// An explicit role set in genesis always wins over the environment.
+	if !loyaltyState.Authorities.IsEmpty() {
+		return nil
+	}
+	loyaltyState.Authorities = loyaltymoduletypes.NewAuthoritiesForAddress(authority)
+
+	appState[loyaltymoduletypes.ModuleName] = cdc.MustMarshalJSON(&loyaltyState)
+	return nil
+}
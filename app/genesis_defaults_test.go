@@ -12,6 +12,8 @@ import (
 	mint "github.com/cosmos/cosmos-sdk/x/mint"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/require"
+
+	loyaltymoduletypes "tokenchain/x/loyalty/types"
 )
 
 func TestEnforceNoInflationGenesis(t *testing.T) {
@@ -70,3 +72,27 @@ func TestEnforceWasmUploadPolicy(t *testing.T) {
 	require.Equal(t, wasmtypes.AccessTypeNobody, outWasm.Params.CodeUploadAccess.Permission)
 	require.Equal(t, wasmtypes.AccessTypeEverybody, outWasm.Params.InstantiateDefaultPermission)
 }
+
+func TestSeedLoyaltyAuthorities(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	seed := "tokenchain1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqf6vsh"
+
+	appState := map[string]json.RawMessage{
+		loyaltymoduletypes.ModuleName: encCfg.Codec.MustMarshalJSON(loyaltymoduletypes.DefaultGenesis()),
+	}
+	require.NoError(t, seedLoyaltyAuthorities(encCfg.Codec, appState, seed))
+
+	var outLoyalty loyaltymoduletypes.GenesisState
+	require.NoError(t, encCfg.Codec.UnmarshalJSON(appState[loyaltymoduletypes.ModuleName], &outLoyalty))
+	for _, role := range loyaltymoduletypes.AllRoles {
+		require.True(t, outLoyalty.Authorities.HasRole(role, seed), role)
+	}
+
+	// Roles already present in genesis are left untouched.
+	explicit := loyaltymoduletypes.DefaultGenesis()
+	explicit.Authorities.AccrualRecorders = []string{"tokenchain1recorder"}
+	appState[loyaltymoduletypes.ModuleName] = encCfg.Codec.MustMarshalJSON(explicit)
+	require.NoError(t, seedLoyaltyAuthorities(encCfg.Codec, appState, seed))
+	require.NoError(t, encCfg.Codec.UnmarshalJSON(appState[loyaltymoduletypes.ModuleName], &outLoyalty))
+	require.True(t, explicit.Authorities.Equal(outLoyalty.Authorities))
+}
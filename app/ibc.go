@@ -30,6 +30,8 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	loyaltyibc "tokenchain/x/loyalty/ibcmiddleware"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -76,6 +78,12 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		govModuleAddr,
 	)
 
+	// Outbound transfers pass through the loyalty middleware, which enforces the IBC
+	// policy of verified tokens. It must be the transfer keeper's ICS4Wrapper before
+	// the keeper is copied into the transfer modules below.
+	loyaltyTransferMiddleware := loyaltyibc.NewIBCMiddleware(app.IBCKeeper.ChannelKeeper, app.LoyaltyKeeper)
+	app.TransferKeeper.WithICS4Wrapper(loyaltyTransferMiddleware)
+
 	// Create interchain account keepers
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		app.appCodec,
@@ -99,14 +107,22 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		govModuleAddr,
 	)
 
+	// merchant interchain accounts are driven by the loyalty module
+	app.LoyaltyKeeper.SetIBCKeepers(app.ICAControllerKeeper, app.TransferKeeper, app.IBCKeeper.ChannelKeeper)
+
 	// create IBC module from bottom to top of stack
 	var (
 		transferStack      porttypes.IBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
 		transferStackV2    ibcapi.IBCModule    = ibctransferv2.NewIBCModule(app.TransferKeeper)
-		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddlewareWithAuth(loyaltyibc.NewICAControllerModule(app.LoyaltyKeeper), app.ICAControllerKeeper)
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
 	)
 
+	// wrap the transfer applications with the loyalty token policy middleware
+	loyaltyTransferMiddleware.SetUnderlyingApplication(transferStack)
+	transferStack = loyaltyTransferMiddleware
+	transferStackV2 = loyaltyibc.NewIBCMiddlewareV2(transferStackV2, app.LoyaltyKeeper)
+
 	// create IBC v1 router, add transfer route, then set it on the keeper
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
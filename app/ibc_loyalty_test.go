@@ -0,0 +1,398 @@
+package app
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	loyaltykeeper "tokenchain/x/loyalty/keeper"
+	loyaltytypes "tokenchain/x/loyalty/types"
+)
+
+// setupIBCTestingApp runs every chain of the ibc-go test coordinator on this app,
+// each with its own home directory for the wasm VM.
+func setupIBCTestingApp(t *testing.T) {
+	t.Helper()
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()})
+		return app, app.DefaultGenesis()
+	}
+}
+
+// createIBCTestToken registers a verified token owned by the loyalty authority on
+// chain and mints amount of it to the chain's sender account.
+func createIBCTestToken(t *testing.T, chain *ibctesting.TestChain, subdenom string, seizureOptIn bool, amount uint64) string {
+	t.Helper()
+	app := chain.App.(*App)
+	ctx := chain.GetContext()
+	srv := loyaltykeeper.NewMsgServerImpl(app.LoyaltyKeeper)
+	authority := sdk.AccAddress(app.LoyaltyKeeper.GetAuthority()).String()
+
+	msg := &loyaltytypes.MsgCreateVerifiedtoken{
+		Creator:   authority,
+		Denom:     subdenom,
+		Issuer:    authority,
+		Name:      "IBC " + subdenom,
+		Symbol:    subdenom,
+		MaxSupply: 1_000_000,
+		Decimals:  loyaltytypes.LegacyTokenDecimals,
+	}
+	if seizureOptIn {
+		msg.SeizureOptIn = true
+		msg.RecoveryGroupPolicy = createIBCTestGroupPolicy(t, chain)
+		msg.RecoveryTimelockHours = 24
+	}
+	_, err := srv.CreateVerifiedtoken(ctx, msg)
+	require.NoError(t, err)
+	denom := "factory/" + authority + "/" + subdenom
+	_, err = srv.MintVerifiedToken(ctx, &loyaltytypes.MsgMintVerifiedToken{
+		Creator:   authority,
+		Denom:     denom,
+		Recipient: chain.SenderAccount.GetAddress().String(),
+		Amount:    amount,
+	})
+	require.NoError(t, err)
+	chain.NextBlock()
+	return denom
+}
+
+// createIBCTestGroupPolicy creates a one-member x/group policy on chain to act as a
+// token's recovery group policy.
+func createIBCTestGroupPolicy(t *testing.T, chain *ibctesting.TestChain) string {
+	t.Helper()
+	sender := chain.SenderAccount.GetAddress().String()
+	msg, err := group.NewMsgCreateGroupWithPolicy(
+		sender,
+		[]group.MemberRequest{{Address: sender, Weight: "1"}},
+		"", "", false,
+		group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+	)
+	require.NoError(t, err)
+	res, err := chain.SendMsgs(msg)
+	require.NoError(t, err)
+	for _, event := range res.Events {
+		if event.Type != "cosmos.group.v1.EventCreateGroupPolicy" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "address" {
+				return strings.Trim(attr.Value, `"`)
+			}
+		}
+	}
+	t.Fatal("no group policy created")
+	return ""
+}
+
+func setIBCTestPolicy(t *testing.T, chain *ibctesting.TestChain, denom, mode string, channels ...string) {
+	t.Helper()
+	app := chain.App.(*App)
+	_, err := loyaltykeeper.NewMsgServerImpl(app.LoyaltyKeeper).SetTokenIBCPolicy(chain.GetContext(), &loyaltytypes.MsgSetTokenIBCPolicy{
+		Creator:         sdk.AccAddress(app.LoyaltyKeeper.GetAuthority()).String(),
+		Denom:           denom,
+		Mode:            mode,
+		AllowedChannels: channels,
+	})
+	require.NoError(t, err)
+	chain.NextBlock()
+}
+
+func ibcEscrowed(t *testing.T, chain *ibctesting.TestChain, denom string) uint64 {
+	t.Helper()
+	app := chain.App.(*App)
+	resp, err := loyaltykeeper.NewQueryServerImpl(app.LoyaltyKeeper).TokenIBCPolicy(chain.GetContext(), &loyaltytypes.QueryTokenIBCPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	return resp.Escrowed
+}
+
+func sendIBCTestTransfer(path *ibctesting.Path, denom string, amount int64, timeoutHeight clienttypes.Height) (*transfertypes.MsgTransfer, error) {
+	chain := path.EndpointA.Chain
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(denom, amount),
+		chain.SenderAccount.GetAddress().String(),
+		path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, "",
+	)
+	_, err := chain.SendMsgs(msg)
+	return msg, err
+}
+
+func TestLoyaltyIBCMiddlewareTokenPolicy(t *testing.T) {
+	setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	other := ibctesting.NewTransferPath(chainA, chainB)
+	other.Setup()
+
+	denom := createIBCTestToken(t, chainA, "mileage", false, 1_000)
+	voucher := transfertypes.NewDenom(denom, transfertypes.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+
+	// Plain tokens may leave by default; the escrow is recorded once the packet is sent.
+	res, err := path.EndpointA.Chain.SendMsgs(transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(denom, 100),
+		chainA.SenderAccount.GetAddress().String(), chainB.SenderAccount.GetAddress().String(),
+		chainB.GetTimeoutHeight(), 0, "",
+	))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	require.EqualValues(t, 100, ibcEscrowed(t, chainA, denom))
+	appB := chainB.App.(*App)
+	require.Equal(t, sdkmath.NewInt(100), appB.BankKeeper.GetBalance(chainB.GetContext(), chainB.SenderAccount.GetAddress(), voucher).Amount)
+
+	// Blocked tokens cannot be sent anywhere.
+	setIBCTestPolicy(t, chainA, denom, loyaltytypes.IBCPolicyBlocked)
+	_, err = sendIBCTestTransfer(path, denom, 10, chainB.GetTimeoutHeight())
+	require.ErrorContains(t, err, loyaltytypes.ErrIBCTransferBlocked.Error())
+
+	// Allowlisted tokens only leave over the listed channels.
+	setIBCTestPolicy(t, chainA, denom, loyaltytypes.IBCPolicyAllowlisted, other.EndpointA.ChannelID)
+	_, err = sendIBCTestTransfer(path, denom, 10, chainB.GetTimeoutHeight())
+	require.ErrorContains(t, err, loyaltytypes.ErrIBCTransferBlocked.Error())
+	_, err = sendIBCTestTransfer(other, denom, 10, chainB.GetTimeoutHeight())
+	require.NoError(t, err)
+	require.EqualValues(t, 110, ibcEscrowed(t, chainA, denom))
+
+	// Tokens coming home are taken off the tally, whatever the outbound policy.
+	back := path.Reversed()
+	res, err = chainB.SendMsgs(transfertypes.NewMsgTransfer(
+		back.EndpointA.ChannelConfig.PortID, back.EndpointA.ChannelID, sdk.NewInt64Coin(voucher, 40),
+		chainB.SenderAccount.GetAddress().String(), chainA.SenderAccount.GetAddress().String(),
+		chainA.GetTimeoutHeight(), 0, "",
+	))
+	require.NoError(t, err)
+	packet, err = ibctesting.ParseV1PacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, back.RelayPacket(packet))
+	require.EqualValues(t, 70, ibcEscrowed(t, chainA, denom))
+}
+
+func TestLoyaltyIBCMiddlewareRefundsAndRecoveryTokens(t *testing.T) {
+	setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+
+	// Recovery-enabled tokens are blocked until channels are allowlisted, and cannot
+	// be opened to every channel.
+	denom := createIBCTestToken(t, chainA, "recoverable", true, 1_000)
+	_, err := sendIBCTestTransfer(path, denom, 10, chainB.GetTimeoutHeight())
+	require.ErrorContains(t, err, loyaltytypes.ErrIBCTransferBlocked.Error())
+	app := chainA.App.(*App)
+	_, err = loyaltykeeper.NewMsgServerImpl(app.LoyaltyKeeper).SetTokenIBCPolicy(chainA.GetContext(), &loyaltytypes.MsgSetTokenIBCPolicy{
+		Creator: sdk.AccAddress(app.LoyaltyKeeper.GetAuthority()).String(),
+		Denom:   denom,
+		Mode:    loyaltytypes.IBCPolicyAllowed,
+	})
+	require.ErrorIs(t, err, loyaltytypes.ErrInvalidIBCPolicy)
+	setIBCTestPolicy(t, chainA, denom, loyaltytypes.IBCPolicyAllowlisted, path.EndpointA.ChannelID)
+
+	// A transfer that times out is refunded and leaves the tally.
+	timeout := clienttypes.NewHeight(clienttypes.ParseChainID(chainB.ChainID), uint64(chainB.GetContext().BlockHeight())+1)
+	res, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(denom, 25),
+		chainA.SenderAccount.GetAddress().String(), chainB.SenderAccount.GetAddress().String(),
+		timeout, 0, "",
+	))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.EqualValues(t, 25, ibcEscrowed(t, chainA, denom))
+
+	coordinator.CommitNBlocks(chainB, 3)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.Zero(t, ibcEscrowed(t, chainA, denom))
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(chainA.GetContext(), chainA.SenderAccount.GetAddress(), denom).Amount)
+}
+
+// relayIBCTestMemoTransfer sends coin over path with memo and relays the packet
+// and its acknowledgement.
+func relayIBCTestMemoTransfer(t *testing.T, path *ibctesting.Path, coin sdk.Coin, receiver, memo string) {
+	t.Helper()
+	chain := path.EndpointA.Chain
+	res, err := chain.SendMsgs(transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		chain.SenderAccount.GetAddress().String(), receiver,
+		path.EndpointB.Chain.GetTimeoutHeight(), 0, memo,
+	))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+}
+
+func TestLoyaltyIBCMemoAccrualsAndPoolFunding(t *testing.T) {
+	setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	// Memos travel from the partner chain B to chain A.
+	partner := path.Reversed()
+
+	appA := chainA.App.(*App)
+	appB := chainB.App.(*App)
+	denom := createIBCTestToken(t, chainA, "cashback", false, 1_000)
+	holder := sdk.AccAddress([]byte("loyalty-memo-holder_")).String()
+	partnerSender := chainB.SenderAccount.GetAddress()
+	stake := sdk.DefaultBondDenom
+	accrueMemo := func(amount string) string {
+		return `{"loyalty":{"action":"accrue","address":"` + holder + `","denom":"` + denom + `","amount":"` + amount + `"}}`
+	}
+	accrual := func() uint64 {
+		resp, err := loyaltykeeper.NewQueryServerImpl(appA.LoyaltyKeeper).GetRewardaccrual(chainA.GetContext(), &loyaltytypes.QueryGetRewardaccrualRequest{Key: holder + "|" + denom})
+		if err != nil {
+			return 0
+		}
+		return resp.Rewardaccrual.Amount
+	}
+	stakeOnB := func() sdkmath.Int {
+		return appB.BankKeeper.GetBalance(chainB.GetContext(), partnerSender, stake).Amount
+	}
+
+	// Senders that are not allowlisted recorders get an error acknowledgement and
+	// their tokens back.
+	before := stakeOnB()
+	relayIBCTestMemoTransfer(t, partner, sdk.NewInt64Coin(stake, 10), chainA.SenderAccount.GetAddress().String(), accrueMemo("250"))
+	require.Zero(t, accrual())
+	require.Equal(t, before, stakeOnB())
+
+	authority := sdk.AccAddress(appA.LoyaltyKeeper.GetAuthority()).String()
+	_, err := loyaltykeeper.NewMsgServerImpl(appA.LoyaltyKeeper).SetIBCRecorder(chainA.GetContext(), &loyaltytypes.MsgSetIBCRecorder{
+		Creator:   authority,
+		ChannelId: path.EndpointA.ChannelID,
+		Sender:    partnerSender.String(),
+		Enabled:   true,
+	})
+	require.NoError(t, err)
+	chainA.NextBlock()
+
+	// An accrue memo credits the accrual; the transfer itself is delivered as usual.
+	stakeVoucher := transfertypes.NewDenom(stake, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)).IBCDenom()
+	relayIBCTestMemoTransfer(t, partner, sdk.NewInt64Coin(stake, 10), chainA.SenderAccount.GetAddress().String(), accrueMemo("250"))
+	require.EqualValues(t, 250, accrual())
+	require.Equal(t, sdkmath.NewInt(10), appA.BankKeeper.GetBalance(chainA.GetContext(), chainA.SenderAccount.GetAddress(), stakeVoucher).Amount)
+	resp, err := loyaltykeeper.NewQueryServerImpl(appA.LoyaltyKeeper).GetRewardaccrual(chainA.GetContext(), &loyaltytypes.QueryGetRewardaccrualRequest{Key: holder + "|" + denom})
+	require.NoError(t, err)
+	require.Equal(t, loyaltytypes.IBCMemoIntermediaryAddress(path.EndpointA.ChannelID, partnerSender.String()).String(), resp.Rewardaccrual.Creator)
+
+	// Malformed memos fail the whole transfer.
+	before = stakeOnB()
+	relayIBCTestMemoTransfer(t, partner, sdk.NewInt64Coin(stake, 10), chainA.SenderAccount.GetAddress().String(), accrueMemo("-5"))
+	require.EqualValues(t, 250, accrual())
+	require.Equal(t, before, stakeOnB())
+
+	// Tokens coming home with a fund_pool memo land in the reward pool.
+	relayIBCTestMemoTransfer(t, path, sdk.NewInt64Coin(denom, 100), partnerSender.String(), "")
+	voucher := transfertypes.NewDenom(denom, transfertypes.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+	fundMemo := `{"loyalty":{"action":"fund_pool","denom":"` + denom + `"}}`
+	moduleAddr := authtypes.NewModuleAddress(loyaltytypes.ModuleName)
+	relayIBCTestMemoTransfer(t, partner, sdk.NewInt64Coin(voucher, 40), moduleAddr.String(), fundMemo)
+	require.Equal(t, sdkmath.NewInt(40), appA.BankKeeper.GetBalance(chainA.GetContext(), moduleAddr, denom).Amount)
+	require.EqualValues(t, 60, ibcEscrowed(t, chainA, denom))
+
+	// fund_pool must name the module account as receiver and the token it delivers.
+	relayIBCTestMemoTransfer(t, partner, sdk.NewInt64Coin(voucher, 10), chainA.SenderAccount.GetAddress().String(), fundMemo)
+	relayIBCTestMemoTransfer(t, partner, sdk.NewInt64Coin(stake, 10), moduleAddr.String(), fundMemo)
+	require.Equal(t, sdkmath.NewInt(40), appA.BankKeeper.GetBalance(chainA.GetContext(), moduleAddr, denom).Amount)
+	require.Equal(t, sdkmath.NewInt(60), appB.BankKeeper.GetBalance(chainB.GetContext(), partnerSender, voucher).Amount)
+	require.EqualValues(t, 60, ibcEscrowed(t, chainA, denom))
+}
+
+func TestLoyaltyIBCRateLimits(t *testing.T) {
+	setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+
+	appA := chainA.App.(*App)
+	appB := chainB.App.(*App)
+	srv := loyaltykeeper.NewMsgServerImpl(appA.LoyaltyKeeper)
+	qs := loyaltykeeper.NewQueryServerImpl(appA.LoyaltyKeeper)
+	denom := sdk.DefaultBondDenom
+	_, err := srv.SetIBCRateLimit(chainA.GetContext(), &loyaltytypes.MsgSetIBCRateLimit{
+		Authority:     sdk.AccAddress(appA.LoyaltyKeeper.GetAuthority()).String(),
+		Denom:         denom,
+		ChannelId:     path.EndpointA.ChannelID,
+		MaxOutflow:    1_000,
+		MaxInflow:     300,
+		WindowSeconds: 3_600,
+	})
+	require.NoError(t, err)
+	chainA.NextBlock()
+
+	// Sends fail once they would take the window's outflow past the quota.
+	send := func(amount int64) error {
+		res, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(denom, amount),
+			chainA.SenderAccount.GetAddress().String(), chainB.SenderAccount.GetAddress().String(),
+			chainB.GetTimeoutHeight(), 0, "",
+		))
+		if err != nil {
+			return err
+		}
+		packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+		require.NoError(t, err)
+		return path.RelayPacket(packet)
+	}
+	require.NoError(t, send(600))
+	require.ErrorContains(t, send(500), loyaltytypes.ErrIBCRateLimited.Error())
+	require.NoError(t, send(400))
+
+	// Tokens coming back past the inflow quota get an error acknowledgement and are
+	// refunded on the counterparty.
+	voucher := transfertypes.NewDenom(denom, transfertypes.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+	back := path.Reversed()
+	sendBack := func(amount int64) {
+		res, err := chainB.SendMsgs(transfertypes.NewMsgTransfer(
+			back.EndpointA.ChannelConfig.PortID, back.EndpointA.ChannelID, sdk.NewInt64Coin(voucher, amount),
+			chainB.SenderAccount.GetAddress().String(), chainA.SenderAccount.GetAddress().String(),
+			chainA.GetTimeoutHeight(), 0, "",
+		))
+		require.NoError(t, err)
+		packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+		require.NoError(t, err)
+		require.NoError(t, back.RelayPacket(packet))
+	}
+	sendBack(200)
+	sendBack(200)
+	require.Equal(t, sdkmath.NewInt(800), appB.BankKeeper.GetBalance(chainB.GetContext(), chainB.SenderAccount.GetAddress(), voucher).Amount)
+
+	usage, err := qs.IBCRateLimit(chainA.GetContext(), &loyaltytypes.QueryIBCRateLimitRequest{Denom: denom, ChannelId: path.EndpointA.ChannelID})
+	require.NoError(t, err)
+	require.EqualValues(t, 1_000, usage.Usage.Flow.Outflow)
+	require.EqualValues(t, 200, usage.Usage.Flow.Inflow)
+	require.Zero(t, usage.Usage.RemainingOutflow)
+	require.EqualValues(t, 100, usage.Usage.RemainingInflow)
+
+	// The quota is available again in the next window.
+	coordinator.IncrementTimeBy(time.Hour)
+	require.NoError(t, path.EndpointB.UpdateClient())
+	require.NoError(t, send(500))
+}
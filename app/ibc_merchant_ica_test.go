@@ -0,0 +1,218 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	loyaltykeeper "tokenchain/x/loyalty/keeper"
+	loyaltytypes "tokenchain/x/loyalty/types"
+)
+
+// createIBCTestMerchantToken registers a merchant owned by the chain's sender
+// account and a verified token linked to it, minted to the sender. The chain is
+// switched to allowlisted token creation.
+func createIBCTestMerchantToken(t *testing.T, chain *ibctesting.TestChain, subdenom string, amount uint64) (string, uint64) {
+	t.Helper()
+	app := chain.App.(*App)
+	ctx := chain.GetContext()
+	srv := loyaltykeeper.NewMsgServerImpl(app.LoyaltyKeeper)
+	owner := chain.SenderAccount.GetAddress().String()
+
+	params, err := app.LoyaltyKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CreationMode = loyaltytypes.CreationModeAllowlisted
+	require.NoError(t, app.LoyaltyKeeper.Params.Set(ctx, params))
+	_, err = srv.CreateCreatorallowlist(ctx, &loyaltytypes.MsgCreateCreatorallowlist{
+		Creator: sdk.AccAddress(app.LoyaltyKeeper.GetAuthority()).String(),
+		Address: owner,
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	merchant, err := srv.RegisterMerchant(ctx, &loyaltytypes.MsgRegisterMerchant{
+		Creator:       owner,
+		LegalName:     "Harbour Coffee Ltd",
+		PayoutAddress: owner,
+	})
+	require.NoError(t, err)
+	_, err = srv.CreateVerifiedtoken(ctx, &loyaltytypes.MsgCreateVerifiedtoken{
+		Creator:    owner,
+		Denom:      subdenom,
+		Issuer:     owner,
+		Name:       "Merchant " + subdenom,
+		Symbol:     subdenom,
+		MaxSupply:  1_000_000,
+		Decimals:   loyaltytypes.LegacyTokenDecimals,
+		MerchantId: merchant.Id,
+	})
+	require.NoError(t, err)
+	denom := "factory/" + owner + "/" + subdenom
+	_, err = srv.MintVerifiedToken(ctx, &loyaltytypes.MsgMintVerifiedToken{
+		Creator:   owner,
+		Denom:     denom,
+		Recipient: owner,
+		Amount:    amount,
+	})
+	require.NoError(t, err)
+	chain.NextBlock()
+	return denom, merchant.Id
+}
+
+// merchantICATestPath is the interchain account path of a merchant over the
+// connection of transferPath.
+func merchantICATestPath(transferPath *ibctesting.Path, merchantID uint64) *ibctesting.Path {
+	path := ibctesting.NewPath(transferPath.EndpointA.Chain, transferPath.EndpointB.Chain)
+	path.EndpointA.ClientID = transferPath.EndpointA.ClientID
+	path.EndpointA.ConnectionID = transferPath.EndpointA.ConnectionID
+	path.EndpointB.ClientID = transferPath.EndpointB.ClientID
+	path.EndpointB.ConnectionID = transferPath.EndpointB.ConnectionID
+	path.EndpointA.ChannelConfig.PortID = loyaltytypes.MerchantICAPortID(merchantID)
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	return path
+}
+
+func merchantICA(t *testing.T, chain *ibctesting.TestChain, merchantID uint64) loyaltytypes.MerchantICA {
+	t.Helper()
+	app := chain.App.(*App)
+	resp, err := loyaltykeeper.NewQueryServerImpl(app.LoyaltyKeeper).MerchantICAs(chain.GetContext(), &loyaltytypes.QueryMerchantICAsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	require.Len(t, resp.Icas, 1)
+	return resp.Icas[0]
+}
+
+func merchantPacketsInFlight(t *testing.T, chain *ibctesting.TestChain, merchantID uint64) int {
+	t.Helper()
+	app := chain.App.(*App)
+	resp, err := loyaltykeeper.NewQueryServerImpl(app.LoyaltyKeeper).MerchantICAPackets(chain.GetContext(), &loyaltytypes.QueryMerchantICAPacketsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	return len(resp.Packets)
+}
+
+func TestMerchantICATreasuryFlow(t *testing.T) {
+	setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	transferPath := ibctesting.NewTransferPath(chainA, chainB)
+	transferPath.Setup()
+
+	appA := chainA.App.(*App)
+	appB := chainB.App.(*App)
+	srv := loyaltykeeper.NewMsgServerImpl(appA.LoyaltyKeeper)
+	owner := chainA.SenderAccount.GetAddress().String()
+	denom, merchantID := createIBCTestMerchantToken(t, chainA, "beans", 10_000)
+
+	// Registering starts the handshake; the account is active once it completes.
+	ctx := chainA.GetContext()
+	registered, err := srv.RegisterMerchantICA(ctx, &loyaltytypes.MsgRegisterMerchantICA{
+		Creator:      owner,
+		Denom:        denom,
+		ConnectionId: transferPath.EndpointA.ConnectionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, loyaltytypes.MerchantICAStatusPending, registered.Ica.Status)
+	chainA.NextBlock()
+
+	icaPath := merchantICATestPath(transferPath, merchantID)
+	icaPath.EndpointA.ChannelID = registered.Ica.ChannelId
+	version := icaPath.EndpointA.GetChannel().Version
+	icaPath.EndpointA.ChannelConfig.Version = version
+	icaPath.EndpointB.ChannelConfig.Version = version
+	require.NoError(t, icaPath.EndpointB.ChanOpenTry())
+	require.NoError(t, icaPath.EndpointA.ChanOpenAck())
+	require.NoError(t, icaPath.EndpointB.ChanOpenConfirm())
+
+	ica := merchantICA(t, chainA, merchantID)
+	require.Equal(t, loyaltytypes.MerchantICAStatusActive, ica.Status)
+	hostAddress, found := appA.ICAControllerKeeper.GetInterchainAccountAddress(chainA.GetContext(), transferPath.EndpointA.ConnectionID, ica.PortId)
+	require.True(t, found)
+	require.Equal(t, hostAddress, ica.Address)
+
+	// The treasury share of an allocation is forwarded to the account.
+	ctx = chainA.GetContext()
+	alloc, err := srv.RecordMerchantAllocation(ctx, &loyaltytypes.MsgRecordMerchantAllocation{
+		Creator:       sdk.AccAddress(appA.LoyaltyKeeper.GetAuthority()).String(),
+		Denom:         denom,
+		ActivityScore: 5,
+		BucketCAmount: 2_000,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, alloc.TreasuryAmount)
+	_, err = srv.ForwardMerchantTreasury(ctx, &loyaltytypes.MsgForwardMerchantTreasury{
+		Creator:         owner,
+		AllocationKey:   alloc.Key,
+		ConnectionId:    transferPath.EndpointA.ConnectionID,
+		TransferChannel: transferPath.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+	packet, err := ibctesting.ParseV1PacketFromEvents(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	chainA.NextBlock()
+	require.Equal(t, 1, merchantPacketsInFlight(t, chainA, merchantID))
+	require.NoError(t, transferPath.RelayPacket(packet))
+	require.Zero(t, merchantPacketsInFlight(t, chainA, merchantID))
+
+	voucher := transfertypes.NewDenom(denom, transfertypes.NewHop(transferPath.EndpointB.ChannelConfig.PortID, transferPath.EndpointB.ChannelID)).IBCDenom()
+	treasury := sdkmath.NewIntFromUint64(alloc.TreasuryAmount)
+	require.Equal(t, treasury, appB.BankKeeper.GetBalance(chainB.GetContext(), sdk.MustAccAddressFromBech32(hostAddress), voucher).Amount)
+	forwards, err := loyaltykeeper.NewQueryServerImpl(appA.LoyaltyKeeper).TreasuryForwards(chainA.GetContext(), &loyaltytypes.QueryTreasuryForwardsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	require.Len(t, forwards.Forwards, 1)
+	require.Equal(t, loyaltytypes.TreasuryForwardCompleted, forwards.Forwards[0].Status)
+
+	// The account moves the forwarded funds on the host chain.
+	payee := chainB.SenderAccount.GetAddress()
+	sendTx := func(amount sdkmath.Int, timeoutSeconds uint64) channeltypes.Packet {
+		cosmosTx, err := icatypes.SerializeCosmosTx(appA.AppCodec(), []proto.Message{&banktypes.MsgSend{
+			FromAddress: hostAddress,
+			ToAddress:   payee.String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(voucher, amount)),
+		}}, icatypes.EncodingProtobuf)
+		require.NoError(t, err)
+		ctx := chainA.GetContext()
+		_, err = srv.SendMerchantICATx(ctx, &loyaltytypes.MsgSendMerchantICATx{
+			Creator:        owner,
+			Denom:          denom,
+			ConnectionId:   transferPath.EndpointA.ConnectionID,
+			CosmosTx:       cosmosTx,
+			TimeoutSeconds: timeoutSeconds,
+		})
+		require.NoError(t, err)
+		packet, err := ibctesting.ParseV1PacketFromEvents(ctx.EventManager().ABCIEvents())
+		require.NoError(t, err)
+		chainA.NextBlock()
+		return packet
+	}
+	before := appB.BankKeeper.GetBalance(chainB.GetContext(), payee, voucher).Amount
+	require.NoError(t, icaPath.RelayPacket(sendTx(sdkmath.NewInt(30), 0)))
+	require.Equal(t, before.AddRaw(30), appB.BankKeeper.GetBalance(chainB.GetContext(), payee, voucher).Amount)
+
+	// Spending more than the account holds fails on the host.
+	require.NoError(t, icaPath.RelayPacket(sendTx(treasury, 0)))
+
+	// Packets that are never relayed time out.
+	packet = sendTx(sdkmath.NewInt(1), 60)
+	require.Equal(t, 1, merchantPacketsInFlight(t, chainA, merchantID))
+	coordinator.IncrementTimeBy(2 * time.Minute)
+	require.NoError(t, icaPath.EndpointA.UpdateClient())
+	require.NoError(t, icaPath.EndpointA.TimeoutPacket(packet))
+	require.Zero(t, merchantPacketsInFlight(t, chainA, merchantID))
+
+	ica = merchantICA(t, chainA, merchantID)
+	require.EqualValues(t, 4, ica.PacketsSent)
+	require.EqualValues(t, 2, ica.PacketsAcknowledged)
+	require.EqualValues(t, 1, ica.PacketsFailed)
+	require.EqualValues(t, 1, ica.PacketsTimedOut)
+	require.Equal(t, loyaltytypes.MerchantICAStatusActive, ica.Status)
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/server/types"
+
+	loyaltystream "tokenchain/x/loyalty/stream"
+)
+
+// registerEventStream hooks the loyalty event stream into the ABCI listeners of
+// the base app when it is enabled in app.toml.
+func (app *App) registerEventStream(appOpts types.AppOptions, logger log.Logger) error {
+	cfg, err := loyaltystream.ReadConfig(appOpts)
+	if err != nil {
+		return fmt.Errorf("read loyalty stream config: %w", err)
+	}
+	if !cfg.Enable {
+		return nil
+	}
+
+	app.EventStream = loyaltystream.NewService(cfg, logger)
+	manager := app.StreamingManager()
+	app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: append(manager.ABCIListeners, app.EventStream),
+		StopNodeOnErr: manager.StopNodeOnErr,
+	})
+	return nil
+}
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	loyaltykeeper "tokenchain/x/loyalty/keeper"
+	loyaltytypes "tokenchain/x/loyalty/types"
+)
+
+// eventStreamServer collects the events of a Subscribe call.
+type eventStreamServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *loyaltytypes.StreamedEvent
+}
+
+func (s *eventStreamServer) Context() context.Context { return s.ctx }
+
+func (s *eventStreamServer) Send(event *loyaltytypes.StreamedEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestLoyaltyEventStream(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{
+			flags.FlagHome:          t.TempDir(),
+			"loyalty-stream.enable": true,
+		})
+		return app, app.DefaultGenesis()
+	}
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.App.(*App)
+	require.NotNil(t, app.EventStream)
+	require.Contains(t, app.StreamingManager().ABCIListeners, storetypes.ABCIListener(app.EventStream))
+
+	denom := createIBCTestToken(t, chain, "streamed", false, 1_000)
+	ctx := chain.GetContext()
+	srv := loyaltykeeper.NewMsgServerImpl(app.LoyaltyKeeper)
+	sender := chain.SenderAccount.GetAddress().String()
+	authority := sdk.AccAddress(app.LoyaltyKeeper.GetAuthority()).String()
+	_, err := srv.FundRewardPool(ctx, &loyaltytypes.MsgFundRewardPool{Creator: sender, Denom: denom, Amount: 100})
+	require.NoError(t, err)
+	_, err = srv.RecordRewardAccrual(ctx, &loyaltytypes.MsgRecordRewardAccrual{Creator: authority, Address: sender, Denom: denom, Amount: 40})
+	require.NoError(t, err)
+	chain.NextBlock()
+
+	_, err = chain.SendMsgs(&loyaltytypes.MsgClaimReward{Creator: sender, Denom: denom})
+	require.NoError(t, err)
+	height := chain.LatestCommittedHeader.Header.Height
+
+	// The claim is streamed from the committed block with its transaction hash.
+	stream := &eventStreamServer{events: make(chan *loyaltytypes.StreamedEvent, 8)}
+	var cancel context.CancelFunc
+	stream.ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = app.EventStream.Subscribe(&loyaltytypes.SubscribeEventsRequest{
+			Denom:      denom,
+			Address:    sender,
+			FromHeight: height,
+			EventTypes: []string{"loyalty.reward_claimed"},
+		}, stream)
+	}()
+	select {
+	case event := <-stream.events:
+		require.Equal(t, height, event.Height)
+		require.NotEmpty(t, event.TxHash)
+		require.Equal(t, "40", event.Attributes["amount"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("claim was not streamed")
+	}
+}
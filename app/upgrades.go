@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"tokenchain/app/upgrades"
+	v2 "tokenchain/app/upgrades/v2"
+)
+
+// Upgrades lists every named software upgrade the binary knows how to apply.
+// New upgrades are appended here and picked up by setUpgradeHandlers and setUpgradeStoreLoaders.
+var Upgrades = []upgrades.Upgrade{
+	v2.Upgrade,
+}
+
+// setUpgradeHandlers registers the x/upgrade handler of every known upgrade.
+func (app *App) setUpgradeHandlers() {
+	keepers := upgrades.AppKeepers{
+		LoyaltyKeeper: app.LoyaltyKeeper,
+	}
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), keepers),
+		)
+	}
+}
+
+// setUpgradeStoreLoaders installs the store loader of the pending upgrade, if any,
+// so that its store additions and deletions apply when the node restarts at the upgrade height.
+func (app *App) setUpgradeStoreLoaders() error {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("failed to read upgrade info from disk: %w", err)
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return nil
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name != upgrade.UpgradeName {
+			continue
+		}
+		storeUpgrades := upgrade.StoreUpgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package upgrades
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	loyaltymodulekeeper "tokenchain/x/loyalty/keeper"
+)
+
+// AppKeepers exposes the keepers an upgrade handler may need beyond module migrations.
+type AppKeepers struct {
+	LoyaltyKeeper loyaltymodulekeeper.Keeper
+}
+
+// Upgrade defines a named software upgrade: the stores it adds, renames or deletes,
+// and the handler that migrates state once the upgrade height is reached.
+type Upgrade struct {
+	// UpgradeName is the name of the upgrade plan submitted through governance.
+	UpgradeName string
+
+	// CreateUpgradeHandler builds the x/upgrade handler run at the upgrade height.
+	CreateUpgradeHandler func(*module.Manager, module.Configurator, AppKeepers) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades lists the store keys added, renamed or deleted by the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+}
@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"tokenchain/app/upgrades"
+	loyaltykeeper "tokenchain/x/loyalty/keeper"
+	loyaltytypes "tokenchain/x/loyalty/types"
+)
+
+// UpgradeName is the upgrade plan name that runs the x/loyalty v1 -> v2 store migration.
+const UpgradeName = "loyalty-v2"
+
+// Upgrade moves x/loyalty to consensus version 2. It adds or removes no stores.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        storetypes.StoreUpgrades{},
+}
+
+// PlanInfo is what the upgrade reads from the info of its plan. The info is a
+// JSON object, so it can carry the binaries cosmovisor downloads alongside.
+type PlanInfo struct {
+	// LoyaltyAuthority is the address the pre-upgrade binary used as loyalty
+	// authority in place of x/gov. It is granted every loyalty role.
+	LoyaltyAuthority string `json:"loyalty_authority,omitempty"`
+}
+
+// CreateUpgradeHandler runs the registered module migrations, including loyalty Migrate1to2,
+// then grants every loyalty role to the pre-upgrade authority named in the plan info.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator, keepers upgrades.AppKeepers) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		info, err := parsePlanInfo(plan.Info)
+		if err != nil {
+			return nil, err
+		}
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+		if err := seedLoyaltyAuthorities(ctx, keepers.LoyaltyKeeper, info.LoyaltyAuthority); err != nil {
+			return nil, err
+		}
+		return vm, nil
+	}
+}
+
+// parsePlanInfo reads the plan info. Info that is not a JSON object, such as
+// empty info or a URL, carries nothing for the upgrade.
+func parsePlanInfo(raw string) (PlanInfo, error) {
+	var info PlanInfo
+	if !strings.HasPrefix(strings.TrimSpace(raw), "{") {
+		return info, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &info); err != nil {
+		return PlanInfo{}, fmt.Errorf("invalid %s plan info: %w", UpgradeName, err)
+	}
+	return info, nil
+}
+
+// seedLoyaltyAuthorities keeps the operator that v1 treated as loyalty authority in
+// charge: v2 resolves the keeper authority to x/gov, so without a role set every
+// scoped permission would move to governance. The address comes from the plan,
+// so every validator writes the same role set. A role set already in state is
+// left alone.
+func seedLoyaltyAuthorities(ctx context.Context, k loyaltykeeper.Keeper, authority string) error {
+	if authority == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid pre-upgrade loyalty authority %q: %w", authority, err)
+	}
+
+	current, err := k.Authorities.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if !current.IsEmpty() {
+		return nil
+	}
+	return k.Authorities.Set(ctx, loyaltytypes.NewAuthoritiesForAddress(authority))
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "tokenchain/app/upgrades/v2"
+	loyaltymodulekeeper "tokenchain/x/loyalty/keeper"
+	loyaltymoduletypes "tokenchain/x/loyalty/types"
+)
+
+func TestUpgradesRegistered(t *testing.T) {
+	names := make(map[string]struct{}, len(Upgrades))
+	for _, upgrade := range Upgrades {
+		require.NotEmpty(t, upgrade.UpgradeName)
+		require.NotNil(t, upgrade.CreateUpgradeHandler)
+		_, dup := names[upgrade.UpgradeName]
+		require.False(t, dup, "duplicate upgrade name %s", upgrade.UpgradeName)
+		names[upgrade.UpgradeName] = struct{}{}
+	}
+	require.Contains(t, names, v2.UpgradeName)
+}
+
+func TestLoyaltyV2UpgradeHandler(t *testing.T) {
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions)
+	require.True(t, app.UpgradeKeeper.HasHandler(v2.UpgradeName))
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, Time: time.Unix(1_772_000_000, 0)})
+
+	// Pretend the chain was started by the previous binary, where x/loyalty was at version 1.
+	prevVM := app.ModuleManager.GetVersionMap()
+	require.EqualValues(t, 2, prevVM[loyaltymoduletypes.ModuleName])
+	prevVM[loyaltymoduletypes.ModuleName] = 1
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, prevVM))
+
+	denom := "factory/tokenchain1merchant/wheat"
+	require.NoError(t, app.LoyaltyKeeper.Verifiedtoken.Set(ctx, denom, loyaltymoduletypes.Verifiedtoken{
+		Denom:  denom,
+		Issuer: "tokenchain1merchant",
+	}))
+	require.NoError(t, app.LoyaltyKeeper.Rewardaccrual.Set(ctx, "legacy", loyaltymoduletypes.Rewardaccrual{
+		Key:     "legacy",
+		Address: "tokenchain1customer",
+		Denom:   denom,
+		Amount:  25,
+	}))
+
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: v2.UpgradeName, Height: ctx.BlockHeight()}))
+
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, vm[loyaltymoduletypes.ModuleName])
+
+	token, err := app.LoyaltyKeeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, loyaltymoduletypes.DefaultMerchantIncentiveStakersBps, token.MerchantIncentiveStakersBps)
+	require.Equal(t, loyaltymoduletypes.DefaultMerchantIncentiveTreasuryBps, token.MerchantIncentiveTreasuryBps)
+	require.Equal(t, "tokenchain1merchant", token.Creator)
+
+	accrual, err := app.LoyaltyKeeper.Rewardaccrual.Get(ctx, "tokenchain1customer|"+denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 25, accrual.Amount)
+	has, err := app.LoyaltyKeeper.Rewardaccrual.Has(ctx, "legacy")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Without a loyalty authority in the plan info, roles are left to gov.
+	has, err = app.LoyaltyKeeper.Authorities.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestLoyaltyV2UpgradeKeepsOperatorRoles(t *testing.T) {
+	// The previous binary ran with the operator as loyalty authority instead of x/gov.
+	operator := sdk.AccAddress([]byte("loyalty-operator____")).String()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, Time: time.Unix(1_772_000_000, 0)})
+
+	prevVM := app.ModuleManager.GetVersionMap()
+	prevVM[loyaltymoduletypes.ModuleName] = 1
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, prevVM))
+	has, err := app.LoyaltyKeeper.Authorities.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The plan names the operator, so the role set does not depend on node configuration.
+	t.Setenv(loyaltyAuthorityEnvVar, sdk.AccAddress([]byte("misconfigured-node__")).String())
+	info, err := json.Marshal(v2.PlanInfo{LoyaltyAuthority: operator})
+	require.NoError(t, err)
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: v2.UpgradeName, Height: ctx.BlockHeight(), Info: string(info)}))
+
+	authorities, err := app.LoyaltyKeeper.Authorities.Get(ctx)
+	require.NoError(t, err)
+	for _, role := range []loyaltymoduletypes.Role{
+		loyaltymoduletypes.RoleParamsAdmin,
+		loyaltymoduletypes.RoleAccrualRecorder,
+		loyaltymoduletypes.RoleAllowlistAdmin,
+		loyaltymoduletypes.RoleRecoveryOverseer,
+	} {
+		require.True(t, authorities.HasRole(role, operator), "operator lost the %s role", role)
+	}
+
+	// The operator can still act, e.g. on the creator allowlist.
+	_, err = loyaltymodulekeeper.NewMsgServerImpl(app.LoyaltyKeeper).CreateCreatorallowlist(ctx, &loyaltymoduletypes.MsgCreateCreatorallowlist{
+		Creator: operator,
+		Address: sdk.AccAddress([]byte("loyalty-merchant____")).String(),
+		Enabled: true,
+	})
+	require.NoError(t, err)
+}
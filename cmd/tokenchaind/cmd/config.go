@@ -4,6 +4,8 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+
+	loyaltystream "tokenchain/x/loyalty/stream"
 )
 
 // initCometBFTConfig helps to override default CometBFT Config values.
@@ -24,6 +26,8 @@ func initAppConfig() (string, interface{}) {
 	// The following code snippet is just for reference.
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
+
+		LoyaltyStream loyaltystream.Config `mapstructure:"loyalty-stream"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -44,10 +48,11 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.MinGasPrices = "0stake"
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
+		Config:        *srvCfg,
+		LoyaltyStream: loyaltystream.DefaultConfig(),
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate()
+	customAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate() + loyaltystream.DefaultConfigTemplate()
 
 	return customAppTemplate, customAppConfig
 }
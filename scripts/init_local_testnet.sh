@@ -8,6 +8,7 @@ HOME_DIR="${HOME_DIR:-$HOME/.tokenchain}"
 KEYRING_BACKEND="${KEYRING_BACKEND:-test}"
 MONIKER="${MONIKER:-founder}"
 RESET_HOME="${RESET_HOME:-1}"
+NETWORK_MODE="${NETWORK_MODE:-testnet}"
 
 if [[ "${RESET_HOME}" == "1" ]]; then
   rm -rf "${HOME_DIR}"
@@ -35,12 +36,13 @@ echo "Creating validator gentx"
 
 "${BINARY}" genesis collect-gentxs --home "${HOME_DIR}"
 
-echo "Setting wasm upload policy for local testnet founder"
+echo "Setting wasm upload policy for local testnet founder and loyalty network mode ${NETWORK_MODE}"
 GENESIS_FILE="${HOME_DIR}/config/genesis.json"
 TMP_GENESIS="$(mktemp)"
-jq --arg founder "${FOUNDER_ADDR}" \
+jq --arg founder "${FOUNDER_ADDR}" --arg network_mode "${NETWORK_MODE}" \
   '.app_state.wasm.params.code_upload_access = {"permission":"AnyOfAddresses","addresses":[$founder]} |
-   .app_state.wasm.params.instantiate_default_permission = "Everybody"' \
+   .app_state.wasm.params.instantiate_default_permission = "Everybody" |
+   .app_state.loyalty.params.network_mode = $network_mode' \
   "${GENESIS_FILE}" >"${TMP_GENESIS}"
 mv "${TMP_GENESIS}" "${GENESIS_FILE}"
 
@@ -0,0 +1,126 @@
+// Package ante holds the fee decorator that lets holders of verified tokens pay
+// transaction fees with them. The gas pool of the token pays the fee in the staking
+// denom and the tokens paid go to the token owner.
+package ante
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// LoyaltyKeeper is the part of the loyalty keeper the decorator relies on.
+type LoyaltyKeeper interface {
+	IsFeeToken(ctx context.Context, denom string) bool
+	SponsoredFee(ctx context.Context, fee sdk.Coin, msgs []sdk.Msg) (sdk.Coin, error)
+	SponsorTxFee(ctx context.Context, payer sdk.AccAddress, fee, sponsored sdk.Coin) error
+	RecordSponsoredFee(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error
+}
+
+// FeeDecorator takes the place of the SDK DeductFeeDecorator. Transactions paying
+// their fee with a single coin of an enabled fee token have it sponsored by the
+// token's gas pool; every other transaction goes through the SDK decorator, and
+// the fees it takes from a fee grant count against claim sponsorships.
+type FeeDecorator struct {
+	accountKeeper  ante.AccountKeeper
+	feegrantKeeper ante.FeegrantKeeper
+	loyaltyKeeper  LoyaltyKeeper
+	next           ante.DeductFeeDecorator
+}
+
+func NewFeeDecorator(ak ante.AccountKeeper, bk authtypes.BankKeeper, fk ante.FeegrantKeeper, lk LoyaltyKeeper, tfc ante.TxFeeChecker) FeeDecorator {
+	return FeeDecorator{
+		accountKeeper:  ak,
+		feegrantKeeper: fk,
+		loyaltyKeeper:  lk,
+		next:           ante.NewDeductFeeDecorator(ak, bk, fk, tfc),
+	}
+}
+
+func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	fee := feeTx.GetFee()
+	if len(fee) != 1 || !fd.loyaltyKeeper.IsFeeToken(ctx, fee[0].Denom) {
+		granter, payer := feeTx.FeeGranter(), feeTx.FeePayer()
+		if granter == nil || bytes.Equal(granter, payer) {
+			return fd.next.AnteHandle(ctx, tx, simulate, next)
+		}
+		return fd.next.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if err := fd.loyaltyKeeper.RecordSponsoredFee(ctx, granter, payer, fee); err != nil {
+				return ctx, err
+			}
+			return next(ctx, tx, simulate)
+		})
+	}
+
+	gas := feeTx.GetGas()
+	if !simulate && ctx.BlockHeight() > 0 && gas == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+	sponsored, err := fd.loyaltyKeeper.SponsoredFee(ctx, fee[0], tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	// The validator minimum gas prices apply to what the gas pool pays.
+	if !simulate && ctx.IsCheckTx() {
+		if minGasPrice := ctx.MinGasPrices().AmountOf(sponsored.Denom); minGasPrice.IsPositive() {
+			required := minGasPrice.MulInt64(int64(gas)).Ceil().RoundInt()
+			if sponsored.Amount.LT(required) {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; %s pays %s, required: %s%s", fee, sponsored, required, sponsored.Denom)
+			}
+		}
+	}
+
+	payer := feeTx.FeePayer()
+	deductFrom := sdk.AccAddress(payer)
+	if granter := feeTx.FeeGranter(); granter != nil {
+		if fd.feegrantKeeper == nil {
+			return ctx, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		}
+		if !bytes.Equal(granter, payer) {
+			if err := fd.feegrantKeeper.UseGrantedFees(ctx, granter, payer, fee, tx.GetMsgs()); err != nil {
+				return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", sdk.AccAddress(granter), sdk.AccAddress(payer))
+			}
+		}
+		deductFrom = granter
+	}
+	if fd.accountKeeper.GetAccount(ctx, deductFrom) == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFrom)
+	}
+	if err := fd.loyaltyKeeper.SponsorTxFee(ctx, deductFrom, fee[0], sponsored); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFrom.String()),
+		),
+	)
+
+	return next(ctx.WithPriority(txPriority(sponsored.Amount, gas)), tx, simulate)
+}
+
+// txPriority ranks a sponsored transaction by the gas price the gas pool pays, as
+// the SDK ranks transactions paying in the staking denom.
+func txPriority(amount sdkmath.Int, gas uint64) int64 {
+	if gas == 0 || gas > math.MaxInt64 {
+		return 0
+	}
+	gasPrice := amount.QuoRaw(int64(gas))
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+	return gasPrice.Int64()
+}
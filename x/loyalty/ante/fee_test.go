@@ -0,0 +1,260 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"tokenchain/x/loyalty/ante"
+	"tokenchain/x/loyalty/types"
+)
+
+const feeDenom = "factory/merchant/shop"
+
+// mockAccountKeeper knows the accounts it has been given.
+type mockAccountKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func (m *mockAccountKeeper) GetParams(context.Context) authtypes.Params {
+	return authtypes.DefaultParams()
+}
+
+func (m *mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m.accounts[addr.String()]
+}
+
+func (m *mockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	m.accounts[acc.GetAddress().String()] = acc
+}
+
+func (m *mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (m *mockAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmos")
+}
+
+func (m *mockAccountKeeper) UnorderedTransactionsEnabled() bool { return false }
+
+func (m *mockAccountKeeper) RemoveExpiredUnorderedNonces(sdk.Context) error { return nil }
+
+func (m *mockAccountKeeper) TryAddUnorderedNonce(sdk.Context, []byte, time.Time) error { return nil }
+
+// mockBankKeeper tracks account balances and what reached the fee collector.
+type mockBankKeeper struct {
+	balances  map[string]sdk.Coins
+	collected sdk.Coins
+}
+
+func (m *mockBankKeeper) IsSendEnabledCoins(context.Context, ...sdk.Coin) error { return nil }
+
+func (m *mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, ok := m.balances[from.String()].SafeSub(amt...)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[from.String()] = balance
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	balance, ok := m.balances[from.String()].SafeSub(amt...)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[from.String()] = balance
+	if module == authtypes.FeeCollectorName {
+		m.collected = m.collected.Add(amt...)
+	}
+	return nil
+}
+
+// mockFeegrantKeeper accepts every grant.
+type mockFeegrantKeeper struct{}
+
+func (mockFeegrantKeeper) UseGrantedFees(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins, []sdk.Msg) error {
+	return nil
+}
+
+// mockLoyaltyKeeper has a single fee token whose gas pool pays one staking unit
+// for every two token units.
+type mockLoyaltyKeeper struct {
+	pool      uint64
+	sponsored []sdk.AccAddress
+	recorded  sdk.Coins
+}
+
+func (m *mockLoyaltyKeeper) IsFeeToken(_ context.Context, denom string) bool {
+	return denom == feeDenom
+}
+
+func (m *mockLoyaltyKeeper) SponsoredFee(_ context.Context, fee sdk.Coin, _ []sdk.Msg) (sdk.Coin, error) {
+	sponsored := sdk.NewCoin(sdk.DefaultBondDenom, fee.Amount.QuoRaw(2))
+	if sponsored.Amount.GT(sdkmath.NewIntFromUint64(m.pool)) {
+		return sdk.Coin{}, types.ErrFeeNotSponsored.Wrap("gas pool is exhausted")
+	}
+	return sponsored, nil
+}
+
+func (m *mockLoyaltyKeeper) SponsorTxFee(_ context.Context, payer sdk.AccAddress, _, sponsored sdk.Coin) error {
+	m.pool -= sponsored.Amount.Uint64()
+	m.sponsored = append(m.sponsored, payer)
+	return nil
+}
+
+func (m *mockLoyaltyKeeper) RecordSponsoredFee(_ context.Context, _, _ sdk.AccAddress, fee sdk.Coins) error {
+	m.recorded = m.recorded.Add(fee...)
+	return nil
+}
+
+// feeTx is a transaction carrying only what the fee decorator reads.
+type feeTx struct {
+	fee     sdk.Coins
+	gas     uint64
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg                       { return nil }
+func (tx feeTx) GetMsgsV2() ([]protov2.Message, error)    { return nil, nil }
+func (tx feeTx) GetGas() uint64                           { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins                        { return tx.fee }
+func (tx feeTx) FeePayer() []byte                         { return tx.payer }
+func (tx feeTx) FeeGranter() []byte                       { return tx.granter }
+func (tx feeTx) ValidateBasic() error                     { return nil }
+func (tx feeTx) WithFee(fee ...sdk.Coin) feeTx            { tx.fee = sdk.NewCoins(fee...); return tx }
+func (tx feeTx) WithGranter(granter sdk.AccAddress) feeTx { tx.granter = granter; return tx }
+
+type feeFixture struct {
+	ctx       sdk.Context
+	decorator ante.FeeDecorator
+	bank      *mockBankKeeper
+	loyalty   *mockLoyaltyKeeper
+	payer     sdk.AccAddress
+	tx        feeTx
+}
+
+func initFeeFixture(pool uint64) *feeFixture {
+	payer := sdk.AccAddress("payer_______________")
+	granter := sdk.AccAddress("granter_____________")
+	accounts := &mockAccountKeeper{accounts: map[string]sdk.AccountI{}}
+	for _, addr := range []sdk.AccAddress{payer, granter} {
+		accounts.SetAccount(context.Background(), authtypes.NewBaseAccountWithAddress(addr))
+	}
+	bank := &mockBankKeeper{balances: map[string]sdk.Coins{
+		payer.String():   sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1_000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)),
+		granter.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)),
+	}}
+	loyalty := &mockLoyaltyKeeper{pool: pool}
+	return &feeFixture{
+		ctx:       sdk.Context{}.WithBlockHeight(1).WithEventManager(sdk.NewEventManager()),
+		decorator: ante.NewFeeDecorator(accounts, bank, mockFeegrantKeeper{}, loyalty, nil),
+		bank:      bank,
+		loyalty:   loyalty,
+		payer:     payer,
+		tx:        feeTx{gas: 100, payer: payer},
+	}
+}
+
+// run passes tx through the decorator and reports whether the next handler ran.
+func (f *feeFixture) run(tx sdk.Tx, simulate bool) (sdk.Context, bool, error) {
+	var called bool
+	ctx, err := f.decorator.AnteHandle(f.ctx, tx, simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		called = true
+		return ctx, nil
+	})
+	return ctx, called, err
+}
+
+func TestFeeDecoratorSponsorsFeeToken(t *testing.T) {
+	f := initFeeFixture(1_000)
+
+	ctx, called, err := f.run(f.tx.WithFee(sdk.NewInt64Coin(feeDenom, 200)), false)
+	require.NoError(t, err)
+	require.True(t, called)
+	require.Equal(t, []sdk.AccAddress{f.payer}, f.loyalty.sponsored)
+	require.EqualValues(t, 900, f.loyalty.pool)
+	// The gas pool pays, so nothing goes through the SDK fee deduction.
+	require.True(t, f.bank.collected.IsZero())
+	require.EqualValues(t, 1, ctx.Priority())
+
+	var feePayer string
+	for _, event := range ctx.EventManager().Events() {
+		if attr, ok := event.GetAttribute(sdk.AttributeKeyFeePayer); event.Type == sdk.EventTypeTx && ok {
+			feePayer = attr.Value
+		}
+	}
+	require.Equal(t, f.payer.String(), feePayer)
+
+	// Sponsored fees must still cover the validator's minimum gas price.
+	f.ctx = f.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 2)))
+	_, called, err = f.run(f.tx.WithFee(sdk.NewInt64Coin(feeDenom, 200)), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.False(t, called)
+
+	f.ctx = f.ctx.WithIsCheckTx(false)
+	_, _, err = f.run(feeTx{payer: f.payer, fee: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 200))}, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidGasLimit)
+}
+
+func TestFeeDecoratorExhaustedPool(t *testing.T) {
+	f := initFeeFixture(50)
+
+	_, called, err := f.run(f.tx.WithFee(sdk.NewInt64Coin(feeDenom, 200)), false)
+	require.ErrorIs(t, err, types.ErrFeeNotSponsored)
+	require.False(t, called)
+	require.EqualValues(t, 50, f.loyalty.pool)
+	require.Empty(t, f.loyalty.sponsored)
+	// The fee is not taken in the token either.
+	require.Equal(t, sdkmath.NewInt(1_000), f.bank.balances[f.payer.String()].AmountOf(feeDenom))
+	require.True(t, f.bank.collected.IsZero())
+
+	// A fee the pool can still cover goes through.
+	_, called, err = f.run(f.tx.WithFee(sdk.NewInt64Coin(feeDenom, 100)), false)
+	require.NoError(t, err)
+	require.True(t, called)
+	require.Zero(t, f.loyalty.pool)
+}
+
+func TestFeeDecoratorFallsBackToStandardFee(t *testing.T) {
+	f := initFeeFixture(1_000)
+
+	// A staking denom fee is deducted by the SDK decorator.
+	_, called, err := f.run(f.tx.WithFee(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)), false)
+	require.NoError(t, err)
+	require.True(t, called)
+	require.Empty(t, f.loyalty.sponsored)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)), f.bank.collected)
+	require.Equal(t, sdkmath.NewInt(970), f.bank.balances[f.payer.String()].AmountOf(sdk.DefaultBondDenom))
+
+	// So is a fee mixing the fee token with other coins, in full.
+	mixed := f.tx.WithFee(sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	_, _, err = f.run(mixed, false)
+	require.NoError(t, err)
+	require.Empty(t, f.loyalty.sponsored)
+	require.Equal(t, sdkmath.NewInt(990), f.bank.balances[f.payer.String()].AmountOf(feeDenom))
+
+	// Fees paid through a fee grant are recorded against claim sponsorships once deducted.
+	granter := sdk.AccAddress("granter_____________")
+	_, _, err = f.run(f.tx.WithFee(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)).WithGranter(granter), false)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(960), f.bank.balances[granter.String()].AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)), f.loyalty.recorded)
+
+	// A fee the payer cannot cover fails in the SDK decorator.
+	_, called, err = f.run(f.tx.WithFee(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5_000)), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.False(t, called)
+}
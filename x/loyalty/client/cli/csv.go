@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// ParseMintRecipientsCSV reads "recipient,amount" rows into batch mint recipients,
+// converting each amount with parseAmount. A leading "recipient,amount" header, blank
+// lines and lines starting with # are skipped. Addresses are checked on chain.
+func ParseMintRecipientsCSV(r io.Reader, parseAmount func(string) (uint64, error)) ([]types.MintRecipient, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var recipients []types.MintRecipient
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		recipient, amount := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if len(recipients) == 0 && strings.EqualFold(recipient, "recipient") && strings.EqualFold(amount, "amount") {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		if recipient == "" {
+			return nil, fmt.Errorf("line %d: recipient is empty", line)
+		}
+		parsed, err := parseAmount(amount)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount %q: %w", line, amount, err)
+		}
+		recipients = append(recipients, types.MintRecipient{Recipient: recipient, Amount: parsed})
+	}
+	if len(recipients) == 0 {
+		return nil, errors.New("csv file has no recipients")
+	}
+	if len(recipients) > types.MaxMintBatchRecipients {
+		return nil, fmt.Errorf("csv file has %d recipients; at most %d fit in one batch", len(recipients), types.MaxMintBatchRecipients)
+	}
+	return recipients, nil
+}
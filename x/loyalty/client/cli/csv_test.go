@@ -0,0 +1,53 @@
+package cli_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/client/cli"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestParseMintRecipientsCSV(t *testing.T) {
+	baseUnits := func(amount string) (uint64, error) { return strconv.ParseUint(amount, 10, 64) }
+	displayUnits := func(amount string) (uint64, error) { return types.ParseTokenAmount(amount, 2) }
+
+	tests := []struct {
+		desc        string
+		input       string
+		parseAmount func(string) (uint64, error)
+		expected    []types.MintRecipient
+		err         string
+	}{
+		{
+			desc:        "header, comments and blank lines",
+			input:       "recipient,amount\n# launch cohort\naddr1, 10\n\naddr2,20\n",
+			parseAmount: baseUnits,
+			expected:    []types.MintRecipient{{Recipient: "addr1", Amount: 10}, {Recipient: "addr2", Amount: 20}},
+		},
+		{
+			desc:        "display units",
+			input:       "addr1,1.5\n",
+			parseAmount: displayUnits,
+			expected:    []types.MintRecipient{{Recipient: "addr1", Amount: 150}},
+		},
+		{desc: "bad amount", input: "addr1,10\naddr2,ten\n", parseAmount: baseUnits, err: "line 2"},
+		{desc: "missing column", input: "addr1\n", parseAmount: baseUnits, err: "wrong number of fields"},
+		{desc: "empty recipient", input: ",10\n", parseAmount: baseUnits, err: "recipient is empty"},
+		{desc: "no rows", input: "recipient,amount\n", parseAmount: baseUnits, err: "no recipients"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			recipients, err := cli.ParseMintRecipientsCSV(strings.NewReader(tc.input), tc.parseAmount)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, recipients)
+		})
+	}
+}
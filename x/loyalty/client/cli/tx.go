@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// FlagDisplayUnits makes amount arguments read as display units of the token.
+const FlagDisplayUnits = "display-units"
+
+// GetTxCmd returns the loyalty tx commands that need client-side logic. Every other
+// loyalty tx command is generated by autocli and added to this one.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transactions commands for the loyalty module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdMintVerifiedToken(),
+		CmdMintVerifiedTokenBatch(),
+		CmdFundRewardPool(),
+	)
+
+	return cmd
+}
+
+// CmdMintVerifiedToken mints a verified token, optionally taking the amount in display units.
+func CmdMintVerifiedToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-verified-token [denom] [recipient] [amount]",
+		Short: "Send a mint-verified-token tx",
+		Long:  "Mint a verified token to a recipient. The amount is in base units unless --display-units is set, in which case it may carry up to the token's decimals (e.g. 12.5).",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := parseAmountArg(cmd, clientCtx, args[0], args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMintVerifiedToken{
+				Creator:   clientCtx.GetFromAddress().String(),
+				Denom:     args[0],
+				Recipient: args[1],
+				Amount:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDisplayUnits, false, "read the amount in display units using the token's decimals")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdMintVerifiedTokenBatch mints a verified token to every recipient listed in a CSV file.
+func CmdMintVerifiedTokenBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-verified-token-batch [denom] [recipients-csv]",
+		Short: "Mint a verified token to many recipients from a CSV file",
+		Long: `Mint a verified token to many recipients in one tx. The CSV file has one
+"recipient,amount" row per recipient; an optional "recipient,amount" header, blank lines
+and lines starting with # are ignored. Amounts are in base units unless --display-units
+is set.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			parseAmount, err := amountParser(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			recipients, err := ParseMintRecipientsCSV(file, parseAmount)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMintVerifiedTokenBatch{
+				Creator:    clientCtx.GetFromAddress().String(),
+				Denom:      args[0],
+				Recipients: recipients,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDisplayUnits, false, "read the amounts in display units using the token's decimals")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdFundRewardPool funds the reward pool, optionally taking the amount in display units.
+func CmdFundRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-reward-pool [denom] [amount]",
+		Short: "Fund the loyalty reward pool from signer account",
+		Long:  "Fund the loyalty reward pool. The amount is in base units unless --display-units is set, in which case it may carry up to the token's decimals (e.g. 12.5).",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := parseAmountArg(cmd, clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFundRewardPool{
+				Creator: clientCtx.GetFromAddress().String(),
+				Denom:   args[0],
+				Amount:  amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDisplayUnits, false, "read the amount in display units using the token's decimals")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseAmountArg reads amount as base units, or as display units of denom when
+// --display-units is set, looking up the token's decimals on chain.
+func parseAmountArg(cmd *cobra.Command, clientCtx client.Context, denom, amount string) (uint64, error) {
+	parseAmount, err := amountParser(cmd, clientCtx, denom)
+	if err != nil {
+		return 0, err
+	}
+	return parseAmount(amount)
+}
+
+// amountParser returns a parser for amounts of denom honouring --display-units. The
+// token's decimals are looked up once, so the parser can be reused for many amounts.
+func amountParser(cmd *cobra.Command, clientCtx client.Context, denom string) (func(string) (uint64, error), error) {
+	displayUnits, err := cmd.Flags().GetBool(FlagDisplayUnits)
+	if err != nil {
+		return nil, err
+	}
+	if !displayUnits {
+		return func(amount string) (uint64, error) {
+			return strconv.ParseUint(amount, 10, 64)
+		}, nil
+	}
+
+	res, err := types.NewQueryClient(clientCtx).GetVerifiedtokenByDenom(cmd.Context(), &types.QueryGetVerifiedtokenByDenomRequest{Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+	decimals := res.Verifiedtoken.Decimals
+	return func(amount string) (uint64, error) {
+		return types.ParseTokenAmount(amount, decimals)
+	}, nil
+}
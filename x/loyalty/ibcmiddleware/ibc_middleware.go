@@ -0,0 +1,263 @@
+// Package ibcmiddleware wraps the ICS-20 transfer application so verified tokens
+// only leave the chain as their IBC policy allows and their escrow is tracked, so
+// native tokens cross rate-limited channels within their quotas, and so allowlisted
+// senders on other chains can record accruals and fund reward pools through loyalty
+// memos on incoming transfers. It also holds the authentication module of merchant
+// interchain accounts.
+package ibcmiddleware
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// LoyaltyKeeper is the part of the loyalty keeper the middleware relies on.
+type LoyaltyKeeper interface {
+	CheckIBCTransfer(ctx context.Context, denom, channel string) error
+	RecordIBCEscrow(ctx context.Context, denom string, amount sdkmath.Int) error
+	ReleaseIBCEscrow(ctx context.Context, denom string, amount sdkmath.Int) error
+	ConsumeIBCOutflow(ctx context.Context, denom, channel string, amount sdkmath.Int) error
+	ConsumeIBCInflow(ctx context.Context, denom, channel string, amount sdkmath.Int) error
+	RefundIBCOutflow(ctx context.Context, denom, channel string, amount sdkmath.Int) error
+	ExecuteIBCLoyaltyMemo(ctx context.Context, channel, sender string, memo types.IBCLoyaltyMemo, received sdk.Coin) error
+	SettleMerchantPacket(ctx context.Context, channelID string, sequence uint64, outcome string) error
+}
+
+var (
+	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware sits between core IBC and the IBC v1 transfer application. Packets
+// the transfer application sends pass through SendPacket, where the token policy and
+// outflow quotas are enforced; refunds and returning tokens release the escrow tally,
+// and returning tokens count against inflow quotas.
+type IBCMiddleware struct {
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      LoyaltyKeeper
+}
+
+// NewIBCMiddleware creates the middleware. The transfer keeper must use it as its
+// ICS4Wrapper, and the transfer application must be set with SetUnderlyingApplication
+// before the router is sealed.
+func NewIBCMiddleware(ics4Wrapper porttypes.ICS4Wrapper, k LoyaltyKeeper) *IBCMiddleware {
+	return &IBCMiddleware{ics4Wrapper: ics4Wrapper, keeper: k}
+}
+
+// SetUnderlyingApplication sets the transfer application wrapped by the middleware.
+func (im *IBCMiddleware) SetUnderlyingApplication(app porttypes.IBCModule) {
+	im.app = app
+}
+
+func (im *IBCMiddleware) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID, channelID string, counterparty channeltypes.Counterparty, version string) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+}
+
+func (im *IBCMiddleware) OnChanOpenTry(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID, channelID string, counterparty channeltypes.Counterparty, counterpartyVersion string) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+}
+
+func (im *IBCMiddleware) OnChanOpenAck(ctx sdk.Context, portID, channelID, counterpartyChannelID, counterpartyVersion string) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+func (im *IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+func (im *IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+func (im *IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket runs the loyalty memo of an incoming transfer and, when one of our
+// tokens comes back, counts it against the inflow quota and releases the escrow
+// tally. An exceeded quota or a failing memo fails the packet, so core IBC discards
+// the transfer and the sender is refunded.
+func (im *IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	memo, hasMemo, err := parseMemo(data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if hasMemo && memo.Action == types.IBCMemoActionFundPool {
+		bz, err := redirectToIntermediary(data, packet.GetDestChannel(), channelVersion, "")
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		packet.Data = bz
+	}
+
+	ack := im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+	if err := releaseReturning(ctx, im.keeper, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestChannel(), data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if hasMemo {
+		if err := executeMemo(ctx, im.keeper, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), data, memo); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+	return ack
+}
+
+// OnAcknowledgementPacket releases the escrow tally and outflow quota when a failed
+// transfer is refunded and settles merchant treasury forwards.
+func (im *IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	outcome := ackOutcome(acknowledgement)
+	if err := im.keeper.SettleMerchantPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), outcome); err != nil {
+		return err
+	}
+	// The transfer application has rejected malformed acknowledgements by now.
+	if outcome == types.MerchantPacketAcknowledged {
+		return nil
+	}
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+	return releaseRefunded(ctx, im.keeper, packet.GetSourceChannel(), data)
+}
+
+// OnTimeoutPacket releases the escrow tally and outflow quota when a timed out
+// transfer is refunded and settles merchant treasury forwards.
+func (im *IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+	if err := im.keeper.SettleMerchantPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), types.MerchantPacketTimedOut); err != nil {
+		return err
+	}
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+	return releaseRefunded(ctx, im.keeper, packet.GetSourceChannel(), data)
+}
+
+// SendPacket enforces the token policy and outflow quotas on outbound transfers and
+// records what the transfer application escrowed for them.
+func (im *IBCMiddleware) SendPacket(ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	version, ok := im.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !ok {
+		return im.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+	packetData, err := transfertypes.UnmarshalPacketData(data, version, "")
+	if err != nil {
+		return 0, err
+	}
+	if err := checkOutbound(ctx, im.keeper, sourceChannel, packetData); err != nil {
+		return 0, err
+	}
+	sequence, err := im.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+	if err := recordOutbound(ctx, im.keeper, packetData); err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+func (im *IBCMiddleware) WriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+}
+
+func (im *IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// UnmarshalPacketData defers to the transfer application.
+func (im *IBCMiddleware) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (any, string, error) {
+	unmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, "", errorsmod.Wrap(ibcerrors.ErrInvalidType, "transfer application cannot unmarshal packet data")
+	}
+	return unmarshaler.UnmarshalPacketData(ctx, portID, channelID, bz)
+}
+
+// checkOutbound applies the token policy and outflow quota to a token leaving over
+// channel. Only tokens native to this chain are escrowed here and subject to a
+// policy or quota; vouchers of other chains' tokens are burned and go home.
+func checkOutbound(ctx sdk.Context, k LoyaltyKeeper, channel string, data transfertypes.InternalTransferRepresentation) error {
+	if !data.Token.Denom.IsNative() {
+		return nil
+	}
+	if err := k.CheckIBCTransfer(ctx, data.Token.Denom.Base, channel); err != nil {
+		return err
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return transfertypes.ErrInvalidAmount
+	}
+	return k.ConsumeIBCOutflow(ctx, data.Token.Denom.Base, channel, amount)
+}
+
+// recordOutbound adds a sent native token to its escrow tally.
+func recordOutbound(ctx sdk.Context, k LoyaltyKeeper, data transfertypes.InternalTransferRepresentation) error {
+	if !data.Token.Denom.IsNative() {
+		return nil
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return transfertypes.ErrInvalidAmount
+	}
+	return k.RecordIBCEscrow(ctx, data.Token.Denom.Base, amount)
+}
+
+// releaseRefunded takes a refunded native token off its escrow tally and gives
+// back the outflow quota it used on channel.
+func releaseRefunded(ctx sdk.Context, k LoyaltyKeeper, channel string, data transfertypes.InternalTransferRepresentation) error {
+	if !data.Token.Denom.IsNative() {
+		return nil
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return transfertypes.ErrInvalidAmount
+	}
+	if err := k.RefundIBCOutflow(ctx, data.Token.Denom.Base, channel, amount); err != nil {
+		return err
+	}
+	return k.ReleaseIBCEscrow(ctx, data.Token.Denom.Base, amount)
+}
+
+// releaseReturning counts a token coming back from the chain it was sent to
+// against the inflow quota of destChannel and takes it off its escrow tally. The
+// token's trace then starts with the packet's source port and channel, and nothing
+// is left once that hop is removed.
+func releaseReturning(ctx sdk.Context, k LoyaltyKeeper, sourcePort, sourceChannel, destChannel string, data transfertypes.InternalTransferRepresentation) error {
+	if !data.Token.Denom.HasPrefix(sourcePort, sourceChannel) || len(data.Token.Denom.Trace) != 1 {
+		return nil
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return transfertypes.ErrInvalidAmount
+	}
+	if err := k.ConsumeIBCInflow(ctx, data.Token.Denom.Base, destChannel, amount); err != nil {
+		return err
+	}
+	return k.ReleaseIBCEscrow(ctx, data.Token.Denom.Base, amount)
+}
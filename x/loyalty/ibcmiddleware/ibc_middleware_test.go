@@ -0,0 +1,350 @@
+package ibcmiddleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/types"
+)
+
+const testDenom = "factory/merchant/shop"
+
+var errTestPolicy = errors.New("policy refused")
+
+// mockLoyaltyKeeper records the calls the middleware makes, one string per call.
+type mockLoyaltyKeeper struct {
+	calls      []string
+	checkErr   error
+	inflowErr  error
+	outflowErr error
+}
+
+func (m *mockLoyaltyKeeper) record(format string, args ...any) {
+	m.calls = append(m.calls, fmt.Sprintf(format, args...))
+}
+
+func (m *mockLoyaltyKeeper) CheckIBCTransfer(_ context.Context, denom, channel string) error {
+	m.record("check %s %s", denom, channel)
+	return m.checkErr
+}
+
+func (m *mockLoyaltyKeeper) RecordIBCEscrow(_ context.Context, denom string, amount sdkmath.Int) error {
+	m.record("escrow %s %s", denom, amount)
+	return nil
+}
+
+func (m *mockLoyaltyKeeper) ReleaseIBCEscrow(_ context.Context, denom string, amount sdkmath.Int) error {
+	m.record("release %s %s", denom, amount)
+	return nil
+}
+
+func (m *mockLoyaltyKeeper) ConsumeIBCOutflow(_ context.Context, denom, channel string, amount sdkmath.Int) error {
+	m.record("outflow %s %s %s", denom, channel, amount)
+	return m.outflowErr
+}
+
+func (m *mockLoyaltyKeeper) ConsumeIBCInflow(_ context.Context, denom, channel string, amount sdkmath.Int) error {
+	m.record("inflow %s %s %s", denom, channel, amount)
+	return m.inflowErr
+}
+
+func (m *mockLoyaltyKeeper) RefundIBCOutflow(_ context.Context, denom, channel string, amount sdkmath.Int) error {
+	m.record("refund %s %s %s", denom, channel, amount)
+	return nil
+}
+
+func (m *mockLoyaltyKeeper) ExecuteIBCLoyaltyMemo(_ context.Context, channel, sender string, memo types.IBCLoyaltyMemo, received sdk.Coin) error {
+	m.record("memo %s %s %s %s", channel, sender, memo.Action, received)
+	return nil
+}
+
+func (m *mockLoyaltyKeeper) SettleMerchantPacket(_ context.Context, channelID string, sequence uint64, outcome string) error {
+	m.record("settle %s %d %s", channelID, sequence, outcome)
+	return nil
+}
+
+// mockTransferApp stands in for the transfer application, returning a fixed
+// receive acknowledgement.
+type mockTransferApp struct {
+	porttypes.IBCModule
+	recvAck ibcexported.Acknowledgement
+}
+
+func (m mockTransferApp) OnRecvPacket(sdk.Context, string, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	return m.recvAck
+}
+
+func (mockTransferApp) OnAcknowledgementPacket(sdk.Context, string, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (mockTransferApp) OnTimeoutPacket(sdk.Context, string, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+// mockTransferAppV2 is the IBC v2 counterpart of mockTransferApp.
+type mockTransferAppV2 struct {
+	api.IBCModule
+	recvStatus channeltypesv2.PacketStatus
+}
+
+func (m mockTransferAppV2) OnRecvPacket(sdk.Context, string, string, uint64, channeltypesv2.Payload, sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	return channeltypesv2.RecvPacketResult{Status: m.recvStatus}
+}
+
+func (mockTransferAppV2) OnAcknowledgementPacket(sdk.Context, string, string, uint64, []byte, channeltypesv2.Payload, sdk.AccAddress) error {
+	return nil
+}
+
+func transfer(amount string, denom transfertypes.Denom) transfertypes.InternalTransferRepresentation {
+	return transfertypes.InternalTransferRepresentation{
+		Token:    transfertypes.Token{Denom: denom, Amount: amount},
+		Sender:   "sender",
+		Receiver: "receiver",
+	}
+}
+
+func packetData(t *testing.T, denomPath string) []byte {
+	t.Helper()
+	bz, err := transfertypes.MarshalPacketData(
+		transfertypes.NewFungibleTokenPacketData(denomPath, "100", "sender", "receiver", ""),
+		transfertypes.V1,
+		transfertypes.EncodingJSON,
+	)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestCheckOutbound(t *testing.T) {
+	native := transfertypes.NewDenom(testDenom)
+	voucher := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-1"))
+	multiHop := transfertypes.NewDenom(testDenom, transfertypes.NewHop("transfer", "channel-2"), transfertypes.NewHop("transfer", "channel-3"))
+
+	tests := []struct {
+		name   string
+		keeper *mockLoyaltyKeeper
+		data   transfertypes.InternalTransferRepresentation
+		err    error
+		calls  []string
+	}{
+		{
+			name:   "native token",
+			keeper: &mockLoyaltyKeeper{},
+			data:   transfer("100", native),
+			calls:  []string{"check " + testDenom + " channel-0", "outflow " + testDenom + " channel-0 100"},
+		},
+		{
+			name:   "policy refuses",
+			keeper: &mockLoyaltyKeeper{checkErr: errTestPolicy},
+			data:   transfer("100", native),
+			err:    errTestPolicy,
+			calls:  []string{"check " + testDenom + " channel-0"},
+		},
+		{
+			name:   "outflow quota exceeded",
+			keeper: &mockLoyaltyKeeper{outflowErr: types.ErrIBCRateLimited},
+			data:   transfer("100", native),
+			err:    types.ErrIBCRateLimited,
+			calls:  []string{"check " + testDenom + " channel-0", "outflow " + testDenom + " channel-0 100"},
+		},
+		{
+			name:   "invalid amount",
+			keeper: &mockLoyaltyKeeper{},
+			data:   transfer("lots", native),
+			err:    transfertypes.ErrInvalidAmount,
+			calls:  []string{"check " + testDenom + " channel-0"},
+		},
+		{
+			name:   "voucher going home",
+			keeper: &mockLoyaltyKeeper{},
+			data:   transfer("100", voucher),
+		},
+		{
+			// Our own token coming back through another chain is still a voucher here.
+			name:   "multi-hop voucher",
+			keeper: &mockLoyaltyKeeper{},
+			data:   transfer("100", multiHop),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkOutbound(sdk.Context{}, tc.keeper, "channel-0", tc.data)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.calls, tc.keeper.calls)
+		})
+	}
+}
+
+func TestReleaseRefunded(t *testing.T) {
+	k := &mockLoyaltyKeeper{}
+	require.NoError(t, releaseRefunded(sdk.Context{}, k, "channel-0", transfer("40", transfertypes.NewDenom(testDenom))))
+	require.Equal(t, []string{"refund " + testDenom + " channel-0 40", "release " + testDenom + " 40"}, k.calls)
+
+	// Refunded vouchers were burned, not escrowed, whatever their route.
+	k = &mockLoyaltyKeeper{}
+	multiHop := transfertypes.NewDenom(testDenom, transfertypes.NewHop("transfer", "channel-2"), transfertypes.NewHop("transfer", "channel-3"))
+	require.NoError(t, releaseRefunded(sdk.Context{}, k, "channel-0", transfer("40", multiHop)))
+	require.Empty(t, k.calls)
+
+	k = &mockLoyaltyKeeper{}
+	require.ErrorIs(t, releaseRefunded(sdk.Context{}, k, "channel-0", transfer("", transfertypes.NewDenom(testDenom))), transfertypes.ErrInvalidAmount)
+	require.Empty(t, k.calls)
+}
+
+func TestReleaseReturning(t *testing.T) {
+	// The counterparty sends over its channel-7, which is our channel-0.
+	returning := transfertypes.NewDenom(testDenom, transfertypes.NewHop("transfer", "channel-7"))
+
+	tests := []struct {
+		name   string
+		keeper *mockLoyaltyKeeper
+		denom  transfertypes.Denom
+		err    error
+		calls  []string
+	}{
+		{
+			name:   "token coming home",
+			keeper: &mockLoyaltyKeeper{},
+			denom:  returning,
+			calls:  []string{"inflow " + testDenom + " channel-0 100", "release " + testDenom + " 100"},
+		},
+		{
+			name:   "inflow quota exceeded",
+			keeper: &mockLoyaltyKeeper{inflowErr: types.ErrIBCRateLimited},
+			denom:  returning,
+			err:    types.ErrIBCRateLimited,
+			calls:  []string{"inflow " + testDenom + " channel-0 100"},
+		},
+		{
+			// Still a voucher of the counterparty's counterparty once the hop is removed.
+			name:   "multi-hop token",
+			keeper: &mockLoyaltyKeeper{},
+			denom:  transfertypes.NewDenom(testDenom, transfertypes.NewHop("transfer", "channel-7"), transfertypes.NewHop("transfer", "channel-9")),
+		},
+		{
+			name:   "token that left over another channel",
+			keeper: &mockLoyaltyKeeper{},
+			denom:  transfertypes.NewDenom(testDenom, transfertypes.NewHop("transfer", "channel-8")),
+		},
+		{
+			name:   "counterparty native token",
+			keeper: &mockLoyaltyKeeper{},
+			denom:  transfertypes.NewDenom("uatom"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := releaseReturning(sdk.Context{}, tc.keeper, "transfer", "channel-7", "channel-0", transfer("100", tc.denom))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.calls, tc.keeper.calls)
+		})
+	}
+}
+
+func TestOnAcknowledgementPacketReleasesFailedTransfer(t *testing.T) {
+	packet := channeltypes.Packet{
+		Sequence:      3,
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Data:          packetData(t, testDenom),
+	}
+
+	k := &mockLoyaltyKeeper{}
+	im := NewIBCMiddleware(nil, k)
+	im.SetUnderlyingApplication(mockTransferApp{})
+	failed := channeltypes.NewErrorAcknowledgement(errors.New("receive failed")).Acknowledgement()
+	require.NoError(t, im.OnAcknowledgementPacket(sdk.Context{}, transfertypes.V1, packet, failed, nil))
+	require.Equal(t, []string{
+		"settle channel-0 3 " + types.MerchantPacketFailed,
+		"refund " + testDenom + " channel-0 100",
+		"release " + testDenom + " 100",
+	}, k.calls)
+
+	k = &mockLoyaltyKeeper{}
+	im = NewIBCMiddleware(nil, k)
+	im.SetUnderlyingApplication(mockTransferApp{})
+	succeeded := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	require.NoError(t, im.OnAcknowledgementPacket(sdk.Context{}, transfertypes.V1, packet, succeeded, nil))
+	require.Equal(t, []string{"settle channel-0 3 " + types.MerchantPacketAcknowledged}, k.calls)
+}
+
+func TestOnRecvPacketSkipsFailedInnerAck(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-7",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+		Data:               packetData(t, "transfer/channel-7/"+testDenom),
+	}
+
+	// A returning token the transfer application refused is not counted or released.
+	k := &mockLoyaltyKeeper{}
+	im := NewIBCMiddleware(nil, k)
+	failed := channeltypes.NewErrorAcknowledgement(errors.New("receive failed"))
+	im.SetUnderlyingApplication(mockTransferApp{recvAck: failed})
+	require.Equal(t, failed, im.OnRecvPacket(sdk.Context{}, transfertypes.V1, packet, nil))
+	require.Empty(t, k.calls)
+
+	k = &mockLoyaltyKeeper{}
+	im = NewIBCMiddleware(nil, k)
+	im.SetUnderlyingApplication(mockTransferApp{recvAck: channeltypes.NewResultAcknowledgement([]byte{1})})
+	require.True(t, im.OnRecvPacket(sdk.Context{}, transfertypes.V1, packet, nil).Success())
+	require.Equal(t, []string{"inflow " + testDenom + " channel-0 100", "release " + testDenom + " 100"}, k.calls)
+
+	// An exceeded inflow quota turns a successful receive into an error acknowledgement.
+	k = &mockLoyaltyKeeper{inflowErr: types.ErrIBCRateLimited}
+	im = NewIBCMiddleware(nil, k)
+	im.SetUnderlyingApplication(mockTransferApp{recvAck: channeltypes.NewResultAcknowledgement([]byte{1})})
+	require.False(t, im.OnRecvPacket(sdk.Context{}, transfertypes.V1, packet, nil).Success())
+}
+
+func TestIBCMiddlewareV2Acknowledgements(t *testing.T) {
+	payload := channeltypesv2.Payload{
+		SourcePort:      "transfer",
+		DestinationPort: "transfer",
+		Version:         transfertypes.V1,
+		Encoding:        transfertypes.EncodingJSON,
+		Value:           packetData(t, testDenom),
+	}
+
+	k := &mockLoyaltyKeeper{}
+	im := NewIBCMiddlewareV2(mockTransferAppV2{}, k)
+	require.NoError(t, im.OnAcknowledgementPacket(sdk.Context{}, "client-0", "client-1", 1, channeltypesv2.ErrorAcknowledgement[:], payload, nil))
+	require.Equal(t, []string{"refund " + testDenom + " client-0 100", "release " + testDenom + " 100"}, k.calls)
+
+	k = &mockLoyaltyKeeper{}
+	im = NewIBCMiddlewareV2(mockTransferAppV2{}, k)
+	require.NoError(t, im.OnAcknowledgementPacket(sdk.Context{}, "client-0", "client-1", 1, []byte{1}, payload, nil))
+	require.Empty(t, k.calls)
+
+	// A returning token the transfer application failed to receive is left alone.
+	payload.Value = packetData(t, "transfer/client-1/"+testDenom)
+	k = &mockLoyaltyKeeper{}
+	im = NewIBCMiddlewareV2(mockTransferAppV2{recvStatus: channeltypesv2.PacketStatus_Failure}, k)
+	require.Equal(t, channeltypesv2.PacketStatus_Failure, im.OnRecvPacket(sdk.Context{}, "client-1", "client-0", 1, payload, nil).Status)
+	require.Empty(t, k.calls)
+
+	im = NewIBCMiddlewareV2(mockTransferAppV2{recvStatus: channeltypesv2.PacketStatus_Success}, k)
+	require.Equal(t, channeltypesv2.PacketStatus_Success, im.OnRecvPacket(sdk.Context{}, "client-1", "client-0", 1, payload, nil).Status)
+	require.Equal(t, []string{"inflow " + testDenom + " client-0 100", "release " + testDenom + " 100"}, k.calls)
+}
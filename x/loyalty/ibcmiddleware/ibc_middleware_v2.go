@@ -0,0 +1,114 @@
+package ibcmiddleware
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+var (
+	_ api.IBCModule             = (*IBCMiddlewareV2)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCMiddlewareV2)(nil)
+)
+
+// IBCMiddlewareV2 applies the same token policy, rate limits, escrow tracking and
+// loyalty memos to the IBC v2 transfer application, where sends reach the
+// application through OnSendPacket. Recorders and rate limits of IBC v2 transfers
+// are keyed by client id.
+type IBCMiddlewareV2 struct {
+	app    api.IBCModule
+	keeper LoyaltyKeeper
+}
+
+// NewIBCMiddlewareV2 wraps the IBC v2 transfer application.
+func NewIBCMiddlewareV2(app api.IBCModule, k LoyaltyKeeper) *IBCMiddlewareV2 {
+	return &IBCMiddlewareV2{app: app, keeper: k}
+}
+
+func (im *IBCMiddlewareV2) OnSendPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+	if err := checkOutbound(ctx, im.keeper, sourceClient, data); err != nil {
+		return err
+	}
+	if err := im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer); err != nil {
+		return err
+	}
+	return recordOutbound(ctx, im.keeper, data)
+}
+
+func (im *IBCMiddlewareV2) OnRecvPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	failure := channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+	memo, hasMemo, err := parseMemo(data)
+	if err != nil {
+		return failure
+	}
+	if hasMemo && memo.Action == types.IBCMemoActionFundPool {
+		bz, err := redirectToIntermediary(data, destinationClient, payload.Version, payload.Encoding)
+		if err != nil {
+			return failure
+		}
+		payload.Value = bz
+	}
+
+	result := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if result.Status != channeltypesv2.PacketStatus_Success {
+		return result
+	}
+	if err := releaseReturning(ctx, im.keeper, payload.SourcePort, sourceClient, destinationClient, data); err != nil {
+		return failure
+	}
+	if hasMemo {
+		if err := executeMemo(ctx, im.keeper, payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient, data, memo); err != nil {
+			return failure
+		}
+	}
+	return result
+}
+
+func (im *IBCMiddlewareV2) OnTimeoutPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+	return releaseRefunded(ctx, im.keeper, sourceClient, data)
+}
+
+func (im *IBCMiddlewareV2) OnAcknowledgementPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+	if !bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		return nil
+	}
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+	return releaseRefunded(ctx, im.keeper, sourceClient, data)
+}
+
+// UnmarshalPacketData defers to the transfer application.
+func (im *IBCMiddlewareV2) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	unmarshaler, ok := im.app.(api.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrap(ibcerrors.ErrInvalidType, "transfer application cannot unmarshal packet data")
+	}
+	return unmarshaler.UnmarshalPacketData(payload)
+}
@@ -0,0 +1,91 @@
+package ibcmiddleware
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// MerchantICAKeeper is the part of the loyalty keeper that tracks merchant
+// interchain accounts.
+type MerchantICAKeeper interface {
+	OnMerchantICAChannelInit(ctx sdk.Context, portID, connectionID, channelID string) error
+	OnMerchantICAChannelOpen(ctx sdk.Context, portID, channelID string) error
+	OnMerchantICAChannelClosed(ctx sdk.Context, portID, channelID string) error
+	SettleMerchantPacket(ctx context.Context, channelID string, sequence uint64, outcome string) error
+}
+
+var _ porttypes.IBCModule = (*ICAControllerModule)(nil)
+
+// ICAControllerModule is the authentication module under the interchain accounts
+// controller middleware. The controller only routes callbacks here for accounts
+// registered through the loyalty module, which are merchant accounts; accounts
+// registered with MsgRegisterInterchainAccount bypass it.
+type ICAControllerModule struct {
+	keeper MerchantICAKeeper
+}
+
+// NewICAControllerModule creates the module. Wrap it with the controller
+// middleware using icacontroller.NewIBCMiddlewareWithAuth.
+func NewICAControllerModule(k MerchantICAKeeper) ICAControllerModule {
+	return ICAControllerModule{keeper: k}
+}
+
+func (im ICAControllerModule) OnChanOpenInit(ctx sdk.Context, _ channeltypes.Order, connectionHops []string, portID, channelID string, _ channeltypes.Counterparty, version string) (string, error) {
+	if err := im.keeper.OnMerchantICAChannelInit(ctx, portID, connectionHops[0], channelID); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+func (ICAControllerModule) OnChanOpenTry(sdk.Context, channeltypes.Order, []string, string, string, channeltypes.Counterparty, string) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+func (im ICAControllerModule) OnChanOpenAck(ctx sdk.Context, portID, channelID, _, _ string) error {
+	return im.keeper.OnMerchantICAChannelOpen(ctx, portID, channelID)
+}
+
+func (ICAControllerModule) OnChanOpenConfirm(sdk.Context, string, string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+func (ICAControllerModule) OnChanCloseInit(sdk.Context, string, string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "merchant interchain account channels cannot be closed by the controller")
+}
+
+func (im ICAControllerModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.keeper.OnMerchantICAChannelClosed(ctx, portID, channelID)
+}
+
+func (ICAControllerModule) OnRecvPacket(_ sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket settles the packet of a merchant transaction with the
+// outcome the host reported.
+func (im ICAControllerModule) OnAcknowledgementPacket(ctx sdk.Context, _ string, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress) error {
+	return im.keeper.SettleMerchantPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), ackOutcome(acknowledgement))
+}
+
+// OnTimeoutPacket settles the packet of a merchant transaction that timed out.
+func (im ICAControllerModule) OnTimeoutPacket(ctx sdk.Context, _ string, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	return im.keeper.SettleMerchantPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), types.MerchantPacketTimedOut)
+}
+
+// ackOutcome reads a channel acknowledgement as used by both interchain accounts
+// and transfers. Anything but a success result counts as a failure.
+func ackOutcome(acknowledgement []byte) string {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		return types.MerchantPacketFailed
+	}
+	return types.MerchantPacketAcknowledged
+}
@@ -0,0 +1,63 @@
+package ibcmiddleware
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// parseMemo returns the loyalty memo of a received transfer, if it carries one.
+func parseMemo(data transfertypes.InternalTransferRepresentation) (types.IBCLoyaltyMemo, bool, error) {
+	memo, found, err := types.ParseIBCLoyaltyMemo(data.Memo)
+	if err != nil {
+		return types.IBCLoyaltyMemo{}, true, errorsmod.Wrap(types.ErrInvalidIBCMemo, err.Error())
+	}
+	return memo, found, nil
+}
+
+// redirectToIntermediary re-encodes the data of a fund_pool transfer so the
+// transfer application delivers the tokens to the memo intermediary address of
+// channel and sender. The sender names the loyalty module account as receiver,
+// which the transfer application refuses on its own, so a fund_pool transfer can
+// never land anywhere but the pool.
+func redirectToIntermediary(data transfertypes.InternalTransferRepresentation, channel, version, encoding string) ([]byte, error) {
+	if data.Receiver != authtypes.NewModuleAddress(types.ModuleName).String() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidIBCMemo, "%s transfers must be sent to the loyalty module account", types.IBCMemoActionFundPool)
+	}
+	if version != transfertypes.V1 {
+		return nil, errorsmod.Wrap(transfertypes.ErrInvalidVersion, version)
+	}
+	if encoding == "" {
+		encoding = transfertypes.EncodingJSON
+	}
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		data.Token.Denom.Path(),
+		data.Token.Amount,
+		data.Sender,
+		types.IBCMemoIntermediaryAddress(channel, data.Sender).String(),
+		data.Memo,
+	)
+	return transfertypes.MarshalPacketData(packetData, version, encoding)
+}
+
+// executeMemo runs the loyalty memo of a transfer the transfer application has
+// accepted. Memo senders are allowlisted per destination channel.
+func executeMemo(ctx sdk.Context, k LoyaltyKeeper, sourcePort, sourceChannel, destPort, destChannel string, data transfertypes.InternalTransferRepresentation, memo types.IBCLoyaltyMemo) error {
+	amount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return transfertypes.ErrInvalidAmount
+	}
+	// Work out the local denom the same way the transfer application does: a token
+	// coming back loses the hop it gained on the way out, any other gains ours.
+	denom := data.Token.Denom
+	if denom.HasPrefix(sourcePort, sourceChannel) {
+		denom.Trace = denom.Trace[1:]
+	} else {
+		denom.Trace = append([]transfertypes.Hop{transfertypes.NewHop(destPort, destChannel)}, denom.Trace...)
+	}
+	return k.ExecuteIBCLoyaltyMemo(ctx, destChannel, data.Sender, memo, sdk.NewCoin(denom.IBCDenom(), amount))
+}
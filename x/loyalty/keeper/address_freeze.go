@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// activeAddressFreeze returns the freeze of address for denom if one is in force at
+// the current block time.
+func (k Keeper) activeAddressFreeze(ctx context.Context, denom, address string) (types.AddressFreeze, bool, error) {
+	freeze, err := k.AddressFreeze.Get(ctx, collections.Join(denom, address))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.AddressFreeze{}, false, nil
+		}
+		return types.AddressFreeze{}, false, err
+	}
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	return freeze, freeze.ActiveAt(now), nil
+}
+
+// ensureNotFrozen fails with ErrAddressFrozen when address may not send denom.
+func (k Keeper) ensureNotFrozen(ctx context.Context, denom, address string) error {
+	freeze, active, err := k.activeAddressFreeze(ctx, denom, address)
+	if err != nil {
+		return err
+	}
+	if active {
+		return errorsmod.Wrapf(types.ErrAddressFrozen, "%s is frozen for %s until %d", address, denom, freeze.ExpiresAt)
+	}
+	return nil
+}
+
+// setAddressFreeze stores freeze, replacing any earlier freeze of the address and its
+// expiry entry.
+func (k Keeper) setAddressFreeze(ctx context.Context, freeze types.AddressFreeze) error {
+	if err := k.removeAddressFreeze(ctx, freeze.Denom, freeze.Address); err != nil {
+		return err
+	}
+	if err := k.AddressFreeze.Set(ctx, collections.Join(freeze.Denom, freeze.Address), freeze); err != nil {
+		return err
+	}
+	return k.AddressFreezeExpiry.Set(ctx, collections.Join3(freeze.ExpiresAt, freeze.Denom, freeze.Address))
+}
+
+// removeAddressFreeze drops the freeze of address for denom and its expiry entry, if any.
+func (k Keeper) removeAddressFreeze(ctx context.Context, denom, address string) error {
+	key := collections.Join(denom, address)
+	freeze, err := k.AddressFreeze.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := k.AddressFreezeExpiry.Remove(ctx, collections.Join3(freeze.ExpiresAt, denom, address)); err != nil {
+		return err
+	}
+	return k.AddressFreeze.Remove(ctx, key)
+}
+
+// releaseAddressFreeze removes a freeze and tells the holder why it was lifted.
+func (k Keeper) releaseAddressFreeze(ctx context.Context, freeze types.AddressFreeze, reason string) error {
+	if err := k.removeAddressFreeze(ctx, freeze.Denom, freeze.Address); err != nil {
+		return err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.address_unfrozen",
+			sdk.NewAttribute("denom", freeze.Denom),
+			sdk.NewAttribute("address", freeze.Address),
+			sdk.NewAttribute("recovery_id", freezeRecoveryID(freeze)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
+
+// freezeRecoveryID renders the linked recovery id for events, empty when unlinked.
+func freezeRecoveryID(freeze types.AddressFreeze) string {
+	if !freeze.RecoveryLinked {
+		return ""
+	}
+	return fmt.Sprintf("%d", freeze.RecoveryId)
+}
+
+// releaseRecoveryFreeze lifts the freeze linked to op once op has executed or been
+// cancelled. Freezes of the same address that are not linked to op are kept.
+func (k Keeper) releaseRecoveryFreeze(ctx context.Context, op types.Recoveryoperation, reason string) error {
+	freeze, err := k.AddressFreeze.Get(ctx, collections.Join(op.Denom, op.FromAddress))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !freeze.RecoveryLinked || freeze.RecoveryId != op.Id {
+		return nil
+	}
+	return k.releaseAddressFreeze(ctx, freeze, reason)
+}
+
+// removeAddressFreezes drops every freeze of a deleted token.
+func (k Keeper) removeAddressFreezes(ctx context.Context, denom string) error {
+	var freezes []types.AddressFreeze
+	rng := collections.NewPrefixedPairRange[string, string](denom)
+	if err := k.AddressFreeze.Walk(ctx, rng, func(_ collections.Pair[string, string], freeze types.AddressFreeze) (bool, error) {
+		freezes = append(freezes, freeze)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, freeze := range freezes {
+		if err := k.removeAddressFreeze(ctx, freeze.Denom, freeze.Address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireAddressFreezes lifts every address freeze whose expiry has passed.
+func (k Keeper) ExpireAddressFreezes(ctx context.Context) error {
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	var expired []collections.Triple[uint64, string, string]
+	if err := k.AddressFreezeExpiry.Walk(ctx, nil, func(key collections.Triple[uint64, string, string]) (bool, error) {
+		if key.K1() > now {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		freeze, err := k.AddressFreeze.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+		if err := k.releaseAddressFreeze(ctx, freeze, "expired"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
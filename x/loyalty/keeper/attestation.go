@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// setAttestation stores attestation, replacing any previous attestation by the
+// same verifier and keeping the expiry queue in sync.
+func (k Keeper) setAttestation(ctx context.Context, attestation types.Attestation) error {
+	key := collections.Join(attestation.Denom, attestation.Verifier)
+	previous, err := k.Attestation.Get(ctx, key)
+	if err == nil {
+		if err := k.AttestationExpiry.Remove(ctx, collections.Join3(previous.ExpiresAt, previous.Denom, previous.Verifier)); err != nil {
+			return err
+		}
+	}
+	if err := k.Attestation.Set(ctx, key, attestation); err != nil {
+		return err
+	}
+	return k.AttestationExpiry.Set(ctx, collections.Join3(attestation.ExpiresAt, attestation.Denom, attestation.Verifier))
+}
+
+// removeAttestation deletes attestation and its expiry queue entry.
+func (k Keeper) removeAttestation(ctx context.Context, attestation types.Attestation) error {
+	if err := k.Attestation.Remove(ctx, collections.Join(attestation.Denom, attestation.Verifier)); err != nil {
+		return err
+	}
+	return k.AttestationExpiry.Remove(ctx, collections.Join3(attestation.ExpiresAt, attestation.Denom, attestation.Verifier))
+}
+
+// recordAttestation appends an entry to the token's attestation history.
+func (k Keeper) recordAttestation(ctx context.Context, action string, attestation types.Attestation) error {
+	id, err := k.AttestationHistorySeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	record := types.AttestationRecord{
+		Id:          id,
+		Denom:       attestation.Denom,
+		Verifier:    attestation.Verifier,
+		Action:      action,
+		EvidenceUri: attestation.EvidenceUri,
+		ExpiresAt:   attestation.ExpiresAt,
+		RecordedAt:  uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()),
+	}
+	return k.AttestationHistory.Set(ctx, collections.Join(record.Denom, record.Id), record)
+}
+
+// countActiveAttestations returns how many of denom's attestations are unexpired at now.
+func (k Keeper) countActiveAttestations(ctx context.Context, denom string, now uint64) (uint32, error) {
+	var count uint32
+	err := k.Attestation.Walk(ctx, collections.NewPrefixedPairRange[string, string](denom), func(_ collections.Pair[string, string], attestation types.Attestation) (bool, error) {
+		if attestation.IsActive(now) {
+			count++
+		}
+		return false, nil
+	})
+	return count, err
+}
+
+// refreshTokenVerification recomputes denom's verified flag from its unexpired
+// attestations and emits an event when the flag flips.
+func (k Keeper) refreshTokenVerification(ctx context.Context, denom string) (bool, uint32, error) {
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		return false, 0, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	count, err := k.countActiveAttestations(ctx, denom, uint64(sdkCtx.BlockTime().Unix()))
+	if err != nil {
+		return false, 0, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	verified := count >= params.MinVerifierAttestations
+	if verified == token.Verified {
+		return verified, count, nil
+	}
+
+	token.Verified = verified
+	if err := k.Verifiedtoken.Set(ctx, denom, token); err != nil {
+		return false, 0, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.token_verification_changed",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("verified", fmt.Sprintf("%t", verified)),
+			sdk.NewAttribute("attestation_count", fmt.Sprintf("%d", count)),
+		),
+	)
+	return verified, count, nil
+}
+
+// refreshAllTokenVerification recomputes every token's verified flag, used when the
+// attestation threshold changes.
+func (k Keeper) refreshAllTokenVerification(ctx context.Context) error {
+	var denoms []string
+	if err := k.Verifiedtoken.Walk(ctx, nil, func(denom string, _ types.Verifiedtoken) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, denom := range denoms {
+		if _, _, err := k.refreshTokenVerification(ctx, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clearTokenAttestations revokes every current attestation for denom, used when the
+// token leaves the registry.
+func (k Keeper) clearTokenAttestations(ctx context.Context, denom string) error {
+	var attestations []types.Attestation
+	if err := k.Attestation.Walk(ctx, collections.NewPrefixedPairRange[string, string](denom), func(_ collections.Pair[string, string], attestation types.Attestation) (bool, error) {
+		attestations = append(attestations, attestation)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, attestation := range attestations {
+		if err := k.removeAttestation(ctx, attestation); err != nil {
+			return err
+		}
+		if err := k.recordAttestation(ctx, types.AttestationActionRevoked, attestation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireAttestations drops attestations whose expiry has passed and un-verifies
+// tokens that fall below the attestation threshold as a result.
+func (k Keeper) ExpireAttestations(ctx context.Context) error {
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	var due []collections.Triple[uint64, string, string]
+	if err := k.AttestationExpiry.Walk(ctx, nil, func(key collections.Triple[uint64, string, string]) (bool, error) {
+		if key.K1() > now {
+			return true, nil
+		}
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	touched := make(map[string]struct{})
+	var denoms []string
+	for _, key := range due {
+		attestation, err := k.Attestation.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+		if err := k.removeAttestation(ctx, attestation); err != nil {
+			return err
+		}
+		if err := k.recordAttestation(ctx, types.AttestationActionExpired, attestation); err != nil {
+			return err
+		}
+		if _, ok := touched[attestation.Denom]; !ok {
+			touched[attestation.Denom] = struct{}{}
+			denoms = append(denoms, attestation.Denom)
+		}
+	}
+	for _, denom := range denoms {
+		if _, _, err := k.refreshTokenVerification(ctx, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,403 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// sponsorClaimFees grants address an allowance from the claim sponsorship of
+// denom, or extends the one it already has. It is called when an accrual credits an
+// address with nothing left to claim. Allowances x/feegrant refuses are skipped so
+// the accrual itself goes through.
+func (k Keeper) sponsorClaimFees(ctx context.Context, denom, address string) error {
+	sponsorship, found, err := k.getClaimSponsorship(ctx, denom)
+	if err != nil || !found || !sponsorship.Enabled || k.feegrantKeeper == nil {
+		return err
+	}
+	usage, err := k.getClaimSponsorshipUsage(ctx, denom)
+	if err != nil {
+		return err
+	}
+	remaining := usage.RemainingBudget(sponsorship.DailyBudget)
+	if remaining == 0 {
+		return nil
+	}
+
+	sponsorAddr, err := k.addressCodec.StringToBytes(sponsorship.Sponsor)
+	if err != nil {
+		return err
+	}
+	granteeAddr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return err
+	}
+	key := collections.Join(sponsorship.Sponsor, address)
+	current, owned, err := k.getSponsoredAllowance(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if owned && current.Denom != denom {
+		// x/feegrant holds one allowance per sponsor and grantee, so it cannot
+		// be shared with the sponsorship of another token.
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.claim_sponsorship_skipped",
+				sdk.NewAttribute("denom", denom),
+				sdk.NewAttribute("grantee", address),
+				sdk.NewAttribute("reason", fmt.Sprintf("grantee already has an allowance sponsoring %s", current.Denom)),
+			),
+		)
+		return nil
+	}
+	expiresAt := sdkCtx.BlockTime().Add(types.ClaimSponsorshipAllowancePeriod)
+	allowance := types.SponsoredAllowance{
+		Sponsor:    sponsorship.Sponsor,
+		Grantee:    address,
+		Denom:      denom,
+		SpendLimit: min(sponsorship.PerUserCap, remaining),
+		ExpiresAt:  uint64(expiresAt.Unix()),
+	}
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := k.grantSponsoredAllowance(cacheCtx, sponsorAddr, granteeAddr, owned, allowance, expiresAt, sponsorship.AllowedMsgTypes()); err != nil {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.claim_sponsorship_skipped",
+				sdk.NewAttribute("denom", denom),
+				sdk.NewAttribute("grantee", address),
+				sdk.NewAttribute("reason", err.Error()),
+			),
+		)
+		return nil
+	}
+	write()
+
+	if err := k.SponsoredAllowance.Set(ctx, key, allowance); err != nil {
+		return err
+	}
+	usage.AllowancesGranted++
+	if err := k.ClaimSponsorshipUsage.Set(ctx, denom, usage); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.claim_fees_sponsored",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("sponsor", sponsorship.Sponsor),
+			sdk.NewAttribute("grantee", address),
+			sdk.NewAttribute("spend_limit", fmt.Sprintf("%d", allowance.SpendLimit)),
+			sdk.NewAttribute("expires_at", fmt.Sprintf("%d", allowance.ExpiresAt)),
+		),
+	)
+	return nil
+}
+
+// grantSponsoredAllowance writes the x/feegrant allowance of a sponsored grantee.
+// An allowance the sponsor granted outside of the sponsorship is left alone.
+func (k Keeper) grantSponsoredAllowance(ctx context.Context, sponsor, grantee sdk.AccAddress, owned bool, allowance types.SponsoredAllowance, expiresAt time.Time, msgTypes []string) error {
+	feeAllowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(allowance.SpendLimit))),
+		Expiration: &expiresAt,
+	}, msgTypes)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.feegrantKeeper.GetAllowance(ctx, sponsor, grantee)
+	switch {
+	case errors.Is(err, sdkerrors.ErrNotFound):
+		return k.feegrantKeeper.GrantAllowance(ctx, sponsor, grantee, feeAllowance)
+	case err != nil:
+		return err
+	case !owned:
+		return errorsmod.Wrap(types.ErrInvalidClaimSponsorship, "grantee already has an allowance from the sponsor")
+	default:
+		return k.feegrantKeeper.UpdateAllowance(ctx, sponsor, grantee, feeAllowance)
+	}
+}
+
+// RecordSponsoredFee counts a fee paid through a fee grant against the claim
+// sponsorship that granted it. Once the daily budget is spent, further fees are
+// refused and the sponsorship is queued so EndBlock revokes its allowances; it runs
+// in the ante handler, which must not walk every allowance of the sponsor.
+func (k Keeper) RecordSponsoredFee(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	granterStr, err := k.addressCodec.BytesToString(granter)
+	if err != nil {
+		return err
+	}
+	granteeStr, err := k.addressCodec.BytesToString(grantee)
+	if err != nil {
+		return err
+	}
+	allowance, found, err := k.getSponsoredAllowance(ctx, collections.Join(granterStr, granteeStr))
+	if err != nil || !found {
+		return err
+	}
+	sponsorship, found, err := k.getClaimSponsorship(ctx, allowance.Denom)
+	if err != nil || !found {
+		return err
+	}
+	usage, err := k.getClaimSponsorshipUsage(ctx, allowance.Denom)
+	if err != nil {
+		return err
+	}
+
+	if usage.Exhausted {
+		return errorsmod.Wrapf(types.ErrFeeNotSponsored, "claim sponsorship of %s has spent its daily budget", allowance.Denom)
+	}
+
+	paid := fee.AmountOf(sdk.DefaultBondDenom)
+	if !paid.IsUint64() || usage.Spent > ^uint64(0)-paid.Uint64() {
+		usage.Spent = ^uint64(0)
+	} else {
+		usage.Spent += paid.Uint64()
+	}
+	if usage.Spent >= sponsorship.DailyBudget {
+		usage.Exhausted = true
+		if err := k.ClaimSponsorshipRevocation.Set(ctx, collections.Join(sponsorship.Denom, sponsorship.Sponsor)); err != nil {
+			return err
+		}
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.claim_sponsorship_exhausted",
+				sdk.NewAttribute("denom", sponsorship.Denom),
+				sdk.NewAttribute("sponsor", sponsorship.Sponsor),
+				sdk.NewAttribute("date", usage.Date),
+				sdk.NewAttribute("spent", fmt.Sprintf("%d", usage.Spent)),
+			),
+		)
+	}
+	return k.ClaimSponsorshipUsage.Set(ctx, allowance.Denom, usage)
+}
+
+// maxSponsoredAllowanceVisitsPerBlock bounds how many allowances EndBlock inspects
+// while revoking spent claim sponsorships; the rest are revoked in the following
+// blocks.
+const maxSponsoredAllowanceVisitsPerBlock = 500
+
+// RevokeSpentClaimSponsorships revokes the allowances of the claim sponsorships
+// that spent their daily budget. Entries whose sponsorship was removed, disabled,
+// handed to another sponsor or moved on to a new rollup date are dropped, since
+// those paths revoke or reuse the allowances themselves.
+func (k Keeper) RevokeSpentClaimSponsorships(ctx context.Context) error {
+	var queued []collections.Pair[string, string]
+	if err := k.ClaimSponsorshipRevocation.Walk(ctx, nil, func(key collections.Pair[string, string]) (bool, error) {
+		queued = append(queued, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	budget := maxSponsoredAllowanceVisitsPerBlock
+	for _, key := range queued {
+		if budget == 0 {
+			return nil
+		}
+		denom, sponsor := key.K1(), key.K2()
+		sponsorship, found, err := k.getClaimSponsorship(ctx, denom)
+		if err != nil {
+			return err
+		}
+		spent := found && sponsorship.Enabled && sponsorship.Sponsor == sponsor
+		if spent {
+			usage, err := k.getClaimSponsorshipUsage(ctx, denom)
+			if err != nil {
+				return err
+			}
+			spent = usage.Exhausted
+		}
+		if spent {
+			visited, done, err := k.revokeSponsoredAllowancesUpTo(ctx, sponsor, denom, budget)
+			if err != nil {
+				return err
+			}
+			budget -= visited
+			if !done {
+				return nil
+			}
+		}
+		if err := k.ClaimSponsorshipRevocation.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeSponsoredAllowances revokes the allowances sponsor granted for denom.
+func (k Keeper) revokeSponsoredAllowances(ctx context.Context, sponsor, denom string) error {
+	_, _, err := k.revokeSponsoredAllowancesUpTo(ctx, sponsor, denom, 0)
+	return err
+}
+
+// revokeSponsoredAllowancesUpTo revokes the allowances sponsor granted for denom,
+// inspecting at most limit of the sponsor's allowances unless limit is zero. It
+// returns how many it inspected and whether none are left. Allowances x/feegrant
+// already pruned are only forgotten.
+func (k Keeper) revokeSponsoredAllowancesUpTo(ctx context.Context, sponsor, denom string, limit int) (int, bool, error) {
+	sponsorAddr, err := k.addressCodec.StringToBytes(sponsor)
+	if err != nil {
+		return 0, false, err
+	}
+	var (
+		keys    []collections.Pair[string, string]
+		visited int
+		done    = true
+	)
+	err = k.SponsoredAllowance.Walk(ctx, collections.NewPrefixedPairRange[string, string](sponsor), func(key collections.Pair[string, string], allowance types.SponsoredAllowance) (bool, error) {
+		if limit > 0 && visited == limit {
+			done = false
+			return true, nil
+		}
+		visited++
+		if allowance.Denom == denom {
+			keys = append(keys, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	for _, key := range keys {
+		if err := k.SponsoredAllowance.Remove(ctx, key); err != nil {
+			return 0, false, err
+		}
+		granteeAddr, err := k.addressCodec.StringToBytes(key.K2())
+		if err != nil {
+			return 0, false, err
+		}
+		if _, err := k.feegrantKeeper.GetAllowance(ctx, sponsorAddr, granteeAddr); err != nil {
+			if errors.Is(err, sdkerrors.ErrNotFound) {
+				continue
+			}
+			return 0, false, err
+		}
+		if err := k.feegrantKeeper.RevokeAllowance(ctx, sponsorAddr, granteeAddr); err != nil {
+			return 0, false, err
+		}
+	}
+	return visited, done, nil
+}
+
+// sponsoringDenom returns the denom of another enabled claim sponsorship of
+// sponsor than denom, if any.
+func (k Keeper) sponsoringDenom(ctx context.Context, sponsor, denom string) (string, bool, error) {
+	var other string
+	err := k.ClaimSponsorship.Walk(ctx, nil, func(key string, sponsorship types.ClaimSponsorship) (bool, error) {
+		if key != denom && sponsorship.Enabled && sponsorship.Sponsor == sponsor {
+			other = key
+			return true, nil
+		}
+		return false, nil
+	})
+	return other, other != "", err
+}
+
+// removeClaimSponsorship revokes the allowances granted under the claim sponsorship
+// of denom and drops the sponsorship and its usage.
+func (k Keeper) removeClaimSponsorship(ctx context.Context, denom string) error {
+	sponsorship, found, err := k.getClaimSponsorship(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.revokeSponsoredAllowances(ctx, sponsorship.Sponsor, denom); err != nil {
+			return err
+		}
+	}
+	if err := k.ClaimSponsorship.Remove(ctx, denom); err != nil {
+		return err
+	}
+	return k.ClaimSponsorshipUsage.Remove(ctx, denom)
+}
+
+// remainingSponsoredAllowance returns the staking denom the x/feegrant allowance
+// of a sponsored grantee still covers.
+func (k Keeper) remainingSponsoredAllowance(ctx context.Context, allowance types.SponsoredAllowance) (uint64, error) {
+	sponsorAddr, err := k.addressCodec.StringToBytes(allowance.Sponsor)
+	if err != nil {
+		return 0, err
+	}
+	granteeAddr, err := k.addressCodec.StringToBytes(allowance.Grantee)
+	if err != nil {
+		return 0, err
+	}
+	feeAllowance, err := k.feegrantKeeper.GetAllowance(ctx, sponsorAddr, granteeAddr)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if allowed, ok := feeAllowance.(*feegrant.AllowedMsgAllowance); ok {
+		if feeAllowance, err = allowed.GetAllowance(); err != nil {
+			return 0, err
+		}
+	}
+	basic, ok := feeAllowance.(*feegrant.BasicAllowance)
+	if !ok {
+		return 0, nil
+	}
+	if basic.Expiration != nil && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(*basic.Expiration) {
+		return 0, nil
+	}
+	remaining := basic.SpendLimit.AmountOf(sdk.DefaultBondDenom)
+	if !remaining.IsUint64() {
+		return ^uint64(0), nil
+	}
+	return remaining.Uint64(), nil
+}
+
+func (k Keeper) getClaimSponsorship(ctx context.Context, denom string) (types.ClaimSponsorship, bool, error) {
+	sponsorship, err := k.ClaimSponsorship.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ClaimSponsorship{}, false, nil
+		}
+		return types.ClaimSponsorship{}, false, err
+	}
+	return sponsorship, true, nil
+}
+
+// getClaimSponsorshipUsage returns the usage of the sponsorship of denom on the
+// current rollup date.
+func (k Keeper) getClaimSponsorshipUsage(ctx context.Context, denom string) (types.ClaimSponsorshipUsage, error) {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return types.ClaimSponsorshipUsage{}, err
+	}
+	location, err := loadRollupLocation(params.DailyRollupTimezone)
+	if err != nil {
+		return types.ClaimSponsorshipUsage{}, err
+	}
+	today := sdk.UnwrapSDKContext(ctx).BlockTime().In(location).Format(rollupDateLayout)
+
+	usage, err := k.ClaimSponsorshipUsage.Get(ctx, denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.ClaimSponsorshipUsage{}, err
+	}
+	return usage.OnDate(denom, today), nil
+}
+
+func (k Keeper) getSponsoredAllowance(ctx context.Context, key collections.Pair[string, string]) (types.SponsoredAllowance, bool, error) {
+	allowance, err := k.SponsoredAllowance.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.SponsoredAllowance{}, false, nil
+		}
+		return types.SponsoredAllowance{}, false, err
+	}
+	return allowance, true, nil
+}
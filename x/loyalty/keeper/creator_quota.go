@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// enforceCreatorQuota reports whether signer's allowlist quota applies. Quotas
+// only bind under the allowlisted creation mode and never bind allowlist admins.
+func (k Keeper) enforceCreatorQuota(ctx context.Context, signer string, params types.Params) bool {
+	if params.CreationMode != types.CreationModeAllowlisted {
+		return false
+	}
+	return k.ensureRole(ctx, signer, types.RoleAllowlistAdmin) != nil
+}
+
+// getCreatorUsage returns creator's registered tokens and aggregate supply,
+// treating a creator without recorded usage as having none.
+func (k Keeper) getCreatorUsage(ctx context.Context, creator string) (types.CreatorUsage, error) {
+	usage, err := k.CreatorUsage.Get(ctx, creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.CreatorUsage{Address: creator}, nil
+		}
+		return types.CreatorUsage{}, err
+	}
+	return usage, nil
+}
+
+// adjustCreatorUsage applies a change in registered tokens and aggregate supply to
+// creator's usage. Usage is recorded whether or not the creator has an allowlist
+// entry; when enforce is set and an entry exists, increases beyond its quota are
+// rejected.
+func (k Keeper) adjustCreatorUsage(ctx context.Context, creator string, tokenDelta int, oldSupply, newSupply uint64, enforce bool) error {
+	usage, err := k.getCreatorUsage(ctx, creator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	var entry *types.Creatorallowlist
+	if enforce {
+		val, err := k.Creatorallowlist.Get(ctx, creator)
+		if err == nil {
+			entry = &val
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	switch {
+	case tokenDelta > 0:
+		if entry != nil {
+			if remaining, unlimited := entry.RemainingTokens(usage); !unlimited && remaining < uint64(tokenDelta) {
+				return errorsmod.Wrapf(types.ErrCreatorQuotaExceeded, "creator may register at most %d tokens", entry.MaxTokens)
+			}
+		}
+		usage.TokensRegistered += uint64(tokenDelta)
+	case tokenDelta < 0:
+		usage.TokensRegistered -= min(usage.TokensRegistered, uint64(-tokenDelta))
+	}
+
+	if newSupply > oldSupply {
+		increase := newSupply - oldSupply
+		if entry != nil {
+			if remaining, unlimited := entry.RemainingSupply(usage); !unlimited && remaining < increase {
+				return errorsmod.Wrapf(types.ErrCreatorQuotaExceeded, "aggregate max supply would exceed %d (remaining %d)", entry.MaxTotalSupply, remaining)
+			}
+		}
+		if usage.TotalSupplyRegistered > math.MaxUint64-increase {
+			return errorsmod.Wrap(types.ErrCreatorQuotaExceeded, "aggregate max supply overflow")
+		}
+		usage.TotalSupplyRegistered += increase
+	} else {
+		usage.TotalSupplyRegistered -= min(usage.TotalSupplyRegistered, oldSupply-newSupply)
+	}
+
+	if usage.TokensRegistered == 0 && usage.TotalSupplyRegistered == 0 {
+		if err := k.CreatorUsage.Remove(ctx, creator); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		return nil
+	}
+	if err := k.CreatorUsage.Set(ctx, creator, usage); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return nil
+}
+
+// pruneExpiredCreatorallowlist disables allowlist entries whose expiry has passed.
+// Entries are kept rather than removed so the creator's settings remain visible.
+func (k Keeper) pruneExpiredCreatorallowlist(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	var expired []types.Creatorallowlist
+	if err := k.Creatorallowlist.Walk(ctx, nil, func(_ string, entry types.Creatorallowlist) (bool, error) {
+		if entry.Enabled && entry.IsExpired(now) {
+			expired = append(expired, entry)
+		}
+		return false, nil
+	}); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	for _, entry := range expired {
+		entry.Enabled = false
+		if err := k.Creatorallowlist.Set(ctx, entry.Address, entry); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCreatorallowlistExpired,
+				sdk.NewAttribute(types.AttributeKeyAddress, entry.Address),
+			),
+		)
+	}
+
+	return nil
+}
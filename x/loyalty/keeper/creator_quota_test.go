@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func setAllowlistedMode(t *testing.T, f *fixture) {
+	t.Helper()
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.CreationMode = types.CreationModeAllowlisted
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+}
+
+func TestCreatorQuotaEnforcedOnCreate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	setAllowlistedMode(t, f)
+	merchant := sample.AccAddress()
+
+	_, err := srv.CreateCreatorallowlist(f.ctx, &types.MsgCreateCreatorallowlist{
+		Creator:        authorityAddress(t, f),
+		Address:        merchant,
+		Enabled:        true,
+		MaxTokens:      2,
+		MaxTotalSupply: 1_500_000,
+	})
+	require.NoError(t, err)
+
+	first := baseVerifiedToken(merchant, "quota-a")
+	_, err = srv.CreateVerifiedtoken(f.ctx, first)
+	require.NoError(t, err)
+
+	tooLarge := baseVerifiedToken(merchant, "quota-b")
+	tooLarge.MaxSupply = 600_000
+	_, err = srv.CreateVerifiedtoken(f.ctx, tooLarge)
+	require.ErrorIs(t, err, types.ErrCreatorQuotaExceeded)
+
+	tooLarge.MaxSupply = 500_000
+	_, err = srv.CreateVerifiedtoken(f.ctx, tooLarge)
+	require.NoError(t, err)
+
+	_, err = srv.CreateVerifiedtoken(f.ctx, baseVerifiedToken(merchant, "quota-c"))
+	require.ErrorIs(t, err, types.ErrCreatorQuotaExceeded)
+
+	quota, err := qs.CreatorQuota(f.ctx, &types.QueryCreatorQuotaRequest{Address: merchant})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, quota.Usage.TokensRegistered)
+	require.EqualValues(t, 1_500_000, quota.Usage.TotalSupplyRegistered)
+	require.Zero(t, quota.RemainingTokens)
+	require.Zero(t, quota.RemainingSupply)
+	require.False(t, quota.UnlimitedTokens)
+	require.False(t, quota.Expired)
+
+	// Raising the cap of an existing token counts against the aggregate quota.
+	update := &types.MsgUpdateVerifiedtoken{
+		Creator:   merchant,
+		Denom:     factoryDenom(merchant, "quota-b"),
+		Issuer:    merchant,
+		Name:      "Token quota-b",
+		Symbol:    "ttquota-b",
+		MaxSupply: 500_001,
+	}
+	_, err = srv.UpdateVerifiedtoken(f.ctx, update)
+	require.ErrorIs(t, err, types.ErrCreatorQuotaExceeded)
+
+	// Deleting an unminted token frees its share of the quota.
+	_, err = srv.DeleteVerifiedtoken(f.ctx, &types.MsgDeleteVerifiedtoken{Creator: merchant, Denom: factoryDenom(merchant, "quota-a")})
+	require.NoError(t, err)
+	quota, err = qs.CreatorQuota(f.ctx, &types.QueryCreatorQuotaRequest{Address: merchant})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, quota.RemainingTokens)
+	require.EqualValues(t, 1_000_000, quota.RemainingSupply)
+
+	_, err = srv.UpdateVerifiedtoken(f.ctx, update)
+	require.NoError(t, err)
+}
+
+func TestCreatorallowlistExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	setAllowlistedMode(t, f)
+	merchant := sample.AccAddress()
+	expiresAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := srv.CreateCreatorallowlist(f.ctx, &types.MsgCreateCreatorallowlist{
+		Creator:   authorityAddress(t, f),
+		Address:   merchant,
+		Enabled:   true,
+		ExpiresAt: uint64(expiresAt.Unix()),
+	})
+	require.NoError(t, err)
+
+	before := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(expiresAt.Add(-time.Hour))
+	_, err = srv.CreateVerifiedtoken(before, baseVerifiedToken(merchant, "early"))
+	require.NoError(t, err)
+
+	after := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(expiresAt.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	_, err = srv.CreateVerifiedtoken(after, baseVerifiedToken(merchant, "late"))
+	require.ErrorIs(t, err, types.ErrCreatorNotAllowed)
+
+	quota, err := qs.CreatorQuota(after, &types.QueryCreatorQuotaRequest{Address: merchant})
+	require.NoError(t, err)
+	require.True(t, quota.Expired)
+	require.True(t, quota.UnlimitedTokens)
+	require.True(t, quota.UnlimitedSupply)
+
+	require.NoError(t, f.keeper.RunDailyRollup(after))
+	entry, err := f.keeper.Creatorallowlist.Get(after, merchant)
+	require.NoError(t, err)
+	require.False(t, entry.Enabled)
+	usage, err := f.keeper.CreatorUsage.Get(after, merchant)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, usage.TokensRegistered)
+
+	var pruned bool
+	for _, event := range after.EventManager().Events() {
+		if event.Type == types.EventTypeCreatorallowlistExpired {
+			pruned = true
+		}
+	}
+	require.True(t, pruned)
+}
+
+func TestCreatorallowlistReaddKeepsUsage(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	setAllowlistedMode(t, f)
+	authority := authorityAddress(t, f)
+	merchant := sample.AccAddress()
+	expiresAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	allowlist := &types.MsgCreateCreatorallowlist{
+		Creator:        authority,
+		Address:        merchant,
+		Enabled:        true,
+		ExpiresAt:      uint64(expiresAt.Unix()),
+		MaxTokens:      2,
+		MaxTotalSupply: 2_000_000,
+	}
+	before := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(expiresAt.Add(-time.Hour))
+	_, err := srv.CreateCreatorallowlist(before, allowlist)
+	require.NoError(t, err)
+	_, err = srv.CreateVerifiedtoken(before, baseVerifiedToken(merchant, "first"))
+	require.NoError(t, err)
+
+	// An active entry cannot be added twice.
+	_, err = srv.CreateCreatorallowlist(before, allowlist)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	after := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(expiresAt.Add(time.Hour))
+	require.NoError(t, f.keeper.RunDailyRollup(after))
+
+	// Re-adding the expired creator keeps counting the token it already registered.
+	allowlist.ExpiresAt = uint64(expiresAt.AddDate(0, 1, 0).Unix())
+	_, err = srv.CreateCreatorallowlist(after, allowlist)
+	require.NoError(t, err)
+	entry, err := f.keeper.Creatorallowlist.Get(after, merchant)
+	require.NoError(t, err)
+	require.True(t, entry.Enabled)
+	usage, err := f.keeper.CreatorUsage.Get(after, merchant)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, usage.TokensRegistered)
+	require.EqualValues(t, 1_000_000, usage.TotalSupplyRegistered)
+
+	_, err = srv.CreateVerifiedtoken(after, baseVerifiedToken(merchant, "second"))
+	require.NoError(t, err)
+	_, err = srv.CreateVerifiedtoken(after, baseVerifiedToken(merchant, "third"))
+	require.ErrorIs(t, err, types.ErrCreatorQuotaExceeded)
+}
+
+func TestCreatorallowlistDeleteRecreateKeepsUsage(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	setAllowlistedMode(t, f)
+	authority := authorityAddress(t, f)
+	merchant := sample.AccAddress()
+
+	allowlist := &types.MsgCreateCreatorallowlist{
+		Creator:        authority,
+		Address:        merchant,
+		Enabled:        true,
+		MaxTokens:      2,
+		MaxTotalSupply: 2_000_000,
+	}
+	_, err := srv.CreateCreatorallowlist(f.ctx, allowlist)
+	require.NoError(t, err)
+	_, err = srv.CreateVerifiedtoken(f.ctx, baseVerifiedToken(merchant, "first"))
+	require.NoError(t, err)
+	_, err = srv.CreateVerifiedtoken(f.ctx, baseVerifiedToken(merchant, "second"))
+	require.NoError(t, err)
+
+	_, err = srv.DeleteCreatorallowlist(f.ctx, &types.MsgDeleteCreatorallowlist{Creator: authority, Address: merchant})
+	require.NoError(t, err)
+	_, err = srv.CreateCreatorallowlist(f.ctx, allowlist)
+	require.NoError(t, err)
+
+	// The recreated entry still counts the tokens registered under the deleted one.
+	quota, err := qs.CreatorQuota(f.ctx, &types.QueryCreatorQuotaRequest{Address: merchant})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, quota.Usage.TokensRegistered)
+	require.EqualValues(t, 2_000_000, quota.Usage.TotalSupplyRegistered)
+	require.Zero(t, quota.RemainingTokens)
+	_, err = srv.CreateVerifiedtoken(f.ctx, baseVerifiedToken(merchant, "third"))
+	require.ErrorIs(t, err, types.ErrCreatorQuotaExceeded)
+
+	// Deleting a token while the creator has no entry still releases its usage.
+	_, err = srv.DeleteCreatorallowlist(f.ctx, &types.MsgDeleteCreatorallowlist{Creator: authority, Address: merchant})
+	require.NoError(t, err)
+	_, err = srv.DeleteVerifiedtoken(f.ctx, &types.MsgDeleteVerifiedtoken{Creator: merchant, Denom: factoryDenom(merchant, "first")})
+	require.NoError(t, err)
+	usage, err := f.keeper.CreatorUsage.Get(f.ctx, merchant)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, usage.TokensRegistered)
+	require.EqualValues(t, 1_000_000, usage.TotalSupplyRegistered)
+}
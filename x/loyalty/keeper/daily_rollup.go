@@ -16,7 +16,9 @@ import (
 const rollupDateLayout = "2006-01-02"
 
 // RunDailyRollup records the first block observed for a new local calendar day
-// (according to params.daily_rollup_timezone) and emits a rollup event.
+// (according to params.daily_rollup_timezone), checkpoints the reward liabilities
+// at the close of the previous day, prunes expired creator allowlist entries and
+// emits a rollup event.
 func (k Keeper) RunDailyRollup(ctx context.Context) error {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -40,6 +42,14 @@ func (k Keeper) RunDailyRollup(ctx context.Context) error {
 	if err := k.LastDailyRollupDate.Set(ctx, today); err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
+	if lastDate != "" {
+		if err := k.checkpointLiabilities(ctx, lastDate); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+	if err := k.pruneExpiredCreatorallowlist(ctx); err != nil {
+		return err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -6,13 +6,10 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"tokenchain/x/loyalty/types"
 )
 
-const businessTokenDisplayExponent uint32 = 6
-
 func (k Keeper) setVerifiedTokenDenomMetadata(ctx context.Context, token types.Verifiedtoken) error {
 	if err := sdk.ValidateDenom(token.Denom); err != nil {
 		return errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
@@ -29,19 +26,11 @@ func (k Keeper) setVerifiedTokenDenomMetadata(ctx context.Context, token types.V
 		return err
 	}
 
-	metadata := banktypes.Metadata{
-		Description: token.Description,
-		DenomUnits: []*banktypes.DenomUnit{
-			{Denom: token.Denom, Exponent: 0},
-			{Denom: subdenom, Exponent: businessTokenDisplayExponent},
-		},
-		Base:    token.Denom,
-		Display: subdenom,
-		Name:    token.Name,
-		Symbol:  token.Symbol,
-		URI:     token.Website,
+	if err := types.ValidateTokenDenomUnits(token); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDenomUnits, err.Error())
 	}
-	if err := metadata.Validate(); err != nil {
+	metadata, err := types.TokenBankMetadata(token)
+	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
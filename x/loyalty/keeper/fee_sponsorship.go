@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// IsFeeToken reports whether denom is an enabled fee token.
+func (k Keeper) IsFeeToken(ctx context.Context, denom string) bool {
+	feeToken, found, err := k.getFeeToken(ctx, denom)
+	return err == nil && found && feeToken.Enabled
+}
+
+// SponsoredFee returns the staking denom fee the gas pool of fee.Denom pays for a
+// transaction carrying msgs. It fails with ErrFeeNotSponsored when the token cannot
+// pay for one of msgs, the fee is over the per-transaction cap or the pool cannot
+// cover it.
+func (k Keeper) SponsoredFee(ctx context.Context, fee sdk.Coin, msgs []sdk.Msg) (sdk.Coin, error) {
+	feeToken, found, err := k.getFeeToken(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !found || !feeToken.Enabled {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeNotSponsored, "%s is not a fee token", fee.Denom)
+	}
+	for _, msg := range msgs {
+		if msgType := sdk.MsgTypeURL(msg); !feeToken.PaysFor(msgType) {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeNotSponsored, "%s cannot pay for %s", fee.Denom, msgType)
+		}
+	}
+
+	amount := feeToken.Convert(fee.Amount)
+	if !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeNotSponsored, "fee of %s is worth no %s", fee, sdk.DefaultBondDenom)
+	}
+	if feeToken.MaxUtokenPerTx > 0 && amount.GT(sdkmath.NewIntFromUint64(feeToken.MaxUtokenPerTx)) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeNotSponsored, "fee of %s%s is over the cap of %d per transaction", amount, sdk.DefaultBondDenom, feeToken.MaxUtokenPerTx)
+	}
+	pool, err := k.getGasPool(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if amount.GT(sdkmath.NewIntFromUint64(pool.Balance)) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeNotSponsored, "gas pool of %s holds %d%s, the fee is %s", fee.Denom, pool.Balance, sdk.DefaultBondDenom, amount)
+	}
+	return sdk.NewCoin(sdk.DefaultBondDenom, amount), nil
+}
+
+// SponsorTxFee moves fee from payer to the token owner and has the gas pool pay
+// sponsored, as returned by SponsoredFee, to the fee collector. The transfer to the
+// owner goes through the bank send restrictions, so frozen or paused holders
+// cannot pay fees with the token.
+func (k Keeper) SponsorTxFee(ctx context.Context, payer sdk.AccAddress, fee, sponsored sdk.Coin) error {
+	token, err := k.Verifiedtoken.Get(ctx, fee.Denom)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrTokenNotFound, fee.Denom)
+	}
+	pool, err := k.getGasPool(ctx, fee.Denom)
+	if err != nil {
+		return err
+	}
+	if !sponsored.Amount.IsUint64() || sponsored.Amount.Uint64() > pool.Balance {
+		return errorsmod.Wrapf(types.ErrFeeNotSponsored, "gas pool of %s cannot cover %s", fee.Denom, sponsored)
+	}
+
+	if payer.String() != token.Creator {
+		coins := sdk.NewCoins(fee)
+		if err := k.bankKeeper.InputOutputCoins(
+			ctx,
+			banktypes.Input{Address: payer.String(), Coins: coins},
+			[]banktypes.Output{{Address: token.Creator, Coins: coins}},
+		); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.GasPoolName, authtypes.FeeCollectorName, sdk.NewCoins(sponsored)); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	pool.Balance -= sponsored.Amount.Uint64()
+	pool.Sponsored += sponsored.Amount.Uint64()
+	pool.SponsoredTxs++
+	if err := k.GasPool.Set(ctx, fee.Denom, pool); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.fee_sponsored",
+			sdk.NewAttribute("denom", fee.Denom),
+			sdk.NewAttribute("payer", payer.String()),
+			sdk.NewAttribute("fee", fee.String()),
+			sdk.NewAttribute("sponsored", sponsored.String()),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) getFeeToken(ctx context.Context, denom string) (types.FeeToken, bool, error) {
+	feeToken, err := k.FeeToken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.FeeToken{}, false, nil
+		}
+		return types.FeeToken{}, false, err
+	}
+	return feeToken, true, nil
+}
+
+// getGasPool returns the gas pool of denom, empty if it was never funded.
+func (k Keeper) getGasPool(ctx context.Context, denom string) (types.GasPool, error) {
+	pool, err := k.GasPool.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.GasPool{Denom: denom}, nil
+		}
+		return types.GasPool{}, err
+	}
+	return pool, nil
+}
+
+// removeFeeToken drops denom's fee token settings and its empty gas pool so a
+// token registered again under the same denom starts without them.
+func (k Keeper) removeFeeToken(ctx context.Context, denom string) error {
+	if err := k.FeeToken.Remove(ctx, denom); err != nil {
+		return err
+	}
+	return k.GasPool.Remove(ctx, denom)
+}
@@ -16,6 +16,11 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return err
 		}
 	}
+	for _, elem := range genState.CreatorUsageList {
+		if err := k.CreatorUsage.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
 	for _, elem := range genState.VerifiedtokenMap {
 		if err := k.Verifiedtoken.Set(ctx, elem.Denom, elem); err != nil {
 			return err
@@ -25,12 +30,12 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 	for _, elem := range genState.RewardaccrualMap {
-		if err := k.Rewardaccrual.Set(ctx, elem.Key, elem); err != nil {
+		if err := k.setRewardAccrual(ctx, elem); err != nil {
 			return err
 		}
 	}
 	for _, elem := range genState.MerchantallocationMap {
-		if err := k.Merchantallocation.Set(ctx, elem.Key, elem); err != nil {
+		if err := k.setMerchantallocation(ctx, elem); err != nil {
 			return err
 		}
 	}
@@ -43,12 +48,163 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	if err := k.RecoveryoperationSeq.Set(ctx, genState.RecoveryoperationCount); err != nil {
 		return err
 	}
+	for _, elem := range genState.MerchantList {
+		if err := k.Merchant.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.MerchantSeq.Set(ctx, genState.MerchantCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.AttestationList {
+		if err := k.setAttestation(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.AttestationHistory {
+		if err := k.AttestationHistory.Set(ctx, collections.Join(elem.Denom, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+	if err := k.AttestationHistorySeq.Set(ctx, genState.AttestationHistoryCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.PendingMetadataChangeList {
+		if err := k.schedulePendingMetadataChange(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.MetadataVersionList {
+		if err := k.MetadataVersion.Set(ctx, collections.Join(elem.Denom, elem.Version), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.MintScheduleList {
+		if err := k.setMintSchedule(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.MintScheduleSeq.Set(ctx, genState.MintScheduleCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.MintRateLimitList {
+		if err := k.MintRateLimit.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.PendingMintRateLimitList {
+		if err := k.schedulePendingMintRateLimit(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.MintUsageList {
+		if err := k.MintUsage.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TokenPauseList {
+		if err := k.setTokenPause(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.AddressFreezeList {
+		if err := k.setAddressFreeze(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TokenIbcPolicyList {
+		if err := k.TokenIBCPolicy.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.IbcEscrowList {
+		if err := k.IBCEscrow.Set(ctx, elem.Denom, elem.Amount); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.IbcRecorderList {
+		if err := k.IBCRecorder.Set(ctx, collections.Join(elem.ChannelId, elem.Sender), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.MerchantIcaList {
+		if err := k.MerchantICA.Set(ctx, collections.Join(elem.MerchantId, elem.ConnectionId), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.MerchantIcaPacketList {
+		if err := k.MerchantICAPacket.Set(ctx, collections.Join(elem.ChannelId, elem.Sequence), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TreasuryForwardList {
+		if err := k.TreasuryForward.Set(ctx, collections.Join(elem.MerchantId, elem.AllocationKey), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.IbcRateLimitList {
+		if err := k.IBCRateLimit.Set(ctx, collections.Join(elem.Denom, elem.ChannelId), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.IbcRateLimitFlowList {
+		if err := k.IBCRateLimitFlow.Set(ctx, collections.Join(elem.Denom, elem.ChannelId), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.FeeTokenList {
+		if err := k.FeeToken.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.GasPoolList {
+		if err := k.GasPool.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ClaimSponsorshipList {
+		if err := k.ClaimSponsorship.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ClaimSponsorshipUsageList {
+		if err := k.ClaimSponsorshipUsage.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+		if !elem.Exhausted {
+			continue
+		}
+		sponsorship, err := k.ClaimSponsorship.Get(ctx, elem.Denom)
+		if err != nil {
+			return err
+		}
+		if err := k.ClaimSponsorshipRevocation.Set(ctx, collections.Join(elem.Denom, sponsorship.Sponsor)); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.SponsoredAllowanceList {
+		if err := k.SponsoredAllowance.Set(ctx, collections.Join(elem.Sponsor, elem.Grantee), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.LiabilityCheckpointList {
+		if err := k.LiabilityCheckpoint.Set(ctx, collections.Join(elem.Denom, elem.Date), elem); err != nil {
+			return err
+		}
+	}
 	if genState.LastDailyRollupDate != "" {
 		if err := k.LastDailyRollupDate.Set(ctx, genState.LastDailyRollupDate); err != nil {
 			return err
 		}
 	}
 
+	if err := k.validateAuthorities(genState.Authorities); err != nil {
+		return err
+	}
+	if err := k.Authorities.Set(ctx, genState.Authorities); err != nil {
+		return err
+	}
+
 	return k.Params.Set(ctx, genState.Params)
 }
 
@@ -67,6 +223,12 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.CreatorUsage.Walk(ctx, nil, func(_ string, val types.CreatorUsage) (stop bool, err error) {
+		genesis.CreatorUsageList = append(genesis.CreatorUsageList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 	if err := k.Verifiedtoken.Walk(ctx, nil, func(_ string, val types.Verifiedtoken) (stop bool, err error) {
 		genesis.VerifiedtokenMap = append(genesis.VerifiedtokenMap, val)
 		return false, nil
@@ -97,6 +259,172 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.Merchant.Walk(ctx, nil, func(_ uint64, elem types.Merchant) (bool, error) {
+		genesis.MerchantList = append(genesis.MerchantList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.MerchantCount, err = k.MerchantSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Attestation.Walk(ctx, nil, func(_ collections.Pair[string, string], elem types.Attestation) (bool, error) {
+		genesis.AttestationList = append(genesis.AttestationList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.AttestationHistory.Walk(ctx, nil, func(_ collections.Pair[string, uint64], elem types.AttestationRecord) (bool, error) {
+		genesis.AttestationHistory = append(genesis.AttestationHistory, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.AttestationHistoryCount, err = k.AttestationHistorySeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.PendingMetadataChange.Walk(ctx, nil, func(_ string, elem types.PendingMetadataChange) (bool, error) {
+		genesis.PendingMetadataChangeList = append(genesis.PendingMetadataChangeList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.MetadataVersion.Walk(ctx, nil, func(_ collections.Pair[string, uint64], elem types.MetadataVersion) (bool, error) {
+		genesis.MetadataVersionList = append(genesis.MetadataVersionList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.MintSchedule.Walk(ctx, nil, func(_ collections.Pair[string, uint64], elem types.MintSchedule) (bool, error) {
+		genesis.MintScheduleList = append(genesis.MintScheduleList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.MintScheduleCount, err = k.MintScheduleSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.MintRateLimit.Walk(ctx, nil, func(_ string, elem types.MintRateLimit) (bool, error) {
+		genesis.MintRateLimitList = append(genesis.MintRateLimitList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PendingMintRateLimit.Walk(ctx, nil, func(_ string, elem types.PendingMintRateLimit) (bool, error) {
+		genesis.PendingMintRateLimitList = append(genesis.PendingMintRateLimitList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.MintUsage.Walk(ctx, nil, func(_ string, elem types.MintUsage) (bool, error) {
+		genesis.MintUsageList = append(genesis.MintUsageList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.TokenPause.Walk(ctx, nil, func(_ string, elem types.TokenPause) (bool, error) {
+		genesis.TokenPauseList = append(genesis.TokenPauseList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.AddressFreeze.Walk(ctx, nil, func(_ collections.Pair[string, string], elem types.AddressFreeze) (bool, error) {
+		genesis.AddressFreezeList = append(genesis.AddressFreezeList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.TokenIBCPolicy.Walk(ctx, nil, func(_ string, elem types.TokenIBCPolicy) (bool, error) {
+		genesis.TokenIbcPolicyList = append(genesis.TokenIbcPolicyList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.IBCEscrow.Walk(ctx, nil, func(denom string, amount uint64) (bool, error) {
+		genesis.IbcEscrowList = append(genesis.IbcEscrowList, types.IBCEscrow{Denom: denom, Amount: amount})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.IBCRecorder.Walk(ctx, nil, func(_ collections.Pair[string, string], elem types.IBCRecorder) (bool, error) {
+		genesis.IbcRecorderList = append(genesis.IbcRecorderList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.MerchantICA.Walk(ctx, nil, func(_ collections.Pair[uint64, string], elem types.MerchantICA) (bool, error) {
+		genesis.MerchantIcaList = append(genesis.MerchantIcaList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.MerchantICAPacket.Walk(ctx, nil, func(_ collections.Pair[string, uint64], elem types.MerchantICAPacket) (bool, error) {
+		genesis.MerchantIcaPacketList = append(genesis.MerchantIcaPacketList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.TreasuryForward.Walk(ctx, nil, func(_ collections.Pair[uint64, string], elem types.TreasuryForward) (bool, error) {
+		genesis.TreasuryForwardList = append(genesis.TreasuryForwardList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.IBCRateLimit.Walk(ctx, nil, func(_ collections.Pair[string, string], elem types.IBCRateLimit) (bool, error) {
+		genesis.IbcRateLimitList = append(genesis.IbcRateLimitList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.IBCRateLimitFlow.Walk(ctx, nil, func(_ collections.Pair[string, string], elem types.IBCRateLimitFlow) (bool, error) {
+		genesis.IbcRateLimitFlowList = append(genesis.IbcRateLimitFlowList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.FeeToken.Walk(ctx, nil, func(_ string, elem types.FeeToken) (bool, error) {
+		genesis.FeeTokenList = append(genesis.FeeTokenList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.GasPool.Walk(ctx, nil, func(_ string, elem types.GasPool) (bool, error) {
+		genesis.GasPoolList = append(genesis.GasPoolList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.ClaimSponsorship.Walk(ctx, nil, func(_ string, elem types.ClaimSponsorship) (bool, error) {
+		genesis.ClaimSponsorshipList = append(genesis.ClaimSponsorshipList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.ClaimSponsorshipUsage.Walk(ctx, nil, func(_ string, elem types.ClaimSponsorshipUsage) (bool, error) {
+		genesis.ClaimSponsorshipUsageList = append(genesis.ClaimSponsorshipUsageList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.SponsoredAllowance.Walk(ctx, nil, func(_ collections.Pair[string, string], elem types.SponsoredAllowance) (bool, error) {
+		genesis.SponsoredAllowanceList = append(genesis.SponsoredAllowanceList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.LiabilityCheckpoint.Walk(ctx, nil, func(_ collections.Pair[string, string], elem types.LiabilityCheckpoint) (bool, error) {
+		genesis.LiabilityCheckpointList = append(genesis.LiabilityCheckpointList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.Authorities, err = k.getAuthorities(ctx)
+	if err != nil {
+		return nil, err
+	}
 	lastDailyRollupDate, err := k.LastDailyRollupDate.Get(ctx)
 	if err == nil {
 		genesis.LastDailyRollupDate = lastDailyRollupDate
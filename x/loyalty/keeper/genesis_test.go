@@ -18,7 +18,7 @@ func TestGenesis(t *testing.T) {
 		Params:              types.DefaultParams(),
 		CreatorallowlistMap: []types.Creatorallowlist{{Address: "0"}, {Address: "1"}},
 		VerifiedtokenMap: []types.Verifiedtoken{
-			{Denom: denom0, Issuer: creator, Name: "Genesis 0", Symbol: "G0"},
+			{Denom: denom0, Issuer: creator, Name: "Genesis 0", Symbol: "G0", MerchantId: 1},
 			{Denom: denom1, Issuer: creator, Name: "Genesis 1", Symbol: "G1"},
 		},
 		RewardaccrualMap:       []types.Rewardaccrual{{Key: "0"}, {Key: "1"}},
@@ -26,6 +26,18 @@ func TestGenesis(t *testing.T) {
 		RecoveryoperationList:  []types.Recoveryoperation{{Id: 0}, {Id: 1}},
 		RecoveryoperationCount: 2,
 		LastDailyRollupDate:    "2026-02-26",
+		Authorities:            types.Authorities{AccrualRecorders: []string{creator}},
+		MerchantList: []types.Merchant{
+			{Id: 1, Owner: creator, LegalName: "Genesis Merchant", PayoutAddress: creator, VerificationTier: types.MerchantTierBasic, Denoms: []string{denom0}, Active: true},
+		},
+		MerchantCount: 1,
+		AttestationList: []types.Attestation{
+			{Denom: denom1, Verifier: creator, EvidenceUri: "https://verifier.example/g1", ExpiresAt: 2_000_000_000},
+		},
+		AttestationHistory: []types.AttestationRecord{
+			{Id: 0, Denom: denom1, Verifier: creator, Action: types.AttestationActionAttested, ExpiresAt: 2_000_000_000},
+		},
+		AttestationHistoryCount: 1,
 	}
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
 	require.NoError(t, err)
@@ -40,6 +52,12 @@ func TestGenesis(t *testing.T) {
 	require.EqualExportedValues(t, genesisState.MerchantallocationMap, got.MerchantallocationMap)
 	require.EqualExportedValues(t, genesisState.RecoveryoperationList, got.RecoveryoperationList)
 	require.Equal(t, genesisState.RecoveryoperationCount, got.RecoveryoperationCount)
+	require.Equal(t, genesisState.Authorities, got.Authorities)
+	require.EqualExportedValues(t, genesisState.MerchantList, got.MerchantList)
+	require.Equal(t, genesisState.MerchantCount, got.MerchantCount)
+	require.EqualExportedValues(t, genesisState.AttestationList, got.AttestationList)
+	require.EqualExportedValues(t, genesisState.AttestationHistory, got.AttestationHistory)
+	require.Equal(t, genesisState.AttestationHistoryCount, got.AttestationHistoryCount)
 
 	metadata, ok := f.bankKeeper.denomMetadata[denom0]
 	require.True(t, ok)
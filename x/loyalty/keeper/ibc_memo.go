@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// ExecuteIBCLoyaltyMemo runs the loyalty memo of a transfer received over channel
+// from sender on the counterparty chain. received is the coin the transfer
+// delivered; a fund_pool transfer delivers it to the memo intermediary address, from
+// where it is moved into the reward pool. Any error fails the packet, which reverts
+// the transfer and refunds the sender.
+func (k Keeper) ExecuteIBCLoyaltyMemo(ctx context.Context, channel, sender string, memo types.IBCLoyaltyMemo, received sdk.Coin) error {
+	allowed, err := k.IBCRecorder.Has(ctx, collections.Join(channel, sender))
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errorsmod.Wrapf(types.ErrIBCRecorderUnauthorized, "%s over %s", sender, channel)
+	}
+	if err := k.ensureNotPaused(ctx, memo.Denom, types.PauseScopeClaim); err != nil {
+		return err
+	}
+
+	intermediary := types.IBCMemoIntermediaryAddress(channel, sender)
+	var amount uint64
+	switch memo.Action {
+	case types.IBCMemoActionAccrue:
+		if _, err := k.addressCodec.StringToBytes(memo.Address); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidIBCMemo, "invalid address: %s", err)
+		}
+		if amount, err = memo.AccrualAmount(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidIBCMemo, err.Error())
+		}
+		// The accrual is attributed to the intermediary address, which stands for
+		// the channel and sender on this chain.
+		if _, _, err := k.creditRewardAccrual(ctx, intermediary.String(), memo.Address, memo.Denom, amount, memo.Date); err != nil {
+			return err
+		}
+	case types.IBCMemoActionFundPool:
+		if received.Denom != memo.Denom {
+			return errorsmod.Wrapf(types.ErrInvalidIBCMemo, "transfer delivered %s, not %s", received.Denom, memo.Denom)
+		}
+		if !received.Amount.IsUint64() {
+			return errorsmod.Wrapf(types.ErrInvalidIBCMemo, "amount %s overflows", received.Amount)
+		}
+		amount = received.Amount.Uint64()
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediary, types.ModuleName, sdk.NewCoins(received)); err != nil {
+			return err
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidIBCMemo, "unknown action %q", memo.Action)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.ibc_memo_executed",
+			sdk.NewAttribute("channel_id", channel),
+			sdk.NewAttribute("sender", sender),
+			sdk.NewAttribute("action", memo.Action),
+			sdk.NewAttribute("address", memo.Address),
+			sdk.NewAttribute("denom", memo.Denom),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// tokenIBCPolicy returns the IBC policy in force for token and whether it is the
+// default because none was stored. An allowed policy set before the token opted
+// into recovery no longer applies.
+func (k Keeper) tokenIBCPolicy(ctx context.Context, token types.Verifiedtoken) (types.TokenIBCPolicy, bool, error) {
+	policy, err := k.TokenIBCPolicy.Get(ctx, token.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DefaultTokenIBCPolicy(token), true, nil
+		}
+		return types.TokenIBCPolicy{}, false, err
+	}
+	if token.SeizureOptIn && policy.Mode == types.IBCPolicyAllowed {
+		return types.DefaultTokenIBCPolicy(token), true, nil
+	}
+	return policy, false, nil
+}
+
+// CheckIBCTransfer fails with ErrIBCTransferBlocked when the policy of a verified
+// token does not let it leave over channel. Other denoms are not restricted.
+func (k Keeper) CheckIBCTransfer(ctx context.Context, denom, channel string) error {
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	policy, _, err := k.tokenIBCPolicy(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !policy.AllowsChannel(channel) {
+		return errorsmod.Wrapf(types.ErrIBCTransferBlocked, "%s may not be sent over %s (%s)", denom, channel, policy.Mode)
+	}
+	return nil
+}
+
+// RecordIBCEscrow adds amount to the escrow tally of a verified token sent to
+// another chain. Other denoms are ignored.
+func (k Keeper) RecordIBCEscrow(ctx context.Context, denom string, amount sdkmath.Int) error {
+	has, err := k.Verifiedtoken.Has(ctx, denom)
+	if err != nil || !has {
+		return err
+	}
+	escrowed, err := k.ibcEscrowed(ctx, denom)
+	if err != nil {
+		return err
+	}
+	total := sdkmath.NewIntFromUint64(escrowed).Add(amount)
+	if !total.IsUint64() {
+		return errorsmod.Wrapf(types.ErrIBCTransferBlocked, "escrowed amount of %s overflows", denom)
+	}
+	return k.IBCEscrow.Set(ctx, denom, total.Uint64())
+}
+
+// ReleaseIBCEscrow subtracts amount from the escrow tally of a verified token once
+// it is refunded or comes back. The tally does not go below zero, so tokens escrowed
+// before the tally existed are released without error.
+func (k Keeper) ReleaseIBCEscrow(ctx context.Context, denom string, amount sdkmath.Int) error {
+	escrowed, err := k.ibcEscrowed(ctx, denom)
+	if err != nil || escrowed == 0 {
+		return err
+	}
+	if !amount.IsUint64() || amount.Uint64() >= escrowed {
+		return k.IBCEscrow.Remove(ctx, denom)
+	}
+	return k.IBCEscrow.Set(ctx, denom, escrowed-amount.Uint64())
+}
+
+// ibcEscrowed returns the escrow tally of denom, zero when nothing is escrowed.
+func (k Keeper) ibcEscrowed(ctx context.Context, denom string) (uint64, error) {
+	escrowed, err := k.IBCEscrow.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return escrowed, nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// ConsumeIBCOutflow counts amount of denom leaving over channel against its rate
+// limit and fails with ErrIBCRateLimited when the outflow quota would be exceeded.
+// Denoms without a rate limit on channel are not restricted.
+func (k Keeper) ConsumeIBCOutflow(ctx context.Context, denom, channel string, amount sdkmath.Int) error {
+	return k.consumeIBCRateLimit(ctx, denom, channel, amount, true)
+}
+
+// ConsumeIBCInflow counts amount of denom coming back over channel against its
+// rate limit and fails with ErrIBCRateLimited when the inflow quota would be
+// exceeded.
+func (k Keeper) ConsumeIBCInflow(ctx context.Context, denom, channel string, amount sdkmath.Int) error {
+	return k.consumeIBCRateLimit(ctx, denom, channel, amount, false)
+}
+
+// RefundIBCOutflow gives back the outflow quota of a transfer that was refunded.
+// Transfers sent in an earlier window no longer count, so the flow of the current
+// window does not go below zero.
+func (k Keeper) RefundIBCOutflow(ctx context.Context, denom, channel string, amount sdkmath.Int) error {
+	limit, found, err := k.getIBCRateLimit(ctx, denom, channel)
+	if err != nil || !found {
+		return err
+	}
+	flow, err := k.ibcRateLimitFlow(ctx, limit)
+	if err != nil {
+		return err
+	}
+	if !amount.IsUint64() || amount.Uint64() >= flow.Outflow {
+		flow.Outflow = 0
+	} else {
+		flow.Outflow -= amount.Uint64()
+	}
+	return k.IBCRateLimitFlow.Set(ctx, collections.Join(denom, channel), flow)
+}
+
+func (k Keeper) consumeIBCRateLimit(ctx context.Context, denom, channel string, amount sdkmath.Int, outbound bool) error {
+	limit, found, err := k.getIBCRateLimit(ctx, denom, channel)
+	if err != nil || !found {
+		return err
+	}
+	flow, err := k.ibcRateLimitFlow(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	used, quota, direction := &flow.Inflow, limit.MaxInflow, "inflow"
+	if outbound {
+		used, quota, direction = &flow.Outflow, limit.MaxOutflow, "outflow"
+	}
+	total := sdkmath.NewIntFromUint64(*used).Add(amount)
+	if quota > 0 && total.GT(sdkmath.NewIntFromUint64(quota)) {
+		return errorsmod.Wrapf(types.ErrIBCRateLimited, "%s %s of %s over %s would reach %s; the quota is %d per %d seconds", amount, direction, denom, channel, total, quota, limit.WindowSeconds)
+	}
+	if !total.IsUint64() {
+		return errorsmod.Wrapf(types.ErrIBCRateLimited, "%s of %s over %s overflows", direction, denom, channel)
+	}
+	*used = total.Uint64()
+	return k.IBCRateLimitFlow.Set(ctx, collections.Join(denom, channel), flow)
+}
+
+func (k Keeper) getIBCRateLimit(ctx context.Context, denom, channel string) (types.IBCRateLimit, bool, error) {
+	limit, err := k.IBCRateLimit.Get(ctx, collections.Join(denom, channel))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.IBCRateLimit{}, false, nil
+		}
+		return types.IBCRateLimit{}, false, err
+	}
+	return limit, true, nil
+}
+
+// ibcRateLimitFlow returns the flow of limit in the window in force at the block
+// time.
+func (k Keeper) ibcRateLimitFlow(ctx context.Context, limit types.IBCRateLimit) (types.IBCRateLimitFlow, error) {
+	flow, err := k.IBCRateLimitFlow.Get(ctx, collections.Join(limit.Denom, limit.ChannelId))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.IBCRateLimitFlow{}, err
+	}
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	return flow.InWindow(limit, now), nil
+}
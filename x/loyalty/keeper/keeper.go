@@ -15,25 +15,110 @@ type Keeper struct {
 	storeService corestore.KVStoreService
 	cdc          codec.Codec
 	addressCodec address.Codec
-	// Address capable of executing a MsgUpdateParams message.
-	// Typically, this should be the x/gov module account.
+	// Address capable of executing a MsgUpdateAuthorities message. It implicitly
+	// holds every scoped role. Typically, this should be the x/gov module account.
 	authority []byte
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// Scoped role holders managed through MsgUpdateAuthorities.
+	Authorities collections.Item[types.Authorities]
 	// Tracks the last calendar date (in configured rollup timezone) when begin-block rollup fired.
 	LastDailyRollupDate collections.Item[string]
 
-	bankKeeper           types.BankKeeper
-	authKeeper           types.AuthKeeper
-	stakingKeeper        types.StakingKeeper
-	groupKeeper          types.GroupKeeper
-	Creatorallowlist     collections.Map[string, types.Creatorallowlist]
-	Verifiedtoken        collections.Map[string, types.Verifiedtoken]
+	bankKeeper       types.BankKeeper
+	authKeeper       types.AuthKeeper
+	stakingKeeper    types.StakingKeeper
+	groupKeeper      types.GroupKeeper
+	circuitKeeper    types.CircuitKeeper
+	feegrantKeeper   types.FeeGrantKeeper
+	Creatorallowlist collections.Map[string, types.Creatorallowlist]
+	// Registered tokens and aggregate supply keyed by creator, kept apart from the allowlist entry.
+	CreatorUsage collections.Map[string, types.CreatorUsage]
+	// Verified tokens keyed by denom, indexed by issuer and symbol.
+	Verifiedtoken        *collections.IndexedMap[string, types.Verifiedtoken, types.VerifiedtokenIndexes]
 	Rewardaccrual        collections.Map[string, types.Rewardaccrual]
 	Merchantallocation   collections.Map[string, types.Merchantallocation]
 	RecoveryoperationSeq collections.Sequence
 	Recoveryoperation    collections.Map[uint64, types.Recoveryoperation]
+	MerchantSeq          collections.Sequence
+	Merchant             collections.Map[uint64, types.Merchant]
+	// Current attestations keyed by (denom, verifier).
+	Attestation collections.Map[collections.Pair[string, string], types.Attestation]
+	// Expiry queue keyed by (expires_at, denom, verifier), drained in EndBlock.
+	AttestationExpiry     collections.KeySet[collections.Triple[uint64, string, string]]
+	AttestationHistorySeq collections.Sequence
+	AttestationHistory    collections.Map[collections.Pair[string, uint64], types.AttestationRecord]
+	// Scheduled name/symbol/max_supply changes keyed by denom, applied in EndBlock.
+	PendingMetadataChange collections.Map[string, types.PendingMetadataChange]
+	// Apply queue keyed by (effective_at, denom).
+	MetadataChangeQueue collections.KeySet[collections.Pair[uint64, string]]
+	MetadataVersion     collections.Map[collections.Pair[string, uint64], types.MetadataVersion]
+	MintScheduleSeq     collections.Sequence
+	// Active mint schedules keyed by (denom, id); finished or cancelled ones are removed.
+	MintSchedule collections.Map[collections.Pair[string, uint64], types.MintSchedule]
+	// Release queue keyed by (next_release_date, denom, id), drained in EndBlock.
+	MintScheduleQueue collections.KeySet[collections.Triple[string, string, uint64]]
+	MintRateLimit     collections.Map[string, types.MintRateLimit]
+	// Loosened limits keyed by denom, applied in EndBlock once the change delay elapses.
+	PendingMintRateLimit collections.Map[string, types.PendingMintRateLimit]
+	// Apply queue keyed by (effective_at, denom).
+	MintRateLimitQueue collections.KeySet[collections.Pair[uint64, string]]
+	MintUsage          collections.Map[string, types.MintUsage]
+	// Per-token pauses keyed by denom.
+	TokenPause collections.Map[string, types.TokenPause]
+	// Expiry queue keyed by (expires_at, denom), drained in EndBlock.
+	TokenPauseExpiry collections.KeySet[collections.Pair[uint64, string]]
+	// Address freezes keyed by (denom, address).
+	AddressFreeze collections.Map[collections.Pair[string, string], types.AddressFreeze]
+	// Expiry queue keyed by (expires_at, denom, address), drained in EndBlock.
+	AddressFreezeExpiry collections.KeySet[collections.Triple[uint64, string, string]]
+	// IBC policies keyed by denom; tokens without one follow the default.
+	TokenIBCPolicy collections.Map[string, types.TokenIBCPolicy]
+	// Amount escrowed for outbound IBC transfers, keyed by denom.
+	IBCEscrow collections.Map[string, uint64]
+	// Counterparty senders allowed to send loyalty memos, keyed by (channel, sender).
+	IBCRecorder collections.Map[collections.Pair[string, string], types.IBCRecorder]
+	// Merchant interchain accounts keyed by (merchant_id, connection_id).
+	MerchantICA collections.Map[collections.Pair[uint64, string], types.MerchantICA]
+	// Unsettled merchant packets keyed by (source channel, sequence).
+	MerchantICAPacket collections.Map[collections.Pair[string, uint64], types.MerchantICAPacket]
+	// Treasury forwards keyed by (merchant_id, allocation_key).
+	TreasuryForward collections.Map[collections.Pair[uint64, string], types.TreasuryForward]
+	// IBC rate limits keyed by (denom, channel).
+	IBCRateLimit collections.Map[collections.Pair[string, string], types.IBCRateLimit]
+	// Flow counted against each IBC rate limit in its current window.
+	IBCRateLimitFlow collections.Map[collections.Pair[string, string], types.IBCRateLimitFlow]
+	// Verified tokens that can pay transaction fees, keyed by denom.
+	FeeToken collections.Map[string, types.FeeToken]
+	// Staking denom set aside to pay the fees of each fee token, keyed by denom.
+	GasPool collections.Map[string, types.GasPool]
+	// Reward claim fee sponsorships keyed by denom.
+	ClaimSponsorship collections.Map[string, types.ClaimSponsorship]
+	// Spend of each claim sponsorship on its current day, keyed by denom.
+	ClaimSponsorshipUsage collections.Map[string, types.ClaimSponsorshipUsage]
+	// Fee allowances granted by claim sponsorships, keyed by (sponsor, grantee).
+	SponsoredAllowance collections.Map[collections.Pair[string, string], types.SponsoredAllowance]
+	// Spent claim sponsorships keyed by (denom, sponsor), revoked in EndBlock.
+	ClaimSponsorshipRevocation collections.KeySet[collections.Pair[string, string]]
+	// Running totals of the outstanding reward accruals, keyed by denom.
+	RewardLiability collections.Map[string, types.RewardLiability]
+	// Liability checkpoints keyed by (denom, rollup date), written by the daily rollup.
+	LiabilityCheckpoint collections.Map[collections.Pair[string, string], types.LiabilityCheckpoint]
+	// Merchant allocation totals keyed by denom.
+	TokenStakerStats collections.Map[string, types.TokenStakerStats]
+
+	// IBC keepers are created after this keeper, see SetIBCKeepers.
+	ibc *ibcKeepers
+}
+
+// ibcKeepers holds the IBC keepers used by merchant interchain accounts. It is
+// shared by pointer, so every copy of the keeper sees keepers set after depinject
+// built it.
+type ibcKeepers struct {
+	icaController types.ICAControllerKeeper
+	transfer      types.TransferKeeper
+	channel       types.ChannelKeeper
 }
 
 func NewKeeper(
@@ -46,6 +131,8 @@ func NewKeeper(
 	authKeeper types.AuthKeeper,
 	stakingKeeper types.StakingKeeper,
 	groupKeeper types.GroupKeeper,
+	circuitKeeper types.CircuitKeeper,
+	feegrantKeeper types.FeeGrantKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -59,23 +146,245 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		bankKeeper:    bankKeeper,
-		authKeeper:    authKeeper,
-		stakingKeeper: stakingKeeper,
-		groupKeeper:   groupKeeper,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		bankKeeper:     bankKeeper,
+		authKeeper:     authKeeper,
+		stakingKeeper:  stakingKeeper,
+		groupKeeper:    groupKeeper,
+		circuitKeeper:  circuitKeeper,
+		feegrantKeeper: feegrantKeeper,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Authorities:    collections.NewItem(sb, types.AuthoritiesKey, "authorities", codec.CollValue[types.Authorities](cdc)),
 		LastDailyRollupDate: collections.NewItem(
 			sb,
 			types.LastDailyRollupDateKey,
 			"last_daily_rollup_date",
 			collections.StringValue,
 		),
-		Creatorallowlist:     collections.NewMap(sb, types.CreatorallowlistKey, "creatorallowlist", collections.StringKey, codec.CollValue[types.Creatorallowlist](cdc)),
-		Verifiedtoken:        collections.NewMap(sb, types.VerifiedtokenKey, "verifiedtoken", collections.StringKey, codec.CollValue[types.Verifiedtoken](cdc)),
+		Creatorallowlist: collections.NewMap(sb, types.CreatorallowlistKey, "creatorallowlist", collections.StringKey, codec.CollValue[types.Creatorallowlist](cdc)),
+		CreatorUsage:     collections.NewMap(sb, types.CreatorUsageKey, "creatorUsage", collections.StringKey, codec.CollValue[types.CreatorUsage](cdc)),
+		Verifiedtoken: collections.NewIndexedMap(
+			sb,
+			types.VerifiedtokenKey,
+			"verifiedtoken",
+			collections.StringKey,
+			codec.CollValue[types.Verifiedtoken](cdc),
+			types.NewVerifiedtokenIndexes(sb),
+		),
 		Rewardaccrual:        collections.NewMap(sb, types.RewardaccrualKey, "rewardaccrual", collections.StringKey, codec.CollValue[types.Rewardaccrual](cdc)),
 		Merchantallocation:   collections.NewMap(sb, types.MerchantallocationKey, "merchantallocation", collections.StringKey, codec.CollValue[types.Merchantallocation](cdc)),
 		Recoveryoperation:    collections.NewMap(sb, types.RecoveryoperationKey, "recoveryoperation", collections.Uint64Key, codec.CollValue[types.Recoveryoperation](cdc)),
 		RecoveryoperationSeq: collections.NewSequence(sb, types.RecoveryoperationCountKey, "recoveryoperationSequence"),
+		Merchant:             collections.NewMap(sb, types.MerchantKey, "merchant", collections.Uint64Key, codec.CollValue[types.Merchant](cdc)),
+		MerchantSeq:          collections.NewSequence(sb, types.MerchantCountKey, "merchantSequence"),
+		Attestation: collections.NewMap(
+			sb,
+			types.AttestationKey,
+			"attestation",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.Attestation](cdc),
+		),
+		AttestationExpiry: collections.NewKeySet(
+			sb,
+			types.AttestationExpiryKey,
+			"attestationExpiry",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey),
+		),
+		AttestationHistorySeq: collections.NewSequence(sb, types.AttestationHistoryCountKey, "attestationHistorySequence"),
+		AttestationHistory: collections.NewMap(
+			sb,
+			types.AttestationHistoryKey,
+			"attestationHistory",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.AttestationRecord](cdc),
+		),
+		PendingMetadataChange: collections.NewMap(
+			sb,
+			types.PendingMetadataChangeKey,
+			"pendingMetadataChange",
+			collections.StringKey,
+			codec.CollValue[types.PendingMetadataChange](cdc),
+		),
+		MetadataChangeQueue: collections.NewKeySet(
+			sb,
+			types.MetadataChangeQueueKey,
+			"metadataChangeQueue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		MetadataVersion: collections.NewMap(
+			sb,
+			types.MetadataVersionKey,
+			"metadataVersion",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.MetadataVersion](cdc),
+		),
+		MintScheduleSeq: collections.NewSequence(sb, types.MintScheduleCountKey, "mintScheduleSequence"),
+		MintSchedule: collections.NewMap(
+			sb,
+			types.MintScheduleKey,
+			"mintSchedule",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.MintSchedule](cdc),
+		),
+		MintScheduleQueue: collections.NewKeySet(
+			sb,
+			types.MintScheduleQueueKey,
+			"mintScheduleQueue",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+		),
+		MintRateLimit: collections.NewMap(
+			sb,
+			types.MintRateLimitKey,
+			"mintRateLimit",
+			collections.StringKey,
+			codec.CollValue[types.MintRateLimit](cdc),
+		),
+		PendingMintRateLimit: collections.NewMap(
+			sb,
+			types.PendingMintRateLimitKey,
+			"pendingMintRateLimit",
+			collections.StringKey,
+			codec.CollValue[types.PendingMintRateLimit](cdc),
+		),
+		MintRateLimitQueue: collections.NewKeySet(
+			sb,
+			types.MintRateLimitQueueKey,
+			"mintRateLimitQueue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		MintUsage: collections.NewMap(
+			sb,
+			types.MintUsageKey,
+			"mintUsage",
+			collections.StringKey,
+			codec.CollValue[types.MintUsage](cdc),
+		),
+		TokenPause: collections.NewMap(
+			sb,
+			types.TokenPauseKey,
+			"tokenPause",
+			collections.StringKey,
+			codec.CollValue[types.TokenPause](cdc),
+		),
+		TokenPauseExpiry: collections.NewKeySet(
+			sb,
+			types.TokenPauseExpiryKey,
+			"tokenPauseExpiry",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		AddressFreeze: collections.NewMap(
+			sb,
+			types.AddressFreezeKey,
+			"addressFreeze",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.AddressFreeze](cdc),
+		),
+		AddressFreezeExpiry: collections.NewKeySet(
+			sb,
+			types.AddressFreezeExpiryKey,
+			"addressFreezeExpiry",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey),
+		),
+		TokenIBCPolicy: collections.NewMap(
+			sb,
+			types.TokenIBCPolicyKey,
+			"tokenIBCPolicy",
+			collections.StringKey,
+			codec.CollValue[types.TokenIBCPolicy](cdc),
+		),
+		IBCEscrow: collections.NewMap(sb, types.IBCEscrowKey, "ibcEscrow", collections.StringKey, collections.Uint64Value),
+		IBCRecorder: collections.NewMap(
+			sb,
+			types.IBCRecorderKey,
+			"ibcRecorder",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.IBCRecorder](cdc),
+		),
+		MerchantICA: collections.NewMap(
+			sb,
+			types.MerchantICAKey,
+			"merchantICA",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.MerchantICA](cdc),
+		),
+		MerchantICAPacket: collections.NewMap(
+			sb,
+			types.MerchantICAPacketKey,
+			"merchantICAPacket",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.MerchantICAPacket](cdc),
+		),
+		TreasuryForward: collections.NewMap(
+			sb,
+			types.TreasuryForwardKey,
+			"treasuryForward",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.TreasuryForward](cdc),
+		),
+		IBCRateLimit: collections.NewMap(
+			sb,
+			types.IBCRateLimitKey,
+			"ibcRateLimit",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.IBCRateLimit](cdc),
+		),
+		IBCRateLimitFlow: collections.NewMap(
+			sb,
+			types.IBCRateLimitFlowKey,
+			"ibcRateLimitFlow",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.IBCRateLimitFlow](cdc),
+		),
+		FeeToken: collections.NewMap(sb, types.FeeTokenKey, "feeToken", collections.StringKey, codec.CollValue[types.FeeToken](cdc)),
+		GasPool:  collections.NewMap(sb, types.GasPoolKey, "gasPool", collections.StringKey, codec.CollValue[types.GasPool](cdc)),
+		ClaimSponsorship: collections.NewMap(
+			sb,
+			types.ClaimSponsorshipKey,
+			"claimSponsorship",
+			collections.StringKey,
+			codec.CollValue[types.ClaimSponsorship](cdc),
+		),
+		ClaimSponsorshipUsage: collections.NewMap(
+			sb,
+			types.ClaimSponsorshipUsageKey,
+			"claimSponsorshipUsage",
+			collections.StringKey,
+			codec.CollValue[types.ClaimSponsorshipUsage](cdc),
+		),
+		SponsoredAllowance: collections.NewMap(
+			sb,
+			types.SponsoredAllowanceKey,
+			"sponsoredAllowance",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.SponsoredAllowance](cdc),
+		),
+		ClaimSponsorshipRevocation: collections.NewKeySet(
+			sb,
+			types.ClaimSponsorshipRevocationKey,
+			"claimSponsorshipRevocation",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		RewardLiability: collections.NewMap(
+			sb,
+			types.RewardLiabilityKey,
+			"rewardLiability",
+			collections.StringKey,
+			codec.CollValue[types.RewardLiability](cdc),
+		),
+		LiabilityCheckpoint: collections.NewMap(
+			sb,
+			types.LiabilityCheckpointKey,
+			"liabilityCheckpoint",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.LiabilityCheckpoint](cdc),
+		),
+		TokenStakerStats: collections.NewMap(
+			sb,
+			types.TokenStakerStatsKey,
+			"tokenStakerStats",
+			collections.StringKey,
+			codec.CollValue[types.TokenStakerStats](cdc),
+		),
+		ibc: &ibcKeepers{},
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -86,6 +395,14 @@ func NewKeeper(
 	return k
 }
 
+// SetIBCKeepers sets the IBC keepers merchant interchain accounts rely on. IBC
+// modules are not built by depinject, so the app sets them once they exist.
+func (k Keeper) SetIBCKeepers(icaController types.ICAControllerKeeper, transfer types.TransferKeeper, channel types.ChannelKeeper) {
+	k.ibc.icaController = icaController
+	k.ibc.transfer = transfer
+	k.ibc.channel = channel
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
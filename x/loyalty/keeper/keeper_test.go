@@ -2,10 +2,13 @@ package keeper_test
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -22,11 +25,13 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
-	bankKeeper   *mockBankKeeper
-	groupKeeper  *mockGroupKeeper
+	ctx            context.Context
+	keeper         keeper.Keeper
+	addressCodec   address.Codec
+	bankKeeper     *mockBankKeeper
+	groupKeeper    *mockGroupKeeper
+	circuitKeeper  *mockCircuitKeeper
+	feegrantKeeper *mockFeeGrantKeeper
 }
 
 type mockBankKeeper struct {
@@ -57,6 +62,56 @@ func (m *mockGroupKeeper) GroupPolicyInfo(_ context.Context, req *grouptypes.Que
 	return &grouptypes.QueryGroupPolicyInfoResponse{Info: info}, nil
 }
 
+type mockCircuitKeeper struct {
+	// operators maps an address to the message type URLs it may trip.
+	operators map[string][]string
+}
+
+func (m *mockCircuitKeeper) CanTrip(_ context.Context, addr sdk.AccAddress, msgTypeURL string) (bool, error) {
+	return slices.Contains(m.operators[addr.String()], msgTypeURL), nil
+}
+
+type mockFeeGrantKeeper struct {
+	// allowances maps granter/grantee to the allowance granted.
+	allowances map[string]feegrant.FeeAllowanceI
+}
+
+func feeGrantKey(granter, grantee sdk.AccAddress) string {
+	return granter.String() + "/" + grantee.String()
+}
+
+func (m *mockFeeGrantKeeper) GrantAllowance(_ context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	if _, ok := m.allowances[feeGrantKey(granter, grantee)]; ok {
+		return feegrant.ErrFeeLimitExceeded.Wrap("fee allowance already exists")
+	}
+	m.allowances[feeGrantKey(granter, grantee)] = allowance
+	return nil
+}
+
+func (m *mockFeeGrantKeeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	if _, err := m.GetAllowance(ctx, granter, grantee); err != nil {
+		return err
+	}
+	m.allowances[feeGrantKey(granter, grantee)] = allowance
+	return nil
+}
+
+func (m *mockFeeGrantKeeper) GetAllowance(_ context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	allowance, ok := m.allowances[feeGrantKey(granter, grantee)]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrap("fee-grant not found")
+	}
+	return allowance, nil
+}
+
+func (m *mockFeeGrantKeeper) RevokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	if _, err := m.GetAllowance(ctx, granter, grantee); err != nil {
+		return err
+	}
+	delete(m.allowances, feeGrantKey(granter, grantee))
+	return nil
+}
+
 func newMockBankKeeper() *mockBankKeeper {
 	return &mockBankKeeper{
 		accountBalances: make(map[string]sdk.Coins),
@@ -78,6 +133,10 @@ func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress)
 	return cloneCoins(m.accountBalances[addr.String()])
 }
 
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return cloneCoins(m.accountBalances[addr.String()])
+}
+
 func (m *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
 	m.moduleBalances[moduleName] = m.moduleBalances[moduleName].Add(amt...)
 	moduleAddr := authtypes.NewModuleAddress(moduleName).String()
@@ -109,6 +168,52 @@ func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderA
 	return nil
 }
 
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	moduleBal := m.moduleBalances[senderModule]
+	if !moduleBal.IsAllGTE(amt) {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.moduleBalances[senderModule] = moduleBal.Sub(amt...)
+	m.moduleBalances[recipientModule] = m.moduleBalances[recipientModule].Add(amt...)
+	senderAddr := authtypes.NewModuleAddress(senderModule).String()
+	recipientAddr := authtypes.NewModuleAddress(recipientModule).String()
+	m.accountBalances[senderAddr] = m.accountBalances[senderAddr].Sub(amt...)
+	m.accountBalances[recipientAddr] = m.accountBalances[recipientAddr].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) InputOutputCoins(_ context.Context, input banktypes.Input, outputs []banktypes.Output) error {
+	if err := banktypes.ValidateInputOutputs(input, outputs); err != nil {
+		return err
+	}
+	inputBal := m.accountBalances[input.Address]
+	if !inputBal.IsAllGTE(input.Coins) {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.accountBalances[input.Address] = inputBal.Sub(input.Coins...)
+	for moduleName := range m.moduleBalances {
+		if authtypes.NewModuleAddress(moduleName).String() == input.Address {
+			m.moduleBalances[moduleName] = m.moduleBalances[moduleName].Sub(input.Coins...)
+		}
+	}
+	for _, output := range outputs {
+		m.accountBalances[output.Address] = m.accountBalances[output.Address].Add(output.Coins...)
+	}
+	return nil
+}
+
+func (m *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	supply := sdk.Coin{Denom: denom, Amount: sdkmath.ZeroInt()}
+	for _, balance := range m.accountBalances {
+		supply.Amount = supply.Amount.Add(balance.AmountOf(denom))
+	}
+	return supply
+}
+
+func (m *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return addr.Equals(authtypes.NewModuleAddress(types.ModuleName))
+}
+
 func (m *mockBankKeeper) SetDenomMetaData(_ context.Context, metadata banktypes.Metadata) {
 	m.denomMetadata[metadata.Base] = metadata
 }
@@ -126,6 +231,8 @@ func initFixture(t *testing.T) *fixture {
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 	bankKeeper := newMockBankKeeper()
 	groupKeeper := newMockGroupKeeper()
+	circuitKeeper := &mockCircuitKeeper{operators: make(map[string][]string)}
+	feegrantKeeper := &mockFeeGrantKeeper{allowances: make(map[string]feegrant.FeeAllowanceI)}
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -136,18 +243,24 @@ func initFixture(t *testing.T) *fixture {
 		nil,
 		nil,
 		groupKeeper,
+		circuitKeeper,
+		feegrantKeeper,
 	)
 
-	// Initialize params
-	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+	// Initialize params; tests run with the relaxed localnet timelock floor.
+	params := types.DefaultParams()
+	params.NetworkMode = types.NetworkModeLocalnet
+	if err := k.Params.Set(ctx, params); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
-		bankKeeper:   bankKeeper,
-		groupKeeper:  groupKeeper,
+		ctx:            ctx,
+		keeper:         k,
+		addressCodec:   addressCodec,
+		bankKeeper:     bankKeeper,
+		groupKeeper:    groupKeeper,
+		circuitKeeper:  circuitKeeper,
+		feegrantKeeper: feegrantKeeper,
 	}
 }
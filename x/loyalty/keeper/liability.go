@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// setRewardAccrual stores record and moves the reward liability of its denom by
+// the difference with the record it replaces.
+func (k Keeper) setRewardAccrual(ctx context.Context, record types.Rewardaccrual) error {
+	previous, err := k.Rewardaccrual.Get(ctx, record.Key)
+	switch {
+	case err == nil:
+		if err := k.debitRewardLiability(ctx, previous); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.Rewardaccrual.Set(ctx, record.Key, record); err != nil {
+		return err
+	}
+	liability, err := k.getRewardLiability(ctx, record.Denom)
+	if err != nil {
+		return err
+	}
+	return k.RewardLiability.Set(ctx, record.Denom, liability.Credit(record.Amount))
+}
+
+// removeRewardAccrual removes record and takes it out of the reward liability of
+// its denom.
+func (k Keeper) removeRewardAccrual(ctx context.Context, record types.Rewardaccrual) error {
+	if err := k.Rewardaccrual.Remove(ctx, record.Key); err != nil {
+		return err
+	}
+	return k.debitRewardLiability(ctx, record)
+}
+
+func (k Keeper) debitRewardLiability(ctx context.Context, record types.Rewardaccrual) error {
+	liability, err := k.getRewardLiability(ctx, record.Denom)
+	if err != nil {
+		return err
+	}
+	liability = liability.Debit(record.Amount)
+	if liability.Holders == 0 && liability.Outstanding == 0 {
+		return k.RewardLiability.Remove(ctx, record.Denom)
+	}
+	return k.RewardLiability.Set(ctx, record.Denom, liability)
+}
+
+func (k Keeper) getRewardLiability(ctx context.Context, denom string) (types.RewardLiability, error) {
+	liability, err := k.RewardLiability.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RewardLiability{Denom: denom}, nil
+		}
+		return types.RewardLiability{}, err
+	}
+	return liability, nil
+}
+
+// checkpointLiabilities records the reward liability of every verified token at
+// the close of date. Tokens whose figures did not change since their latest
+// checkpoint, or that never had any, get none.
+func (k Keeper) checkpointLiabilities(ctx context.Context, date string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+	var checkpoints []types.LiabilityCheckpoint
+	err := k.Verifiedtoken.Walk(ctx, nil, func(denom string, token types.Verifiedtoken) (bool, error) {
+		liability, err := k.getRewardLiability(ctx, denom)
+		if err != nil {
+			return true, err
+		}
+		checkpoint := types.LiabilityCheckpoint{
+			Denom:        denom,
+			Date:         date,
+			Outstanding:  liability.Outstanding,
+			MintedSupply: token.MintedSupply,
+			Holders:      liability.Holders,
+			Height:       sdkCtx.BlockHeight(),
+		}
+		if balance := pool.AmountOf(denom); balance.IsUint64() {
+			checkpoint.PoolBalance = balance.Uint64()
+		}
+		latest, found, err := k.latestLiabilityCheckpoint(ctx, denom, date)
+		if err != nil {
+			return true, err
+		}
+		if (found && latest.SameTotals(checkpoint)) || (!found && checkpoint.SameTotals(types.LiabilityCheckpoint{})) {
+			return false, nil
+		}
+		checkpoints = append(checkpoints, checkpoint)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, checkpoint := range checkpoints {
+		if err := k.LiabilityCheckpoint.Set(ctx, collections.Join(checkpoint.Denom, checkpoint.Date), checkpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// latestLiabilityCheckpoint returns the latest checkpoint of denom on or before
+// date.
+func (k Keeper) latestLiabilityCheckpoint(ctx context.Context, denom, date string) (types.LiabilityCheckpoint, bool, error) {
+	rng := collections.NewPrefixedPairRange[string, string](denom).EndInclusive(date).Descending()
+	iter, err := k.LiabilityCheckpoint.Iterate(ctx, rng)
+	if err != nil {
+		return types.LiabilityCheckpoint{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.LiabilityCheckpoint{}, false, nil
+	}
+	checkpoint, err := iter.Value()
+	if err != nil {
+		return types.LiabilityCheckpoint{}, false, err
+	}
+	return checkpoint, true, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestLiabilityCheckpoints(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	// Noon in America/Edmonton on 2026-03-01 and the following days.
+	day := func(n int) sdk.Context {
+		return sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2026, 3, 1+n, 19, 0, 0, 0, time.UTC)).WithBlockHeight(int64(100 + n))
+	}
+	ctx := day(0)
+	require.NoError(t, f.keeper.RunDailyRollup(ctx))
+
+	owner, denom := createMerchantToken(t, f, srv, ctx, "audited")
+	first, second := sample.AccAddress(), sample.AccAddress()
+	_, err := srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: owner, Denom: denom, Recipient: owner, Amount: 1_000})
+	require.NoError(t, err)
+	_, err = srv.FundRewardPool(ctx, &types.MsgFundRewardPool{Creator: owner, Denom: denom, Amount: 300})
+	require.NoError(t, err)
+	for address, amount := range map[string]uint64{first: 50, second: 30} {
+		_, err = srv.RecordRewardAccrual(ctx, &types.MsgRecordRewardAccrual{Creator: authority, Address: address, Denom: denom, Amount: amount})
+		require.NoError(t, err)
+	}
+
+	// The date has not closed until the rollup of the next one.
+	_, err = qs.LiabilitiesAt(ctx, &types.QueryLiabilitiesAtRequest{Denom: denom, Date: "2026-03-01"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	ctx = day(1)
+	require.NoError(t, f.keeper.RunDailyRollup(ctx))
+	closed := types.LiabilityCheckpoint{Denom: denom, Date: "2026-03-01", Outstanding: 80, PoolBalance: 300, MintedSupply: 1_000, Holders: 2, Height: 101}
+	res, err := qs.LiabilitiesAt(ctx, &types.QueryLiabilitiesAtRequest{Denom: denom, Date: "2026-03-01"})
+	require.NoError(t, err)
+	require.Equal(t, closed, res.Checkpoint)
+
+	// Claims leave the past checkpoint as it was.
+	_, err = srv.ClaimReward(ctx, &types.MsgClaimReward{Creator: first, Denom: denom})
+	require.NoError(t, err)
+	ctx = day(2)
+	require.NoError(t, f.keeper.RunDailyRollup(ctx))
+	res, err = qs.LiabilitiesAt(ctx, &types.QueryLiabilitiesAtRequest{Denom: denom, Date: "2026-03-01"})
+	require.NoError(t, err)
+	require.Equal(t, closed, res.Checkpoint)
+	res, err = qs.LiabilitiesAt(ctx, &types.QueryLiabilitiesAtRequest{Denom: denom, Date: "2026-03-02"})
+	require.NoError(t, err)
+	require.Equal(t, types.LiabilityCheckpoint{Denom: denom, Date: "2026-03-02", Outstanding: 30, PoolBalance: 250, MintedSupply: 1_000, Holders: 1, Height: 102}, res.Checkpoint)
+
+	// Days without changes are served by the latest checkpoint before them.
+	ctx = day(4)
+	require.NoError(t, f.keeper.RunDailyRollup(ctx))
+	res, err = qs.LiabilitiesAt(ctx, &types.QueryLiabilitiesAtRequest{Denom: denom, Date: "2026-03-04"})
+	require.NoError(t, err)
+	require.Equal(t, "2026-03-02", res.Checkpoint.Date)
+	has, err := f.keeper.LiabilityCheckpoint.Has(ctx, collections.Join(denom, "2026-03-03"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = qs.LiabilitiesAt(ctx, &types.QueryLiabilitiesAtRequest{Denom: denom, Date: "2026-02-28"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = qs.LiabilitiesAt(ctx, &types.QueryLiabilitiesAtRequest{Denom: denom, Date: "03/01/2026"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k Keeper) getMerchant(ctx context.Context, id uint64) (types.Merchant, error) {
+	merchant, err := k.Merchant.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Merchant{}, errorsmod.Wrapf(types.ErrMerchantNotFound, "merchant %d", id)
+		}
+		return types.Merchant{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return merchant, nil
+}
+
+// ensureMerchantManager checks that signer owns merchant or is an allowlist admin.
+func (k Keeper) ensureMerchantManager(ctx context.Context, signer string, merchant types.Merchant) error {
+	if signer == merchant.Owner {
+		return nil
+	}
+	if err := k.ensureRole(ctx, signer, types.RoleAllowlistAdmin); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the merchant owner or an allowlist admin can manage this merchant")
+	}
+	return nil
+}
+
+// relinkTokenMerchant moves denom from merchant oldID to merchant newID, keeping both
+// merchants' denom lists in sync. Zero ids mean unlinked. Linking to a new merchant
+// requires it to be active and managed by signer.
+func (k Keeper) relinkTokenMerchant(ctx context.Context, signer, denom string, oldID, newID uint64) error {
+	if oldID == newID {
+		return nil
+	}
+
+	if newID != 0 {
+		merchant, err := k.getMerchant(ctx, newID)
+		if err != nil {
+			return err
+		}
+		if !merchant.Active {
+			return errorsmod.Wrapf(types.ErrMerchantInactive, "merchant %d", newID)
+		}
+		if err := k.ensureMerchantManager(ctx, signer, merchant); err != nil {
+			return err
+		}
+		merchant.AddDenom(denom)
+		if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	if oldID != 0 {
+		merchant, err := k.Merchant.Get(ctx, oldID)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil
+			}
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		merchant.RemoveDenom(denom)
+		if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// trackMerchantPacket records a packet sent for a merchant until it settles.
+func (k Keeper) trackMerchantPacket(ctx context.Context, ica types.MerchantICA, packet types.MerchantICAPacket) error {
+	if err := k.MerchantICAPacket.Set(ctx, collections.Join(packet.ChannelId, packet.Sequence), packet); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	ica.PacketsSent++
+	if err := k.MerchantICA.Set(ctx, collections.Join(ica.MerchantId, ica.ConnectionId), ica); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return nil
+}
+
+// OnMerchantICAChannelInit records the channel a merchant account handshake opens.
+// It is called by the controller while RegisterMerchantICA runs.
+func (k Keeper) OnMerchantICAChannelInit(ctx sdk.Context, portID, connectionID, channelID string) error {
+	ica, key, err := k.merchantICAOnPort(ctx, portID, connectionID)
+	if err != nil {
+		return err
+	}
+	if ica.Status != types.MerchantICAStatusPending {
+		return errorsmod.Wrapf(types.ErrInvalidMerchantICA, "merchant %d account on %s is not registering", ica.MerchantId, connectionID)
+	}
+	ica.ChannelId = channelID
+	if err := k.MerchantICA.Set(ctx, key, ica); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return nil
+}
+
+// OnMerchantICAChannelOpen activates a merchant account once the host has
+// acknowledged the handshake and its address is known.
+func (k Keeper) OnMerchantICAChannelOpen(ctx sdk.Context, portID, channelID string) error {
+	connectionID, err := k.ibc.icaController.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	ica, key, err := k.merchantICAOnPort(ctx, portID, connectionID)
+	if err != nil {
+		return err
+	}
+	address, found := k.ibc.icaController.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidMerchantICA, "no interchain account address for %s on %s", portID, connectionID)
+	}
+	ica.ChannelId = channelID
+	ica.Address = address
+	ica.Status = types.MerchantICAStatusActive
+	ica.OpenedAt = uint64(ctx.BlockTime().Unix())
+	if err := k.MerchantICA.Set(ctx, key, ica); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_ica_opened",
+			sdk.NewAttribute("merchant_id", strconv.FormatUint(ica.MerchantId, 10)),
+			sdk.NewAttribute("connection_id", connectionID),
+			sdk.NewAttribute("channel_id", channelID),
+			sdk.NewAttribute("address", address),
+		),
+	)
+	return nil
+}
+
+// OnMerchantICAChannelClosed marks a merchant account closed when its channel
+// closes. It can then be registered again.
+func (k Keeper) OnMerchantICAChannelClosed(ctx sdk.Context, portID, channelID string) error {
+	connectionID, err := k.ibc.icaController.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	ica, key, err := k.merchantICAOnPort(ctx, portID, connectionID)
+	if err != nil {
+		return err
+	}
+	if ica.ChannelId != channelID {
+		return nil
+	}
+	ica.Status = types.MerchantICAStatusClosed
+	if err := k.MerchantICA.Set(ctx, key, ica); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_ica_closed",
+			sdk.NewAttribute("merchant_id", strconv.FormatUint(ica.MerchantId, 10)),
+			sdk.NewAttribute("connection_id", connectionID),
+			sdk.NewAttribute("channel_id", channelID),
+		),
+	)
+	return nil
+}
+
+// SettleMerchantPacket clears an in-flight merchant packet once it is acknowledged
+// or times out and counts the outcome on the merchant account. A treasury forward
+// completes on success and is removed otherwise, so the allocation can be forwarded
+// again. Packets that were not sent for a merchant are ignored.
+func (k Keeper) SettleMerchantPacket(ctx context.Context, channelID string, sequence uint64, outcome string) error {
+	key := collections.Join(channelID, sequence)
+	packet, err := k.MerchantICAPacket.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := k.MerchantICAPacket.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	icaKey := collections.Join(packet.MerchantId, packet.ConnectionId)
+	ica, err := k.MerchantICA.Get(ctx, icaKey)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		switch outcome {
+		case types.MerchantPacketAcknowledged:
+			ica.PacketsAcknowledged++
+		case types.MerchantPacketFailed:
+			ica.PacketsFailed++
+		case types.MerchantPacketTimedOut:
+			ica.PacketsTimedOut++
+		}
+		if err := k.MerchantICA.Set(ctx, icaKey, ica); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if packet.Kind == types.MerchantPacketKindTreasuryForward {
+		forwardKey := collections.Join(packet.MerchantId, packet.AllocationKey)
+		forward, err := k.TreasuryForward.Get(ctx, forwardKey)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+		case err != nil:
+			return err
+		case forward.ChannelId != channelID || forward.Sequence != sequence:
+		case outcome == types.MerchantPacketAcknowledged:
+			forward.Status = types.TreasuryForwardCompleted
+			forward.CompletedAt = uint64(sdkCtx.BlockTime().Unix())
+			if err := k.TreasuryForward.Set(ctx, forwardKey, forward); err != nil {
+				return err
+			}
+		default:
+			if err := k.TreasuryForward.Remove(ctx, forwardKey); err != nil {
+				return err
+			}
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_packet_settled",
+			sdk.NewAttribute("merchant_id", strconv.FormatUint(packet.MerchantId, 10)),
+			sdk.NewAttribute("channel_id", channelID),
+			sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute("kind", packet.Kind),
+			sdk.NewAttribute("outcome", outcome),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) merchantICAOnPort(ctx context.Context, portID, connectionID string) (types.MerchantICA, collections.Pair[uint64, string], error) {
+	merchantID, ok := types.MerchantIDFromICAPort(portID)
+	if !ok {
+		return types.MerchantICA{}, collections.Pair[uint64, string]{}, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "port %s belongs to no merchant", portID)
+	}
+	key := collections.Join(merchantID, connectionID)
+	ica, err := k.MerchantICA.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MerchantICA{}, key, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "merchant %d has no account on %s", merchantID, connectionID)
+		}
+		return types.MerchantICA{}, key, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return ica, key, nil
+}
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// metadataChangeDelaySeconds returns the delay in force. Params stored before the
+// delay existed read as zero and fall back to the default.
+func metadataChangeDelaySeconds(params types.Params) uint64 {
+	hours := params.MetadataChangeDelayHours
+	if hours == 0 {
+		hours = types.DefaultMetadataChangeDelayHours
+	}
+	return hours * 3600
+}
+
+// recordMetadataVersion snapshots token's public metadata as its next version and
+// bumps token.MetadataVersion. The caller persists token.
+func (k Keeper) recordMetadataVersion(ctx context.Context, token *types.Verifiedtoken) error {
+	token.MetadataVersion++
+	version := types.MetadataVersion{
+		Denom:       token.Denom,
+		Version:     token.MetadataVersion,
+		Name:        token.Name,
+		Symbol:      token.Symbol,
+		Description: token.Description,
+		Website:     token.Website,
+		MaxSupply:   token.MaxSupply,
+		EffectiveAt: uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()),
+	}
+	return k.MetadataVersion.Set(ctx, collections.Join(version.Denom, version.Version), version)
+}
+
+// metadataChanged reports whether the versioned metadata fields differ between a and b.
+func metadataChanged(a, b types.Verifiedtoken) bool {
+	return a.Name != b.Name ||
+		a.Symbol != b.Symbol ||
+		a.Description != b.Description ||
+		a.Website != b.Website ||
+		a.MaxSupply != b.MaxSupply
+}
+
+func (k Keeper) getPendingMetadataChange(ctx context.Context, denom string) (types.PendingMetadataChange, bool, error) {
+	change, err := k.PendingMetadataChange.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PendingMetadataChange{}, false, nil
+		}
+		return types.PendingMetadataChange{}, false, err
+	}
+	return change, true, nil
+}
+
+// reservedMaxSupply is the max supply counted against the creator's quota: a pending
+// increase is reserved as soon as it is proposed.
+func (k Keeper) reservedMaxSupply(ctx context.Context, token types.Verifiedtoken) (uint64, error) {
+	change, found, err := k.getPendingMetadataChange(ctx, token.Denom)
+	if err != nil {
+		return 0, err
+	}
+	if found && change.MaxSupply > token.MaxSupply {
+		return change.MaxSupply, nil
+	}
+	return token.MaxSupply, nil
+}
+
+// schedulePendingMetadataChange stores change as denom's only pending change,
+// replacing and unqueueing any earlier one.
+func (k Keeper) schedulePendingMetadataChange(ctx context.Context, change types.PendingMetadataChange) error {
+	if err := k.removePendingMetadataChange(ctx, change.Denom); err != nil {
+		return err
+	}
+	if err := k.PendingMetadataChange.Set(ctx, change.Denom, change); err != nil {
+		return err
+	}
+	return k.MetadataChangeQueue.Set(ctx, collections.Join(change.EffectiveAt, change.Denom))
+}
+
+// removePendingMetadataChange drops denom's pending change and its queue entry, if any.
+func (k Keeper) removePendingMetadataChange(ctx context.Context, denom string) error {
+	change, found, err := k.getPendingMetadataChange(ctx, denom)
+	if err != nil || !found {
+		return err
+	}
+	if err := k.MetadataChangeQueue.Remove(ctx, collections.Join(change.EffectiveAt, change.Denom)); err != nil {
+		return err
+	}
+	return k.PendingMetadataChange.Remove(ctx, denom)
+}
+
+// ApplyMetadataChanges applies every pending metadata change whose delay has elapsed,
+// republishing bank metadata and recording a new metadata version for each token.
+func (k Keeper) ApplyMetadataChanges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+
+	var due []collections.Pair[uint64, string]
+	if err := k.MetadataChangeQueue.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+		if key.K1() > now {
+			return true, nil
+		}
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		change, err := k.PendingMetadataChange.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.removePendingMetadataChange(ctx, change.Denom); err != nil {
+			return err
+		}
+		token, err := k.Verifiedtoken.Get(ctx, change.Denom)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		token.Name = change.Name
+		token.Symbol = change.Symbol
+		if token.AdminRenounced {
+			// Renouncing after the proposal locks the cap; release the reserved increase.
+			if change.MaxSupply > token.MaxSupply {
+				if err := k.adjustCreatorUsage(ctx, token.Creator, 0, change.MaxSupply, token.MaxSupply, false); err != nil {
+					return err
+				}
+			}
+		} else {
+			token.MaxSupply = change.MaxSupply
+		}
+		if err := k.recordMetadataVersion(ctx, &token); err != nil {
+			return err
+		}
+		if err := k.Verifiedtoken.Set(ctx, token.Denom, token); err != nil {
+			return err
+		}
+		if err := k.setVerifiedTokenDenomMetadata(ctx, token); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.metadata_change_applied",
+				sdk.NewAttribute("denom", token.Denom),
+				sdk.NewAttribute("version", fmt.Sprintf("%d", token.MetadataVersion)),
+				sdk.NewAttribute("name", token.Name),
+				sdk.NewAttribute("symbol", token.Symbol),
+				sdk.NewAttribute("max_supply", fmt.Sprintf("%d", token.MaxSupply)),
+			),
+		)
+	}
+	return nil
+}
+
+// proposeMetadataChange schedules the delayed part of an update and emits the public
+// proposal event.
+func (k Keeper) proposeMetadataChange(ctx context.Context, proposer string, token types.Verifiedtoken, name, symbol string, maxSupply uint64) (types.PendingMetadataChange, error) {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return types.PendingMetadataChange{}, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+	change := types.PendingMetadataChange{
+		Denom:       token.Denom,
+		Name:        name,
+		Symbol:      symbol,
+		MaxSupply:   maxSupply,
+		Proposer:    proposer,
+		ProposedAt:  now,
+		EffectiveAt: now + metadataChangeDelaySeconds(params),
+	}
+	if err := k.schedulePendingMetadataChange(ctx, change); err != nil {
+		return types.PendingMetadataChange{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.metadata_change_proposed",
+			sdk.NewAttribute("denom", change.Denom),
+			sdk.NewAttribute("proposer", proposer),
+			sdk.NewAttribute("current_name", token.Name),
+			sdk.NewAttribute("current_symbol", token.Symbol),
+			sdk.NewAttribute("current_max_supply", fmt.Sprintf("%d", token.MaxSupply)),
+			sdk.NewAttribute("name", change.Name),
+			sdk.NewAttribute("symbol", change.Symbol),
+			sdk.NewAttribute("max_supply", fmt.Sprintf("%d", change.MaxSupply)),
+			sdk.NewAttribute("effective_at", fmt.Sprintf("%d", change.EffectiveAt)),
+		),
+	)
+	return change, nil
+}
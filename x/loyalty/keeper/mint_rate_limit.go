@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// mintClock is the current position of the mint rate limit windows.
+type mintClock struct {
+	hour  uint64
+	today string
+}
+
+// windowStart is the first hourly bucket inside the rolling window.
+func (c mintClock) windowStart() uint64 {
+	return c.hour - types.MintRateLimitWindowHours
+}
+
+func (k Keeper) getMintClock(ctx context.Context) (mintClock, error) {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return mintClock{}, err
+	}
+	location, err := loadRollupLocation(params.DailyRollupTimezone)
+	if err != nil {
+		return mintClock{}, err
+	}
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	return mintClock{
+		hour:  types.MintUsageHour(blockTime.Unix()),
+		today: blockTime.In(location).Format(types.MintScheduleDateLayout),
+	}, nil
+}
+
+// getMintRateLimit returns denom's limit, or an empty (unlimited) one if none is set.
+func (k Keeper) getMintRateLimit(ctx context.Context, denom string) (types.MintRateLimit, error) {
+	limit, err := k.MintRateLimit.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MintRateLimit{Denom: denom}, nil
+		}
+		return types.MintRateLimit{}, err
+	}
+	return limit, nil
+}
+
+// setMintRateLimit stores limit, dropping the entry when it no longer limits anything.
+func (k Keeper) setMintRateLimit(ctx context.Context, limit types.MintRateLimit) error {
+	if limit.IsZero() {
+		return k.MintRateLimit.Remove(ctx, limit.Denom)
+	}
+	return k.MintRateLimit.Set(ctx, limit.Denom, limit)
+}
+
+func (k Keeper) getPendingMintRateLimit(ctx context.Context, denom string) (types.PendingMintRateLimit, bool, error) {
+	pending, err := k.PendingMintRateLimit.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PendingMintRateLimit{}, false, nil
+		}
+		return types.PendingMintRateLimit{}, false, err
+	}
+	return pending, true, nil
+}
+
+// schedulePendingMintRateLimit stores pending as the token's only pending limit,
+// replacing and unqueueing any earlier one.
+func (k Keeper) schedulePendingMintRateLimit(ctx context.Context, pending types.PendingMintRateLimit) error {
+	if err := k.removePendingMintRateLimit(ctx, pending.Limit.Denom); err != nil {
+		return err
+	}
+	if err := k.PendingMintRateLimit.Set(ctx, pending.Limit.Denom, pending); err != nil {
+		return err
+	}
+	return k.MintRateLimitQueue.Set(ctx, collections.Join(pending.EffectiveAt, pending.Limit.Denom))
+}
+
+// removePendingMintRateLimit drops denom's pending limit and its queue entry, if any.
+func (k Keeper) removePendingMintRateLimit(ctx context.Context, denom string) error {
+	pending, found, err := k.getPendingMintRateLimit(ctx, denom)
+	if err != nil || !found {
+		return err
+	}
+	if err := k.MintRateLimitQueue.Remove(ctx, collections.Join(pending.EffectiveAt, denom)); err != nil {
+		return err
+	}
+	return k.PendingMintRateLimit.Remove(ctx, denom)
+}
+
+// removeMintRateLimitState drops every rate limit record of a deleted token.
+func (k Keeper) removeMintRateLimitState(ctx context.Context, denom string) error {
+	if err := k.removePendingMintRateLimit(ctx, denom); err != nil {
+		return err
+	}
+	if err := k.MintRateLimit.Remove(ctx, denom); err != nil {
+		return err
+	}
+	return k.MintUsage.Remove(ctx, denom)
+}
+
+func (k Keeper) getMintUsage(ctx context.Context, denom string) (types.MintUsage, error) {
+	usage, err := k.MintUsage.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MintUsage{Denom: denom}, nil
+		}
+		return types.MintUsage{}, err
+	}
+	return usage, nil
+}
+
+// mintRateAllowance returns the most that limit lets be minted in one tx given usage.
+func mintRateAllowance(limit types.MintRateLimit, usage types.MintUsage, clock mintClock) uint64 {
+	available := uint64(math.MaxUint64)
+	if limit.MaxPerTx > 0 {
+		available = min(available, limit.MaxPerTx)
+	}
+	if limit.MaxPerRollingDay > 0 {
+		available = min(available, limit.MaxPerRollingDay-min(limit.MaxPerRollingDay, usage.MintedSince(clock.windowStart())))
+	}
+	if limit.MaxPerRollupDate > 0 {
+		available = min(available, limit.MaxPerRollupDate-min(limit.MaxPerRollupDate, usage.MintedOn(clock.today)))
+	}
+	return available
+}
+
+// consumeMintRateLimit fails with ErrMintRateLimited when minting amount of denom now
+// would break its rate limit, and records the mint otherwise.
+func (k Keeper) consumeMintRateLimit(ctx context.Context, denom string, amount uint64) error {
+	limit, err := k.getMintRateLimit(ctx, denom)
+	if err != nil {
+		return err
+	}
+	usage, err := k.getMintUsage(ctx, denom)
+	if err != nil {
+		return err
+	}
+	clock, err := k.getMintClock(ctx)
+	if err != nil {
+		return err
+	}
+
+	if limit.MaxPerTx > 0 && amount > limit.MaxPerTx {
+		return errorsmod.Wrapf(types.ErrMintRateLimited, "amount %d exceeds the per-tx limit of %d", amount, limit.MaxPerTx)
+	}
+	if limit.MaxPerRollingDay > 0 {
+		if used := usage.MintedSince(clock.windowStart()); amount > limit.MaxPerRollingDay-min(limit.MaxPerRollingDay, used) {
+			return errorsmod.Wrapf(types.ErrMintRateLimited, "%d already minted in the last 24 hours; the limit is %d", used, limit.MaxPerRollingDay)
+		}
+	}
+	if limit.MaxPerRollupDate > 0 {
+		if used := usage.MintedOn(clock.today); amount > limit.MaxPerRollupDate-min(limit.MaxPerRollupDate, used) {
+			return errorsmod.Wrapf(types.ErrMintRateLimited, "%d already minted on %s; the limit is %d", used, clock.today, limit.MaxPerRollupDate)
+		}
+	}
+	return k.writeMintUsage(ctx, usage, clock, amount)
+}
+
+// writeMintUsage adds amount to usage, pruning buckets that left the rolling window.
+func (k Keeper) writeMintUsage(ctx context.Context, usage types.MintUsage, clock mintClock, amount uint64) error {
+	hourly := usage.Hourly[:0]
+	for _, bucket := range usage.Hourly {
+		if bucket.Hour >= clock.windowStart() {
+			hourly = append(hourly, bucket)
+		}
+	}
+	if n := len(hourly); n > 0 && hourly[n-1].Hour == clock.hour {
+		hourly[n-1].Amount += amount
+	} else {
+		hourly = append(hourly, types.MintUsageBucket{Hour: clock.hour, Amount: amount})
+	}
+	usage.Hourly = hourly
+
+	if usage.RollupDate != clock.today {
+		usage.RollupDate = clock.today
+		usage.MintedOnRollupDate = 0
+	}
+	usage.MintedOnRollupDate += amount
+	return k.MintUsage.Set(ctx, usage.Denom, usage)
+}
+
+// ApplyMintRateLimitChanges applies every pending mint rate limit whose delay has elapsed.
+func (k Keeper) ApplyMintRateLimitChanges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+
+	var due []collections.Pair[uint64, string]
+	if err := k.MintRateLimitQueue.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+		if key.K1() > now {
+			return true, nil
+		}
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		pending, err := k.PendingMintRateLimit.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.removePendingMintRateLimit(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.setMintRateLimit(ctx, pending.Limit); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.mint_rate_limit_applied",
+				sdk.NewAttribute("denom", pending.Limit.Denom),
+				sdk.NewAttribute("max_per_tx", fmt.Sprintf("%d", pending.Limit.MaxPerTx)),
+				sdk.NewAttribute("max_per_rolling_day", fmt.Sprintf("%d", pending.Limit.MaxPerRollingDay)),
+				sdk.NewAttribute("max_per_rollup_date", fmt.Sprintf("%d", pending.Limit.MaxPerRollupDate)),
+			),
+		)
+	}
+	return nil
+}
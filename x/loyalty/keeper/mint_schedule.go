@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// maxMintTranchesPerBlock bounds the EndBlock work; anything left over is released in
+// the following blocks.
+const maxMintTranchesPerBlock = 100
+
+// maxMintQueueVisitsPerBlock bounds how many due queue entries EndBlock inspects,
+// including those of paused tokens that are skipped.
+const maxMintQueueVisitsPerBlock = 1_000
+
+func (k Keeper) getMintSchedule(ctx context.Context, denom string, id uint64) (types.MintSchedule, error) {
+	schedule, err := k.MintSchedule.Get(ctx, collections.Join(denom, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MintSchedule{}, errorsmod.Wrapf(types.ErrMintScheduleNotFound, "mint schedule %d for %s", id, denom)
+		}
+		return types.MintSchedule{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return schedule, nil
+}
+
+// setMintSchedule stores schedule and queues its next release.
+func (k Keeper) setMintSchedule(ctx context.Context, schedule types.MintSchedule) error {
+	if err := k.MintSchedule.Set(ctx, collections.Join(schedule.Denom, schedule.Id), schedule); err != nil {
+		return err
+	}
+	return k.MintScheduleQueue.Set(ctx, collections.Join3(schedule.NextReleaseDate, schedule.Denom, schedule.Id))
+}
+
+// removeMintSchedule drops schedule and its queue entry.
+func (k Keeper) removeMintSchedule(ctx context.Context, schedule types.MintSchedule) error {
+	if err := k.MintScheduleQueue.Remove(ctx, collections.Join3(schedule.NextReleaseDate, schedule.Denom, schedule.Id)); err != nil {
+		return err
+	}
+	return k.MintSchedule.Remove(ctx, collections.Join(schedule.Denom, schedule.Id))
+}
+
+// cancelMintSchedule removes schedule and returns its unreleased amount to the token's
+// available supply.
+func (k Keeper) cancelMintSchedule(ctx context.Context, schedule types.MintSchedule) error {
+	if err := k.removeMintSchedule(ctx, schedule); err != nil {
+		return err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, schedule.Denom)
+	if err != nil {
+		return err
+	}
+	token.ScheduledSupply -= min(token.ScheduledSupply, schedule.Remaining())
+	return k.Verifiedtoken.Set(ctx, token.Denom, token)
+}
+
+// ReleaseMintTranches mints every tranche due on or before today's date in the daily
+// rollup timezone. A tranche that cannot be minted is deferred to the next day
+// instead of failing the block.
+func (k Keeper) ReleaseMintTranches(ctx context.Context) error {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+	location, err := loadRollupLocation(params.DailyRollupTimezone)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().In(location)
+	today := now.Format(types.MintScheduleDateLayout)
+	tomorrow := now.AddDate(0, 0, 1).Format(types.MintScheduleDateLayout)
+
+	// Tranches of tokens whose minting is paused stay queued and are caught up once the
+	// pause is lifted; they do not count against the per-block limit, but do count
+	// against the visit limit.
+	var due []collections.Triple[string, string, uint64]
+	paused := make(map[string]bool)
+	visited := 0
+	if err := k.MintScheduleQueue.Walk(ctx, nil, func(key collections.Triple[string, string, uint64]) (bool, error) {
+		if key.K1() > today || len(due) == maxMintTranchesPerBlock || visited == maxMintQueueVisitsPerBlock {
+			return true, nil
+		}
+		visited++
+		isPaused, seen := paused[key.K2()]
+		if !seen {
+			pause, active, err := k.activeTokenPause(ctx, key.K2())
+			if err != nil {
+				return true, err
+			}
+			isPaused = active && pause.Covers(types.PauseScopeMint)
+			paused[key.K2()] = isPaused
+		}
+		if !isPaused {
+			due = append(due, key)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		schedule, err := k.MintSchedule.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.releaseMintTranche(cacheCtx, schedule); err != nil {
+			if err := k.deferMintTranche(ctx, schedule, tomorrow); err != nil {
+				return err
+			}
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"loyalty.mint_tranche_deferred",
+					sdk.NewAttribute("denom", schedule.Denom),
+					sdk.NewAttribute("id", fmt.Sprintf("%d", schedule.Id)),
+					sdk.NewAttribute("release_date", schedule.NextReleaseDate),
+					sdk.NewAttribute("retry_date", tomorrow),
+					sdk.NewAttribute("error", err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// deferMintTranche requeues schedule's pending tranche for date. Later tranche dates
+// are still derived from the start date, so a deferral does not shift the cadence.
+func (k Keeper) deferMintTranche(ctx context.Context, schedule types.MintSchedule, date string) error {
+	if err := k.MintScheduleQueue.Remove(ctx, collections.Join3(schedule.NextReleaseDate, schedule.Denom, schedule.Id)); err != nil {
+		return err
+	}
+	schedule.NextReleaseDate = date
+	return k.setMintSchedule(ctx, schedule)
+}
+
+// releaseMintTranche mints the next tranche of schedule and requeues or completes it.
+func (k Keeper) releaseMintTranche(ctx sdk.Context, schedule types.MintSchedule) error {
+	token, err := k.Verifiedtoken.Get(ctx, schedule.Denom)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addressCodec.StringToBytes(schedule.Recipient)
+	if err != nil {
+		return err
+	}
+	amount := min(schedule.TrancheAmount, schedule.Remaining())
+	if token.ScheduledSupply < amount {
+		return fmt.Errorf("scheduled supply %d does not cover tranche of %d", token.ScheduledSupply, amount)
+	}
+	if err := k.consumeMintRateLimit(ctx, schedule.Denom, amount); err != nil {
+		return err
+	}
+	if err := k.mintVerifiedCoins(ctx, schedule.Denom, recipient, amount); err != nil {
+		return err
+	}
+
+	token.MintedSupply += amount
+	token.ScheduledSupply -= amount
+	if err := k.Verifiedtoken.Set(ctx, token.Denom, token); err != nil {
+		return err
+	}
+
+	if err := k.removeMintSchedule(ctx, schedule); err != nil {
+		return err
+	}
+	schedule.ReleasedAmount += amount
+	schedule.TranchesReleased++
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.mint_tranche_released",
+			sdk.NewAttribute("denom", schedule.Denom),
+			sdk.NewAttribute("id", fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute("recipient", schedule.Recipient),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
+			sdk.NewAttribute("release_date", schedule.NextReleaseDate),
+			sdk.NewAttribute("released_amount", fmt.Sprintf("%d", schedule.ReleasedAmount)),
+		),
+	)
+
+	if schedule.Remaining() == 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.mint_schedule_completed",
+				sdk.NewAttribute("denom", schedule.Denom),
+				sdk.NewAttribute("id", fmt.Sprintf("%d", schedule.Id)),
+			),
+		)
+		return nil
+	}
+
+	schedule.NextReleaseDate, err = types.MintScheduleReleaseDate(schedule.StartDate, schedule.Cadence, schedule.Interval, schedule.TranchesReleased)
+	if err != nil {
+		return err
+	}
+	return k.setMintSchedule(ctx, schedule)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// maxFreezeReasonLength bounds the free-form reason stored with a freeze.
+const maxFreezeReasonLength = 512
+
+// recoveryTokenForFreeze loads a recovery-enabled token and checks that signer may
+// freeze its holders: the token's recovery group policy or a recovery overseer.
+func (k msgServer) recoveryTokenForFreeze(ctx context.Context, signer, denom string) (types.Verifiedtoken, error) {
+	if err := k.validateTokenFactoryDenom(denom); err != nil {
+		return types.Verifiedtoken{}, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Verifiedtoken{}, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return types.Verifiedtoken{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !token.SeizureOptIn {
+		return types.Verifiedtoken{}, errorsmod.Wrap(types.ErrRecoveryPolicy, "token recovery is disabled (no-seizure default)")
+	}
+	isAuthority := k.ensureRole(ctx, signer, types.RoleRecoveryOverseer) == nil
+	if signer != token.RecoveryGroupPolicy && !isAuthority {
+		return types.Verifiedtoken{}, errorsmod.Wrap(types.ErrRecoveryUnauthorized, "only recovery group policy or authority can freeze addresses")
+	}
+	return token, nil
+}
+
+func (k msgServer) FreezeAddress(ctx context.Context, msg *types.MsgFreezeAddress) (*types.MsgFreezeAddressResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	holder, err := k.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if sdk.AccAddress(holder).Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidFreeze, "the loyalty module account cannot be frozen")
+	}
+	token, err := k.recoveryTokenForFreeze(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(msg.Reason)
+	if len(reason) > maxFreezeReasonLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFreeze, "reason exceeds %d characters", maxFreezeReasonLength)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+	if msg.ExpiresAt <= now {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFreeze, "expires_at %d is not in the future", msg.ExpiresAt)
+	}
+	if maxExpiry := now + types.MaxAddressFreezeSeconds(token.RecoveryTimelockHours); msg.ExpiresAt > maxExpiry {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFreeze, "expires_at may be at most %d (recovery timelock plus %d hours)", maxExpiry, types.AddressFreezeGraceHours)
+	}
+
+	if msg.LinkRecovery {
+		op, err := k.Recoveryoperation.Get(ctx, msg.RecoveryId)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "recovery operation %d not found", msg.RecoveryId)
+			}
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if op.Status != types.RecoveryStatusQueued {
+			return nil, errorsmod.Wrapf(types.ErrRecoveryNotQueued, "recovery operation %d is in %s state", op.Id, op.Status)
+		}
+		if op.Denom != msg.Denom || op.FromAddress != msg.Address {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFreeze, "recovery operation %d does not recover %s from %s", op.Id, msg.Denom, msg.Address)
+		}
+	}
+
+	freeze := types.AddressFreeze{
+		Denom:     msg.Denom,
+		Address:   msg.Address,
+		FrozenBy:  msg.Creator,
+		Reason:    reason,
+		FrozenAt:  now,
+		ExpiresAt: msg.ExpiresAt,
+	}
+	if msg.LinkRecovery {
+		freeze.RecoveryId = msg.RecoveryId
+		freeze.RecoveryLinked = true
+	}
+	if err := k.setAddressFreeze(ctx, freeze); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.address_frozen",
+			sdk.NewAttribute("denom", freeze.Denom),
+			sdk.NewAttribute("address", freeze.Address),
+			sdk.NewAttribute("recovery_id", freezeRecoveryID(freeze)),
+			sdk.NewAttribute("frozen_by", freeze.FrozenBy),
+			sdk.NewAttribute("reason", freeze.Reason),
+			sdk.NewAttribute("expires_at", fmt.Sprintf("%d", freeze.ExpiresAt)),
+		),
+	)
+
+	return &types.MsgFreezeAddressResponse{ExpiresAt: freeze.ExpiresAt}, nil
+}
+
+func (k msgServer) UnfreezeAddress(ctx context.Context, msg *types.MsgUnfreezeAddress) (*types.MsgUnfreezeAddressResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if _, err := k.recoveryTokenForFreeze(ctx, msg.Creator, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	freeze, active, err := k.activeAddressFreeze(ctx, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !active {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFreeze, "%s is not frozen for %s", msg.Address, msg.Denom)
+	}
+	if err := k.releaseAddressFreeze(ctx, freeze, "unfrozen"); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgUnfreezeAddressResponse{}, nil
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestFreezeAddress(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	holder := sample.AccAddress()
+	other := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	now := uint64(ctx.BlockTime().Unix())
+	denom := createRecoveryEnabledToken(t, f, srv, ctx, creator, "freezable")
+	plainDenom := factoryDenom(creator, "nofreeze")
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "nofreeze"))
+	require.NoError(t, err)
+
+	for _, d := range []string{denom, plainDenom} {
+		_, err = srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: creator, Denom: d, Recipient: holder, Amount: 100})
+		require.NoError(t, err)
+	}
+
+	freeze := func(msg types.MsgFreezeAddress) error {
+		_, err := srv.FreezeAddress(ctx, &msg)
+		return err
+	}
+	require.ErrorIs(t, freeze(types.MsgFreezeAddress{Creator: sample.AccAddress(), Denom: denom, Address: holder, ExpiresAt: now + 60}), types.ErrRecoveryUnauthorized)
+	require.ErrorIs(t, freeze(types.MsgFreezeAddress{Creator: creator, Denom: plainDenom, Address: holder, ExpiresAt: now + 60}), types.ErrRecoveryPolicy)
+	require.ErrorIs(t, freeze(types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, ExpiresAt: now}), types.ErrInvalidFreeze)
+	// The recovery timelock is one hour, so a freeze may last at most a week and an hour.
+	maxExpiry := now + types.MaxAddressFreezeSeconds(1)
+	require.EqualValues(t, now+(1+7*24)*3600, maxExpiry)
+	require.ErrorIs(t, freeze(types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, ExpiresAt: maxExpiry + 1}), types.ErrInvalidFreeze)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	require.ErrorIs(t, freeze(types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: moduleAddr, ExpiresAt: now + 60}), types.ErrInvalidFreeze)
+	require.ErrorIs(t, freeze(types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, RecoveryId: 42, LinkRecovery: true, ExpiresAt: now + 60}), sdkerrors.ErrKeyNotFound)
+
+	require.NoError(t, freeze(types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, Reason: "stolen key", ExpiresAt: maxExpiry}))
+
+	holderAddr := sdk.MustAccAddressFromBech32(holder)
+	otherAddr := sdk.MustAccAddressFromBech32(other)
+	_, err = f.keeper.SendRestrictionFn(ctx, holderAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.ErrorIs(t, err, types.ErrAddressFrozen)
+	// Only the frozen denom is blocked, and only on the way out.
+	_, err = f.keeper.SendRestrictionFn(ctx, holderAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin(plainDenom, 1)))
+	require.NoError(t, err)
+	_, err = f.keeper.SendRestrictionFn(ctx, otherAddr, holderAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.NoError(t, err)
+	_, err = srv.FundRewardPool(ctx, &types.MsgFundRewardPool{Creator: holder, Denom: denom, Amount: 1})
+	require.ErrorIs(t, err, types.ErrAddressFrozen)
+
+	resp, err := qs.AddressFreeze(ctx, &types.QueryAddressFreezeRequest{Denom: denom, Address: holder})
+	require.NoError(t, err)
+	require.Equal(t, "stolen key", resp.AddressFreeze.Reason)
+	require.Equal(t, creator, resp.AddressFreeze.FrozenBy)
+	require.Equal(t, now, resp.AddressFreeze.FrozenAt)
+	list, err := qs.AddressFreezes(ctx, &types.QueryAddressFreezesRequest{Denom: plainDenom})
+	require.NoError(t, err)
+	require.Empty(t, list.AddressFreezes)
+
+	_, err = srv.UnfreezeAddress(ctx, &types.MsgUnfreezeAddress{Creator: sample.AccAddress(), Denom: denom, Address: holder})
+	require.ErrorIs(t, err, types.ErrRecoveryUnauthorized)
+	_, err = srv.UnfreezeAddress(ctx, &types.MsgUnfreezeAddress{Creator: creator, Denom: denom, Address: holder})
+	require.NoError(t, err)
+	_, err = srv.UnfreezeAddress(ctx, &types.MsgUnfreezeAddress{Creator: creator, Denom: denom, Address: holder})
+	require.ErrorIs(t, err, types.ErrInvalidFreeze)
+	_, err = f.keeper.SendRestrictionFn(ctx, holderAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.NoError(t, err)
+	_, err = qs.AddressFreeze(ctx, &types.QueryAddressFreezeRequest{Denom: denom, Address: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestAddressFreezeLinkedToRecovery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	holder := sample.AccAddress()
+	to := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	now := uint64(ctx.BlockTime().Unix())
+	denom := createRecoveryEnabledToken(t, f, srv, ctx, creator, "linked")
+
+	_, err := srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: creator, Denom: denom, Recipient: holder, Amount: 100})
+	require.NoError(t, err)
+
+	queue := func() uint64 {
+		resp, err := srv.QueueRecoveryTransfer(ctx, &types.MsgQueueRecoveryTransfer{Creator: creator, Denom: denom, FromAddress: holder, ToAddress: to, Amount: 40})
+		require.NoError(t, err)
+		return resp.Id
+	}
+
+	// A linked freeze must point at a queued recovery of the same holder.
+	executed := queue()
+	_, err = srv.FreezeAddress(ctx, &types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: to, RecoveryId: executed, LinkRecovery: true, ExpiresAt: now + 7200})
+	require.ErrorIs(t, err, types.ErrInvalidFreeze)
+	_, err = srv.FreezeAddress(ctx, &types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, RecoveryId: executed, LinkRecovery: true, ExpiresAt: now + 7200})
+	require.NoError(t, err)
+
+	// The frozen balance is still collected by the recovery, which lifts the freeze.
+	execCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour + time.Second))
+	_, err = srv.ExecuteRecoveryTransfer(execCtx, &types.MsgExecuteRecoveryTransfer{Creator: creator, Id: executed})
+	require.NoError(t, err)
+	require.EqualValues(t, 40, f.bankKeeper.SpendableCoins(execCtx, sdk.MustAccAddressFromBech32(to)).AmountOf(denom).Int64())
+	_, err = activeFreeze(f, execCtx, denom, holder)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	requireUnfrozenEvent(t, execCtx, holder, "recovery_executed")
+
+	cancelled := queue()
+	_, err = srv.FreezeAddress(ctx, &types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, RecoveryId: cancelled, LinkRecovery: true, ExpiresAt: now + 7200})
+	require.NoError(t, err)
+	cancelCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.CancelRecoveryTransfer(cancelCtx, &types.MsgCancelRecoveryTransfer{Creator: creator, Id: cancelled, Reason: "false alarm"})
+	require.NoError(t, err)
+	_, err = activeFreeze(f, cancelCtx, denom, holder)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	requireUnfrozenEvent(t, cancelCtx, holder, "recovery_cancelled")
+
+	// A freeze that was re-issued for another recovery is left alone.
+	first := queue()
+	second := queue()
+	_, err = srv.FreezeAddress(ctx, &types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, RecoveryId: second, LinkRecovery: true, ExpiresAt: now + 7200})
+	require.NoError(t, err)
+	_, err = srv.CancelRecoveryTransfer(ctx, &types.MsgCancelRecoveryTransfer{Creator: creator, Id: first, Reason: "duplicate"})
+	require.NoError(t, err)
+	freeze, err := activeFreeze(f, ctx, denom, holder)
+	require.NoError(t, err)
+	require.Equal(t, second, freeze.RecoveryId)
+}
+
+func TestAddressFreezeExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	holder := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	now := uint64(ctx.BlockTime().Unix())
+	denom := createRecoveryEnabledToken(t, f, srv, ctx, creator, "expiring")
+
+	_, err := srv.FreezeAddress(ctx, &types.MsgFreezeAddress{Creator: creator, Denom: denom, Address: holder, ExpiresAt: now + 600})
+	require.NoError(t, err)
+
+	// Past expiry the freeze no longer applies, even before the end block sweep.
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	_, err = f.keeper.SendRestrictionFn(later, sdk.MustAccAddressFromBech32(holder), sdk.MustAccAddressFromBech32(creator), sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.NoError(t, err)
+	list, err := qs.AddressFreezes(later, &types.QueryAddressFreezesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.AddressFreezes)
+
+	require.NoError(t, f.keeper.ExpireAddressFreezes(later))
+	has, err := f.keeper.AddressFreeze.Has(later, collections.Join(denom, holder))
+	require.NoError(t, err)
+	require.False(t, has)
+	requireUnfrozenEvent(t, later, holder, "expired")
+}
+
+func activeFreeze(f *fixture, ctx sdk.Context, denom, address string) (types.AddressFreeze, error) {
+	resp, err := keeper.NewQueryServerImpl(f.keeper).AddressFreeze(ctx, &types.QueryAddressFreezeRequest{Denom: denom, Address: address})
+	if err != nil {
+		return types.AddressFreeze{}, err
+	}
+	return resp.AddressFreeze, nil
+}
+
+func requireUnfrozenEvent(t *testing.T, ctx sdk.Context, address, reason string) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "loyalty.address_unfrozen" {
+			continue
+		}
+		addr, _ := event.GetAttribute("address")
+		why, _ := event.GetAttribute("reason")
+		if addr.Value == address && why.Value == reason {
+			return
+		}
+	}
+	t.Fatalf("no address_unfrozen event for %s with reason %s", address, reason)
+}
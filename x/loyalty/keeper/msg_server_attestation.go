@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k msgServer) SubmitAttestation(ctx context.Context, msg *types.MsgSubmitAttestation) (*types.MsgSubmitAttestationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleVerifier); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateEvidenceURI(msg.EvidenceUri); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, err.Error())
+	}
+
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.Verifiedtoken.Get(ctx, denom); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "verifiedtoken not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+	if msg.ExpiresAt <= now {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "expires_at must be in the future")
+	}
+
+	attestation := types.Attestation{
+		Denom:       denom,
+		Verifier:    msg.Creator,
+		EvidenceUri: msg.EvidenceUri,
+		ExpiresAt:   msg.ExpiresAt,
+		AttestedAt:  now,
+	}
+	if err := k.setAttestation(ctx, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.recordAttestation(ctx, types.AttestationActionAttested, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	verified, count, err := k.refreshTokenVerification(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.attestation_submitted",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("verifier", msg.Creator),
+			sdk.NewAttribute("evidence_uri", msg.EvidenceUri),
+			sdk.NewAttribute("expires_at", fmt.Sprintf("%d", msg.ExpiresAt)),
+		),
+	)
+
+	return &types.MsgSubmitAttestationResponse{Denom: denom, Verified: verified, AttestationCount: count}, nil
+}
+
+func (k msgServer) RevokeAttestation(ctx context.Context, msg *types.MsgRevokeAttestation) (*types.MsgRevokeAttestationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	// Revocation stays open to a verifier removed from the set so stale vouches can be withdrawn.
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	attestation, err := k.Attestation.Get(ctx, collections.Join(denom, msg.Creator))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrAttestationNotFound, "no attestation by %s for %s", msg.Creator, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.removeAttestation(ctx, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.recordAttestation(ctx, types.AttestationActionRevoked, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	verified, count, err := k.refreshTokenVerification(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.attestation_revoked",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("verifier", msg.Creator),
+		),
+	)
+
+	return &types.MsgRevokeAttestationResponse{Denom: denom, Verified: verified, AttestationCount: count}, nil
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+// setupAttestedToken creates a token owned by the authority and grants the verifier
+// role to two fresh accounts, requiring both to attest.
+func setupAttestedToken(t *testing.T, f *fixture, srv types.MsgServer) (sdk.Context, string, []string) {
+	t.Helper()
+	creator := authorityAddress(t, f)
+	verifiers := []string{sample.AccAddress(), sample.AccAddress()}
+
+	_, err := srv.UpdateAuthorities(f.ctx, &types.MsgUpdateAuthorities{
+		Authority:   creator,
+		Authorities: types.Authorities{Verifiers: verifiers},
+	})
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.NetworkMode = types.NetworkModeLocalnet
+	params.MinVerifierAttestations = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err = srv.CreateVerifiedtoken(f.ctx, baseVerifiedToken(creator, "attested"))
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	return ctx, factoryDenom(creator, "attested"), verifiers
+}
+
+func TestSubmitAttestationReachesThreshold(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx, denom, verifiers := setupAttestedToken(t, f, srv)
+	expiresAt := uint64(ctx.BlockTime().Unix()) + 3600
+
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, token.Verified, "tokens start unverified")
+
+	resp, err := srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     verifiers[0],
+		Denom:       denom,
+		EvidenceUri: "https://verifier.example/a",
+		ExpiresAt:   expiresAt,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Verified)
+	require.EqualValues(t, 1, resp.AttestationCount)
+
+	// Re-attesting refreshes the same verifier's entry rather than adding a second one.
+	resp, err = srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     verifiers[0],
+		Denom:       denom,
+		EvidenceUri: "https://verifier.example/a2",
+		ExpiresAt:   expiresAt + 60,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.AttestationCount)
+
+	resp, err = srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     verifiers[1],
+		Denom:       denom,
+		EvidenceUri: "https://verifier.example/b",
+		ExpiresAt:   expiresAt,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+	require.EqualValues(t, 2, resp.AttestationCount)
+
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, token.Verified)
+
+	// Owner updates cannot drop the verified flag.
+	_, err = srv.UpdateVerifiedtoken(ctx, &types.MsgUpdateVerifiedtoken{
+		Creator:   token.Creator,
+		Denom:     denom,
+		Issuer:    token.Issuer,
+		Name:      "Renamed",
+		Symbol:    token.Symbol,
+		MaxSupply: token.MaxSupply,
+	})
+	require.NoError(t, err)
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, token.Verified)
+}
+
+func TestSubmitAttestationValidation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx, denom, verifiers := setupAttestedToken(t, f, srv)
+	future := uint64(ctx.BlockTime().Unix()) + 3600
+
+	_, err := srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     sample.AccAddress(),
+		Denom:       denom,
+		EvidenceUri: "https://verifier.example/a",
+		ExpiresAt:   future,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     verifiers[0],
+		Denom:       denom,
+		EvidenceUri: "https://verifier.example/a",
+		ExpiresAt:   uint64(ctx.BlockTime().Unix()),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	_, err = srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:   verifiers[0],
+		Denom:     denom,
+		ExpiresAt: future,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	_, err = srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     verifiers[0],
+		Denom:       factoryDenom(verifiers[0], "missing"),
+		EvidenceUri: "https://verifier.example/a",
+		ExpiresAt:   future,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Creator: verifiers[1], Denom: denom})
+	require.ErrorIs(t, err, types.ErrAttestationNotFound)
+}
+
+func TestAttestationRevokeAndExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, denom, verifiers := setupAttestedToken(t, f, srv)
+	now := uint64(ctx.BlockTime().Unix())
+
+	for i, verifier := range verifiers {
+		_, err := srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+			Creator:     verifier,
+			Denom:       denom,
+			EvidenceUri: "https://verifier.example/evidence",
+			ExpiresAt:   now + uint64(i+1)*3600,
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Creator: verifiers[1], Denom: denom})
+	require.NoError(t, err)
+	require.False(t, resp.Verified)
+	require.EqualValues(t, 1, resp.AttestationCount)
+
+	_, err = srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     verifiers[1],
+		Denom:       denom,
+		EvidenceUri: "https://verifier.example/evidence",
+		ExpiresAt:   now + 7200,
+	})
+	require.NoError(t, err)
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, token.Verified)
+
+	// Nothing lapses before the first expiry.
+	require.NoError(t, f.keeper.ExpireAttestations(ctx.WithBlockTime(time.Unix(int64(now)+1800, 0))))
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, token.Verified)
+
+	lapsed := ctx.WithBlockTime(time.Unix(int64(now)+3600, 0)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExpireAttestations(lapsed))
+	token, err = f.keeper.Verifiedtoken.Get(lapsed, denom)
+	require.NoError(t, err)
+	require.False(t, token.Verified)
+
+	var flipped bool
+	for _, event := range lapsed.EventManager().Events() {
+		if event.Type == "loyalty.token_verification_changed" {
+			flipped = true
+		}
+	}
+	require.True(t, flipped)
+
+	current, err := qs.Attestations(lapsed, &types.QueryAttestationsRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, current.Attestations, 1)
+	require.Equal(t, verifiers[1], current.Attestations[0].Verifier)
+	require.False(t, current.Verified)
+	require.EqualValues(t, 1, current.ActiveCount)
+	require.EqualValues(t, 2, current.RequiredCount)
+
+	history, err := qs.AttestationHistory(lapsed, &types.QueryAttestationHistoryRequest{Denom: denom})
+	require.NoError(t, err)
+	actions := make([]string, 0, len(history.Records))
+	for _, record := range history.Records {
+		actions = append(actions, record.Action)
+	}
+	require.Equal(t, []string{
+		types.AttestationActionAttested,
+		types.AttestationActionAttested,
+		types.AttestationActionRevoked,
+		types.AttestationActionAttested,
+		types.AttestationActionExpired,
+	}, actions)
+}
+
+func TestLoweringAttestationThresholdReverifiesTokens(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx, denom, verifiers := setupAttestedToken(t, f, srv)
+
+	_, err := srv.SubmitAttestation(ctx, &types.MsgSubmitAttestation{
+		Creator:     verifiers[0],
+		Denom:       denom,
+		EvidenceUri: "https://verifier.example/a",
+		ExpiresAt:   uint64(ctx.BlockTime().Unix()) + 3600,
+	})
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.NetworkMode = types.NetworkModeLocalnet
+	params.MinVerifierAttestations = 1
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authorityAddress(t, f), Params: params})
+	require.NoError(t, err)
+
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, token.Verified)
+
+	// Deleting the token clears its attestations but keeps the history.
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: token.Creator, Denom: denom})
+	require.NoError(t, err)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	history, err := qs.AttestationHistory(ctx, &types.QueryAttestationHistoryRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 2)
+	require.Equal(t, types.AttestationActionRevoked, history.Records[1].Action)
+}
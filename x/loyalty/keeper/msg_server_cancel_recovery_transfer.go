@@ -49,7 +49,7 @@ func (k msgServer) CancelRecoveryTransfer(ctx context.Context, msg *types.MsgCan
 			return nil, err
 		}
 	}
-	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	isAuthority := k.ensureRole(ctx, msg.Creator, types.RoleRecoveryOverseer) == nil
 	if msg.Creator != token.RecoveryGroupPolicy && !isAuthority {
 		return nil, errorsmod.Wrap(types.ErrRecoveryUnauthorized, "only recovery group policy or authority can cancel recovery")
 	}
@@ -77,6 +77,10 @@ func (k msgServer) CancelRecoveryTransfer(ctx context.Context, msg *types.MsgCan
 		),
 	)
 
+	if err := k.releaseRecoveryFreeze(ctx, op, "recovery_cancelled"); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
 	return &types.MsgCancelRecoveryTransferResponse{
 		Id:          op.Id,
 		Status:      op.Status,
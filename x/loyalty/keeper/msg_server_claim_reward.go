@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"tokenchain/x/loyalty/types"
 
@@ -22,6 +23,9 @@ func (k msgServer) ClaimReward(ctx context.Context, msg *types.MsgClaimReward) (
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
 	}
+	if err := k.ensureNotPaused(ctx, msg.Denom, types.PauseScopeClaim); err != nil {
+		return nil, err
+	}
 
 	key := rewardAccrualKey(msg.Creator, msg.Denom)
 	record, err := k.Rewardaccrual.Get(ctx, key)
@@ -56,10 +60,19 @@ func (k msgServer) ClaimReward(ctx context.Context, msg *types.MsgClaimReward) (
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, coins); err != nil {
 		return nil, err
 	}
-	if err := k.Rewardaccrual.Remove(ctx, key); err != nil {
+	if err := k.removeRewardAccrual(ctx, record); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.reward_claimed",
+			sdk.NewAttribute("denom", msg.Denom),
+			sdk.NewAttribute("address", msg.Creator),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", record.Amount)),
+		),
+	)
+
 	return &types.MsgClaimRewardResponse{
 		Address:       msg.Creator,
 		Denom:         msg.Denom,
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// SetClaimSponsorship sets how the owner of a verified token pays the claim fees of
+// its holders. The owner is the sponsor: allowances are granted from its account.
+// A sponsor can only run one enabled sponsorship, since x/feegrant holds a single
+// allowance per granter and grantee. Disabling the sponsorship revokes the
+// allowances it granted.
+func (k msgServer) SetClaimSponsorship(ctx context.Context, msg *types.MsgSetClaimSponsorship) (*types.MsgSetClaimSponsorshipResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.Creator != token.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner can sponsor claim fees")
+	}
+	if err := k.ensureAdminNotLockedOut(ctx, denom, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sponsorship := types.ClaimSponsorship{
+		Denom:       denom,
+		Sponsor:     msg.Creator,
+		DailyBudget: msg.DailyBudget,
+		PerUserCap:  msg.PerUserCap,
+		MsgTypes:    msg.MsgTypes,
+		Enabled:     msg.Enabled,
+		UpdatedAt:   uint64(sdkCtx.BlockTime().Unix()),
+	}
+	if err := sponsorship.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidClaimSponsorship, err.Error())
+	}
+	if sponsorship.Enabled {
+		other, sponsoring, err := k.sponsoringDenom(ctx, sponsorship.Sponsor, denom)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if sponsoring {
+			return nil, errorsmod.Wrapf(types.ErrInvalidClaimSponsorship, "sponsor already sponsors the claim fees of %s", other)
+		}
+	}
+	current, found, err := k.getClaimSponsorship(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if found && (!sponsorship.Enabled || current.Sponsor != sponsorship.Sponsor) {
+		if err := k.revokeSponsoredAllowances(ctx, current.Sponsor, denom); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+	if err := k.ClaimSponsorship.Set(ctx, denom, sponsorship); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.claim_sponsorship_set",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("sponsor", sponsorship.Sponsor),
+			sdk.NewAttribute("daily_budget", fmt.Sprintf("%d", sponsorship.DailyBudget)),
+			sdk.NewAttribute("per_user_cap", fmt.Sprintf("%d", sponsorship.PerUserCap)),
+			sdk.NewAttribute("enabled", fmt.Sprintf("%t", sponsorship.Enabled)),
+		),
+	)
+
+	return &types.MsgSetClaimSponsorshipResponse{Sponsorship: sponsorship}, nil
+}
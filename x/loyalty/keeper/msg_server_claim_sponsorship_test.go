@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestClaimSponsorship(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	authority := authorityAddress(t, f)
+	owner, denom := createMerchantToken(t, f, srv, ctx, "sponsored")
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	holders := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	accrue := func(ctx sdk.Context, address string) {
+		t.Helper()
+		_, err := srv.RecordRewardAccrual(ctx, &types.MsgRecordRewardAccrual{Creator: authority, Address: address, Denom: denom, Amount: 5})
+		require.NoError(t, err)
+	}
+	hasAllowance := func(address string) bool {
+		_, ok := f.feegrantKeeper.allowances[feeGrantKey(ownerAddr, sdk.MustAccAddressFromBech32(address))]
+		return ok
+	}
+
+	// Only the owner sponsors the claims of its token.
+	set := types.MsgSetClaimSponsorship{Creator: authority, Denom: denom, DailyBudget: 250, PerUserCap: 100, Enabled: true}
+	_, err := srv.SetClaimSponsorship(ctx, &set)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	set.Creator, set.PerUserCap = owner, 0
+	_, err = srv.SetClaimSponsorship(ctx, &set)
+	require.ErrorIs(t, err, types.ErrInvalidClaimSponsorship)
+	set.PerUserCap = 100
+	_, err = srv.SetClaimSponsorship(ctx, &set)
+	require.NoError(t, err)
+
+	// A new address gets an allowance scoped to reward claims; a second accrual
+	// does not grant another one.
+	accrue(ctx, holders[0])
+	accrue(ctx, holders[0])
+	allowance, ok := f.feegrantKeeper.allowances[feeGrantKey(ownerAddr, sdk.MustAccAddressFromBech32(holders[0]))].(*feegrant.AllowedMsgAllowance)
+	require.True(t, ok)
+	require.Equal(t, types.DefaultClaimSponsorshipMsgTypes, allowance.AllowedMessages)
+	res, err := qs.ClaimSponsorship(ctx, &types.QueryClaimSponsorshipRequest{Denom: denom, Address: holders[0]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(250), res.RemainingBudget)
+	require.Equal(t, uint64(1), res.Usage.AllowancesGranted)
+	require.Equal(t, uint64(100), res.RemainingAllowance)
+	require.Equal(t, uint64(ctx.BlockTime().Add(types.ClaimSponsorshipAllowancePeriod).Unix()), res.Allowance.ExpiresAt)
+
+	// Allowances the owner granted outside of the sponsorship are left alone.
+	foreign := &feegrant.BasicAllowance{}
+	f.feegrantKeeper.allowances[feeGrantKey(ownerAddr, sdk.MustAccAddressFromBech32(holders[1]))] = foreign
+	accrue(ctx, holders[1])
+	require.Same(t, foreign, f.feegrantKeeper.allowances[feeGrantKey(ownerAddr, sdk.MustAccAddressFromBech32(holders[1]))])
+
+	// Fees paid through the allowances count against the daily budget, which also
+	// bounds new allowances.
+	require.NoError(t, f.keeper.RecordSponsoredFee(ctx, ownerAddr, sdk.MustAccAddressFromBech32(holders[0]), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 180))))
+	accrue(ctx, holders[2])
+	res, err = qs.ClaimSponsorship(ctx, &types.QueryClaimSponsorshipRequest{Denom: denom, Address: holders[2]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(70), res.RemainingBudget)
+	require.Equal(t, uint64(70), res.Allowance.SpendLimit)
+
+	// Spending the budget refuses further fees at once and revokes the allowances
+	// in EndBlock until the next day.
+	require.NoError(t, f.keeper.RecordSponsoredFee(ctx, ownerAddr, sdk.MustAccAddressFromBech32(holders[2]), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 70))))
+	require.True(t, hasAllowance(holders[0]))
+	err = f.keeper.RecordSponsoredFee(ctx, ownerAddr, sdk.MustAccAddressFromBech32(holders[0]), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.ErrorIs(t, err, types.ErrFeeNotSponsored)
+	require.NoError(t, f.keeper.RevokeSpentClaimSponsorships(ctx))
+	require.False(t, hasAllowance(holders[0]))
+	require.False(t, hasAllowance(holders[2]))
+	require.True(t, hasAllowance(holders[1]))
+	accrue(ctx, holders[3])
+	require.False(t, hasAllowance(holders[3]))
+	res, err = qs.ClaimSponsorship(ctx, &types.QueryClaimSponsorshipRequest{Denom: denom, Address: holders[0]})
+	require.NoError(t, err)
+	require.True(t, res.Usage.Exhausted)
+	require.Zero(t, res.RemainingBudget)
+	require.Nil(t, res.Allowance)
+
+	nextDay := ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	newcomer := sample.AccAddress()
+	accrue(nextDay, newcomer)
+	require.True(t, hasAllowance(newcomer))
+
+	// Disabling the sponsorship revokes its allowances.
+	set.Enabled = false
+	_, err = srv.SetClaimSponsorship(nextDay, &set)
+	require.NoError(t, err)
+	require.False(t, hasAllowance(newcomer))
+	res, err = qs.ClaimSponsorship(nextDay, &types.QueryClaimSponsorshipRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Zero(t, res.RemainingBudget)
+}
+
+func TestClaimSponsorshipOneDenomPerSponsor(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	owner, shop := createMerchantToken(t, f, srv, ctx, "shop")
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(owner, "cafe"))
+	require.NoError(t, err)
+	cafe := factoryDenom(owner, "cafe")
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	holder := sample.AccAddress()
+	holderAddr := sdk.MustAccAddressFromBech32(holder)
+
+	set := types.MsgSetClaimSponsorship{Creator: owner, Denom: shop, DailyBudget: 250, PerUserCap: 100, Enabled: true}
+	_, err = srv.SetClaimSponsorship(ctx, &set)
+	require.NoError(t, err)
+
+	// The owner's account holds one allowance per holder, so it cannot sponsor
+	// the claims of both tokens.
+	set.Denom = cafe
+	_, err = srv.SetClaimSponsorship(ctx, &set)
+	require.ErrorIs(t, err, types.ErrInvalidClaimSponsorship)
+	set.Enabled = false
+	_, err = srv.SetClaimSponsorship(ctx, &set)
+	require.NoError(t, err)
+
+	// An allowance granted for one token is not taken over by the other, so
+	// fees keep counting against the token that granted it.
+	require.NoError(t, f.keeper.SponsoredAllowance.Set(ctx, collections.Join(owner, holder), types.SponsoredAllowance{Sponsor: owner, Grantee: holder, Denom: cafe}))
+	_, err = srv.RecordRewardAccrual(ctx, &types.MsgRecordRewardAccrual{Creator: authorityAddress(t, f), Address: holder, Denom: shop, Amount: 5})
+	require.NoError(t, err)
+	require.NotContains(t, f.feegrantKeeper.allowances, feeGrantKey(ownerAddr, holderAddr))
+	allowance, err := f.keeper.SponsoredAllowance.Get(ctx, collections.Join(owner, holder))
+	require.NoError(t, err)
+	require.Equal(t, cafe, allowance.Denom)
+}
+
+func TestRevokeSpentClaimSponsorshipsIsBounded(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	owner, denom := createMerchantToken(t, f, srv, ctx, "popular")
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	_, err := srv.SetClaimSponsorship(ctx, &types.MsgSetClaimSponsorship{Creator: owner, Denom: denom, DailyBudget: 100, PerUserCap: 1, Enabled: true})
+	require.NoError(t, err)
+
+	var holders []sdk.AccAddress
+	for range 600 {
+		holder := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		holders = append(holders, holder)
+		require.NoError(t, f.keeper.SponsoredAllowance.Set(ctx, collections.Join(owner, holder.String()), types.SponsoredAllowance{Sponsor: owner, Grantee: holder.String(), Denom: denom, SpendLimit: 1}))
+		f.feegrantKeeper.allowances[feeGrantKey(ownerAddr, holder)] = &feegrant.BasicAllowance{}
+	}
+
+	// Spending the budget in the ante handler only queues the sponsorship.
+	require.NoError(t, f.keeper.RecordSponsoredFee(ctx, ownerAddr, holders[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+	require.Len(t, f.feegrantKeeper.allowances, 600)
+
+	// EndBlock revokes a bounded batch per block until none are left.
+	require.NoError(t, f.keeper.RevokeSpentClaimSponsorships(ctx))
+	require.Len(t, f.feegrantKeeper.allowances, 100)
+	has, err := f.keeper.ClaimSponsorshipRevocation.Has(ctx, collections.Join(denom, owner))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, f.keeper.RevokeSpentClaimSponsorships(ctx))
+	require.Empty(t, f.feegrantKeeper.allowances)
+	has, err = f.keeper.ClaimSponsorshipRevocation.Has(ctx, collections.Join(denom, owner))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDeleteVerifiedtokenRevokesClaimSponsorship(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	owner, denom := createMerchantToken(t, f, srv, ctx, "retired")
+	holder := sample.AccAddress()
+	grantKey := feeGrantKey(sdk.MustAccAddressFromBech32(owner), sdk.MustAccAddressFromBech32(holder))
+
+	_, err := srv.SetClaimSponsorship(ctx, &types.MsgSetClaimSponsorship{Creator: owner, Denom: denom, DailyBudget: 250, PerUserCap: 100, Enabled: true})
+	require.NoError(t, err)
+	_, err = srv.RecordRewardAccrual(ctx, &types.MsgRecordRewardAccrual{Creator: authorityAddress(t, f), Address: holder, Denom: denom, Amount: 5})
+	require.NoError(t, err)
+	require.Contains(t, f.feegrantKeeper.allowances, grantKey)
+
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: owner, Denom: denom})
+	require.NoError(t, err)
+
+	// The owner's fee grants do not outlive the token.
+	require.NotContains(t, f.feegrantKeeper.allowances, grantKey)
+	has, err := f.keeper.SponsoredAllowance.Has(ctx, collections.Join(owner, holder))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ClaimSponsorship.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ClaimSponsorshipUsage.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
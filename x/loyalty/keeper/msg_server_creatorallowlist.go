@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -19,22 +20,26 @@ func (k msgServer) CreateCreatorallowlist(ctx context.Context, msg *types.MsgCre
 	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid allowlist address: %s", err))
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAllowlistAdmin); err != nil {
 		return nil, err
 	}
 
-	// Check if the value already exists
-	ok, err := k.Creatorallowlist.Has(ctx, msg.Address)
-	if err != nil {
+	// Check if the value already exists. An inactive entry, such as one disabled at
+	// expiry, may be replaced; the address's usage is tracked separately and carries over.
+	existing, err := k.Creatorallowlist.Get(ctx, msg.Address)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
-	} else if ok {
+	} else if err == nil && existing.Enabled && !existing.IsExpired(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
 	}
 
 	var creatorallowlist = types.Creatorallowlist{
-		Creator: msg.Creator,
-		Address: msg.Address,
-		Enabled: msg.Enabled,
+		Creator:        msg.Creator,
+		Address:        msg.Address,
+		Enabled:        msg.Enabled,
+		ExpiresAt:      msg.ExpiresAt,
+		MaxTokens:      msg.MaxTokens,
+		MaxTotalSupply: msg.MaxTotalSupply,
 	}
 
 	if err := k.Creatorallowlist.Set(ctx, creatorallowlist.Address, creatorallowlist); err != nil {
@@ -51,7 +56,7 @@ func (k msgServer) UpdateCreatorallowlist(ctx context.Context, msg *types.MsgUpd
 	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid allowlist address: %s", err))
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAllowlistAdmin); err != nil {
 		return nil, err
 	}
 
@@ -66,9 +71,12 @@ func (k msgServer) UpdateCreatorallowlist(ctx context.Context, msg *types.MsgUpd
 	}
 
 	var creatorallowlist = types.Creatorallowlist{
-		Creator: val.Creator,
-		Address: msg.Address,
-		Enabled: msg.Enabled,
+		Creator:        val.Creator,
+		Address:        msg.Address,
+		Enabled:        msg.Enabled,
+		ExpiresAt:      msg.ExpiresAt,
+		MaxTokens:      msg.MaxTokens,
+		MaxTotalSupply: msg.MaxTotalSupply,
 	}
 
 	if err := k.Creatorallowlist.Set(ctx, creatorallowlist.Address, creatorallowlist); err != nil {
@@ -85,7 +93,7 @@ func (k msgServer) DeleteCreatorallowlist(ctx context.Context, msg *types.MsgDel
 	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid allowlist address: %s", err))
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAllowlistAdmin); err != nil {
 		return nil, err
 	}
 
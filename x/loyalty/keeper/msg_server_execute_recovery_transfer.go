@@ -45,7 +45,10 @@ func (k msgServer) ExecuteRecoveryTransfer(ctx context.Context, msg *types.MsgEx
 	if !token.SeizureOptIn {
 		return nil, errorsmod.Wrap(types.ErrRecoveryPolicy, "token recovery is disabled")
 	}
-	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	if err := k.ensureNotPaused(ctx, op.Denom, types.PauseScopeTransfer); err != nil {
+		return nil, err
+	}
+	isAuthority := k.ensureRole(ctx, msg.Creator, types.RoleRecoveryOverseer) == nil
 	if msg.Creator != token.RecoveryGroupPolicy && !isAuthority {
 		return nil, errorsmod.Wrap(types.ErrRecoveryUnauthorized, "only recovery group policy or authority can execute recovery")
 	}
@@ -92,6 +95,10 @@ func (k msgServer) ExecuteRecoveryTransfer(ctx context.Context, msg *types.MsgEx
 		),
 	)
 
+	if err := k.releaseRecoveryFreeze(ctx, op, "recovery_executed"); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
 	return &types.MsgExecuteRecoveryTransferResponse{
 		Id:         op.Id,
 		Status:     op.Status,
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// SetFeeToken sets the rate, cap and message types at which a verified token pays
+// transaction fees. Only the module authority may enable or disable a fee token;
+// the token owner may retune one that is enabled.
+func (k msgServer) SetFeeToken(ctx context.Context, msg *types.MsgSetFeeToken) (*types.MsgSetFeeTokenResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	token, err := k.feeTokenOwnerOrAuthority(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	current, found, err := k.getFeeToken(ctx, token.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if k.ensureAuthority(msg.Creator) != nil {
+		if !found || !current.Enabled || !msg.Enabled {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the module authority can enable or disable a fee token")
+		}
+		if token.AdminRenounced {
+			return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced")
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	feeToken := types.FeeToken{
+		Denom:          token.Denom,
+		TokenUnits:     msg.TokenUnits,
+		UtokenUnits:    msg.UtokenUnits,
+		MaxUtokenPerTx: msg.MaxUtokenPerTx,
+		MsgTypes:       msg.MsgTypes,
+		Enabled:        msg.Enabled,
+		UpdatedBy:      msg.Creator,
+		UpdatedAt:      uint64(sdkCtx.BlockTime().Unix()),
+	}
+	if err := feeToken.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidFeeToken, err.Error())
+	}
+	if err := k.FeeToken.Set(ctx, feeToken.Denom, feeToken); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.fee_token_set",
+			sdk.NewAttribute("denom", feeToken.Denom),
+			sdk.NewAttribute("token_units", fmt.Sprintf("%d", feeToken.TokenUnits)),
+			sdk.NewAttribute("utoken_units", fmt.Sprintf("%d", feeToken.UtokenUnits)),
+			sdk.NewAttribute("max_utoken_per_tx", fmt.Sprintf("%d", feeToken.MaxUtokenPerTx)),
+			sdk.NewAttribute("enabled", fmt.Sprintf("%t", feeToken.Enabled)),
+			sdk.NewAttribute("updated_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgSetFeeTokenResponse{FeeToken: feeToken}, nil
+}
+
+// FundGasPool adds staking denom to the gas pool of a verified token, including
+// before the token is enabled as a fee token. Only the token owner or the module
+// authority may fund a pool, since only they can withdraw from it.
+func (k msgServer) FundGasPool(ctx context.Context, msg *types.MsgFundGasPool) (*types.MsgFundGasPoolResponse, error) {
+	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	token, err := k.feeTokenOwnerOrAuthority(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	denom := token.Denom
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than zero")
+	}
+	pool, err := k.getGasPool(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if pool.Balance+msg.Amount < pool.Balance {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gas pool balance overflows")
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(msg.Amount)))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.GasPoolName, coins); err != nil {
+		return nil, err
+	}
+	pool.Balance += msg.Amount
+	if err := k.GasPool.Set(ctx, denom, pool); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.gas_pool_funded",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("funder", msg.Creator),
+			sdk.NewAttribute("amount", coins.String()),
+			sdk.NewAttribute("balance", fmt.Sprintf("%d", pool.Balance)),
+		),
+	)
+
+	return &types.MsgFundGasPoolResponse{GasPool: pool}, nil
+}
+
+// WithdrawGasPool pays staking denom out of the gas pool of a token to recipient.
+func (k msgServer) WithdrawGasPool(ctx context.Context, msg *types.MsgWithdrawGasPool) (*types.MsgWithdrawGasPoolResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	recipient := msg.Recipient
+	if recipient == "" {
+		recipient = msg.Creator
+	}
+	recipientAddr, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+	}
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+	token, err := k.feeTokenOwnerOrAuthority(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than zero")
+	}
+	pool, err := k.getGasPool(ctx, token.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.Amount > pool.Balance {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "gas pool of %s holds %d", token.Denom, pool.Balance)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(msg.Amount)))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.GasPoolName, recipientAddr, coins); err != nil {
+		return nil, err
+	}
+	pool.Balance -= msg.Amount
+	if err := k.GasPool.Set(ctx, token.Denom, pool); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.gas_pool_withdrawn",
+			sdk.NewAttribute("denom", token.Denom),
+			sdk.NewAttribute("recipient", recipient),
+			sdk.NewAttribute("amount", coins.String()),
+			sdk.NewAttribute("balance", fmt.Sprintf("%d", pool.Balance)),
+		),
+	)
+
+	return &types.MsgWithdrawGasPoolResponse{GasPool: pool}, nil
+}
+
+// feeTokenOwnerOrAuthority returns the verified token of denom when signer is
+// its owner or the module authority.
+func (k msgServer) feeTokenOwnerOrAuthority(ctx context.Context, signer, denom string) (types.Verifiedtoken, error) {
+	denom, err := k.resolveStoredDenom(denom)
+	if err != nil {
+		return types.Verifiedtoken{}, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Verifiedtoken{}, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return types.Verifiedtoken{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if signer != token.Creator && k.ensureAuthority(signer) != nil {
+		return types.Verifiedtoken{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or authority can manage its fees")
+	}
+	if err := k.ensureAdminNotLockedOut(ctx, denom, signer); err != nil {
+		return types.Verifiedtoken{}, err
+	}
+	return token, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestFeeSponsorship(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	authority := authorityAddress(t, f)
+	owner, denom := createMerchantToken(t, f, srv, ctx, "feecoin")
+	holder, funder := sample.AccAddress(), sample.AccAddress()
+	f.bankKeeper.accountBalances[holder] = sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000))
+	f.bankKeeper.accountBalances[funder] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000))
+	f.bankKeeper.accountBalances[owner] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	send := []sdk.Msg{&banktypes.MsgSend{}}
+	fee := sdk.NewInt64Coin(denom, 100)
+
+	// Only the authority can turn a token into a fee token.
+	setFeeToken := types.MsgSetFeeToken{Creator: owner, Denom: denom, TokenUnits: 10, UtokenUnits: 3, Enabled: true}
+	_, err := srv.SetFeeToken(ctx, &setFeeToken)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetFeeToken(ctx, &types.MsgSetFeeToken{Creator: authority, Denom: denom, Enabled: true})
+	require.ErrorIs(t, err, types.ErrInvalidFeeToken)
+	setFeeToken.Creator = authority
+	_, err = srv.SetFeeToken(ctx, &setFeeToken)
+	require.NoError(t, err)
+	require.True(t, f.keeper.IsFeeToken(ctx, denom))
+
+	// The gas pool must cover the fee.
+	_, err = f.keeper.SponsoredFee(ctx, fee, send)
+	require.ErrorIs(t, err, types.ErrFeeNotSponsored)
+	// Third parties cannot fund the pool, since only the owner could take it back out.
+	_, err = srv.FundGasPool(ctx, &types.MsgFundGasPool{Creator: funder, Denom: denom, Amount: 1_000})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, sdkmath.NewInt(10_000), f.bankKeeper.accountBalances[funder].AmountOf(sdk.DefaultBondDenom))
+	_, err = srv.FundGasPool(ctx, &types.MsgFundGasPool{Creator: owner, Denom: denom, Amount: 1_000})
+	require.NoError(t, err)
+
+	sponsored, err := f.keeper.SponsoredFee(ctx, fee, send)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 30), sponsored)
+	_, err = f.keeper.SponsoredFee(ctx, fee, []sdk.Msg{&types.MsgCreateVerifiedtoken{}})
+	require.ErrorIs(t, err, types.ErrFeeNotSponsored)
+	_, err = f.keeper.SponsoredFee(ctx, sdk.NewInt64Coin(denom, 3), send)
+	require.ErrorIs(t, err, types.ErrFeeNotSponsored)
+
+	holderAddr := sdk.MustAccAddressFromBech32(holder)
+	require.NoError(t, f.keeper.SponsorTxFee(ctx, holderAddr, fee, sponsored))
+	require.Equal(t, sdkmath.NewInt(900), f.bankKeeper.accountBalances[holder].AmountOf(denom))
+	require.Equal(t, sdkmath.NewInt(100), f.bankKeeper.accountBalances[owner].AmountOf(denom))
+	require.Equal(t, sdkmath.NewInt(30), f.bankKeeper.moduleBalances[authtypes.FeeCollectorName].AmountOf(sdk.DefaultBondDenom))
+	res, err := qs.FeeToken(ctx, &types.QueryFeeTokenRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, types.GasPool{Denom: denom, Balance: 970, Sponsored: 30, SponsoredTxs: 1}, res.GasPool)
+
+	// The owner may retune an enabled fee token but not disable it.
+	setFeeToken.Creator, setFeeToken.MaxUtokenPerTx = owner, 20
+	_, err = srv.SetFeeToken(ctx, &setFeeToken)
+	require.NoError(t, err)
+	_, err = f.keeper.SponsoredFee(ctx, fee, send)
+	require.ErrorIs(t, err, types.ErrFeeNotSponsored)
+	setFeeToken.Enabled = false
+	_, err = srv.SetFeeToken(ctx, &setFeeToken)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetFeeToken(ctx, &types.MsgSetFeeToken{Creator: sample.AccAddress(), Denom: denom, TokenUnits: 1, UtokenUnits: 1, Enabled: true})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Only the owner or the authority can withdraw from the pool.
+	_, err = srv.WithdrawGasPool(ctx, &types.MsgWithdrawGasPool{Creator: funder, Denom: denom, Amount: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.WithdrawGasPool(ctx, &types.MsgWithdrawGasPool{Creator: owner, Denom: denom, Amount: 971})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	withdrawn, err := srv.WithdrawGasPool(ctx, &types.MsgWithdrawGasPool{Creator: owner, Denom: denom, Amount: 970})
+	require.NoError(t, err)
+	require.Zero(t, withdrawn.GasPool.Balance)
+	require.Equal(t, sdkmath.NewInt(970), f.bankKeeper.accountBalances[owner].AmountOf(sdk.DefaultBondDenom))
+
+	setFeeToken.Creator = authority
+	_, err = srv.SetFeeToken(ctx, &setFeeToken)
+	require.NoError(t, err)
+	require.False(t, f.keeper.IsFeeToken(ctx, denom))
+	list, err := qs.FeeTokens(ctx, &types.QueryFeeTokensRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.FeeTokens, 1)
+}
+
+func TestDeleteVerifiedtokenWithGasPool(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	owner, denom := createMerchantToken(t, f, srv, ctx, "pooled")
+	f.bankKeeper.accountBalances[owner] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+
+	_, err := srv.SetFeeToken(ctx, &types.MsgSetFeeToken{Creator: authorityAddress(t, f), Denom: denom, TokenUnits: 10, UtokenUnits: 3, Enabled: true})
+	require.NoError(t, err)
+	_, err = srv.FundGasPool(ctx, &types.MsgFundGasPool{Creator: owner, Denom: denom, Amount: 500})
+	require.NoError(t, err)
+
+	// The pool would be stranded in the module account once the token is gone.
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: owner, Denom: denom})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.WithdrawGasPool(ctx, &types.MsgWithdrawGasPool{Creator: owner, Denom: denom, Amount: 500})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000), f.bankKeeper.accountBalances[owner].AmountOf(sdk.DefaultBondDenom))
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: owner, Denom: denom})
+	require.NoError(t, err)
+
+	// A token registered again under the denom does not inherit the fee settings.
+	require.False(t, f.keeper.IsFeeToken(ctx, denom))
+	has, err := f.keeper.FeeToken.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.GasPool.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -20,10 +20,18 @@ func (k msgServer) FundRewardPool(ctx context.Context, msg *types.MsgFundRewardP
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
 	}
+	if err := k.ensureNotPaused(ctx, msg.Denom, types.PauseScopeClaim); err != nil {
+		return nil, err
+	}
 	if msg.Amount == 0 {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than zero")
 	}
 
+	// Sends to the module account skip the send restriction, so check the freeze here.
+	if err := k.ensureNotFrozen(ctx, msg.Denom, msg.Creator); err != nil {
+		return nil, err
+	}
+
 	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, sdkmath.NewIntFromUint64(msg.Amount)))
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, coins); err != nil {
 		return nil, err
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k msgServer) SetTokenIBCPolicy(ctx context.Context, msg *types.MsgSetTokenIBCPolicy) (*types.MsgSetTokenIBCPolicyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	if msg.Creator != token.Creator && !isAuthority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or authority can set the ibc policy")
+	}
+	if err := k.ensureAdminNotLockedOut(ctx, denom, msg.Creator); err != nil {
+		return nil, err
+	}
+	if token.AdminRenounced && !isAuthority {
+		return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced")
+	}
+
+	mode := strings.TrimSpace(msg.Mode)
+	channels, err := types.NormalizeIBCPolicyChannels(mode, msg.AllowedChannels)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidIBCPolicy, err.Error())
+	}
+	// Recovery cannot reach tokens on other chains, so a recovery-enabled token may
+	// only leave over channels someone has vetted.
+	if token.SeizureOptIn && mode == types.IBCPolicyAllowed {
+		return nil, errorsmod.Wrap(types.ErrInvalidIBCPolicy, "recovery-enabled tokens can only be sent over allowlisted channels")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	policy := types.TokenIBCPolicy{
+		Denom:           denom,
+		Mode:            mode,
+		AllowedChannels: channels,
+		UpdatedBy:       msg.Creator,
+		UpdatedAt:       uint64(sdkCtx.BlockTime().Unix()),
+	}
+	if err := k.TokenIBCPolicy.Set(ctx, denom, policy); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.token_ibc_policy_updated",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("mode", policy.Mode),
+			sdk.NewAttribute("allowed_channels", strings.Join(policy.AllowedChannels, ",")),
+			sdk.NewAttribute("updated_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgSetTokenIBCPolicyResponse{Policy: policy}, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestSetTokenIBCPolicy(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	merchant, denom := createMerchantToken(t, f, srv, ctx, "travelling")
+	recoveryDenom := createRecoveryEnabledToken(t, f, srv, ctx, authority, "homebound")
+
+	res, err := qs.TokenIBCPolicy(ctx, &types.QueryTokenIBCPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.True(t, res.IsDefault)
+	require.Equal(t, types.IBCPolicyAllowed, res.Policy.Mode)
+	res, err = qs.TokenIBCPolicy(ctx, &types.QueryTokenIBCPolicyRequest{Denom: recoveryDenom})
+	require.NoError(t, err)
+	require.True(t, res.IsDefault)
+	require.Equal(t, types.IBCPolicyBlocked, res.Policy.Mode)
+	require.NoError(t, f.keeper.CheckIBCTransfer(ctx, denom, "channel-0"))
+	require.ErrorIs(t, f.keeper.CheckIBCTransfer(ctx, recoveryDenom, "channel-0"), types.ErrIBCTransferBlocked)
+
+	set := func(msg types.MsgSetTokenIBCPolicy) error {
+		_, err := srv.SetTokenIBCPolicy(ctx, &msg)
+		return err
+	}
+	require.ErrorIs(t, set(types.MsgSetTokenIBCPolicy{Creator: sample.AccAddress(), Denom: denom, Mode: types.IBCPolicyBlocked}), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, set(types.MsgSetTokenIBCPolicy{Creator: merchant, Denom: denom, Mode: "sometimes"}), types.ErrInvalidIBCPolicy)
+	require.ErrorIs(t, set(types.MsgSetTokenIBCPolicy{Creator: merchant, Denom: denom, Mode: types.IBCPolicyAllowlisted}), types.ErrInvalidIBCPolicy)
+	require.ErrorIs(t, set(types.MsgSetTokenIBCPolicy{Creator: merchant, Denom: denom, Mode: types.IBCPolicyBlocked, AllowedChannels: []string{"channel-0"}}), types.ErrInvalidIBCPolicy)
+	require.ErrorIs(t, set(types.MsgSetTokenIBCPolicy{Creator: merchant, Denom: denom, Mode: types.IBCPolicyAllowlisted, AllowedChannels: []string{"not a channel"}}), types.ErrInvalidIBCPolicy)
+	require.ErrorIs(t, set(types.MsgSetTokenIBCPolicy{Creator: authority, Denom: recoveryDenom, Mode: types.IBCPolicyAllowed}), types.ErrInvalidIBCPolicy)
+
+	require.NoError(t, set(types.MsgSetTokenIBCPolicy{Creator: merchant, Denom: denom, Mode: types.IBCPolicyAllowlisted, AllowedChannels: []string{"channel-7", "channel-2", "channel-7"}}))
+	res, err = qs.TokenIBCPolicy(ctx, &types.QueryTokenIBCPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.False(t, res.IsDefault)
+	require.Equal(t, []string{"channel-2", "channel-7"}, res.Policy.AllowedChannels)
+	require.Equal(t, merchant, res.Policy.UpdatedBy)
+	require.NoError(t, f.keeper.CheckIBCTransfer(ctx, denom, "channel-7"))
+	require.ErrorIs(t, f.keeper.CheckIBCTransfer(ctx, denom, "channel-0"), types.ErrIBCTransferBlocked)
+	// Denoms the module does not govern pass through untouched.
+	require.NoError(t, f.keeper.CheckIBCTransfer(ctx, "stake", "channel-0"))
+
+	// An allowlist admin may change any token's policy, including after the owner renounced.
+	_, err = srv.RenounceTokenAdmin(ctx, &types.MsgRenounceTokenAdmin{Creator: merchant, Denom: denom})
+	require.NoError(t, err)
+	require.ErrorIs(t, set(types.MsgSetTokenIBCPolicy{Creator: merchant, Denom: denom, Mode: types.IBCPolicyAllowed}), types.ErrAdminRenounced)
+	require.NoError(t, set(types.MsgSetTokenIBCPolicy{Creator: authority, Denom: denom, Mode: types.IBCPolicyBlocked}))
+	require.ErrorIs(t, f.keeper.CheckIBCTransfer(ctx, denom, "channel-7"), types.ErrIBCTransferBlocked)
+
+	_, err = qs.TokenIBCPolicy(ctx, &types.QueryTokenIBCPolicyRequest{Denom: factoryDenom(merchant, "missing")})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestIBCEscrowAccounting(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	_, denom := createMerchantToken(t, f, srv, ctx, "escrowed")
+
+	require.NoError(t, f.keeper.RecordIBCEscrow(ctx, denom, sdkmath.NewInt(30)))
+	require.NoError(t, f.keeper.RecordIBCEscrow(ctx, "stake", sdkmath.NewInt(30)))
+	require.NoError(t, f.keeper.ReleaseIBCEscrow(ctx, denom, sdkmath.NewInt(10)))
+	res, err := qs.TokenIBCPolicy(ctx, &types.QueryTokenIBCPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.EqualValues(t, 20, res.Escrowed)
+
+	// Releasing more than is recorded clears the entry rather than failing the packet.
+	require.NoError(t, f.keeper.ReleaseIBCEscrow(ctx, denom, sdkmath.NewInt(50)))
+	has, err := f.keeper.IBCEscrow.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.IBCEscrow.Has(ctx, "stake")
+	require.NoError(t, err)
+	require.False(t, has)
+}
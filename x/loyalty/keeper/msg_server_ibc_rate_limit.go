@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// SetIBCRateLimit adds or replaces the quotas of a denom on a channel. The flow of
+// the current window is kept, so tightening a quota takes effect at once.
+func (k msgServer) SetIBCRateLimit(ctx context.Context, msg *types.MsgSetIBCRateLimit) (*types.MsgSetIBCRateLimitResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Authority); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid authority address: %s", err))
+	}
+	if err := k.ensureAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	limit := types.IBCRateLimit{
+		Denom:         msg.Denom,
+		ChannelId:     msg.ChannelId,
+		MaxOutflow:    msg.MaxOutflow,
+		MaxInflow:     msg.MaxInflow,
+		WindowSeconds: msg.WindowSeconds,
+		UpdatedBy:     msg.Authority,
+		UpdatedAt:     uint64(sdkCtx.BlockTime().Unix()),
+	}
+	if err := limit.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidIBCRateLimit, err.Error())
+	}
+	if err := k.IBCRateLimit.Set(ctx, collections.Join(limit.Denom, limit.ChannelId), limit); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.ibc_rate_limit_set",
+			sdk.NewAttribute("denom", limit.Denom),
+			sdk.NewAttribute("channel_id", limit.ChannelId),
+			sdk.NewAttribute("max_outflow", fmt.Sprintf("%d", limit.MaxOutflow)),
+			sdk.NewAttribute("max_inflow", fmt.Sprintf("%d", limit.MaxInflow)),
+			sdk.NewAttribute("window_seconds", fmt.Sprintf("%d", limit.WindowSeconds)),
+		),
+	)
+
+	return &types.MsgSetIBCRateLimitResponse{RateLimit: limit}, nil
+}
+
+func (k msgServer) RemoveIBCRateLimit(ctx context.Context, msg *types.MsgRemoveIBCRateLimit) (*types.MsgRemoveIBCRateLimitResponse, error) {
+	key, err := k.authorizeIBCRateLimitChange(ctx, msg.Authority, msg.Denom, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.IBCRateLimit.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.IBCRateLimitFlow.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.ibc_rate_limit_removed",
+			sdk.NewAttribute("denom", msg.Denom),
+			sdk.NewAttribute("channel_id", msg.ChannelId),
+		),
+	)
+
+	return &types.MsgRemoveIBCRateLimitResponse{}, nil
+}
+
+func (k msgServer) ResetIBCRateLimit(ctx context.Context, msg *types.MsgResetIBCRateLimit) (*types.MsgResetIBCRateLimitResponse, error) {
+	key, err := k.authorizeIBCRateLimitChange(ctx, msg.Authority, msg.Denom, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	flow := types.IBCRateLimitFlow{
+		Denom:       msg.Denom,
+		ChannelId:   msg.ChannelId,
+		WindowStart: uint64(sdkCtx.BlockTime().Unix()),
+	}
+	if err := k.IBCRateLimitFlow.Set(ctx, key, flow); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.ibc_rate_limit_reset",
+			sdk.NewAttribute("denom", msg.Denom),
+			sdk.NewAttribute("channel_id", msg.ChannelId),
+		),
+	)
+
+	return &types.MsgResetIBCRateLimitResponse{}, nil
+}
+
+// authorizeIBCRateLimitChange checks that authority may change the existing rate
+// limit of denom on channel and returns its key.
+func (k msgServer) authorizeIBCRateLimitChange(ctx context.Context, authority, denom, channel string) (collections.Pair[string, string], error) {
+	key := collections.Join(denom, channel)
+	if _, err := k.addressCodec.StringToBytes(authority); err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid authority address: %s", err))
+	}
+	if err := k.ensureAuthority(authority); err != nil {
+		return key, err
+	}
+	has, err := k.IBCRateLimit.Has(ctx, key)
+	if err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !has {
+		return key, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no ibc rate limit of %s on %s", denom, channel)
+	}
+	return key, nil
+}
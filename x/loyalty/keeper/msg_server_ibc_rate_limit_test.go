@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestIBCRateLimit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	start := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+	const denom, channel = "utoken", "channel-4"
+
+	set := func(msg types.MsgSetIBCRateLimit) error {
+		_, err := srv.SetIBCRateLimit(ctx, &msg)
+		return err
+	}
+	valid := types.MsgSetIBCRateLimit{Authority: authority, Denom: denom, ChannelId: channel, MaxOutflow: 500, MaxInflow: 200, WindowSeconds: 3_600}
+	invalid := func(update func(*types.MsgSetIBCRateLimit)) types.MsgSetIBCRateLimit {
+		msg := valid
+		update(&msg)
+		return msg
+	}
+	require.ErrorIs(t, set(invalid(func(m *types.MsgSetIBCRateLimit) { m.Authority = sample.AccAddress() })), types.ErrInvalidSigner)
+	require.ErrorIs(t, set(invalid(func(m *types.MsgSetIBCRateLimit) {
+		m.Denom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	})), types.ErrInvalidIBCRateLimit)
+	require.ErrorIs(t, set(invalid(func(m *types.MsgSetIBCRateLimit) { m.ChannelId = "not a channel" })), types.ErrInvalidIBCRateLimit)
+	require.ErrorIs(t, set(invalid(func(m *types.MsgSetIBCRateLimit) { m.MaxOutflow, m.MaxInflow = 0, 0 })), types.ErrInvalidIBCRateLimit)
+	require.ErrorIs(t, set(invalid(func(m *types.MsgSetIBCRateLimit) { m.WindowSeconds = 30 })), types.ErrInvalidIBCRateLimit)
+	require.NoError(t, set(valid))
+
+	// Other channels and denoms are not limited.
+	require.NoError(t, f.keeper.ConsumeIBCOutflow(ctx, denom, "channel-5", sdkmath.NewInt(10_000)))
+	require.NoError(t, f.keeper.ConsumeIBCOutflow(ctx, "uother", channel, sdkmath.NewInt(10_000)))
+
+	require.NoError(t, f.keeper.ConsumeIBCOutflow(ctx, denom, channel, sdkmath.NewInt(400)))
+	require.ErrorIs(t, f.keeper.ConsumeIBCOutflow(ctx, denom, channel, sdkmath.NewInt(101)), types.ErrIBCRateLimited)
+	require.NoError(t, f.keeper.ConsumeIBCInflow(ctx, denom, channel, sdkmath.NewInt(200)))
+	require.ErrorIs(t, f.keeper.ConsumeIBCInflow(ctx, denom, channel, sdkmath.NewInt(1)), types.ErrIBCRateLimited)
+
+	// Refunds give the outflow quota back.
+	require.NoError(t, f.keeper.RefundIBCOutflow(ctx, denom, channel, sdkmath.NewInt(150)))
+	res, err := qs.IBCRateLimit(ctx, &types.QueryIBCRateLimitRequest{Denom: denom, ChannelId: channel})
+	require.NoError(t, err)
+	require.EqualValues(t, 250, res.Usage.Flow.Outflow)
+	require.EqualValues(t, 250, res.Usage.RemainingOutflow)
+	require.Zero(t, res.Usage.RemainingInflow)
+	require.Equal(t, uint64(start.Unix())+3_600, res.Usage.WindowEndsAt)
+
+	// The flow starts over in the next window.
+	later := ctx.WithBlockTime(start.Add(time.Hour))
+	res, err = qs.IBCRateLimit(later, &types.QueryIBCRateLimitRequest{Denom: denom, ChannelId: channel})
+	require.NoError(t, err)
+	require.Zero(t, res.Usage.Flow.Outflow)
+	require.EqualValues(t, 500, res.Usage.RemainingOutflow)
+	require.NoError(t, f.keeper.ConsumeIBCInflow(later, denom, channel, sdkmath.NewInt(200)))
+
+	// Gov can clear the flow at once, and lift the limit altogether.
+	_, err = srv.ResetIBCRateLimit(ctx, &types.MsgResetIBCRateLimit{Authority: sample.AccAddress(), Denom: denom, ChannelId: channel})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.ResetIBCRateLimit(ctx, &types.MsgResetIBCRateLimit{Authority: authority, Denom: denom, ChannelId: "channel-5"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.ResetIBCRateLimit(later, &types.MsgResetIBCRateLimit{Authority: authority, Denom: denom, ChannelId: channel})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ConsumeIBCInflow(later, denom, channel, sdkmath.NewInt(200)))
+
+	require.NoError(t, set(types.MsgSetIBCRateLimit{Authority: authority, Denom: denom, ChannelId: "07-tendermint-2", MaxOutflow: 1, WindowSeconds: 60}))
+	list, err := qs.IBCRateLimits(ctx, &types.QueryIBCRateLimitsRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, list.Usages, 2)
+
+	_, err = srv.RemoveIBCRateLimit(ctx, &types.MsgRemoveIBCRateLimit{Authority: authority, Denom: denom, ChannelId: channel})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ConsumeIBCInflow(later, denom, channel, sdkmath.NewInt(10_000)))
+	_, err = qs.IBCRateLimit(ctx, &types.QueryIBCRateLimitRequest{Denom: denom, ChannelId: channel})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// maxIBCRecorderSenderLength bounds the counterparty sender of a recorder entry.
+const maxIBCRecorderSenderLength = 256
+
+// SetIBCRecorder adds or removes a counterparty sender allowed to send loyalty memos
+// over a channel. Recorders act with the powers of an accrual recorder, so only
+// accrual recorders may appoint them.
+func (k msgServer) SetIBCRecorder(ctx context.Context, msg *types.MsgSetIBCRecorder) (*types.MsgSetIBCRecorderResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if !types.IsValidIBCChannelID(msg.ChannelId) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id %q", msg.ChannelId)
+	}
+	sender := strings.TrimSpace(msg.Sender)
+	if sender == "" || sender != msg.Sender || len(sender) > maxIBCRecorderSenderLength {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sender must be 1-%d characters without surrounding spaces", maxIBCRecorderSenderLength)
+	}
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAccrualRecorder); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	key := collections.Join(msg.ChannelId, sender)
+	eventType := "loyalty.ibc_recorder_removed"
+	if msg.Enabled {
+		recorder := types.IBCRecorder{
+			ChannelId: msg.ChannelId,
+			Sender:    sender,
+			AddedBy:   msg.Creator,
+			AddedAt:   uint64(sdkCtx.BlockTime().Unix()),
+		}
+		if err := k.IBCRecorder.Set(ctx, key, recorder); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		eventType = "loyalty.ibc_recorder_added"
+	} else {
+		has, err := k.IBCRecorder.Has(ctx, key)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if !has {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not a recorder on %s", sender, msg.ChannelId)
+		}
+		if err := k.IBCRecorder.Remove(ctx, key); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("channel_id", msg.ChannelId),
+			sdk.NewAttribute("sender", sender),
+			sdk.NewAttribute("updated_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgSetIBCRecorderResponse{}, nil
+}
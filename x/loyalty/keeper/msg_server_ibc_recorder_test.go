@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestSetIBCRecorder(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	set := func(msg types.MsgSetIBCRecorder) error {
+		_, err := srv.SetIBCRecorder(ctx, &msg)
+		return err
+	}
+	require.ErrorIs(t, set(types.MsgSetIBCRecorder{Creator: sample.AccAddress(), ChannelId: "channel-0", Sender: "osmo1partner", Enabled: true}), types.ErrInvalidSigner)
+	require.ErrorIs(t, set(types.MsgSetIBCRecorder{Creator: authority, ChannelId: "not a channel", Sender: "osmo1partner", Enabled: true}), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, set(types.MsgSetIBCRecorder{Creator: authority, ChannelId: "channel-0", Sender: " osmo1partner", Enabled: true}), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, set(types.MsgSetIBCRecorder{Creator: authority, ChannelId: "channel-0", Sender: "osmo1partner"}), sdkerrors.ErrKeyNotFound)
+
+	require.NoError(t, set(types.MsgSetIBCRecorder{Creator: authority, ChannelId: "channel-0", Sender: "osmo1partner", Enabled: true}))
+	require.NoError(t, set(types.MsgSetIBCRecorder{Creator: authority, ChannelId: "07-tendermint-1", Sender: "osmo1partner", Enabled: true}))
+	res, err := qs.IBCRecorders(ctx, &types.QueryIBCRecordersRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Len(t, res.Recorders, 1)
+	require.Equal(t, authority, res.Recorders[0].AddedBy)
+	res, err = qs.IBCRecorders(ctx, &types.QueryIBCRecordersRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Recorders, 2)
+
+	require.NoError(t, set(types.MsgSetIBCRecorder{Creator: authority, ChannelId: "channel-0", Sender: "osmo1partner"}))
+	has, err := f.keeper.IBCRecorder.Has(ctx, collections.Join("channel-0", "osmo1partner"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestExecuteIBCLoyaltyMemo(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	merchant, denom := createMerchantToken(t, f, srv, ctx, "crosschain")
+	holder := sample.AccAddress()
+	const channel, sender = "channel-3", "osmo1partner"
+	voucher := sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 5)
+	accrue := types.IBCLoyaltyMemo{Action: types.IBCMemoActionAccrue, Address: holder, Denom: denom, Amount: "75"}
+
+	require.ErrorIs(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, channel, sender, accrue, voucher), types.ErrIBCRecorderUnauthorized)
+	_, err := srv.SetIBCRecorder(ctx, &types.MsgSetIBCRecorder{Creator: authority, ChannelId: channel, Sender: sender, Enabled: true})
+	require.NoError(t, err)
+	// Recorders are per channel.
+	require.ErrorIs(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, "channel-4", sender, accrue, voucher), types.ErrIBCRecorderUnauthorized)
+
+	require.NoError(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, channel, sender, accrue, voucher))
+	require.NoError(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, channel, sender, accrue, voucher))
+	record, err := f.keeper.Rewardaccrual.Get(ctx, holder+"|"+denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 150, record.Amount)
+	require.Equal(t, types.IBCMemoIntermediaryAddress(channel, sender).String(), record.Creator)
+
+	badAddress := accrue
+	badAddress.Address = "osmo1holder"
+	require.ErrorIs(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, channel, sender, badAddress, voucher), types.ErrInvalidIBCMemo)
+
+	// fund_pool moves what the transfer delivered to the intermediary into the pool.
+	intermediary := types.IBCMemoIntermediaryAddress(channel, sender)
+	received := sdk.NewInt64Coin(denom, 30)
+	_, err = srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: merchant, Denom: denom, Recipient: intermediary.String(), Amount: 30})
+	require.NoError(t, err)
+	fund := types.IBCLoyaltyMemo{Action: types.IBCMemoActionFundPool, Denom: denom}
+	require.ErrorIs(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, channel, sender, fund, voucher), types.ErrInvalidIBCMemo)
+	require.NoError(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, channel, sender, fund, received))
+	require.True(t, f.bankKeeper.SpendableCoins(ctx, intermediary).IsZero())
+	require.Equal(t, int64(30), f.bankKeeper.moduleBalances[types.ModuleName].AmountOf(denom).Int64())
+
+	// Memos respect claim pauses like their local counterparts.
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: merchant, Denom: denom, Scopes: []string{types.PauseScopeClaim}})
+	require.NoError(t, err)
+	require.ErrorIs(t, f.keeper.ExecuteIBCLoyaltyMemo(ctx, channel, sender, accrue, voucher), types.ErrTokenPaused)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k msgServer) RegisterMerchant(ctx context.Context, msg *types.MsgRegisterMerchant) (*types.MsgRegisterMerchantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.PayoutAddress); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid payout address: %s", err))
+	}
+	if err := types.ValidateMerchantProfile(msg.LegalName, msg.ContactUri, msg.LogoHash); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMerchant, err.Error())
+	}
+
+	// Merchant ids start at 1 so that zero can mean "unlinked" on verified tokens.
+	seq, err := k.MerchantSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+	merchant := types.Merchant{
+		Id:               seq + 1,
+		Owner:            msg.Creator,
+		LegalName:        strings.TrimSpace(msg.LegalName),
+		PayoutAddress:    msg.PayoutAddress,
+		ContactUri:       msg.ContactUri,
+		VerificationTier: types.MerchantTierUnverified,
+		LogoHash:         strings.ToLower(msg.LogoHash),
+		Active:           true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_registered",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", merchant.Id)),
+			sdk.NewAttribute("owner", merchant.Owner),
+			sdk.NewAttribute("legal_name", merchant.LegalName),
+		),
+	)
+
+	return &types.MsgRegisterMerchantResponse{Id: merchant.Id}, nil
+}
+
+func (k msgServer) UpdateMerchant(ctx context.Context, msg *types.MsgUpdateMerchant) (*types.MsgUpdateMerchantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.PayoutAddress); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid payout address: %s", err))
+	}
+	if err := types.ValidateMerchantProfile(msg.LegalName, msg.ContactUri, msg.LogoHash); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMerchant, err.Error())
+	}
+
+	merchant, err := k.getMerchant(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !merchant.Active {
+		return nil, errorsmod.Wrapf(types.ErrMerchantInactive, "merchant %d", msg.Id)
+	}
+	if err := k.ensureMerchantManager(ctx, msg.Creator, merchant); err != nil {
+		return nil, err
+	}
+	isAdmin := k.ensureRole(ctx, msg.Creator, types.RoleAllowlistAdmin) == nil
+
+	legalName := strings.TrimSpace(msg.LegalName)
+	tier := merchant.VerificationTier
+	if msg.VerificationTier != "" && msg.VerificationTier != tier {
+		if !isAdmin {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only an allowlist admin can change the verification tier")
+		}
+		if err := types.ValidateMerchantTier(msg.VerificationTier); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidMerchant, err.Error())
+		}
+		tier = msg.VerificationTier
+	} else if !isAdmin && (legalName != merchant.LegalName || msg.PayoutAddress != merchant.PayoutAddress) {
+		// A verified identity does not carry over to a renamed merchant or a new payout account.
+		tier = types.MerchantTierUnverified
+	}
+
+	merchant.LegalName = legalName
+	merchant.PayoutAddress = msg.PayoutAddress
+	merchant.ContactUri = msg.ContactUri
+	merchant.LogoHash = strings.ToLower(msg.LogoHash)
+	merchant.VerificationTier = tier
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	merchant.UpdatedAt = uint64(sdkCtx.BlockTime().Unix())
+	if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update merchant")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_updated",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", merchant.Id)),
+			sdk.NewAttribute("verification_tier", merchant.VerificationTier),
+		),
+	)
+
+	return &types.MsgUpdateMerchantResponse{}, nil
+}
+
+func (k msgServer) DeactivateMerchant(ctx context.Context, msg *types.MsgDeactivateMerchant) (*types.MsgDeactivateMerchantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	merchant, err := k.getMerchant(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !merchant.Active {
+		return nil, errorsmod.Wrapf(types.ErrMerchantInactive, "merchant %d is already deactivated", msg.Id)
+	}
+	if err := k.ensureMerchantManager(ctx, msg.Creator, merchant); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+	merchant.Active = false
+	merchant.DeactivatedAt = now
+	merchant.UpdatedAt = now
+	if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to deactivate merchant")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_deactivated",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", merchant.Id)),
+			sdk.NewAttribute("deactivated_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgDeactivateMerchantResponse{Id: merchant.Id, DeactivatedAt: merchant.DeactivatedAt}, nil
+}
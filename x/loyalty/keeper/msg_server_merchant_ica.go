@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+const (
+	// defaultMerchantPacketTimeout applies when a message leaves timeout_seconds unset.
+	defaultMerchantPacketTimeout = 10 * time.Minute
+	// maxMerchantPacketTimeout bounds how long a merchant packet may stay in flight.
+	maxMerchantPacketTimeout = 24 * time.Hour
+	// maxMerchantICAMsgs bounds the messages of one interchain account transaction.
+	maxMerchantICAMsgs = 16
+)
+
+// RegisterMerchantICA opens an interchain account for the merchant of a verified
+// token over a connection. The channel handshake completes asynchronously; the
+// account turns active when the host acknowledges it.
+func (k msgServer) RegisterMerchantICA(ctx context.Context, msg *types.MsgRegisterMerchantICA) (*types.MsgRegisterMerchantICAResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if !connectiontypes.IsValidConnectionID(msg.ConnectionId) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid connection id %q", msg.ConnectionId)
+	}
+	if err := k.ensureICAControllerAvailable(); err != nil {
+		return nil, err
+	}
+	_, merchant, err := k.merchantOfOwnedToken(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	key := collections.Join(merchant.Id, msg.ConnectionId)
+	ica, err := k.MerchantICA.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		ica = types.MerchantICA{MerchantId: merchant.Id, ConnectionId: msg.ConnectionId}
+	case err != nil:
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	case ica.Status != types.MerchantICAStatusClosed:
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "merchant %d already has a %s account on %s", merchant.Id, ica.Status, msg.ConnectionId)
+	}
+	// A closed account keeps its address and counters; registering it again reopens
+	// the same account on a new channel.
+	ica.PortId = types.MerchantICAPortID(merchant.Id)
+	ica.ChannelId = ""
+	ica.Status = types.MerchantICAStatusPending
+	ica.RegisteredBy = msg.Creator
+	ica.RegisteredAt = uint64(sdkCtx.BlockTime().Unix())
+	if err := k.MerchantICA.Set(ctx, key, ica); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// The controller calls back into OnMerchantICAChannelInit, which records the
+	// channel id on the pending account.
+	if err := k.ibc.icaController.RegisterInterchainAccount(sdkCtx, msg.ConnectionId, types.MerchantICAOwner(merchant.Id), "", channeltypes.UNORDERED); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMerchantICA, err.Error())
+	}
+	ica, err = k.MerchantICA.Get(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_ica_registered",
+			sdk.NewAttribute("merchant_id", strconv.FormatUint(merchant.Id, 10)),
+			sdk.NewAttribute("connection_id", ica.ConnectionId),
+			sdk.NewAttribute("port_id", ica.PortId),
+			sdk.NewAttribute("channel_id", ica.ChannelId),
+			sdk.NewAttribute("registered_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgRegisterMerchantICAResponse{Ica: ica}, nil
+}
+
+// SendMerchantICATx sends a transaction for the merchant's interchain account to
+// execute on the host chain and tracks the packet until it settles.
+func (k msgServer) SendMerchantICATx(ctx context.Context, msg *types.MsgSendMerchantICATx) (*types.MsgSendMerchantICATxResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	// Host messages are not registered on this chain, so the transaction is decoded
+	// without resolving its Any values.
+	var cosmosTx icatypes.CosmosTx
+	if err := cosmosTx.Unmarshal(msg.CosmosTx); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid cosmos_tx: %s", err)
+	}
+	if len(cosmosTx.Messages) == 0 || len(cosmosTx.Messages) > maxMerchantICAMsgs {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cosmos_tx must hold 1-%d messages", maxMerchantICAMsgs)
+	}
+	typeURLs := make([]string, 0, len(cosmosTx.Messages))
+	for _, m := range cosmosTx.Messages {
+		if m == nil || strings.TrimSpace(m.TypeUrl) == "" {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cosmos_tx messages need a type url")
+		}
+		typeURLs = append(typeURLs, m.TypeUrl)
+	}
+	timeout, err := merchantPacketTimeout(msg.TimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ensureICAControllerAvailable(); err != nil {
+		return nil, err
+	}
+	_, merchant, err := k.merchantOfOwnedToken(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	ica, err := k.activeMerchantICA(ctx, merchant.Id, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeoutTimestamp := uint64(sdkCtx.BlockTime().Add(timeout).UnixNano())
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: msg.CosmosTx,
+		Memo: msg.Memo,
+	}
+	sequence, err := k.ibc.icaController.SendTx(sdkCtx, ica.ConnectionId, ica.PortId, packetData, timeoutTimestamp)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMerchantICA, err.Error())
+	}
+
+	packet := types.MerchantICAPacket{
+		ChannelId:        ica.ChannelId,
+		Sequence:         sequence,
+		MerchantId:       merchant.Id,
+		ConnectionId:     ica.ConnectionId,
+		Kind:             types.MerchantPacketKindTx,
+		MsgTypeUrls:      typeURLs,
+		SentBy:           msg.Creator,
+		SentAt:           uint64(sdkCtx.BlockTime().Unix()),
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+	if err := k.trackMerchantPacket(ctx, ica, packet); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.merchant_ica_tx_sent",
+			sdk.NewAttribute("merchant_id", strconv.FormatUint(merchant.Id, 10)),
+			sdk.NewAttribute("connection_id", ica.ConnectionId),
+			sdk.NewAttribute("channel_id", ica.ChannelId),
+			sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute("msg_type_urls", strings.Join(typeURLs, ",")),
+			sdk.NewAttribute("sent_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgSendMerchantICATxResponse{Sequence: sequence}, nil
+}
+
+// ForwardMerchantTreasury sends the treasury amount of a merchant allocation from
+// the token owner to the merchant's interchain account over a transfer channel on
+// the same connection. Allocations are a ledger, so the owner funds the transfer;
+// the forward marks the allocation so it is not forwarded twice.
+func (k msgServer) ForwardMerchantTreasury(ctx context.Context, msg *types.MsgForwardMerchantTreasury) (*types.MsgForwardMerchantTreasuryResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if !channeltypes.IsValidChannelID(msg.TransferChannel) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid transfer channel %q", msg.TransferChannel)
+	}
+	timeout, err := merchantPacketTimeout(msg.TimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ensureICAControllerAvailable(); err != nil {
+		return nil, err
+	}
+	allocation, err := k.Merchantallocation.Get(ctx, msg.AllocationKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "merchant allocation %q", msg.AllocationKey)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	token, merchant, err := k.merchantOfOwnedToken(ctx, msg.Creator, allocation.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if allocation.MerchantId != merchant.Id {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "allocation %s was not recorded for merchant %d", allocation.Key, merchant.Id)
+	}
+	if allocation.TreasuryAmount == 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "allocation %s has no treasury amount", allocation.Key)
+	}
+	forwardKey := collections.Join(merchant.Id, allocation.Key)
+	forwarded, err := k.TreasuryForward.Has(ctx, forwardKey)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if forwarded {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "allocation %s is already forwarded", allocation.Key)
+	}
+	ica, err := k.activeMerchantICA(ctx, merchant.Id, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	channel, found := k.ibc.channel.GetChannel(sdkCtx, transfertypes.PortID, msg.TransferChannel)
+	if !found || channel.State != channeltypes.OPEN {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "transfer channel %s is not open", msg.TransferChannel)
+	}
+	if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != ica.ConnectionId {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "transfer channel %s does not run over %s", msg.TransferChannel, ica.ConnectionId)
+	}
+
+	timeoutTimestamp := uint64(sdkCtx.BlockTime().Add(timeout).UnixNano())
+	coin := sdk.NewCoin(token.Denom, sdkmath.NewIntFromUint64(allocation.TreasuryAmount))
+	transfer := transfertypes.NewMsgTransfer(transfertypes.PortID, msg.TransferChannel, coin, msg.Creator, ica.Address, clienttypes.ZeroHeight(), timeoutTimestamp, "")
+	res, err := k.ibc.transfer.Transfer(ctx, transfer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "forward allocation %s", allocation.Key)
+	}
+
+	forward := types.TreasuryForward{
+		MerchantId:    merchant.Id,
+		AllocationKey: allocation.Key,
+		Denom:         token.Denom,
+		Amount:        allocation.TreasuryAmount,
+		ConnectionId:  ica.ConnectionId,
+		ChannelId:     msg.TransferChannel,
+		Sequence:      res.Sequence,
+		Receiver:      ica.Address,
+		Status:        types.TreasuryForwardInFlight,
+		ForwardedBy:   msg.Creator,
+		ForwardedAt:   uint64(sdkCtx.BlockTime().Unix()),
+	}
+	if err := k.TreasuryForward.Set(ctx, forwardKey, forward); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	packet := types.MerchantICAPacket{
+		ChannelId:        msg.TransferChannel,
+		Sequence:         res.Sequence,
+		MerchantId:       merchant.Id,
+		ConnectionId:     ica.ConnectionId,
+		Kind:             types.MerchantPacketKindTreasuryForward,
+		AllocationKey:    allocation.Key,
+		SentBy:           msg.Creator,
+		SentAt:           forward.ForwardedAt,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+	if err := k.trackMerchantPacket(ctx, ica, packet); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.treasury_forwarded",
+			sdk.NewAttribute("merchant_id", strconv.FormatUint(merchant.Id, 10)),
+			sdk.NewAttribute("allocation_key", allocation.Key),
+			sdk.NewAttribute("amount", coin.String()),
+			sdk.NewAttribute("channel_id", msg.TransferChannel),
+			sdk.NewAttribute("sequence", strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute("receiver", ica.Address),
+			sdk.NewAttribute("forwarded_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgForwardMerchantTreasuryResponse{Forward: forward}, nil
+}
+
+// merchantOfOwnedToken returns a verified token owned by signer and its active
+// merchant. Only the owner of a merchant token acts for the merchant over IBC.
+func (k msgServer) merchantOfOwnedToken(ctx context.Context, signer, denom string) (types.Verifiedtoken, types.Merchant, error) {
+	denom, err := k.resolveStoredDenom(denom)
+	if err != nil {
+		return types.Verifiedtoken{}, types.Merchant{}, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Verifiedtoken{}, types.Merchant{}, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return types.Verifiedtoken{}, types.Merchant{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if signer != token.Creator {
+		return types.Verifiedtoken{}, types.Merchant{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the token owner can manage merchant interchain accounts")
+	}
+	if err := k.ensureAdminNotLockedOut(ctx, denom, signer); err != nil {
+		return types.Verifiedtoken{}, types.Merchant{}, err
+	}
+	if token.AdminRenounced {
+		return types.Verifiedtoken{}, types.Merchant{}, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced")
+	}
+	if token.MerchantId == 0 {
+		return types.Verifiedtoken{}, types.Merchant{}, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "%s is not linked to a merchant", denom)
+	}
+	merchant, err := k.getMerchant(ctx, token.MerchantId)
+	if err != nil {
+		return types.Verifiedtoken{}, types.Merchant{}, err
+	}
+	if !merchant.Active {
+		return types.Verifiedtoken{}, types.Merchant{}, errorsmod.Wrapf(types.ErrMerchantInactive, "merchant %d", merchant.Id)
+	}
+	return token, merchant, nil
+}
+
+func (k Keeper) activeMerchantICA(ctx context.Context, merchantID uint64, connectionID string) (types.MerchantICA, error) {
+	ica, err := k.MerchantICA.Get(ctx, collections.Join(merchantID, connectionID))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MerchantICA{}, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "merchant %d has no account on %q", merchantID, connectionID)
+		}
+		return types.MerchantICA{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if ica.Status != types.MerchantICAStatusActive {
+		return types.MerchantICA{}, errorsmod.Wrapf(types.ErrInvalidMerchantICA, "merchant %d account on %s is %s", merchantID, connectionID, ica.Status)
+	}
+	return ica, nil
+}
+
+func (k Keeper) ensureICAControllerAvailable() error {
+	if k.ibc.icaController == nil || k.ibc.transfer == nil || k.ibc.channel == nil {
+		return errorsmod.Wrap(types.ErrInvalidMerchantICA, "interchain accounts are not available on this chain")
+	}
+	return nil
+}
+
+func merchantPacketTimeout(seconds uint64) (time.Duration, error) {
+	if seconds == 0 {
+		return defaultMerchantPacketTimeout, nil
+	}
+	if seconds > uint64(maxMerchantPacketTimeout/time.Second) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "timeout must be at most %s", maxMerchantPacketTimeout)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+// mockICAController stands in for the controller submodule: registering runs the
+// channel init callback the way the controller middleware does.
+type mockICAController struct {
+	keeper      keeper.Keeper
+	channels    map[string]string // channel id -> connection id
+	addresses   map[string]string // port id -> interchain account address
+	sequence    uint64
+	registerErr error
+}
+
+func (m *mockICAController) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, _ string, _ channeltypes.Order) error {
+	if m.registerErr != nil {
+		return m.registerErr
+	}
+	channelID := channeltypes.FormatChannelIdentifier(uint64(len(m.channels)))
+	m.channels[channelID] = connectionID
+	return m.keeper.OnMerchantICAChannelInit(ctx, icatypes.ControllerPortPrefix+owner, connectionID, channelID)
+}
+
+func (m *mockICAController) SendTx(_ sdk.Context, _, _ string, data icatypes.InterchainAccountPacketData, _ uint64) (uint64, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	m.sequence++
+	return m.sequence, nil
+}
+
+func (m *mockICAController) GetInterchainAccountAddress(_ sdk.Context, _, portID string) (string, bool) {
+	address, ok := m.addresses[portID]
+	return address, ok
+}
+
+func (m *mockICAController) GetConnectionID(_ sdk.Context, _, channelID string) (string, error) {
+	connectionID, ok := m.channels[channelID]
+	if !ok {
+		return "", fmt.Errorf("channel %s not found", channelID)
+	}
+	return connectionID, nil
+}
+
+type mockTransferKeeper struct {
+	sent     []*transfertypes.MsgTransfer
+	sequence uint64
+}
+
+func (m *mockTransferKeeper) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	m.sent = append(m.sent, msg)
+	m.sequence++
+	return &transfertypes.MsgTransferResponse{Sequence: m.sequence}, nil
+}
+
+type mockChannelKeeper struct {
+	channels map[string]channeltypes.Channel
+}
+
+func (m *mockChannelKeeper) GetChannel(_ sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	channel, ok := m.channels[portID+"/"+channelID]
+	return channel, ok
+}
+
+func TestMerchantICALifecycle(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+
+	setAllowlistedMode(t, f)
+	owner := sample.AccAddress()
+	_, err := srv.CreateCreatorallowlist(ctx, &types.MsgCreateCreatorallowlist{Creator: authorityAddress(t, f), Address: owner, Enabled: true})
+	require.NoError(t, err)
+	merchantID := registerMerchant(t, srv, f, owner)
+	token := baseVerifiedToken(owner, "treasury")
+	token.MerchantId = merchantID
+	_, err = srv.CreateVerifiedtoken(ctx, token)
+	require.NoError(t, err)
+	denom := factoryDenom(owner, "treasury")
+	_, err = srv.CreateVerifiedtoken(ctx, baseVerifiedToken(owner, "unlinked"))
+	require.NoError(t, err)
+
+	register := &types.MsgRegisterMerchantICA{Creator: owner, Denom: denom, ConnectionId: "connection-0"}
+	_, err = srv.RegisterMerchantICA(ctx, register)
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+
+	controller := &mockICAController{keeper: f.keeper, channels: map[string]string{}, addresses: map[string]string{}}
+	transfer := &mockTransferKeeper{}
+	channels := &mockChannelKeeper{channels: map[string]channeltypes.Channel{
+		"transfer/channel-5": {State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}},
+		"transfer/channel-6": {State: channeltypes.OPEN, ConnectionHops: []string{"connection-1"}},
+	}}
+	f.keeper.SetIBCKeepers(controller, transfer, channels)
+
+	_, err = srv.RegisterMerchantICA(ctx, &types.MsgRegisterMerchantICA{Creator: sample.AccAddress(), Denom: denom, ConnectionId: "connection-0"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RegisterMerchantICA(ctx, &types.MsgRegisterMerchantICA{Creator: owner, Denom: factoryDenom(owner, "unlinked"), ConnectionId: "connection-0"})
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+	_, err = srv.RegisterMerchantICA(ctx, &types.MsgRegisterMerchantICA{Creator: owner, Denom: denom, ConnectionId: "connection"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Registering leaves the account pending on the channel the handshake opened.
+	resp, err := srv.RegisterMerchantICA(ctx, register)
+	require.NoError(t, err)
+	require.Equal(t, types.MerchantICAStatusPending, resp.Ica.Status)
+	require.Equal(t, "channel-0", resp.Ica.ChannelId)
+	require.Equal(t, types.MerchantICAPortID(merchantID), resp.Ica.PortId)
+	_, err = srv.RegisterMerchantICA(ctx, register)
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+
+	cosmosTx, err := (&icatypes.CosmosTx{Messages: []*codectypes.Any{{TypeUrl: "/osmosis.gamm.v1beta1.MsgJoinPool", Value: []byte{1}}}}).Marshal()
+	require.NoError(t, err)
+	sendTx := &types.MsgSendMerchantICATx{Creator: owner, Denom: denom, ConnectionId: "connection-0", CosmosTx: cosmosTx}
+	_, err = srv.SendMerchantICATx(ctx, sendTx)
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+
+	hostAddress := sample.AccAddress()
+	controller.addresses[types.MerchantICAPortID(merchantID)] = hostAddress
+	require.NoError(t, f.keeper.OnMerchantICAChannelOpen(ctx, types.MerchantICAPortID(merchantID), "channel-0"))
+	icas, err := qs.MerchantICAs(ctx, &types.QueryMerchantICAsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	require.Len(t, icas.Icas, 1)
+	require.Equal(t, types.MerchantICAStatusActive, icas.Icas[0].Status)
+	require.Equal(t, hostAddress, icas.Icas[0].Address)
+
+	// Transactions are tracked until they settle.
+	_, err = srv.SendMerchantICATx(ctx, &types.MsgSendMerchantICATx{Creator: owner, Denom: denom, ConnectionId: "connection-0", CosmosTx: []byte{0xff}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.SendMerchantICATx(ctx, &types.MsgSendMerchantICATx{Creator: owner, Denom: denom, ConnectionId: "connection-0", CosmosTx: cosmosTx, TimeoutSeconds: 90_000})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	txResp, err := srv.SendMerchantICATx(ctx, sendTx)
+	require.NoError(t, err)
+	packets, err := qs.MerchantICAPackets(ctx, &types.QueryMerchantICAPacketsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	require.Len(t, packets.Packets, 1)
+	require.Equal(t, []string{"/osmosis.gamm.v1beta1.MsgJoinPool"}, packets.Packets[0].MsgTypeUrls)
+	require.Equal(t, uint64(ctx.BlockTime().Add(10*time.Minute).UnixNano()), packets.Packets[0].TimeoutTimestamp)
+	require.NoError(t, f.keeper.SettleMerchantPacket(ctx, "channel-0", txResp.Sequence, types.MerchantPacketFailed))
+	packets, err = qs.MerchantICAPackets(ctx, &types.QueryMerchantICAPacketsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	require.Empty(t, packets.Packets)
+
+	// Treasury forwards send the allocation's treasury share to the account over
+	// a transfer channel on the same connection.
+	alloc, err := srv.RecordMerchantAllocation(ctx, &types.MsgRecordMerchantAllocation{
+		Creator:       authorityAddress(t, f),
+		Date:          "2027-01-15",
+		Denom:         denom,
+		ActivityScore: 10,
+		BucketCAmount: 1_000,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, alloc.TreasuryAmount)
+	forward := &types.MsgForwardMerchantTreasury{Creator: owner, AllocationKey: alloc.Key, ConnectionId: "connection-0", TransferChannel: "channel-6"}
+	_, err = srv.ForwardMerchantTreasury(ctx, forward)
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+	forward.TransferChannel = "channel-5"
+	fwdResp, err := srv.ForwardMerchantTreasury(ctx, forward)
+	require.NoError(t, err)
+	require.Equal(t, types.TreasuryForwardInFlight, fwdResp.Forward.Status)
+	require.Len(t, transfer.sent, 1)
+	require.Equal(t, hostAddress, transfer.sent[0].Receiver)
+	require.Equal(t, owner, transfer.sent[0].Sender)
+	require.Equal(t, sdk.NewInt64Coin(denom, int64(alloc.TreasuryAmount)), transfer.sent[0].Token)
+	_, err = srv.ForwardMerchantTreasury(ctx, forward)
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+
+	// A timed out forward can be retried; an acknowledged one completes.
+	require.NoError(t, f.keeper.SettleMerchantPacket(ctx, "channel-5", fwdResp.Forward.Sequence, types.MerchantPacketTimedOut))
+	forwards, err := qs.TreasuryForwards(ctx, &types.QueryTreasuryForwardsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	require.Empty(t, forwards.Forwards)
+	fwdResp, err = srv.ForwardMerchantTreasury(ctx, forward)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SettleMerchantPacket(ctx, "channel-5", fwdResp.Forward.Sequence, types.MerchantPacketAcknowledged))
+	forwards, err = qs.TreasuryForwards(ctx, &types.QueryTreasuryForwardsRequest{MerchantId: merchantID})
+	require.NoError(t, err)
+	require.Len(t, forwards.Forwards, 1)
+	require.Equal(t, types.TreasuryForwardCompleted, forwards.Forwards[0].Status)
+	_, err = srv.ForwardMerchantTreasury(ctx, forward)
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+
+	// A closed account keeps its counters and can be registered again.
+	require.NoError(t, f.keeper.OnMerchantICAChannelClosed(ctx, types.MerchantICAPortID(merchantID), "channel-0"))
+	_, err = srv.SendMerchantICATx(ctx, sendTx)
+	require.ErrorIs(t, err, types.ErrInvalidMerchantICA)
+	resp, err = srv.RegisterMerchantICA(ctx, register)
+	require.NoError(t, err)
+	require.Equal(t, types.MerchantICAStatusPending, resp.Ica.Status)
+	require.Equal(t, "channel-1", resp.Ica.ChannelId)
+	require.EqualValues(t, 3, resp.Ica.PacketsSent)
+	require.EqualValues(t, 1, resp.Ica.PacketsAcknowledged)
+	require.EqualValues(t, 1, resp.Ica.PacketsFailed)
+	require.EqualValues(t, 1, resp.Ica.PacketsTimedOut)
+
+	// Deactivated merchants cannot use their accounts.
+	_, err = srv.DeactivateMerchant(ctx, &types.MsgDeactivateMerchant{Creator: owner, Id: merchantID})
+	require.NoError(t, err)
+	_, err = srv.SendMerchantICATx(ctx, sendTx)
+	require.ErrorIs(t, err, types.ErrMerchantInactive)
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func registerMerchant(t *testing.T, srv types.MsgServer, f *fixture, owner string) uint64 {
+	t.Helper()
+	resp, err := srv.RegisterMerchant(f.ctx, &types.MsgRegisterMerchant{
+		Creator:       owner,
+		LegalName:     "Corner Bakery LLC",
+		PayoutAddress: owner,
+		ContactUri:    "https://bakery.example",
+	})
+	require.NoError(t, err)
+	return resp.Id
+}
+
+func TestRegisterMerchant(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	owner := sample.AccAddress()
+
+	first := registerMerchant(t, srv, f, owner)
+	second := registerMerchant(t, srv, f, owner)
+	require.Equal(t, uint64(1), first)
+	require.Equal(t, uint64(2), second)
+
+	merchant, err := f.keeper.Merchant.Get(f.ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, owner, merchant.Owner)
+	require.Equal(t, types.MerchantTierUnverified, merchant.VerificationTier)
+	require.True(t, merchant.Active)
+
+	_, err = srv.RegisterMerchant(f.ctx, &types.MsgRegisterMerchant{
+		Creator:       owner,
+		LegalName:     " ",
+		PayoutAddress: owner,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidMerchant)
+
+	_, err = srv.RegisterMerchant(f.ctx, &types.MsgRegisterMerchant{
+		Creator:       owner,
+		LegalName:     "Corner Bakery LLC",
+		PayoutAddress: owner,
+		LogoHash:      "not-a-hash",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidMerchant)
+
+	_, err = srv.RegisterMerchant(f.ctx, &types.MsgRegisterMerchant{
+		Creator:       owner,
+		LegalName:     "Corner Bakery LLC",
+		PayoutAddress: "invalid",
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestUpdateMerchantVerificationTier(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	admin := authorityAddress(t, f)
+	owner := sample.AccAddress()
+	id := registerMerchant(t, srv, f, owner)
+
+	update := &types.MsgUpdateMerchant{
+		Creator:          owner,
+		Id:               id,
+		LegalName:        "Corner Bakery LLC",
+		PayoutAddress:    owner,
+		ContactUri:       "https://bakery.example",
+		VerificationTier: types.MerchantTierEnhanced,
+	}
+	_, err := srv.UpdateMerchant(f.ctx, update)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.UpdateMerchant(f.ctx, &types.MsgUpdateMerchant{
+		Creator:       sample.AccAddress(),
+		Id:            id,
+		LegalName:     "Corner Bakery LLC",
+		PayoutAddress: owner,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	update.Creator = admin
+	_, err = srv.UpdateMerchant(f.ctx, update)
+	require.NoError(t, err)
+	merchant, err := f.keeper.Merchant.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.MerchantTierEnhanced, merchant.VerificationTier)
+
+	// Contact details can change without losing the tier.
+	_, err = srv.UpdateMerchant(f.ctx, &types.MsgUpdateMerchant{
+		Creator:       owner,
+		Id:            id,
+		LegalName:     "Corner Bakery LLC",
+		PayoutAddress: owner,
+		ContactUri:    "https://bakery.example/contact",
+	})
+	require.NoError(t, err)
+	merchant, err = f.keeper.Merchant.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.MerchantTierEnhanced, merchant.VerificationTier)
+	require.Equal(t, "https://bakery.example/contact", merchant.ContactUri)
+
+	// A rename by the owner drops the merchant back to unverified.
+	_, err = srv.UpdateMerchant(f.ctx, &types.MsgUpdateMerchant{
+		Creator:       owner,
+		Id:            id,
+		LegalName:     "Corner Bakery Holdings",
+		PayoutAddress: owner,
+	})
+	require.NoError(t, err)
+	merchant, err = f.keeper.Merchant.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.MerchantTierUnverified, merchant.VerificationTier)
+	require.Equal(t, "Corner Bakery Holdings", merchant.LegalName)
+
+	update.VerificationTier = "platinum"
+	_, err = srv.UpdateMerchant(f.ctx, update)
+	require.ErrorIs(t, err, types.ErrInvalidMerchant)
+
+	_, err = srv.UpdateMerchant(f.ctx, &types.MsgUpdateMerchant{
+		Creator:       owner,
+		Id:            99,
+		LegalName:     "Nobody",
+		PayoutAddress: owner,
+	})
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+}
+
+func TestDeactivateMerchant(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	owner := sample.AccAddress()
+	id := registerMerchant(t, srv, f, owner)
+
+	_, err := srv.DeactivateMerchant(f.ctx, &types.MsgDeactivateMerchant{Creator: sample.AccAddress(), Id: id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	resp, err := srv.DeactivateMerchant(f.ctx, &types.MsgDeactivateMerchant{Creator: owner, Id: id})
+	require.NoError(t, err)
+	require.Equal(t, id, resp.Id)
+
+	merchant, err := f.keeper.Merchant.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.False(t, merchant.Active)
+	require.Equal(t, resp.DeactivatedAt, merchant.DeactivatedAt)
+
+	_, err = srv.DeactivateMerchant(f.ctx, &types.MsgDeactivateMerchant{Creator: owner, Id: id})
+	require.ErrorIs(t, err, types.ErrMerchantInactive)
+
+	_, err = srv.UpdateMerchant(f.ctx, &types.MsgUpdateMerchant{
+		Creator:       owner,
+		Id:            id,
+		LegalName:     "Corner Bakery LLC",
+		PayoutAddress: owner,
+	})
+	require.ErrorIs(t, err, types.ErrMerchantInactive)
+}
+
+func TestVerifiedtokenMerchantLink(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	first := registerMerchant(t, srv, f, creator)
+	second := registerMerchant(t, srv, f, creator)
+	denom := factoryDenom(creator, "linked")
+
+	msg := baseVerifiedToken(creator, "linked")
+	msg.MerchantId = first
+	_, err := srv.CreateVerifiedtoken(f.ctx, msg)
+	require.NoError(t, err)
+
+	token, err := f.keeper.Verifiedtoken.Get(f.ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, first, token.MerchantId)
+	merchant, err := f.keeper.Merchant.Get(f.ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, []string{denom}, merchant.Denoms)
+
+	update := &types.MsgUpdateVerifiedtoken{
+		Creator:    creator,
+		Denom:      denom,
+		Issuer:     creator,
+		Name:       msg.Name,
+		Symbol:     msg.Symbol,
+		MaxSupply:  msg.MaxSupply,
+		MerchantId: second,
+	}
+	_, err = srv.UpdateVerifiedtoken(f.ctx, update)
+	require.NoError(t, err)
+
+	merchant, err = f.keeper.Merchant.Get(f.ctx, first)
+	require.NoError(t, err)
+	require.Empty(t, merchant.Denoms)
+	merchant, err = f.keeper.Merchant.Get(f.ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, []string{denom}, merchant.Denoms)
+
+	_, err = srv.DeleteVerifiedtoken(f.ctx, &types.MsgDeleteVerifiedtoken{Creator: creator, Denom: denom})
+	require.NoError(t, err)
+	merchant, err = f.keeper.Merchant.Get(f.ctx, second)
+	require.NoError(t, err)
+	require.Empty(t, merchant.Denoms)
+
+	msg = baseVerifiedToken(creator, "inactive")
+	msg.MerchantId = 42
+	_, err = srv.CreateVerifiedtoken(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+
+	_, err = srv.DeactivateMerchant(f.ctx, &types.MsgDeactivateMerchant{Creator: creator, Id: first})
+	require.NoError(t, err)
+	msg.MerchantId = first
+	_, err = srv.CreateVerifiedtoken(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrMerchantInactive)
+}
+
+func TestVerifiedtokenMerchantLinkRequiresManager(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := sample.AccAddress()
+	foreign := registerMerchant(t, srv, f, sample.AccAddress())
+	own := registerMerchant(t, srv, f, issuer)
+
+	params := types.DefaultParams()
+	params.CreationMode = types.CreationModePermissionless
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	msg := baseVerifiedToken(issuer, "storefront")
+	msg.MerchantId = foreign
+	_, err := srv.CreateVerifiedtoken(f.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg.MerchantId = own
+	_, err = srv.CreateVerifiedtoken(f.ctx, msg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func metadataUpdate(token types.Verifiedtoken) *types.MsgUpdateVerifiedtoken {
+	return &types.MsgUpdateVerifiedtoken{
+		Creator:     token.Creator,
+		Denom:       token.Denom,
+		Issuer:      token.Issuer,
+		Name:        token.Name,
+		Symbol:      token.Symbol,
+		Description: token.Description,
+		Website:     token.Website,
+		MaxSupply:   token.MaxSupply,
+		Decimals:    token.Decimals,
+		DenomUnits:  token.DenomUnits,
+	}
+}
+
+func TestMetadataChangeIsDelayed(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "rebrand")
+	start := time.Unix(1_800_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithEventManager(sdk.NewEventManager())
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "rebrand"))
+	require.NoError(t, err)
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, token.MetadataVersion)
+
+	msg := metadataUpdate(token)
+	msg.Name = "Rebranded"
+	msg.Symbol = "RBD"
+	msg.Description = "new description"
+	resp, err := srv.UpdateVerifiedtoken(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, resp.MetadataChangePending)
+	delay := types.DefaultMetadataChangeDelayHours * 3600
+	require.Equal(t, uint64(start.Unix())+delay, resp.EffectiveAt)
+
+	var proposed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "loyalty.metadata_change_proposed" {
+			proposed = true
+		}
+	}
+	require.True(t, proposed)
+
+	// Non-identity fields apply right away; the rename does not.
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, "new description", token.Description)
+	require.Equal(t, "Token rebrand", token.Name)
+	require.Equal(t, "Token rebrand", f.bankKeeper.denomMetadata[denom].Name)
+
+	pending, err := qs.PendingMetadataChange(ctx, &types.QueryPendingMetadataChangeRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, "Rebranded", pending.PendingChange.Name)
+	require.Equal(t, creator, pending.PendingChange.Proposer)
+
+	require.NoError(t, f.keeper.ApplyMetadataChanges(ctx.WithBlockTime(start.Add(time.Hour))))
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, "Token rebrand", token.Name)
+
+	require.NoError(t, f.keeper.ApplyMetadataChanges(ctx.WithBlockTime(time.Unix(int64(resp.EffectiveAt), 0))))
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, "Rebranded", token.Name)
+	require.Equal(t, "RBD", token.Symbol)
+	require.Equal(t, "RBD", f.bankKeeper.denomMetadata[denom].Symbol)
+
+	_, err = qs.PendingMetadataChange(ctx, &types.QueryPendingMetadataChangeRequest{Denom: denom})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	history, err := qs.MetadataHistory(ctx, &types.QueryMetadataHistoryRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, history.Versions, 3)
+	require.Equal(t, "Token rebrand", history.Versions[0].Name)
+	require.Equal(t, "new description", history.Versions[1].Description)
+	require.Equal(t, "Token rebrand", history.Versions[1].Name)
+	require.Equal(t, "Rebranded", history.Versions[2].Name)
+	require.EqualValues(t, 3, history.Versions[2].Version)
+}
+
+func TestMetadataChangeSupplyRules(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "capped")
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "capped"))
+	require.NoError(t, err)
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+
+	// Cap decreases are immediate.
+	msg := metadataUpdate(token)
+	msg.MaxSupply = 500_000
+	resp, err := srv.UpdateVerifiedtoken(ctx, msg)
+	require.NoError(t, err)
+	require.False(t, resp.MetadataChangePending)
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 500_000, token.MaxSupply)
+
+	// Cap increases wait, and a later proposal replaces the earlier one.
+	msg.MaxSupply = 2_000_000
+	_, err = srv.UpdateVerifiedtoken(ctx, msg)
+	require.NoError(t, err)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	msg.MaxSupply = 3_000_000
+	resp, err = srv.UpdateVerifiedtoken(later, msg)
+	require.NoError(t, err)
+	require.True(t, resp.MetadataChangePending)
+
+	pending, err := f.keeper.PendingMetadataChange.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 3_000_000, pending.MaxSupply)
+	require.Equal(t, resp.EffectiveAt, pending.EffectiveAt)
+
+	// Resubmitting the current values withdraws the pending change.
+	msg.MaxSupply = token.MaxSupply
+	resp, err = srv.UpdateVerifiedtoken(later, msg)
+	require.NoError(t, err)
+	require.False(t, resp.MetadataChangePending)
+	_, err = f.keeper.PendingMetadataChange.Get(ctx, denom)
+	require.Error(t, err)
+
+	require.NoError(t, f.keeper.ApplyMetadataChanges(ctx.WithBlockTime(ctx.BlockTime().Add(72*time.Hour))))
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 500_000, token.MaxSupply)
+}
+
+func TestMetadataChangeCapLockedByRenounce(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "locked")
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "locked"))
+	require.NoError(t, err)
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+
+	msg := metadataUpdate(token)
+	msg.Name = "Locked Points"
+	msg.MaxSupply = 5_000_000
+	resp, err := srv.UpdateVerifiedtoken(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = srv.RenounceTokenAdmin(ctx, &types.MsgRenounceTokenAdmin{Creator: creator, Denom: denom})
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ApplyMetadataChanges(ctx.WithBlockTime(time.Unix(int64(resp.EffectiveAt), 0))))
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, "Locked Points", token.Name)
+	require.EqualValues(t, 1_000_000, token.MaxSupply)
+}
+
+func TestMetadataChangeClearedOnDelete(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "gone")
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "gone"))
+	require.NoError(t, err)
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+
+	msg := metadataUpdate(token)
+	msg.Symbol = "GONE"
+	_, err = srv.UpdateVerifiedtoken(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: creator, Denom: denom})
+	require.NoError(t, err)
+	_, err = f.keeper.PendingMetadataChange.Get(ctx, denom)
+	require.Error(t, err)
+
+	require.NoError(t, f.keeper.ApplyMetadataChanges(ctx.WithBlockTime(ctx.BlockTime().Add(72*time.Hour))))
+	has, err := f.keeper.Verifiedtoken.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
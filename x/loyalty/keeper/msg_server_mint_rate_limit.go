@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k msgServer) SetMintRateLimit(ctx context.Context, msg *types.MsgSetMintRateLimit) (*types.MsgSetMintRateLimitResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	if msg.Creator != token.Creator && !isAuthority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or authority can set mint rate limits")
+	}
+	if err := k.ensureAdminNotLockedOut(ctx, denom, msg.Creator); err != nil {
+		return nil, err
+	}
+	if token.AdminRenounced {
+		return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced; minting is disabled")
+	}
+
+	current, err := k.getMintRateLimit(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	requested := types.MintRateLimit{
+		Denom:            denom,
+		MaxPerTx:         msg.MaxPerTx,
+		MaxPerRollingDay: msg.MaxPerRollingDay,
+		MaxPerRollupDate: msg.MaxPerRollupDate,
+	}
+
+	// Tightening applies now so a suspected key compromise can be contained at once;
+	// anything that loosens the limit waits out the change delay. A new request always
+	// replaces an earlier pending one, so resubmitting the current limit cancels it.
+	tightened := types.TightenMintRateLimit(current, requested)
+	if err := k.removePendingMintRateLimit(ctx, denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.setMintRateLimit(ctx, tightened); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.mint_rate_limit_updated",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("updated_by", msg.Creator),
+			sdk.NewAttribute("max_per_tx", fmt.Sprintf("%d", tightened.MaxPerTx)),
+			sdk.NewAttribute("max_per_rolling_day", fmt.Sprintf("%d", tightened.MaxPerRollingDay)),
+			sdk.NewAttribute("max_per_rollup_date", fmt.Sprintf("%d", tightened.MaxPerRollupDate)),
+		),
+	)
+
+	resp := &types.MsgSetMintRateLimitResponse{MintRateLimit: tightened}
+	if tightened == requested {
+		return resp, nil
+	}
+
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := uint64(sdkCtx.BlockTime().Unix())
+	pending := types.PendingMintRateLimit{
+		Limit:       requested,
+		Proposer:    msg.Creator,
+		ProposedAt:  now,
+		EffectiveAt: now + metadataChangeDelaySeconds(params),
+	}
+	if err := k.schedulePendingMintRateLimit(ctx, pending); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.mint_rate_limit_proposed",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("proposer", msg.Creator),
+			sdk.NewAttribute("max_per_tx", fmt.Sprintf("%d", requested.MaxPerTx)),
+			sdk.NewAttribute("max_per_rolling_day", fmt.Sprintf("%d", requested.MaxPerRollingDay)),
+			sdk.NewAttribute("max_per_rollup_date", fmt.Sprintf("%d", requested.MaxPerRollupDate)),
+			sdk.NewAttribute("effective_at", fmt.Sprintf("%d", pending.EffectiveAt)),
+		),
+	)
+
+	resp.Pending = true
+	resp.EffectiveAt = pending.EffectiveAt
+	return resp, nil
+}
@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestMintRateLimitEnforcement(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "limited")
+	recipient := sample.AccAddress()
+	// 22:00 in the rollup timezone, so the rollup date changes two hours later.
+	start := edmontonNoon(t, "2027-03-01").Add(10 * time.Hour)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "limited"))
+	require.NoError(t, err)
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom, MaxPerTx: 60, MaxPerRollingDay: 150, MaxPerRollupDate: 100})
+	require.NoError(t, err)
+
+	mint := func(at time.Time, amount uint64) error {
+		_, err := srv.MintVerifiedToken(ctx.WithBlockTime(at), &types.MsgMintVerifiedToken{Creator: creator, Denom: denom, Recipient: recipient, Amount: amount})
+		return err
+	}
+
+	require.ErrorIs(t, mint(start, 61), types.ErrMintRateLimited)
+	require.NoError(t, mint(start, 60))
+	require.NoError(t, mint(start.Add(time.Hour), 40))
+	// The rollup date is used up.
+	require.ErrorIs(t, mint(start.Add(time.Hour), 1), types.ErrMintRateLimited)
+
+	res, err := qs.MintRateLimit(ctx.WithBlockTime(start.Add(time.Hour)), &types.QueryMintRateLimitRequest{Denom: denom})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.MintedRollingDay)
+	require.EqualValues(t, 100, res.MintedRollupDate)
+	require.Equal(t, "2027-03-01", res.RollupDate)
+	require.Zero(t, res.Available)
+
+	// After local midnight only the rolling window still counts the earlier mints.
+	nextDay := start.Add(3 * time.Hour)
+	res, err = qs.MintRateLimit(ctx.WithBlockTime(nextDay), &types.QueryMintRateLimitRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, "2027-03-02", res.RollupDate)
+	require.Zero(t, res.MintedRollupDate)
+	require.EqualValues(t, 50, res.Available)
+	require.ErrorIs(t, mint(nextDay, 51), types.ErrMintRateLimited)
+	require.NoError(t, mint(nextDay, 50))
+
+	// The batch total counts as one tx.
+	_, err = srv.MintVerifiedTokenBatch(ctx.WithBlockTime(start.Add(26*time.Hour)), &types.MsgMintVerifiedTokenBatch{
+		Creator: creator,
+		Denom:   denom,
+		Recipients: []types.MintRecipient{
+			{Recipient: recipient, Amount: 40},
+			{Recipient: sample.AccAddress(), Amount: 40},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrMintRateLimited)
+
+	// Once the first mints leave the rolling window the daily allowance is back.
+	require.NoError(t, mint(start.Add(26*time.Hour), 50))
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, token.MintedSupply)
+}
+
+func TestMintRateLimitLooseningIsDelayed(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "loosen")
+	start := time.Unix(1_800_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithEventManager(sdk.NewEventManager())
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "loosen"))
+	require.NoError(t, err)
+
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: sample.AccAddress(), Denom: denom, MaxPerTx: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Setting a first limit only tightens, so it applies at once.
+	resp, err := srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom, MaxPerTx: 100, MaxPerRollingDay: 500})
+	require.NoError(t, err)
+	require.False(t, resp.Pending)
+	require.EqualValues(t, 100, resp.MintRateLimit.MaxPerTx)
+
+	// Loosening the per-tx limit while tightening the rolling one: the tightening
+	// applies now, the loosening later.
+	resp, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom, MaxPerTx: 200, MaxPerRollingDay: 300})
+	require.NoError(t, err)
+	require.True(t, resp.Pending)
+	require.EqualValues(t, 100, resp.MintRateLimit.MaxPerTx)
+	require.EqualValues(t, 300, resp.MintRateLimit.MaxPerRollingDay)
+	delay := types.DefaultMetadataChangeDelayHours * 3600
+	require.Equal(t, uint64(start.Unix())+delay, resp.EffectiveAt)
+
+	var proposed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "loyalty.mint_rate_limit_proposed" {
+			proposed = true
+		}
+	}
+	require.True(t, proposed)
+
+	res, err := qs.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom})
+	require.NoError(t, err)
+	require.NotNil(t, res.Pending)
+	require.EqualValues(t, 200, res.Pending.Limit.MaxPerTx)
+
+	require.NoError(t, f.keeper.ApplyMintRateLimitChanges(ctx.WithBlockTime(start.Add(time.Hour))))
+	limit, err := f.keeper.MintRateLimit.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, limit.MaxPerTx)
+
+	require.NoError(t, f.keeper.ApplyMintRateLimitChanges(ctx.WithBlockTime(time.Unix(int64(resp.EffectiveAt), 0))))
+	limit, err = f.keeper.MintRateLimit.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, limit.MaxPerTx)
+	require.EqualValues(t, 300, limit.MaxPerRollingDay)
+
+	// Removing the limit is a loosening too; resubmitting the current limit cancels it.
+	resp, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom})
+	require.NoError(t, err)
+	require.True(t, resp.Pending)
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom, MaxPerTx: 200, MaxPerRollingDay: 300})
+	require.NoError(t, err)
+	res, err = qs.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Nil(t, res.Pending)
+	require.NoError(t, f.keeper.ApplyMintRateLimitChanges(ctx.WithBlockTime(time.Unix(int64(resp.EffectiveAt), 0))))
+	limit, err = f.keeper.MintRateLimit.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, limit.MaxPerTx)
+
+	// Deleting the token clears its rate limit state.
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom, MaxPerTx: 500})
+	require.NoError(t, err)
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: creator, Denom: denom})
+	require.NoError(t, err)
+	has, err := f.keeper.MintRateLimit.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.PendingMintRateLimit.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMintRateLimitWithSchedules(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "limitsched")
+	recipient := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(edmontonNoon(t, "2027-01-31"))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "limitsched"))
+	require.NoError(t, err)
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom, MaxPerRollupDate: 150})
+	require.NoError(t, err)
+
+	msg := mintScheduleMsg(creator, denom, recipient)
+	msg.TrancheAmount = 151
+	_, err = srv.CreateMintSchedule(ctx, msg)
+	require.ErrorIs(t, err, types.ErrMintRateLimited)
+
+	_, err = srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+	_, err = srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: creator, Denom: denom, Recipient: recipient, Amount: 100})
+	require.NoError(t, err)
+
+	// The tranche does not fit what is left of the date, so it stays queued for the
+	// next day instead of breaking the limit.
+	require.NoError(t, f.keeper.ReleaseMintTranches(ctx))
+	require.EqualValues(t, 100, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+	res, err := qs.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.MintedRollupDate)
+
+	next := ctx.WithBlockTime(edmontonNoon(t, "2027-02-01"))
+	require.NoError(t, f.keeper.ReleaseMintTranches(next))
+	require.EqualValues(t, 200, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+	res, err = qs.MintRateLimit(next, &types.QueryMintRateLimitRequest{Denom: denom})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.MintedRollupDate)
+}
+
+func TestMintRateLimitHoldsBackSchedulesOverRollingDay(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "rolling")
+	recipient := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(edmontonNoon(t, "2027-01-31"))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "rolling"))
+	require.NoError(t, err)
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: creator, Denom: denom, MaxPerRollingDay: 150})
+	require.NoError(t, err)
+
+	// Each tranche fits the limit on its own; together they exceed it.
+	first, err := srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+	second, err := srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+
+	releaseCtx := ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ReleaseMintTranches(releaseCtx))
+	require.EqualValues(t, 100, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+	var deferred bool
+	for _, event := range releaseCtx.EventManager().Events() {
+		if event.Type == "loyalty.mint_tranche_deferred" {
+			deferred = true
+		}
+	}
+	require.True(t, deferred)
+	res, err := qs.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.MintedRollingDay)
+
+	// The held-back tranche is still queued, not cancelled.
+	held, err := qs.MintSchedule(ctx, &types.QueryMintScheduleRequest{Denom: denom, Id: second.Id})
+	require.NoError(t, err)
+	require.Equal(t, "2027-02-01", held.MintSchedule.NextReleaseDate)
+	require.Zero(t, held.MintSchedule.TranchesReleased)
+	released, err := qs.MintSchedule(ctx, &types.QueryMintScheduleRequest{Denom: denom, Id: first.Id})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, released.MintSchedule.TranchesReleased)
+
+	// Once the first tranche leaves the rolling window the second one is released.
+	require.NoError(t, f.keeper.ReleaseMintTranches(ctx.WithBlockTime(edmontonNoon(t, "2027-02-01").Add(time.Hour))))
+	require.EqualValues(t, 200, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, token.MintedSupply)
+	require.EqualValues(t, 300, token.ScheduledSupply)
+}
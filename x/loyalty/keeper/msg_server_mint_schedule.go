@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k msgServer) CreateMintSchedule(ctx context.Context, msg *types.MsgCreateMintSchedule) (*types.MsgCreateMintScheduleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	}
+	interval := max(msg.Interval, 1)
+	if err := types.ValidateMintScheduleTerms(msg.TrancheAmount, msg.TotalAmount, msg.Cadence, interval, msg.StartDate); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMintSchedule, err.Error())
+	}
+
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	if msg.Creator != token.Creator && !isAuthority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or authority can schedule mints")
+	}
+	if token.AdminRenounced {
+		return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced; minting is disabled")
+	}
+	if err := k.ensureNotPaused(ctx, denom, types.PauseScopeMint); err != nil {
+		return nil, err
+	}
+	if !capAllows(token, msg.TotalAmount) {
+		return nil, errorsmod.Wrap(types.ErrCapExceeded, "schedule total exceeds configured cap")
+	}
+	// Tranches are rate limited when released; one larger than a single mint may be
+	// could never be released.
+	limit, err := k.getMintRateLimit(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if maxTranche := limit.MaxSingleMint(); maxTranche > 0 && msg.TrancheAmount > maxTranche {
+		return nil, errorsmod.Wrapf(types.ErrMintRateLimited, "tranche amount exceeds the mint rate limit of %d", maxTranche)
+	}
+
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	location, err := loadRollupLocation(params.DailyRollupTimezone)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if today := sdkCtx.BlockTime().In(location).Format(types.MintScheduleDateLayout); msg.StartDate < today {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMintSchedule, "start date cannot be before %s", today)
+	}
+
+	id, err := k.MintScheduleSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	schedule := types.MintSchedule{
+		Id:              id,
+		Denom:           denom,
+		Creator:         msg.Creator,
+		Recipient:       msg.Recipient,
+		TrancheAmount:   msg.TrancheAmount,
+		TotalAmount:     msg.TotalAmount,
+		Cadence:         msg.Cadence,
+		Interval:        interval,
+		StartDate:       msg.StartDate,
+		NextReleaseDate: msg.StartDate,
+		CreatedAt:       uint64(sdkCtx.BlockTime().Unix()),
+	}
+	if err := k.setMintSchedule(ctx, schedule); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	token.ScheduledSupply += msg.TotalAmount
+	if err := k.Verifiedtoken.Set(ctx, token.Denom, token); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.mint_schedule_created",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("recipient", msg.Recipient),
+			sdk.NewAttribute("tranche_amount", fmt.Sprintf("%d", msg.TrancheAmount)),
+			sdk.NewAttribute("total_amount", fmt.Sprintf("%d", msg.TotalAmount)),
+			sdk.NewAttribute("cadence", msg.Cadence),
+			sdk.NewAttribute("interval", fmt.Sprintf("%d", interval)),
+			sdk.NewAttribute("start_date", msg.StartDate),
+		),
+	)
+
+	return &types.MsgCreateMintScheduleResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelMintSchedule(ctx context.Context, msg *types.MsgCancelMintSchedule) (*types.MsgCancelMintScheduleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := k.getMintSchedule(ctx, denom, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	if msg.Creator != schedule.Creator && msg.Creator != token.Creator && !isAuthority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the schedule creator, token owner or authority can cancel a mint schedule")
+	}
+
+	if err := k.cancelMintSchedule(ctx, schedule); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.mint_schedule_cancelled",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("id", fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute("cancelled_by", msg.Creator),
+			sdk.NewAttribute("released_amount", fmt.Sprintf("%d", schedule.ReleasedAmount)),
+			sdk.NewAttribute("cancelled_amount", fmt.Sprintf("%d", schedule.Remaining())),
+		),
+	)
+
+	return &types.MsgCancelMintScheduleResponse{
+		ReleasedAmount:  schedule.ReleasedAmount,
+		CancelledAmount: schedule.Remaining(),
+	}, nil
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+// edmontonNoon returns noon on the given date in the default rollup timezone.
+func edmontonNoon(t *testing.T, date string) time.Time {
+	t.Helper()
+	location, err := time.LoadLocation(types.DefaultDailyRollupTimezone)
+	require.NoError(t, err)
+	day, err := time.ParseInLocation(types.MintScheduleDateLayout, date, location)
+	require.NoError(t, err)
+	return day.Add(12 * time.Hour)
+}
+
+func mintScheduleMsg(creator, denom, recipient string) *types.MsgCreateMintSchedule {
+	return &types.MsgCreateMintSchedule{
+		Creator:       creator,
+		Denom:         denom,
+		Recipient:     recipient,
+		TrancheAmount: 100,
+		TotalAmount:   250,
+		Cadence:       types.MintCadenceMonthly,
+		StartDate:     "2027-01-31",
+	}
+}
+
+func TestMintScheduleCreateValidation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "sched")
+	recipient := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(edmontonNoon(t, "2027-01-31"))
+
+	token := baseVerifiedToken(creator, "sched")
+	token.MaxSupply = 1_000
+	_, err := srv.CreateVerifiedtoken(ctx, token)
+	require.NoError(t, err)
+
+	msg := mintScheduleMsg(creator, denom, recipient)
+	msg.Cadence = "hourly"
+	_, err = srv.CreateMintSchedule(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidMintSchedule)
+
+	msg = mintScheduleMsg(creator, denom, recipient)
+	msg.TrancheAmount = 0
+	_, err = srv.CreateMintSchedule(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidMintSchedule)
+
+	msg = mintScheduleMsg(creator, denom, recipient)
+	msg.StartDate = "2027-01-30"
+	_, err = srv.CreateMintSchedule(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidMintSchedule)
+
+	msg = mintScheduleMsg(creator, denom, recipient)
+	msg.Creator = sample.AccAddress()
+	_, err = srv.CreateMintSchedule(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = mintScheduleMsg(creator, denom, recipient)
+	msg.TotalAmount = 1_001
+	_, err = srv.CreateMintSchedule(ctx, msg)
+	require.ErrorIs(t, err, types.ErrCapExceeded)
+
+	// The schedule reserves its total against the cap.
+	msg = mintScheduleMsg(creator, denom, recipient)
+	msg.TotalAmount = 800
+	_, err = srv.CreateMintSchedule(ctx, msg)
+	require.NoError(t, err)
+	stored, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 800, stored.ScheduledSupply)
+
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: creator, Denom: denom})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: creator, Denom: denom, Recipient: recipient, Amount: 201})
+	require.ErrorIs(t, err, types.ErrCapExceeded)
+	_, err = srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: creator, Denom: denom, Recipient: recipient, Amount: 200})
+	require.NoError(t, err)
+
+	update := metadataUpdate(stored)
+	update.MaxSupply = 999
+	_, err = srv.UpdateVerifiedtoken(ctx, update)
+	require.ErrorIs(t, err, types.ErrInvalidCap)
+
+	_, err = srv.RenounceTokenAdmin(ctx, &types.MsgRenounceTokenAdmin{Creator: creator, Denom: denom})
+	require.NoError(t, err)
+	_, err = srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.ErrorIs(t, err, types.ErrAdminRenounced)
+}
+
+func TestMintScheduleReleasesTranches(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "monthly")
+	recipient := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(edmontonNoon(t, "2027-01-30"))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "monthly"))
+	require.NoError(t, err)
+	resp, err := srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+
+	release := func(date string) sdk.Context {
+		releaseCtx := ctx.WithBlockTime(edmontonNoon(t, date)).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.ReleaseMintTranches(releaseCtx))
+		return releaseCtx
+	}
+	balance := func() int64 {
+		return f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64()
+	}
+
+	release("2027-01-30")
+	require.Zero(t, balance())
+
+	release("2027-01-31")
+	require.EqualValues(t, 100, balance())
+	schedule, err := qs.MintSchedule(ctx, &types.QueryMintScheduleRequest{Denom: denom, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, "2027-02-28", schedule.MintSchedule.NextReleaseDate)
+	require.EqualValues(t, 1, schedule.MintSchedule.TranchesReleased)
+
+	// Running again on the same day is a no-op.
+	release("2027-01-31")
+	require.EqualValues(t, 100, balance())
+
+	release("2027-02-28")
+	require.EqualValues(t, 200, balance())
+	schedule, err = qs.MintSchedule(ctx, &types.QueryMintScheduleRequest{Denom: denom, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, "2027-03-31", schedule.MintSchedule.NextReleaseDate)
+
+	// A missed date is caught up by the next block; the last tranche is partial.
+	releaseCtx := release("2027-04-02")
+	require.EqualValues(t, 250, balance())
+	var completed bool
+	for _, event := range releaseCtx.EventManager().Events() {
+		if event.Type == "loyalty.mint_schedule_completed" {
+			completed = true
+		}
+	}
+	require.True(t, completed)
+
+	_, err = qs.MintSchedule(ctx, &types.QueryMintScheduleRequest{Denom: denom, Id: resp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 250, token.MintedSupply)
+	require.Zero(t, token.ScheduledSupply)
+}
+
+func TestMintScheduleCancel(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "cancel")
+	recipient := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(edmontonNoon(t, "2027-01-31"))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "cancel"))
+	require.NoError(t, err)
+	first, err := srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+	second, err := srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+
+	list, err := qs.MintSchedules(ctx, &types.QueryMintSchedulesRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, list.MintSchedules, 2)
+
+	require.NoError(t, f.keeper.ReleaseMintTranches(ctx))
+
+	_, err = srv.CancelMintSchedule(ctx, &types.MsgCancelMintSchedule{Creator: sample.AccAddress(), Denom: denom, Id: first.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CancelMintSchedule(ctx, &types.MsgCancelMintSchedule{Creator: creator, Denom: denom, Id: 99})
+	require.ErrorIs(t, err, types.ErrMintScheduleNotFound)
+
+	resp, err := srv.CancelMintSchedule(ctx, &types.MsgCancelMintSchedule{Creator: creator, Denom: denom, Id: first.Id})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, resp.ReleasedAmount)
+	require.EqualValues(t, 150, resp.CancelledAmount)
+
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, token.MintedSupply)
+	require.EqualValues(t, 150, token.ScheduledSupply)
+
+	list, err = qs.MintSchedules(ctx, &types.QueryMintSchedulesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.MintSchedules, 1)
+	require.Equal(t, second.Id, list.MintSchedules[0].Id)
+
+	// Cancelled schedules never release again.
+	require.NoError(t, f.keeper.ReleaseMintTranches(ctx.WithBlockTime(edmontonNoon(t, "2027-02-28"))))
+	require.EqualValues(t, 300, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+
+	_, err = srv.CancelMintSchedule(ctx, &types.MsgCancelMintSchedule{Creator: creator, Denom: denom, Id: second.Id})
+	require.NoError(t, err)
+	token, err = f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Zero(t, token.ScheduledSupply)
+}
+
+func TestMintScheduleDefersFailedTranche(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "defer")
+	recipient := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(edmontonNoon(t, "2027-01-31"))
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "defer"))
+	require.NoError(t, err)
+	resp, err := srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+
+	// Shrink the reservation so the due tranche cannot be minted.
+	token, err := f.keeper.Verifiedtoken.Get(ctx, denom)
+	require.NoError(t, err)
+	token.ScheduledSupply = 50
+	require.NoError(t, f.keeper.Verifiedtoken.Set(ctx, denom, token))
+
+	releaseCtx := ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ReleaseMintTranches(releaseCtx))
+	require.Zero(t, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+	var deferred bool
+	for _, event := range releaseCtx.EventManager().Events() {
+		if event.Type == "loyalty.mint_tranche_deferred" {
+			deferred = true
+		}
+	}
+	require.True(t, deferred)
+
+	// The schedule survives and retries the tranche the next day.
+	schedule, err := qs.MintSchedule(ctx, &types.QueryMintScheduleRequest{Denom: denom, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, "2027-02-01", schedule.MintSchedule.NextReleaseDate)
+	require.Zero(t, schedule.MintSchedule.TranchesReleased)
+
+	token.ScheduledSupply = 250
+	require.NoError(t, f.keeper.Verifiedtoken.Set(ctx, denom, token))
+	require.NoError(t, f.keeper.ReleaseMintTranches(ctx.WithBlockTime(edmontonNoon(t, "2027-02-01"))))
+	require.EqualValues(t, 100, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+
+	// Later tranches keep their original dates.
+	schedule, err = qs.MintSchedule(ctx, &types.QueryMintScheduleRequest{Denom: denom, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, "2027-02-28", schedule.MintSchedule.NextReleaseDate)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"tokenchain/x/loyalty/types"
 
@@ -48,16 +49,19 @@ func (k msgServer) MintVerifiedToken(ctx context.Context, msg *types.MsgMintVeri
 	if token.AdminRenounced {
 		return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced; minting is disabled")
 	}
+	if err := k.ensureNotPaused(ctx, msg.Denom, types.PauseScopeMint); err != nil {
+		return nil, err
+	}
 
-	if token.MintedSupply > token.MaxSupply-msg.Amount {
+	// Supply reserved by mint schedules is not available for direct mints.
+	if !capAllows(token, msg.Amount) {
 		return nil, errorsmod.Wrap(types.ErrCapExceeded, "mint amount exceeds configured cap")
 	}
-
-	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, sdkmath.NewIntFromUint64(msg.Amount)))
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+	if err := k.consumeMintRateLimit(ctx, msg.Denom, msg.Amount); err != nil {
 		return nil, err
 	}
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+
+	if err := k.mintVerifiedCoins(ctx, msg.Denom, recipientAddr, msg.Amount); err != nil {
 		return nil, err
 	}
 
@@ -66,8 +70,35 @@ func (k msgServer) MintVerifiedToken(ctx context.Context, msg *types.MsgMintVeri
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.verified_token_minted",
+			sdk.NewAttribute("denom", token.Denom),
+			sdk.NewAttribute("minter", msg.Creator),
+			sdk.NewAttribute("recipient", msg.Recipient),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", msg.Amount)),
+			sdk.NewAttribute("minted_supply", fmt.Sprintf("%d", token.MintedSupply)),
+		),
+	)
+
 	return &types.MsgMintVerifiedTokenResponse{
 		Denom:        token.Denom,
 		MintedSupply: token.MintedSupply,
 	}, nil
 }
+
+// capAllows reports whether amount more can be committed without minted plus scheduled
+// supply exceeding the token's max supply.
+func capAllows(token types.Verifiedtoken, amount uint64) bool {
+	committed := token.MintedSupply + token.ScheduledSupply
+	return amount <= token.MaxSupply && committed <= token.MaxSupply-amount
+}
+
+// mintVerifiedCoins mints amount of denom into the module account and sends it to recipient.
+func (k Keeper) mintVerifiedCoins(ctx context.Context, denom string, recipient sdk.AccAddress, amount uint64) error {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(amount)))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
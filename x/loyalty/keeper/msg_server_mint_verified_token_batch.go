@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k msgServer) MintVerifiedTokenBatch(ctx context.Context, msg *types.MsgMintVerifiedTokenBatch) (*types.MsgMintVerifiedTokenBatchResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if len(msg.Recipients) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one recipient is required")
+	}
+	if len(msg.Recipients) > types.MaxMintBatchRecipients {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "batch has %d recipients; at most %d are allowed", len(msg.Recipients), types.MaxMintBatchRecipients)
+	}
+
+	var total uint64
+	seen := make(map[string]struct{}, len(msg.Recipients))
+	for i, recipient := range msg.Recipients {
+		addr, err := k.addressCodec.StringToBytes(recipient.Recipient)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address at index %d", i)
+		}
+		if k.bankKeeper.BlockedAddr(addr) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "recipient %s is not allowed to receive funds", recipient.Recipient)
+		}
+		if _, ok := seen[recipient.Recipient]; ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate recipient %s", recipient.Recipient)
+		}
+		seen[recipient.Recipient] = struct{}{}
+		if recipient.Amount == 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount for %s must be greater than zero", recipient.Recipient)
+		}
+		if recipient.Amount > math.MaxUint64-total {
+			return nil, errorsmod.Wrap(types.ErrCapExceeded, "batch total overflows")
+		}
+		total += recipient.Amount
+	}
+
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validateTokenFactoryDenom(denom); err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	if msg.Creator != token.Creator && !isAuthority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or authority can mint")
+	}
+	if token.AdminRenounced {
+		return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced; minting is disabled")
+	}
+	if err := k.ensureNotPaused(ctx, denom, types.PauseScopeMint); err != nil {
+		return nil, err
+	}
+	if !capAllows(token, total) {
+		return nil, errorsmod.Wrap(types.ErrCapExceeded, "batch total exceeds configured cap")
+	}
+	if err := k.consumeMintRateLimit(ctx, denom, total); err != nil {
+		return nil, err
+	}
+
+	totalCoins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(total)))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, totalCoins); err != nil {
+		return nil, err
+	}
+	moduleAddr, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	outputs := make([]banktypes.Output, 0, len(msg.Recipients))
+	for _, recipient := range msg.Recipients {
+		outputs = append(outputs, banktypes.Output{
+			Address: recipient.Recipient,
+			Coins:   sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(recipient.Amount))),
+		})
+	}
+	if err := k.bankKeeper.InputOutputCoins(ctx, banktypes.Input{Address: moduleAddr, Coins: totalCoins}, outputs); err != nil {
+		return nil, err
+	}
+
+	token.MintedSupply += total
+	if err := k.Verifiedtoken.Set(ctx, token.Denom, token); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.verified_token_batch_minted",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("minter", msg.Creator),
+			sdk.NewAttribute("recipients", fmt.Sprintf("%d", len(msg.Recipients))),
+			sdk.NewAttribute("total_amount", fmt.Sprintf("%d", total)),
+		),
+	)
+
+	return &types.MsgMintVerifiedTokenBatchResponse{
+		Denom:        token.Denom,
+		MintedSupply: token.MintedSupply,
+		TotalAmount:  total,
+		Minted:       msg.Recipients,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestMintVerifiedTokenBatch(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "airdrop")
+
+	token := baseVerifiedToken(creator, "airdrop")
+	token.MaxSupply = 1_000
+	_, err := srv.CreateVerifiedtoken(f.ctx, token)
+	require.NoError(t, err)
+
+	alice, bob, carol := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	recipients := []types.MintRecipient{
+		{Recipient: alice, Amount: 100},
+		{Recipient: bob, Amount: 250},
+		{Recipient: carol, Amount: 50},
+	}
+	resp, err := srv.MintVerifiedTokenBatch(f.ctx, &types.MsgMintVerifiedTokenBatch{Creator: creator, Denom: denom, Recipients: recipients})
+	require.NoError(t, err)
+	require.EqualValues(t, 400, resp.TotalAmount)
+	require.EqualValues(t, 400, resp.MintedSupply)
+	require.Equal(t, recipients, resp.Minted)
+
+	require.EqualValues(t, 100, f.bankKeeper.accountBalances[alice].AmountOf(denom).Int64())
+	require.EqualValues(t, 250, f.bankKeeper.accountBalances[bob].AmountOf(denom).Int64())
+	require.EqualValues(t, 50, f.bankKeeper.accountBalances[carol].AmountOf(denom).Int64())
+	require.True(t, f.bankKeeper.moduleBalances[types.ModuleName].AmountOf(denom).IsZero())
+
+	stored, err := f.keeper.Verifiedtoken.Get(f.ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 400, stored.MintedSupply)
+
+	// The aggregate is checked against the cap even when every entry fits on its own.
+	_, err = srv.MintVerifiedTokenBatch(f.ctx, &types.MsgMintVerifiedTokenBatch{
+		Creator: creator,
+		Denom:   denom,
+		Recipients: []types.MintRecipient{
+			{Recipient: alice, Amount: 400},
+			{Recipient: bob, Amount: 201},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrCapExceeded)
+	stored, err = f.keeper.Verifiedtoken.Get(f.ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 400, stored.MintedSupply)
+}
+
+func TestMintVerifiedTokenBatchValidation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "airdropv")
+	_, err := srv.CreateVerifiedtoken(f.ctx, baseVerifiedToken(creator, "airdropv"))
+	require.NoError(t, err)
+
+	alice := sample.AccAddress()
+	tooMany := make([]types.MintRecipient, types.MaxMintBatchRecipients+1)
+	for i := range tooMany {
+		tooMany[i] = types.MintRecipient{Recipient: sample.AccAddress(), Amount: 1}
+	}
+
+	tests := []struct {
+		desc       string
+		creator    string
+		recipients []types.MintRecipient
+		err        error
+	}{
+		{desc: "empty", creator: creator, err: sdkerrors.ErrInvalidRequest},
+		{desc: "too many recipients", creator: creator, recipients: tooMany, err: sdkerrors.ErrInvalidRequest},
+		{
+			desc:       "invalid recipient",
+			creator:    creator,
+			recipients: []types.MintRecipient{{Recipient: "invalid", Amount: 1}},
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:       "blocked recipient",
+			creator:    creator,
+			recipients: []types.MintRecipient{{Recipient: authtypes.NewModuleAddress(types.ModuleName).String(), Amount: 1}},
+			err:        sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:       "duplicate recipient",
+			creator:    creator,
+			recipients: []types.MintRecipient{{Recipient: alice, Amount: 1}, {Recipient: alice, Amount: 2}},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:       "zero amount",
+			creator:    creator,
+			recipients: []types.MintRecipient{{Recipient: alice, Amount: 0}},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:       "not owner",
+			creator:    sample.AccAddress(),
+			recipients: []types.MintRecipient{{Recipient: alice, Amount: 1}},
+			err:        sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.MintVerifiedTokenBatch(f.ctx, &types.MsgMintVerifiedTokenBatch{Creator: tc.creator, Denom: denom, Recipients: tc.recipients})
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"tokenchain/x/loyalty/keeper"
@@ -36,4 +37,10 @@ func TestMintVerifiedTokenRequiresFullDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, denom, resp.Denom)
 	require.EqualValues(t, 7, resp.MintedSupply)
+	requireEvent(t, sdk.UnwrapSDKContext(f.ctx), "loyalty.verified_token_minted", map[string]string{
+		"denom":         denom,
+		"recipient":     creator,
+		"amount":        "7",
+		"minted_supply": "7",
+	})
 }
@@ -51,7 +51,7 @@ func (k msgServer) QueueRecoveryTransfer(ctx context.Context, msg *types.MsgQueu
 	if err := k.ensureGroupPolicyExists(ctx, token.RecoveryGroupPolicy); err != nil {
 		return nil, err
 	}
-	isAuthority := k.ensureAuthority(msg.Creator) == nil
+	isAuthority := k.ensureRole(ctx, msg.Creator, types.RoleRecoveryOverseer) == nil
 	if msg.Creator != token.RecoveryGroupPolicy && !isAuthority {
 		return nil, errorsmod.Wrap(types.ErrRecoveryUnauthorized, "only recovery group policy or authority can queue recovery")
 	}
@@ -59,7 +59,7 @@ func (k msgServer) QueueRecoveryTransfer(ctx context.Context, msg *types.MsgQueu
 	if err != nil {
 		return nil, err
 	}
-	minTimelock := minimumRecoveryTimelockHours(ctx, params)
+	minTimelock := params.MinimumRecoveryTimelockHours()
 	if token.RecoveryTimelockHours < minTimelock {
 		return nil, errorsmod.Wrapf(types.ErrRecoveryPolicy, "recovery timelock must be at least %d hours for this network", minTimelock)
 	}
@@ -18,12 +18,15 @@ func (k msgServer) RecordMerchantAllocation(ctx context.Context, msg *types.MsgR
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid authority address")
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAccrualRecorder); err != nil {
 		return nil, err
 	}
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
 	}
+	if err := k.ensureNotPaused(ctx, msg.Denom, types.PauseScopeClaim); err != nil {
+		return nil, err
+	}
 	if msg.ActivityScore == 0 {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "activity score must be greater than zero")
 	}
@@ -54,7 +57,6 @@ func (k msgServer) RecordMerchantAllocation(ctx context.Context, msg *types.MsgR
 		}
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
-	token = normalizeMerchantIncentiveRouting(token)
 	if err := types.ValidateMerchantIncentiveRouting(token.MerchantIncentiveStakersBps, token.MerchantIncentiveTreasuryBps); err != nil {
 		return nil, errorsmod.Wrap(types.ErrMerchantRouting, err.Error())
 	}
@@ -79,9 +81,10 @@ func (k msgServer) RecordMerchantAllocation(ctx context.Context, msg *types.MsgR
 		TreasuryAmount:               treasuryAmount,
 		MerchantIncentiveStakersBps:  token.MerchantIncentiveStakersBps,
 		MerchantIncentiveTreasuryBps: token.MerchantIncentiveTreasuryBps,
+		MerchantId:                   token.MerchantId,
 	}
 
-	if err := k.Merchantallocation.Set(ctx, key, record); err != nil {
+	if err := k.setMerchantallocation(ctx, record); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"time"
 
@@ -24,60 +25,91 @@ func (k msgServer) RecordRewardAccrual(ctx context.Context, msg *types.MsgRecord
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
 	}
+	if err := k.ensureNotPaused(ctx, msg.Denom, types.PauseScopeClaim); err != nil {
+		return nil, err
+	}
 	if msg.Amount == 0 {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than zero")
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAccrualRecorder); err != nil {
 		return nil, err
 	}
 
-	params, err := k.getParams(ctx)
+	record, rollupDate, err := k.creditRewardAccrual(ctx, msg.Creator, msg.Address, msg.Denom, msg.Amount, msg.Date)
 	if err != nil {
 		return nil, err
 	}
 
-	rollupDate := msg.Date
+	return &types.MsgRecordRewardAccrualResponse{
+		Key:         record.Key,
+		Address:     record.Address,
+		Denom:       record.Denom,
+		AmountAdded: msg.Amount,
+		TotalAmount: record.Amount,
+		RollupDate:  rollupDate,
+	}, nil
+}
+
+// creditRewardAccrual adds amount to the accrual of address for denom on the rollup
+// date (today in the rollup timezone when date is empty). Callers authorize creator.
+func (k Keeper) creditRewardAccrual(ctx context.Context, creator, address, denom string, amount uint64, date string) (types.Rewardaccrual, string, error) {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return types.Rewardaccrual{}, "", err
+	}
+
+	rollupDate := date
 	if rollupDate == "" {
 		location, err := loadRollupLocation(params.DailyRollupTimezone)
 		if err != nil {
-			return nil, err
+			return types.Rewardaccrual{}, "", err
 		}
 		rollupDate = sdk.UnwrapSDKContext(ctx).BlockTime().In(location).Format(rollupDateLayout)
 	} else if _, err := time.Parse(rollupDateLayout, rollupDate); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "date must be YYYY-MM-DD")
+		return types.Rewardaccrual{}, "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "date must be YYYY-MM-DD")
 	}
 
-	key := rewardAccrualKey(msg.Address, msg.Denom)
+	key := rewardAccrualKey(address, denom)
 	record, err := k.Rewardaccrual.Get(ctx, key)
+	credited := err == nil
 	if err != nil {
 		if !errors.Is(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+			return types.Rewardaccrual{}, "", errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 		}
 		record = types.Rewardaccrual{
-			Creator:        msg.Creator,
+			Creator:        creator,
 			Key:            key,
-			Address:        msg.Address,
-			Denom:          msg.Denom,
+			Address:        address,
+			Denom:          denom,
 			Amount:         0,
 			LastRollupDate: rollupDate,
 		}
 	}
 
-	if record.Amount > math.MaxUint64-msg.Amount {
-		return nil, errorsmod.Wrap(types.ErrAccrualOverflow, "accrual amount would overflow uint64")
+	if record.Amount > math.MaxUint64-amount {
+		return types.Rewardaccrual{}, "", errorsmod.Wrap(types.ErrAccrualOverflow, "accrual amount would overflow uint64")
 	}
-	record.Amount += msg.Amount
+	record.Amount += amount
 	record.LastRollupDate = rollupDate
-	if err := k.Rewardaccrual.Set(ctx, key, record); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	if err := k.setRewardAccrual(ctx, record); err != nil {
+		return types.Rewardaccrual{}, "", errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.reward_accrued",
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("address", address),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
+			sdk.NewAttribute("total_amount", fmt.Sprintf("%d", record.Amount)),
+			sdk.NewAttribute("date", rollupDate),
+		),
+	)
+	// Addresses with nothing to claim yet get their claim fees sponsored.
+	if !credited {
+		if err := k.sponsorClaimFees(ctx, denom, address); err != nil {
+			return types.Rewardaccrual{}, "", errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
 	}
 
-	return &types.MsgRecordRewardAccrualResponse{
-		Key:         key,
-		Address:     record.Address,
-		Denom:       record.Denom,
-		AmountAdded: msg.Amount,
-		TotalAmount: record.Amount,
-		RollupDate:  rollupDate,
-	}, nil
+	return record, rollupDate, nil
 }
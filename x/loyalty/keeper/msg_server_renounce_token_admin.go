@@ -32,6 +32,9 @@ func (k msgServer) RenounceTokenAdmin(ctx context.Context, msg *types.MsgRenounc
 	if msg.Creator != token.Creator && !isAuthority {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or authority can renounce token admin")
 	}
+	if err := k.ensureAdminNotLockedOut(ctx, token.Denom, msg.Creator); err != nil {
+		return nil, err
+	}
 	if token.AdminRenounced {
 		return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin already renounced")
 	}
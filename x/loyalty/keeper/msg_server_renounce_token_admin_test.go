@@ -131,7 +131,6 @@ func TestUpdateVerifiedTokenBlockedAfterAdminRenounceForCapOrRecoveryChanges(t *
 		Website:               "https://tokentap.ca",
 		MaxSupply:             2_000_000,
 		MintedSupply:          0,
-		Verified:              true,
 		SeizureOptIn:          false,
 		RecoveryGroupPolicy:   "",
 		RecoveryTimelockHours: 0,
@@ -17,7 +17,7 @@ func (k msgServer) CreateRewardaccrual(ctx context.Context, msg *types.MsgCreate
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAccrualRecorder); err != nil {
 		return nil, err
 	}
 	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
@@ -26,6 +26,9 @@ func (k msgServer) CreateRewardaccrual(ctx context.Context, msg *types.MsgCreate
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
 	}
+	if err := k.ensureNotPaused(ctx, msg.Denom, types.PauseScopeClaim); err != nil {
+		return nil, err
+	}
 	if msg.Key != rewardAccrualKey(msg.Address, msg.Denom) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "key must be <address>|<denom>")
 	}
@@ -50,7 +53,7 @@ func (k msgServer) CreateRewardaccrual(ctx context.Context, msg *types.MsgCreate
 		LastRollupDate: msg.LastRollupDate,
 	}
 
-	if err := k.Rewardaccrual.Set(ctx, rewardaccrual.Key, rewardaccrual); err != nil {
+	if err := k.setRewardAccrual(ctx, rewardaccrual); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
@@ -61,7 +64,7 @@ func (k msgServer) UpdateRewardaccrual(ctx context.Context, msg *types.MsgUpdate
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAccrualRecorder); err != nil {
 		return nil, err
 	}
 	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
@@ -70,6 +73,9 @@ func (k msgServer) UpdateRewardaccrual(ctx context.Context, msg *types.MsgUpdate
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
 	}
+	if err := k.ensureNotPaused(ctx, msg.Denom, types.PauseScopeClaim); err != nil {
+		return nil, err
+	}
 	if msg.Key != rewardAccrualKey(msg.Address, msg.Denom) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "key must be <address>|<denom>")
 	}
@@ -96,7 +102,7 @@ func (k msgServer) UpdateRewardaccrual(ctx context.Context, msg *types.MsgUpdate
 		LastRollupDate: msg.LastRollupDate,
 	}
 
-	if err := k.Rewardaccrual.Set(ctx, rewardaccrual.Key, rewardaccrual); err != nil {
+	if err := k.setRewardAccrual(ctx, rewardaccrual); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update rewardaccrual")
 	}
 
@@ -107,12 +113,12 @@ func (k msgServer) DeleteRewardaccrual(ctx context.Context, msg *types.MsgDelete
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
-	if err := k.ensureAuthority(msg.Creator); err != nil {
+	if err := k.ensureRole(ctx, msg.Creator, types.RoleAccrualRecorder); err != nil {
 		return nil, err
 	}
 
 	// Check if the value exists
-	_, err := k.Rewardaccrual.Get(ctx, msg.Key)
+	val, err := k.Rewardaccrual.Get(ctx, msg.Key)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
@@ -120,8 +126,11 @@ func (k msgServer) DeleteRewardaccrual(ctx context.Context, msg *types.MsgDelete
 
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
+	if err := k.ensureNotPaused(ctx, val.Denom, types.PauseScopeClaim); err != nil {
+		return nil, err
+	}
 
-	if err := k.Rewardaccrual.Remove(ctx, msg.Key); err != nil {
+	if err := k.removeRewardAccrual(ctx, val); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove rewardaccrual")
 	}
 
@@ -45,6 +45,34 @@ func TestRecordRewardAccrualResponse(t *testing.T) {
 	require.EqualValues(t, 25, resp2.AmountAdded)
 	require.EqualValues(t, 125, resp2.TotalAmount)
 	require.Equal(t, "2026-02-26", resp2.RollupDate)
+	requireEvent(t, sdk.UnwrapSDKContext(f.ctx), "loyalty.reward_accrued", map[string]string{
+		"denom":        "utoken",
+		"address":      address,
+		"amount":       "25",
+		"total_amount": "125",
+		"date":         "2026-02-26",
+	})
+}
+
+// requireEvent fails unless ctx emitted an event of eventType with attrs.
+func requireEvent(t *testing.T, ctx sdk.Context, eventType string, attrs map[string]string) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		matched := true
+		for key, value := range attrs {
+			if attr, ok := event.GetAttribute(key); !ok || attr.Value != value {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return
+		}
+	}
+	t.Fatalf("no %s event with %v", eventType, attrs)
 }
 
 func TestClaimRewardResponse(t *testing.T) {
@@ -74,6 +102,11 @@ func TestClaimRewardResponse(t *testing.T) {
 	require.Equal(t, address, resp.Address)
 	require.Equal(t, "utoken", resp.Denom)
 	require.EqualValues(t, 345, resp.AmountClaimed)
+	requireEvent(t, sdk.UnwrapSDKContext(f.ctx), "loyalty.reward_claimed", map[string]string{
+		"denom":   "utoken",
+		"address": address,
+		"amount":  "345",
+	})
 
 	exists, err := f.keeper.Rewardaccrual.Has(f.ctx, key)
 	require.NoError(t, err)
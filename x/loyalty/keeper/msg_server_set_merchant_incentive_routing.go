@@ -34,6 +34,9 @@ func (k msgServer) SetMerchantIncentiveRouting(ctx context.Context, msg *types.M
 	if msg.Creator != token.Creator && !isAuthority {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or authority can set merchant incentive routing")
 	}
+	if err := k.ensureAdminNotLockedOut(ctx, msg.Denom, msg.Creator); err != nil {
+		return nil, err
+	}
 
 	if err := types.ValidateMerchantIncentiveRouting(msg.MerchantIncentiveStakersBps, msg.MerchantIncentiveTreasuryBps); err != nil {
 		return nil, errorsmod.Wrap(types.ErrMerchantRouting, err.Error())
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// maxPauseReasonLength bounds the free-form reason stored with a pause.
+const maxPauseReasonLength = 256
+
+func (k msgServer) PauseToken(ctx context.Context, msg *types.MsgPauseToken) (*types.MsgPauseTokenResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	isAuthority := k.isPauseAuthority(ctx, msg.Creator)
+	if !isAuthority {
+		if msg.Creator != token.Creator {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or a pause authority can pause a token")
+		}
+		if token.AdminRenounced {
+			return nil, errorsmod.Wrap(types.ErrAdminRenounced, "token admin has been renounced")
+		}
+	}
+
+	scopes, err := types.NormalizePauseScopes(msg.Scopes)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPause, err.Error())
+	}
+	reason := strings.TrimSpace(msg.Reason)
+	if len(reason) > maxPauseReasonLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPause, "reason must be at most %d bytes", maxPauseReasonLength)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+	if msg.ExpiresAt != 0 && msg.ExpiresAt <= now {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPause, "expires_at %d is not in the future", msg.ExpiresAt)
+	}
+
+	// An owner may tighten or replace their own pause, but not one an authority put in
+	// place: that pause may be there precisely because the owner key is not trusted.
+	current, active, err := k.activeTokenPause(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if active && current.ByAuthority && !isAuthority {
+		return nil, errorsmod.Wrapf(types.ErrTokenPaused, "%s was paused by an authority; only an authority can change it", denom)
+	}
+
+	pause := types.TokenPause{
+		Denom:       denom,
+		Scopes:      scopes,
+		PausedBy:    msg.Creator,
+		ByAuthority: isAuthority && msg.Creator != token.Creator,
+		Reason:      reason,
+		PausedAt:    now,
+		ExpiresAt:   msg.ExpiresAt,
+	}
+	if err := k.setTokenPause(ctx, pause); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(tokenPauseEvent("loyalty.token_paused", pause))
+
+	return &types.MsgPauseTokenResponse{}, nil
+}
+
+func (k msgServer) UnpauseToken(ctx context.Context, msg *types.MsgUnpauseToken) (*types.MsgUnpauseTokenResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	denom, err := k.resolveStoredDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	token, err := k.Verifiedtoken.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTokenNotFound, denom)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	isAuthority := k.isPauseAuthority(ctx, msg.Creator)
+	if msg.Creator != token.Creator && !isAuthority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only token owner or a pause authority can unpause a token")
+	}
+
+	pause, active, err := k.activeTokenPause(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !active {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPause, "%s is not paused", denom)
+	}
+	if pause.ByAuthority && !isAuthority {
+		return nil, errorsmod.Wrapf(types.ErrTokenPaused, "%s was paused by an authority; only an authority can unpause it", denom)
+	}
+
+	lift, err := types.NormalizePauseScopes(msg.Scopes)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPause, err.Error())
+	}
+	remaining := make([]string, 0, len(pause.Scopes))
+	for _, scope := range pause.Scopes {
+		if !slices.Contains(lift, scope) {
+			remaining = append(remaining, scope)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if len(remaining) == 0 {
+		if err := k.removeTokenPause(ctx, denom); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.token_unpaused",
+				sdk.NewAttribute("denom", denom),
+				sdk.NewAttribute("unpaused_by", msg.Creator),
+				sdk.NewAttribute("reason", "unpaused"),
+			),
+		)
+		return &types.MsgUnpauseTokenResponse{}, nil
+	}
+
+	pause.Scopes = remaining
+	if err := k.setTokenPause(ctx, pause); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	sdkCtx.EventManager().EmitEvent(tokenPauseEvent("loyalty.token_pause_updated", pause))
+
+	return &types.MsgUnpauseTokenResponse{RemainingScopes: remaining}, nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+// createMerchantToken allowlists a fresh merchant and registers a token it owns.
+func createMerchantToken(t *testing.T, f *fixture, srv types.MsgServer, ctx sdk.Context, subdenom string) (string, string) {
+	t.Helper()
+	setAllowlistedMode(t, f)
+	merchant := sample.AccAddress()
+	_, err := srv.CreateCreatorallowlist(ctx, &types.MsgCreateCreatorallowlist{
+		Creator: authorityAddress(t, f),
+		Address: merchant,
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	_, err = srv.CreateVerifiedtoken(ctx, baseVerifiedToken(merchant, subdenom))
+	require.NoError(t, err)
+	return merchant, factoryDenom(merchant, subdenom)
+}
+
+func TestTokenPauseScopes(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	holder := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	merchant, denom := createMerchantToken(t, f, srv, ctx, "pausable")
+
+	_, err := srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: merchant, Denom: denom, Recipient: holder, Amount: 100})
+	require.NoError(t, err)
+
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: sample.AccAddress(), Denom: denom})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: merchant, Denom: denom, Scopes: []string{"burn"}})
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: merchant, Denom: denom, Scopes: []string{types.PauseScopeMint}, Reason: "backend compromised"})
+	require.NoError(t, err)
+
+	_, err = srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: merchant, Denom: denom, Recipient: holder, Amount: 1})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = srv.MintVerifiedTokenBatch(ctx, &types.MsgMintVerifiedTokenBatch{Creator: merchant, Denom: denom, Recipients: []types.MintRecipient{{Recipient: holder, Amount: 1}}})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	// Claims and transfers are still open.
+	_, err = srv.RecordRewardAccrual(ctx, &types.MsgRecordRewardAccrual{Creator: authority, Address: holder, Denom: denom, Amount: 5})
+	require.NoError(t, err)
+	_, err = f.keeper.SendRestrictionFn(ctx, sdk.MustAccAddressFromBech32(holder), sdk.MustAccAddressFromBech32(merchant), sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.NoError(t, err)
+
+	// Re-pausing replaces the scopes.
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: merchant, Denom: denom, Scopes: []string{types.PauseScopeTransfer, types.PauseScopeClaim, types.PauseScopeClaim}})
+	require.NoError(t, err)
+	pause, err := f.keeper.TokenPause.Get(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, []string{types.PauseScopeClaim, types.PauseScopeTransfer}, pause.Scopes)
+	require.False(t, pause.ByAuthority)
+
+	_, err = srv.RecordRewardAccrual(ctx, &types.MsgRecordRewardAccrual{Creator: authority, Address: holder, Denom: denom, Amount: 5})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = srv.ClaimReward(ctx, &types.MsgClaimReward{Creator: holder, Denom: denom})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = srv.FundRewardPool(ctx, &types.MsgFundRewardPool{Creator: holder, Denom: denom, Amount: 1})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = f.keeper.SendRestrictionFn(ctx, sdk.MustAccAddressFromBech32(holder), sdk.MustAccAddressFromBech32(merchant), sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	// Other denoms and the loyalty module account are not restricted.
+	_, err = f.keeper.SendRestrictionFn(ctx, sdk.MustAccAddressFromBech32(holder), sdk.MustAccAddressFromBech32(merchant), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	require.NoError(t, err)
+	_, err = f.keeper.SendRestrictionFn(ctx, authtypes.NewModuleAddress(types.ModuleName), sdk.MustAccAddressFromBech32(holder), sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.NoError(t, err)
+
+	res, err := qs.PausedTokens(ctx, &types.QueryPausedTokensRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PausedTokens, 1)
+	require.Equal(t, denom, res.PausedTokens[0].Denom)
+
+	unpaused, err := srv.UnpauseToken(ctx, &types.MsgUnpauseToken{Creator: merchant, Denom: denom, Scopes: []string{types.PauseScopeClaim}})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.PauseScopeTransfer}, unpaused.RemainingScopes)
+	_, err = srv.RecordRewardAccrual(ctx, &types.MsgRecordRewardAccrual{Creator: authority, Address: holder, Denom: denom, Amount: 5})
+	require.NoError(t, err)
+
+	unpaused, err = srv.UnpauseToken(ctx, &types.MsgUnpauseToken{Creator: merchant, Denom: denom})
+	require.NoError(t, err)
+	require.Empty(t, unpaused.RemainingScopes)
+	has, err := f.keeper.TokenPause.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = srv.UnpauseToken(ctx, &types.MsgUnpauseToken{Creator: merchant, Denom: denom})
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+}
+
+func TestTokenPauseExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "expiring")
+	recipient := sample.AccAddress()
+	start := edmontonNoon(t, "2027-01-31")
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	_, err := srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "expiring"))
+	require.NoError(t, err)
+	_, err = srv.CreateMintSchedule(ctx, mintScheduleMsg(creator, denom, recipient))
+	require.NoError(t, err)
+
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: creator, Denom: denom, ExpiresAt: uint64(start.Unix())})
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+	expiresAt := start.Add(48 * time.Hour)
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: creator, Denom: denom, ExpiresAt: uint64(expiresAt.Unix())})
+	require.NoError(t, err)
+
+	// The due tranche stays queued while minting is paused.
+	require.NoError(t, f.keeper.ReleaseMintTranches(ctx))
+	require.True(t, f.bankKeeper.accountBalances[recipient].AmountOf(denom).IsZero())
+
+	// The pause stops applying at expires_at even before the end blocker cleans it up.
+	later := ctx.WithBlockTime(expiresAt)
+	res, err := qs.PausedTokens(later, &types.QueryPausedTokensRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.PausedTokens)
+	_, err = srv.MintVerifiedToken(later, &types.MsgMintVerifiedToken{Creator: creator, Denom: denom, Recipient: recipient, Amount: 1})
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ExpireTokenPauses(later))
+	has, err := f.keeper.TokenPause.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Missed tranches are caught up once the pause is gone.
+	require.NoError(t, f.keeper.ReleaseMintTranches(later))
+	require.EqualValues(t, 101, f.bankKeeper.accountBalances[recipient].AmountOf(denom).Int64())
+
+	// Deleting a token drops its pause.
+	other := factoryDenom(creator, "deleted")
+	_, err = srv.CreateVerifiedtoken(ctx, baseVerifiedToken(creator, "deleted"))
+	require.NoError(t, err)
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: creator, Denom: other, ExpiresAt: uint64(expiresAt.Unix())})
+	require.NoError(t, err)
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: creator, Denom: other})
+	require.NoError(t, err)
+	has, err = f.keeper.TokenPause.Has(ctx, other)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.TokenPauseExpiry.Has(ctx, collections.Join(uint64(expiresAt.Unix()), other))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestTokenPauseByCircuitOperator(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	operator := sample.AccAddress()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_800_000_000, 0))
+	merchant, denom := createMerchantToken(t, f, srv, ctx, "guarded")
+
+	// Circuit permissions for other messages do not cover token pauses.
+	f.circuitKeeper.operators[operator] = []string{sdk.MsgTypeURL(&types.MsgMintVerifiedToken{})}
+	_, err := srv.PauseToken(ctx, &types.MsgPauseToken{Creator: operator, Denom: denom})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	f.circuitKeeper.operators[operator] = []string{sdk.MsgTypeURL(&types.MsgPauseToken{})}
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: operator, Denom: denom, Reason: "incident"})
+	require.NoError(t, err)
+	pause, err := f.keeper.TokenPause.Get(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, pause.ByAuthority)
+	require.Equal(t, operator, pause.PausedBy)
+
+	// The owner is locked out of the pause and of admin changes while it lasts.
+	_, err = srv.UnpauseToken(ctx, &types.MsgUnpauseToken{Creator: merchant, Denom: denom})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = srv.PauseToken(ctx, &types.MsgPauseToken{Creator: merchant, Denom: denom, Scopes: []string{types.PauseScopeMint}})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: merchant, Denom: denom})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = srv.RenounceTokenAdmin(ctx, &types.MsgRenounceTokenAdmin{Creator: merchant, Denom: denom})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = srv.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: merchant, Denom: denom})
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+
+	// Any pause authority can lift it, not only the one that set it.
+	_, err = srv.UnpauseToken(ctx, &types.MsgUnpauseToken{Creator: authority, Denom: denom})
+	require.NoError(t, err)
+	_, err = srv.SetMintRateLimit(ctx, &types.MsgSetMintRateLimit{Creator: merchant, Denom: denom, MaxPerTx: 10})
+	require.NoError(t, err)
+}
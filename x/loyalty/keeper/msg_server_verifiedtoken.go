@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -35,6 +36,9 @@ func (k msgServer) CreateVerifiedtoken(ctx context.Context, msg *types.MsgCreate
 	if msg.MintedSupply != 0 {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "minted_supply must be zero on create; use mint-verified-token")
 	}
+	if err := types.ValidateTokenDenomUnits(types.Verifiedtoken{Denom: msg.Denom, Decimals: msg.Decimals, DenomUnits: msg.DenomUnits}); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenomUnits, err.Error())
+	}
 
 	params, err := k.getParams(ctx)
 	if err != nil {
@@ -62,6 +66,12 @@ func (k msgServer) CreateVerifiedtoken(ctx context.Context, msg *types.MsgCreate
 	if err != nil {
 		return nil, err
 	}
+	if err := k.adjustCreatorUsage(ctx, msg.Creator, 1, 0, msg.MaxSupply, k.enforceCreatorQuota(ctx, msg.Creator, params)); err != nil {
+		return nil, err
+	}
+	if err := k.relinkTokenMerchant(ctx, msg.Creator, msg.Denom, 0, msg.MerchantId); err != nil {
+		return nil, err
+	}
 	merchantStakersBps := types.DefaultMerchantIncentiveStakersBps
 	merchantTreasuryBps := types.DefaultMerchantIncentiveTreasuryBps
 	if err := types.ValidateMerchantIncentiveRouting(merchantStakersBps, merchantTreasuryBps); err != nil {
@@ -78,13 +88,19 @@ func (k msgServer) CreateVerifiedtoken(ctx context.Context, msg *types.MsgCreate
 		Website:                      msg.Website,
 		MaxSupply:                    msg.MaxSupply,
 		MintedSupply:                 0,
-		Verified:                     msg.Verified,
+		Verified:                     false,
 		SeizureOptIn:                 msg.SeizureOptIn,
 		RecoveryGroupPolicy:          recoveryPolicy,
 		RecoveryTimelockHours:        recoveryTimelock,
 		AdminRenounced:               false,
 		MerchantIncentiveStakersBps:  merchantStakersBps,
 		MerchantIncentiveTreasuryBps: merchantTreasuryBps,
+		MerchantId:                   msg.MerchantId,
+		Decimals:                     msg.Decimals,
+		DenomUnits:                   msg.DenomUnits,
+	}
+	if err := k.recordMetadataVersion(ctx, &verifiedtoken); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
 	if err := k.Verifiedtoken.Set(ctx, verifiedtoken.Denom, verifiedtoken); err != nil {
@@ -116,7 +132,6 @@ func (k msgServer) UpdateVerifiedtoken(ctx context.Context, msg *types.MsgUpdate
 
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
-	val = normalizeMerchantIncentiveRouting(val)
 
 	if _, err := k.addressCodec.StringToBytes(msg.Issuer); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid issuer address: %s", err))
@@ -134,12 +149,18 @@ func (k msgServer) UpdateVerifiedtoken(ctx context.Context, msg *types.MsgUpdate
 	if msg.Issuer != denomIssuer {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "issuer must match tokenfactory denom issuer")
 	}
-	if msg.MaxSupply < val.MintedSupply {
-		return nil, errorsmod.Wrapf(types.ErrInvalidCap, "max supply cannot be lower than minted supply (%d)", val.MintedSupply)
+	if msg.MaxSupply < val.MintedSupply+val.ScheduledSupply {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCap, "max supply cannot be lower than minted plus scheduled supply (%d)", val.MintedSupply+val.ScheduledSupply)
 	}
 	if !val.SeizureOptIn && msg.SeizureOptIn && val.MintedSupply > 0 {
 		return nil, errorsmod.Wrap(types.ErrRecoveryPolicy, "cannot enable seizure/recovery after token minting has started")
 	}
+	if val.MintedSupply > 0 && (msg.Decimals != val.Decimals || !types.DenomUnitsEqual(msg.DenomUnits, val.DenomUnits)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenomUnits, "decimals and denom units cannot change after token minting has started")
+	}
+	if err := types.ValidateTokenDenomUnits(types.Verifiedtoken{Denom: msg.Denom, Decimals: msg.Decimals, DenomUnits: msg.DenomUnits}); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenomUnits, err.Error())
+	}
 	if val.AdminRenounced {
 		if msg.MaxSupply != val.MaxSupply ||
 			msg.SeizureOptIn != val.SeizureOptIn ||
@@ -154,6 +175,9 @@ func (k msgServer) UpdateVerifiedtoken(ctx context.Context, msg *types.MsgUpdate
 	if msg.Creator != val.Creator && !isAuthority {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
+	if err := k.ensureAdminNotLockedOut(ctx, msg.Denom, msg.Creator); err != nil {
+		return nil, err
+	}
 
 	params, err := k.getParams(ctx)
 	if err != nil {
@@ -165,24 +189,50 @@ func (k msgServer) UpdateVerifiedtoken(ctx context.Context, msg *types.MsgUpdate
 	if err != nil {
 		return nil, err
 	}
+	// A pending cap increase is already counted against the creator's quota.
+	reservedSupply, err := k.reservedMaxSupply(ctx, val)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.adjustCreatorUsage(ctx, val.Creator, 0, reservedSupply, msg.MaxSupply, k.enforceCreatorQuota(ctx, msg.Creator, params)); err != nil {
+		return nil, err
+	}
+	if err := k.relinkTokenMerchant(ctx, msg.Creator, msg.Denom, val.MerchantId, msg.MerchantId); err != nil {
+		return nil, err
+	}
+
+	// Renames and cap increases wait out the metadata change delay; everything else,
+	// including cap decreases, applies immediately.
+	delayed := msg.Name != val.Name || msg.Symbol != val.Symbol || msg.MaxSupply > val.MaxSupply
+	appliedMaxSupply := min(msg.MaxSupply, val.MaxSupply)
 
 	var verifiedtoken = types.Verifiedtoken{
 		Creator:                      val.Creator,
 		Denom:                        msg.Denom,
 		Issuer:                       msg.Issuer,
-		Name:                         msg.Name,
-		Symbol:                       msg.Symbol,
+		Name:                         val.Name,
+		Symbol:                       val.Symbol,
 		Description:                  msg.Description,
 		Website:                      msg.Website,
-		MaxSupply:                    msg.MaxSupply,
+		MaxSupply:                    appliedMaxSupply,
 		MintedSupply:                 val.MintedSupply,
-		Verified:                     msg.Verified,
+		ScheduledSupply:              val.ScheduledSupply,
+		Verified:                     val.Verified,
 		SeizureOptIn:                 msg.SeizureOptIn,
 		RecoveryGroupPolicy:          recoveryPolicy,
 		RecoveryTimelockHours:        recoveryTimelock,
 		AdminRenounced:               val.AdminRenounced,
 		MerchantIncentiveStakersBps:  val.MerchantIncentiveStakersBps,
 		MerchantIncentiveTreasuryBps: val.MerchantIncentiveTreasuryBps,
+		MerchantId:                   msg.MerchantId,
+		MetadataVersion:              val.MetadataVersion,
+		Decimals:                     msg.Decimals,
+		DenomUnits:                   msg.DenomUnits,
+	}
+	if metadataChanged(val, verifiedtoken) {
+		if err := k.recordMetadataVersion(ctx, &verifiedtoken); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
 	}
 
 	if err := k.Verifiedtoken.Set(ctx, verifiedtoken.Denom, verifiedtoken); err != nil {
@@ -192,7 +242,31 @@ func (k msgServer) UpdateVerifiedtoken(ctx context.Context, msg *types.MsgUpdate
 		return nil, err
 	}
 
-	return &types.MsgUpdateVerifiedtokenResponse{}, nil
+	if !delayed {
+		// Resubmitting the current name, symbol and cap withdraws a pending change.
+		if _, found, err := k.getPendingMetadataChange(ctx, msg.Denom); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		} else if found {
+			if err := k.removePendingMetadataChange(ctx, msg.Denom); err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+			}
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+				sdk.NewEvent(
+					"loyalty.metadata_change_cancelled",
+					sdk.NewAttribute("denom", msg.Denom),
+					sdk.NewAttribute("cancelled_by", msg.Creator),
+				),
+			)
+		}
+		return &types.MsgUpdateVerifiedtokenResponse{}, nil
+	}
+
+	change, err := k.proposeMetadataChange(ctx, msg.Creator, verifiedtoken, msg.Name, msg.Symbol, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateVerifiedtokenResponse{MetadataChangePending: true, EffectiveAt: change.EffectiveAt}, nil
 }
 
 func (k msgServer) DeleteVerifiedtoken(ctx context.Context, msg *types.MsgDeleteVerifiedtoken) (*types.MsgDeleteVerifiedtokenResponse, error) {
@@ -220,13 +294,60 @@ func (k msgServer) DeleteVerifiedtoken(ctx context.Context, msg *types.MsgDelete
 	if msg.Creator != val.Creator && !isAuthority {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
+	if err := k.ensureAdminNotLockedOut(ctx, msg.Denom, msg.Creator); err != nil {
+		return nil, err
+	}
 	if val.MintedSupply > 0 {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot delete token with non-zero minted supply")
 	}
+	if val.ScheduledSupply > 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot delete token with active mint schedules")
+	}
+	gasPool, err := k.getGasPool(ctx, val.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if gasPool.Balance > 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot delete token with a funded gas pool; withdraw it first")
+	}
 
 	if err := k.Verifiedtoken.Remove(ctx, msg.Denom); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove verifiedtoken")
 	}
+	reservedSupply, err := k.reservedMaxSupply(ctx, val)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.removePendingMetadataChange(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.removeMintRateLimitState(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.removeTokenPause(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.removeAddressFreezes(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.TokenIBCPolicy.Remove(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.removeFeeToken(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.removeClaimSponsorship(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.adjustCreatorUsage(ctx, val.Creator, -1, reservedSupply, 0, false); err != nil {
+		return nil, err
+	}
+	if err := k.relinkTokenMerchant(ctx, msg.Creator, val.Denom, val.MerchantId, 0); err != nil {
+		return nil, err
+	}
+	if err := k.clearTokenAttestations(ctx, val.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
 
 	return &types.MsgDeleteVerifiedtokenResponse{}, nil
 }
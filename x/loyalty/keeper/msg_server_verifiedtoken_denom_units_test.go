@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestVerifiedtokenDecimalsFlowIntoBankMetadata(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+
+	whole := baseVerifiedToken(creator, "stamps")
+	whole.Decimals = 0
+	_, err := srv.CreateVerifiedtoken(f.ctx, whole)
+	require.NoError(t, err)
+	denom := factoryDenom(creator, "stamps")
+	metadata := f.bankKeeper.denomMetadata[denom]
+	require.Equal(t, denom, metadata.Display)
+	require.Len(t, metadata.DenomUnits, 1)
+	require.Equal(t, []string{"stamps"}, metadata.DenomUnits[0].Aliases)
+
+	cents := baseVerifiedToken(creator, "cents")
+	cents.Decimals = 2
+	cents.DenomUnits = []types.TokenDenomUnit{{Denom: "kcents", Exponent: 5, Aliases: []string{"kilocents"}}}
+	_, err = srv.CreateVerifiedtoken(f.ctx, cents)
+	require.NoError(t, err)
+	denom = factoryDenom(creator, "cents")
+	token, err := f.keeper.Verifiedtoken.Get(f.ctx, denom)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, token.Decimals)
+	require.Equal(t, cents.DenomUnits, token.DenomUnits)
+
+	metadata = f.bankKeeper.denomMetadata[denom]
+	require.Equal(t, "cents", metadata.Display)
+	require.Len(t, metadata.DenomUnits, 3)
+	require.EqualValues(t, 2, metadata.DenomUnits[1].Exponent)
+	require.Equal(t, "kcents", metadata.DenomUnits[2].Denom)
+	require.EqualValues(t, 5, metadata.DenomUnits[2].Exponent)
+}
+
+func TestVerifiedtokenDenomUnitsValidation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+
+	for _, tc := range []struct {
+		desc     string
+		decimals uint32
+		units    []types.TokenDenomUnit
+	}{
+		{desc: "too many decimals", decimals: types.MaxTokenDecimals + 1},
+		{desc: "unit shares display exponent", decimals: 2, units: []types.TokenDenomUnit{{Denom: "other", Exponent: 2}}},
+		{desc: "unit at base exponent", decimals: 2, units: []types.TokenDenomUnit{{Denom: "other", Exponent: 0}}},
+		{desc: "unit reuses subdenom", decimals: 2, units: []types.TokenDenomUnit{{Denom: "badunits", Exponent: 4}}},
+		{desc: "alias reuses subdenom", decimals: 2, units: []types.TokenDenomUnit{{Denom: "other", Exponent: 4, Aliases: []string{"badunits"}}}},
+		{desc: "invalid unit denom", decimals: 2, units: []types.TokenDenomUnit{{Denom: "1x", Exponent: 4}}},
+		{desc: "blank alias", decimals: 2, units: []types.TokenDenomUnit{{Denom: "other", Exponent: 4, Aliases: []string{" "}}}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := baseVerifiedToken(creator, "badunits")
+			msg.Decimals = tc.decimals
+			msg.DenomUnits = tc.units
+			_, err := srv.CreateVerifiedtoken(f.ctx, msg)
+			require.ErrorIs(t, err, types.ErrInvalidDenomUnits)
+		})
+	}
+}
+
+func TestVerifiedtokenDenomUnitsFrozenAfterMint(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	denom := factoryDenom(creator, "frozen")
+
+	_, err := srv.CreateVerifiedtoken(f.ctx, baseVerifiedToken(creator, "frozen"))
+	require.NoError(t, err)
+	token, err := f.keeper.Verifiedtoken.Get(f.ctx, denom)
+	require.NoError(t, err)
+
+	// Before minting the units can still be reshaped.
+	msg := metadataUpdate(token)
+	msg.Decimals = 2
+	msg.DenomUnits = []types.TokenDenomUnit{{Denom: "kfrozen", Exponent: 5}}
+	_, err = srv.UpdateVerifiedtoken(f.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "frozen", f.bankKeeper.denomMetadata[denom].Display)
+	require.Len(t, f.bankKeeper.denomMetadata[denom].DenomUnits, 3)
+
+	_, err = srv.MintVerifiedToken(f.ctx, &types.MsgMintVerifiedToken{Creator: creator, Denom: denom, Recipient: creator, Amount: 10})
+	require.NoError(t, err)
+	token, err = f.keeper.Verifiedtoken.Get(f.ctx, denom)
+	require.NoError(t, err)
+
+	msg = metadataUpdate(token)
+	msg.Decimals = 6
+	_, err = srv.UpdateVerifiedtoken(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidDenomUnits)
+
+	msg = metadataUpdate(token)
+	msg.DenomUnits = nil
+	_, err = srv.UpdateVerifiedtoken(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidDenomUnits)
+
+	msg = metadataUpdate(token)
+	msg.Description = "still editable"
+	_, err = srv.UpdateVerifiedtoken(f.ctx, msg)
+	require.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -39,7 +40,12 @@ func TestVerifiedtokenCreate_MainnetUsesMainnetTimelockMinimum(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
 	creator := authorityAddress(t, f)
-	mainnetCtx := sdk.UnwrapSDKContext(f.ctx).WithChainID("tokenchain-1")
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.NetworkMode = types.NetworkModeMainnet
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	// The chain-id no longer influences which minimum applies.
+	mainnetCtx := sdk.UnwrapSDKContext(f.ctx).WithChainID("tokenchain-1-localnet-fork")
 
 	msg := baseVerifiedToken(creator, "mainnet-timelock")
 	msg.SeizureOptIn = true
@@ -47,7 +53,7 @@ func TestVerifiedtokenCreate_MainnetUsesMainnetTimelockMinimum(t *testing.T) {
 	msg.RecoveryTimelockHours = 1
 	f.groupKeeper.addPolicy(msg.RecoveryGroupPolicy)
 
-	_, err := srv.CreateVerifiedtoken(mainnetCtx, msg)
+	_, err = srv.CreateVerifiedtoken(mainnetCtx, msg)
 	require.ErrorIs(t, err, types.ErrRecoveryPolicy)
 
 	msg.RecoveryTimelockHours = 24
@@ -66,10 +72,10 @@ func baseVerifiedToken(creator, denom string) *types.MsgCreateVerifiedtoken {
 		Website:               "https://tokentap.ca",
 		MaxSupply:             1_000_000,
 		MintedSupply:          0,
-		Verified:              true,
 		SeizureOptIn:          false,
 		RecoveryGroupPolicy:   "",
 		RecoveryTimelockHours: 0,
+		Decimals:              types.LegacyTokenDecimals,
 	}
 }
 
@@ -135,7 +141,6 @@ func TestVerifiedtokenMsgServerUpdate(t *testing.T) {
 				Symbol:    "updated",
 				Website:   "https://tokentap.ca",
 				MaxSupply: 1_000_000,
-				Verified:  true,
 			},
 			err: sdkerrors.ErrUnauthorized,
 		},
@@ -149,7 +154,6 @@ func TestVerifiedtokenMsgServerUpdate(t *testing.T) {
 				Symbol:    "updated",
 				Website:   "https://tokentap.ca",
 				MaxSupply: 1_000_000,
-				Verified:  true,
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
@@ -163,7 +167,6 @@ func TestVerifiedtokenMsgServerUpdate(t *testing.T) {
 				Symbol:    "updated",
 				Website:   "https://tokentap.ca",
 				MaxSupply: 1_000_000,
-				Verified:  true,
 			},
 			err: sdkerrors.ErrKeyNotFound,
 		},
@@ -179,7 +182,6 @@ func TestVerifiedtokenMsgServerUpdate(t *testing.T) {
 				Website:               "https://tokentap.ca",
 				MaxSupply:             2_000_000,
 				MintedSupply:          0,
-				Verified:              true,
 				SeizureOptIn:          false,
 				RecoveryGroupPolicy:   "",
 				RecoveryTimelockHours: 0,
@@ -198,10 +200,22 @@ func TestVerifiedtokenMsgServerUpdate(t *testing.T) {
 			require.NoError(t, err)
 			rst, err := f.keeper.Verifiedtoken.Get(f.ctx, denom)
 			require.NoError(t, err)
-			require.Equal(t, "Updated Token", rst.Name)
-			require.EqualValues(t, 2_000_000, rst.MaxSupply)
+			require.Equal(t, "updated description", rst.Description)
 			require.EqualValues(t, types.DefaultMerchantIncentiveStakersBps, rst.MerchantIncentiveStakersBps)
 			require.EqualValues(t, types.DefaultMerchantIncentiveTreasuryBps, rst.MerchantIncentiveTreasuryBps)
+
+			// The rename and cap increase only land once the metadata delay elapses.
+			require.Equal(t, "Token token0", rst.Name)
+			require.EqualValues(t, 1_000_000, rst.MaxSupply)
+			pending, err := f.keeper.PendingMetadataChange.Get(f.ctx, denom)
+			require.NoError(t, err)
+			applyAt := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(int64(pending.EffectiveAt), 0))
+			require.NoError(t, f.keeper.ApplyMetadataChanges(applyAt))
+
+			rst, err = f.keeper.Verifiedtoken.Get(f.ctx, denom)
+			require.NoError(t, err)
+			require.Equal(t, "Updated Token", rst.Name)
+			require.EqualValues(t, 2_000_000, rst.MaxSupply)
 			metadata, ok := f.bankKeeper.denomMetadata[denom]
 			require.True(t, ok)
 			require.Equal(t, "Updated Token", metadata.Name)
@@ -239,7 +253,6 @@ func TestVerifiedtokenUpdate_CannotEnableSeizureAfterMinting(t *testing.T) {
 		Website:               "https://tokentap.ca",
 		MaxSupply:             2_000_000,
 		MintedSupply:          0,
-		Verified:              true,
 		SeizureOptIn:          true,
 		RecoveryGroupPolicy:   policy,
 		RecoveryTimelockHours: 1,
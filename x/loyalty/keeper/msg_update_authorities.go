@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (k msgServer) UpdateAuthorities(ctx context.Context, req *types.MsgUpdateAuthorities) (*types.MsgUpdateAuthoritiesResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(req.Authority); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	// Role membership is managed by the module authority alone; holding a role
+	// never grants the right to hand it out.
+	if err := k.ensureAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateAuthorities(req.Authorities); err != nil {
+		return nil, err
+	}
+
+	if err := k.Authorities.Set(ctx, req.Authorities); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"loyalty.authorities_updated",
+			sdk.NewAttribute("authority", req.Authority),
+			sdk.NewAttribute("params_admins", strings.Join(req.Authorities.ParamsAdmins, ",")),
+			sdk.NewAttribute("accrual_recorders", strings.Join(req.Authorities.AccrualRecorders, ",")),
+			sdk.NewAttribute("allowlist_admins", strings.Join(req.Authorities.AllowlistAdmins, ",")),
+			sdk.NewAttribute("recovery_overseers", strings.Join(req.Authorities.RecoveryOverseers, ",")),
+			sdk.NewAttribute("verifiers", strings.Join(req.Authorities.Verifiers, ",")),
+		),
+	)
+
+	return &types.MsgUpdateAuthoritiesResponse{}, nil
+}
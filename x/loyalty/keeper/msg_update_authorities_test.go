@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestMsgUpdateAuthorities(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	admin := sample.AccAddress()
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateAuthorities
+		expErr    error
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &types.MsgUpdateAuthorities{Authority: "invalid"},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:   "role holder cannot grant roles",
+			input:  &types.MsgUpdateAuthorities{Authority: admin, Authorities: types.NewAuthoritiesForAddress(admin)},
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name: "invalid role address",
+			input: &types.MsgUpdateAuthorities{
+				Authority:   authority,
+				Authorities: types.Authorities{ParamsAdmins: []string{"invalid"}},
+			},
+			expErr: types.ErrInvalidAuthorities,
+		},
+		{
+			name: "duplicated role address",
+			input: &types.MsgUpdateAuthorities{
+				Authority:   authority,
+				Authorities: types.Authorities{AllowlistAdmins: []string{admin, admin}},
+			},
+			expErr: types.ErrInvalidAuthorities,
+		},
+		{
+			name: "all good",
+			input: &types.MsgUpdateAuthorities{
+				Authority:   authority,
+				Authorities: types.Authorities{AllowlistAdmins: []string{admin}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateAuthorities(f.ctx, tc.input)
+			switch {
+			case tc.expErr != nil:
+				require.ErrorIs(t, err, tc.expErr)
+			case tc.expErrMsg != "":
+				require.ErrorContains(t, err, tc.expErrMsg)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	stored, err := f.keeper.Authorities.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{admin}, stored.AllowlistAdmins)
+	require.Empty(t, stored.ParamsAdmins)
+}
+
+func TestScopedRoles(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityAddress(t, f)
+	allowlistAdmin := sample.AccAddress()
+	recorder := sample.AccAddress()
+	paramsAdmin := sample.AccAddress()
+
+	_, err := ms.UpdateAuthorities(f.ctx, &types.MsgUpdateAuthorities{
+		Authority: authority,
+		Authorities: types.Authorities{
+			ParamsAdmins:     []string{paramsAdmin},
+			AccrualRecorders: []string{recorder},
+			AllowlistAdmins:  []string{allowlistAdmin},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := qs.Authorities(f.ctx, &types.QueryAuthoritiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, authority, resp.Authority)
+	require.Equal(t, []string{recorder}, resp.Authorities.AccrualRecorders)
+
+	// The allowlist admin can manage the allowlist but not record accruals.
+	_, err = ms.CreateCreatorallowlist(f.ctx, &types.MsgCreateCreatorallowlist{
+		Creator: allowlistAdmin,
+		Address: sample.AccAddress(),
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	_, err = ms.CreateCreatorallowlist(f.ctx, &types.MsgCreateCreatorallowlist{
+		Creator: recorder,
+		Address: sample.AccAddress(),
+		Enabled: true,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// Only params admins (and the module authority) may update params.
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.CreationMode = types.CreationModeAllowlisted
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: allowlistAdmin, Params: params})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: paramsAdmin, Params: params})
+	require.NoError(t, err)
+
+	// The network mode stays with the module authority.
+	params.NetworkMode = types.NetworkModeMainnet
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: paramsAdmin, Params: params})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	// Allowlist admins cannot act on a merchant's token; only its owner or the module authority can.
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	merchant, denom := createMerchantToken(t, f, ms, ctx, "scoped")
+	_, err = ms.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: allowlistAdmin, Denom: denom, Recipient: merchant, Amount: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.UpdateVerifiedtoken(ctx, &types.MsgUpdateVerifiedtoken{
+		Creator:   allowlistAdmin,
+		Denom:     denom,
+		Issuer:    merchant,
+		Name:      "Renamed",
+		Symbol:    "ttscoped",
+		MaxSupply: 1_000_000,
+		Decimals:  types.LegacyTokenDecimals,
+	})
+	require.ErrorContains(t, err, "incorrect owner")
+	_, err = ms.DeleteVerifiedtoken(ctx, &types.MsgDeleteVerifiedtoken{Creator: allowlistAdmin, Denom: denom})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: authority, Denom: denom, Recipient: merchant, Amount: 1})
+	require.NoError(t, err)
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
@@ -10,23 +9,38 @@ import (
 )
 
 func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authority, err := k.addressCodec.StringToBytes(req.Authority)
-	if err != nil {
+	if _, err := k.addressCodec.StringToBytes(req.Authority); err != nil {
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
 
-	if !bytes.Equal(k.GetAuthority(), authority) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	if err := k.ensureRole(ctx, req.Authority, types.RoleParamsAdmin); err != nil {
+		return nil, err
 	}
 
 	if err := req.Params.Validate(); err != nil {
 		return nil, err
 	}
 
+	// The network mode decides which recovery timelock floor applies, so it stays
+	// with the module authority rather than delegated params admins.
+	current, err := k.getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Params.NetworkMode != current.NetworkMode {
+		if err := k.ensureAuthority(req.Authority); err != nil {
+			return nil, errorsmod.Wrap(err, "network mode can only be changed by the module authority")
+		}
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
+	if req.Params.MinVerifierAttestations != current.MinVerifierAttestations {
+		if err := k.refreshAllTokenVerification(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
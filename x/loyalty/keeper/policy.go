@@ -37,6 +37,52 @@ func (k Keeper) ensureAuthority(signer string) error {
 	return nil
 }
 
+// getAuthorities returns the stored role holders, or an empty set when none were configured.
+func (k Keeper) getAuthorities(ctx context.Context) (types.Authorities, error) {
+	authorities, err := k.Authorities.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Authorities{}, nil
+		}
+		return types.Authorities{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return authorities, nil
+}
+
+// ensureRole checks that signer is the module authority or has been granted role.
+func (k Keeper) ensureRole(ctx context.Context, signer string, role types.Role) error {
+	if err := k.ensureAuthority(signer); err == nil {
+		return nil
+	} else if !errors.Is(err, types.ErrInvalidSigner) {
+		return err
+	}
+
+	authorities, err := k.getAuthorities(ctx)
+	if err != nil {
+		return err
+	}
+	if !authorities.HasRole(role, signer) {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "%s does not hold the %s role", signer, role)
+	}
+
+	return nil
+}
+
+// validateAuthorities checks the role set and that every holder is a valid address.
+func (k Keeper) validateAuthorities(authorities types.Authorities) error {
+	if err := authorities.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAuthorities, err.Error())
+	}
+	for _, role := range types.AllRoles {
+		for _, address := range authorities.Holders(role) {
+			if _, err := k.addressCodec.StringToBytes(address); err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidAuthorities, "invalid %s address %s: %s", role, address, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (k Keeper) getParams(ctx context.Context) (types.Params, error) {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -46,7 +92,7 @@ func (k Keeper) getParams(ctx context.Context) (types.Params, error) {
 }
 
 func (k Keeper) creatorCanCreateToken(ctx context.Context, signer string, mode string) (bool, error) {
-	if err := k.ensureAuthority(signer); err == nil {
+	if err := k.ensureRole(ctx, signer, types.RoleAllowlistAdmin); err == nil {
 		return true, nil
 	}
 
@@ -61,7 +107,7 @@ func (k Keeper) creatorCanCreateToken(ctx context.Context, signer string, mode s
 			}
 			return false, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 		}
-		return entry.Enabled, nil
+		return entry.Enabled && !entry.IsExpired(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()), nil
 	case types.CreationModePermissionless:
 		return true, nil
 	default:
@@ -77,14 +123,6 @@ func merchantAllocationKey(date, denom string) string {
 	return fmt.Sprintf("%s|%s", date, denom)
 }
 
-func minimumRecoveryTimelockHours(ctx context.Context, params types.Params) uint64 {
-	chainID := strings.ToLower(sdk.UnwrapSDKContext(ctx).ChainID())
-	if chainID == "" || strings.Contains(chainID, "testnet") || strings.Contains(chainID, "localnet") {
-		return params.TestnetTimelockHours
-	}
-	return params.MainnetTimelockHours
-}
-
 func (k Keeper) validateRecoverySettings(ctx context.Context, seizureOptIn bool, recoveryPolicy string, recoveryTimelock uint64, params types.Params) (string, uint64, error) {
 	if !seizureOptIn {
 		return "", 0, nil
@@ -101,7 +139,7 @@ func (k Keeper) validateRecoverySettings(ctx context.Context, seizureOptIn bool,
 		return "", 0, err
 	}
 
-	minTimelock := minimumRecoveryTimelockHours(ctx, params)
+	minTimelock := params.MinimumRecoveryTimelockHours()
 	if recoveryTimelock < minTimelock {
 		return "", 0, errorsmod.Wrapf(types.ErrRecoveryPolicy, "recovery timelock must be at least %d hours for this network", minTimelock)
 	}
@@ -121,11 +159,3 @@ func (k Keeper) ensureGroupPolicyExists(ctx context.Context, policyAddress strin
 
 	return nil
 }
-
-func normalizeMerchantIncentiveRouting(token types.Verifiedtoken) types.Verifiedtoken {
-	if token.MerchantIncentiveStakersBps == 0 && token.MerchantIncentiveTreasuryBps == 0 {
-		token.MerchantIncentiveStakersBps = types.DefaultMerchantIncentiveStakersBps
-		token.MerchantIncentiveTreasuryBps = types.DefaultMerchantIncentiveTreasuryBps
-	}
-	return token
-}
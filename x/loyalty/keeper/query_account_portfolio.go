@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) AccountPortfolio(ctx context.Context, req *types.QueryAccountPortfolioRequest) (*types.QueryAccountPortfolioResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	cursor, limit, err := cursorPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gather every denom the address holds, has accrued or faces a recovery in;
+	// only verified tokens make it into the portfolio.
+	balances := q.k.bankKeeper.GetAllBalances(ctx, addr)
+	denoms := make(map[string]struct{})
+	for _, coin := range balances {
+		if coin.IsPositive() {
+			denoms[coin.Denom] = struct{}{}
+		}
+	}
+	claimable := make(map[string]uint64)
+	rng := new(collections.Range[string]).
+		StartInclusive(req.Address + "|").
+		EndExclusive(req.Address + "}")
+	if err := q.k.Rewardaccrual.Walk(ctx, rng, func(_ string, record types.Rewardaccrual) (bool, error) {
+		if record.Address == req.Address && record.Amount > 0 {
+			claimable[record.Denom] += record.Amount
+			denoms[record.Denom] = struct{}{}
+		}
+		return false, nil
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	recoveries := make(map[string][]types.Recoveryoperation)
+	if err := q.k.Recoveryoperation.Walk(ctx, nil, func(_ uint64, op types.Recoveryoperation) (bool, error) {
+		if op.Status == types.RecoveryStatusQueued && op.FromAddress == req.Address {
+			recoveries[op.Denom] = append(recoveries[op.Denom], op)
+			denoms[op.Denom] = struct{}{}
+		}
+		return false, nil
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pool := q.k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	entries := make([]types.PortfolioEntry, 0)
+	pageRes := &query.PageResponse{}
+	for _, denom := range slices.Sorted(maps.Keys(denoms)) {
+		if denom < string(cursor) {
+			continue
+		}
+		token, err := q.k.Verifiedtoken.Get(ctx, denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		if uint64(len(entries)) == limit {
+			pageRes.NextKey = []byte(denom)
+			break
+		}
+
+		entry := types.PortfolioEntry{
+			Denom:             denom,
+			Verifiedtoken:     token,
+			Claimable:         claimable[denom],
+			PendingRecoveries: recoveries[denom],
+		}
+		if balance := balances.AmountOf(denom); balance.IsUint64() {
+			entry.Balance = balance.Uint64()
+		}
+		poolBalance := pool.AmountOf(denom)
+		if poolBalance.IsUint64() {
+			entry.PoolBalance = poolBalance.Uint64()
+		}
+		entry.ClaimFunded = poolBalance.GTE(sdkmath.NewIntFromUint64(entry.Claimable))
+		entries = append(entries, entry)
+	}
+
+	return &types.QueryAccountPortfolioResponse{Entries: entries, Pagination: pageRes}, nil
+}
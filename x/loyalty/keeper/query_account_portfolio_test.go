@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestAccountPortfolio(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	holder := sample.AccAddress()
+	other := sample.AccAddress()
+
+	for _, denom := range []string{"ucorn", "ubean", "urice", "uidle"} {
+		require.NoError(t, f.keeper.Verifiedtoken.Set(f.ctx, denom, types.Verifiedtoken{Denom: denom, Symbol: denom}))
+	}
+	// ubean is held, ucorn is held and accrued, urice is only accrued and faces a
+	// recovery; stake is not a verified token and uidle is not touched.
+	f.bankKeeper.accountBalances[holder] = sdk.NewCoins(
+		sdk.NewInt64Coin("ubean", 40),
+		sdk.NewInt64Coin("ucorn", 10),
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 5),
+	)
+	f.bankKeeper.accountBalances[authtypes.NewModuleAddress(types.ModuleName).String()] = sdk.NewCoins(
+		sdk.NewInt64Coin("ucorn", 100),
+		sdk.NewInt64Coin("urice", 3),
+	)
+	for _, record := range []types.Rewardaccrual{
+		{Key: holder + "|ucorn", Address: holder, Denom: "ucorn", Amount: 25},
+		{Key: holder + "|urice", Address: holder, Denom: "urice", Amount: 7},
+		{Key: other + "|uidle", Address: other, Denom: "uidle", Amount: 9},
+	} {
+		require.NoError(t, f.keeper.Rewardaccrual.Set(f.ctx, record.Key, record))
+	}
+	for _, op := range []types.Recoveryoperation{
+		{Id: 0, Denom: "urice", FromAddress: holder, ToAddress: other, Amount: 2, Status: types.RecoveryStatusQueued},
+		{Id: 1, Denom: "urice", FromAddress: holder, ToAddress: other, Amount: 1, Status: types.RecoveryStatusExecuted},
+		{Id: 2, Denom: "uidle", FromAddress: other, ToAddress: holder, Amount: 1, Status: types.RecoveryStatusQueued},
+	} {
+		require.NoError(t, f.keeper.Recoveryoperation.Set(f.ctx, op.Id, op))
+	}
+
+	resp, err := qs.AccountPortfolio(f.ctx, &types.QueryAccountPortfolioRequest{Address: holder})
+	require.NoError(t, err)
+	require.Nil(t, resp.Pagination.NextKey)
+	require.Equal(t, []types.PortfolioEntry{
+		{
+			Denom:         "ubean",
+			Verifiedtoken: types.Verifiedtoken{Denom: "ubean", Symbol: "ubean"},
+			Balance:       40,
+			ClaimFunded:   true,
+		},
+		{
+			Denom:         "ucorn",
+			Verifiedtoken: types.Verifiedtoken{Denom: "ucorn", Symbol: "ucorn"},
+			Balance:       10,
+			Claimable:     25,
+			PoolBalance:   100,
+			ClaimFunded:   true,
+		},
+		{
+			Denom:         "urice",
+			Verifiedtoken: types.Verifiedtoken{Denom: "urice", Symbol: "urice"},
+			Claimable:     7,
+			PoolBalance:   3,
+			PendingRecoveries: []types.Recoveryoperation{
+				{Id: 0, Denom: "urice", FromAddress: holder, ToAddress: other, Amount: 2, Status: types.RecoveryStatusQueued},
+			},
+		},
+	}, resp.Entries)
+
+	var denoms []string
+	var next []byte
+	for {
+		resp, err := qs.AccountPortfolio(f.ctx, &types.QueryAccountPortfolioRequest{
+			Address:    holder,
+			Pagination: &query.PageRequest{Key: next, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.Entries), 2)
+		for _, entry := range resp.Entries {
+			denoms = append(denoms, entry.Denom)
+		}
+		next = resp.Pagination.NextKey
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, []string{"ubean", "ucorn", "urice"}, denoms)
+
+	resp, err = qs.AccountPortfolio(f.ctx, &types.QueryAccountPortfolioRequest{Address: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Empty(t, resp.Entries)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryAccountPortfolioRequest
+	}{
+		{desc: "nil request"},
+		{desc: "invalid address", request: &types.QueryAccountPortfolioRequest{Address: "invalid"}},
+		{desc: "offset", request: &types.QueryAccountPortfolioRequest{Address: holder, Pagination: &query.PageRequest{Offset: 1}}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := qs.AccountPortfolio(f.ctx, tc.request)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
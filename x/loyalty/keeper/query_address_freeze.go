@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) AddressFreeze(ctx context.Context, req *types.QueryAddressFreezeRequest) (*types.QueryAddressFreezeResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" || strings.TrimSpace(req.Address) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	freeze, active, err := q.k.activeAddressFreeze(ctx, req.Denom, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !active {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryAddressFreezeResponse{AddressFreeze: freeze}, nil
+}
+
+func (q queryServer) AddressFreezes(ctx context.Context, req *types.QueryAddressFreezesRequest) (*types.QueryAddressFreezesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.Denom))
+	}
+	// Expired freezes stay in state until the end of the block; leave them out.
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	freezes, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.AddressFreeze,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.AddressFreeze) (bool, error) {
+			return value.ActiveAt(now), nil
+		},
+		func(_ collections.Pair[string, string], value types.AddressFreeze) (types.AddressFreeze, error) {
+			return value, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressFreezesResponse{AddressFreezes: freezes, Pagination: pageRes}, nil
+}
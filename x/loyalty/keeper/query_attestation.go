@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) Attestations(ctx context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := q.k.Verifiedtoken.Get(ctx, req.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	params, err := q.k.getParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	active, err := q.k.countActiveAttestations(ctx, req.Denom, now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	attestations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Attestation,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.Attestation) (types.Attestation, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationsResponse{
+		Attestations:  attestations,
+		Verified:      token.Verified,
+		ActiveCount:   active,
+		RequiredCount: params.MinVerifierAttestations,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (q queryServer) AttestationHistory(ctx context.Context, req *types.QueryAttestationHistoryRequest) (*types.QueryAttestationHistoryResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.AttestationHistory,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.AttestationRecord) (types.AttestationRecord, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) Authorities(ctx context.Context, req *types.QueryAuthoritiesRequest) (*types.QueryAuthoritiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	authorities, err := q.k.getAuthorities(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryAuthoritiesResponse{
+		Authority:   q.k.authorityString(),
+		Authorities: authorities,
+	}, nil
+}
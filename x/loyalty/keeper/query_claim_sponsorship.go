@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) ClaimSponsorship(ctx context.Context, req *types.QueryClaimSponsorshipRequest) (*types.QueryClaimSponsorshipResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Address != "" {
+		if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid address")
+		}
+	}
+
+	sponsorship, found, err := q.k.getClaimSponsorship(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	usage, err := q.k.getClaimSponsorshipUsage(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	res := &types.QueryClaimSponsorshipResponse{Sponsorship: sponsorship, Usage: usage}
+	if sponsorship.Enabled {
+		res.RemainingBudget = usage.RemainingBudget(sponsorship.DailyBudget)
+	}
+	if req.Address == "" {
+		return res, nil
+	}
+
+	allowance, found, err := q.k.getSponsoredAllowance(ctx, collections.Join(sponsorship.Sponsor, req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found || allowance.Denom != sponsorship.Denom {
+		return res, nil
+	}
+	res.Allowance = &allowance
+	if res.RemainingAllowance, err = q.k.remainingSponsoredAllowance(ctx, allowance); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return res, nil
+}
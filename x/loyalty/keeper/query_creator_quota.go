@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) CreatorQuota(ctx context.Context, req *types.QueryCreatorQuotaRequest) (*types.QueryCreatorQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	entry, err := q.k.Creatorallowlist.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	usage, err := q.k.getCreatorUsage(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	remainingTokens, unlimitedTokens := entry.RemainingTokens(usage)
+	if unlimitedTokens {
+		remainingTokens = 0
+	}
+	remainingSupply, unlimitedSupply := entry.RemainingSupply(usage)
+	if unlimitedSupply {
+		remainingSupply = 0
+	}
+
+	return &types.QueryCreatorQuotaResponse{
+		Creatorallowlist: entry,
+		Expired:          entry.IsExpired(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()),
+		RemainingTokens:  remainingTokens,
+		UnlimitedTokens:  unlimitedTokens,
+		RemainingSupply:  remainingSupply,
+		UnlimitedSupply:  unlimitedSupply,
+		Usage:            usage,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) EffectiveMinimums(ctx context.Context, req *types.QueryEffectiveMinimumsRequest) (*types.QueryEffectiveMinimumsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryEffectiveMinimumsResponse{
+		NetworkMode:           params.NetworkMode,
+		RecoveryTimelockHours: params.MinimumRecoveryTimelockHours(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestEffectiveMinimumsQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.EffectiveMinimums(f.ctx, nil)
+	require.Error(t, err)
+
+	for _, tc := range []struct {
+		mode     string
+		expHours uint64
+	}{
+		{mode: types.NetworkModeMainnet, expHours: types.DefaultMainnetTimelockHours},
+		{mode: types.NetworkModeTestnet, expHours: types.DefaultTestnetTimelockHours},
+		{mode: types.NetworkModeLocalnet, expHours: types.DefaultTestnetTimelockHours},
+	} {
+		t.Run(tc.mode, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.NetworkMode = tc.mode
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			response, err := qs.EffectiveMinimums(f.ctx, &types.QueryEffectiveMinimumsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, &types.QueryEffectiveMinimumsResponse{
+				NetworkMode:           tc.mode,
+				RecoveryTimelockHours: tc.expHours,
+			}, response)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) FeeToken(ctx context.Context, req *types.QueryFeeTokenRequest) (*types.QueryFeeTokenResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	feeToken, found, err := q.k.getFeeToken(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	pool, err := q.k.getGasPool(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryFeeTokenResponse{FeeToken: feeToken, GasPool: pool}, nil
+}
+
+func (q queryServer) FeeTokens(ctx context.Context, req *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	feeTokens, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.FeeToken,
+		req.Pagination,
+		func(_ string, feeToken types.FeeToken) (types.FeeToken, error) {
+			return feeToken, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeTokensResponse{FeeTokens: feeTokens, Pagination: pageRes}, nil
+}
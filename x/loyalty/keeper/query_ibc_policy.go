@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) TokenIBCPolicy(ctx context.Context, req *types.QueryTokenIBCPolicyRequest) (*types.QueryTokenIBCPolicyResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := q.k.Verifiedtoken.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	policy, isDefault, err := q.k.tokenIBCPolicy(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	escrowed, err := q.k.ibcEscrowed(ctx, token.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryTokenIBCPolicyResponse{Policy: policy, IsDefault: isDefault, Escrowed: escrowed}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) IBCRateLimit(ctx context.Context, req *types.QueryIBCRateLimitRequest) (*types.QueryIBCRateLimitResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" || strings.TrimSpace(req.ChannelId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	limit, found, err := q.k.getIBCRateLimit(ctx, req.Denom, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	flow, err := q.k.ibcRateLimitFlow(ctx, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryIBCRateLimitResponse{Usage: limit.Usage(flow)}, nil
+}
+
+func (q queryServer) IBCRateLimits(ctx context.Context, req *types.QueryIBCRateLimitsRequest) (*types.QueryIBCRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.Denom))
+	}
+	usages, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.IBCRateLimit,
+		req.Pagination,
+		func(_ collections.Pair[string, string], limit types.IBCRateLimit) (types.IBCRateLimitUsage, error) {
+			flow, err := q.k.ibcRateLimitFlow(ctx, limit)
+			if err != nil {
+				return types.IBCRateLimitUsage{}, err
+			}
+			return limit.Usage(flow), nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIBCRateLimitsResponse{Usages: usages, Pagination: pageRes}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) IBCRecorders(ctx context.Context, req *types.QueryIBCRecordersRequest) (*types.QueryIBCRecordersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.ChannelId != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.ChannelId))
+	}
+	recorders, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.IBCRecorder,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.IBCRecorder) (types.IBCRecorder, error) {
+			return value, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIBCRecordersResponse{Recorders: recorders, Pagination: pageRes}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) LiabilitiesAt(ctx context.Context, req *types.QueryLiabilitiesAtRequest) (*types.QueryLiabilitiesAtResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := time.Parse(rollupDateLayout, req.Date); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "date must be YYYY-MM-DD")
+	}
+	// Checkpoints close a date at the first block of the next one.
+	currentDate, err := q.k.LastDailyRollupDate.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if req.Date >= currentDate {
+		return nil, status.Error(codes.InvalidArgument, "date has not closed yet")
+	}
+
+	checkpoint, found, err := q.k.latestLiabilityCheckpoint(ctx, req.Denom, req.Date)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryLiabilitiesAtResponse{Checkpoint: checkpoint}, nil
+}
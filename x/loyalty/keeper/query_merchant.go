@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) ListMerchant(ctx context.Context, req *types.QueryAllMerchantRequest) (*types.QueryAllMerchantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	merchants, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Merchant,
+		req.Pagination,
+		func(_ uint64, value types.Merchant) (types.Merchant, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMerchantResponse{Merchant: merchants, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetMerchant(ctx context.Context, req *types.QueryGetMerchantRequest) (*types.QueryGetMerchantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	merchant, err := q.k.Merchant.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetMerchantResponse{Merchant: merchant}, nil
+}
+
+func (q queryServer) VerifiedtokensByMerchant(ctx context.Context, req *types.QueryVerifiedtokensByMerchantRequest) (*types.QueryVerifiedtokensByMerchantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	merchant, err := q.k.Merchant.Get(ctx, req.MerchantId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	start := uint64(0)
+	limit := uint64(len(merchant.Denoms))
+	needTotal := true
+	if req.Pagination != nil {
+		needTotal = req.Pagination.CountTotal
+		if len(req.Pagination.Key) > 0 {
+			keyStart, err := strconv.ParseUint(string(req.Pagination.Key), 10, 64)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+			}
+			start = keyStart
+		} else {
+			start = req.Pagination.Offset
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	total := uint64(len(merchant.Denoms))
+	if start > total {
+		start = total
+	}
+	end := total
+	if limit < end-start {
+		end = start + limit
+	}
+
+	tokens := make([]types.Verifiedtoken, 0, end-start)
+	for _, denom := range merchant.Denoms[start:end] {
+		token, err := q.k.Verifiedtoken.Get(ctx, denom)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		tokens = append(tokens, token)
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = []byte(strconv.FormatUint(end, 10))
+	}
+	if needTotal {
+		pageRes.Total = total
+	}
+
+	return &types.QueryVerifiedtokensByMerchantResponse{
+		Verifiedtoken: tokens,
+		Pagination:    pageRes,
+	}, nil
+}
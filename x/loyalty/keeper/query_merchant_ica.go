@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) MerchantICAs(ctx context.Context, req *types.QueryMerchantICAsRequest) (*types.QueryMerchantICAsResponse, error) {
+	if req == nil || req.MerchantId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	icas, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.MerchantICA,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], value types.MerchantICA) (types.MerchantICA, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.MerchantId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMerchantICAsResponse{Icas: icas, Pagination: pageRes}, nil
+}
+
+func (q queryServer) MerchantICAPackets(ctx context.Context, req *types.QueryMerchantICAPacketsRequest) (*types.QueryMerchantICAPacketsResponse, error) {
+	if req == nil || req.MerchantId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Packets are keyed by channel and sequence for the IBC callbacks, so the
+	// listing filters on the merchant.
+	packets, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.MerchantICAPacket,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.MerchantICAPacket) (bool, error) {
+			return value.MerchantId == req.MerchantId, nil
+		},
+		func(_ collections.Pair[string, uint64], value types.MerchantICAPacket) (types.MerchantICAPacket, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMerchantICAPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+func (q queryServer) TreasuryForwards(ctx context.Context, req *types.QueryTreasuryForwardsRequest) (*types.QueryTreasuryForwardsResponse, error) {
+	if req == nil || req.MerchantId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	forwards, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.TreasuryForward,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], value types.TreasuryForward) (types.TreasuryForward, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.MerchantId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTreasuryForwardsResponse{Forwards: forwards, Pagination: pageRes}, nil
+}
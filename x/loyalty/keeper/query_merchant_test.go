@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestMerchantQueries(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := authorityAddress(t, f)
+	id := registerMerchant(t, srv, f, creator)
+	registerMerchant(t, srv, f, creator)
+
+	for _, sub := range []string{"shopa", "shopb", "shopc"} {
+		msg := baseVerifiedToken(creator, sub)
+		msg.MerchantId = id
+		_, err := srv.CreateVerifiedtoken(f.ctx, msg)
+		require.NoError(t, err)
+	}
+
+	got, err := qs.GetMerchant(f.ctx, &types.QueryGetMerchantRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, got.Merchant.Denoms, 3)
+
+	_, err = qs.GetMerchant(f.ctx, &types.QueryGetMerchantRequest{Id: 99})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	all, err := qs.ListMerchant(f.ctx, &types.QueryAllMerchantRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, all.Merchant, 2)
+	require.EqualValues(t, 2, all.Pagination.Total)
+
+	page, err := qs.VerifiedtokensByMerchant(f.ctx, &types.QueryVerifiedtokensByMerchantRequest{
+		MerchantId: id,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Verifiedtoken, 2)
+	require.EqualValues(t, 3, page.Pagination.Total)
+	require.NotEmpty(t, page.Pagination.NextKey)
+
+	rest, err := qs.VerifiedtokensByMerchant(f.ctx, &types.QueryVerifiedtokensByMerchantRequest{
+		MerchantId: id,
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, rest.Verifiedtoken, 1)
+	require.Empty(t, rest.Pagination.NextKey)
+	require.Equal(t, factoryDenom(creator, "shopc"), rest.Verifiedtoken[0].Denom)
+
+	_, err = qs.VerifiedtokensByMerchant(f.ctx, nil)
+	require.Error(t, err)
+}
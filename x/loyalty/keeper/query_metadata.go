@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) PendingMetadataChange(ctx context.Context, req *types.QueryPendingMetadataChangeRequest) (*types.QueryPendingMetadataChangeResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	change, found, err := q.k.getPendingMetadataChange(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryPendingMetadataChangeResponse{PendingChange: change}, nil
+}
+
+func (q queryServer) MetadataHistory(ctx context.Context, req *types.QueryMetadataHistoryRequest) (*types.QueryMetadataHistoryResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	versions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.MetadataVersion,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.MetadataVersion) (types.MetadataVersion, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMetadataHistoryResponse{Versions: versions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) MintRateLimit(ctx context.Context, req *types.QueryMintRateLimitRequest) (*types.QueryMintRateLimitResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := q.k.Verifiedtoken.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	limit, err := q.k.getMintRateLimit(ctx, token.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	usage, err := q.k.getMintUsage(ctx, token.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	clock, err := q.k.getMintClock(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryMintRateLimitResponse{
+		MintRateLimit:    limit,
+		MintedRollingDay: usage.MintedSince(clock.windowStart()),
+		RollupDate:       clock.today,
+		MintedRollupDate: usage.MintedOn(clock.today),
+	}
+	pending, found, err := q.k.getPendingMintRateLimit(ctx, token.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if found {
+		resp.Pending = &pending
+	}
+	if !token.AdminRenounced {
+		resp.Available = min(mintRateAllowance(limit, usage, clock), token.MaxSupply-min(token.MaxSupply, token.MintedSupply+token.ScheduledSupply))
+	}
+
+	return resp, nil
+}
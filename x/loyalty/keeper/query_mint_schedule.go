@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) MintSchedule(ctx context.Context, req *types.QueryMintScheduleRequest) (*types.QueryMintScheduleResponse, error) {
+	if req == nil || strings.TrimSpace(req.Denom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	schedule, err := q.k.MintSchedule.Get(ctx, collections.Join(req.Denom, req.Id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryMintScheduleResponse{MintSchedule: schedule}, nil
+}
+
+func (q queryServer) MintSchedules(ctx context.Context, req *types.QueryMintSchedulesRequest) (*types.QueryMintSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, uint64]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom))
+	}
+	schedules, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.MintSchedule,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.MintSchedule) (types.MintSchedule, error) {
+			return value, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintSchedulesResponse{MintSchedules: schedules, Pagination: pageRes}, nil
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// maxCursorPageLimit bounds a page of the cursor based listings, which read
+// balances and stats for every token they return.
+const maxCursorPageLimit = 100
+
+// verifiedtokenFilter holds the filters of SearchVerifiedtokens; nil flags match
+// both values.
+type verifiedtokenFilter struct {
+	issuer         string
+	symbolPrefix   string
+	verified       *bool
+	seizureOptIn   *bool
+	adminRenounced *bool
+}
+
+func (f verifiedtokenFilter) match(token types.Verifiedtoken) bool {
+	flagMatches := func(want *bool, value bool) bool { return want == nil || *want == value }
+	return (f.issuer == "" || token.Issuer == f.issuer) &&
+		strings.HasPrefix(types.SymbolIndexKey(token.Symbol), f.symbolPrefix) &&
+		flagMatches(f.verified, token.Verified) &&
+		flagMatches(f.seizureOptIn, token.SeizureOptIn) &&
+		flagMatches(f.adminRenounced, token.AdminRenounced)
+}
+
+func (q queryServer) SearchVerifiedtokens(ctx context.Context, req *types.QuerySearchVerifiedtokensRequest) (*types.QuerySearchVerifiedtokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	filter := verifiedtokenFilter{issuer: req.Issuer, symbolPrefix: types.SymbolIndexKey(req.SymbolPrefix)}
+	for _, flag := range []struct {
+		name  string
+		value string
+		dst   **bool
+	}{
+		{"verified", req.Verified, &filter.verified},
+		{"seizure_opt_in", req.SeizureOptIn, &filter.seizureOptIn},
+		{"admin_renounced", req.AdminRenounced, &filter.adminRenounced},
+	} {
+		if flag.value == "" {
+			continue
+		}
+		value, err := strconv.ParseBool(flag.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be true or false", flag.name)
+		}
+		*flag.dst = &value
+	}
+
+	cursor, limit, err := cursorPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []types.Verifiedtoken
+	pageRes := &query.PageResponse{}
+	visit := func(key []byte, token types.Verifiedtoken) bool {
+		if !filter.match(token) {
+			return false
+		}
+		if uint64(len(tokens)) == limit {
+			pageRes.NextKey = key
+			return true
+		}
+		tokens = append(tokens, token)
+		return false
+	}
+	switch {
+	case filter.issuer != "":
+		err = q.k.scanVerifiedtokensByIssuer(ctx, filter.issuer, cursor, visit)
+	case filter.symbolPrefix != "":
+		start := collections.Join(filter.symbolPrefix, "")
+		if len(cursor) > 0 {
+			_, key, err := q.k.Verifiedtoken.Indexes.Symbol.KeyCodec().Decode(cursor)
+			if err != nil || !strings.HasPrefix(key.K1(), filter.symbolPrefix) {
+				return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+			}
+			start = key
+		}
+		err = q.k.scanVerifiedtokensBySymbol(ctx, filter.symbolPrefix, start, visit)
+	default:
+		err = q.k.scanVerifiedtokens(ctx, cursor, visit)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pool := q.k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	listings := make([]types.VerifiedtokenListing, 0, len(tokens))
+	for _, token := range tokens {
+		stats, err := q.k.getTokenStakerStats(ctx, token.Denom)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		listing := types.VerifiedtokenListing{Verifiedtoken: token, StakerStats: stats}
+		if supply := q.k.bankKeeper.GetSupply(ctx, token.Denom).Amount; supply.IsUint64() {
+			listing.Supply = supply.Uint64()
+		}
+		if balance := pool.AmountOf(token.Denom); balance.IsUint64() {
+			listing.PoolBalance = balance.Uint64()
+		}
+		listings = append(listings, listing)
+	}
+
+	return &types.QuerySearchVerifiedtokensResponse{Verifiedtokens: listings, Pagination: pageRes}, nil
+}
+
+// cursorPage returns the cursor and limit of a key and limit page request.
+func cursorPage(pagination *query.PageRequest) ([]byte, uint64, error) {
+	if pagination == nil {
+		return nil, query.DefaultLimit, nil
+	}
+	if pagination.Offset > 0 || pagination.CountTotal || pagination.Reverse {
+		return nil, 0, status.Error(codes.InvalidArgument, "only key and limit pagination is supported")
+	}
+	limit := uint64(query.DefaultLimit)
+	if pagination.Limit > 0 {
+		limit = min(pagination.Limit, maxCursorPageLimit)
+	}
+	return pagination.Key, limit, nil
+}
+
+// scanVerifiedtokens visits tokens in denom order from the denom in cursor until
+// visit returns true. Cursors are denoms.
+func (k Keeper) scanVerifiedtokens(ctx context.Context, cursor []byte, visit func(key []byte, token types.Verifiedtoken) bool) error {
+	rng := new(collections.Range[string])
+	if len(cursor) > 0 {
+		rng = rng.StartInclusive(string(cursor))
+	}
+	return k.Verifiedtoken.Walk(ctx, rng, func(denom string, token types.Verifiedtoken) (bool, error) {
+		return visit([]byte(denom), token), nil
+	})
+}
+
+// scanVerifiedtokensByIssuer visits the tokens of issuer in denom order from the
+// denom in cursor until visit returns true. Cursors are denoms.
+func (k Keeper) scanVerifiedtokensByIssuer(ctx context.Context, issuer string, cursor []byte, visit func(key []byte, token types.Verifiedtoken) bool) error {
+	rng := collections.NewPrefixedPairRange[string, string](issuer)
+	if len(cursor) > 0 {
+		rng = rng.StartInclusive(string(cursor))
+	}
+	iter, err := k.Verifiedtoken.Indexes.Issuer.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom, err := iter.PrimaryKey()
+		if err != nil {
+			return err
+		}
+		token, err := k.Verifiedtoken.Get(ctx, denom)
+		if err != nil {
+			return err
+		}
+		if visit([]byte(denom), token) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// scanVerifiedtokensBySymbol visits the tokens whose lowercased symbol starts with
+// prefix in symbol order from the index key start until visit returns true.
+// Cursors are encoded (symbol, denom) index keys.
+func (k Keeper) scanVerifiedtokensBySymbol(ctx context.Context, prefix string, start collections.Pair[string, string], visit func(key []byte, token types.Verifiedtoken) bool) error {
+	iter, err := k.Verifiedtoken.Indexes.Symbol.Iterate(ctx, new(collections.Range[collections.Pair[string, string]]).StartInclusive(start))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(key.K1(), prefix) {
+			return nil
+		}
+		token, err := k.Verifiedtoken.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		encoded, err := collections.EncodeKeyWithPrefix(nil, k.Verifiedtoken.Indexes.Symbol.KeyCodec(), key)
+		if err != nil {
+			return err
+		}
+		if visit(encoded, token) {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func searchDenoms(listings []types.VerifiedtokenListing) []string {
+	denoms := make([]string, 0, len(listings))
+	for _, listing := range listings {
+		denoms = append(denoms, listing.Verifiedtoken.Denom)
+	}
+	return denoms
+}
+
+func TestSearchVerifiedtokensFilters(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	for _, token := range []types.Verifiedtoken{
+		{Denom: "a-bean", Issuer: "alice", Symbol: "BEAN", Verified: true, SeizureOptIn: true},
+		{Denom: "a-beer", Issuer: "alice", Symbol: "Beer", Verified: false},
+		{Denom: "b-bead", Issuer: "bob", Symbol: "bead", Verified: true, AdminRenounced: true},
+		{Denom: "b-corn", Issuer: "bob", Symbol: "CORN", Verified: true},
+	} {
+		require.NoError(t, f.keeper.Verifiedtoken.Set(f.ctx, token.Denom, token))
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QuerySearchVerifiedtokensRequest
+		denoms  []string
+	}{
+		{desc: "all", request: &types.QuerySearchVerifiedtokensRequest{}, denoms: []string{"a-bean", "a-beer", "b-bead", "b-corn"}},
+		{desc: "issuer", request: &types.QuerySearchVerifiedtokensRequest{Issuer: "bob"}, denoms: []string{"b-bead", "b-corn"}},
+		{desc: "symbol prefix ignores case", request: &types.QuerySearchVerifiedtokensRequest{SymbolPrefix: "bE"}, denoms: []string{"b-bead", "a-bean", "a-beer"}},
+		{desc: "verified", request: &types.QuerySearchVerifiedtokensRequest{Verified: "false"}, denoms: []string{"a-beer"}},
+		{desc: "seizure opt-in", request: &types.QuerySearchVerifiedtokensRequest{SeizureOptIn: "true"}, denoms: []string{"a-bean"}},
+		{desc: "admin renounced", request: &types.QuerySearchVerifiedtokensRequest{AdminRenounced: "true"}, denoms: []string{"b-bead"}},
+		{desc: "combined", request: &types.QuerySearchVerifiedtokensRequest{Issuer: "alice", SymbolPrefix: "bea", Verified: "true"}, denoms: []string{"a-bean"}},
+		{desc: "no match", request: &types.QuerySearchVerifiedtokensRequest{Issuer: "carol"}, denoms: []string{}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := qs.SearchVerifiedtokens(f.ctx, tc.request)
+			require.NoError(t, err)
+			require.Equal(t, tc.denoms, searchDenoms(resp.Verifiedtokens))
+			require.Nil(t, resp.Pagination.NextKey)
+		})
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QuerySearchVerifiedtokensRequest
+	}{
+		{desc: "nil request"},
+		{desc: "bad flag", request: &types.QuerySearchVerifiedtokensRequest{Verified: "yes"}},
+		{desc: "offset", request: &types.QuerySearchVerifiedtokensRequest{Pagination: &query.PageRequest{Offset: 1}}},
+		{desc: "count total", request: &types.QuerySearchVerifiedtokensRequest{Pagination: &query.PageRequest{CountTotal: true}}},
+		{desc: "bad symbol cursor", request: &types.QuerySearchVerifiedtokensRequest{SymbolPrefix: "be", Pagination: &query.PageRequest{Key: []byte("corn")}}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := qs.SearchVerifiedtokens(f.ctx, tc.request)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestSearchVerifiedtokensPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNVerifiedtoken(f.keeper, f.ctx, 5)
+	for i := range msgs {
+		msgs[i].Issuer = "shop"
+		msgs[i].Symbol = "pt" + msgs[i].Symbol
+		require.NoError(t, f.keeper.Verifiedtoken.Set(f.ctx, msgs[i].Denom, msgs[i]))
+	}
+
+	for _, request := range []*types.QuerySearchVerifiedtokensRequest{
+		{},
+		{Issuer: "shop"},
+		{SymbolPrefix: "PT"},
+	} {
+		var denoms []string
+		var next []byte
+		for {
+			req := *request
+			req.Pagination = &query.PageRequest{Key: next, Limit: 2}
+			resp, err := qs.SearchVerifiedtokens(f.ctx, &req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Verifiedtokens), 2)
+			denoms = append(denoms, searchDenoms(resp.Verifiedtokens)...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, []string{"0", "1", "2", "3", "4"}, denoms)
+	}
+}
+
+func TestSearchVerifiedtokensListing(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	authority := authorityAddress(t, f)
+	merchant, denom := createMerchantToken(t, f, srv, ctx, "listed")
+
+	_, err := srv.MintVerifiedToken(ctx, &types.MsgMintVerifiedToken{Creator: merchant, Denom: denom, Recipient: merchant, Amount: 100})
+	require.NoError(t, err)
+	_, err = srv.FundRewardPool(ctx, &types.MsgFundRewardPool{Creator: merchant, Denom: denom, Amount: 30})
+	require.NoError(t, err)
+	for _, date := range []string{"2026-02-25", "2026-02-26"} {
+		_, err = srv.RecordMerchantAllocation(ctx, &types.MsgRecordMerchantAllocation{
+			Creator:       authority,
+			Date:          date,
+			Denom:         denom,
+			ActivityScore: 10,
+			BucketCAmount: 1000,
+		})
+		require.NoError(t, err)
+	}
+	// Re-recording a day replaces its totals rather than adding to them.
+	_, err = srv.RecordMerchantAllocation(ctx, &types.MsgRecordMerchantAllocation{
+		Creator:       authority,
+		Date:          "2026-02-26",
+		Denom:         denom,
+		ActivityScore: 10,
+		BucketCAmount: 200,
+	})
+	require.NoError(t, err)
+
+	resp, err := qs.SearchVerifiedtokens(ctx, &types.QuerySearchVerifiedtokensRequest{Issuer: merchant})
+	require.NoError(t, err)
+	require.Len(t, resp.Verifiedtokens, 1)
+	listing := resp.Verifiedtokens[0]
+	require.Equal(t, denom, listing.Verifiedtoken.Denom)
+	require.EqualValues(t, 100, listing.Supply)
+	require.EqualValues(t, 30, listing.PoolBalance)
+	require.Equal(t, types.TokenStakerStats{
+		Denom:              denom,
+		Allocations:        2,
+		StakersAmount:      600,
+		TreasuryAmount:     600,
+		LastAllocationDate: "2026-02-26",
+	}, listing.StakerStats)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func (q queryServer) PausedTokens(ctx context.Context, req *types.QueryPausedTokensRequest) (*types.QueryPausedTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Expired pauses stay in state until the end of the block; leave them out.
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	pauses, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.TokenPause,
+		req.Pagination,
+		func(_ string, value types.TokenPause) (bool, error) {
+			return value.ActiveAt(now), nil
+		},
+		func(_ string, value types.TokenPause) (types.TokenPause, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPausedTokensResponse{PausedTokens: pauses, Pagination: pageRes}, nil
+}
@@ -162,23 +162,3 @@ func TestVerifiedtokenQueryByDenomParam(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
-
-func TestVerifiedtokenQueryLegacyRoutingDefaults(t *testing.T) {
-	f := initFixture(t)
-	qs := keeper.NewQueryServerImpl(f.keeper)
-	denom := "legacy-denom"
-	legacy := types.Verifiedtoken{
-		Denom:                        denom,
-		Issuer:                       "legacy-issuer",
-		Name:                         "legacy",
-		Symbol:                       "LEG",
-		MerchantIncentiveStakersBps:  0,
-		MerchantIncentiveTreasuryBps: 0,
-	}
-	require.NoError(t, f.keeper.Verifiedtoken.Set(f.ctx, denom, legacy))
-
-	resp, err := qs.GetVerifiedtoken(f.ctx, &types.QueryGetVerifiedtokenRequest{Denom: denom})
-	require.NoError(t, err)
-	require.EqualValues(t, types.DefaultMerchantIncentiveStakersBps, resp.Verifiedtoken.MerchantIncentiveStakersBps)
-	require.EqualValues(t, types.DefaultMerchantIncentiveTreasuryBps, resp.Verifiedtoken.MerchantIncentiveTreasuryBps)
-}
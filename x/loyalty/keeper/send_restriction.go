@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// SendRestrictionFn blocks bank transfers of tokens whose transfer scope is paused and
+// outgoing transfers from addresses frozen for the token. Sends from or to the loyalty
+// module account are left to the loyalty handlers, which check pauses and freezes
+// themselves; recovery execution relies on this to collect frozen funds.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	if fromAddr.Equals(moduleAddr) || toAddr.Equals(moduleAddr) {
+		return toAddr, nil
+	}
+	from, err := k.addressCodec.BytesToString(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range amt {
+		if err := k.ensureNotPaused(ctx, coin.Denom, types.PauseScopeTransfer); err != nil {
+			return nil, err
+		}
+		if err := k.ensureNotFrozen(ctx, coin.Denom, from); err != nil {
+			return nil, err
+		}
+	}
+	return toAddr, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// setMerchantallocation stores record and counts it in the staker stats of its
+// denom, in place of the record it replaces.
+func (k Keeper) setMerchantallocation(ctx context.Context, record types.Merchantallocation) error {
+	stats, err := k.getTokenStakerStats(ctx, record.Denom)
+	if err != nil {
+		return err
+	}
+	previous, err := k.Merchantallocation.Get(ctx, record.Key)
+	switch {
+	case err == nil && previous.Denom == record.Denom:
+		stats = stats.Sub(previous)
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.Merchantallocation.Set(ctx, record.Key, record); err != nil {
+		return err
+	}
+	return k.TokenStakerStats.Set(ctx, record.Denom, stats.Add(record))
+}
+
+func (k Keeper) getTokenStakerStats(ctx context.Context, denom string) (types.TokenStakerStats, error) {
+	stats, err := k.TokenStakerStats.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TokenStakerStats{Denom: denom}, nil
+		}
+		return types.TokenStakerStats{}, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// activeTokenPause returns denom's pause if one is in force at the current block time.
+func (k Keeper) activeTokenPause(ctx context.Context, denom string) (types.TokenPause, bool, error) {
+	pause, err := k.TokenPause.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TokenPause{}, false, nil
+		}
+		return types.TokenPause{}, false, err
+	}
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	return pause, pause.ActiveAt(now), nil
+}
+
+// ensureNotPaused fails with ErrTokenPaused when scope of denom is paused.
+func (k Keeper) ensureNotPaused(ctx context.Context, denom, scope string) error {
+	pause, active, err := k.activeTokenPause(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if active && pause.Covers(scope) {
+		return errorsmod.Wrapf(types.ErrTokenPaused, "%s of %s is paused", scope, denom)
+	}
+	return nil
+}
+
+// ensureAdminNotLockedOut rejects token admin changes by signer while an authority
+// pause is in force, so a compromised owner key cannot work around the pause.
+func (k Keeper) ensureAdminNotLockedOut(ctx context.Context, denom, signer string) error {
+	pause, active, err := k.activeTokenPause(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if active && pause.ByAuthority && !k.isPauseAuthority(ctx, signer) {
+		return errorsmod.Wrapf(types.ErrTokenPaused, "%s was paused by an authority; only an authority can change it", denom)
+	}
+	return nil
+}
+
+// isPauseAuthority reports whether signer may pause any token and lift authority
+// pauses: allowlist admins and accounts allowed to trip the circuit breaker for
+// MsgPauseToken.
+func (k Keeper) isPauseAuthority(ctx context.Context, signer string) bool {
+	if k.ensureRole(ctx, signer, types.RoleAllowlistAdmin) == nil {
+		return true
+	}
+	if k.circuitKeeper == nil {
+		return false
+	}
+	addr, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return false
+	}
+	allowed, err := k.circuitKeeper.CanTrip(ctx, addr, sdk.MsgTypeURL(&types.MsgPauseToken{}))
+	return err == nil && allowed
+}
+
+// setTokenPause stores pause, replacing any earlier pause of the token and its expiry entry.
+func (k Keeper) setTokenPause(ctx context.Context, pause types.TokenPause) error {
+	if err := k.removeTokenPause(ctx, pause.Denom); err != nil {
+		return err
+	}
+	if err := k.TokenPause.Set(ctx, pause.Denom, pause); err != nil {
+		return err
+	}
+	if pause.ExpiresAt == 0 {
+		return nil
+	}
+	return k.TokenPauseExpiry.Set(ctx, collections.Join(pause.ExpiresAt, pause.Denom))
+}
+
+// removeTokenPause drops denom's pause and its expiry entry, if any.
+func (k Keeper) removeTokenPause(ctx context.Context, denom string) error {
+	pause, err := k.TokenPause.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if pause.ExpiresAt != 0 {
+		if err := k.TokenPauseExpiry.Remove(ctx, collections.Join(pause.ExpiresAt, denom)); err != nil {
+			return err
+		}
+	}
+	return k.TokenPause.Remove(ctx, denom)
+}
+
+// ExpireTokenPauses lifts every pause whose expiry has passed. Pauses stop applying at
+// expires_at regardless; this only cleans up state and emits the unpause event.
+func (k Keeper) ExpireTokenPauses(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+
+	var expired []collections.Pair[uint64, string]
+	if err := k.TokenPauseExpiry.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+		if key.K1() > now {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.removeTokenPause(ctx, key.K2()); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"loyalty.token_unpaused",
+				sdk.NewAttribute("denom", key.K2()),
+				sdk.NewAttribute("unpaused_by", ""),
+				sdk.NewAttribute("reason", "expired"),
+			),
+		)
+	}
+	return nil
+}
+
+// tokenPauseEvent builds the event emitted when a token is paused or its scopes change.
+func tokenPauseEvent(eventType string, pause types.TokenPause) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute("denom", pause.Denom),
+		sdk.NewAttribute("scopes", strings.Join(pause.Scopes, ",")),
+		sdk.NewAttribute("paused_by", pause.PausedBy),
+		sdk.NewAttribute("by_authority", fmt.Sprintf("%t", pause.ByAuthority)),
+		sdk.NewAttribute("reason", pause.Reason),
+		sdk.NewAttribute("expires_at", fmt.Sprintf("%d", pause.ExpiresAt)),
+	)
+}
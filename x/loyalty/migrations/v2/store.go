@@ -0,0 +1,389 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// MigrateStore performs the in-place store migration from consensus version 1 to 2.
+// It rewrites legacy records once so that read paths no longer need to patch them:
+//   - verified tokens stored with 0/0 merchant incentive routing get the default split,
+//     tokens missing a creator inherit their issuer, and tokens keep the legacy
+//     display exponent as their decimals;
+//   - reward accruals and merchant allocations are moved to their canonical
+//     <address>|<denom> and <date>|<denom> keys, with fields derivable from the key backfilled;
+//   - merchant allocations missing routing bps inherit the owning token's routing;
+//   - params without a network mode get the mode v1 inferred from the chain-id, and
+//     params without an attestation threshold get the default one;
+//   - the verified flag of every token is recomputed from its unexpired attestations,
+//     so v1 tokens their owners marked verified start out unverified;
+//   - creator usage is backfilled from the registered tokens of every creator;
+//   - the running reward liability of each denom is summed from its accruals, and
+//     the staker stats of each denom from its merchant allocations;
+//   - verified tokens are indexed by issuer and symbol.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	tokens := collections.NewIndexedMap(sb, types.VerifiedtokenKey, "verifiedtoken", collections.StringKey, codec.CollValue[types.Verifiedtoken](cdc), types.NewVerifiedtokenIndexes(sb))
+	accruals := collections.NewMap(sb, types.RewardaccrualKey, "rewardaccrual", collections.StringKey, codec.CollValue[types.Rewardaccrual](cdc))
+	allocations := collections.NewMap(sb, types.MerchantallocationKey, "merchantallocation", collections.StringKey, codec.CollValue[types.Merchantallocation](cdc))
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	creatorUsage := collections.NewMap(sb, types.CreatorUsageKey, "creatorUsage", collections.StringKey, codec.CollValue[types.CreatorUsage](cdc))
+	liabilities := collections.NewMap(sb, types.RewardLiabilityKey, "rewardLiability", collections.StringKey, codec.CollValue[types.RewardLiability](cdc))
+	stakerStats := collections.NewMap(sb, types.TokenStakerStatsKey, "tokenStakerStats", collections.StringKey, codec.CollValue[types.TokenStakerStats](cdc))
+	attestations := collections.NewMap(sb, types.AttestationKey, "attestation", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.Attestation](cdc))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	minAttestations, err := migrateParams(ctx, params)
+	if err != nil {
+		return err
+	}
+	attested, err := countActiveAttestations(ctx, attestations)
+	if err != nil {
+		return err
+	}
+	routing, err := migrateVerifiedtokens(ctx, tokens, attested, minAttestations)
+	if err != nil {
+		return err
+	}
+	if err := backfillCreatorUsage(ctx, creatorUsage, routing); err != nil {
+		return err
+	}
+	if err := migrateRewardaccruals(ctx, accruals); err != nil {
+		return err
+	}
+	if err := backfillRewardLiabilities(ctx, accruals, liabilities); err != nil {
+		return err
+	}
+	if err := migrateMerchantallocations(ctx, allocations, routing); err != nil {
+		return err
+	}
+	return backfillTokenStakerStats(ctx, allocations, stakerStats)
+}
+
+// migrateParams backfills params fields v1 did not have and returns the
+// attestation threshold in force after the migration.
+func migrateParams(ctx context.Context, params collections.Item[types.Params]) (uint32, error) {
+	current, err := params.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DefaultMinVerifierAttestations, nil
+		}
+		return 0, err
+	}
+	if current.NetworkMode != "" && current.MinVerifierAttestations != 0 {
+		return current.MinVerifierAttestations, nil
+	}
+
+	if current.NetworkMode == "" {
+		current.NetworkMode = legacyNetworkMode(sdk.UnwrapSDKContext(ctx).ChainID())
+	}
+	if current.MinVerifierAttestations == 0 {
+		current.MinVerifierAttestations = types.DefaultMinVerifierAttestations
+	}
+	return current.MinVerifierAttestations, params.Set(ctx, current)
+}
+
+// legacyNetworkMode mirrors the v1 chain-id heuristic so existing chains keep
+// the timelock minimum they were running with.
+func legacyNetworkMode(chainID string) string {
+	chainID = strings.ToLower(chainID)
+	switch {
+	case chainID == "" || strings.Contains(chainID, "localnet"):
+		return types.NetworkModeLocalnet
+	case strings.Contains(chainID, "testnet"):
+		return types.NetworkModeTestnet
+	default:
+		return types.NetworkModeMainnet
+	}
+}
+
+// countActiveAttestations returns the number of unexpired attestations per denom.
+func countActiveAttestations(
+	ctx context.Context,
+	attestations collections.Map[collections.Pair[string, string], types.Attestation],
+) (map[string]uint32, error) {
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	counts := make(map[string]uint32)
+	err := attestations.Walk(ctx, nil, func(key collections.Pair[string, string], attestation types.Attestation) (bool, error) {
+		if attestation.IsActive(now) {
+			counts[key.K1()]++
+		}
+		return false, nil
+	})
+	return counts, err
+}
+
+// migrateVerifiedtokens rewrites every token, which also indexes it.
+func migrateVerifiedtokens(
+	ctx context.Context,
+	tokens *collections.IndexedMap[string, types.Verifiedtoken, types.VerifiedtokenIndexes],
+	attested map[string]uint32,
+	minAttestations uint32,
+) (map[string]types.Verifiedtoken, error) {
+	legacy := make(map[string]types.Verifiedtoken)
+	if err := tokens.Walk(ctx, nil, func(key string, token types.Verifiedtoken) (bool, error) {
+		legacy[key] = token
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	migrated := make(map[string]types.Verifiedtoken, len(legacy))
+	for _, key := range sortedKeys(legacy) {
+		token := legacy[key]
+		if token.Denom == "" {
+			token.Denom = key
+		}
+		if token.MerchantIncentiveStakersBps == 0 && token.MerchantIncentiveTreasuryBps == 0 {
+			token.MerchantIncentiveStakersBps = types.DefaultMerchantIncentiveStakersBps
+			token.MerchantIncentiveTreasuryBps = types.DefaultMerchantIncentiveTreasuryBps
+		}
+		if token.Creator == "" {
+			token.Creator = token.Issuer
+		}
+		// v1 owners set verified themselves; only attestations count from v2 on.
+		token.Verified = attested[token.Denom] >= minAttestations
+		// v1 tokens predate metadata versions and published a fixed display exponent.
+		if token.MetadataVersion == 0 && token.Decimals == 0 {
+			token.Decimals = types.LegacyTokenDecimals
+		}
+
+		if key != token.Denom {
+			if _, ok := legacy[token.Denom]; ok {
+				return nil, fmt.Errorf("verifiedtoken %q stored under key %q collides with an existing record", token.Denom, key)
+			}
+			if err := tokens.Remove(ctx, key); err != nil {
+				return nil, err
+			}
+		}
+		if err := tokens.Set(ctx, token.Denom, token); err != nil {
+			return nil, err
+		}
+		migrated[token.Denom] = token
+	}
+
+	return migrated, nil
+}
+
+func backfillCreatorUsage(
+	ctx context.Context,
+	creatorUsage collections.Map[string, types.CreatorUsage],
+	tokens map[string]types.Verifiedtoken,
+) error {
+	usage := make(map[string]types.CreatorUsage)
+	for _, denom := range sortedKeys(tokens) {
+		token := tokens[denom]
+		entry := usage[token.Creator]
+		entry.Address = token.Creator
+		entry.TokensRegistered++
+		if entry.TotalSupplyRegistered > math.MaxUint64-token.MaxSupply {
+			entry.TotalSupplyRegistered = math.MaxUint64
+		} else {
+			entry.TotalSupplyRegistered += token.MaxSupply
+		}
+		usage[token.Creator] = entry
+	}
+
+	for _, address := range sortedKeys(usage) {
+		if err := creatorUsage.Set(ctx, address, usage[address]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func migrateRewardaccruals(ctx context.Context, accruals collections.Map[string, types.Rewardaccrual]) error {
+	legacy := make(map[string]types.Rewardaccrual)
+	if err := accruals.Walk(ctx, nil, func(key string, record types.Rewardaccrual) (bool, error) {
+		legacy[key] = record
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	canonical := make(map[string]types.Rewardaccrual, len(legacy))
+	for _, key := range sortedKeys(legacy) {
+		record := legacy[key]
+		address, denom, ok := splitCompositeKey(key)
+		if record.Address == "" && ok {
+			record.Address = address
+		}
+		if record.Denom == "" && ok {
+			record.Denom = denom
+		}
+		if record.Address == "" || record.Denom == "" {
+			// Nothing to canonicalize against; keep the record where it is.
+			canonical[key] = record
+			continue
+		}
+		record.Key = fmt.Sprintf("%s|%s", record.Address, record.Denom)
+
+		if existing, ok := canonical[record.Key]; ok {
+			if existing.Amount > math.MaxUint64-record.Amount {
+				return fmt.Errorf("merging rewardaccrual %q would overflow uint64", record.Key)
+			}
+			record.Amount += existing.Amount
+			if existing.LastRollupDate > record.LastRollupDate {
+				record.LastRollupDate = existing.LastRollupDate
+			}
+			if existing.Creator != "" {
+				record.Creator = existing.Creator
+			}
+		}
+		canonical[record.Key] = record
+	}
+
+	for _, key := range sortedKeys(legacy) {
+		if err := accruals.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	for _, key := range sortedKeys(canonical) {
+		if err := accruals.Set(ctx, key, canonical[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func backfillRewardLiabilities(
+	ctx context.Context,
+	accruals collections.Map[string, types.Rewardaccrual],
+	liabilities collections.Map[string, types.RewardLiability],
+) error {
+	totals := make(map[string]types.RewardLiability)
+	if err := accruals.Walk(ctx, nil, func(_ string, record types.Rewardaccrual) (bool, error) {
+		if record.Denom == "" {
+			return false, nil
+		}
+		liability := totals[record.Denom]
+		liability.Denom = record.Denom
+		if liability.Outstanding > math.MaxUint64-record.Amount {
+			return true, fmt.Errorf("reward liability of %s would overflow uint64", record.Denom)
+		}
+		totals[record.Denom] = liability.Credit(record.Amount)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, denom := range sortedKeys(totals) {
+		if totals[denom].Holders == 0 {
+			continue
+		}
+		if err := liabilities.Set(ctx, denom, totals[denom]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func backfillTokenStakerStats(
+	ctx context.Context,
+	allocations collections.Map[string, types.Merchantallocation],
+	stakerStats collections.Map[string, types.TokenStakerStats],
+) error {
+	totals := make(map[string]types.TokenStakerStats)
+	if err := allocations.Walk(ctx, nil, func(_ string, allocation types.Merchantallocation) (bool, error) {
+		if allocation.Denom == "" {
+			return false, nil
+		}
+		stats := totals[allocation.Denom]
+		stats.Denom = allocation.Denom
+		totals[allocation.Denom] = stats.Add(allocation)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, denom := range sortedKeys(totals) {
+		if err := stakerStats.Set(ctx, denom, totals[denom]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func migrateMerchantallocations(
+	ctx context.Context,
+	allocations collections.Map[string, types.Merchantallocation],
+	tokens map[string]types.Verifiedtoken,
+) error {
+	legacy := make(map[string]types.Merchantallocation)
+	if err := allocations.Walk(ctx, nil, func(key string, record types.Merchantallocation) (bool, error) {
+		legacy[key] = record
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	canonical := make(map[string]types.Merchantallocation, len(legacy))
+	for _, key := range sortedKeys(legacy) {
+		record := legacy[key]
+		date, denom, ok := splitCompositeKey(key)
+		if record.Date == "" && ok {
+			record.Date = date
+		}
+		if record.Denom == "" && ok {
+			record.Denom = denom
+		}
+		if record.MerchantIncentiveStakersBps == 0 && record.MerchantIncentiveTreasuryBps == 0 {
+			if token, found := tokens[record.Denom]; found {
+				record.MerchantIncentiveStakersBps = token.MerchantIncentiveStakersBps
+				record.MerchantIncentiveTreasuryBps = token.MerchantIncentiveTreasuryBps
+			}
+		}
+		if record.Date == "" || record.Denom == "" {
+			canonical[key] = record
+			continue
+		}
+		record.Key = fmt.Sprintf("%s|%s", record.Date, record.Denom)
+
+		// A record already stored under the canonical key is the latest write for that day.
+		if _, ok := canonical[record.Key]; ok && key != record.Key {
+			continue
+		}
+		canonical[record.Key] = record
+	}
+
+	for _, key := range sortedKeys(legacy) {
+		if err := allocations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	for _, key := range sortedKeys(canonical) {
+		if err := allocations.Set(ctx, key, canonical[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func splitCompositeKey(key string) (string, string, bool) {
+	left, right, found := strings.Cut(key, "|")
+	if !found || left == "" || right == "" {
+		return "", "", false
+	}
+	return left, right, true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,168 @@
+package v2_test
+
+import (
+	"os"
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "tokenchain/x/loyalty/migrations/v2"
+	module "tokenchain/x/loyalty/module"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	cdc := encCfg.Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	sb := collections.NewSchemaBuilder(storeService)
+	tokens := collections.NewMap(sb, types.VerifiedtokenKey, "verifiedtoken", collections.StringKey, codec.CollValue[types.Verifiedtoken](cdc))
+	accruals := collections.NewMap(sb, types.RewardaccrualKey, "rewardaccrual", collections.StringKey, codec.CollValue[types.Rewardaccrual](cdc))
+	allocations := collections.NewMap(sb, types.MerchantallocationKey, "merchantallocation", collections.StringKey, codec.CollValue[types.Merchantallocation](cdc))
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	allowlist := collections.NewMap(sb, types.CreatorallowlistKey, "creatorallowlist", collections.StringKey, codec.CollValue[types.Creatorallowlist](cdc))
+	creatorUsage := collections.NewMap(sb, types.CreatorUsageKey, "creatorUsage", collections.StringKey, codec.CollValue[types.CreatorUsage](cdc))
+	liabilities := collections.NewMap(sb, types.RewardLiabilityKey, "rewardLiability", collections.StringKey, codec.CollValue[types.RewardLiability](cdc))
+	stakerStats := collections.NewMap(sb, types.TokenStakerStatsKey, "tokenStakerStats", collections.StringKey, codec.CollValue[types.TokenStakerStats](cdc))
+	tokenIndexes := types.NewVerifiedtokenIndexes(sb)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// Load the v1 fixture the same way v1 InitGenesis stored it.
+	bz, err := os.ReadFile("testdata/v1_genesis.json")
+	require.NoError(t, err)
+	var genState types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &genState))
+	require.NoError(t, params.Set(ctx, genState.Params))
+	for _, elem := range genState.CreatorallowlistMap {
+		require.NoError(t, allowlist.Set(ctx, elem.Address, elem))
+	}
+	for _, elem := range genState.VerifiedtokenMap {
+		require.NoError(t, tokens.Set(ctx, elem.Denom, elem))
+	}
+	for _, elem := range genState.RewardaccrualMap {
+		require.NoError(t, accruals.Set(ctx, elem.Key, elem))
+	}
+	for _, elem := range genState.MerchantallocationMap {
+		require.NoError(t, allocations.Set(ctx, elem.Key, elem))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	migratedParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NetworkModeLocalnet, migratedParams.NetworkMode)
+	require.Equal(t, types.DefaultMinVerifierAttestations, migratedParams.MinVerifierAttestations)
+
+	wheat, err := tokens.Get(ctx, "factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMerchantIncentiveStakersBps, wheat.MerchantIncentiveStakersBps)
+	require.Equal(t, types.DefaultMerchantIncentiveTreasuryBps, wheat.MerchantIncentiveTreasuryBps)
+	require.Equal(t, "merchant-a", wheat.Creator)
+	require.EqualValues(t, 2500, wheat.MintedSupply)
+	require.Equal(t, types.LegacyTokenDecimals, wheat.Decimals)
+	// The owner marked wheat verified in v1, but it holds no attestation.
+	require.False(t, wheat.Verified)
+
+	stone, err := tokens.Get(ctx, "factory/merchant-b/stone")
+	require.NoError(t, err)
+	require.EqualValues(t, 8000, stone.MerchantIncentiveStakersBps)
+	require.EqualValues(t, 2000, stone.MerchantIncentiveTreasuryBps)
+	require.Equal(t, "merchant-b-ops", stone.Creator)
+
+	// Read paths use the stored routing as is, so every token must carry a valid split.
+	require.NoError(t, tokens.Walk(ctx, nil, func(denom string, token types.Verifiedtoken) (bool, error) {
+		require.NoError(t, types.ValidateMerchantIncentiveRouting(token.MerchantIncentiveStakersBps, token.MerchantIncentiveTreasuryBps), denom)
+		return false, nil
+	}))
+
+	merged, err := accruals.Get(ctx, "customer-1|factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, "customer-1", merged.Address)
+	require.Equal(t, "factory/merchant-a/wheat", merged.Denom)
+	require.EqualValues(t, 100, merged.Amount)
+	require.Equal(t, "2026-02-26", merged.LastRollupDate)
+	require.Equal(t, "loyalty-authority", merged.Creator)
+
+	has, err := accruals.Has(ctx, "legacy-accrual-1")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	untouched, err := accruals.Get(ctx, "customer-2|factory/merchant-b/stone")
+	require.NoError(t, err)
+	require.EqualValues(t, 15, untouched.Amount)
+
+	orphan, err := accruals.Get(ctx, "orphan-accrual")
+	require.NoError(t, err)
+	require.EqualValues(t, 7, orphan.Amount)
+
+	wheatLiability, err := liabilities.Get(ctx, "factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, types.RewardLiability{Denom: "factory/merchant-a/wheat", Outstanding: 100, Holders: 1}, wheatLiability)
+	stoneLiability, err := liabilities.Get(ctx, "factory/merchant-b/stone")
+	require.NoError(t, err)
+	require.Equal(t, types.RewardLiability{Denom: "factory/merchant-b/stone", Outstanding: 15, Holders: 1}, stoneLiability)
+
+	ops, err := creatorUsage.Get(ctx, "merchant-b-ops")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, ops.TokensRegistered)
+	require.EqualValues(t, 500000, ops.TotalSupplyRegistered)
+	idle, err := creatorUsage.Has(ctx, "merchant-c")
+	require.NoError(t, err)
+	require.False(t, idle)
+
+	backfilled, err := allocations.Get(ctx, "2026-02-25|factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, "2026-02-25", backfilled.Date)
+	require.Equal(t, "factory/merchant-a/wheat", backfilled.Denom)
+	require.Equal(t, types.DefaultMerchantIncentiveStakersBps, backfilled.MerchantIncentiveStakersBps)
+	require.Equal(t, types.DefaultMerchantIncentiveTreasuryBps, backfilled.MerchantIncentiveTreasuryBps)
+
+	moved, err := allocations.Get(ctx, "2026-02-26|factory/merchant-b/stone")
+	require.NoError(t, err)
+	require.Equal(t, "2026-02-26|factory/merchant-b/stone", moved.Key)
+	require.EqualValues(t, 40, moved.BucketCAmount)
+
+	has, err = allocations.Has(ctx, "legacy-allocation-1")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	wheatStats, err := stakerStats.Get(ctx, "factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, types.TokenStakerStats{Denom: "factory/merchant-a/wheat", Allocations: 1, StakersAmount: 50, TreasuryAmount: 50, LastAllocationDate: "2026-02-25"}, wheatStats)
+	stoneStats, err := stakerStats.Get(ctx, "factory/merchant-b/stone")
+	require.NoError(t, err)
+	require.Equal(t, types.TokenStakerStats{Denom: "factory/merchant-b/stone", Allocations: 1, StakersAmount: 32, TreasuryAmount: 8, LastAllocationDate: "2026-02-26"}, stoneStats)
+
+	byIssuer, err := tokenIndexes.Issuer.MatchExact(ctx, "merchant-a")
+	require.NoError(t, err)
+	issuerDenoms, err := byIssuer.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"factory/merchant-a/wheat"}, issuerDenoms)
+	bySymbol, err := tokenIndexes.Symbol.MatchExact(ctx, "stone")
+	require.NoError(t, err)
+	symbolDenoms, err := bySymbol.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"factory/merchant-b/stone"}, symbolDenoms)
+
+	// Running the migration again must be a no-op.
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+	again, err := accruals.Get(ctx, "customer-1|factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, merged, again)
+	wheatAgain, err := liabilities.Get(ctx, "factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, wheatLiability, wheatAgain)
+	wheatStatsAgain, err := stakerStats.Get(ctx, "factory/merchant-a/wheat")
+	require.NoError(t, err)
+	require.Equal(t, wheatStats, wheatStatsAgain)
+}
@@ -17,6 +17,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "EffectiveMinimums",
+					Use:       "effective-minimums",
+					Short:     "Shows the minimums enforced for the configured network mode",
+				},
+				{
+					RpcMethod: "Authorities",
+					Use:       "authorities",
+					Short:     "Shows the module authority and the scoped role holders",
+				},
 				{
 					RpcMethod: "ListCreatorallowlist",
 					Use:       "list-creatorallowlist",
@@ -29,6 +39,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-creatorallowlist"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "CreatorQuota",
+					Use:            "creator-quota [address]",
+					Short:          "Shows an allowlisted creator's remaining token creation quota",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				{
 					RpcMethod: "ListVerifiedtoken",
 					Use:       "list-verifiedtoken",
@@ -64,6 +80,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "filter-rewardaccrual",
 					Short:     "Filter reward accrual records by address/denom",
 				},
+				{
+					RpcMethod:      "AccountPortfolio",
+					Use:            "account-portfolio [address]",
+					Short:          "Show the balance, claimable reward, reward pool cover, pending recoveries and metadata of every verified token an address touches",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				{
 					RpcMethod: "ListMerchantallocation",
 					Use:       "list-merchantallocation",
@@ -111,6 +133,149 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "denom"},
 					},
 				},
+				{
+					RpcMethod: "ListMerchant",
+					Use:       "list-merchant",
+					Short:     "List all merchant profiles",
+				},
+				{
+					RpcMethod:      "GetMerchant",
+					Use:            "get-merchant [id]",
+					Short:          "Gets a merchant profile by id",
+					Alias:          []string{"show-merchant"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "VerifiedtokensByMerchant",
+					Use:            "verifiedtokens-by-merchant [merchant-id]",
+					Short:          "List the verified tokens linked to a merchant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "merchant_id"}},
+				},
+				{
+					RpcMethod: "SearchVerifiedtokens",
+					Use:       "search-verifiedtokens",
+					Short:     "List verified tokens filtered by issuer, symbol prefix, verified, seizure opt-in and admin renounced (true or false), with supply, reward pool and staker totals",
+				},
+				{
+					RpcMethod:      "Attestations",
+					Use:            "attestations [denom]",
+					Short:          "List current verifier attestations and verification status for a token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "AttestationHistory",
+					Use:            "attestation-history [denom]",
+					Short:          "List the attestation, revocation and expiry history for a token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "PendingMetadataChange",
+					Use:            "pending-metadata-change [denom]",
+					Short:          "Show the queued name, symbol or max supply change for a token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "MetadataHistory",
+					Use:            "metadata-history [denom]",
+					Short:          "List the recorded metadata versions of a token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "MintSchedule",
+					Use:            "mint-schedule [denom] [id]",
+					Short:          "Show an active mint schedule of a token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "MintSchedules",
+					Use:       "mint-schedules",
+					Short:     "List active mint schedules (optional --denom)",
+				},
+				{
+					RpcMethod:      "MintRateLimit",
+					Use:            "mint-rate-limit [denom]",
+					Short:          "Show a token's mint rate limit, any pending loosening and current usage",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "PausedTokens",
+					Use:       "paused-tokens",
+					Short:     "List tokens with a pause in force",
+				},
+				{
+					RpcMethod:      "AddressFreeze",
+					Use:            "address-freeze [denom] [address]",
+					Short:          "Show the freeze of an address for a recovery-enabled token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "AddressFreezes",
+					Use:       "address-freezes",
+					Short:     "List address freezes in force (optional --denom)",
+				},
+				{
+					RpcMethod:      "TokenIBCPolicy",
+					Use:            "token-ibc-policy [denom]",
+					Short:          "Show a verified token's IBC transfer policy and the amount escrowed in outbound transfers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "IBCRecorders",
+					Use:       "ibc-recorders",
+					Short:     "List counterparty senders allowed to send loyalty memos (optional --channel-id)",
+				},
+				{
+					RpcMethod:      "IBCRateLimit",
+					Use:            "ibc-rate-limit [denom] [channel-id]",
+					Short:          "Show the IBC rate limit of a denom on a channel and its usage in the current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod: "IBCRateLimits",
+					Use:       "ibc-rate-limits",
+					Short:     "List IBC rate limits with their usage (optional --denom)",
+				},
+				{
+					RpcMethod:      "FeeToken",
+					Use:            "fee-token [denom]",
+					Short:          "Show the fee settings and gas pool of a verified token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "FeeTokens",
+					Use:       "fee-tokens",
+					Short:     "List the verified tokens that can pay transaction fees",
+				},
+				{
+					RpcMethod:      "ClaimSponsorship",
+					Use:            "claim-sponsorship [denom]",
+					Short:          "Show the claim sponsorship of a verified token and what is left of its daily budget (optional --address)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "LiabilitiesAt",
+					Use:            "liabilities-at [denom] [date]",
+					Short:          "Show the outstanding rewards, reward pool, minted supply and holders of a verified token at the close of a date (YYYY-MM-DD)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "date"}},
+				},
+				{
+					RpcMethod:      "MerchantICAs",
+					Use:            "merchant-icas [merchant-id]",
+					Short:          "List the interchain accounts of a merchant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "merchant_id"}},
+				},
+				{
+					RpcMethod:      "MerchantICAPackets",
+					Use:            "merchant-ica-packets [merchant-id]",
+					Short:          "List the packets sent for a merchant that are still in flight",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "merchant_id"}},
+				},
+				{
+					RpcMethod:      "TreasuryForwards",
+					Use:            "treasury-forwards [merchant-id]",
+					Short:          "List the treasury allocations forwarded to a merchant's interchain accounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "merchant_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -122,16 +287,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "UpdateAuthorities",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetIBCRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveIBCRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ResetIBCRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreateCreatorallowlist",
 					Use:            "create-creatorallowlist [address] [enabled]",
-					Short:          "Create a new creatorallowlist",
+					Short:          "Create a new creatorallowlist (optional --expires-at, --max-tokens, --max-total-supply)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "enabled"}},
 				},
 				{
 					RpcMethod:      "UpdateCreatorallowlist",
 					Use:            "update-creatorallowlist [address] [enabled]",
-					Short:          "Update creatorallowlist",
+					Short:          "Update creatorallowlist (optional --expires-at, --max-tokens, --max-total-supply)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "enabled"}},
 				},
 				{
@@ -142,15 +323,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "CreateVerifiedtoken",
-					Use:            "create-verifiedtoken [denom] [issuer] [name] [symbol] [description] [website] [max-supply] [minted-supply] [verified] [seizure-opt-in] [recovery-group-policy] [recovery-timelock-hours]",
-					Short:          "Create a new verifiedtoken",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "issuer"}, {ProtoField: "name"}, {ProtoField: "symbol"}, {ProtoField: "description"}, {ProtoField: "website"}, {ProtoField: "max_supply"}, {ProtoField: "minted_supply"}, {ProtoField: "verified"}, {ProtoField: "seizure_opt_in"}, {ProtoField: "recovery_group_policy"}, {ProtoField: "recovery_timelock_hours"}},
+					Use:            "create-verifiedtoken [denom] [issuer] [name] [symbol] [description] [website] [max-supply] [minted-supply] [seizure-opt-in] [recovery-group-policy] [recovery-timelock-hours]",
+					Short:          "Create a new verifiedtoken (optional --merchant-id, --decimals, --denom-units)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "issuer"}, {ProtoField: "name"}, {ProtoField: "symbol"}, {ProtoField: "description"}, {ProtoField: "website"}, {ProtoField: "max_supply"}, {ProtoField: "minted_supply"}, {ProtoField: "seizure_opt_in"}, {ProtoField: "recovery_group_policy"}, {ProtoField: "recovery_timelock_hours"}},
 				},
 				{
 					RpcMethod:      "UpdateVerifiedtoken",
-					Use:            "update-verifiedtoken [denom] [issuer] [name] [symbol] [description] [website] [max-supply] [minted-supply] [verified] [seizure-opt-in] [recovery-group-policy] [recovery-timelock-hours]",
-					Short:          "Update verifiedtoken",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "issuer"}, {ProtoField: "name"}, {ProtoField: "symbol"}, {ProtoField: "description"}, {ProtoField: "website"}, {ProtoField: "max_supply"}, {ProtoField: "minted_supply"}, {ProtoField: "verified"}, {ProtoField: "seizure_opt_in"}, {ProtoField: "recovery_group_policy"}, {ProtoField: "recovery_timelock_hours"}},
+					Use:            "update-verifiedtoken [denom] [issuer] [name] [symbol] [description] [website] [max-supply] [minted-supply] [seizure-opt-in] [recovery-group-policy] [recovery-timelock-hours]",
+					Short:          "Update verifiedtoken (optional --merchant-id, --decimals, --denom-units)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "issuer"}, {ProtoField: "name"}, {ProtoField: "symbol"}, {ProtoField: "description"}, {ProtoField: "website"}, {ProtoField: "max_supply"}, {ProtoField: "minted_supply"}, {ProtoField: "seizure_opt_in"}, {ProtoField: "recovery_group_policy"}, {ProtoField: "recovery_timelock_hours"}},
 				},
 				{
 					RpcMethod:      "RenounceTokenAdmin",
@@ -189,10 +370,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key"}},
 				},
 				{
-					RpcMethod:      "MintVerifiedToken",
-					Use:            "mint-verified-token [denom] [recipient] [amount]",
-					Short:          "Send a mint-verified-token tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "recipient"}, {ProtoField: "amount"}},
+					RpcMethod: "MintVerifiedToken",
+					Skip:      true, // see client/cli: accepts display-unit amounts
+				},
+				{
+					RpcMethod: "MintVerifiedTokenBatch",
+					Skip:      true, // see client/cli: reads recipients from a CSV file
 				},
 				{
 					RpcMethod:      "ClaimReward",
@@ -201,10 +384,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
 				},
 				{
-					RpcMethod:      "FundRewardPool",
-					Use:            "fund-reward-pool [denom] [amount]",
-					Short:          "Fund the loyalty reward pool from signer account",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "amount"}},
+					RpcMethod: "FundRewardPool",
+					Skip:      true, // see client/cli: accepts display-unit amounts
 				},
 				{
 					RpcMethod:      "RecordRewardAccrual",
@@ -236,6 +417,142 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a cancel-recovery-transfer tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "reason"}},
 				},
+				{
+					RpcMethod:      "RegisterMerchant",
+					Use:            "register-merchant [legal-name] [payout-address]",
+					Short:          "Register a merchant profile owned by the signer (optional --contact-uri, --logo-hash)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "legal_name"}, {ProtoField: "payout_address"}},
+				},
+				{
+					RpcMethod:      "UpdateMerchant",
+					Use:            "update-merchant [id] [legal-name] [payout-address]",
+					Short:          "Update a merchant profile (optional --contact-uri, --logo-hash, --verification-tier)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "legal_name"}, {ProtoField: "payout_address"}},
+				},
+				{
+					RpcMethod:      "DeactivateMerchant",
+					Use:            "deactivate-merchant [id]",
+					Short:          "Permanently deactivate a merchant profile",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "SubmitAttestation",
+					Use:            "submit-attestation [denom] [evidence-uri] [expires-at]",
+					Short:          "Attest a verified token as a verifier until expires-at (unix seconds)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "evidence_uri"}, {ProtoField: "expires_at"}},
+				},
+				{
+					RpcMethod:      "RevokeAttestation",
+					Use:            "revoke-attestation [denom]",
+					Short:          "Revoke your attestation for a verified token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "CreateMintSchedule",
+					Use:            "create-mint-schedule [denom] [recipient] [tranche-amount] [total-amount] [cadence] [start-date]",
+					Short:          "Release total-amount to recipient in tranches on a daily, weekly or monthly cadence from start-date (YYYY-MM-DD, optional --interval)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "recipient"}, {ProtoField: "tranche_amount"}, {ProtoField: "total_amount"}, {ProtoField: "cadence"}, {ProtoField: "start_date"}},
+				},
+				{
+					RpcMethod:      "CancelMintSchedule",
+					Use:            "cancel-mint-schedule [denom] [id]",
+					Short:          "Cancel a mint schedule and release its unminted reservation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "SetMintRateLimit",
+					Use:            "set-mint-rate-limit [denom]",
+					Short:          "Set a token's mint rate limit (--max-per-tx, --max-per-rolling-day, --max-per-rollup-date; 0 is unlimited)",
+					Long:           "Set a token's mint rate limit. Tighter limits apply immediately; any loosening waits metadata_change_delay_hours. Resubmitting the current limit cancels a pending loosening.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "PauseToken",
+					Use:            "pause-token [denom]",
+					Short:          "Pause a token's mint, claim and/or transfer activity (--scopes, default all; optional --reason, --expires-at)",
+					Long:           "Pause a token. The owner and pause authorities (allowlist admins and accounts allowed to trip the circuit breaker for MsgPauseToken) may pause; a pause set by an authority can only be changed or lifted by an authority.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "UnpauseToken",
+					Use:            "unpause-token [denom]",
+					Short:          "Lift a token's pause, or only some of its scopes with --scopes",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "FreezeAddress",
+					Use:            "freeze-address [denom] [address] [expires-at]",
+					Short:          "Block outgoing transfers of a recovery-enabled token from an address until expires-at (unix seconds; optional --link-recovery --recovery-id, --reason)",
+					Long:           "Freeze an address's balance of a recovery-enabled token. Only the token's recovery group policy or a recovery overseer may freeze, for at most the recovery timelock plus a 7 day grace period. A freeze linked with --link-recovery --recovery-id is lifted when that recovery executes or is cancelled.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}, {ProtoField: "expires_at"}},
+				},
+				{
+					RpcMethod:      "UnfreezeAddress",
+					Use:            "unfreeze-address [denom] [address]",
+					Short:          "Lift an address freeze early",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SetTokenIBCPolicy",
+					Use:            "set-token-ibc-policy [denom] [mode]",
+					Short:          "Set whether a verified token may leave the chain over IBC: allowed, blocked or allowlisted (with --allowed-channels)",
+					Long:           "Set a verified token's IBC transfer policy. Tokens default to allowed, or blocked when recovery is enabled; recovery-enabled tokens can only be blocked or limited to allowlisted channels (or client ids for IBC v2). Only the token owner or an allowlist admin may change it.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "mode"}},
+				},
+				{
+					RpcMethod:      "SetIBCRecorder",
+					Use:            "set-ibc-recorder [channel-id] [sender] [enabled]",
+					Short:          "Allow (enabled=true) or stop (false) a sender on a counterparty chain sending loyalty memos over a channel",
+					Long:           "Allowlist a transfer sender on the chain at the other end of channel-id (a client id for IBC v2) to record reward accruals and fund reward pools with loyalty memos on incoming ICS-20 transfers. Only accrual recorders may change the list.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "sender"}, {ProtoField: "enabled"}},
+				},
+				{
+					RpcMethod:      "RegisterMerchantICA",
+					Use:            "register-merchant-ica [denom] [connection-id]",
+					Short:          "Open an interchain account for the merchant of a verified token you own",
+					Long:           "Start the channel handshake of an interchain account controlled by the merchant linked to denom on the chain at the other end of connection-id. The account turns active once a relayer completes the handshake; see merchant-icas.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod:      "SendMerchantICATx",
+					Use:            "send-merchant-ica-tx [denom] [connection-id] [cosmos-tx]",
+					Short:          "Execute messages with a merchant interchain account",
+					Long:           "cosmos-tx is a base64 proto-encoded CosmosTx, as in the data of packet data built by 'interchain-accounts host generate-packet-data'. Optional flags: --memo, --timeout-seconds (default ten minutes).",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "connection_id"}, {ProtoField: "cosmos_tx"}},
+				},
+				{
+					RpcMethod:      "ForwardMerchantTreasury",
+					Use:            "forward-merchant-treasury [allocation-key] [connection-id] [transfer-channel]",
+					Short:          "Send the treasury amount of a merchant allocation to the merchant's interchain account",
+					Long:           "Transfer the allocation's treasury amount of the token from your account to the merchant interchain account on connection-id, over a transfer channel on the same connection. Optional flag: --timeout-seconds (default ten minutes).",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "allocation_key"}, {ProtoField: "connection_id"}, {ProtoField: "transfer_channel"}},
+				},
+				{
+					RpcMethod:      "SetFeeToken",
+					Use:            "set-fee-token [denom] [token-units] [utoken-units] [enabled]",
+					Short:          "Set the rate at which a verified token pays transaction fees (optional --max-utoken-per-tx, --msg-types)",
+					Long:           "token-units of denom pay for utoken-units of the staking denom. Only the module authority can enable or disable a fee token; the token owner can retune one that is enabled.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "token_units"}, {ProtoField: "utoken_units"}, {ProtoField: "enabled"}},
+				},
+				{
+					RpcMethod:      "FundGasPool",
+					Use:            "fund-gas-pool [denom] [amount]",
+					Short:          "Add staking denom to the gas pool that pays the fees of a verified token you own",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "WithdrawGasPool",
+					Use:            "withdraw-gas-pool [denom] [amount]",
+					Short:          "Take staking denom out of the gas pool of a verified token you own (optional --recipient)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "SetClaimSponsorship",
+					Use:            "set-claim-sponsorship [denom] [daily-budget] [per-user-cap] [enabled]",
+					Short:          "Pay the claim fees of holders of a verified token you own through fee grants (optional --msg-types)",
+					Long:           "When an accrual credits an address with nothing to claim, your account grants it a fee allowance of up to per-user-cap in the staking denom, within daily-budget per day. Allowances pay for reward claims unless --msg-types is set. Disabling the sponsorship revokes them.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "daily_budget"}, {ProtoField: "per_user_cap"}, {ProtoField: "enabled"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -0,0 +1,44 @@
+package loyalty
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+var _ types.CircuitKeeper = circuitAdapter{}
+
+// circuitAdapter lets the loyalty keeper ask x/circuit who may trip a message, using
+// the same rules as MsgTripCircuitBreaker.
+type circuitAdapter struct {
+	keeper circuitkeeper.Keeper
+}
+
+func (a circuitAdapter) CanTrip(ctx context.Context, addr sdk.AccAddress, msgTypeURL string) (bool, error) {
+	if bytes.Equal(addr, a.keeper.GetAuthority()) {
+		return true, nil
+	}
+	perms, err := a.keeper.Permissions.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	switch perms.Level {
+	case circuittypes.Permissions_LEVEL_SUPER_ADMIN, circuittypes.Permissions_LEVEL_ALL_MSGS:
+		return true, nil
+	case circuittypes.Permissions_LEVEL_SOME_MSGS:
+		return slices.Contains(perms.LimitTypeUrls, msgTypeURL), nil
+	default:
+		return false, nil
+	}
+}
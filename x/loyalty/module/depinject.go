@@ -6,8 +6,11 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"tokenchain/x/loyalty/keeper"
 	"tokenchain/x/loyalty/types"
@@ -33,17 +36,20 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper    types.AuthKeeper
-	BankKeeper    types.BankKeeper
-	StakingKeeper types.StakingKeeper
-	GroupKeeper   types.GroupKeeper
+	AuthKeeper     types.AuthKeeper
+	BankKeeper     types.BankKeeper
+	StakingKeeper  types.StakingKeeper
+	GroupKeeper    types.GroupKeeper
+	CircuitKeeper  circuitkeeper.Keeper
+	FeeGrantKeeper feegrantkeeper.Keeper
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	LoyaltyKeeper keeper.Keeper
-	Module        appmodule.AppModule
+	LoyaltyKeeper   keeper.Keeper
+	Module          appmodule.AppModule
+	SendRestriction banktypes.SendRestrictionFn
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -61,8 +67,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AuthKeeper,
 		in.StakingKeeper,
 		in.GroupKeeper,
+		circuitAdapter{keeper: in.CircuitKeeper},
+		feegrantAdapter{keeper: in.FeeGrantKeeper, addressCodec: in.AddressCodec},
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{LoyaltyKeeper: k, Module: m}
+	return ModuleOutputs{LoyaltyKeeper: k, Module: m, SendRestriction: k.SendRestrictionFn}
 }
@@ -0,0 +1,47 @@
+package loyalty
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"tokenchain/x/loyalty/types"
+)
+
+var _ types.FeeGrantKeeper = feegrantAdapter{}
+
+// feegrantAdapter lets the loyalty keeper manage the allowances of claim
+// sponsorships. x/feegrant only revokes through its message server, so revocations
+// go through it.
+type feegrantAdapter struct {
+	keeper       feegrantkeeper.Keeper
+	addressCodec address.Codec
+}
+
+func (a feegrantAdapter) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	return a.keeper.GrantAllowance(ctx, granter, grantee, feeAllowance)
+}
+
+func (a feegrantAdapter) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	return a.keeper.UpdateAllowance(ctx, granter, grantee, feeAllowance)
+}
+
+func (a feegrantAdapter) GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	return a.keeper.GetAllowance(ctx, granter, grantee)
+}
+
+func (a feegrantAdapter) RevokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	granterStr, err := a.addressCodec.BytesToString(granter)
+	if err != nil {
+		return err
+	}
+	granteeStr, err := a.addressCodec.BytesToString(grantee)
+	if err != nil {
+		return err
+	}
+	_, err = feegrantkeeper.NewMsgServerImpl(a.keeper).RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{Granter: granterStr, Grantee: granteeStr})
+	return err
+}
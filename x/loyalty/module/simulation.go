@@ -3,270 +3,131 @@ package loyalty
 import (
 	"math/rand"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
-	"tokenchain/testutil/sample"
+	"tokenchain/x/loyalty/keeper"
 	loyaltysimulation "tokenchain/x/loyalty/simulation"
 	"tokenchain/x/loyalty/types"
 )
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	loyaltyGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-		CreatorallowlistMap: []types.Creatorallowlist{{Creator: sample.AccAddress(),
-			Address: "0",
-		}, {Creator: sample.AccAddress(),
-			Address: "1",
-		}}, VerifiedtokenMap: []types.Verifiedtoken{{Creator: sample.AccAddress(),
-			Denom: "0",
-		}, {Creator: sample.AccAddress(),
-			Denom: "1",
-		}}, RewardaccrualMap: []types.Rewardaccrual{{Creator: sample.AccAddress(),
-			Key: "0",
-		}, {Creator: sample.AccAddress(),
-			Key: "1",
-		}}}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&loyaltyGenesis)
+	loyaltysimulation.RandomizedGenState(simState)
 }
 
 // RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
-
-// WeightedOperations returns the all the gov module operations with their respective weights.
-func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	operations := make([]simtypes.WeightedOperation, 0)
-	const (
-		opWeightMsgCreateCreatorallowlist          = "op_weight_msg_loyalty"
-		defaultWeightMsgCreateCreatorallowlist int = 100
-	)
-
-	var weightMsgCreateCreatorallowlist int
-	simState.AppParams.GetOrGenerate(opWeightMsgCreateCreatorallowlist, &weightMsgCreateCreatorallowlist, nil,
-		func(_ *rand.Rand) {
-			weightMsgCreateCreatorallowlist = defaultWeightMsgCreateCreatorallowlist
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCreateCreatorallowlist,
-		loyaltysimulation.SimulateMsgCreateCreatorallowlist(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgUpdateCreatorallowlist          = "op_weight_msg_loyalty"
-		defaultWeightMsgUpdateCreatorallowlist int = 100
-	)
-
-	var weightMsgUpdateCreatorallowlist int
-	simState.AppParams.GetOrGenerate(opWeightMsgUpdateCreatorallowlist, &weightMsgUpdateCreatorallowlist, nil,
-		func(_ *rand.Rand) {
-			weightMsgUpdateCreatorallowlist = defaultWeightMsgUpdateCreatorallowlist
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateCreatorallowlist,
-		loyaltysimulation.SimulateMsgUpdateCreatorallowlist(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgDeleteCreatorallowlist          = "op_weight_msg_loyalty"
-		defaultWeightMsgDeleteCreatorallowlist int = 100
-	)
-
-	var weightMsgDeleteCreatorallowlist int
-	simState.AppParams.GetOrGenerate(opWeightMsgDeleteCreatorallowlist, &weightMsgDeleteCreatorallowlist, nil,
-		func(_ *rand.Rand) {
-			weightMsgDeleteCreatorallowlist = defaultWeightMsgDeleteCreatorallowlist
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgDeleteCreatorallowlist,
-		loyaltysimulation.SimulateMsgDeleteCreatorallowlist(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgCreateVerifiedtoken          = "op_weight_msg_loyalty"
-		defaultWeightMsgCreateVerifiedtoken int = 100
-	)
-
-	var weightMsgCreateVerifiedtoken int
-	simState.AppParams.GetOrGenerate(opWeightMsgCreateVerifiedtoken, &weightMsgCreateVerifiedtoken, nil,
-		func(_ *rand.Rand) {
-			weightMsgCreateVerifiedtoken = defaultWeightMsgCreateVerifiedtoken
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCreateVerifiedtoken,
-		loyaltysimulation.SimulateMsgCreateVerifiedtoken(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgUpdateVerifiedtoken          = "op_weight_msg_loyalty"
-		defaultWeightMsgUpdateVerifiedtoken int = 100
-	)
-
-	var weightMsgUpdateVerifiedtoken int
-	simState.AppParams.GetOrGenerate(opWeightMsgUpdateVerifiedtoken, &weightMsgUpdateVerifiedtoken, nil,
-		func(_ *rand.Rand) {
-			weightMsgUpdateVerifiedtoken = defaultWeightMsgUpdateVerifiedtoken
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateVerifiedtoken,
-		loyaltysimulation.SimulateMsgUpdateVerifiedtoken(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgDeleteVerifiedtoken          = "op_weight_msg_loyalty"
-		defaultWeightMsgDeleteVerifiedtoken int = 100
-	)
-
-	var weightMsgDeleteVerifiedtoken int
-	simState.AppParams.GetOrGenerate(opWeightMsgDeleteVerifiedtoken, &weightMsgDeleteVerifiedtoken, nil,
-		func(_ *rand.Rand) {
-			weightMsgDeleteVerifiedtoken = defaultWeightMsgDeleteVerifiedtoken
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgDeleteVerifiedtoken,
-		loyaltysimulation.SimulateMsgDeleteVerifiedtoken(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgCreateRewardaccrual          = "op_weight_msg_loyalty"
-		defaultWeightMsgCreateRewardaccrual int = 100
-	)
-
-	var weightMsgCreateRewardaccrual int
-	simState.AppParams.GetOrGenerate(opWeightMsgCreateRewardaccrual, &weightMsgCreateRewardaccrual, nil,
-		func(_ *rand.Rand) {
-			weightMsgCreateRewardaccrual = defaultWeightMsgCreateRewardaccrual
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCreateRewardaccrual,
-		loyaltysimulation.SimulateMsgCreateRewardaccrual(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgUpdateRewardaccrual          = "op_weight_msg_loyalty"
-		defaultWeightMsgUpdateRewardaccrual int = 100
-	)
-
-	var weightMsgUpdateRewardaccrual int
-	simState.AppParams.GetOrGenerate(opWeightMsgUpdateRewardaccrual, &weightMsgUpdateRewardaccrual, nil,
-		func(_ *rand.Rand) {
-			weightMsgUpdateRewardaccrual = defaultWeightMsgUpdateRewardaccrual
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateRewardaccrual,
-		loyaltysimulation.SimulateMsgUpdateRewardaccrual(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgDeleteRewardaccrual          = "op_weight_msg_loyalty"
-		defaultWeightMsgDeleteRewardaccrual int = 100
-	)
-
-	var weightMsgDeleteRewardaccrual int
-	simState.AppParams.GetOrGenerate(opWeightMsgDeleteRewardaccrual, &weightMsgDeleteRewardaccrual, nil,
-		func(_ *rand.Rand) {
-			weightMsgDeleteRewardaccrual = defaultWeightMsgDeleteRewardaccrual
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgDeleteRewardaccrual,
-		loyaltysimulation.SimulateMsgDeleteRewardaccrual(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgMintVerifiedToken          = "op_weight_msg_loyalty"
-		defaultWeightMsgMintVerifiedToken int = 100
-	)
-
-	var weightMsgMintVerifiedToken int
-	simState.AppParams.GetOrGenerate(opWeightMsgMintVerifiedToken, &weightMsgMintVerifiedToken, nil,
-		func(_ *rand.Rand) {
-			weightMsgMintVerifiedToken = defaultWeightMsgMintVerifiedToken
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgMintVerifiedToken,
-		loyaltysimulation.SimulateMsgMintVerifiedToken(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgClaimReward          = "op_weight_msg_loyalty"
-		defaultWeightMsgClaimReward int = 100
-	)
-
-	var weightMsgClaimReward int
-	simState.AppParams.GetOrGenerate(opWeightMsgClaimReward, &weightMsgClaimReward, nil,
-		func(_ *rand.Rand) {
-			weightMsgClaimReward = defaultWeightMsgClaimReward
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgClaimReward,
-		loyaltysimulation.SimulateMsgClaimReward(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgRecordRewardAccrual          = "op_weight_msg_loyalty"
-		defaultWeightMsgRecordRewardAccrual int = 100
-	)
-
-	var weightMsgRecordRewardAccrual int
-	simState.AppParams.GetOrGenerate(opWeightMsgRecordRewardAccrual, &weightMsgRecordRewardAccrual, nil,
-		func(_ *rand.Rand) {
-			weightMsgRecordRewardAccrual = defaultWeightMsgRecordRewardAccrual
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgRecordRewardAccrual,
-		loyaltysimulation.SimulateMsgRecordRewardAccrual(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgQueueRecoveryTransfer          = "op_weight_msg_loyalty"
-		defaultWeightMsgQueueRecoveryTransfer int = 100
-	)
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = loyaltysimulation.NewDecodeStore(am.cdc)
+}
 
-	var weightMsgQueueRecoveryTransfer int
-	simState.AppParams.GetOrGenerate(opWeightMsgQueueRecoveryTransfer, &weightMsgQueueRecoveryTransfer, nil,
-		func(_ *rand.Rand) {
-			weightMsgQueueRecoveryTransfer = defaultWeightMsgQueueRecoveryTransfer
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgQueueRecoveryTransfer,
-		loyaltysimulation.SimulateMsgQueueRecoveryTransfer(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgExecuteRecoveryTransfer          = "op_weight_msg_loyalty"
-		defaultWeightMsgExecuteRecoveryTransfer int = 100
-	)
+// Simulation operation weights. Each key can be overridden through the simulator's
+// app params file.
+const (
+	opWeightMsgCreateCreatorallowlist      = "op_weight_msg_create_creatorallowlist"
+	opWeightMsgUpdateCreatorallowlist      = "op_weight_msg_update_creatorallowlist"
+	opWeightMsgDeleteCreatorallowlist      = "op_weight_msg_delete_creatorallowlist"
+	opWeightMsgCreateVerifiedtoken         = "op_weight_msg_create_verifiedtoken"
+	opWeightMsgUpdateVerifiedtoken         = "op_weight_msg_update_verifiedtoken"
+	opWeightMsgDeleteVerifiedtoken         = "op_weight_msg_delete_verifiedtoken"
+	opWeightMsgCreateRewardaccrual         = "op_weight_msg_create_rewardaccrual"
+	opWeightMsgUpdateRewardaccrual         = "op_weight_msg_update_rewardaccrual"
+	opWeightMsgDeleteRewardaccrual         = "op_weight_msg_delete_rewardaccrual"
+	opWeightMsgMintVerifiedToken           = "op_weight_msg_mint_verified_token"
+	opWeightMsgMintVerifiedTokenBatch      = "op_weight_msg_mint_verified_token_batch"
+	opWeightMsgFundRewardPool              = "op_weight_msg_fund_reward_pool"
+	opWeightMsgRecordRewardAccrual         = "op_weight_msg_record_reward_accrual"
+	opWeightMsgClaimReward                 = "op_weight_msg_claim_reward"
+	opWeightMsgRecordMerchantAllocation    = "op_weight_msg_record_merchant_allocation"
+	opWeightMsgSetMerchantIncentiveRouting = "op_weight_msg_set_merchant_incentive_routing"
+	opWeightMsgRenounceTokenAdmin          = "op_weight_msg_renounce_token_admin"
+	opWeightMsgQueueRecoveryTransfer       = "op_weight_msg_queue_recovery_transfer"
+	opWeightMsgExecuteRecoveryTransfer     = "op_weight_msg_execute_recovery_transfer"
+	opWeightMsgCancelRecoveryTransfer      = "op_weight_msg_cancel_recovery_transfer"
+	opWeightMsgRegisterMerchant            = "op_weight_msg_register_merchant"
+	opWeightMsgUpdateMerchant              = "op_weight_msg_update_merchant"
+	opWeightMsgDeactivateMerchant          = "op_weight_msg_deactivate_merchant"
+	opWeightMsgSubmitAttestation           = "op_weight_msg_submit_attestation"
+	opWeightMsgRevokeAttestation           = "op_weight_msg_revoke_attestation"
+	opWeightMsgCreateMintSchedule          = "op_weight_msg_create_mint_schedule"
+	opWeightMsgCancelMintSchedule          = "op_weight_msg_cancel_mint_schedule"
+	opWeightMsgSetMintRateLimit            = "op_weight_msg_set_mint_rate_limit"
+	opWeightMsgPauseToken                  = "op_weight_msg_pause_token"
+	opWeightMsgUnpauseToken                = "op_weight_msg_unpause_token"
+	opWeightMsgFreezeAddress               = "op_weight_msg_freeze_address"
+	opWeightMsgUnfreezeAddress             = "op_weight_msg_unfreeze_address"
+	opWeightMsgSetTokenIBCPolicy           = "op_weight_msg_set_token_ibc_policy"
+	opWeightMsgSetIBCRecorder              = "op_weight_msg_set_ibc_recorder"
+	opWeightMsgFundGasPool                 = "op_weight_msg_fund_gas_pool"
+	opWeightMsgWithdrawGasPool             = "op_weight_msg_withdraw_gas_pool"
+	opWeightMsgSetClaimSponsorship         = "op_weight_msg_set_claim_sponsorship"
+)
 
-	var weightMsgExecuteRecoveryTransfer int
-	simState.AppParams.GetOrGenerate(opWeightMsgExecuteRecoveryTransfer, &weightMsgExecuteRecoveryTransfer, nil,
-		func(_ *rand.Rand) {
-			weightMsgExecuteRecoveryTransfer = defaultWeightMsgExecuteRecoveryTransfer
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgExecuteRecoveryTransfer,
-		loyaltysimulation.SimulateMsgExecuteRecoveryTransfer(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgCancelRecoveryTransfer          = "op_weight_msg_loyalty"
-		defaultWeightMsgCancelRecoveryTransfer int = 100
-	)
+// WeightedOperations returns the all the loyalty module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	type operationFactory func(types.AuthKeeper, types.BankKeeper, keeper.Keeper, client.TxConfig) simtypes.Operation
+
+	// Default weights favour the day-to-day flows (mint, accrue, fund, claim) over
+	// administrative and one-way operations such as renouncing token admin.
+	ops := []struct {
+		key           string
+		defaultWeight int
+		factory       operationFactory
+	}{
+		{opWeightMsgCreateCreatorallowlist, 20, loyaltysimulation.SimulateMsgCreateCreatorallowlist},
+		{opWeightMsgUpdateCreatorallowlist, 10, loyaltysimulation.SimulateMsgUpdateCreatorallowlist},
+		{opWeightMsgDeleteCreatorallowlist, 5, loyaltysimulation.SimulateMsgDeleteCreatorallowlist},
+		{opWeightMsgCreateVerifiedtoken, 30, loyaltysimulation.SimulateMsgCreateVerifiedtoken},
+		{opWeightMsgUpdateVerifiedtoken, 10, loyaltysimulation.SimulateMsgUpdateVerifiedtoken},
+		{opWeightMsgDeleteVerifiedtoken, 5, loyaltysimulation.SimulateMsgDeleteVerifiedtoken},
+		{opWeightMsgCreateRewardaccrual, 10, loyaltysimulation.SimulateMsgCreateRewardaccrual},
+		{opWeightMsgUpdateRewardaccrual, 10, loyaltysimulation.SimulateMsgUpdateRewardaccrual},
+		{opWeightMsgDeleteRewardaccrual, 5, loyaltysimulation.SimulateMsgDeleteRewardaccrual},
+		{opWeightMsgMintVerifiedToken, 100, loyaltysimulation.SimulateMsgMintVerifiedToken},
+		{opWeightMsgMintVerifiedTokenBatch, 20, loyaltysimulation.SimulateMsgMintVerifiedTokenBatch},
+		{opWeightMsgFundRewardPool, 60, loyaltysimulation.SimulateMsgFundRewardPool},
+		{opWeightMsgRecordRewardAccrual, 80, loyaltysimulation.SimulateMsgRecordRewardAccrual},
+		{opWeightMsgClaimReward, 60, loyaltysimulation.SimulateMsgClaimReward},
+		{opWeightMsgRecordMerchantAllocation, 40, loyaltysimulation.SimulateMsgRecordMerchantAllocation},
+		{opWeightMsgSetMerchantIncentiveRouting, 20, loyaltysimulation.SimulateMsgSetMerchantIncentiveRouting},
+		{opWeightMsgRenounceTokenAdmin, 2, loyaltysimulation.SimulateMsgRenounceTokenAdmin},
+		{opWeightMsgQueueRecoveryTransfer, 30, loyaltysimulation.SimulateMsgQueueRecoveryTransfer},
+		{opWeightMsgExecuteRecoveryTransfer, 30, loyaltysimulation.SimulateMsgExecuteRecoveryTransfer},
+		{opWeightMsgCancelRecoveryTransfer, 10, loyaltysimulation.SimulateMsgCancelRecoveryTransfer},
+		{opWeightMsgRegisterMerchant, 20, loyaltysimulation.SimulateMsgRegisterMerchant},
+		{opWeightMsgUpdateMerchant, 10, loyaltysimulation.SimulateMsgUpdateMerchant},
+		{opWeightMsgDeactivateMerchant, 2, loyaltysimulation.SimulateMsgDeactivateMerchant},
+		{opWeightMsgSubmitAttestation, 20, loyaltysimulation.SimulateMsgSubmitAttestation},
+		{opWeightMsgRevokeAttestation, 5, loyaltysimulation.SimulateMsgRevokeAttestation},
+		{opWeightMsgCreateMintSchedule, 20, loyaltysimulation.SimulateMsgCreateMintSchedule},
+		{opWeightMsgCancelMintSchedule, 5, loyaltysimulation.SimulateMsgCancelMintSchedule},
+		{opWeightMsgSetMintRateLimit, 10, loyaltysimulation.SimulateMsgSetMintRateLimit},
+		{opWeightMsgPauseToken, 3, loyaltysimulation.SimulateMsgPauseToken},
+		{opWeightMsgUnpauseToken, 5, loyaltysimulation.SimulateMsgUnpauseToken},
+		{opWeightMsgFreezeAddress, 5, loyaltysimulation.SimulateMsgFreezeAddress},
+		{opWeightMsgUnfreezeAddress, 3, loyaltysimulation.SimulateMsgUnfreezeAddress},
+		{opWeightMsgSetTokenIBCPolicy, 5, loyaltysimulation.SimulateMsgSetTokenIBCPolicy},
+		{opWeightMsgSetIBCRecorder, 3, loyaltysimulation.SimulateMsgSetIBCRecorder},
+		{opWeightMsgFundGasPool, 10, loyaltysimulation.SimulateMsgFundGasPool},
+		{opWeightMsgWithdrawGasPool, 3, loyaltysimulation.SimulateMsgWithdrawGasPool},
+		{opWeightMsgSetClaimSponsorship, 5, loyaltysimulation.SimulateMsgSetClaimSponsorship},
+	}
 
-	var weightMsgCancelRecoveryTransfer int
-	simState.AppParams.GetOrGenerate(opWeightMsgCancelRecoveryTransfer, &weightMsgCancelRecoveryTransfer, nil,
-		func(_ *rand.Rand) {
-			weightMsgCancelRecoveryTransfer = defaultWeightMsgCancelRecoveryTransfer
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCancelRecoveryTransfer,
-		loyaltysimulation.SimulateMsgCancelRecoveryTransfer(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
+	operations := make([]simtypes.WeightedOperation, 0, len(ops))
+	for _, op := range ops {
+		var weight int
+		simState.AppParams.GetOrGenerate(op.key, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = op.defaultWeight
+			},
+		)
+		operations = append(operations, simulation.NewWeightedOperation(
+			weight,
+			op.factory(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		))
+	}
 
 	return operations
 }
@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgFreezeAddress(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgFreezeAddress{}
+		token, found := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+			return token.SeizureOptIn && token.RecoveryGroupPolicy != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no recovery-enabled verifiedtoken"), nil, nil
+		}
+		signer, found := recoverySigner(r, ctx, ak, k, accs, token, "")
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no recovery signer account"), nil, nil
+		}
+
+		// Freeze an address outside the simulation accounts: bank send operations do not
+		// know about freezes and would fail on a frozen sender.
+		target := simtypes.RandomAccounts(r, 1)[0]
+		msg.Creator = signer.Address.String()
+		msg.Denom = token.Denom
+		msg.Address = target.Address.String()
+		msg.Reason = simtypes.RandStringOfLength(r, 12)
+		msg.ExpiresAt = uint64(ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 6)) * time.Hour).Unix())
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, signer, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgUnfreezeAddress(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUnfreezeAddress{}
+		now := uint64(ctx.BlockTime().Unix())
+		var freezes []types.AddressFreeze
+		if err := k.AddressFreeze.Walk(ctx, nil, func(_ collections.Pair[string, string], freeze types.AddressFreeze) (bool, error) {
+			if freeze.ActiveAt(now) {
+				freezes = append(freezes, freeze)
+			}
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+		if len(freezes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no frozen address"), nil, nil
+		}
+		freeze := freezes[r.Intn(len(freezes))]
+		token, err := k.Verifiedtoken.Get(ctx, freeze.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "frozen verifiedtoken not found"), nil, nil
+		}
+		signer, found := recoverySigner(r, ctx, ak, k, accs, token, "")
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no recovery signer account"), nil, nil
+		}
+
+		msg.Creator = signer.Address.String()
+		msg.Denom = freeze.Denom
+		msg.Address = freeze.Address
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, signer, msg, sdk.NewCoins())
+	}
+}
@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgSubmitAttestation(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSubmitAttestation{}
+		verifier, ok := roleHolder(ctx, ak, k, accs, types.RoleVerifier)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifier account"), nil, nil
+		}
+		token, found := randomToken(r, ctx, k, func(types.Verifiedtoken) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifiedtoken"), nil, nil
+		}
+
+		msg.Creator = verifier.Address.String()
+		msg.Denom = token.Denom
+		msg.EvidenceUri = "https://verifier.example/" + simtypes.RandStringOfLength(r, 10)
+		msg.ExpiresAt = uint64(ctx.BlockTime().Unix()) + uint64(simtypes.RandIntBetween(r, 60, 7*24*3600))
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, verifier, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgRevokeAttestation(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgRevokeAttestation{}
+
+		var candidates []types.Attestation
+		if err := k.Attestation.Walk(ctx, nil, func(_ collections.Pair[string, string], attestation types.Attestation) (bool, error) {
+			if _, ok := findAccount(ak, accs, attestation.Verifier); ok {
+				candidates = append(candidates, attestation)
+			}
+			return false, nil
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), err.Error()), nil, nil
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no revocable attestation"), nil, nil
+		}
+		attestation := candidates[r.Intn(len(candidates))]
+		verifier, _ := findAccount(ak, accs, attestation.Verifier)
+
+		msg.Creator = attestation.Verifier
+		msg.Denom = attestation.Denom
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, verifier, msg, sdk.NewCoins())
+	}
+}
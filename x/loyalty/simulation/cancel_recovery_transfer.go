@@ -20,13 +20,24 @@ func SimulateMsgCancelRecoveryTransfer(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgCancelRecoveryTransfer{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgCancelRecoveryTransfer{}
+		op, found := randomQueuedRecovery(r, ctx, k, func(types.Recoveryoperation) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no queued recovery operation"), nil, nil
+		}
+		token, err := k.Verifiedtoken.Get(ctx, op.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "recovery token not found"), nil, nil
+		}
+		signer, found := recoverySigner(r, ctx, ak, k, accs, token, "")
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no recovery signer account"), nil, nil
 		}
 
-		// TODO: Handle the CancelRecoveryTransfer simulation
+		msg.Creator = signer.Address.String()
+		msg.Id = op.Id
+		msg.Reason = simtypes.RandStringOfLength(r, 16)
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "CancelRecoveryTransfer simulation not implemented"), nil, nil
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, signer, msg, sdk.NewCoins())
 	}
 }
@@ -3,10 +3,12 @@ package simulation
 import (
 	"math/rand"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"tokenchain/x/loyalty/keeper"
 	"tokenchain/x/loyalty/types"
@@ -20,13 +22,34 @@ func SimulateMsgClaimReward(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgClaimReward{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgClaimReward{}
+		pool := bk.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+		// Only accruals the reward pool can currently cover are claimed.
+		var claimable []types.Rewardaccrual
+		if err := k.Rewardaccrual.Walk(ctx, nil, func(_ string, record types.Rewardaccrual) (bool, error) {
+			if _, ok := findAccount(ak, accs, record.Address); !ok {
+				return false, nil
+			}
+			if isPaused(ctx, k, record.Denom, types.PauseScopeClaim) {
+				return false, nil
+			}
+			if record.Amount > 0 && pool.AmountOf(record.Denom).GTE(sdkmath.NewIntFromUint64(record.Amount)) {
+				claimable = append(claimable, record)
+			}
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+		if len(claimable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no claimable reward accrual"), nil, nil
 		}
 
-		// TODO: Handle the ClaimReward simulation
+		record := claimable[r.Intn(len(claimable))]
+		claimer, _ := findAccount(ak, accs, record.Address)
+		msg.Creator = record.Address
+		msg.Denom = record.Denom
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "ClaimReward simulation not implemented"), nil, nil
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, claimer, msg, sdk.NewCoins())
 	}
 }
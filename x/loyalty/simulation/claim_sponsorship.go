@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgSetClaimSponsorship(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetClaimSponsorship{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			pause, err := k.TokenPause.Get(ctx, token.Denom)
+			return err != nil || !pause.ByAuthority
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifiedtoken owned by a simulation account"), nil, nil
+		}
+
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.PerUserCap = uint64(simtypes.RandIntBetween(r, 1_000, 100_000))
+		msg.DailyBudget = msg.PerUserCap * uint64(simtypes.RandIntBetween(r, 1, 50))
+		msg.Enabled = r.Intn(4) != 0
+		// An owner sponsors the claims of a single token at a time.
+		_ = k.ClaimSponsorship.Walk(ctx, nil, func(denom string, sponsorship types.ClaimSponsorship) (bool, error) {
+			if denom != token.Denom && sponsorship.Enabled && sponsorship.Sponsor == msg.Creator {
+				msg.Enabled = false
+				return true, nil
+			}
+			return false, nil
+		})
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
@@ -2,13 +2,11 @@ package simulation
 
 import (
 	"math/rand"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"tokenchain/x/loyalty/keeper"
 	"tokenchain/x/loyalty/types"
@@ -22,33 +20,24 @@ func SimulateMsgCreateCreatorallowlist(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-		i := r.Int()
-		msg := &types.MsgCreateCreatorallowlist{
-			Creator: simAccount.Address.String(),
-			Address: strconv.Itoa(i),
+		msg := &types.MsgCreateCreatorallowlist{}
+		admin, found := roleHolder(ctx, ak, k, accs, types.RoleAllowlistAdmin)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no allowlist admin account"), nil, nil
 		}
 
-		found, err := k.Creatorallowlist.Has(ctx, msg.Address)
-		if err == nil && found {
+		target, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = admin.Address.String()
+		msg.Address = target.Address.String()
+		msg.Enabled = true
+		msg.MaxTokens = uint64(simtypes.RandIntBetween(r, 1, 10))
+
+		exists, err := k.Creatorallowlist.Has(ctx, msg.Address)
+		if err == nil && exists {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Creatorallowlist already exist"), nil, nil
 		}
 
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, admin, msg, sdk.NewCoins())
 	}
 }
 
@@ -60,54 +49,27 @@ func SimulateMsgUpdateCreatorallowlist(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount       = simtypes.Account{}
-			creatorallowlist = types.Creatorallowlist{}
-			msg              = &types.MsgUpdateCreatorallowlist{}
-			found            = false
-		)
-
-		var allCreatorallowlist []types.Creatorallowlist
-		err := k.Creatorallowlist.Walk(ctx, nil, func(key string, value types.Creatorallowlist) (stop bool, err error) {
-			allCreatorallowlist = append(allCreatorallowlist, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
+		msg := &types.MsgUpdateCreatorallowlist{}
+		admin, found := roleHolder(ctx, ak, k, accs, types.RoleAllowlistAdmin)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no allowlist admin account"), nil, nil
 		}
 
-		for _, obj := range allCreatorallowlist {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				creatorallowlist = obj
-				break
-			}
-		}
+		entry, found := randomCreatorallowlist(r, ctx, k, func(types.Creatorallowlist) bool { return true })
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "creatorallowlist creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Address = creatorallowlist.Address
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "creatorallowlist not found"), nil, nil
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+
+		msg.Creator = admin.Address.String()
+		msg.Address = entry.Address
+		// Mostly keep creators enabled so token creation keeps being exercised.
+		msg.Enabled = r.Intn(4) != 0
+		msg.ExpiresAt = entry.ExpiresAt
+		usage, _ := k.CreatorUsage.Get(ctx, entry.Address)
+		msg.MaxTokens = usage.TokensRegistered + uint64(simtypes.RandIntBetween(r, 1, 10))
+		msg.MaxTotalSupply = entry.MaxTotalSupply
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, admin, msg, sdk.NewCoins())
 	}
 }
 
@@ -119,53 +81,37 @@ func SimulateMsgDeleteCreatorallowlist(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount       = simtypes.Account{}
-			creatorallowlist = types.Creatorallowlist{}
-			msg              = &types.MsgUpdateCreatorallowlist{}
-			found            = false
-		)
-
-		var allCreatorallowlist []types.Creatorallowlist
-		err := k.Creatorallowlist.Walk(ctx, nil, func(key string, value types.Creatorallowlist) (stop bool, err error) {
-			allCreatorallowlist = append(allCreatorallowlist, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
+		msg := &types.MsgDeleteCreatorallowlist{}
+		admin, found := roleHolder(ctx, ak, k, accs, types.RoleAllowlistAdmin)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no allowlist admin account"), nil, nil
 		}
 
-		for _, obj := range allCreatorallowlist {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				creatorallowlist = obj
-				break
-			}
-		}
+		entry, found := randomCreatorallowlist(r, ctx, k, func(types.Creatorallowlist) bool { return true })
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "creatorallowlist creator not found"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "creatorallowlist not found"), nil, nil
 		}
-		msg.Creator = simAccount.Address.String()
-		msg.Address = creatorallowlist.Address
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
+
+		msg.Creator = admin.Address.String()
+		msg.Address = entry.Address
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, admin, msg, sdk.NewCoins())
+	}
+}
+
+// randomCreatorallowlist picks a random allowlist entry accepted by filter.
+func randomCreatorallowlist(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.Creatorallowlist) bool) (types.Creatorallowlist, bool) {
+	var candidates []types.Creatorallowlist
+	if err := k.Creatorallowlist.Walk(ctx, nil, func(_ string, entry types.Creatorallowlist) (bool, error) {
+		if filter(entry) {
+			candidates = append(candidates, entry)
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(candidates) == 0 {
+		return types.Creatorallowlist{}, false
 	}
+	return candidates[r.Intn(len(candidates))], true
 }
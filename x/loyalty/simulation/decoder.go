@@ -0,0 +1,243 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding loyalty type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.HasPrefix(kvA.Key, types.AuthoritiesKey):
+			var authoritiesA, authoritiesB types.Authorities
+			cdc.MustUnmarshal(kvA.Value, &authoritiesA)
+			cdc.MustUnmarshal(kvB.Value, &authoritiesB)
+			return fmt.Sprintf("%v\n%v", authoritiesA, authoritiesB)
+
+		case bytes.HasPrefix(kvA.Key, types.CreatorallowlistKey):
+			var entryA, entryB types.Creatorallowlist
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		case bytes.HasPrefix(kvA.Key, types.VerifiedtokenKey):
+			var tokenA, tokenB types.Verifiedtoken
+			cdc.MustUnmarshal(kvA.Value, &tokenA)
+			cdc.MustUnmarshal(kvB.Value, &tokenB)
+			return fmt.Sprintf("%v\n%v", tokenA, tokenB)
+
+		case bytes.HasPrefix(kvA.Key, types.RewardaccrualKey):
+			var accrualA, accrualB types.Rewardaccrual
+			cdc.MustUnmarshal(kvA.Value, &accrualA)
+			cdc.MustUnmarshal(kvB.Value, &accrualB)
+			return fmt.Sprintf("%v\n%v", accrualA, accrualB)
+
+		case bytes.HasPrefix(kvA.Key, types.MerchantallocationKey):
+			var allocationA, allocationB types.Merchantallocation
+			cdc.MustUnmarshal(kvA.Value, &allocationA)
+			cdc.MustUnmarshal(kvB.Value, &allocationB)
+			return fmt.Sprintf("%v\n%v", allocationA, allocationB)
+
+		case bytes.HasPrefix(kvA.Key, types.RecoveryoperationKey):
+			var opA, opB types.Recoveryoperation
+			cdc.MustUnmarshal(kvA.Value, &opA)
+			cdc.MustUnmarshal(kvB.Value, &opB)
+			return fmt.Sprintf("%v\n%v", opA, opB)
+
+		case bytes.HasPrefix(kvA.Key, types.MerchantKey):
+			var merchantA, merchantB types.Merchant
+			cdc.MustUnmarshal(kvA.Value, &merchantA)
+			cdc.MustUnmarshal(kvB.Value, &merchantB)
+			return fmt.Sprintf("%v\n%v", merchantA, merchantB)
+
+		case bytes.HasPrefix(kvA.Key, types.AttestationKey):
+			var attestationA, attestationB types.Attestation
+			cdc.MustUnmarshal(kvA.Value, &attestationA)
+			cdc.MustUnmarshal(kvB.Value, &attestationB)
+			return fmt.Sprintf("%v\n%v", attestationA, attestationB)
+
+		case bytes.HasPrefix(kvA.Key, types.AttestationHistoryKey):
+			var recordA, recordB types.AttestationRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.HasPrefix(kvA.Key, types.PendingMetadataChangeKey):
+			var changeA, changeB types.PendingMetadataChange
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+
+		case bytes.HasPrefix(kvA.Key, types.MetadataVersionKey):
+			var versionA, versionB types.MetadataVersion
+			cdc.MustUnmarshal(kvA.Value, &versionA)
+			cdc.MustUnmarshal(kvB.Value, &versionB)
+			return fmt.Sprintf("%v\n%v", versionA, versionB)
+
+		case bytes.HasPrefix(kvA.Key, types.MintScheduleKey):
+			var scheduleA, scheduleB types.MintSchedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.HasPrefix(kvA.Key, types.MintRateLimitKey):
+			var limitA, limitB types.MintRateLimit
+			cdc.MustUnmarshal(kvA.Value, &limitA)
+			cdc.MustUnmarshal(kvB.Value, &limitB)
+			return fmt.Sprintf("%v\n%v", limitA, limitB)
+
+		case bytes.HasPrefix(kvA.Key, types.PendingMintRateLimitKey):
+			var pendingA, pendingB types.PendingMintRateLimit
+			cdc.MustUnmarshal(kvA.Value, &pendingA)
+			cdc.MustUnmarshal(kvB.Value, &pendingB)
+			return fmt.Sprintf("%v\n%v", pendingA, pendingB)
+
+		case bytes.HasPrefix(kvA.Key, types.MintUsageKey):
+			var usageA, usageB types.MintUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+
+		case bytes.HasPrefix(kvA.Key, types.TokenPauseKey):
+			var pauseA, pauseB types.TokenPause
+			cdc.MustUnmarshal(kvA.Value, &pauseA)
+			cdc.MustUnmarshal(kvB.Value, &pauseB)
+			return fmt.Sprintf("%v\n%v", pauseA, pauseB)
+
+		case bytes.HasPrefix(kvA.Key, types.MerchantICAKey):
+			var icaA, icaB types.MerchantICA
+			cdc.MustUnmarshal(kvA.Value, &icaA)
+			cdc.MustUnmarshal(kvB.Value, &icaB)
+			return fmt.Sprintf("%v\n%v", icaA, icaB)
+
+		case bytes.HasPrefix(kvA.Key, types.MerchantICAPacketKey):
+			var packetA, packetB types.MerchantICAPacket
+			cdc.MustUnmarshal(kvA.Value, &packetA)
+			cdc.MustUnmarshal(kvB.Value, &packetB)
+			return fmt.Sprintf("%v\n%v", packetA, packetB)
+
+		case bytes.HasPrefix(kvA.Key, types.TreasuryForwardKey):
+			var forwardA, forwardB types.TreasuryForward
+			cdc.MustUnmarshal(kvA.Value, &forwardA)
+			cdc.MustUnmarshal(kvB.Value, &forwardB)
+			return fmt.Sprintf("%v\n%v", forwardA, forwardB)
+
+		case bytes.HasPrefix(kvA.Key, types.IBCRateLimitKey):
+			var limitA, limitB types.IBCRateLimit
+			cdc.MustUnmarshal(kvA.Value, &limitA)
+			cdc.MustUnmarshal(kvB.Value, &limitB)
+			return fmt.Sprintf("%v\n%v", limitA, limitB)
+
+		case bytes.HasPrefix(kvA.Key, types.IBCRateLimitFlowKey):
+			var flowA, flowB types.IBCRateLimitFlow
+			cdc.MustUnmarshal(kvA.Value, &flowA)
+			cdc.MustUnmarshal(kvB.Value, &flowB)
+			return fmt.Sprintf("%v\n%v", flowA, flowB)
+
+		case bytes.HasPrefix(kvA.Key, types.FeeTokenKey):
+			var feeTokenA, feeTokenB types.FeeToken
+			cdc.MustUnmarshal(kvA.Value, &feeTokenA)
+			cdc.MustUnmarshal(kvB.Value, &feeTokenB)
+			return fmt.Sprintf("%v\n%v", feeTokenA, feeTokenB)
+
+		case bytes.HasPrefix(kvA.Key, types.GasPoolKey):
+			var poolA, poolB types.GasPool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+
+		case bytes.HasPrefix(kvA.Key, types.ClaimSponsorshipKey):
+			var sponsorshipA, sponsorshipB types.ClaimSponsorship
+			cdc.MustUnmarshal(kvA.Value, &sponsorshipA)
+			cdc.MustUnmarshal(kvB.Value, &sponsorshipB)
+			return fmt.Sprintf("%v\n%v", sponsorshipA, sponsorshipB)
+
+		case bytes.HasPrefix(kvA.Key, types.ClaimSponsorshipUsageKey):
+			var usageA, usageB types.ClaimSponsorshipUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+
+		case bytes.HasPrefix(kvA.Key, types.RewardLiabilityKey):
+			var liabilityA, liabilityB types.RewardLiability
+			cdc.MustUnmarshal(kvA.Value, &liabilityA)
+			cdc.MustUnmarshal(kvB.Value, &liabilityB)
+			return fmt.Sprintf("%v\n%v", liabilityA, liabilityB)
+
+		case bytes.HasPrefix(kvA.Key, types.LiabilityCheckpointKey):
+			var checkpointA, checkpointB types.LiabilityCheckpoint
+			cdc.MustUnmarshal(kvA.Value, &checkpointA)
+			cdc.MustUnmarshal(kvB.Value, &checkpointB)
+			return fmt.Sprintf("%v\n%v", checkpointA, checkpointB)
+
+		case bytes.HasPrefix(kvA.Key, types.TokenStakerStatsKey):
+			var statsA, statsB types.TokenStakerStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		case bytes.HasPrefix(kvA.Key, types.SponsoredAllowanceKey):
+			var allowanceA, allowanceB types.SponsoredAllowance
+			cdc.MustUnmarshal(kvA.Value, &allowanceA)
+			cdc.MustUnmarshal(kvB.Value, &allowanceB)
+			return fmt.Sprintf("%v\n%v", allowanceA, allowanceB)
+
+		case bytes.HasPrefix(kvA.Key, types.IBCRecorderKey):
+			var recorderA, recorderB types.IBCRecorder
+			cdc.MustUnmarshal(kvA.Value, &recorderA)
+			cdc.MustUnmarshal(kvB.Value, &recorderB)
+			return fmt.Sprintf("%v\n%v", recorderA, recorderB)
+
+		case bytes.HasPrefix(kvA.Key, types.TokenIBCPolicyKey):
+			var policyA, policyB types.TokenIBCPolicy
+			cdc.MustUnmarshal(kvA.Value, &policyA)
+			cdc.MustUnmarshal(kvB.Value, &policyB)
+			return fmt.Sprintf("%v\n%v", policyA, policyB)
+
+		case bytes.HasPrefix(kvA.Key, types.AddressFreezeKey):
+			var freezeA, freezeB types.AddressFreeze
+			cdc.MustUnmarshal(kvA.Value, &freezeA)
+			cdc.MustUnmarshal(kvB.Value, &freezeB)
+			return fmt.Sprintf("%v\n%v", freezeA, freezeB)
+
+		case bytes.HasPrefix(kvA.Key, types.AttestationExpiryKey),
+			bytes.HasPrefix(kvA.Key, types.MetadataChangeQueueKey),
+			bytes.HasPrefix(kvA.Key, types.MintScheduleQueueKey),
+			bytes.HasPrefix(kvA.Key, types.MintRateLimitQueueKey),
+			bytes.HasPrefix(kvA.Key, types.TokenPauseExpiryKey),
+			bytes.HasPrefix(kvA.Key, types.AddressFreezeExpiryKey),
+			bytes.HasPrefix(kvA.Key, types.ClaimSponsorshipRevocationKey),
+			bytes.HasPrefix(kvA.Key, types.VerifiedtokenIssuerIndexKey),
+			bytes.HasPrefix(kvA.Key, types.VerifiedtokenSymbolIndexKey):
+			// Queue and index entries carry everything in the key.
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.RecoveryoperationCountKey),
+			bytes.HasPrefix(kvA.Key, types.MerchantCountKey),
+			bytes.HasPrefix(kvA.Key, types.AttestationHistoryCountKey),
+			bytes.HasPrefix(kvA.Key, types.MintScheduleCountKey),
+			bytes.HasPrefix(kvA.Key, types.IBCEscrowKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.LastDailyRollupDateKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
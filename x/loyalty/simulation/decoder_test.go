@@ -0,0 +1,106 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/testutil/sample"
+	module "tokenchain/x/loyalty/module"
+	"tokenchain/x/loyalty/simulation"
+	"tokenchain/x/loyalty/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	creator := sample.AccAddress()
+	denom := fmt.Sprintf("factory/%s/points", creator)
+	params := types.DefaultParams()
+	authorities := types.NewAuthoritiesForAddress(creator)
+	entry := types.Creatorallowlist{Address: creator, Creator: creator, Enabled: true, MaxTokens: 2}
+	token := types.Verifiedtoken{Creator: creator, Denom: denom, Issuer: creator, Name: "Points", MaxSupply: 1000}
+	accrual := types.Rewardaccrual{Key: creator + "|" + denom, Address: creator, Denom: denom, Amount: 5}
+	allocation := types.Merchantallocation{Key: "2026-01-02|" + denom, Date: "2026-01-02", Denom: denom, BucketCAmount: 7}
+	op := types.Recoveryoperation{Id: 3, Denom: denom, FromAddress: creator, Amount: 9, Status: types.RecoveryStatusQueued}
+	merchant := types.Merchant{Id: 1, Owner: creator, LegalName: "Points Co", PayoutAddress: creator, VerificationTier: types.MerchantTierBasic, Denoms: []string{denom}, Active: true}
+	attestation := types.Attestation{Denom: denom, Verifier: creator, EvidenceUri: "https://verifier.example/points", ExpiresAt: 100}
+	record := types.AttestationRecord{Id: 2, Denom: denom, Verifier: creator, Action: types.AttestationActionAttested, ExpiresAt: 100}
+	change := types.PendingMetadataChange{Denom: denom, Name: "Points+", Proposer: creator, EffectiveAt: 200}
+	version := types.MetadataVersion{Denom: denom, Version: 1, Name: "Points", MaxSupply: 1000}
+	schedule := types.MintSchedule{Id: 5, Denom: denom, Creator: creator, Recipient: creator, TrancheAmount: 10, TotalAmount: 100, Cadence: types.MintCadenceMonthly, Interval: 1, StartDate: "2026-01-31"}
+	limit := types.MintRateLimit{Denom: denom, MaxPerTx: 10, MaxPerRollingDay: 50}
+	pendingLimit := types.PendingMintRateLimit{Limit: types.MintRateLimit{Denom: denom, MaxPerTx: 20}, Proposer: creator, EffectiveAt: 300}
+	usage := types.MintUsage{Denom: denom, RollupDate: "2026-01-02", MintedOnRollupDate: 8, Hourly: []types.MintUsageBucket{{Hour: 1, Amount: 8}}}
+	count := binary.BigEndian.AppendUint64(nil, 4)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.AuthoritiesKey, Value: cdc.MustMarshal(&authorities)},
+			{Key: append(types.CreatorallowlistKey.Bytes(), creator...), Value: cdc.MustMarshal(&entry)},
+			{Key: append(types.VerifiedtokenKey.Bytes(), denom...), Value: cdc.MustMarshal(&token)},
+			{Key: append(types.RewardaccrualKey.Bytes(), accrual.Key...), Value: cdc.MustMarshal(&accrual)},
+			{Key: append(types.MerchantallocationKey.Bytes(), allocation.Key...), Value: cdc.MustMarshal(&allocation)},
+			{Key: append(types.RecoveryoperationKey.Bytes(), binary.BigEndian.AppendUint64(nil, op.Id)...), Value: cdc.MustMarshal(&op)},
+			{Key: types.RecoveryoperationCountKey, Value: count},
+			{Key: append(types.MerchantKey.Bytes(), binary.BigEndian.AppendUint64(nil, merchant.Id)...), Value: cdc.MustMarshal(&merchant)},
+			{Key: types.MerchantCountKey, Value: count},
+			{Key: append(types.AttestationKey.Bytes(), denom...), Value: cdc.MustMarshal(&attestation)},
+			{Key: append(types.AttestationHistoryKey.Bytes(), denom...), Value: cdc.MustMarshal(&record)},
+			{Key: types.AttestationHistoryCountKey, Value: count},
+			{Key: append(types.PendingMetadataChangeKey.Bytes(), denom...), Value: cdc.MustMarshal(&change)},
+			{Key: append(types.MetadataVersionKey.Bytes(), denom...), Value: cdc.MustMarshal(&version)},
+			{Key: append(types.MintScheduleKey.Bytes(), denom...), Value: cdc.MustMarshal(&schedule)},
+			{Key: types.MintScheduleCountKey, Value: count},
+			{Key: append(types.MintRateLimitKey.Bytes(), denom...), Value: cdc.MustMarshal(&limit)},
+			{Key: append(types.PendingMintRateLimitKey.Bytes(), denom...), Value: cdc.MustMarshal(&pendingLimit)},
+			{Key: append(types.MintUsageKey.Bytes(), denom...), Value: cdc.MustMarshal(&usage)},
+			{Key: types.LastDailyRollupDateKey, Value: []byte("2026-01-02")},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+		panics      bool
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params), false},
+		{"Authorities", fmt.Sprintf("%v\n%v", authorities, authorities), false},
+		{"Creatorallowlist", fmt.Sprintf("%v\n%v", entry, entry), false},
+		{"Verifiedtoken", fmt.Sprintf("%v\n%v", token, token), false},
+		{"Rewardaccrual", fmt.Sprintf("%v\n%v", accrual, accrual), false},
+		{"Merchantallocation", fmt.Sprintf("%v\n%v", allocation, allocation), false},
+		{"Recoveryoperation", fmt.Sprintf("%v\n%v", op, op), false},
+		{"RecoveryoperationCount", "4\n4", false},
+		{"Merchant", fmt.Sprintf("%v\n%v", merchant, merchant), false},
+		{"MerchantCount", "4\n4", false},
+		{"Attestation", fmt.Sprintf("%v\n%v", attestation, attestation), false},
+		{"AttestationHistory", fmt.Sprintf("%v\n%v", record, record), false},
+		{"AttestationHistoryCount", "4\n4", false},
+		{"PendingMetadataChange", fmt.Sprintf("%v\n%v", change, change), false},
+		{"MetadataVersion", fmt.Sprintf("%v\n%v", version, version), false},
+		{"MintSchedule", fmt.Sprintf("%v\n%v", schedule, schedule), false},
+		{"MintScheduleCount", "4\n4", false},
+		{"MintRateLimit", fmt.Sprintf("%v\n%v", limit, limit), false},
+		{"PendingMintRateLimit", fmt.Sprintf("%v\n%v", pendingLimit, pendingLimit), false},
+		{"MintUsage", fmt.Sprintf("%v\n%v", usage, usage), false},
+		{"LastDailyRollupDate", "2026-01-02\n2026-01-02", false},
+		{"other", "", true},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panics {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,13 +21,34 @@ func SimulateMsgExecuteRecoveryTransfer(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgExecuteRecoveryTransfer{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgExecuteRecoveryTransfer{}
+		now := ctx.BlockTime().Unix()
+		op, found := randomQueuedRecovery(r, ctx, k, func(op types.Recoveryoperation) bool {
+			if now < 0 || uint64(now) < op.ExecuteAfter {
+				return false
+			}
+			from, ok := findAccount(ak, accs, op.FromAddress)
+			if !ok {
+				return false
+			}
+			return bk.SpendableCoins(ctx, from.Address).AmountOf(op.Denom).GTE(sdkmath.NewIntFromUint64(op.Amount))
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no executable recovery operation"), nil, nil
+		}
+		token, err := k.Verifiedtoken.Get(ctx, op.Denom)
+		if err != nil || !token.SeizureOptIn {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "recovery token no longer recovery-enabled"), nil, nil
+		}
+		// The source account never signs, so transaction fees cannot eat into the recovered balance.
+		signer, found := recoverySigner(r, ctx, ak, k, accs, token, op.FromAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no recovery signer account"), nil, nil
 		}
 
-		// TODO: Handle the ExecuteRecoveryTransfer simulation
+		msg.Creator = signer.Address.String()
+		msg.Id = op.Id
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "ExecuteRecoveryTransfer simulation not implemented"), nil, nil
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, signer, msg, sdk.NewCoins())
 	}
 }
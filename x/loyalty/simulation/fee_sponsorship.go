@@ -0,0 +1,81 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgFundGasPool(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgFundGasPool{}
+		// Only the token owner may fund its pool.
+		funder, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			if pause, err := k.TokenPause.Get(ctx, token.Denom); err == nil && pause.ByAuthority {
+				return false
+			}
+			return bk.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(token.Creator)).AmountOf(sdk.DefaultBondDenom).IsPositive()
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no owned token with a staking balance"), nil, nil
+		}
+		balance := bk.SpendableCoins(ctx, funder.Address).AmountOf(sdk.DefaultBondDenom)
+
+		// Keep most of the balance for fees and staking.
+		amount, err := simtypes.RandPositiveInt(r, balance.QuoRaw(10).AddRaw(1))
+		if err != nil || !amount.IsUint64() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate fund amount"), nil, nil
+		}
+		msg.Creator = funder.Address.String()
+		msg.Denom = token.Denom
+		msg.Amount = amount.Uint64()
+
+		spent := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(msg.Amount)))
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, funder, msg, spent)
+	}
+}
+
+func SimulateMsgWithdrawGasPool(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgWithdrawGasPool{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			if pause, err := k.TokenPause.Get(ctx, token.Denom); err == nil && pause.ByAuthority {
+				return false
+			}
+			pool, err := k.GasPool.Get(ctx, token.Denom)
+			return err == nil && pool.Balance > 0
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no funded gas pool"), nil, nil
+		}
+		pool, err := k.GasPool.Get(ctx, token.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read gas pool"), nil, nil
+		}
+
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.Amount = uint64(simtypes.RandIntBetween(r, 1, int(min(pool.Balance, 1<<30))+1))
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgFundRewardPool(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgFundRewardPool{}
+		token, found := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+			return token.MintedSupply > 0 && !isPaused(ctx, k, token.Denom, types.PauseScopeClaim)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no minted verifiedtoken"), nil, nil
+		}
+		funder, balance, found := randomHolder(r, ctx, bk, accs, token.Denom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no holder of verifiedtoken"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance.Amount)
+		if err != nil || !amount.IsUint64() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate fund amount"), nil, nil
+		}
+		msg.Creator = funder.Address.String()
+		msg.Denom = token.Denom
+		msg.Amount = amount.Uint64()
+
+		spent := sdk.NewCoins(sdk.NewCoin(token.Denom, sdkmath.NewIntFromUint64(msg.Amount)))
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, funder, msg, spent)
+	}
+}
@@ -0,0 +1,115 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// maxGenesisCreators bounds how many simulation accounts start with a verified token.
+const maxGenesisCreators = 5
+
+// RandomizedGenState generates a loyalty genesis state wired to the simulation accounts:
+// one operator holds every scoped role, a handful of accounts are allowlisted creators
+// owning a verified token each (attested by the operator for a random window), and
+// every other token opts into recovery through a group policy taken from the x/group
+// simulation genesis.
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+	accs := simState.Accounts
+
+	params := types.DefaultParams()
+	params.NetworkMode = types.NetworkModeLocalnet
+	params.CreationMode = types.CreationModeAllowlisted
+
+	operator, _ := simtypes.RandomAcc(r, accs)
+	operatorAddr := operator.Address.String()
+	recoveryPolicy := genesisRecoveryPolicy(simState)
+
+	var (
+		allowlist    []types.Creatorallowlist
+		usage        []types.CreatorUsage
+		tokens       []types.Verifiedtoken
+		attestations []types.Attestation
+	)
+	perm := r.Perm(len(accs))
+	for i, idx := range perm[:min(len(perm), maxGenesisCreators)] {
+		creator := accs[idx].Address.String()
+		token := types.Verifiedtoken{
+			Creator:                      creator,
+			Denom:                        fmt.Sprintf("factory/%s/sim%d", creator, i),
+			Issuer:                       creator,
+			Name:                         fmt.Sprintf("Simulation Points %d", i),
+			Symbol:                       fmt.Sprintf("SIM%d", i),
+			Description:                  "simulation loyalty token",
+			MaxSupply:                    uint64(simtypes.RandIntBetween(r, 1_000_000, 1_000_000_000)),
+			Verified:                     true,
+			MerchantIncentiveStakersBps:  types.DefaultMerchantIncentiveStakersBps,
+			MerchantIncentiveTreasuryBps: types.DefaultMerchantIncentiveTreasuryBps,
+			Decimals:                     randomDecimals(r),
+		}
+		if recoveryPolicy != "" && i%2 == 0 {
+			token.SeizureOptIn = true
+			token.RecoveryGroupPolicy = recoveryPolicy
+			token.RecoveryTimelockHours = params.MinimumRecoveryTimelockHours()
+		}
+		tokens = append(tokens, token)
+		attestations = append(attestations, types.Attestation{
+			Denom:       token.Denom,
+			Verifier:    operatorAddr,
+			EvidenceUri: "https://verifier.example/" + token.Symbol,
+			ExpiresAt:   uint64(simState.GenTimestamp.Unix()) + uint64(simtypes.RandIntBetween(r, 60, 7*24*3600)),
+			AttestedAt:  uint64(simState.GenTimestamp.Unix()),
+		})
+
+		allowlist = append(allowlist, types.Creatorallowlist{
+			Address:   creator,
+			Enabled:   true,
+			Creator:   operatorAddr,
+			MaxTokens: uint64(simtypes.RandIntBetween(r, 2, 10)),
+		})
+		usage = append(usage, types.CreatorUsage{
+			Address:               creator,
+			TokensRegistered:      1,
+			TotalSupplyRegistered: token.MaxSupply,
+		})
+	}
+
+	loyaltyGenesis := types.GenesisState{
+		Params:                 params,
+		CreatorallowlistMap:    allowlist,
+		CreatorUsageList:       usage,
+		VerifiedtokenMap:       tokens,
+		RewardaccrualMap:       []types.Rewardaccrual{},
+		MerchantallocationMap:  []types.Merchantallocation{},
+		RecoveryoperationList:  []types.Recoveryoperation{},
+		RecoveryoperationCount: 0,
+		Authorities:            types.NewAuthoritiesForAddress(operatorAddr),
+		AttestationList:        attestations,
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&loyaltyGenesis)
+}
+
+// genesisRecoveryPolicy returns a group policy address from the x/group simulation
+// genesis, which is generated first because modules are simulated in name order.
+// The x/group simulator uses simulation accounts as policy addresses, so the policy
+// can also sign recovery messages itself.
+func genesisRecoveryPolicy(simState *module.SimulationState) string {
+	groupStateBz, ok := simState.GenState[group.ModuleName]
+	if !ok {
+		return ""
+	}
+
+	var groupState group.GenesisState
+	simState.Cdc.MustUnmarshalJSON(groupStateBz, &groupState)
+	for _, policy := range groupState.GroupPolicies {
+		if policy != nil && policy.Address != "" {
+			return policy.Address
+		}
+	}
+	return ""
+}
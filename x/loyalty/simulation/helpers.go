@@ -0,0 +1,141 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+// roleHolder returns a simulation account that holds role, if any.
+func roleHolder(ctx sdk.Context, ak types.AuthKeeper, k keeper.Keeper, accs []simtypes.Account, role types.Role) (simtypes.Account, bool) {
+	authorities, err := k.Authorities.Get(ctx)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	for _, holder := range authorities.Holders(role) {
+		if acc, ok := findAccount(ak, accs, holder); ok {
+			return acc, true
+		}
+	}
+	return simtypes.Account{}, false
+}
+
+// findAccount resolves a bech32 address to a simulation account.
+func findAccount(ak types.AuthKeeper, accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	bz, err := ak.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, sdk.AccAddress(bz))
+}
+
+// randomToken picks a random verified token accepted by filter.
+func randomToken(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.Verifiedtoken) bool) (types.Verifiedtoken, bool) {
+	var candidates []types.Verifiedtoken
+	if err := k.Verifiedtoken.Walk(ctx, nil, func(_ string, token types.Verifiedtoken) (bool, error) {
+		if filter(token) {
+			candidates = append(candidates, token)
+		}
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(candidates) == 0 {
+		return types.Verifiedtoken{}, false
+	}
+	return candidates[r.Intn(len(candidates))], true
+}
+
+// randomOwnedToken picks a random verified token accepted by filter whose owner is a
+// simulation account, returning that account as the signer.
+func randomOwnedToken(
+	r *rand.Rand,
+	ctx sdk.Context,
+	ak types.AuthKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	filter func(types.Verifiedtoken) bool,
+) (simtypes.Account, types.Verifiedtoken, bool) {
+	token, found := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+		if _, ok := findAccount(ak, accs, token.Creator); !ok {
+			return false
+		}
+		return filter(token)
+	})
+	if !found {
+		return simtypes.Account{}, types.Verifiedtoken{}, false
+	}
+	owner, _ := findAccount(ak, accs, token.Creator)
+	return owner, token, true
+}
+
+// randomHolder picks a simulation account holding a spendable balance of denom.
+func randomHolder(r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, accs []simtypes.Account, denom string) (simtypes.Account, sdk.Coin, bool) {
+	var holders []simtypes.Account
+	for _, acc := range accs {
+		if bk.SpendableCoins(ctx, acc.Address).AmountOf(denom).IsPositive() {
+			holders = append(holders, acc)
+		}
+	}
+	if len(holders) == 0 {
+		return simtypes.Account{}, sdk.Coin{}, false
+	}
+	holder := holders[r.Intn(len(holders))]
+	return holder, sdk.NewCoin(denom, bk.SpendableCoins(ctx, holder.Address).AmountOf(denom)), true
+}
+
+func genAndDeliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	txGen client.TxConfig,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpent,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// isPaused reports whether scope of denom is paused at the current block time.
+func isPaused(ctx sdk.Context, k keeper.Keeper, denom, scope string) bool {
+	pause, err := k.TokenPause.Get(ctx, denom)
+	if err != nil {
+		return false
+	}
+	return pause.ActiveAt(uint64(ctx.BlockTime().Unix())) && pause.Covers(scope)
+}
+
+// mintAvailable returns how much of denom can be minted right now under its cap,
+// mint rate limit and any mint pause.
+func mintAvailable(ctx sdk.Context, k keeper.Keeper, denom string) uint64 {
+	if isPaused(ctx, k, denom, types.PauseScopeMint) {
+		return 0
+	}
+	res, err := keeper.NewQueryServerImpl(k).MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom})
+	if err != nil {
+		return 0
+	}
+	return res.Available
+}
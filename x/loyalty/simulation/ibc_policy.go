@@ -0,0 +1,80 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgSetTokenIBCPolicy(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetTokenIBCPolicy{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			return !token.AdminRenounced
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifiedtoken with an active admin"), nil, nil
+		}
+
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		switch r.Intn(3) {
+		case 0:
+			msg.Mode = types.IBCPolicyBlocked
+		case 1:
+			msg.Mode = types.IBCPolicyAllowlisted
+			msg.AllowedChannels = []string{fmt.Sprintf("channel-%d", r.Intn(4))}
+		default:
+			// Recovery-enabled tokens cannot be opened to every channel.
+			if token.SeizureOptIn {
+				msg.Mode = types.IBCPolicyBlocked
+			} else {
+				msg.Mode = types.IBCPolicyAllowed
+			}
+		}
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgSetIBCRecorder(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetIBCRecorder{}
+		recorder, found := roleHolder(ctx, ak, k, accs, types.RoleAccrualRecorder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accrual recorder account"), nil, nil
+		}
+
+		msg.Creator = recorder.Address.String()
+		msg.ChannelId = fmt.Sprintf("channel-%d", r.Intn(4))
+		msg.Sender = fmt.Sprintf("partner%d", r.Intn(8))
+		has, err := k.IBCRecorder.Has(ctx, collections.Join(msg.ChannelId, msg.Sender))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read ibc recorders"), nil, err
+		}
+		// Removing a recorder that is not there fails, so only add in that case.
+		msg.Enabled = !has || r.Intn(2) == 0
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, recorder, msg, sdk.NewCoins())
+	}
+}
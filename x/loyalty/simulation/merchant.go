@@ -0,0 +1,130 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgRegisterMerchant(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		payout, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgRegisterMerchant{
+			Creator:       owner.Address.String(),
+			LegalName:     simtypes.RandStringOfLength(r, 12),
+			PayoutAddress: payout.Address.String(),
+			ContactUri:    "https://" + simtypes.RandStringOfLength(r, 8) + ".example",
+			LogoHash:      randomLogoHash(r),
+		}
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgUpdateMerchant(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUpdateMerchant{}
+		owner, merchant, found := randomOwnedMerchant(r, ctx, ak, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no active merchant owner"), nil, nil
+		}
+
+		msg.Creator = owner.Address.String()
+		msg.Id = merchant.Id
+		msg.LegalName = merchant.LegalName
+		msg.PayoutAddress = merchant.PayoutAddress
+		msg.ContactUri = "https://" + simtypes.RandStringOfLength(r, 8) + ".example"
+		msg.LogoHash = randomLogoHash(r)
+
+		// Allowlist admins occasionally review the merchant's verification tier.
+		if admin, ok := roleHolder(ctx, ak, k, accs, types.RoleAllowlistAdmin); ok && r.Intn(2) == 0 {
+			tiers := []string{types.MerchantTierUnverified, types.MerchantTierBasic, types.MerchantTierEnhanced}
+			msg.Creator = admin.Address.String()
+			msg.VerificationTier = tiers[r.Intn(len(tiers))]
+			return genAndDeliverTx(r, app, ctx, ak, bk, txGen, admin, msg, sdk.NewCoins())
+		}
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgDeactivateMerchant(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgDeactivateMerchant{}
+		owner, merchant, found := randomOwnedMerchant(r, ctx, ak, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no active merchant owner"), nil, nil
+		}
+
+		msg.Creator = owner.Address.String()
+		msg.Id = merchant.Id
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
+
+// randomOwnedMerchant picks a random active merchant whose owner is a simulation account.
+func randomOwnedMerchant(r *rand.Rand, ctx sdk.Context, ak types.AuthKeeper, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, types.Merchant, bool) {
+	var candidates []types.Merchant
+	if err := k.Merchant.Walk(ctx, nil, func(_ uint64, merchant types.Merchant) (bool, error) {
+		if _, ok := findAccount(ak, accs, merchant.Owner); ok && merchant.Active {
+			candidates = append(candidates, merchant)
+		}
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(candidates) == 0 {
+		return simtypes.Account{}, types.Merchant{}, false
+	}
+	merchant := candidates[r.Intn(len(candidates))]
+	owner, _ := findAccount(ak, accs, merchant.Owner)
+	return owner, merchant, true
+}
+
+// activeMerchantOf returns the id of an active merchant owned by owner, or zero.
+func activeMerchantOf(ctx sdk.Context, k keeper.Keeper, owner string) uint64 {
+	var id uint64
+	if err := k.Merchant.Walk(ctx, nil, func(_ uint64, merchant types.Merchant) (bool, error) {
+		if merchant.Active && merchant.Owner == owner {
+			id = merchant.Id
+			return true, nil
+		}
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func randomLogoHash(r *rand.Rand) string {
+	bz := make([]byte, 32)
+	r.Read(bz)
+	return hex.EncodeToString(bz)
+}
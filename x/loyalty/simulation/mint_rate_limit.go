@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgSetMintRateLimit(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetMintRateLimit{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			return !token.AdminRenounced
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifiedtoken with an active admin"), nil, nil
+		}
+
+		// Leave each limit unset half of the time so minting keeps going.
+		randomLimit := func() uint64 {
+			if r.Intn(2) == 0 {
+				return 0
+			}
+			return uint64(simtypes.RandIntBetween(r, 1_000, 1_000_000))
+		}
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.MaxPerTx = randomLimit()
+		msg.MaxPerRollingDay = randomLimit()
+		msg.MaxPerRollupDate = randomLimit()
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
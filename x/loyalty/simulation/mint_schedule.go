@@ -0,0 +1,91 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgCreateMintSchedule(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCreateMintSchedule{}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "params not found"), nil, nil
+		}
+		location, err := time.LoadLocation(params.DailyRollupTimezone)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "invalid rollup timezone"), nil, nil
+		}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			return !token.AdminRenounced && token.MintedSupply+token.ScheduledSupply < token.MaxSupply &&
+				!isPaused(ctx, k, token.Denom, types.PauseScopeMint)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no mintable verifiedtoken"), nil, nil
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		remaining := token.MaxSupply - token.MintedSupply - token.ScheduledSupply
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.Recipient = recipient.Address.String()
+		msg.TotalAmount = uint64(simtypes.RandIntBetween(r, 1, int(min(remaining, 1_000_000))+1))
+		msg.TrancheAmount = max(msg.TotalAmount/uint64(simtypes.RandIntBetween(r, 1, 13)), 1)
+		if limit, err := k.MintRateLimit.Get(ctx, token.Denom); err == nil && limit.MaxSingleMint() > 0 {
+			msg.TrancheAmount = min(msg.TrancheAmount, limit.MaxSingleMint())
+		}
+		msg.Cadence = []string{types.MintCadenceDaily, types.MintCadenceWeekly, types.MintCadenceMonthly}[r.Intn(3)]
+		msg.Interval = uint32(simtypes.RandIntBetween(r, 1, 3))
+		msg.StartDate = ctx.BlockTime().In(location).AddDate(0, 0, r.Intn(3)).Format(types.MintScheduleDateLayout)
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgCancelMintSchedule(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCancelMintSchedule{}
+
+		var candidates []types.MintSchedule
+		if err := k.MintSchedule.Walk(ctx, nil, func(_ collections.Pair[string, uint64], schedule types.MintSchedule) (bool, error) {
+			if _, ok := findAccount(ak, accs, schedule.Creator); ok {
+				candidates = append(candidates, schedule)
+			}
+			return false, nil
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), err.Error()), nil, nil
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no cancellable mint schedule"), nil, nil
+		}
+		schedule := candidates[r.Intn(len(candidates))]
+		creator, _ := findAccount(ak, accs, schedule.Creator)
+
+		msg.Creator = schedule.Creator
+		msg.Denom = schedule.Denom
+		msg.Id = schedule.Id
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, creator, msg, sdk.NewCoins())
+	}
+}
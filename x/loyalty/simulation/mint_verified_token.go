@@ -20,13 +20,59 @@ func SimulateMsgMintVerifiedToken(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgMintVerifiedToken{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgMintVerifiedToken{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			return !token.AdminRenounced && token.MintedSupply+token.ScheduledSupply < token.MaxSupply
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no mintable verifiedtoken"), nil, nil
 		}
 
-		// TODO: Handle the MintVerifiedToken simulation
+		remaining := mintAvailable(ctx, k, token.Denom)
+		if remaining == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "mint rate limit reached"), nil, nil
+		}
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.Recipient = recipient.Address.String()
+		msg.Amount = uint64(simtypes.RandIntBetween(r, 1, int(min(remaining, 1_000_000))+1))
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgMintVerifiedTokenBatch(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgMintVerifiedTokenBatch{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			return !token.AdminRenounced && token.MintedSupply+token.ScheduledSupply < token.MaxSupply
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no mintable verifiedtoken"), nil, nil
+		}
+
+		remaining := mintAvailable(ctx, k, token.Denom)
+		if remaining == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "mint rate limit reached"), nil, nil
+		}
+		count := min(uint64(simtypes.RandIntBetween(r, 1, 6)), uint64(len(accs)), remaining)
+		perRecipient := min(remaining/count, 100_000)
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		for _, i := range r.Perm(len(accs))[:count] {
+			msg.Recipients = append(msg.Recipients, types.MintRecipient{
+				Recipient: accs[i].Address.String(),
+				Amount:    uint64(simtypes.RandIntBetween(r, 1, int(perRecipient)+1)),
+			})
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "MintVerifiedToken simulation not implemented"), nil, nil
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
 	}
 }
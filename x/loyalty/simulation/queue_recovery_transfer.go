@@ -20,13 +20,84 @@ func SimulateMsgQueueRecoveryTransfer(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgQueueRecoveryTransfer{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgQueueRecoveryTransfer{}
+		token, found := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+			return token.SeizureOptIn && token.RecoveryGroupPolicy != "" && token.MintedSupply > 0
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no minted recovery-enabled verifiedtoken"), nil, nil
 		}
+		from, balance, found := randomHolder(r, ctx, bk, accs, token.Denom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no holder of verifiedtoken"), nil, nil
+		}
+		signer, found := recoverySigner(r, ctx, ak, k, accs, token, "")
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no recovery signer account"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+		if to.Address.Equals(from.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "recovery source and destination are equal"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance.Amount)
+		if err != nil || !amount.IsUint64() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate recovery amount"), nil, nil
+		}
+		msg.Creator = signer.Address.String()
+		msg.Denom = token.Denom
+		msg.FromAddress = from.Address.String()
+		msg.ToAddress = to.Address.String()
+		msg.Amount = amount.Uint64()
 
-		// TODO: Handle the QueueRecoveryTransfer simulation
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, signer, msg, sdk.NewCoins())
+	}
+}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "QueueRecoveryTransfer simulation not implemented"), nil, nil
+// recoverySigner picks an account allowed to act on token's recovery operations: its
+// recovery group policy or a recovery overseer. An account matching exclude is skipped.
+func recoverySigner(
+	r *rand.Rand,
+	ctx sdk.Context,
+	ak types.AuthKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	token types.Verifiedtoken,
+	exclude string,
+) (simtypes.Account, bool) {
+	var candidates []simtypes.Account
+	if policy, ok := findAccount(ak, accs, token.RecoveryGroupPolicy); ok {
+		candidates = append(candidates, policy)
+	}
+	if overseer, ok := roleHolder(ctx, ak, k, accs, types.RoleRecoveryOverseer); ok {
+		candidates = append(candidates, overseer)
+	}
+
+	var eligible []simtypes.Account
+	for _, acc := range candidates {
+		if acc.Address.String() != exclude {
+			eligible = append(eligible, acc)
+		}
+	}
+	if len(eligible) == 0 {
+		return simtypes.Account{}, false
+	}
+	return eligible[r.Intn(len(eligible))], true
+}
+
+// randomQueuedRecovery picks a random queued recovery operation accepted by filter.
+func randomQueuedRecovery(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.Recoveryoperation) bool) (types.Recoveryoperation, bool) {
+	var queued []types.Recoveryoperation
+	if err := k.Recoveryoperation.Walk(ctx, nil, func(_ uint64, op types.Recoveryoperation) (bool, error) {
+		if op.Status == types.RecoveryStatusQueued && filter(op) {
+			queued = append(queued, op)
+		}
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(queued) == 0 {
+		return types.Recoveryoperation{}, false
 	}
+	return queued[r.Intn(len(queued))], true
 }
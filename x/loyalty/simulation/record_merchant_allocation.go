@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgRecordMerchantAllocation(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgRecordMerchantAllocation{}
+		recorder, found := roleHolder(ctx, ak, k, accs, types.RoleAccrualRecorder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accrual recorder account"), nil, nil
+		}
+		token, found := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+			return !isPaused(ctx, k, token.Denom, types.PauseScopeClaim)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifiedtoken"), nil, nil
+		}
+
+		msg.Creator = recorder.Address.String()
+		msg.Denom = token.Denom
+		msg.ActivityScore = uint64(simtypes.RandIntBetween(r, 1, 10_000))
+		msg.BucketCAmount = uint64(simtypes.RandIntBetween(r, 1, 1_000_000))
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, recorder, msg, sdk.NewCoins())
+	}
+}
@@ -20,13 +20,24 @@ func SimulateMsgRecordRewardAccrual(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgRecordRewardAccrual{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgRecordRewardAccrual{}
+		recorder, found := roleHolder(ctx, ak, k, accs, types.RoleAccrualRecorder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accrual recorder account"), nil, nil
+		}
+		token, found := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+			return !isPaused(ctx, k, token.Denom, types.PauseScopeClaim)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifiedtoken"), nil, nil
 		}
 
-		// TODO: Handle the RecordRewardAccrual simulation
+		account, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = recorder.Address.String()
+		msg.Address = account.Address.String()
+		msg.Denom = token.Denom
+		msg.Amount = uint64(simtypes.RandIntBetween(r, 1, 100_000))
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "RecordRewardAccrual simulation not implemented"), nil, nil
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, recorder, msg, sdk.NewCoins())
 	}
 }
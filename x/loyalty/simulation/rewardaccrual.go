@@ -1,14 +1,13 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"tokenchain/x/loyalty/keeper"
 	"tokenchain/x/loyalty/types"
@@ -22,33 +21,32 @@ func SimulateMsgCreateRewardaccrual(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-		i := r.Int()
-		msg := &types.MsgCreateRewardaccrual{
-			Creator: simAccount.Address.String(),
-			Key:     strconv.Itoa(i),
+		msg := &types.MsgCreateRewardaccrual{}
+		recorder, found := roleHolder(ctx, ak, k, accs, types.RoleAccrualRecorder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accrual recorder account"), nil, nil
+		}
+		token, found := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+			return !isPaused(ctx, k, token.Denom, types.PauseScopeClaim)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no verifiedtoken"), nil, nil
 		}
 
-		found, err := k.Rewardaccrual.Has(ctx, msg.Key)
-		if err == nil && found {
+		account, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = recorder.Address.String()
+		msg.Address = account.Address.String()
+		msg.Denom = token.Denom
+		msg.Key = fmt.Sprintf("%s|%s", msg.Address, msg.Denom)
+		msg.Amount = uint64(simtypes.RandIntBetween(r, 1, 100_000))
+		msg.LastRollupDate = ctx.BlockTime().UTC().Format("2006-01-02")
+
+		exists, err := k.Rewardaccrual.Has(ctx, msg.Key)
+		if err == nil && exists {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Rewardaccrual already exist"), nil, nil
 		}
 
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, recorder, msg, sdk.NewCoins())
 	}
 }
 
@@ -60,54 +58,24 @@ func SimulateMsgUpdateRewardaccrual(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount    = simtypes.Account{}
-			rewardaccrual = types.Rewardaccrual{}
-			msg           = &types.MsgUpdateRewardaccrual{}
-			found         = false
-		)
-
-		var allRewardaccrual []types.Rewardaccrual
-		err := k.Rewardaccrual.Walk(ctx, nil, func(key string, value types.Rewardaccrual) (stop bool, err error) {
-			allRewardaccrual = append(allRewardaccrual, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		for _, obj := range allRewardaccrual {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				rewardaccrual = obj
-				break
-			}
+		msg := &types.MsgUpdateRewardaccrual{}
+		recorder, found := roleHolder(ctx, ak, k, accs, types.RoleAccrualRecorder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accrual recorder account"), nil, nil
 		}
+		record, found := randomRewardaccrual(r, ctx, k)
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "rewardaccrual creator not found"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "rewardaccrual not found"), nil, nil
 		}
-		msg.Creator = simAccount.Address.String()
-		msg.Key = rewardaccrual.Key
 
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		msg.Creator = recorder.Address.String()
+		msg.Key = record.Key
+		msg.Address = record.Address
+		msg.Denom = record.Denom
+		msg.Amount = uint64(simtypes.RandIntBetween(r, 1, 100_000))
+		msg.LastRollupDate = ctx.BlockTime().UTC().Format("2006-01-02")
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, recorder, msg, sdk.NewCoins())
 	}
 }
 
@@ -119,53 +87,37 @@ func SimulateMsgDeleteRewardaccrual(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount    = simtypes.Account{}
-			rewardaccrual = types.Rewardaccrual{}
-			msg           = &types.MsgUpdateRewardaccrual{}
-			found         = false
-		)
-
-		var allRewardaccrual []types.Rewardaccrual
-		err := k.Rewardaccrual.Walk(ctx, nil, func(key string, value types.Rewardaccrual) (stop bool, err error) {
-			allRewardaccrual = append(allRewardaccrual, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		for _, obj := range allRewardaccrual {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				rewardaccrual = obj
-				break
-			}
+		msg := &types.MsgDeleteRewardaccrual{}
+		recorder, found := roleHolder(ctx, ak, k, accs, types.RoleAccrualRecorder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accrual recorder account"), nil, nil
 		}
+		record, found := randomRewardaccrual(r, ctx, k)
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "rewardaccrual creator not found"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "rewardaccrual not found"), nil, nil
 		}
-		msg.Creator = simAccount.Address.String()
-		msg.Key = rewardaccrual.Key
 
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
+		msg.Creator = recorder.Address.String()
+		msg.Key = record.Key
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, recorder, msg, sdk.NewCoins())
+	}
+}
+
+// randomRewardaccrual picks a random stored reward accrual whose claims are not paused.
+func randomRewardaccrual(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.Rewardaccrual, bool) {
+	var records []types.Rewardaccrual
+	if err := k.Rewardaccrual.Walk(ctx, nil, func(_ string, record types.Rewardaccrual) (bool, error) {
+		if isPaused(ctx, k, record.Denom, types.PauseScopeClaim) {
+			return false, nil
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		records = append(records, record)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(records) == 0 {
+		return types.Rewardaccrual{}, false
 	}
+	return records[r.Intn(len(records))], true
 }
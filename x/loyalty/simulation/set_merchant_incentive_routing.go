@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgSetMerchantIncentiveRouting(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetMerchantIncentiveRouting{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(types.Verifiedtoken) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "verifiedtoken owner not found"), nil, nil
+		}
+
+		stakersBps := uint64(r.Intn(int(types.TotalBPS) + 1))
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.MerchantIncentiveStakersBps = stakersBps
+		msg.MerchantIncentiveTreasuryBps = types.TotalBPS - stakersBps
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
@@ -0,0 +1,78 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"tokenchain/x/loyalty/keeper"
+	"tokenchain/x/loyalty/types"
+)
+
+func SimulateMsgPauseToken(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgPauseToken{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			if token.AdminRenounced {
+				return false
+			}
+			pause, err := k.TokenPause.Get(ctx, token.Denom)
+			return err != nil || !pause.ByAuthority
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no pausable verifiedtoken"), nil, nil
+		}
+
+		// Transfer pauses are left out: bank send operations do not know about them.
+		// Pauses expire within a few hours so the paused flows pick up again.
+		scopes := []string{types.PauseScopeMint, types.PauseScopeClaim}
+		switch r.Intn(3) {
+		case 0:
+			scopes = scopes[:1]
+		case 1:
+			scopes = scopes[1:]
+		}
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.Scopes = scopes
+		msg.Reason = simtypes.RandStringOfLength(r, 12)
+		msg.ExpiresAt = uint64(ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 6)) * time.Hour).Unix())
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
+
+func SimulateMsgUnpauseToken(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUnpauseToken{}
+		now := uint64(ctx.BlockTime().Unix())
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			pause, err := k.TokenPause.Get(ctx, token.Denom)
+			return err == nil && pause.ActiveAt(now) && !pause.ByAuthority
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no owner-paused verifiedtoken"), nil, nil
+		}
+
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
+	}
+}
@@ -1,14 +1,14 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
-	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"tokenchain/x/loyalty/keeper"
 	"tokenchain/x/loyalty/types"
@@ -22,33 +22,66 @@ func SimulateMsgCreateVerifiedtoken(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgCreateVerifiedtoken{}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "params not found"), nil, nil
+		}
+
+		maxSupply := uint64(simtypes.RandIntBetween(r, 1_000, 1_000_000_000))
+		now := ctx.BlockTime().Unix()
+		entry, found := randomCreatorallowlist(r, ctx, k, func(entry types.Creatorallowlist) bool {
+			if _, ok := findAccount(ak, accs, entry.Address); !ok {
+				return false
+			}
+			if !entry.Enabled || entry.IsExpired(now) {
+				return false
+			}
+			// A creator without recorded usage has registered nothing yet.
+			usage, _ := k.CreatorUsage.Get(ctx, entry.Address)
+			if remaining, unlimited := entry.RemainingTokens(usage); !unlimited && remaining == 0 {
+				return false
+			}
+			remaining, unlimited := entry.RemainingSupply(usage)
+			return unlimited || remaining >= maxSupply
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no allowlisted creator with remaining quota"), nil, nil
+		}
+		creator, _ := findAccount(ak, accs, entry.Address)
+
+		msg.Creator = entry.Address
+		msg.Issuer = entry.Address
+		msg.Denom = fmt.Sprintf("factory/%s/sim%d", entry.Address, r.Uint32())
+		msg.Name = simtypes.RandStringOfLength(r, 10)
+		msg.Symbol = simtypes.RandStringOfLength(r, 4)
+		msg.Description = simtypes.RandStringOfLength(r, 20)
+		msg.MaxSupply = maxSupply
+		msg.MerchantId = activeMerchantOf(ctx, k, entry.Address)
+		msg.Decimals = randomDecimals(r)
+		if r.Intn(3) == 0 && msg.Decimals < types.MaxTokenDecimals {
+			msg.DenomUnits = []types.TokenDenomUnit{{
+				Denom:    "m" + msg.Denom[strings.LastIndex(msg.Denom, "/")+1:],
+				Exponent: msg.Decimals + 1,
+				Aliases:  []string{simtypes.RandStringOfLength(r, 6)},
+			}}
+		}
 
-		i := r.Int()
-		msg := &types.MsgCreateVerifiedtoken{
-			Creator: simAccount.Address.String(),
-			Denom:   strconv.Itoa(i),
+		// Opt into recovery through a policy already known to the module, when there is one.
+		if policyToken, ok := randomToken(r, ctx, k, func(token types.Verifiedtoken) bool {
+			return token.RecoveryGroupPolicy != ""
+		}); ok && r.Intn(2) == 0 {
+			msg.SeizureOptIn = true
+			msg.RecoveryGroupPolicy = policyToken.RecoveryGroupPolicy
+			msg.RecoveryTimelockHours = params.MinimumRecoveryTimelockHours()
 		}
 
-		found, err := k.Verifiedtoken.Has(ctx, msg.Denom)
-		if err == nil && found {
+		exists, err := k.Verifiedtoken.Has(ctx, msg.Denom)
+		if err == nil && exists {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Verifiedtoken already exist"), nil, nil
 		}
 
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, creator, msg, sdk.NewCoins())
 	}
 }
 
@@ -60,54 +93,29 @@ func SimulateMsgUpdateVerifiedtoken(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount    = simtypes.Account{}
-			verifiedtoken = types.Verifiedtoken{}
-			msg           = &types.MsgUpdateVerifiedtoken{}
-			found         = false
-		)
-
-		var allVerifiedtoken []types.Verifiedtoken
-		err := k.Verifiedtoken.Walk(ctx, nil, func(key string, value types.Verifiedtoken) (stop bool, err error) {
-			allVerifiedtoken = append(allVerifiedtoken, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		for _, obj := range allVerifiedtoken {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				verifiedtoken = obj
-				break
-			}
-		}
+		msg := &types.MsgUpdateVerifiedtoken{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(types.Verifiedtoken) bool { return true })
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "verifiedtoken creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Denom = verifiedtoken.Denom
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "verifiedtoken owner not found"), nil, nil
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+
+		// Cap and recovery settings are kept so the update never trips quota or renounce checks.
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
+		msg.Issuer = token.Issuer
+		msg.Name = token.Name
+		msg.Symbol = token.Symbol
+		msg.Description = simtypes.RandStringOfLength(r, 20)
+		msg.Website = token.Website
+		msg.MaxSupply = token.MaxSupply
+		msg.SeizureOptIn = token.SeizureOptIn
+		msg.RecoveryGroupPolicy = token.RecoveryGroupPolicy
+		msg.RecoveryTimelockHours = token.RecoveryTimelockHours
+		msg.MerchantId = token.MerchantId
+		msg.Decimals = token.Decimals
+		msg.DenomUnits = token.DenomUnits
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
 	}
 }
 
@@ -119,53 +127,22 @@ func SimulateMsgDeleteVerifiedtoken(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount    = simtypes.Account{}
-			verifiedtoken = types.Verifiedtoken{}
-			msg           = &types.MsgUpdateVerifiedtoken{}
-			found         = false
-		)
-
-		var allVerifiedtoken []types.Verifiedtoken
-		err := k.Verifiedtoken.Walk(ctx, nil, func(key string, value types.Verifiedtoken) (stop bool, err error) {
-			allVerifiedtoken = append(allVerifiedtoken, value)
-			return false, nil
+		msg := &types.MsgDeleteVerifiedtoken{}
+		owner, token, found := randomOwnedToken(r, ctx, ak, k, accs, func(token types.Verifiedtoken) bool {
+			return token.MintedSupply == 0
 		})
-		if err != nil {
-			panic(err)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no unminted verifiedtoken"), nil, nil
 		}
 
-		for _, obj := range allVerifiedtoken {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
+		msg.Creator = owner.Address.String()
+		msg.Denom = token.Denom
 
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				verifiedtoken = obj
-				break
-			}
-		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "verifiedtoken creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Denom = verifiedtoken.Denom
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTx(r, app, ctx, ak, bk, txGen, owner, msg, sdk.NewCoins())
 	}
 }
+
+// randomDecimals picks a display exponent typical of loyalty programs.
+func randomDecimals(r *rand.Rand) uint32 {
+	return []uint32{0, 2, types.LegacyTokenDecimals}[r.Intn(3)]
+}
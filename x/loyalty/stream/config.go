@@ -0,0 +1,105 @@
+package stream
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagEnable           = "loyalty-stream.enable"
+	flagRetainBlocks     = "loyalty-stream.retain-blocks"
+	flagMaxSubscribers   = "loyalty-stream.max-subscribers"
+	flagSubscriberBuffer = "loyalty-stream.subscriber-buffer"
+)
+
+// Config is the node configuration of the loyalty event stream.
+type Config struct {
+	// Enable serves the stream over gRPC and websocket.
+	Enable bool `mapstructure:"enable"`
+	// RetainBlocks is how many of the latest blocks subscribers can resume from.
+	RetainBlocks int64 `mapstructure:"retain-blocks"`
+	// MaxSubscribers bounds the number of open subscriptions.
+	MaxSubscribers int `mapstructure:"max-subscribers"`
+	// SubscriberBuffer is how many events a subscriber may fall behind by before
+	// it is dropped.
+	SubscriberBuffer int `mapstructure:"subscriber-buffer"`
+}
+
+// DefaultConfig returns the default stream configuration, which is disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enable:           false,
+		RetainBlocks:     1000,
+		MaxSubscribers:   100,
+		SubscriberBuffer: 1024,
+	}
+}
+
+// Validate checks the limits of c.
+func (c Config) Validate() error {
+	if c.RetainBlocks < 0 {
+		return fmt.Errorf("retain-blocks cannot be negative")
+	}
+	if c.MaxSubscribers <= 0 {
+		return fmt.Errorf("max-subscribers must be positive")
+	}
+	if c.SubscriberBuffer <= 0 {
+		return fmt.Errorf("subscriber-buffer must be positive")
+	}
+	return nil
+}
+
+// ReadConfig reads the stream configuration from the app options, keeping the
+// defaults of unset values.
+func ReadConfig(opts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	var err error
+	if v := opts.Get(flagEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRetainBlocks); v != nil {
+		if cfg.RetainBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxSubscribers); v != nil {
+		if cfg.MaxSubscribers, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagSubscriberBuffer); v != nil {
+		if cfg.SubscriberBuffer, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// DefaultConfigTemplate returns the app.toml section of the default configuration.
+func DefaultConfigTemplate() string {
+	return ConfigTemplate(DefaultConfig())
+}
+
+// ConfigTemplate returns the app.toml section of c.
+func ConfigTemplate(c Config) string {
+	return fmt.Sprintf(`
+[loyalty-stream]
+# Stream the loyalty events of committed blocks over gRPC
+# (tokenchain.loyalty.v1.EventStream/Subscribe) and websocket
+# (/tokenchain/loyalty/v1/events/ws on the API server).
+enable = %t
+
+# Number of latest blocks whose events subscribers can resume from.
+retain-blocks = %d
+
+# Maximum number of open subscriptions.
+max-subscribers = %d
+
+# Events a subscriber may fall behind by before it is dropped.
+subscriber-buffer = %d
+`, c.Enable, c.RetainBlocks, c.MaxSubscribers, c.SubscriberBuffer)
+}
@@ -0,0 +1,320 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+var (
+	_ storetypes.ABCIListener = (*Service)(nil)
+	_ types.EventStreamServer = (*Service)(nil)
+)
+
+// Service streams the loyalty events of committed blocks. It collects them
+// through the ABCI listener hooks of the base app, keeps the latest blocks for
+// subscribers that resume from a height and fans new blocks out once they are
+// committed. Slow subscribers are dropped rather than holding up the node.
+type Service struct {
+	cfg    Config
+	logger log.Logger
+
+	mu sync.Mutex
+	// pending holds the events of the block being finalized until it commits.
+	pending *block
+	// retained holds the committed blocks with events, oldest first.
+	retained []block
+	// firstHeight and lastHeight are the first and latest heights committed
+	// since the node started.
+	firstHeight int64
+	lastHeight  int64
+	subscribers map[*subscription]struct{}
+}
+
+type block struct {
+	height int64
+	events []types.StreamedEvent
+}
+
+// NewService returns a stream service with the given configuration.
+func NewService(cfg Config, logger log.Logger) *Service {
+	return &Service{
+		cfg:         cfg,
+		logger:      logger.With(log.ModuleKey, "loyalty-stream"),
+		subscribers: make(map[*subscription]struct{}),
+	}
+}
+
+// ListenFinalizeBlock collects the loyalty events of a finalized block.
+func (s *Service) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	events := collectEvents(req, res)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = &block{height: req.Height, events: events}
+	return nil
+}
+
+// ListenCommit publishes the events of the committed block.
+func (s *Service) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending == nil {
+		return nil
+	}
+	committed := *s.pending
+	s.pending = nil
+
+	if s.firstHeight == 0 {
+		s.firstHeight = committed.height
+	}
+	s.lastHeight = committed.height
+	if len(committed.events) > 0 {
+		s.retained = append(s.retained, committed)
+	}
+	for len(s.retained) > 0 && s.retained[0].height <= s.lastHeight-s.cfg.RetainBlocks {
+		s.retained = s.retained[1:]
+	}
+
+	for sub := range s.subscribers {
+		for _, event := range committed.events {
+			if !sub.filter.match(event) {
+				continue
+			}
+			select {
+			case sub.events <- event:
+				continue
+			default:
+			}
+			s.logger.Info("dropping slow subscriber", "height", committed.height)
+			s.drop(sub, status.Errorf(codes.ResourceExhausted, "subscriber fell behind at height %d; subscribe again from that height", committed.height))
+			break
+		}
+	}
+	return nil
+}
+
+// Subscribe implements types.EventStreamServer.
+func (s *Service) Subscribe(req *types.SubscribeEventsRequest, srv types.EventStream_SubscribeServer) error {
+	sub, err := s.subscribe(req)
+	if err != nil {
+		return err
+	}
+	return s.serve(srv.Context(), sub, srv.Send)
+}
+
+// subscription is an open subscription. backlog holds the retained events it
+// resumed from; events receives the events of blocks committed after it opened.
+type subscription struct {
+	filter  filter
+	backlog []types.StreamedEvent
+	events  chan types.StreamedEvent
+	// dropped is closed with err set when the service drops the subscription.
+	dropped chan struct{}
+	err     error
+}
+
+// subscribe opens a subscription for req.
+func (s *Service) subscribe(req *types.SubscribeEventsRequest) (*subscription, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "from_height cannot be negative")
+	}
+	f := newFilter(req)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.subscribers) >= s.cfg.MaxSubscribers {
+		return nil, status.Error(codes.ResourceExhausted, "too many subscribers")
+	}
+	if req.FromHeight > 0 {
+		// Without a committed block the service cannot tell a past height from a
+		// future one.
+		if s.lastHeight == 0 {
+			return nil, status.Error(codes.Unavailable, "no block committed since the node started; resume later")
+		}
+		if oldest := s.oldestRetainedHeight(); req.FromHeight < oldest {
+			return nil, status.Errorf(codes.OutOfRange, "events before height %d are not retained", oldest)
+		}
+	}
+
+	sub := &subscription{
+		filter:  f,
+		events:  make(chan types.StreamedEvent, s.cfg.SubscriberBuffer),
+		dropped: make(chan struct{}),
+	}
+	// Without from_height the subscription starts with the next committed block.
+	if req.FromHeight > 0 {
+		for _, b := range s.retained {
+			for _, event := range b.events {
+				if f.match(event) {
+					sub.backlog = append(sub.backlog, event)
+				}
+			}
+		}
+	}
+	s.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// oldestRetainedHeight is the lowest height subscribers can resume from.
+func (s *Service) oldestRetainedHeight() int64 {
+	return max(s.firstHeight, s.lastHeight-s.cfg.RetainBlocks+1)
+}
+
+// unsubscribe closes sub.
+func (s *Service) unsubscribe(sub *subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// drop closes sub with err. The caller holds the lock.
+func (s *Service) drop(sub *subscription, err error) {
+	if _, ok := s.subscribers[sub]; !ok {
+		return
+	}
+	delete(s.subscribers, sub)
+	sub.err = err
+	close(sub.dropped)
+}
+
+// serve sends the events of sub until ctx is done, send fails or sub is
+// dropped, then closes sub.
+func (s *Service) serve(ctx context.Context, sub *subscription, send func(*types.StreamedEvent) error) error {
+	defer s.unsubscribe(sub)
+	for i := range sub.backlog {
+		if err := send(&sub.backlog[i]); err != nil {
+			return err
+		}
+	}
+	sub.backlog = nil
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-sub.dropped:
+			return sub.err
+		case event := <-sub.events:
+			if err := send(&event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// filter matches the events a subscription asked for.
+type filter struct {
+	denom      string
+	address    string
+	fromHeight int64
+	eventTypes map[string]struct{}
+}
+
+func newFilter(req *types.SubscribeEventsRequest) filter {
+	f := filter{
+		denom:      strings.TrimSpace(req.Denom),
+		address:    strings.TrimSpace(req.Address),
+		fromHeight: req.FromHeight,
+	}
+	for _, eventType := range req.EventTypes {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			if f.eventTypes == nil {
+				f.eventTypes = make(map[string]struct{})
+			}
+			f.eventTypes[eventType] = struct{}{}
+		}
+	}
+	return f
+}
+
+func (f filter) match(event types.StreamedEvent) bool {
+	if event.Height < f.fromHeight {
+		return false
+	}
+	if f.eventTypes != nil {
+		if _, ok := f.eventTypes[event.Type]; !ok {
+			return false
+		}
+	}
+	if f.denom != "" && event.Attributes["denom"] != f.denom {
+		return false
+	}
+	if f.address != "" {
+		for _, value := range event.Attributes {
+			if value == f.address {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// collectEvents returns the loyalty events of a finalized block in execution
+// order: pre and begin block, successful transactions, then end block.
+func collectEvents(req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) []types.StreamedEvent {
+	var begin, end []abci.Event
+	for _, event := range res.Events {
+		if eventMode(event) == "EndBlock" {
+			end = append(end, event)
+		} else {
+			begin = append(begin, event)
+		}
+	}
+
+	var out []types.StreamedEvent
+	add := func(txHash string, events []abci.Event) {
+		for _, event := range events {
+			if !isLoyaltyEvent(event.Type) {
+				continue
+			}
+			streamed := types.StreamedEvent{
+				Height:     req.Height,
+				BlockTime:  req.Time,
+				TxHash:     txHash,
+				Type:       event.Type,
+				Attributes: make(map[string]string, len(event.Attributes)),
+			}
+			for _, attr := range event.Attributes {
+				streamed.Attributes[attr.Key] = attr.Value
+			}
+			out = append(out, streamed)
+		}
+	}
+	add("", begin)
+	for i, tx := range req.Txs {
+		if i >= len(res.TxResults) || !res.TxResults[i].IsOK() {
+			continue
+		}
+		add(fmt.Sprintf("%X", cmttypes.Tx(tx).Hash()), res.TxResults[i].Events)
+	}
+	add("", end)
+	return out
+}
+
+func eventMode(event abci.Event) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// isLoyaltyEvent reports whether eventType is emitted by the loyalty module,
+// either as loyalty.<name> or loyalty_<name>.
+func isLoyaltyEvent(eventType string) bool {
+	return strings.HasPrefix(eventType, types.ModuleName+".") || strings.HasPrefix(eventType, types.ModuleName+"_")
+}
@@ -0,0 +1,312 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/stream"
+	"tokenchain/x/loyalty/types"
+)
+
+func event(eventType string, attrs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return event
+}
+
+// commitBlock runs a block with one transaction per result through the listener
+// hooks of svc.
+func commitBlock(t *testing.T, svc *stream.Service, height int64, blockEvents []abci.Event, txResults ...*abci.ExecTxResult) {
+	t.Helper()
+	req := abci.RequestFinalizeBlock{Height: height, Time: time.Unix(1_800_000_000+height, 0).UTC()}
+	for i := range txResults {
+		req.Txs = append(req.Txs, []byte{byte(height), byte(i)})
+	}
+	res := abci.ResponseFinalizeBlock{Events: blockEvents, TxResults: txResults}
+	require.NoError(t, svc.ListenFinalizeBlock(context.Background(), req, res))
+	require.NoError(t, svc.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+// subscribeServer is an EventStream_SubscribeServer that hands events to a channel.
+type subscribeServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *types.StreamedEvent
+}
+
+func (s *subscribeServer) Context() context.Context { return s.ctx }
+
+func (s *subscribeServer) Send(event *types.StreamedEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// subscribe opens a subscription on svc and returns its events and final error.
+func subscribe(t *testing.T, svc *stream.Service, req *types.SubscribeEventsRequest) (<-chan *types.StreamedEvent, <-chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	srv := &subscribeServer{ctx: ctx, events: make(chan *types.StreamedEvent)}
+	errs := make(chan error, 1)
+	go func() { errs <- svc.Subscribe(req, srv) }()
+	return srv.events, errs
+}
+
+func receive(t *testing.T, events <-chan *types.StreamedEvent) *types.StreamedEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func receiveTypes(t *testing.T, events <-chan *types.StreamedEvent, n int) []string {
+	t.Helper()
+	out := make([]string, 0, n)
+	for range n {
+		out = append(out, receive(t, events).Type)
+	}
+	return out
+}
+
+func newService(mutate func(*stream.Config)) *stream.Service {
+	cfg := stream.DefaultConfig()
+	cfg.Enable = true
+	if mutate != nil {
+		mutate(&cfg)
+	}
+	return stream.NewService(cfg, log.NewNopLogger())
+}
+
+func TestStreamFiltersAndResume(t *testing.T) {
+	svc := newService(nil)
+	commitBlock(t, svc, 1,
+		[]abci.Event{
+			event(types.EventTypeDailyRollup, types.AttributeKeyDate, "2026-02-26", "mode", "BeginBlock"),
+			event("coin_received", "receiver", "alice", "mode", "EndBlock"),
+		},
+		&abci.ExecTxResult{Events: []abci.Event{
+			event("transfer", "recipient", "alice"),
+			event("loyalty.reward_accrued", "denom", "ucorn", "address", "alice", "amount", "5"),
+		}},
+	)
+	commitBlock(t, svc, 2, nil,
+		&abci.ExecTxResult{Code: 5, Events: []abci.Event{
+			event("loyalty.reward_claimed", "denom", "ucorn", "address", "alice", "amount", "5"),
+		}},
+		&abci.ExecTxResult{Events: []abci.Event{
+			event("loyalty.reward_claimed", "denom", "ubean", "address", "bob", "amount", "3"),
+		}},
+	)
+
+	// Everything retained, in execution order; failed transactions and other
+	// modules are left out.
+	events, _ := subscribe(t, svc, &types.SubscribeEventsRequest{FromHeight: 1})
+	rollup := receive(t, events)
+	require.Equal(t, types.EventTypeDailyRollup, rollup.Type)
+	require.EqualValues(t, 1, rollup.Height)
+	require.Empty(t, rollup.TxHash)
+	require.Equal(t, time.Unix(1_800_000_001, 0).UTC(), rollup.BlockTime)
+	accrued := receive(t, events)
+	require.Equal(t, "loyalty.reward_accrued", accrued.Type)
+	require.NotEmpty(t, accrued.TxHash)
+	require.Equal(t, map[string]string{"denom": "ucorn", "address": "alice", "amount": "5"}, accrued.Attributes)
+	claimed := receive(t, events)
+	require.Equal(t, "loyalty.reward_claimed", claimed.Type)
+	require.EqualValues(t, 2, claimed.Height)
+
+	byDenom, _ := subscribe(t, svc, &types.SubscribeEventsRequest{Denom: "ucorn", FromHeight: 1})
+	byAddress, _ := subscribe(t, svc, &types.SubscribeEventsRequest{Address: "bob", FromHeight: 2})
+	byType, _ := subscribe(t, svc, &types.SubscribeEventsRequest{EventTypes: []string{"loyalty.reward_claimed"}, FromHeight: 1})
+	require.Equal(t, "loyalty.reward_accrued", receive(t, byDenom).Type)
+	require.Equal(t, "bob", receive(t, byAddress).Attributes["address"])
+	require.EqualValues(t, 2, receive(t, byType).Height)
+
+	// New blocks reach the subscribers once committed.
+	commitBlock(t, svc, 3, nil, &abci.ExecTxResult{Events: []abci.Event{
+		event("loyalty.verified_token_minted", "denom", "ucorn", "recipient", "bob", "amount", "9"),
+	}})
+	require.EqualValues(t, 3, receive(t, byDenom).Height)
+	require.Equal(t, "loyalty.verified_token_minted", receive(t, byAddress).Type)
+	require.Equal(t, []string{"loyalty.verified_token_minted"}, receiveTypes(t, events, 1))
+	select {
+	case event := <-byType:
+		t.Fatalf("unexpected event %s", event.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestStreamRetention(t *testing.T) {
+	svc := newService(func(cfg *stream.Config) { cfg.RetainBlocks = 2 })
+
+	_, errs := subscribe(t, svc, &types.SubscribeEventsRequest{FromHeight: 1})
+	require.Equal(t, codes.Unavailable, status.Code(<-errs))
+	_, errs = subscribe(t, svc, &types.SubscribeEventsRequest{FromHeight: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(<-errs))
+
+	for height := int64(1); height <= 4; height++ {
+		commitBlock(t, svc, height, nil, &abci.ExecTxResult{Events: []abci.Event{
+			event("loyalty.reward_accrued", "denom", "ucorn", "address", "alice"),
+		}})
+	}
+	_, errs = subscribe(t, svc, &types.SubscribeEventsRequest{FromHeight: 2})
+	require.Equal(t, codes.OutOfRange, status.Code(<-errs))
+
+	events, _ := subscribe(t, svc, &types.SubscribeEventsRequest{FromHeight: 3})
+	require.EqualValues(t, 3, receive(t, events).Height)
+	require.EqualValues(t, 4, receive(t, events).Height)
+
+	// A height ahead of the chain waits for it.
+	future, _ := subscribe(t, svc, &types.SubscribeEventsRequest{FromHeight: 6})
+	for height := int64(5); height <= 6; height++ {
+		commitBlock(t, svc, height, nil, &abci.ExecTxResult{Events: []abci.Event{
+			event("loyalty.reward_accrued", "denom", "ucorn", "address", "alice"),
+		}})
+	}
+	require.EqualValues(t, 6, receive(t, future).Height)
+}
+
+func TestStreamDropsSlowSubscriber(t *testing.T) {
+	svc := newService(func(cfg *stream.Config) { cfg.SubscriberBuffer = 1 })
+
+	// A block with more events than the subscriber can buffer drops it once it
+	// is subscribed; the events it was sent are read afterwards.
+	events, errs := subscribe(t, svc, &types.SubscribeEventsRequest{})
+	var dropErr error
+	require.Eventually(t, func() bool {
+		commitBlock(t, svc, 1, nil, &abci.ExecTxResult{Events: []abci.Event{
+			event("loyalty.reward_accrued", "denom", "ucorn"),
+			event("loyalty.reward_accrued", "denom", "ucorn"),
+			event("loyalty.reward_accrued", "denom", "ucorn"),
+		}})
+		for {
+			select {
+			case dropErr = <-errs:
+				return true
+			case <-events:
+			case <-time.After(10 * time.Millisecond):
+				return false
+			}
+		}
+	}, 5*time.Second, time.Millisecond)
+	require.Equal(t, codes.ResourceExhausted, status.Code(dropErr))
+	require.Contains(t, dropErr.Error(), "height 1")
+}
+
+func TestStreamWebsocket(t *testing.T) {
+	svc := newService(func(cfg *stream.Config) { cfg.MaxSubscribers = 1 })
+	router := mux.NewRouter()
+	svc.RegisterRoutes(router, false)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + stream.WebsocketPath
+
+	_, resp, err := websocket.DefaultDialer.Dial(url+"?from_height=x", nil)
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	commitBlock(t, svc, 1, nil, &abci.ExecTxResult{Events: []abci.Event{
+		event("loyalty.reward_accrued", "denom", "ucorn", "address", "alice"),
+		event("loyalty.reward_claimed", "denom", "ucorn", "address", "alice"),
+	}})
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?from_height=1&event_types=loyalty.reward_claimed,loyalty.verified_token_minted", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	var streamed struct {
+		Height     string            `json:"height"`
+		Type       string            `json:"type"`
+		Attributes map[string]string `json:"attributes"`
+	}
+	require.NoError(t, conn.ReadJSON(&streamed))
+	require.Equal(t, "1", streamed.Height)
+	require.Equal(t, "loyalty.reward_claimed", streamed.Type)
+	require.Equal(t, "alice", streamed.Attributes["address"])
+
+	_, resp, err = websocket.DefaultDialer.Dial(url, nil)
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	commitBlock(t, svc, 2, nil, &abci.ExecTxResult{Events: []abci.Event{
+		event("loyalty.verified_token_minted", "denom", "ucorn", "recipient", "alice"),
+	}})
+	_, message, err := conn.ReadMessage()
+	require.NoError(t, err)
+	var minted map[string]any
+	require.NoError(t, json.Unmarshal(message, &minted))
+	require.Equal(t, "loyalty.verified_token_minted", minted["type"])
+	require.Equal(t, "2", minted["height"])
+}
+
+func TestStreamFromNextBlock(t *testing.T) {
+	svc := newService(nil)
+	router := mux.NewRouter()
+	svc.RegisterRoutes(router, false)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	commitBlock(t, svc, 1, nil, &abci.ExecTxResult{Events: []abci.Event{
+		event("loyalty.reward_accrued", "denom", "ucorn", "address", "alice"),
+	}})
+
+	// The subscription is open once the handshake completes; without from_height
+	// the retained block 1 is not replayed.
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+stream.WebsocketPath, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	commitBlock(t, svc, 2, nil, &abci.ExecTxResult{Events: []abci.Event{
+		event("loyalty.reward_claimed", "denom", "ucorn", "address", "alice"),
+	}})
+
+	var streamed struct {
+		Height string `json:"height"`
+		Type   string `json:"type"`
+	}
+	require.NoError(t, conn.ReadJSON(&streamed))
+	require.Equal(t, "2", streamed.Height)
+	require.Equal(t, "loyalty.reward_claimed", streamed.Type)
+}
+
+func TestReadConfig(t *testing.T) {
+	cfg, err := stream.ReadConfig(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, stream.DefaultConfig(), cfg)
+	require.False(t, cfg.Enable)
+
+	cfg, err = stream.ReadConfig(simtestutil.AppOptionsMap{
+		"loyalty-stream.enable":        true,
+		"loyalty-stream.retain-blocks": "50",
+	})
+	require.NoError(t, err)
+	require.True(t, cfg.Enable)
+	require.EqualValues(t, 50, cfg.RetainBlocks)
+
+	_, err = stream.ReadConfig(simtestutil.AppOptionsMap{"loyalty-stream.max-subscribers": 0})
+	require.Error(t, err)
+	_, err = stream.ReadConfig(simtestutil.AppOptionsMap{"loyalty-stream.enable": "maybe"})
+	require.Error(t, err)
+}
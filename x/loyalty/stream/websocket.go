@@ -0,0 +1,113 @@
+package stream
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tokenchain/x/loyalty/types"
+)
+
+// WebsocketPath is where RegisterRoutes serves the stream on the API server.
+const WebsocketPath = "/tokenchain/loyalty/v1/events/ws"
+
+// websocketWriteTimeout bounds the write of one event to a websocket client.
+const websocketWriteTimeout = 10 * time.Second
+
+// RegisterRoutes serves the stream over websocket on router. The query
+// parameters are those of SubscribeEventsRequest, with event_types comma
+// separated; each message is a StreamedEvent in JSON. Cross-origin clients are
+// only accepted when allowAnyOrigin is set.
+func (s *Service) RegisterRoutes(router *mux.Router, allowAnyOrigin bool) {
+	upgrader := websocket.Upgrader{}
+	if allowAnyOrigin {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+	router.HandleFunc(WebsocketPath, func(w http.ResponseWriter, r *http.Request) {
+		s.serveWebsocket(w, r, upgrader)
+	}).Methods(http.MethodGet)
+}
+
+func (s *Service) serveWebsocket(w http.ResponseWriter, r *http.Request, upgrader websocket.Upgrader) {
+	req, err := websocketRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	sub, err := s.subscribe(req)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has replied to the client.
+		s.unsubscribe(sub)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Clients send nothing; reading notices when they go away.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = s.serve(ctx, sub, func(event *types.StreamedEvent) error {
+		bz, err := codec.ProtoMarshalJSON(event, nil)
+		if err != nil {
+			return err
+		}
+		if err := conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout)); err != nil {
+			return err
+		}
+		return conn.WriteMessage(websocket.TextMessage, bz)
+	})
+	if ctx.Err() != nil {
+		return
+	}
+	closeCode := websocket.CloseInternalServerErr
+	if status.Code(err) == codes.ResourceExhausted {
+		closeCode = websocket.CloseTryAgainLater
+	}
+	_ = conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(closeCode, status.Convert(err).Message()),
+		time.Now().Add(websocketWriteTimeout),
+	)
+}
+
+// websocketRequest reads a subscription request from the query of r.
+func websocketRequest(r *http.Request) (*types.SubscribeEventsRequest, error) {
+	query := r.URL.Query()
+	req := &types.SubscribeEventsRequest{
+		Denom:   query.Get("denom"),
+		Address: query.Get("address"),
+	}
+	if fromHeight := query.Get("from_height"); fromHeight != "" {
+		height, err := strconv.ParseInt(fromHeight, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid from_height")
+		}
+		req.FromHeight = height
+	}
+	for _, eventTypes := range query["event_types"] {
+		req.EventTypes = append(req.EventTypes, strings.Split(eventTypes, ",")...)
+	}
+	return req, nil
+}
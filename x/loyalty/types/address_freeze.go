@@ -0,0 +1,16 @@
+package types
+
+// AddressFreezeGraceHours is how long past a token's recovery timelock a freeze may
+// run, leaving the recovery group time to queue and execute the recovery.
+const AddressFreezeGraceHours = 7 * 24
+
+// MaxAddressFreezeSeconds returns the longest freeze allowed for a token whose
+// recovery timelock is timelockHours.
+func MaxAddressFreezeSeconds(timelockHours uint64) uint64 {
+	return (timelockHours + AddressFreezeGraceHours) * 3600
+}
+
+// ActiveAt reports whether the freeze is still in force at unix time now.
+func (f AddressFreeze) ActiveAt(now uint64) bool {
+	return now < f.ExpiresAt
+}
@@ -0,0 +1,629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/address_freeze.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressFreeze blocks outgoing transfers of one recovery-enabled token from one
+// address while a recovery is prepared.
+type AddressFreeze struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// recovery_id is the queued recovery operation the freeze is linked to when
+	// recovery_linked is set; the freeze is lifted when that operation executes or is
+	// cancelled.
+	RecoveryId uint64 `protobuf:"varint,3,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+	FrozenBy   string `protobuf:"bytes,4,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	FrozenAt   uint64 `protobuf:"varint,6,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	// expires_at lifts the freeze automatically (unix seconds); it is always set.
+	ExpiresAt      uint64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RecoveryLinked bool   `protobuf:"varint,8,opt,name=recovery_linked,json=recoveryLinked,proto3" json:"recovery_linked,omitempty"`
+}
+
+func (m *AddressFreeze) Reset()         { *m = AddressFreeze{} }
+func (m *AddressFreeze) String() string { return proto.CompactTextString(m) }
+func (*AddressFreeze) ProtoMessage()    {}
+func (*AddressFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69b8b4ca8cf2cf21, []int{0}
+}
+func (m *AddressFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressFreeze.Merge(m, src)
+}
+func (m *AddressFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressFreeze proto.InternalMessageInfo
+
+func (m *AddressFreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AddressFreeze) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressFreeze) GetRecoveryId() uint64 {
+	if m != nil {
+		return m.RecoveryId
+	}
+	return 0
+}
+
+func (m *AddressFreeze) GetFrozenBy() string {
+	if m != nil {
+		return m.FrozenBy
+	}
+	return ""
+}
+
+func (m *AddressFreeze) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AddressFreeze) GetFrozenAt() uint64 {
+	if m != nil {
+		return m.FrozenAt
+	}
+	return 0
+}
+
+func (m *AddressFreeze) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *AddressFreeze) GetRecoveryLinked() bool {
+	if m != nil {
+		return m.RecoveryLinked
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AddressFreeze)(nil), "tokenchain.loyalty.v1.AddressFreeze")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/address_freeze.proto", fileDescriptor_69b8b4ca8cf2cf21)
+}
+
+var fileDescriptor_69b8b4ca8cf2cf21 = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
+	0x18, 0xc4, 0xbb, 0xda, 0x3f, 0xc9, 0x8a, 0x0a, 0x8b, 0xca, 0xa2, 0xb8, 0x06, 0x2f, 0x06, 0x0f,
+	0x09, 0x45, 0x5f, 0x20, 0x3d, 0x08, 0x82, 0xa7, 0x1c, 0xbd, 0x84, 0xb4, 0xfb, 0x15, 0x43, 0xe3,
+	0x6e, 0xd8, 0x2c, 0xa1, 0xdb, 0xa7, 0xf0, 0xb1, 0x3c, 0xf6, 0xe8, 0x51, 0x92, 0xb7, 0xf0, 0x24,
+	0x6e, 0x92, 0xda, 0xe3, 0xfc, 0x66, 0xe6, 0xe3, 0x63, 0xf0, 0xbd, 0x96, 0x2b, 0x10, 0x8b, 0xb7,
+	0x34, 0x13, 0x61, 0x2e, 0x4d, 0x9a, 0x6b, 0x13, 0x56, 0xd3, 0x30, 0xe5, 0x5c, 0x41, 0x59, 0x26,
+	0x4b, 0x05, 0xb0, 0x81, 0xa0, 0x50, 0x52, 0x4b, 0x72, 0xfe, 0x9f, 0x0d, 0xba, 0x6c, 0x50, 0x4d,
+	0x6f, 0x7f, 0x10, 0x3e, 0x8e, 0xda, 0xfc, 0x93, 0x8d, 0x93, 0x33, 0x3c, 0xe2, 0x20, 0xe4, 0x3b,
+	0x45, 0x1e, 0xf2, 0xdd, 0xb8, 0x15, 0x84, 0xe2, 0x49, 0x77, 0x96, 0x1e, 0x58, 0xde, 0x4b, 0x72,
+	0x83, 0x8f, 0x14, 0x2c, 0x64, 0x05, 0xca, 0x24, 0x19, 0xa7, 0x87, 0x1e, 0xf2, 0x87, 0x31, 0xee,
+	0xd1, 0x33, 0x27, 0x57, 0xd8, 0x5d, 0x2a, 0xb9, 0x01, 0x91, 0xcc, 0x0d, 0x1d, 0xda, 0xb2, 0xd3,
+	0x82, 0x99, 0x21, 0x17, 0x78, 0xac, 0x20, 0x2d, 0xa5, 0xa0, 0x23, 0xeb, 0x74, 0x6a, 0xaf, 0x94,
+	0x6a, 0x3a, 0xb6, 0x37, 0xbb, 0x52, 0xa4, 0xc9, 0x35, 0xc6, 0xb0, 0x2e, 0x32, 0x05, 0xe5, 0x9f,
+	0x3b, 0xb1, 0xae, 0xdb, 0x91, 0x48, 0x93, 0x3b, 0x7c, 0xba, 0xfb, 0x28, 0xcf, 0xc4, 0x0a, 0x38,
+	0x75, 0x3c, 0xe4, 0x3b, 0xf1, 0x49, 0x8f, 0x5f, 0x2c, 0x9d, 0x3d, 0x7e, 0xd6, 0x0c, 0x6d, 0x6b,
+	0x86, 0xbe, 0x6b, 0x86, 0x3e, 0x1a, 0x36, 0xd8, 0x36, 0x6c, 0xf0, 0xd5, 0xb0, 0xc1, 0xeb, 0xe5,
+	0xde, 0xb2, 0xeb, 0xdd, 0xb6, 0xda, 0x14, 0x50, 0xce, 0xc7, 0x76, 0xd0, 0x87, 0xdf, 0x01, 0x00,
+	0x86, 0x1f, 0x4d, 0x52, 0x7e, 0x01, 0x00, 0x00,
+}
+
+func (m *AddressFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryLinked {
+		i--
+		if m.RecoveryLinked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintAddressFreeze(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FrozenAt != 0 {
+		i = encodeVarintAddressFreeze(dAtA, i, uint64(m.FrozenAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAddressFreeze(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FrozenBy) > 0 {
+		i -= len(m.FrozenBy)
+		copy(dAtA[i:], m.FrozenBy)
+		i = encodeVarintAddressFreeze(dAtA, i, uint64(len(m.FrozenBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecoveryId != 0 {
+		i = encodeVarintAddressFreeze(dAtA, i, uint64(m.RecoveryId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAddressFreeze(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAddressFreeze(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressFreeze(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressFreeze(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAddressFreeze(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAddressFreeze(uint64(l))
+	}
+	if m.RecoveryId != 0 {
+		n += 1 + sovAddressFreeze(uint64(m.RecoveryId))
+	}
+	l = len(m.FrozenBy)
+	if l > 0 {
+		n += 1 + l + sovAddressFreeze(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAddressFreeze(uint64(l))
+	}
+	if m.FrozenAt != 0 {
+		n += 1 + sovAddressFreeze(uint64(m.FrozenAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAddressFreeze(uint64(m.ExpiresAt))
+	}
+	if m.RecoveryLinked {
+		n += 2
+	}
+	return n
+}
+
+func sovAddressFreeze(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressFreeze(x uint64) (n int) {
+	return sovAddressFreeze(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddressFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressFreeze
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
+			}
+			m.RecoveryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAt", wireType)
+			}
+			m.FrozenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrozenAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryLinked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecoveryLinked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressFreeze(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAddressFreeze
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressFreeze(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressFreeze
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressFreeze
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressFreeze
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressFreeze
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressFreeze
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressFreeze        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressFreeze          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressFreeze = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	AttestationActionAttested = "attested"
+	AttestationActionRevoked  = "revoked"
+	AttestationActionExpired  = "expired"
+
+	maxEvidenceURILength = 512
+)
+
+// ValidateEvidenceURI checks that an attestation carries a bounded, non-empty evidence reference.
+func ValidateEvidenceURI(uri string) error {
+	if strings.TrimSpace(uri) == "" {
+		return fmt.Errorf("evidence uri cannot be empty")
+	}
+	if len(uri) > maxEvidenceURILength {
+		return fmt.Errorf("evidence uri exceeds %d characters", maxEvidenceURILength)
+	}
+	return nil
+}
+
+// IsActive reports whether the attestation still counts at unix time now.
+func (a Attestation) IsActive(now uint64) bool {
+	return a.ExpiresAt > now
+}
@@ -0,0 +1,616 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/authorities.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Authorities defines the addresses holding each scoped loyalty role.
+// The module authority (x/gov by default) implicitly holds every role.
+type Authorities struct {
+	// params_admins may update module params.
+	ParamsAdmins []string `protobuf:"bytes,1,rep,name=params_admins,json=paramsAdmins,proto3" json:"params_admins,omitempty"`
+	// accrual_recorders may record reward accruals and merchant allocations.
+	AccrualRecorders []string `protobuf:"bytes,2,rep,name=accrual_recorders,json=accrualRecorders,proto3" json:"accrual_recorders,omitempty"`
+	// allowlist_admins manage the creator allowlist and the verified token registry.
+	AllowlistAdmins []string `protobuf:"bytes,3,rep,name=allowlist_admins,json=allowlistAdmins,proto3" json:"allowlist_admins,omitempty"`
+	// recovery_overseers may queue, execute and cancel recovery transfers on any token.
+	RecoveryOverseers []string `protobuf:"bytes,4,rep,name=recovery_overseers,json=recoveryOverseers,proto3" json:"recovery_overseers,omitempty"`
+	// verifiers may submit and revoke verification attestations for verified tokens.
+	Verifiers []string `protobuf:"bytes,5,rep,name=verifiers,proto3" json:"verifiers,omitempty"`
+}
+
+func (m *Authorities) Reset()         { *m = Authorities{} }
+func (m *Authorities) String() string { return proto.CompactTextString(m) }
+func (*Authorities) ProtoMessage()    {}
+func (*Authorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfae48c9feac20c1, []int{0}
+}
+func (m *Authorities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Authorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Authorities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Authorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authorities.Merge(m, src)
+}
+func (m *Authorities) XXX_Size() int {
+	return m.Size()
+}
+func (m *Authorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authorities proto.InternalMessageInfo
+
+func (m *Authorities) GetParamsAdmins() []string {
+	if m != nil {
+		return m.ParamsAdmins
+	}
+	return nil
+}
+
+func (m *Authorities) GetAccrualRecorders() []string {
+	if m != nil {
+		return m.AccrualRecorders
+	}
+	return nil
+}
+
+func (m *Authorities) GetAllowlistAdmins() []string {
+	if m != nil {
+		return m.AllowlistAdmins
+	}
+	return nil
+}
+
+func (m *Authorities) GetRecoveryOverseers() []string {
+	if m != nil {
+		return m.RecoveryOverseers
+	}
+	return nil
+}
+
+func (m *Authorities) GetVerifiers() []string {
+	if m != nil {
+		return m.Verifiers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Authorities)(nil), "tokenchain.loyalty.v1.Authorities")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/authorities.proto", fileDescriptor_cfae48c9feac20c1)
+}
+
+var fileDescriptor_cfae48c9feac20c1 = []byte{
+	// 270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0xc9, 0xcf, 0x4e,
+	0xcd, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xc9, 0xaf, 0x4c, 0xcc, 0x29, 0xa9, 0xd4, 0x2f,
+	0x33, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xc9, 0x4c, 0x2d, 0xd6, 0x2b, 0x28,
+	0xca, 0x2f, 0xc9, 0x17, 0x12, 0x45, 0x28, 0xd4, 0x83, 0x2a, 0xd4, 0x2b, 0x33, 0x94, 0x12, 0x49,
+	0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0x95, 0xee, 0x32, 0x72, 0x71, 0x3b,
+	0x22, 0x8c, 0x10, 0x52, 0xe6, 0xe2, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x8e, 0x4f, 0x4c, 0xc9,
+	0xcd, 0xcc, 0x2b, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0xe2, 0x81, 0x08, 0x3a, 0x82, 0xc5,
+	0x84, 0xb4, 0xb9, 0x04, 0x13, 0x93, 0x93, 0x8b, 0x4a, 0x13, 0x73, 0xe2, 0x8b, 0x52, 0x93, 0xf3,
+	0x8b, 0x52, 0x52, 0x8b, 0x8a, 0x25, 0x98, 0xc0, 0x0a, 0x05, 0xa0, 0x12, 0x41, 0x30, 0x71, 0x21,
+	0x4d, 0x2e, 0x81, 0xc4, 0x9c, 0x9c, 0xfc, 0xf2, 0x9c, 0xcc, 0xe2, 0x12, 0x98, 0xa1, 0xcc, 0x60,
+	0xb5, 0xfc, 0x70, 0x71, 0xa8, 0xb9, 0xba, 0x5c, 0x42, 0x20, 0xf3, 0xca, 0x52, 0x8b, 0x2a, 0xe3,
+	0x41, 0x64, 0x71, 0x2a, 0xc8, 0x60, 0x16, 0xb0, 0x62, 0x41, 0x98, 0x8c, 0x3f, 0x4c, 0x42, 0x48,
+	0x86, 0x8b, 0xb3, 0x2c, 0xb5, 0x28, 0x33, 0x2d, 0x13, 0xa4, 0x8a, 0x15, 0xac, 0x0a, 0x21, 0x60,
+	0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xc9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
+	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
+	0x31, 0x44, 0x49, 0x21, 0x85, 0x67, 0x05, 0x3c, 0x44, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
+	0xc0, 0x81, 0x63, 0x0c, 0x18, 0x00, 0xe7, 0x25, 0xb2, 0x12, 0x74, 0x01, 0x00, 0x00,
+}
+
+func (this *Authorities) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Authorities)
+	if !ok {
+		that2, ok := that.(Authorities)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ParamsAdmins) != len(that1.ParamsAdmins) {
+		return false
+	}
+	for i := range this.ParamsAdmins {
+		if this.ParamsAdmins[i] != that1.ParamsAdmins[i] {
+			return false
+		}
+	}
+	if len(this.AccrualRecorders) != len(that1.AccrualRecorders) {
+		return false
+	}
+	for i := range this.AccrualRecorders {
+		if this.AccrualRecorders[i] != that1.AccrualRecorders[i] {
+			return false
+		}
+	}
+	if len(this.AllowlistAdmins) != len(that1.AllowlistAdmins) {
+		return false
+	}
+	for i := range this.AllowlistAdmins {
+		if this.AllowlistAdmins[i] != that1.AllowlistAdmins[i] {
+			return false
+		}
+	}
+	if len(this.RecoveryOverseers) != len(that1.RecoveryOverseers) {
+		return false
+	}
+	for i := range this.RecoveryOverseers {
+		if this.RecoveryOverseers[i] != that1.RecoveryOverseers[i] {
+			return false
+		}
+	}
+	if len(this.Verifiers) != len(that1.Verifiers) {
+		return false
+	}
+	for i := range this.Verifiers {
+		if this.Verifiers[i] != that1.Verifiers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Authorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifiers) > 0 {
+		for iNdEx := len(m.Verifiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Verifiers[iNdEx])
+			copy(dAtA[i:], m.Verifiers[iNdEx])
+			i = encodeVarintAuthorities(dAtA, i, uint64(len(m.Verifiers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RecoveryOverseers) > 0 {
+		for iNdEx := len(m.RecoveryOverseers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecoveryOverseers[iNdEx])
+			copy(dAtA[i:], m.RecoveryOverseers[iNdEx])
+			i = encodeVarintAuthorities(dAtA, i, uint64(len(m.RecoveryOverseers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowlistAdmins) > 0 {
+		for iNdEx := len(m.AllowlistAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistAdmins[iNdEx])
+			copy(dAtA[i:], m.AllowlistAdmins[iNdEx])
+			i = encodeVarintAuthorities(dAtA, i, uint64(len(m.AllowlistAdmins[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AccrualRecorders) > 0 {
+		for iNdEx := len(m.AccrualRecorders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccrualRecorders[iNdEx])
+			copy(dAtA[i:], m.AccrualRecorders[iNdEx])
+			i = encodeVarintAuthorities(dAtA, i, uint64(len(m.AccrualRecorders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ParamsAdmins) > 0 {
+		for iNdEx := len(m.ParamsAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ParamsAdmins[iNdEx])
+			copy(dAtA[i:], m.ParamsAdmins[iNdEx])
+			i = encodeVarintAuthorities(dAtA, i, uint64(len(m.ParamsAdmins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthorities(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthorities(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Authorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParamsAdmins) > 0 {
+		for _, s := range m.ParamsAdmins {
+			l = len(s)
+			n += 1 + l + sovAuthorities(uint64(l))
+		}
+	}
+	if len(m.AccrualRecorders) > 0 {
+		for _, s := range m.AccrualRecorders {
+			l = len(s)
+			n += 1 + l + sovAuthorities(uint64(l))
+		}
+	}
+	if len(m.AllowlistAdmins) > 0 {
+		for _, s := range m.AllowlistAdmins {
+			l = len(s)
+			n += 1 + l + sovAuthorities(uint64(l))
+		}
+	}
+	if len(m.RecoveryOverseers) > 0 {
+		for _, s := range m.RecoveryOverseers {
+			l = len(s)
+			n += 1 + l + sovAuthorities(uint64(l))
+		}
+	}
+	if len(m.Verifiers) > 0 {
+		for _, s := range m.Verifiers {
+			l = len(s)
+			n += 1 + l + sovAuthorities(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthorities(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthorities(x uint64) (n int) {
+	return sovAuthorities(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Authorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAdmins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAdmins = append(m.ParamsAdmins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrualRecorders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccrualRecorders = append(m.AccrualRecorders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistAdmins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistAdmins = append(m.AllowlistAdmins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryOverseers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryOverseers = append(m.RecoveryOverseers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifiers = append(m.Verifiers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthorities(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthorities
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthorities
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthorities
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthorities
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthorities
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthorities
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthorities        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthorities          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthorities = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClaimSponsorshipAllowancePeriod is how long a sponsored allowance lasts after it
+// is granted or extended.
+const ClaimSponsorshipAllowancePeriod = 7 * 24 * time.Hour
+
+// DefaultClaimSponsorshipMsgTypes are the messages sponsored allowances pay for
+// when a sponsorship lists none.
+var DefaultClaimSponsorshipMsgTypes = []string{"/tokenchain.loyalty.v1.MsgClaimReward"}
+
+// Validate checks the budget and message types of a claim sponsorship.
+func (s ClaimSponsorship) Validate() error {
+	if s.Denom == "" || s.Sponsor == "" {
+		return fmt.Errorf("denom and sponsor are required")
+	}
+	if s.PerUserCap == 0 {
+		return fmt.Errorf("per_user_cap must be positive")
+	}
+	if s.DailyBudget < s.PerUserCap {
+		return fmt.Errorf("daily_budget %d is below per_user_cap %d", s.DailyBudget, s.PerUserCap)
+	}
+	return validateMsgTypeURLs(s.MsgTypes)
+}
+
+// AllowedMsgTypes returns the message types the allowances of s pay for.
+func (s ClaimSponsorship) AllowedMsgTypes() []string {
+	if len(s.MsgTypes) == 0 {
+		return DefaultClaimSponsorshipMsgTypes
+	}
+	return s.MsgTypes
+}
+
+// OnDate returns u for date, or a fresh usage once the date has moved on.
+func (u ClaimSponsorshipUsage) OnDate(denom, date string) ClaimSponsorshipUsage {
+	if u.Date != date {
+		return ClaimSponsorshipUsage{Denom: denom, Date: date}
+	}
+	return u
+}
+
+// RemainingBudget returns what is left of budget after u.
+func (u ClaimSponsorshipUsage) RemainingBudget(budget uint64) uint64 {
+	if u.Exhausted || u.Spent >= budget {
+		return 0
+	}
+	return budget - u.Spent
+}
@@ -0,0 +1,1273 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/claim_sponsorship.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClaimSponsorship lets the owner of a verified token pay the claim fees of its
+// holders. When an accrual credits an address that has nothing to claim yet, the
+// sponsor grants it an x/feegrant allowance in the staking denom scoped to
+// msg_types.
+type ClaimSponsorship struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sponsor is the granter of the allowances: the token owner who set the policy.
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// daily_budget bounds the fees paid by the allowances per rollup date.
+	DailyBudget uint64 `protobuf:"varint,3,opt,name=daily_budget,json=dailyBudget,proto3" json:"daily_budget,omitempty"`
+	// per_user_cap is the spend limit of each allowance.
+	PerUserCap uint64 `protobuf:"varint,4,opt,name=per_user_cap,json=perUserCap,proto3" json:"per_user_cap,omitempty"`
+	// msg_types are the message type URLs the allowances pay for. Reward claims
+	// only when empty.
+	MsgTypes  []string `protobuf:"bytes,5,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	Enabled   bool     `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	UpdatedAt uint64   `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *ClaimSponsorship) Reset()         { *m = ClaimSponsorship{} }
+func (m *ClaimSponsorship) String() string { return proto.CompactTextString(m) }
+func (*ClaimSponsorship) ProtoMessage()    {}
+func (*ClaimSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_160e698ee1d92092, []int{0}
+}
+func (m *ClaimSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimSponsorship.Merge(m, src)
+}
+func (m *ClaimSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimSponsorship proto.InternalMessageInfo
+
+func (m *ClaimSponsorship) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ClaimSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *ClaimSponsorship) GetDailyBudget() uint64 {
+	if m != nil {
+		return m.DailyBudget
+	}
+	return 0
+}
+
+func (m *ClaimSponsorship) GetPerUserCap() uint64 {
+	if m != nil {
+		return m.PerUserCap
+	}
+	return 0
+}
+
+func (m *ClaimSponsorship) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *ClaimSponsorship) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ClaimSponsorship) GetUpdatedAt() uint64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// ClaimSponsorshipUsage is what a sponsorship has granted and paid on one rollup
+// date.
+type ClaimSponsorshipUsage struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Date  string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// spent is the fees paid through the allowances.
+	Spent             uint64 `protobuf:"varint,3,opt,name=spent,proto3" json:"spent,omitempty"`
+	AllowancesGranted uint64 `protobuf:"varint,4,opt,name=allowances_granted,json=allowancesGranted,proto3" json:"allowances_granted,omitempty"`
+	// exhausted is set once spent reaches the daily budget; the outstanding
+	// allowances are then revoked.
+	Exhausted bool `protobuf:"varint,5,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+}
+
+func (m *ClaimSponsorshipUsage) Reset()         { *m = ClaimSponsorshipUsage{} }
+func (m *ClaimSponsorshipUsage) String() string { return proto.CompactTextString(m) }
+func (*ClaimSponsorshipUsage) ProtoMessage()    {}
+func (*ClaimSponsorshipUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_160e698ee1d92092, []int{1}
+}
+func (m *ClaimSponsorshipUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimSponsorshipUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimSponsorshipUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimSponsorshipUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimSponsorshipUsage.Merge(m, src)
+}
+func (m *ClaimSponsorshipUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimSponsorshipUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimSponsorshipUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimSponsorshipUsage proto.InternalMessageInfo
+
+func (m *ClaimSponsorshipUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ClaimSponsorshipUsage) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *ClaimSponsorshipUsage) GetSpent() uint64 {
+	if m != nil {
+		return m.Spent
+	}
+	return 0
+}
+
+func (m *ClaimSponsorshipUsage) GetAllowancesGranted() uint64 {
+	if m != nil {
+		return m.AllowancesGranted
+	}
+	return 0
+}
+
+func (m *ClaimSponsorshipUsage) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
+	}
+	return false
+}
+
+// SponsoredAllowance is a fee allowance granted by a claim sponsorship.
+type SponsoredAllowance struct {
+	Sponsor    string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Grantee    string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Denom      string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	SpendLimit uint64 `protobuf:"varint,4,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	ExpiresAt  uint64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *SponsoredAllowance) Reset()         { *m = SponsoredAllowance{} }
+func (m *SponsoredAllowance) String() string { return proto.CompactTextString(m) }
+func (*SponsoredAllowance) ProtoMessage()    {}
+func (*SponsoredAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_160e698ee1d92092, []int{2}
+}
+func (m *SponsoredAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsoredAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredAllowance.Merge(m, src)
+}
+func (m *SponsoredAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredAllowance proto.InternalMessageInfo
+
+func (m *SponsoredAllowance) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *SponsoredAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *SponsoredAllowance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SponsoredAllowance) GetSpendLimit() uint64 {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return 0
+}
+
+func (m *SponsoredAllowance) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ClaimSponsorship)(nil), "tokenchain.loyalty.v1.ClaimSponsorship")
+	proto.RegisterType((*ClaimSponsorshipUsage)(nil), "tokenchain.loyalty.v1.ClaimSponsorshipUsage")
+	proto.RegisterType((*SponsoredAllowance)(nil), "tokenchain.loyalty.v1.SponsoredAllowance")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/claim_sponsorship.proto", fileDescriptor_160e698ee1d92092)
+}
+
+var fileDescriptor_160e698ee1d92092 = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xbd, 0x8e, 0x13, 0x31,
+	0x18, 0x8c, 0x49, 0x72, 0xb9, 0xfd, 0xee, 0x0a, 0xb0, 0x38, 0xc9, 0xe2, 0x67, 0x59, 0x52, 0xa5,
+	0xb9, 0x44, 0x27, 0x78, 0x81, 0xdc, 0x15, 0x34, 0x54, 0x81, 0x6b, 0x68, 0x56, 0x4e, 0xfc, 0x69,
+	0xb3, 0xc2, 0x6b, 0x5b, 0xb6, 0x73, 0x24, 0x6f, 0xc1, 0x13, 0x50, 0xf1, 0x30, 0x94, 0x57, 0xd2,
+	0x20, 0xa1, 0xe4, 0x45, 0x90, 0xbd, 0xbb, 0x6c, 0x40, 0xba, 0x6e, 0x67, 0x3c, 0x63, 0x7f, 0x33,
+	0xfb, 0xc1, 0xa5, 0xd7, 0x9f, 0x51, 0xad, 0xd6, 0xbc, 0x54, 0x33, 0xa9, 0x77, 0x5c, 0xfa, 0xdd,
+	0xec, 0xee, 0x6a, 0xb6, 0x92, 0xbc, 0xac, 0x72, 0x67, 0xb4, 0x72, 0xda, 0xba, 0x75, 0x69, 0xa6,
+	0xc6, 0x6a, 0xaf, 0xe9, 0x45, 0x27, 0x9f, 0x36, 0xf2, 0xe9, 0xdd, 0xd5, 0xf8, 0x17, 0x81, 0xc7,
+	0x37, 0xc1, 0xf2, 0xa1, 0x73, 0xd0, 0xa7, 0x30, 0x14, 0xa8, 0x74, 0xc5, 0x48, 0x46, 0x26, 0xc9,
+	0xa2, 0x06, 0x94, 0xc1, 0xa8, 0xb9, 0x96, 0x3d, 0x8a, 0x7c, 0x0b, 0xe9, 0x6b, 0x38, 0x17, 0xbc,
+	0x94, 0xbb, 0x7c, 0xb9, 0x11, 0x05, 0x7a, 0xd6, 0xcf, 0xc8, 0x64, 0xb0, 0x38, 0x8b, 0xdc, 0x75,
+	0xa4, 0x68, 0x06, 0xe7, 0x06, 0x6d, 0xbe, 0x71, 0x68, 0xf3, 0x15, 0x37, 0x6c, 0x10, 0x25, 0x60,
+	0xd0, 0xde, 0x3a, 0xb4, 0x37, 0xdc, 0xd0, 0xe7, 0x90, 0x54, 0xae, 0xc8, 0xfd, 0xce, 0xa0, 0x63,
+	0xc3, 0xac, 0x3f, 0x49, 0x16, 0xa7, 0x95, 0x2b, 0x3e, 0x06, 0x1c, 0xde, 0x46, 0xc5, 0x97, 0x12,
+	0x05, 0x3b, 0xc9, 0xc8, 0xe4, 0x74, 0xd1, 0x42, 0xfa, 0x12, 0x60, 0x63, 0x04, 0xf7, 0x28, 0x72,
+	0xee, 0xd9, 0x28, 0x5e, 0x9b, 0x34, 0xcc, 0xdc, 0x8f, 0xbf, 0x13, 0xb8, 0xf8, 0x3f, 0xdf, 0xad,
+	0xe3, 0x05, 0x3e, 0x10, 0x92, 0xc2, 0x20, 0x58, 0x9b, 0x84, 0xf1, 0x3b, 0x28, 0x9d, 0x41, 0xd5,
+	0xe6, 0xaa, 0x01, 0xbd, 0x04, 0xca, 0xa5, 0xd4, 0x5f, 0xb8, 0x5a, 0xa1, 0xcb, 0x0b, 0xcb, 0x95,
+	0x47, 0xd1, 0xe4, 0x7a, 0xd2, 0x9d, 0xbc, 0xab, 0x0f, 0xe8, 0x0b, 0x48, 0x70, 0xbb, 0xe6, 0x1b,
+	0x17, 0x54, 0xc3, 0x98, 0xa1, 0x23, 0xc6, 0xdf, 0x08, 0xd0, 0x66, 0x42, 0x14, 0xf3, 0xd6, 0x7c,
+	0x5c, 0x39, 0xf9, 0xb7, 0x72, 0x06, 0xa3, 0xfa, 0xc9, 0x76, 0xd4, 0x16, 0x76, 0xb9, 0xfa, 0xc7,
+	0xb9, 0x5e, 0xc1, 0x59, 0x18, 0x5b, 0xe4, 0xb2, 0xac, 0x4a, 0xdf, 0xd6, 0x1f, 0xa9, 0xf7, 0x81,
+	0x09, 0x3d, 0xe2, 0xd6, 0x94, 0x16, 0x5d, 0xe8, 0x71, 0x58, 0xf7, 0xd8, 0x30, 0x73, 0x7f, 0xfd,
+	0xf6, 0xc7, 0x3e, 0x25, 0xf7, 0xfb, 0x94, 0xfc, 0xde, 0xa7, 0xe4, 0xeb, 0x21, 0xed, 0xdd, 0x1f,
+	0xd2, 0xde, 0xcf, 0x43, 0xda, 0xfb, 0xf4, 0xec, 0x68, 0x0f, 0xb7, 0x7f, 0x37, 0x31, 0xfe, 0xc6,
+	0xe5, 0x49, 0xdc, 0xbd, 0x37, 0x7f, 0x06, 0x00, 0x6f, 0x62, 0x9b, 0x6c, 0xac, 0x02, 0x00, 0x00,
+}
+
+func (m *ClaimSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PerUserCap != 0 {
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(m.PerUserCap))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DailyBudget != 0 {
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(m.DailyBudget))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimSponsorshipUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimSponsorshipUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimSponsorshipUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exhausted {
+		i--
+		if m.Exhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AllowancesGranted != 0 {
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(m.AllowancesGranted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Spent != 0 {
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(m.Spent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsoredAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SpendLimit != 0 {
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(m.SpendLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintClaimSponsorship(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaimSponsorship(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaimSponsorship(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClaimSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaimSponsorship(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovClaimSponsorship(uint64(l))
+	}
+	if m.DailyBudget != 0 {
+		n += 1 + sovClaimSponsorship(uint64(m.DailyBudget))
+	}
+	if m.PerUserCap != 0 {
+		n += 1 + sovClaimSponsorship(uint64(m.PerUserCap))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovClaimSponsorship(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovClaimSponsorship(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *ClaimSponsorshipUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaimSponsorship(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovClaimSponsorship(uint64(l))
+	}
+	if m.Spent != 0 {
+		n += 1 + sovClaimSponsorship(uint64(m.Spent))
+	}
+	if m.AllowancesGranted != 0 {
+		n += 1 + sovClaimSponsorship(uint64(m.AllowancesGranted))
+	}
+	if m.Exhausted {
+		n += 2
+	}
+	return n
+}
+
+func (m *SponsoredAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovClaimSponsorship(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovClaimSponsorship(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaimSponsorship(uint64(l))
+	}
+	if m.SpendLimit != 0 {
+		n += 1 + sovClaimSponsorship(uint64(m.SpendLimit))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovClaimSponsorship(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovClaimSponsorship(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaimSponsorship(x uint64) (n int) {
+	return sovClaimSponsorship(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClaimSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyBudget", wireType)
+			}
+			m.DailyBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserCap", wireType)
+			}
+			m.PerUserCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerUserCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimSponsorshipUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimSponsorshipUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimSponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			m.Spent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Spent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowancesGranted", wireType)
+			}
+			m.AllowancesGranted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowancesGranted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhausted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exhausted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsoredAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsoredAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsoredAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			m.SpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaimSponsorship(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaimSponsorship
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaimSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaimSponsorship
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaimSponsorship
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaimSponsorship
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaimSponsorship        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaimSponsorship          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaimSponsorship = fmt.Errorf("proto: unexpected end of group")
+)
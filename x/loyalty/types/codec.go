@@ -7,6 +7,70 @@ import (
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetClaimSponsorship{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetFeeToken{},
+		&MsgFundGasPool{},
+		&MsgWithdrawGasPool{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetIBCRateLimit{},
+		&MsgRemoveIBCRateLimit{},
+		&MsgResetIBCRateLimit{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterMerchantICA{},
+		&MsgSendMerchantICATx{},
+		&MsgForwardMerchantTreasury{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetIBCRecorder{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetTokenIBCPolicy{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFreezeAddress{},
+		&MsgUnfreezeAddress{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPauseToken{},
+		&MsgUnpauseToken{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetMintRateLimit{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMintVerifiedTokenBatch{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateMintSchedule{},
+		&MsgCancelMintSchedule{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitAttestation{},
+		&MsgRevokeAttestation{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterMerchant{},
+		&MsgUpdateMerchant{},
+		&MsgDeactivateMerchant{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCancelRecoveryTransfer{},
 	)
@@ -61,6 +125,7 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateAuthorities{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
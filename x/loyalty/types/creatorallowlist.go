@@ -0,0 +1,32 @@
+package types
+
+import "math"
+
+// IsExpired reports whether the entry's expiry has passed at blockTime (unix seconds).
+func (c Creatorallowlist) IsExpired(blockTime int64) bool {
+	return c.ExpiresAt != 0 && blockTime >= 0 && uint64(blockTime) >= c.ExpiresAt
+}
+
+// RemainingTokens returns how many more tokens the entry may register given the
+// creator's usage, and whether the token count is unlimited.
+func (c Creatorallowlist) RemainingTokens(usage CreatorUsage) (uint64, bool) {
+	if c.MaxTokens == 0 {
+		return math.MaxUint64, true
+	}
+	if usage.TokensRegistered >= c.MaxTokens {
+		return 0, false
+	}
+	return c.MaxTokens - usage.TokensRegistered, false
+}
+
+// RemainingSupply returns how much more aggregate max_supply the entry may
+// register given the creator's usage, and whether the aggregate supply is unlimited.
+func (c Creatorallowlist) RemainingSupply(usage CreatorUsage) (uint64, bool) {
+	if c.MaxTotalSupply == 0 {
+		return math.MaxUint64, true
+	}
+	if usage.TotalSupplyRegistered >= c.MaxTotalSupply {
+		return 0, false
+	}
+	return c.MaxTotalSupply - usage.TotalSupplyRegistered, false
+}
@@ -27,6 +27,13 @@ type Creatorallowlist struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// expires_at is the unix time (seconds) after which the entry no longer grants
+	// token creation. Zero means the entry never expires.
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// max_tokens caps how many tokens the address may register. Zero means unlimited.
+	MaxTokens uint64 `protobuf:"varint,5,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// max_total_supply caps the aggregate max_supply of the address's tokens. Zero means unlimited.
+	MaxTotalSupply uint64 `protobuf:"varint,6,opt,name=max_total_supply,json=maxTotalSupply,proto3" json:"max_total_supply,omitempty"`
 }
 
 func (m *Creatorallowlist) Reset()         { *m = Creatorallowlist{} }
@@ -83,8 +90,94 @@ func (m *Creatorallowlist) GetCreator() string {
 	return ""
 }
 
+func (m *Creatorallowlist) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Creatorallowlist) GetMaxTokens() uint64 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *Creatorallowlist) GetMaxTotalSupply() uint64 {
+	if m != nil {
+		return m.MaxTotalSupply
+	}
+	return 0
+}
+
+// CreatorUsage tracks a creator's registered tokens independently of its
+// allowlist entry, so deleting or expiring the entry does not reset the quota.
+type CreatorUsage struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// tokens_registered counts the address's currently registered tokens.
+	TokensRegistered uint64 `protobuf:"varint,2,opt,name=tokens_registered,json=tokensRegistered,proto3" json:"tokens_registered,omitempty"`
+	// total_supply_registered sums max_supply across the address's registered tokens.
+	TotalSupplyRegistered uint64 `protobuf:"varint,3,opt,name=total_supply_registered,json=totalSupplyRegistered,proto3" json:"total_supply_registered,omitempty"`
+}
+
+func (m *CreatorUsage) Reset()         { *m = CreatorUsage{} }
+func (m *CreatorUsage) String() string { return proto.CompactTextString(m) }
+func (*CreatorUsage) ProtoMessage()    {}
+func (*CreatorUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81bd8c3a9cfcfbdb, []int{1}
+}
+func (m *CreatorUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatorUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatorUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatorUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatorUsage.Merge(m, src)
+}
+func (m *CreatorUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatorUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatorUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatorUsage proto.InternalMessageInfo
+
+func (m *CreatorUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CreatorUsage) GetTokensRegistered() uint64 {
+	if m != nil {
+		return m.TokensRegistered
+	}
+	return 0
+}
+
+func (m *CreatorUsage) GetTotalSupplyRegistered() uint64 {
+	if m != nil {
+		return m.TotalSupplyRegistered
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Creatorallowlist)(nil), "tokenchain.loyalty.v1.Creatorallowlist")
+	proto.RegisterType((*CreatorUsage)(nil), "tokenchain.loyalty.v1.CreatorUsage")
 }
 
 func init() {
@@ -92,19 +185,27 @@ func init() {
 }
 
 var fileDescriptor_81bd8c3a9cfcfbdb = []byte{
-	// 180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xc9, 0xaf, 0x4c, 0xcc, 0x29, 0xa9, 0xd4, 0x2f,
-	0x33, 0xd4, 0x4f, 0x2e, 0x4a, 0x4d, 0x2c, 0xc9, 0x2f, 0x4a, 0xcc, 0xc9, 0xc9, 0x2f, 0xcf, 0xc9,
-	0x2c, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x45, 0xa8, 0xd6, 0x83, 0xaa, 0xd6,
-	0x2b, 0x33, 0x54, 0x4a, 0xe0, 0x12, 0x70, 0x46, 0xd3, 0x20, 0x24, 0xc1, 0xc5, 0x9e, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe3, 0x82, 0x64, 0x52,
-	0xf3, 0x12, 0x93, 0x72, 0x52, 0x53, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x82, 0x60, 0x5c, 0x90,
-	0x0c, 0xd4, 0x62, 0x09, 0x66, 0x88, 0x1e, 0x28, 0xd7, 0xc9, 0xe4, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xa4, 0x90, 0x3c, 0x50, 0x01, 0xf7, 0x42, 0x49, 0x65, 0x41, 0x6a,
-	0x71, 0x12, 0x1b, 0xd8, 0xd5, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0xa1, 0x23, 0x34,
-	0xe5, 0x00, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0xfb, 0x40,
+	0x10, 0xc5, 0xbb, 0xff, 0xe6, 0xdf, 0xa6, 0x8b, 0x48, 0x0c, 0x14, 0x17, 0xc1, 0xa5, 0xf4, 0x14,
+	0x50, 0x5a, 0x8a, 0xe2, 0x5d, 0xbd, 0xf5, 0x18, 0xf5, 0xe2, 0x25, 0x4c, 0xdb, 0xa5, 0x06, 0xb7,
+	0xdd, 0xb0, 0x3b, 0xd6, 0xe4, 0x43, 0x08, 0x7e, 0x2c, 0x8f, 0x3d, 0x78, 0xf0, 0x28, 0xed, 0x17,
+	0x91, 0x6e, 0xb6, 0x6d, 0xf0, 0xe0, 0x71, 0xde, 0xef, 0x3d, 0x66, 0x86, 0x47, 0xcf, 0x51, 0x3d,
+	0x8b, 0xf9, 0xf8, 0x09, 0xd2, 0x79, 0x5f, 0xaa, 0x02, 0x24, 0x16, 0xfd, 0xc5, 0xa0, 0x3f, 0xd6,
+	0x02, 0x50, 0x69, 0x90, 0x52, 0xbd, 0xca, 0xd4, 0x60, 0x2f, 0xd3, 0x0a, 0x55, 0xd8, 0xde, 0xbb,
+	0x7b, 0xce, 0xdd, 0x5b, 0x0c, 0xba, 0x9f, 0x84, 0x06, 0xb7, 0xbf, 0x12, 0x21, 0xa3, 0x4d, 0x98,
+	0x4c, 0xb4, 0x30, 0x86, 0x91, 0x0e, 0x89, 0x5a, 0xf1, 0x76, 0xdc, 0x10, 0x31, 0x87, 0x91, 0x14,
+	0x13, 0xf6, 0xaf, 0x43, 0x22, 0x3f, 0xde, 0x8e, 0x1b, 0xe2, 0x36, 0xb3, 0x7a, 0x99, 0x71, 0x63,
+	0x78, 0x4a, 0xa9, 0xc8, 0xb3, 0x54, 0x0b, 0x93, 0x00, 0x32, 0xaf, 0x43, 0x22, 0x2f, 0x6e, 0x39,
+	0xe5, 0x1a, 0x37, 0x78, 0x06, 0x79, 0x62, 0xcf, 0x33, 0xec, 0x7f, 0x89, 0x67, 0x90, 0xdf, 0x5b,
+	0x21, 0x8c, 0x68, 0x50, 0x62, 0x04, 0x99, 0x98, 0x97, 0x2c, 0x93, 0x05, 0x6b, 0x58, 0xd3, 0xa1,
+	0x35, 0x21, 0xc8, 0x3b, 0xab, 0x0e, 0x3d, 0xbf, 0x19, 0xf8, 0x43, 0xcf, 0xf7, 0x83, 0x56, 0xf7,
+	0x8d, 0xd0, 0x03, 0xf7, 0xd6, 0x83, 0x81, 0xa9, 0xf8, 0xe3, 0xa5, 0x33, 0x7a, 0x54, 0xee, 0x4e,
+	0xb4, 0x98, 0xa6, 0x06, 0x85, 0x76, 0xcf, 0x79, 0x71, 0x50, 0x82, 0x78, 0xa7, 0x87, 0x57, 0xf4,
+	0xb8, 0x7a, 0x49, 0x35, 0x52, 0xb7, 0x91, 0x36, 0xee, 0x2f, 0xda, 0xe7, 0x6e, 0x2e, 0x3f, 0x56,
+	0x9c, 0x2c, 0x57, 0x9c, 0x7c, 0xaf, 0x38, 0x79, 0x5f, 0xf3, 0xda, 0x72, 0xcd, 0x6b, 0x5f, 0x6b,
+	0x5e, 0x7b, 0x3c, 0xa9, 0xb4, 0x98, 0xef, 0x7a, 0xc4, 0x22, 0x13, 0x66, 0xd4, 0xb0, 0xd5, 0x5d,
+	0xfc, 0x0c, 0x00, 0xaf, 0xd1, 0x67, 0x28, 0xea, 0x01, 0x00, 0x00,
 }
 
 func (m *Creatorallowlist) Marshal() (dAtA []byte, err error) {
@@ -127,6 +228,21 @@ func (m *Creatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTotalSupply != 0 {
+		i = encodeVarintCreatorallowlist(dAtA, i, uint64(m.MaxTotalSupply))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTokens != 0 {
+		i = encodeVarintCreatorallowlist(dAtA, i, uint64(m.MaxTokens))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintCreatorallowlist(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -154,6 +270,46 @@ func (m *Creatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreatorUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatorUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatorUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSupplyRegistered != 0 {
+		i = encodeVarintCreatorallowlist(dAtA, i, uint64(m.TotalSupplyRegistered))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TokensRegistered != 0 {
+		i = encodeVarintCreatorallowlist(dAtA, i, uint64(m.TokensRegistered))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCreatorallowlist(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCreatorallowlist(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCreatorallowlist(v)
 	base := offset
@@ -182,6 +338,34 @@ func (m *Creatorallowlist) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCreatorallowlist(uint64(l))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovCreatorallowlist(uint64(m.ExpiresAt))
+	}
+	if m.MaxTokens != 0 {
+		n += 1 + sovCreatorallowlist(uint64(m.MaxTokens))
+	}
+	if m.MaxTotalSupply != 0 {
+		n += 1 + sovCreatorallowlist(uint64(m.MaxTotalSupply))
+	}
+	return n
+}
+
+func (m *CreatorUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCreatorallowlist(uint64(l))
+	}
+	if m.TokensRegistered != 0 {
+		n += 1 + sovCreatorallowlist(uint64(m.TokensRegistered))
+	}
+	if m.TotalSupplyRegistered != 0 {
+		n += 1 + sovCreatorallowlist(uint64(m.TotalSupplyRegistered))
+	}
 	return n
 }
 
@@ -304,6 +488,183 @@ func (m *Creatorallowlist) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreatorallowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			m.MaxTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreatorallowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			}
+			m.MaxTotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreatorallowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCreatorallowlist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCreatorallowlist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatorUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCreatorallowlist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatorUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatorUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreatorallowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreatorallowlist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreatorallowlist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensRegistered", wireType)
+			}
+			m.TokensRegistered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreatorallowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokensRegistered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyRegistered", wireType)
+			}
+			m.TotalSupplyRegistered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreatorallowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSupplyRegistered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCreatorallowlist(dAtA[iNdEx:])
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// LegacyTokenDecimals is the display exponent every token used before decimals
+	// became configurable.
+	LegacyTokenDecimals uint32 = 6
+	// MaxTokenDecimals bounds the display exponent and every extra unit exponent.
+	MaxTokenDecimals uint32 = 18
+	// MaxTokenDenomUnits bounds the extra denom units a token may publish.
+	MaxTokenDenomUnits = 4
+)
+
+// ValidateTokenDenomUnits checks a token's decimals and extra denom units against the
+// bank metadata rules for its base and display units.
+func ValidateTokenDenomUnits(token Verifiedtoken) error {
+	_, _, err := tokenDenomUnits(token)
+	return err
+}
+
+// TokenBankMetadata builds the bank denom metadata of a verified token.
+func TokenBankMetadata(token Verifiedtoken) (banktypes.Metadata, error) {
+	units, display, err := tokenDenomUnits(token)
+	if err != nil {
+		return banktypes.Metadata{}, err
+	}
+	metadata := banktypes.Metadata{
+		Description: token.Description,
+		DenomUnits:  units,
+		Base:        token.Denom,
+		Display:     display,
+		Name:        token.Name,
+		Symbol:      token.Symbol,
+		URI:         token.Website,
+	}
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, err
+	}
+	return metadata, nil
+}
+
+// tokenDenomUnits returns the token's bank denom units sorted by exponent, and its
+// display denom. The subdenom is the display unit at token.Decimals; with zero decimals
+// it becomes an alias of the base unit instead, since bank metadata requires strictly
+// increasing exponents.
+func tokenDenomUnits(token Verifiedtoken) ([]*banktypes.DenomUnit, string, error) {
+	subdenom := token.Denom[strings.LastIndex(token.Denom, "/")+1:]
+	if subdenom == "" {
+		return nil, "", fmt.Errorf("denom %q has no subdenom", token.Denom)
+	}
+	if token.Decimals > MaxTokenDecimals {
+		return nil, "", fmt.Errorf("decimals cannot exceed %d", MaxTokenDecimals)
+	}
+	if len(token.DenomUnits) > MaxTokenDenomUnits {
+		return nil, "", fmt.Errorf("at most %d extra denom units are allowed", MaxTokenDenomUnits)
+	}
+
+	base := &banktypes.DenomUnit{Denom: token.Denom, Exponent: 0}
+	units := []*banktypes.DenomUnit{base}
+	display := subdenom
+	if token.Decimals == 0 {
+		if subdenom != token.Denom {
+			base.Aliases = []string{subdenom}
+		}
+		display = token.Denom
+	} else {
+		units = append(units, &banktypes.DenomUnit{Denom: subdenom, Exponent: token.Decimals})
+	}
+	for _, unit := range token.DenomUnits {
+		if unit.Exponent == 0 || unit.Exponent > MaxTokenDecimals {
+			return nil, "", fmt.Errorf("denom unit %s exponent must be between 1 and %d", unit.Denom, MaxTokenDecimals)
+		}
+		units = append(units, &banktypes.DenomUnit{Denom: unit.Denom, Exponent: unit.Exponent, Aliases: unit.Aliases})
+	}
+	slices.SortStableFunc(units, func(a, b *banktypes.DenomUnit) int {
+		return int(a.Exponent) - int(b.Exponent)
+	})
+
+	// Denoms and aliases share one namespace so clients can resolve any of them.
+	seen := make(map[string]bool)
+	for i, unit := range units {
+		if i > 0 && unit.Exponent == units[i-1].Exponent {
+			return nil, "", fmt.Errorf("denom units %s and %s share exponent %d", units[i-1].Denom, unit.Denom, unit.Exponent)
+		}
+		if err := unit.Validate(); err != nil {
+			return nil, "", err
+		}
+		for _, name := range append([]string{unit.Denom}, unit.Aliases...) {
+			if seen[name] {
+				return nil, "", fmt.Errorf("duplicate denom unit or alias %s", name)
+			}
+			seen[name] = true
+		}
+	}
+	return units, display, nil
+}
+
+// DenomUnitsEqual reports whether two extra denom unit lists are identical.
+func DenomUnitsEqual(a, b []TokenDenomUnit) bool {
+	return slices.EqualFunc(a, b, func(x, y TokenDenomUnit) bool {
+		return x.Denom == y.Denom && x.Exponent == y.Exponent && slices.Equal(x.Aliases, y.Aliases)
+	})
+}
+
+// ParseTokenAmount converts a display-unit amount such as "12.5" into base units for a
+// token with the given decimals. It rejects more fractional digits than decimals allows.
+func ParseTokenAmount(amount string, decimals uint32) (uint64, error) {
+	whole, frac, hasFrac := strings.Cut(strings.TrimSpace(amount), ".")
+	if whole == "" && (!hasFrac || frac == "") {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	if uint32(len(frac)) > decimals {
+		return 0, fmt.Errorf("amount %q has more than %d decimal places", amount, decimals)
+	}
+	digits := whole + frac + strings.Repeat("0", int(decimals)-len(frac))
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid amount %q", amount)
+		}
+	}
+	value, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("amount %q is out of range", amount)
+	}
+	return value, nil
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func TestParseTokenAmount(t *testing.T) {
+	for _, tc := range []struct {
+		amount   string
+		decimals uint32
+		expected uint64
+		valid    bool
+	}{
+		{amount: "12", decimals: 0, expected: 12, valid: true},
+		{amount: "12.5", decimals: 2, expected: 1250, valid: true},
+		{amount: "0.000001", decimals: 6, expected: 1, valid: true},
+		{amount: ".5", decimals: 1, expected: 5, valid: true},
+		{amount: "3.", decimals: 2, expected: 300, valid: true},
+		{amount: "1.5", decimals: 0},
+		{amount: "1.234", decimals: 2},
+		{amount: "-1", decimals: 2},
+		{amount: "1e3", decimals: 2},
+		{amount: ".", decimals: 2},
+		{amount: "", decimals: 2},
+		{amount: "18446744073709551616", decimals: 0},
+	} {
+		t.Run(tc.amount, func(t *testing.T) {
+			got, err := types.ParseTokenAmount(tc.amount, tc.decimals)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -8,23 +8,48 @@ import (
 
 // x/loyalty module sentinel errors
 var (
-	ErrInvalidSigner          = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrInvalidCreationMode    = errors.Register(ModuleName, 1101, "invalid token creation mode")
-	ErrCreatorNotAllowed      = errors.Register(ModuleName, 1102, "creator is not allowed to create tokens")
-	ErrTokenExists            = errors.Register(ModuleName, 1103, "token already exists")
-	ErrTokenNotFound          = errors.Register(ModuleName, 1104, "token not found")
-	ErrInvalidDenom           = errors.Register(ModuleName, 1105, "invalid denom")
-	ErrCapExceeded            = errors.Register(ModuleName, 1106, "mint would exceed max supply cap")
-	ErrInvalidCap             = errors.Register(ModuleName, 1107, "invalid max supply cap")
-	ErrRecoveryPolicy         = errors.Register(ModuleName, 1108, "invalid admin recovery policy")
-	ErrAccrualNotFound        = errors.Register(ModuleName, 1109, "reward accrual not found")
-	ErrRecoveryUnauthorized   = errors.Register(ModuleName, 1110, "recovery action unauthorized")
-	ErrRecoveryNotQueued      = errors.Register(ModuleName, 1111, "recovery operation is not queued")
-	ErrRecoveryTooEarly       = errors.Register(ModuleName, 1112, "recovery operation timelock not elapsed")
-	ErrRecoveryBadRequest     = errors.Register(ModuleName, 1113, "invalid recovery operation request")
-	ErrRewardPoolInsufficient = errors.Register(ModuleName, 1114, "reward pool balance is insufficient for claim")
-	ErrAdminRenounced         = errors.Register(ModuleName, 1115, "token admin has been renounced")
-	ErrAdminRenouncePolicy    = errors.Register(ModuleName, 1116, "token admin renounce is not allowed with recovery-enabled policy")
-	ErrAccrualOverflow        = errors.Register(ModuleName, 1117, "reward accrual amount overflow")
-	ErrMerchantRouting        = errors.Register(ModuleName, 1118, "invalid merchant incentive routing configuration")
+	ErrInvalidSigner           = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidCreationMode     = errors.Register(ModuleName, 1101, "invalid token creation mode")
+	ErrCreatorNotAllowed       = errors.Register(ModuleName, 1102, "creator is not allowed to create tokens")
+	ErrTokenExists             = errors.Register(ModuleName, 1103, "token already exists")
+	ErrTokenNotFound           = errors.Register(ModuleName, 1104, "token not found")
+	ErrInvalidDenom            = errors.Register(ModuleName, 1105, "invalid denom")
+	ErrCapExceeded             = errors.Register(ModuleName, 1106, "mint would exceed max supply cap")
+	ErrInvalidCap              = errors.Register(ModuleName, 1107, "invalid max supply cap")
+	ErrRecoveryPolicy          = errors.Register(ModuleName, 1108, "invalid admin recovery policy")
+	ErrAccrualNotFound         = errors.Register(ModuleName, 1109, "reward accrual not found")
+	ErrRecoveryUnauthorized    = errors.Register(ModuleName, 1110, "recovery action unauthorized")
+	ErrRecoveryNotQueued       = errors.Register(ModuleName, 1111, "recovery operation is not queued")
+	ErrRecoveryTooEarly        = errors.Register(ModuleName, 1112, "recovery operation timelock not elapsed")
+	ErrRecoveryBadRequest      = errors.Register(ModuleName, 1113, "invalid recovery operation request")
+	ErrRewardPoolInsufficient  = errors.Register(ModuleName, 1114, "reward pool balance is insufficient for claim")
+	ErrAdminRenounced          = errors.Register(ModuleName, 1115, "token admin has been renounced")
+	ErrAdminRenouncePolicy     = errors.Register(ModuleName, 1116, "token admin renounce is not allowed with recovery-enabled policy")
+	ErrAccrualOverflow         = errors.Register(ModuleName, 1117, "reward accrual amount overflow")
+	ErrMerchantRouting         = errors.Register(ModuleName, 1118, "invalid merchant incentive routing configuration")
+	ErrInvalidAuthorities      = errors.Register(ModuleName, 1119, "invalid loyalty authorities")
+	ErrCreatorQuotaExceeded    = errors.Register(ModuleName, 1120, "creator allowlist quota exceeded")
+	ErrMerchantNotFound        = errors.Register(ModuleName, 1121, "merchant not found")
+	ErrMerchantInactive        = errors.Register(ModuleName, 1122, "merchant is deactivated")
+	ErrInvalidMerchant         = errors.Register(ModuleName, 1123, "invalid merchant profile")
+	ErrAttestationNotFound     = errors.Register(ModuleName, 1124, "attestation not found")
+	ErrInvalidAttestation      = errors.Register(ModuleName, 1125, "invalid attestation")
+	ErrInvalidDenomUnits       = errors.Register(ModuleName, 1126, "invalid token denom units")
+	ErrMintScheduleNotFound    = errors.Register(ModuleName, 1127, "mint schedule not found")
+	ErrInvalidMintSchedule     = errors.Register(ModuleName, 1128, "invalid mint schedule")
+	ErrMintRateLimited         = errors.Register(ModuleName, 1129, "mint rate limit exceeded")
+	ErrTokenPaused             = errors.Register(ModuleName, 1130, "token is paused")
+	ErrInvalidPause            = errors.Register(ModuleName, 1131, "invalid token pause")
+	ErrAddressFrozen           = errors.Register(ModuleName, 1132, "address is frozen for this token")
+	ErrInvalidFreeze           = errors.Register(ModuleName, 1133, "invalid address freeze")
+	ErrIBCTransferBlocked      = errors.Register(ModuleName, 1134, "ibc transfer blocked by token policy")
+	ErrInvalidIBCPolicy        = errors.Register(ModuleName, 1135, "invalid token ibc policy")
+	ErrIBCRecorderUnauthorized = errors.Register(ModuleName, 1136, "ibc memo sender is not a recorder for this channel")
+	ErrInvalidIBCMemo          = errors.Register(ModuleName, 1137, "invalid loyalty ibc memo")
+	ErrInvalidMerchantICA      = errors.Register(ModuleName, 1138, "invalid merchant interchain account operation")
+	ErrIBCRateLimited          = errors.Register(ModuleName, 1139, "ibc rate limit exceeded")
+	ErrInvalidIBCRateLimit     = errors.Register(ModuleName, 1140, "invalid ibc rate limit")
+	ErrInvalidFeeToken         = errors.Register(ModuleName, 1141, "invalid fee token")
+	ErrFeeNotSponsored         = errors.Register(ModuleName, 1142, "fee cannot be paid with this token")
+	ErrInvalidClaimSponsorship = errors.Register(ModuleName, 1143, "invalid claim sponsorship")
 )
@@ -1,8 +1,10 @@
 package types
 
 const (
-	EventTypeDailyRollup = "loyalty_daily_rollup"
+	EventTypeDailyRollup             = "loyalty_daily_rollup"
+	EventTypeCreatorallowlistExpired = "loyalty_creatorallowlist_expired"
 
 	AttributeKeyDate     = "date"
 	AttributeKeyTimezone = "timezone"
+	AttributeKeyAddress  = "address"
 )
@@ -4,9 +4,13 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	grouptypes "github.com/cosmos/cosmos-sdk/x/group"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,9 +32,14 @@ type AuthKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins
 	MintCoins(context.Context, string, sdk.Coins) error
 	SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error
 	SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error
+	SendCoinsFromModuleToModule(context.Context, string, string, sdk.Coins) error
+	InputOutputCoins(context.Context, banktypes.Input, []banktypes.Output) error
+	BlockedAddr(sdk.AccAddress) bool
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 	SetDenomMetaData(context.Context, banktypes.Metadata)
 	// Methods imported from bank should be defined here
 }
@@ -40,6 +49,41 @@ type GroupKeeper interface {
 	GroupPolicyInfo(context.Context, *grouptypes.QueryGroupPolicyInfoRequest) (*grouptypes.QueryGroupPolicyInfoResponse, error)
 }
 
+// CircuitKeeper defines the expected interface for the Circuit module. Accounts that may
+// trip the circuit breaker for a message may also pause single verified tokens.
+type CircuitKeeper interface {
+	CanTrip(ctx context.Context, addr sdk.AccAddress, msgTypeURL string) (bool, error)
+}
+
+// FeeGrantKeeper defines the expected interface for the x/feegrant module. Claim
+// sponsorships grant, extend and revoke allowances through it.
+type FeeGrantKeeper interface {
+	GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	RevokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error
+}
+
+// ICAControllerKeeper defines the expected interface for the interchain accounts
+// controller submodule. Merchant accounts use its legacy API so that channel and
+// packet callbacks reach the loyalty module.
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string, ordering channeltypes.Order) error
+	SendTx(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetConnectionID(ctx sdk.Context, portID, channelID string) (string, error)
+}
+
+// TransferKeeper defines the expected interface for the ICS-20 transfer module.
+type TransferKeeper interface {
+	Transfer(context.Context, *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// ChannelKeeper defines the expected interface for the IBC channel keeper.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
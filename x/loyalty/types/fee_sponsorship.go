@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// GasPoolName is the module account holding the staking denom of every gas pool.
+	GasPoolName = "loyalty_gas_pool"
+
+	// MaxFeeTokenMsgTypes bounds the message types one fee token or claim
+	// sponsorship may pay for.
+	MaxFeeTokenMsgTypes = 16
+)
+
+// DefaultFeeTokenMsgTypes are the messages a fee token pays for when it lists none:
+// reward claims and transfers.
+var DefaultFeeTokenMsgTypes = []string{
+	"/tokenchain.loyalty.v1.MsgClaimReward",
+	"/cosmos.bank.v1beta1.MsgSend",
+}
+
+// Validate checks the rate and message types of a fee token.
+func (t FeeToken) Validate() error {
+	if t.Denom == "" {
+		return fmt.Errorf("denom is required")
+	}
+	if t.TokenUnits == 0 || t.UtokenUnits == 0 {
+		return fmt.Errorf("token_units and utoken_units must be positive")
+	}
+	return validateMsgTypeURLs(t.MsgTypes)
+}
+
+// validateMsgTypeURLs checks a bounded list of distinct message type URLs.
+func validateMsgTypeURLs(msgTypes []string) error {
+	if len(msgTypes) > MaxFeeTokenMsgTypes {
+		return fmt.Errorf("at most %d message types", MaxFeeTokenMsgTypes)
+	}
+	for i, msgType := range msgTypes {
+		if !strings.HasPrefix(msgType, "/") || strings.TrimSpace(msgType) != msgType {
+			return fmt.Errorf("invalid message type url %q", msgType)
+		}
+		if slices.Contains(msgTypes[:i], msgType) {
+			return fmt.Errorf("duplicated message type %s", msgType)
+		}
+	}
+	return nil
+}
+
+// PaysFor reports whether the token may pay the fee of a message of msgType.
+func (t FeeToken) PaysFor(msgType string) bool {
+	if len(t.MsgTypes) == 0 {
+		return slices.Contains(DefaultFeeTokenMsgTypes, msgType)
+	}
+	return slices.Contains(t.MsgTypes, msgType)
+}
+
+// Convert returns the staking denom amount paid for a fee of amount tokens,
+// rounded down.
+func (t FeeToken) Convert(amount sdkmath.Int) sdkmath.Int {
+	return amount.Mul(sdkmath.NewIntFromUint64(t.UtokenUnits)).Quo(sdkmath.NewIntFromUint64(t.TokenUnits))
+}
@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/fee_sponsorship.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeToken lets holders of a verified token pay transaction fees with it. The
+// token's gas pool pays the fee in the staking denom and the tokens paid go to
+// the token owner.
+type FeeToken struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// token_units of the token pay for utoken_units of the staking denom.
+	TokenUnits  uint64 `protobuf:"varint,2,opt,name=token_units,json=tokenUnits,proto3" json:"token_units,omitempty"`
+	UtokenUnits uint64 `protobuf:"varint,3,opt,name=utoken_units,json=utokenUnits,proto3" json:"utoken_units,omitempty"`
+	// max_utoken_per_tx caps the fee the gas pool pays for one transaction; zero
+	// leaves it uncapped.
+	MaxUtokenPerTx uint64 `protobuf:"varint,4,opt,name=max_utoken_per_tx,json=maxUtokenPerTx,proto3" json:"max_utoken_per_tx,omitempty"`
+	// msg_types are the message type URLs whose fees may be paid with the token.
+	// The default set (reward claims and bank sends) applies when empty.
+	MsgTypes []string `protobuf:"bytes,5,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// enabled is controlled by the module authority.
+	Enabled   bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	UpdatedBy string `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt uint64 `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9736bda9094b5272, []int{0}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetTokenUnits() uint64 {
+	if m != nil {
+		return m.TokenUnits
+	}
+	return 0
+}
+
+func (m *FeeToken) GetUtokenUnits() uint64 {
+	if m != nil {
+		return m.UtokenUnits
+	}
+	return 0
+}
+
+func (m *FeeToken) GetMaxUtokenPerTx() uint64 {
+	if m != nil {
+		return m.MaxUtokenPerTx
+	}
+	return 0
+}
+
+func (m *FeeToken) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *FeeToken) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeToken) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func (m *FeeToken) GetUpdatedAt() uint64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// GasPool is the staking denom set aside to pay the fees of a fee token. Its funds
+// are held by the loyalty_gas_pool module account.
+type GasPool struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Balance uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// sponsored is the total the pool has paid in fees, over sponsored_txs
+	// transactions.
+	Sponsored    uint64 `protobuf:"varint,3,opt,name=sponsored,proto3" json:"sponsored,omitempty"`
+	SponsoredTxs uint64 `protobuf:"varint,4,opt,name=sponsored_txs,json=sponsoredTxs,proto3" json:"sponsored_txs,omitempty"`
+}
+
+func (m *GasPool) Reset()         { *m = GasPool{} }
+func (m *GasPool) String() string { return proto.CompactTextString(m) }
+func (*GasPool) ProtoMessage()    {}
+func (*GasPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9736bda9094b5272, []int{1}
+}
+func (m *GasPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPool.Merge(m, src)
+}
+func (m *GasPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPool proto.InternalMessageInfo
+
+func (m *GasPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GasPool) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *GasPool) GetSponsored() uint64 {
+	if m != nil {
+		return m.Sponsored
+	}
+	return 0
+}
+
+func (m *GasPool) GetSponsoredTxs() uint64 {
+	if m != nil {
+		return m.SponsoredTxs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeeToken)(nil), "tokenchain.loyalty.v1.FeeToken")
+	proto.RegisterType((*GasPool)(nil), "tokenchain.loyalty.v1.GasPool")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/fee_sponsorship.proto", fileDescriptor_9736bda9094b5272)
+}
+
+var fileDescriptor_9736bda9094b5272 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0x29, 0x7f, 0x6d, 0x0f, 0x7c, 0x5f, 0xe2, 0x44, 0x93, 0x89, 0x3f, 0xb5, 0xe2, 0xa6,
+	0xc6, 0x04, 0x42, 0xf4, 0x06, 0x64, 0xa1, 0x5b, 0xd2, 0x94, 0x8d, 0x9b, 0x66, 0x4a, 0x47, 0x20,
+	0xb6, 0x9d, 0xa6, 0x33, 0x25, 0x6d, 0xe2, 0xd2, 0x0b, 0xf0, 0xb2, 0x5c, 0xb2, 0x74, 0x69, 0xe0,
+	0x46, 0x0c, 0xe3, 0x00, 0xdd, 0xb8, 0x7c, 0x9f, 0xf3, 0x4c, 0x26, 0xe7, 0x3d, 0x70, 0x2b, 0xd8,
+	0x2b, 0x4d, 0xa6, 0x73, 0xb2, 0x48, 0x06, 0x11, 0x2b, 0x49, 0x24, 0xca, 0xc1, 0x72, 0x38, 0x78,
+	0xa1, 0xd4, 0xe7, 0x29, 0x4b, 0x38, 0xcb, 0xf8, 0x7c, 0x91, 0xf6, 0xd3, 0x8c, 0x09, 0x86, 0x4e,
+	0x0e, 0x72, 0x5f, 0xc9, 0xfd, 0xe5, 0xb0, 0xf7, 0x5e, 0x07, 0xe3, 0x91, 0x52, 0x6f, 0x3b, 0x44,
+	0xc7, 0xd0, 0x0a, 0x69, 0xc2, 0x62, 0xac, 0xd9, 0x9a, 0x63, 0xba, 0xbf, 0x01, 0x5d, 0x42, 0x47,
+	0xbe, 0xf5, 0xf3, 0x64, 0x21, 0x38, 0xae, 0xdb, 0x9a, 0xd3, 0x74, 0x41, 0xa2, 0xc9, 0x96, 0xa0,
+	0x2b, 0xe8, 0xe6, 0x55, 0xa3, 0x21, 0x8d, 0x4e, 0x5e, 0x51, 0x6e, 0xe0, 0x28, 0x26, 0x85, 0xaf,
+	0xb4, 0x94, 0x66, 0xbe, 0x28, 0x70, 0x53, 0x7a, 0xff, 0x63, 0x52, 0x4c, 0x24, 0x1f, 0xd3, 0xcc,
+	0x2b, 0xd0, 0x19, 0x98, 0x31, 0x9f, 0xf9, 0xa2, 0x4c, 0x29, 0xc7, 0x2d, 0xbb, 0xe1, 0x98, 0xae,
+	0x11, 0xf3, 0x99, 0xb7, 0xcd, 0x08, 0x83, 0x4e, 0x13, 0x12, 0x44, 0x34, 0xc4, 0x6d, 0x5b, 0x73,
+	0x0c, 0x77, 0x17, 0xd1, 0x05, 0x40, 0x9e, 0x86, 0x44, 0xd0, 0xd0, 0x0f, 0x4a, 0xac, 0xcb, 0x05,
+	0x4c, 0x45, 0x46, 0x65, 0x75, 0x4c, 0x04, 0x36, 0xe4, 0xcf, 0xbb, 0xf1, 0x83, 0xe8, 0xbd, 0x81,
+	0xfe, 0x44, 0xf8, 0x98, 0xb1, 0xe8, 0x8f, 0x12, 0x30, 0xe8, 0x01, 0x89, 0x48, 0x32, 0xa5, 0xaa,
+	0x80, 0x5d, 0x44, 0xe7, 0x60, 0xaa, 0xb6, 0x69, 0xa8, 0x56, 0x3f, 0x00, 0x74, 0x0d, 0xff, 0xf6,
+	0xc1, 0x17, 0x05, 0x57, 0x4b, 0x77, 0xf7, 0xd0, 0x2b, 0xf8, 0xe8, 0xfe, 0x73, 0x6d, 0x69, 0xab,
+	0xb5, 0xa5, 0x7d, 0xaf, 0x2d, 0xed, 0x63, 0x63, 0xd5, 0x56, 0x1b, 0xab, 0xf6, 0xb5, 0xb1, 0x6a,
+	0xcf, 0xa7, 0x95, 0x13, 0x17, 0xfb, 0x23, 0xcb, 0x6e, 0x82, 0xb6, 0x3c, 0xec, 0xdd, 0xcf, 0x00,
+	0x73, 0x0d, 0xcf, 0xb5, 0x07, 0x02, 0x00, 0x00,
+}
+
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintFeeSponsorship(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxUtokenPerTx != 0 {
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(m.MaxUtokenPerTx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UtokenUnits != 0 {
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(m.UtokenUnits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TokenUnits != 0 {
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(m.TokenUnits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SponsoredTxs != 0 {
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(m.SponsoredTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sponsored != 0 {
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(m.Sponsored))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Balance != 0 {
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeSponsorship(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeSponsorship(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeSponsorship(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeSponsorship(uint64(l))
+	}
+	if m.TokenUnits != 0 {
+		n += 1 + sovFeeSponsorship(uint64(m.TokenUnits))
+	}
+	if m.UtokenUnits != 0 {
+		n += 1 + sovFeeSponsorship(uint64(m.UtokenUnits))
+	}
+	if m.MaxUtokenPerTx != 0 {
+		n += 1 + sovFeeSponsorship(uint64(m.MaxUtokenPerTx))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovFeeSponsorship(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovFeeSponsorship(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovFeeSponsorship(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *GasPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeSponsorship(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovFeeSponsorship(uint64(m.Balance))
+	}
+	if m.Sponsored != 0 {
+		n += 1 + sovFeeSponsorship(uint64(m.Sponsored))
+	}
+	if m.SponsoredTxs != 0 {
+		n += 1 + sovFeeSponsorship(uint64(m.SponsoredTxs))
+	}
+	return n
+}
+
+func sovFeeSponsorship(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeSponsorship(x uint64) (n int) {
+	return sovFeeSponsorship(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUnits", wireType)
+			}
+			m.TokenUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtokenUnits", wireType)
+			}
+			m.UtokenUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtokenUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUtokenPerTx", wireType)
+			}
+			m.MaxUtokenPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUtokenPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsored", wireType)
+			}
+			m.Sponsored = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sponsored |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredTxs", wireType)
+			}
+			m.SponsoredTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SponsoredTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeSponsorship(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeSponsorship
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeSponsorship
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeSponsorship
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeSponsorship
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeSponsorship        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeSponsorship          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeSponsorship = fmt.Errorf("proto: unexpected end of group")
+)
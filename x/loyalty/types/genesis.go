@@ -8,13 +8,42 @@ import (
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:                 DefaultParams(),
-		CreatorallowlistMap:    []Creatorallowlist{},
-		VerifiedtokenMap:       []Verifiedtoken{},
-		RewardaccrualMap:       []Rewardaccrual{},
-		MerchantallocationMap:  []Merchantallocation{},
-		RecoveryoperationList:  []Recoveryoperation{},
-		RecoveryoperationCount: 0,
+		Params:                    DefaultParams(),
+		CreatorallowlistMap:       []Creatorallowlist{},
+		VerifiedtokenMap:          []Verifiedtoken{},
+		RewardaccrualMap:          []Rewardaccrual{},
+		MerchantallocationMap:     []Merchantallocation{},
+		RecoveryoperationList:     []Recoveryoperation{},
+		RecoveryoperationCount:    0,
+		Authorities:               Authorities{},
+		MerchantList:              []Merchant{},
+		MerchantCount:             0,
+		AttestationList:           []Attestation{},
+		AttestationHistory:        []AttestationRecord{},
+		PendingMetadataChangeList: []PendingMetadataChange{},
+		MetadataVersionList:       []MetadataVersion{},
+		MintScheduleList:          []MintSchedule{},
+		MintScheduleCount:         0,
+		MintRateLimitList:         []MintRateLimit{},
+		PendingMintRateLimitList:  []PendingMintRateLimit{},
+		MintUsageList:             []MintUsage{},
+		TokenPauseList:            []TokenPause{},
+		AddressFreezeList:         []AddressFreeze{},
+		TokenIbcPolicyList:        []TokenIBCPolicy{},
+		IbcEscrowList:             []IBCEscrow{},
+		IbcRecorderList:           []IBCRecorder{},
+		MerchantIcaList:           []MerchantICA{},
+		MerchantIcaPacketList:     []MerchantICAPacket{},
+		TreasuryForwardList:       []TreasuryForward{},
+		IbcRateLimitList:          []IBCRateLimit{},
+		IbcRateLimitFlowList:      []IBCRateLimitFlow{},
+		FeeTokenList:              []FeeToken{},
+		GasPoolList:               []GasPool{},
+		ClaimSponsorshipList:      []ClaimSponsorship{},
+		ClaimSponsorshipUsageList: []ClaimSponsorshipUsage{},
+		SponsoredAllowanceList:    []SponsoredAllowance{},
+		LiabilityCheckpointList:   []LiabilityCheckpoint{},
+		CreatorUsageList:          []CreatorUsage{},
 	}
 }
 
@@ -30,6 +59,13 @@ func (gs GenesisState) Validate() error {
 		}
 		creatorallowlistIndexMap[index] = struct{}{}
 	}
+	creatorUsageIndexMap := make(map[string]struct{})
+	for _, elem := range gs.CreatorUsageList {
+		if _, ok := creatorUsageIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated creator usage %s", elem.Address)
+		}
+		creatorUsageIndexMap[elem.Address] = struct{}{}
+	}
 	verifiedtokenIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.VerifiedtokenMap {
@@ -37,6 +73,9 @@ func (gs GenesisState) Validate() error {
 		if _, ok := verifiedtokenIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for verifiedtoken")
 		}
+		if err := ValidateTokenDenomUnits(elem); err != nil {
+			return fmt.Errorf("invalid denom units for verifiedtoken %s: %w", elem.Denom, err)
+		}
 		verifiedtokenIndexMap[index] = struct{}{}
 	}
 	rewardaccrualIndexMap := make(map[string]struct{})
@@ -78,5 +117,347 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	merchantIdMap := make(map[uint64]bool)
+	for _, elem := range gs.MerchantList {
+		if _, ok := merchantIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for merchant")
+		}
+		if elem.Id == 0 || elem.Id > gs.MerchantCount {
+			return fmt.Errorf("merchant id should be between 1 and the merchant count")
+		}
+		if err := ValidateMerchantProfile(elem.LegalName, elem.ContactUri, elem.LogoHash); err != nil {
+			return fmt.Errorf("invalid merchant %d: %w", elem.Id, err)
+		}
+		if err := ValidateMerchantTier(elem.VerificationTier); err != nil {
+			return fmt.Errorf("invalid merchant %d: %w", elem.Id, err)
+		}
+		merchantIdMap[elem.Id] = true
+	}
+	for _, elem := range gs.VerifiedtokenMap {
+		if elem.MerchantId != 0 && !merchantIdMap[elem.MerchantId] {
+			return fmt.Errorf("verifiedtoken %s references unknown merchant %d", elem.Denom, elem.MerchantId)
+		}
+	}
+
+	attestationIndexMap := make(map[string]struct{})
+	attestationCounts := make(map[string]uint32)
+	for _, elem := range gs.AttestationList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("attestation references unknown verifiedtoken %s", elem.Denom)
+		}
+		index := elem.Denom + "|" + elem.Verifier
+		if _, ok := attestationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated attestation by %s for %s", elem.Verifier, elem.Denom)
+		}
+		if err := ValidateEvidenceURI(elem.EvidenceUri); err != nil {
+			return fmt.Errorf("invalid attestation for %s: %w", elem.Denom, err)
+		}
+		attestationIndexMap[index] = struct{}{}
+		attestationCounts[elem.Denom]++
+	}
+	for _, elem := range gs.VerifiedtokenMap {
+		if elem.Verified && attestationCounts[elem.Denom] < gs.Params.MinVerifierAttestations {
+			return fmt.Errorf("verifiedtoken %s is marked verified without enough attestations", elem.Denom)
+		}
+	}
+	attestationHistoryIdMap := make(map[uint64]bool)
+	for _, elem := range gs.AttestationHistory {
+		if _, ok := attestationHistoryIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for attestation history")
+		}
+		if elem.Id >= gs.AttestationHistoryCount {
+			return fmt.Errorf("attestation history id should be lower than the history count")
+		}
+		attestationHistoryIdMap[elem.Id] = true
+	}
+
+	pendingMetadataIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingMetadataChangeList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("pending metadata change references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, ok := pendingMetadataIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated pending metadata change for %s", elem.Denom)
+		}
+		pendingMetadataIndexMap[elem.Denom] = struct{}{}
+	}
+	metadataVersionIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MetadataVersionList {
+		if elem.Version == 0 {
+			return fmt.Errorf("metadata version for %s must start at 1", elem.Denom)
+		}
+		index := fmt.Sprintf("%s|%d", elem.Denom, elem.Version)
+		if _, ok := metadataVersionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated metadata version %d for %s", elem.Version, elem.Denom)
+		}
+		metadataVersionIndexMap[index] = struct{}{}
+	}
+
+	mintScheduleIdMap := make(map[uint64]bool)
+	scheduledSupply := make(map[string]uint64)
+	for _, elem := range gs.MintScheduleList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("mint schedule %d references unknown verifiedtoken %s", elem.Id, elem.Denom)
+		}
+		if mintScheduleIdMap[elem.Id] {
+			return fmt.Errorf("duplicated id for mint schedule")
+		}
+		if elem.Id >= gs.MintScheduleCount {
+			return fmt.Errorf("mint schedule id should be lower or equal than the last id")
+		}
+		if err := ValidateMintScheduleTerms(elem.TrancheAmount, elem.TotalAmount, elem.Cadence, elem.Interval, elem.StartDate); err != nil {
+			return fmt.Errorf("invalid mint schedule %d: %w", elem.Id, err)
+		}
+		if elem.ReleasedAmount >= elem.TotalAmount {
+			return fmt.Errorf("mint schedule %d has nothing left to release", elem.Id)
+		}
+		mintScheduleIdMap[elem.Id] = true
+		scheduledSupply[elem.Denom] += elem.Remaining()
+	}
+	for _, elem := range gs.VerifiedtokenMap {
+		if elem.ScheduledSupply != scheduledSupply[elem.Denom] {
+			return fmt.Errorf("verifiedtoken %s scheduled supply does not match its mint schedules", elem.Denom)
+		}
+	}
+
+	mintRateLimitIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MintRateLimitList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("mint rate limit references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, ok := mintRateLimitIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated mint rate limit for %s", elem.Denom)
+		}
+		mintRateLimitIndexMap[elem.Denom] = struct{}{}
+	}
+	pendingMintRateLimitIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingMintRateLimitList {
+		if _, ok := verifiedtokenIndexMap[elem.Limit.Denom]; !ok {
+			return fmt.Errorf("pending mint rate limit references unknown verifiedtoken %s", elem.Limit.Denom)
+		}
+		if _, ok := pendingMintRateLimitIndexMap[elem.Limit.Denom]; ok {
+			return fmt.Errorf("duplicated pending mint rate limit for %s", elem.Limit.Denom)
+		}
+		pendingMintRateLimitIndexMap[elem.Limit.Denom] = struct{}{}
+	}
+	mintUsageIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MintUsageList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("mint usage references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, ok := mintUsageIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated mint usage for %s", elem.Denom)
+		}
+		mintUsageIndexMap[elem.Denom] = struct{}{}
+	}
+	tokenPauseIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TokenPauseList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("token pause references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, ok := tokenPauseIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated token pause for %s", elem.Denom)
+		}
+		tokenPauseIndexMap[elem.Denom] = struct{}{}
+		if len(elem.Scopes) == 0 {
+			return fmt.Errorf("token pause for %s has no scopes", elem.Denom)
+		}
+		if _, err := NormalizePauseScopes(elem.Scopes); err != nil {
+			return fmt.Errorf("token pause for %s: %w", elem.Denom, err)
+		}
+	}
+	addressFreezeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AddressFreezeList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("address freeze references unknown verifiedtoken %s", elem.Denom)
+		}
+		if elem.Address == "" {
+			return fmt.Errorf("address freeze for %s has no address", elem.Denom)
+		}
+		if elem.ExpiresAt == 0 {
+			return fmt.Errorf("address freeze of %s for %s has no expiry", elem.Address, elem.Denom)
+		}
+		index := elem.Denom + "|" + elem.Address
+		if _, ok := addressFreezeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated address freeze of %s for %s", elem.Address, elem.Denom)
+		}
+		addressFreezeIndexMap[index] = struct{}{}
+	}
+	tokenIBCPolicyIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TokenIbcPolicyList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("ibc policy references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, ok := tokenIBCPolicyIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated ibc policy for %s", elem.Denom)
+		}
+		tokenIBCPolicyIndexMap[elem.Denom] = struct{}{}
+		if _, err := NormalizeIBCPolicyChannels(elem.Mode, elem.AllowedChannels); err != nil {
+			return fmt.Errorf("ibc policy for %s: %w", elem.Denom, err)
+		}
+	}
+	ibcEscrowIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IbcEscrowList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("ibc escrow references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, ok := ibcEscrowIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated ibc escrow for %s", elem.Denom)
+		}
+		ibcEscrowIndexMap[elem.Denom] = struct{}{}
+	}
+	ibcRecorderIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IbcRecorderList {
+		if !IsValidIBCChannelID(elem.ChannelId) {
+			return fmt.Errorf("ibc recorder has invalid channel id %q", elem.ChannelId)
+		}
+		if elem.Sender == "" {
+			return fmt.Errorf("ibc recorder on %s has no sender", elem.ChannelId)
+		}
+		index := elem.ChannelId + "|" + elem.Sender
+		if _, ok := ibcRecorderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated ibc recorder %s on %s", elem.Sender, elem.ChannelId)
+		}
+		ibcRecorderIndexMap[index] = struct{}{}
+	}
+	merchantICAIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MerchantIcaList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid merchant interchain account: %w", err)
+		}
+		index := fmt.Sprintf("%d|%s", elem.MerchantId, elem.ConnectionId)
+		if _, ok := merchantICAIndexMap[index]; ok {
+			return fmt.Errorf("duplicated interchain account for merchant %d on %s", elem.MerchantId, elem.ConnectionId)
+		}
+		merchantICAIndexMap[index] = struct{}{}
+	}
+	merchantICAPacketIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MerchantIcaPacketList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid merchant packet: %w", err)
+		}
+		index := fmt.Sprintf("%s|%d", elem.ChannelId, elem.Sequence)
+		if _, ok := merchantICAPacketIndexMap[index]; ok {
+			return fmt.Errorf("duplicated merchant packet %d on %s", elem.Sequence, elem.ChannelId)
+		}
+		merchantICAPacketIndexMap[index] = struct{}{}
+	}
+	treasuryForwardIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TreasuryForwardList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid treasury forward: %w", err)
+		}
+		index := fmt.Sprintf("%d|%s", elem.MerchantId, elem.AllocationKey)
+		if _, ok := treasuryForwardIndexMap[index]; ok {
+			return fmt.Errorf("duplicated treasury forward of %s for merchant %d", elem.AllocationKey, elem.MerchantId)
+		}
+		treasuryForwardIndexMap[index] = struct{}{}
+	}
+	ibcRateLimitIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IbcRateLimitList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid ibc rate limit: %w", err)
+		}
+		index := elem.Denom + "|" + elem.ChannelId
+		if _, ok := ibcRateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated ibc rate limit of %s on %s", elem.Denom, elem.ChannelId)
+		}
+		ibcRateLimitIndexMap[index] = struct{}{}
+	}
+	ibcRateLimitFlowIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IbcRateLimitFlowList {
+		index := elem.Denom + "|" + elem.ChannelId
+		if _, ok := ibcRateLimitIndexMap[index]; !ok {
+			return fmt.Errorf("ibc rate limit flow of %s on %s has no rate limit", elem.Denom, elem.ChannelId)
+		}
+		if _, ok := ibcRateLimitFlowIndexMap[index]; ok {
+			return fmt.Errorf("duplicated ibc rate limit flow of %s on %s", elem.Denom, elem.ChannelId)
+		}
+		ibcRateLimitFlowIndexMap[index] = struct{}{}
+	}
+	feeTokenIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FeeTokenList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("fee token references unknown verifiedtoken %s", elem.Denom)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid fee token %s: %w", elem.Denom, err)
+		}
+		if _, ok := feeTokenIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated fee token %s", elem.Denom)
+		}
+		feeTokenIndexMap[elem.Denom] = struct{}{}
+	}
+	gasPoolIndexMap := make(map[string]struct{})
+	for _, elem := range gs.GasPoolList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("gas pool references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, ok := gasPoolIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated gas pool %s", elem.Denom)
+		}
+		gasPoolIndexMap[elem.Denom] = struct{}{}
+	}
+	claimSponsorshipIndexMap := make(map[string]struct{})
+	claimSponsorIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ClaimSponsorshipList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("claim sponsorship references unknown verifiedtoken %s", elem.Denom)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid claim sponsorship %s: %w", elem.Denom, err)
+		}
+		if _, ok := claimSponsorshipIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated claim sponsorship %s", elem.Denom)
+		}
+		claimSponsorshipIndexMap[elem.Denom] = struct{}{}
+		if !elem.Enabled {
+			continue
+		}
+		if _, ok := claimSponsorIndexMap[elem.Sponsor]; ok {
+			return fmt.Errorf("sponsor %s has more than one enabled claim sponsorship", elem.Sponsor)
+		}
+		claimSponsorIndexMap[elem.Sponsor] = struct{}{}
+	}
+	claimSponsorshipUsageIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ClaimSponsorshipUsageList {
+		if _, ok := claimSponsorshipIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("claim sponsorship usage references unknown claim sponsorship %s", elem.Denom)
+		}
+		if _, ok := claimSponsorshipUsageIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated claim sponsorship usage %s", elem.Denom)
+		}
+		claimSponsorshipUsageIndexMap[elem.Denom] = struct{}{}
+	}
+	sponsoredAllowanceIndexMap := make(map[string]struct{})
+	for _, elem := range gs.SponsoredAllowanceList {
+		if _, ok := claimSponsorshipIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("sponsored allowance references unknown claim sponsorship %s", elem.Denom)
+		}
+		index := elem.Sponsor + "/" + elem.Grantee
+		if _, ok := sponsoredAllowanceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated sponsored allowance %s", index)
+		}
+		sponsoredAllowanceIndexMap[index] = struct{}{}
+	}
+	liabilityCheckpointIndexMap := make(map[string]struct{})
+	for _, elem := range gs.LiabilityCheckpointList {
+		if _, ok := verifiedtokenIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("liability checkpoint references unknown verifiedtoken %s", elem.Denom)
+		}
+		if _, err := time.Parse("2006-01-02", elem.Date); err != nil {
+			return fmt.Errorf("invalid liability checkpoint date %q: %w", elem.Date, err)
+		}
+		index := elem.Denom + "|" + elem.Date
+		if _, ok := liabilityCheckpointIndexMap[index]; ok {
+			return fmt.Errorf("duplicated liability checkpoint %s", index)
+		}
+		liabilityCheckpointIndexMap[index] = struct{}{}
+	}
+
+	if err := gs.Authorities.Validate(); err != nil {
+		return fmt.Errorf("invalid authorities: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
@@ -35,6 +35,37 @@ type GenesisState struct {
 	RecoveryoperationCount uint64               `protobuf:"varint,6,opt,name=recoveryoperation_count,json=recoveryoperationCount,proto3" json:"recoveryoperation_count,omitempty"`
 	LastDailyRollupDate    string               `protobuf:"bytes,7,opt,name=last_daily_rollup_date,json=lastDailyRollupDate,proto3" json:"last_daily_rollup_date,omitempty"`
 	MerchantallocationMap  []Merchantallocation `protobuf:"bytes,8,rep,name=merchantallocation_map,json=merchantallocationMap,proto3" json:"merchantallocation_map"`
+	// authorities defines the scoped loyalty role holders.
+	Authorities               Authorities             `protobuf:"bytes,9,opt,name=authorities,proto3" json:"authorities"`
+	MerchantList              []Merchant              `protobuf:"bytes,10,rep,name=merchant_list,json=merchantList,proto3" json:"merchant_list"`
+	MerchantCount             uint64                  `protobuf:"varint,11,opt,name=merchant_count,json=merchantCount,proto3" json:"merchant_count,omitempty"`
+	AttestationList           []Attestation           `protobuf:"bytes,12,rep,name=attestation_list,json=attestationList,proto3" json:"attestation_list"`
+	AttestationHistory        []AttestationRecord     `protobuf:"bytes,13,rep,name=attestation_history,json=attestationHistory,proto3" json:"attestation_history"`
+	AttestationHistoryCount   uint64                  `protobuf:"varint,14,opt,name=attestation_history_count,json=attestationHistoryCount,proto3" json:"attestation_history_count,omitempty"`
+	PendingMetadataChangeList []PendingMetadataChange `protobuf:"bytes,15,rep,name=pending_metadata_change_list,json=pendingMetadataChangeList,proto3" json:"pending_metadata_change_list"`
+	MetadataVersionList       []MetadataVersion       `protobuf:"bytes,16,rep,name=metadata_version_list,json=metadataVersionList,proto3" json:"metadata_version_list"`
+	MintScheduleList          []MintSchedule          `protobuf:"bytes,17,rep,name=mint_schedule_list,json=mintScheduleList,proto3" json:"mint_schedule_list"`
+	MintScheduleCount         uint64                  `protobuf:"varint,18,opt,name=mint_schedule_count,json=mintScheduleCount,proto3" json:"mint_schedule_count,omitempty"`
+	MintRateLimitList         []MintRateLimit         `protobuf:"bytes,19,rep,name=mint_rate_limit_list,json=mintRateLimitList,proto3" json:"mint_rate_limit_list"`
+	PendingMintRateLimitList  []PendingMintRateLimit  `protobuf:"bytes,20,rep,name=pending_mint_rate_limit_list,json=pendingMintRateLimitList,proto3" json:"pending_mint_rate_limit_list"`
+	MintUsageList             []MintUsage             `protobuf:"bytes,21,rep,name=mint_usage_list,json=mintUsageList,proto3" json:"mint_usage_list"`
+	TokenPauseList            []TokenPause            `protobuf:"bytes,22,rep,name=token_pause_list,json=tokenPauseList,proto3" json:"token_pause_list"`
+	AddressFreezeList         []AddressFreeze         `protobuf:"bytes,23,rep,name=address_freeze_list,json=addressFreezeList,proto3" json:"address_freeze_list"`
+	TokenIbcPolicyList        []TokenIBCPolicy        `protobuf:"bytes,24,rep,name=token_ibc_policy_list,json=tokenIbcPolicyList,proto3" json:"token_ibc_policy_list"`
+	IbcEscrowList             []IBCEscrow             `protobuf:"bytes,25,rep,name=ibc_escrow_list,json=ibcEscrowList,proto3" json:"ibc_escrow_list"`
+	IbcRecorderList           []IBCRecorder           `protobuf:"bytes,26,rep,name=ibc_recorder_list,json=ibcRecorderList,proto3" json:"ibc_recorder_list"`
+	MerchantIcaList           []MerchantICA           `protobuf:"bytes,27,rep,name=merchant_ica_list,json=merchantIcaList,proto3" json:"merchant_ica_list"`
+	MerchantIcaPacketList     []MerchantICAPacket     `protobuf:"bytes,28,rep,name=merchant_ica_packet_list,json=merchantIcaPacketList,proto3" json:"merchant_ica_packet_list"`
+	TreasuryForwardList       []TreasuryForward       `protobuf:"bytes,29,rep,name=treasury_forward_list,json=treasuryForwardList,proto3" json:"treasury_forward_list"`
+	IbcRateLimitList          []IBCRateLimit          `protobuf:"bytes,30,rep,name=ibc_rate_limit_list,json=ibcRateLimitList,proto3" json:"ibc_rate_limit_list"`
+	IbcRateLimitFlowList      []IBCRateLimitFlow      `protobuf:"bytes,31,rep,name=ibc_rate_limit_flow_list,json=ibcRateLimitFlowList,proto3" json:"ibc_rate_limit_flow_list"`
+	FeeTokenList              []FeeToken              `protobuf:"bytes,32,rep,name=fee_token_list,json=feeTokenList,proto3" json:"fee_token_list"`
+	GasPoolList               []GasPool               `protobuf:"bytes,33,rep,name=gas_pool_list,json=gasPoolList,proto3" json:"gas_pool_list"`
+	ClaimSponsorshipList      []ClaimSponsorship      `protobuf:"bytes,34,rep,name=claim_sponsorship_list,json=claimSponsorshipList,proto3" json:"claim_sponsorship_list"`
+	ClaimSponsorshipUsageList []ClaimSponsorshipUsage `protobuf:"bytes,35,rep,name=claim_sponsorship_usage_list,json=claimSponsorshipUsageList,proto3" json:"claim_sponsorship_usage_list"`
+	SponsoredAllowanceList    []SponsoredAllowance    `protobuf:"bytes,36,rep,name=sponsored_allowance_list,json=sponsoredAllowanceList,proto3" json:"sponsored_allowance_list"`
+	LiabilityCheckpointList   []LiabilityCheckpoint   `protobuf:"bytes,37,rep,name=liability_checkpoint_list,json=liabilityCheckpointList,proto3" json:"liability_checkpoint_list"`
+	CreatorUsageList          []CreatorUsage          `protobuf:"bytes,38,rep,name=creator_usage_list,json=creatorUsageList,proto3" json:"creator_usage_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -126,6 +157,216 @@ func (m *GenesisState) GetMerchantallocationMap() []Merchantallocation {
 	return nil
 }
 
+func (m *GenesisState) GetAuthorities() Authorities {
+	if m != nil {
+		return m.Authorities
+	}
+	return Authorities{}
+}
+
+func (m *GenesisState) GetMerchantList() []Merchant {
+	if m != nil {
+		return m.MerchantList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerchantCount() uint64 {
+	if m != nil {
+		return m.MerchantCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAttestationList() []Attestation {
+	if m != nil {
+		return m.AttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationHistory() []AttestationRecord {
+	if m != nil {
+		return m.AttestationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationHistoryCount() uint64 {
+	if m != nil {
+		return m.AttestationHistoryCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingMetadataChangeList() []PendingMetadataChange {
+	if m != nil {
+		return m.PendingMetadataChangeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMetadataVersionList() []MetadataVersion {
+	if m != nil {
+		return m.MetadataVersionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintScheduleList() []MintSchedule {
+	if m != nil {
+		return m.MintScheduleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintScheduleCount() uint64 {
+	if m != nil {
+		return m.MintScheduleCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMintRateLimitList() []MintRateLimit {
+	if m != nil {
+		return m.MintRateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingMintRateLimitList() []PendingMintRateLimit {
+	if m != nil {
+		return m.PendingMintRateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintUsageList() []MintUsage {
+	if m != nil {
+		return m.MintUsageList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenPauseList() []TokenPause {
+	if m != nil {
+		return m.TokenPauseList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressFreezeList() []AddressFreeze {
+	if m != nil {
+		return m.AddressFreezeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenIbcPolicyList() []TokenIBCPolicy {
+	if m != nil {
+		return m.TokenIbcPolicyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcEscrowList() []IBCEscrow {
+	if m != nil {
+		return m.IbcEscrowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcRecorderList() []IBCRecorder {
+	if m != nil {
+		return m.IbcRecorderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerchantIcaList() []MerchantICA {
+	if m != nil {
+		return m.MerchantIcaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerchantIcaPacketList() []MerchantICAPacket {
+	if m != nil {
+		return m.MerchantIcaPacketList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryForwardList() []TreasuryForward {
+	if m != nil {
+		return m.TreasuryForwardList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcRateLimitList() []IBCRateLimit {
+	if m != nil {
+		return m.IbcRateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcRateLimitFlowList() []IBCRateLimitFlow {
+	if m != nil {
+		return m.IbcRateLimitFlowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokenList() []FeeToken {
+	if m != nil {
+		return m.FeeTokenList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGasPoolList() []GasPool {
+	if m != nil {
+		return m.GasPoolList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimSponsorshipList() []ClaimSponsorship {
+	if m != nil {
+		return m.ClaimSponsorshipList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimSponsorshipUsageList() []ClaimSponsorshipUsage {
+	if m != nil {
+		return m.ClaimSponsorshipUsageList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsoredAllowanceList() []SponsoredAllowance {
+	if m != nil {
+		return m.SponsoredAllowanceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiabilityCheckpointList() []LiabilityCheckpoint {
+	if m != nil {
+		return m.LiabilityCheckpointList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreatorUsageList() []CreatorUsage {
+	if m != nil {
+		return m.CreatorUsageList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tokenchain.loyalty.v1.GenesisState")
 }
@@ -135,37 +376,90 @@ func init() {
 }
 
 var fileDescriptor_1f337543788fefaa = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x80, 0x6b, 0xd6, 0x15, 0xe6, 0x71, 0x60, 0xd9, 0x56, 0xa2, 0x4a, 0x84, 0x6a, 0x20, 0x91,
-	0x21, 0x48, 0xb4, 0x0d, 0x89, 0x2b, 0xea, 0x26, 0x71, 0xa1, 0x12, 0x0a, 0x12, 0x48, 0x5c, 0xc2,
-	0xc3, 0xf5, 0x3a, 0x0b, 0x37, 0xb6, 0x1c, 0xb7, 0x23, 0xff, 0x82, 0xbf, 0xc0, 0x8d, 0x23, 0x3f,
-	0x63, 0xc7, 0x1d, 0x39, 0x21, 0xd4, 0x1e, 0xf8, 0x1b, 0xc8, 0xae, 0xc7, 0xda, 0x26, 0xd9, 0x25,
-	0xb2, 0xf2, 0xbe, 0xf7, 0x3d, 0xbf, 0x97, 0x17, 0xfc, 0x48, 0x8b, 0x2f, 0x34, 0x23, 0x67, 0xc0,
-	0xb2, 0x98, 0x8b, 0x02, 0xb8, 0x2e, 0xe2, 0xc9, 0x41, 0x3c, 0xa4, 0x19, 0xcd, 0x59, 0x1e, 0x49,
-	0x25, 0xb4, 0xf0, 0x76, 0xaf, 0xa1, 0xc8, 0x41, 0xd1, 0xe4, 0xa0, 0xb3, 0x05, 0x23, 0x96, 0x89,
-	0xd8, 0x3e, 0xe7, 0x64, 0x67, 0x67, 0x28, 0x86, 0xc2, 0x1e, 0x63, 0x73, 0x72, 0x6f, 0x9f, 0x55,
-	0x17, 0x21, 0x8a, 0x82, 0x16, 0x0a, 0x38, 0x17, 0xe7, 0x9c, 0xe5, 0xda, 0xd1, 0x51, 0x35, 0x3d,
-	0xa2, 0x8a, 0x9c, 0x41, 0xa6, 0x0d, 0x4e, 0x40, 0x33, 0x91, 0x39, 0x7e, 0xaf, 0x9a, 0x97, 0xa0,
-	0x60, 0xe4, 0x3a, 0xe8, 0x3c, 0xaf, 0x66, 0x14, 0x25, 0x62, 0x42, 0x55, 0x21, 0x24, 0x55, 0x8b,
-	0xca, 0xfd, 0x3a, 0xfc, 0x1c, 0xd4, 0x00, 0x08, 0x51, 0x63, 0xe0, 0x37, 0xa3, 0x13, 0xaa, 0xd8,
-	0x29, 0xa3, 0x03, 0x1b, 0x9d, 0xa3, 0x7b, 0xdf, 0xd7, 0xf1, 0xdd, 0xd7, 0xf3, 0xc1, 0xbe, 0xd3,
-	0xa0, 0xa9, 0xf7, 0x0a, 0xb7, 0xe6, 0xb7, 0xf4, 0x51, 0x17, 0x85, 0x9b, 0x87, 0x0f, 0xa2, 0xca,
-	0x41, 0x47, 0x6f, 0x2d, 0xd4, 0xdb, 0xb8, 0xf8, 0xfd, 0xb0, 0xf1, 0xe3, 0xef, 0xcf, 0xa7, 0x28,
-	0x71, 0x79, 0xde, 0x27, 0xbc, 0xb3, 0x3a, 0xc5, 0x74, 0x04, 0xd2, 0xbf, 0xd5, 0x5d, 0x0b, 0x37,
-	0x0f, 0x9f, 0xd4, 0xf8, 0x8e, 0x57, 0x52, 0x7a, 0x4d, 0x63, 0x4e, 0xb6, 0x57, 0x55, 0x7d, 0x90,
-	0xde, 0x07, 0xbc, 0xb5, 0xd4, 0x8b, 0xd5, 0xaf, 0x59, 0xfd, 0xe3, 0x1a, 0xfd, 0xfb, 0x45, 0xde,
-	0xb9, 0xef, 0x2d, 0x49, 0x9c, 0x78, 0x69, 0x9e, 0x56, 0xdc, 0xbc, 0x51, 0x9c, 0x2c, 0xf2, 0x57,
-	0xe2, 0x25, 0x89, 0x11, 0x53, 0xdc, 0x2e, 0x7d, 0xd7, 0xd4, 0xb4, 0xe3, 0xaf, 0x5b, 0x7b, 0x58,
-	0x6b, 0x5f, 0x49, 0x72, 0x15, 0x76, 0x4b, 0xb6, 0x37, 0x2c, 0xd7, 0xde, 0x4b, 0x7c, 0xbf, 0x5c,
-	0x86, 0x88, 0x71, 0xa6, 0xfd, 0x56, 0x17, 0x85, 0xcd, 0xa4, 0x7c, 0x8b, 0x63, 0x13, 0xf5, 0x8e,
-	0x70, 0x9b, 0x43, 0xae, 0xd3, 0x01, 0x30, 0x5e, 0xa4, 0x4a, 0x70, 0x3e, 0x96, 0xe9, 0x00, 0x34,
-	0xf5, 0x6f, 0x77, 0x51, 0xb8, 0x91, 0x6c, 0x9b, 0xe8, 0x89, 0x09, 0x26, 0x36, 0x76, 0x62, 0x56,
-	0xe5, 0x14, 0xb7, 0xcb, 0x3f, 0x80, 0x1d, 0xd9, 0x1d, 0xdb, 0xd4, 0x7e, 0x4d, 0x53, 0xfd, 0x52,
-	0xd2, 0x55, 0x57, 0x65, 0x5d, 0x1f, 0x64, 0xef, 0xc5, 0xc5, 0x34, 0x40, 0x97, 0xd3, 0x00, 0xfd,
-	0x99, 0x06, 0xe8, 0xdb, 0x2c, 0x68, 0x5c, 0xce, 0x82, 0xc6, 0xaf, 0x59, 0xd0, 0xf8, 0xd8, 0x59,
-	0x58, 0xf4, 0xaf, 0xff, 0x57, 0x5d, 0x17, 0x92, 0xe6, 0x9f, 0x5b, 0x76, 0xc1, 0x8f, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0xa7, 0x66, 0x03, 0x0f, 0x4e, 0x04, 0x00, 0x00,
+	// 1323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x97, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0xc7, 0x63, 0x5a, 0x0a, 0x55, 0x3e, 0x9a, 0xd8, 0xf9, 0x70, 0x42, 0xeb, 0xba, 0x69, 0xd3,
+	0xa6, 0x1f, 0x38, 0xd3, 0x96, 0x19, 0x66, 0xb8, 0x22, 0x71, 0x49, 0x6b, 0x68, 0x21, 0x38, 0xa1,
+	0x65, 0xca, 0x0c, 0x5b, 0x45, 0x96, 0x6d, 0x4d, 0xd7, 0xab, 0x45, 0x92, 0x53, 0xcc, 0x53, 0xc0,
+	0x5b, 0x70, 0xc9, 0x63, 0xf4, 0xb2, 0x97, 0x5c, 0x31, 0x4c, 0x73, 0xc1, 0x6b, 0x30, 0x3a, 0x92,
+	0x6c, 0xd9, 0xde, 0x55, 0x6e, 0x32, 0xf1, 0xd1, 0xff, 0xfc, 0xfe, 0xd2, 0x59, 0xed, 0x91, 0x16,
+	0x5d, 0x57, 0xfc, 0x35, 0x4d, 0x48, 0x17, 0xb3, 0x64, 0x27, 0xe6, 0x03, 0x1c, 0xab, 0xc1, 0xce,
+	0xc9, 0xfd, 0x9d, 0x0e, 0x4d, 0xa8, 0x64, 0xb2, 0x96, 0x0a, 0xae, 0x78, 0x71, 0x65, 0x24, 0xaa,
+	0x59, 0x51, 0xed, 0xe4, 0xfe, 0xc6, 0x12, 0xee, 0xb1, 0x84, 0xef, 0xc0, 0x5f, 0xa3, 0xdc, 0x58,
+	0xee, 0xf0, 0x0e, 0x87, 0x7f, 0x77, 0xf4, 0x7f, 0x36, 0x7a, 0x27, 0xdb, 0x04, 0xb7, 0x5a, 0x82,
+	0x4a, 0x19, 0xb5, 0x05, 0xa5, 0xbf, 0x51, 0xab, 0xbd, 0x95, 0xa3, 0x55, 0x8a, 0x4a, 0x85, 0x15,
+	0xe3, 0xc9, 0x19, 0xc2, 0xbe, 0xea, 0x72, 0xc1, 0x14, 0xa3, 0x76, 0xf6, 0x1b, 0x9f, 0x66, 0x0b,
+	0x49, 0x8c, 0x59, 0x2f, 0x92, 0x29, 0x4f, 0x24, 0x17, 0xb2, 0xcb, 0x52, 0x2b, 0xbf, 0x97, 0x23,
+	0x17, 0x14, 0x2b, 0x2e, 0x70, 0x1c, 0xf3, 0x37, 0x31, 0x93, 0xca, 0xaa, 0xef, 0x66, 0xab, 0xdb,
+	0x94, 0x66, 0xa0, 0x6f, 0x66, 0x8b, 0xd9, 0x31, 0x89, 0x52, 0x1e, 0x33, 0x32, 0xb0, 0xba, 0xad,
+	0x6c, 0x5d, 0xcc, 0xf0, 0x31, 0x8b, 0x99, 0x72, 0xb2, 0x1b, 0xd9, 0xb2, 0x1e, 0x15, 0xa4, 0x8b,
+	0x13, 0x37, 0xc3, 0xed, 0xb0, 0x2a, 0x62, 0x04, 0x5b, 0x65, 0x2d, 0xac, 0xd4, 0x4b, 0x27, 0xfe,
+	0x13, 0xc8, 0xf5, 0x57, 0xb8, 0x85, 0x15, 0x0e, 0x57, 0xa8, 0xc7, 0x12, 0x15, 0x09, 0xac, 0x68,
+	0x14, 0xb3, 0x1e, 0x73, 0x93, 0xbd, 0x1d, 0x10, 0x4b, 0xd2, 0xa5, 0xad, 0x7e, 0xec, 0x36, 0xca,
+	0x66, 0xb6, 0x34, 0xc5, 0x02, 0xf7, 0xce, 0x78, 0xf4, 0x82, 0x12, 0x7e, 0x42, 0xc5, 0x80, 0xa7,
+	0x54, 0xf8, 0x0b, 0xba, 0x9d, 0x27, 0x7f, 0x83, 0x45, 0x0b, 0x13, 0x22, 0xfa, 0x38, 0x0e, 0xef,
+	0x3e, 0x88, 0x46, 0x29, 0xee, 0x4b, 0x1a, 0x66, 0x9e, 0x50, 0xc1, 0xda, 0x8c, 0xb6, 0x60, 0xd4,
+	0x48, 0x37, 0xff, 0xd8, 0x40, 0x73, 0x8f, 0xcd, 0x8b, 0x77, 0xa8, 0xb0, 0xa2, 0xc5, 0x2f, 0xd1,
+	0x05, 0xb3, 0x9c, 0x72, 0xa1, 0x5a, 0xd8, 0x9e, 0x7d, 0x70, 0xa5, 0x96, 0xf9, 0x22, 0xd6, 0x0e,
+	0x40, 0xb4, 0x77, 0xf1, 0xed, 0x3f, 0x57, 0x67, 0xfe, 0xfc, 0xef, 0xaf, 0x3b, 0x85, 0xa6, 0xcd,
+	0x2b, 0xbe, 0x42, 0xcb, 0x93, 0x1b, 0x37, 0xea, 0xe1, 0xb4, 0xfc, 0x41, 0xf5, 0xdc, 0xf6, 0xec,
+	0x83, 0x5b, 0x39, 0xbc, 0xfa, 0x44, 0xca, 0xde, 0x79, 0x4d, 0x6e, 0x96, 0x26, 0x51, 0xcf, 0x70,
+	0x5a, 0x7c, 0x81, 0x96, 0xc6, 0xd6, 0x02, 0xf8, 0x73, 0x80, 0xbf, 0x91, 0x83, 0x7f, 0xee, 0xeb,
+	0x2d, 0x7b, 0x71, 0x0c, 0x62, 0xc1, 0x63, 0x85, 0x07, 0xf0, 0xf9, 0x20, 0xb8, 0xe9, 0xeb, 0x1d,
+	0x78, 0x0c, 0xa2, 0xc1, 0x14, 0xad, 0x4e, 0x6d, 0x80, 0x48, 0x2f, 0xa7, 0xfc, 0x21, 0xd0, 0xb7,
+	0x73, 0xe9, 0x13, 0x49, 0xd6, 0x61, 0x65, 0x8a, 0xf6, 0x94, 0x49, 0x55, 0xfc, 0x1c, 0xad, 0x4d,
+	0xdb, 0x10, 0xde, 0x4f, 0x54, 0xf9, 0x42, 0xb5, 0xb0, 0x7d, 0xbe, 0x39, 0x3d, 0x8b, 0xba, 0x1e,
+	0x2d, 0x3e, 0x44, 0xab, 0x31, 0x96, 0x2a, 0x6a, 0x61, 0x16, 0x0f, 0x22, 0xc1, 0xe3, 0xb8, 0x9f,
+	0x46, 0x2d, 0xac, 0x68, 0xf9, 0xa3, 0x6a, 0x61, 0xfb, 0x62, 0xb3, 0xa4, 0x47, 0x1f, 0xe9, 0xc1,
+	0x26, 0x8c, 0x3d, 0xd2, 0x5b, 0xa5, 0x8d, 0x56, 0xa7, 0xdf, 0x53, 0x28, 0xd9, 0xc7, 0xb0, 0xa8,
+	0xdb, 0x39, 0x8b, 0x7a, 0x36, 0x95, 0xe4, 0x56, 0x35, 0x8d, 0xd3, 0xc5, 0xfb, 0x0e, 0xcd, 0x7a,
+	0x1d, 0xb6, 0x7c, 0x11, 0xf6, 0xe5, 0x66, 0x0e, 0x7c, 0x77, 0xa4, 0xf4, 0x37, 0xa7, 0x4f, 0x28,
+	0x7e, 0x8d, 0xe6, 0x87, 0xad, 0x08, 0x1e, 0x02, 0x82, 0xf9, 0x5e, 0x3d, 0x63, 0xbe, 0x76, 0x96,
+	0x73, 0x2e, 0x17, 0x4a, 0xbe, 0x85, 0x16, 0x86, 0x2c, 0x53, 0xe9, 0x59, 0xa8, 0xf4, 0xd0, 0xc1,
+	0x14, 0xf8, 0x10, 0x2d, 0x7a, 0xc7, 0x89, 0x71, 0x9d, 0xab, 0x9e, 0x0b, 0x2d, 0x64, 0x24, 0xb7,
+	0xc6, 0x97, 0x3c, 0x02, 0x78, 0x47, 0xa8, 0xe4, 0x43, 0xbb, 0x4c, 0x2a, 0x2e, 0x06, 0xe5, 0xf9,
+	0xe0, 0x96, 0xf2, 0xb8, 0x7a, 0x77, 0x89, 0x96, 0xa5, 0x17, 0x3d, 0xd4, 0x13, 0x43, 0x2a, 0x7e,
+	0x81, 0xd6, 0x33, 0x0c, 0xec, 0x3a, 0x17, 0x60, 0x9d, 0x6b, 0xd3, 0x69, 0x66, 0xc5, 0x12, 0x5d,
+	0x4e, 0x69, 0xd2, 0x62, 0x49, 0x27, 0x72, 0xdd, 0x39, 0xd2, 0x05, 0xe9, 0x50, 0xb3, 0xfa, 0x4b,
+	0x30, 0xcb, 0x7b, 0x79, 0xed, 0xc5, 0xa4, 0x3e, 0xb3, 0x99, 0x75, 0x48, 0xb4, 0x33, 0x5d, 0x4f,
+	0xb3, 0x06, 0xa1, 0x22, 0xaf, 0xd0, 0xca, 0xd0, 0xec, 0x84, 0x0a, 0x39, 0xac, 0xf5, 0x22, 0xb8,
+	0xdd, 0xcc, 0x7d, 0xc2, 0x26, 0xe7, 0xb9, 0x49, 0x71, 0xbd, 0xa7, 0x37, 0x1e, 0x06, 0x87, 0x17,
+	0xa8, 0x38, 0x76, 0x32, 0x18, 0xfc, 0x12, 0xe0, 0xaf, 0xe7, 0xe1, 0x59, 0xa2, 0x0e, 0xad, 0xde,
+	0xb5, 0x88, 0x9e, 0x17, 0x03, 0x70, 0x0d, 0x95, 0xc6, 0xc1, 0xa6, 0xca, 0x45, 0xa8, 0xf2, 0x92,
+	0x2f, 0x37, 0xf5, 0xfd, 0x09, 0x2d, 0x4f, 0x9c, 0x67, 0x66, 0x2a, 0xa5, 0x60, 0xbb, 0xd2, 0x53,
+	0x69, 0x62, 0x45, 0x9f, 0xea, 0x04, 0x3b, 0x97, 0xa5, 0x9e, 0x1f, 0x84, 0xc9, 0xfc, 0xe2, 0x3d,
+	0xbc, 0x2c, 0x93, 0x65, 0x30, 0xb9, 0x7b, 0xc6, 0xc3, 0xcb, 0xf0, 0x2a, 0xa7, 0x19, 0x63, 0x60,
+	0xf9, 0x2d, 0xba, 0x04, 0x56, 0x7d, 0x89, 0xdd, 0x16, 0x59, 0x01, 0x97, 0x6a, 0x60, 0x29, 0x3f,
+	0x68, 0xb1, 0x45, 0xcf, 0xf7, 0x5c, 0x00, 0x78, 0xdf, 0xa3, 0x45, 0xef, 0x64, 0x34, 0xc0, 0x55,
+	0x00, 0x5e, 0xcb, 0x01, 0x1e, 0xe9, 0xe8, 0x81, 0x56, 0x5b, 0xe2, 0x82, 0x1a, 0x46, 0x00, 0xf9,
+	0x12, 0x95, 0xc6, 0xef, 0x8f, 0x86, 0xba, 0x16, 0xac, 0xf8, 0xae, 0xc9, 0xd8, 0x87, 0x04, 0x57,
+	0x71, 0xec, 0x07, 0x81, 0xfd, 0x33, 0x32, 0x37, 0xde, 0x68, 0x74, 0x33, 0x33, 0xf4, 0x32, 0xd0,
+	0xb7, 0x42, 0x73, 0x6e, 0xec, 0xd5, 0x0f, 0x20, 0xc3, 0xbd, 0xca, 0xa0, 0x6d, 0x1c, 0x13, 0x13,
+	0x75, 0xe5, 0xd5, 0x64, 0x2a, 0x89, 0xe0, 0x6f, 0x0c, 0x79, 0x3d, 0x58, 0xde, 0xc6, 0x5e, 0xfd,
+	0x2b, 0x10, 0xbb, 0xf2, 0xb2, 0x63, 0x62, 0x02, 0xc0, 0x3b, 0x42, 0x4b, 0x9a, 0x27, 0xa0, 0x85,
+	0x50, 0x61, 0x88, 0x1b, 0xc1, 0x8e, 0xd6, 0xd8, 0xab, 0x37, 0xad, 0xdc, 0x75, 0x34, 0x76, 0x4c,
+	0x5c, 0xc8, 0x51, 0xfd, 0x4b, 0xa2, 0xa1, 0x7e, 0x12, 0xa4, 0xba, 0xee, 0xdc, 0xa8, 0xef, 0x3a,
+	0xaa, 0x43, 0x34, 0x08, 0x06, 0x6a, 0x07, 0x95, 0xc7, 0xa8, 0x29, 0x26, 0xaf, 0xa9, 0xdd, 0xc9,
+	0x97, 0x83, 0xcd, 0xd2, 0x83, 0x1f, 0x40, 0xd2, 0xe4, 0x49, 0xd5, 0x20, 0xd8, 0x0c, 0xb8, 0xf6,
+	0xa3, 0x04, 0xc5, 0xb2, 0x2f, 0x06, 0x51, 0x9b, 0x0b, 0x7d, 0x09, 0x30, 0x2e, 0x57, 0x82, 0xed,
+	0xe7, 0xc8, 0xe6, 0xec, 0x9b, 0x14, 0xd7, 0x7e, 0xd4, 0x78, 0x18, 0x1c, 0x7e, 0x44, 0x25, 0x28,
+	0xfb, 0xc4, 0xfb, 0x58, 0x09, 0xf6, 0x1f, 0x5d, 0xf8, 0x89, 0xf7, 0x70, 0x51, 0x57, 0x7e, 0xec,
+	0xfd, 0xa3, 0xa8, 0x3c, 0x41, 0x6e, 0xc7, 0x6e, 0xa7, 0x5c, 0x0d, 0x5e, 0xdd, 0x7c, 0xfc, 0x7e,
+	0x3c, 0xdc, 0x30, 0xcb, 0xbe, 0x85, 0x8e, 0x83, 0xcd, 0x37, 0x68, 0x41, 0x7f, 0xa8, 0x98, 0xbd,
+	0x0e, 0xf0, 0x6a, 0xf0, 0xf0, 0xdd, 0xa7, 0xf4, 0xc8, 0xbb, 0xb3, 0xcd, 0xb5, 0xed, 0x6f, 0x80,
+	0x3d, 0x41, 0xf3, 0x1d, 0x2c, 0xa3, 0x94, 0xf3, 0xd8, 0xb0, 0xae, 0x01, 0xab, 0x92, 0xc3, 0x7a,
+	0x8c, 0xe5, 0x01, 0xe7, 0xee, 0x96, 0x36, 0xdb, 0x31, 0x3f, 0x81, 0x44, 0xd0, 0xea, 0xd4, 0xc7,
+	0x99, 0x41, 0x6e, 0x86, 0xaf, 0xad, 0x3a, 0xe9, 0x70, 0x94, 0xe3, 0xd6, 0x4e, 0x26, 0xe2, 0x60,
+	0x22, 0xd1, 0xe5, 0x69, 0x13, 0xaf, 0xdf, 0x5d, 0x0f, 0x1e, 0x89, 0x93, 0x56, 0x7e, 0xef, 0x5b,
+	0x27, 0x59, 0x83, 0x60, 0xca, 0x50, 0xd9, 0xda, 0xd1, 0x56, 0x04, 0xd7, 0x68, 0x9c, 0x10, 0x6b,
+	0x78, 0x23, 0x78, 0x4f, 0x3b, 0x74, 0x69, 0xbb, 0x2e, 0xcb, 0xba, 0xad, 0xca, 0xa9, 0x11, 0xb0,
+	0x8a, 0xd1, 0xfa, 0xf0, 0x7b, 0x31, 0x22, 0x5d, 0x4a, 0x5e, 0xa7, 0x9c, 0xb9, 0x3b, 0xd6, 0x16,
+	0x78, 0xdd, 0xc9, 0xf1, 0x7a, 0xea, 0xf2, 0xea, 0xc3, 0x34, 0x6b, 0xb6, 0x16, 0x4f, 0x0f, 0xb9,
+	0x93, 0xd8, 0x7e, 0x1c, 0xf8, 0x35, 0xbc, 0x19, 0x7c, 0x13, 0xec, 0x57, 0x86, 0x5f, 0xba, 0x45,
+	0xe2, 0xc5, 0x34, 0x78, 0xef, 0xb3, 0xb7, 0xef, 0x2b, 0x85, 0x77, 0xef, 0x2b, 0x85, 0x7f, 0xdf,
+	0x57, 0x0a, 0xbf, 0x9f, 0x56, 0x66, 0xde, 0x9d, 0x56, 0x66, 0xfe, 0x3e, 0xad, 0xcc, 0xbc, 0xdc,
+	0x18, 0x51, 0x77, 0x7e, 0x1d, 0x7e, 0x5a, 0xa9, 0x41, 0x4a, 0xe5, 0xf1, 0x05, 0xf8, 0xa0, 0x7a,
+	0xf8, 0xff, 0x00, 0x88, 0x93, 0x55, 0xb7, 0xde, 0x10, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -188,10 +482,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.MerchantallocationMap) > 0 {
-		for iNdEx := len(m.MerchantallocationMap) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CreatorUsageList) > 0 {
+		for iNdEx := len(m.CreatorUsageList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MerchantallocationMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CreatorUsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -199,25 +493,47 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.LastDailyRollupDate) > 0 {
-		i -= len(m.LastDailyRollupDate)
-		copy(dAtA[i:], m.LastDailyRollupDate)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LastDailyRollupDate)))
-		i--
-		dAtA[i] = 0x3a
+	if len(m.LiabilityCheckpointList) > 0 {
+		for iNdEx := len(m.LiabilityCheckpointList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiabilityCheckpointList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	if m.RecoveryoperationCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RecoveryoperationCount))
-		i--
-		dAtA[i] = 0x30
+	if len(m.SponsoredAllowanceList) > 0 {
+		for iNdEx := len(m.SponsoredAllowanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsoredAllowanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	if len(m.RecoveryoperationList) > 0 {
-		for iNdEx := len(m.RecoveryoperationList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ClaimSponsorshipUsageList) > 0 {
+		for iNdEx := len(m.ClaimSponsorshipUsageList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecoveryoperationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ClaimSponsorshipUsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -225,161 +541,1697 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.RewardaccrualMap) > 0 {
-		for iNdEx := len(m.RewardaccrualMap) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ClaimSponsorshipList) > 0 {
+		for iNdEx := len(m.ClaimSponsorshipList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RewardaccrualMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ClaimSponsorshipList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.GasPoolList) > 0 {
+		for iNdEx := len(m.GasPoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FeeTokenList) > 0 {
+		for iNdEx := len(m.FeeTokenList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokenList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.IbcRateLimitFlowList) > 0 {
+		for iNdEx := len(m.IbcRateLimitFlowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcRateLimitFlowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.IbcRateLimitList) > 0 {
+		for iNdEx := len(m.IbcRateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcRateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.TreasuryForwardList) > 0 {
+		for iNdEx := len(m.TreasuryForwardList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryForwardList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.MerchantIcaPacketList) > 0 {
+		for iNdEx := len(m.MerchantIcaPacketList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerchantIcaPacketList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.MerchantIcaList) > 0 {
+		for iNdEx := len(m.MerchantIcaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerchantIcaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.IbcRecorderList) > 0 {
+		for iNdEx := len(m.IbcRecorderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcRecorderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.IbcEscrowList) > 0 {
+		for iNdEx := len(m.IbcEscrowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcEscrowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.TokenIbcPolicyList) > 0 {
+		for iNdEx := len(m.TokenIbcPolicyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenIbcPolicyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.AddressFreezeList) > 0 {
+		for iNdEx := len(m.AddressFreezeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressFreezeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.TokenPauseList) > 0 {
+		for iNdEx := len(m.TokenPauseList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPauseList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.MintUsageList) > 0 {
+		for iNdEx := len(m.MintUsageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintUsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.PendingMintRateLimitList) > 0 {
+		for iNdEx := len(m.PendingMintRateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMintRateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MintRateLimitList) > 0 {
+		for iNdEx := len(m.MintRateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.MintScheduleCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MintScheduleCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.MintScheduleList) > 0 {
+		for iNdEx := len(m.MintScheduleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintScheduleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MetadataVersionList) > 0 {
+		for iNdEx := len(m.MetadataVersionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataVersionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PendingMetadataChangeList) > 0 {
+		for iNdEx := len(m.PendingMetadataChangeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMetadataChangeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.AttestationHistoryCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationHistoryCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AttestationHistory) > 0 {
+		for iNdEx := len(m.AttestationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AttestationList) > 0 {
+		for iNdEx := len(m.AttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MerchantCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MerchantCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MerchantList) > 0 {
+		for iNdEx := len(m.MerchantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerchantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Authorities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.MerchantallocationMap) > 0 {
+		for iNdEx := len(m.MerchantallocationMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerchantallocationMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LastDailyRollupDate) > 0 {
+		i -= len(m.LastDailyRollupDate)
+		copy(dAtA[i:], m.LastDailyRollupDate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LastDailyRollupDate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RecoveryoperationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecoveryoperationCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RecoveryoperationList) > 0 {
+		for iNdEx := len(m.RecoveryoperationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveryoperationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardaccrualMap) > 0 {
+		for iNdEx := len(m.RewardaccrualMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardaccrualMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VerifiedtokenMap) > 0 {
+		for iNdEx := len(m.VerifiedtokenMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifiedtokenMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CreatorallowlistMap) > 0 {
+		for iNdEx := len(m.CreatorallowlistMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorallowlistMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CreatorallowlistMap) > 0 {
+		for _, e := range m.CreatorallowlistMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerifiedtokenMap) > 0 {
+		for _, e := range m.VerifiedtokenMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardaccrualMap) > 0 {
+		for _, e := range m.RewardaccrualMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecoveryoperationList) > 0 {
+		for _, e := range m.RecoveryoperationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RecoveryoperationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RecoveryoperationCount))
+	}
+	l = len(m.LastDailyRollupDate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MerchantallocationMap) > 0 {
+		for _, e := range m.MerchantallocationMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Authorities.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MerchantList) > 0 {
+		for _, e := range m.MerchantList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MerchantCount != 0 {
+		n += 1 + sovGenesis(uint64(m.MerchantCount))
+	}
+	if len(m.AttestationList) > 0 {
+		for _, e := range m.AttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestationHistory) > 0 {
+		for _, e := range m.AttestationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AttestationHistoryCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AttestationHistoryCount))
+	}
+	if len(m.PendingMetadataChangeList) > 0 {
+		for _, e := range m.PendingMetadataChangeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MetadataVersionList) > 0 {
+		for _, e := range m.MetadataVersionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintScheduleList) > 0 {
+		for _, e := range m.MintScheduleList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintScheduleCount != 0 {
+		n += 2 + sovGenesis(uint64(m.MintScheduleCount))
+	}
+	if len(m.MintRateLimitList) > 0 {
+		for _, e := range m.MintRateLimitList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingMintRateLimitList) > 0 {
+		for _, e := range m.PendingMintRateLimitList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintUsageList) > 0 {
+		for _, e := range m.MintUsageList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenPauseList) > 0 {
+		for _, e := range m.TokenPauseList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressFreezeList) > 0 {
+		for _, e := range m.AddressFreezeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenIbcPolicyList) > 0 {
+		for _, e := range m.TokenIbcPolicyList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcEscrowList) > 0 {
+		for _, e := range m.IbcEscrowList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcRecorderList) > 0 {
+		for _, e := range m.IbcRecorderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerchantIcaList) > 0 {
+		for _, e := range m.MerchantIcaList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerchantIcaPacketList) > 0 {
+		for _, e := range m.MerchantIcaPacketList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryForwardList) > 0 {
+		for _, e := range m.TreasuryForwardList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcRateLimitList) > 0 {
+		for _, e := range m.IbcRateLimitList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcRateLimitFlowList) > 0 {
+		for _, e := range m.IbcRateLimitFlowList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokenList) > 0 {
+		for _, e := range m.FeeTokenList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GasPoolList) > 0 {
+		for _, e := range m.GasPoolList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimSponsorshipList) > 0 {
+		for _, e := range m.ClaimSponsorshipList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimSponsorshipUsageList) > 0 {
+		for _, e := range m.ClaimSponsorshipUsageList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsoredAllowanceList) > 0 {
+		for _, e := range m.SponsoredAllowanceList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiabilityCheckpointList) > 0 {
+		for _, e := range m.LiabilityCheckpointList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreatorUsageList) > 0 {
+		for _, e := range m.CreatorUsageList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorallowlistMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorallowlistMap = append(m.CreatorallowlistMap, Creatorallowlist{})
+			if err := m.CreatorallowlistMap[len(m.CreatorallowlistMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedtokenMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedtokenMap = append(m.VerifiedtokenMap, Verifiedtoken{})
+			if err := m.VerifiedtokenMap[len(m.VerifiedtokenMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardaccrualMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardaccrualMap = append(m.RewardaccrualMap, Rewardaccrual{})
+			if err := m.RewardaccrualMap[len(m.RewardaccrualMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryoperationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryoperationList = append(m.RecoveryoperationList, Recoveryoperation{})
+			if err := m.RecoveryoperationList[len(m.RecoveryoperationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryoperationCount", wireType)
+			}
+			m.RecoveryoperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryoperationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDailyRollupDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastDailyRollupDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantallocationMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerchantallocationMap = append(m.MerchantallocationMap, Merchantallocation{})
+			if err := m.MerchantallocationMap[len(m.MerchantallocationMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerchantList = append(m.MerchantList, Merchant{})
+			if err := m.MerchantList[len(m.MerchantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantCount", wireType)
+			}
+			m.MerchantCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationList = append(m.AttestationList, Attestation{})
+			if err := m.AttestationList[len(m.AttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationHistory = append(m.AttestationHistory, AttestationRecord{})
+			if err := m.AttestationHistory[len(m.AttestationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHistoryCount", wireType)
+			}
+			m.AttestationHistoryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationHistoryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMetadataChangeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMetadataChangeList = append(m.PendingMetadataChangeList, PendingMetadataChange{})
+			if err := m.PendingMetadataChangeList[len(m.PendingMetadataChangeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataVersionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataVersionList = append(m.MetadataVersionList, MetadataVersion{})
+			if err := m.MetadataVersionList[len(m.MetadataVersionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintScheduleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintScheduleList = append(m.MintScheduleList, MintSchedule{})
+			if err := m.MintScheduleList[len(m.MintScheduleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintScheduleCount", wireType)
+			}
+			m.MintScheduleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintScheduleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRateLimitList = append(m.MintRateLimitList, MintRateLimit{})
+			if err := m.MintRateLimitList[len(m.MintRateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMintRateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMintRateLimitList = append(m.PendingMintRateLimitList, PendingMintRateLimit{})
+			if err := m.PendingMintRateLimitList[len(m.PendingMintRateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintUsageList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintUsageList = append(m.MintUsageList, MintUsage{})
+			if err := m.MintUsageList[len(m.MintUsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPauseList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPauseList = append(m.TokenPauseList, TokenPause{})
+			if err := m.TokenPauseList[len(m.TokenPauseList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressFreezeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressFreezeList = append(m.AddressFreezeList, AddressFreeze{})
+			if err := m.AddressFreezeList[len(m.AddressFreezeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIbcPolicyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIbcPolicyList = append(m.TokenIbcPolicyList, TokenIBCPolicy{})
+			if err := m.TokenIbcPolicyList[len(m.TokenIbcPolicyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEscrowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcEscrowList = append(m.IbcEscrowList, IBCEscrow{})
+			if err := m.IbcEscrowList[len(m.IbcEscrowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcRecorderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcRecorderList = append(m.IbcRecorderList, IBCRecorder{})
+			if err := m.IbcRecorderList[len(m.IbcRecorderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIcaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerchantIcaList = append(m.MerchantIcaList, MerchantICA{})
+			if err := m.MerchantIcaList[len(m.MerchantIcaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIcaPacketList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.VerifiedtokenMap) > 0 {
-		for iNdEx := len(m.VerifiedtokenMap) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VerifiedtokenMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerchantIcaPacketList = append(m.MerchantIcaPacketList, MerchantICAPacket{})
+			if err := m.MerchantIcaPacketList[len(m.MerchantIcaPacketList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryForwardList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.CreatorallowlistMap) > 0 {
-		for iNdEx := len(m.CreatorallowlistMap) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CreatorallowlistMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryForwardList = append(m.TreasuryForwardList, TreasuryForward{})
+			if err := m.TreasuryForwardList[len(m.TreasuryForwardList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcRateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.CreatorallowlistMap) > 0 {
-		for _, e := range m.CreatorallowlistMap {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.VerifiedtokenMap) > 0 {
-		for _, e := range m.VerifiedtokenMap {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.RewardaccrualMap) > 0 {
-		for _, e := range m.RewardaccrualMap {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.RecoveryoperationList) > 0 {
-		for _, e := range m.RecoveryoperationList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.RecoveryoperationCount != 0 {
-		n += 1 + sovGenesis(uint64(m.RecoveryoperationCount))
-	}
-	l = len(m.LastDailyRollupDate)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MerchantallocationMap) > 0 {
-		for _, e := range m.MerchantallocationMap {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IbcRateLimitList = append(m.IbcRateLimitList, IBCRateLimit{})
+			if err := m.IbcRateLimitList[len(m.IbcRateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcRateLimitFlowList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -406,13 +2258,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IbcRateLimitFlowList = append(m.IbcRateLimitFlowList, IBCRateLimitFlow{})
+			if err := m.IbcRateLimitFlowList[len(m.IbcRateLimitFlowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorallowlistMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -439,14 +2292,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatorallowlistMap = append(m.CreatorallowlistMap, Creatorallowlist{})
-			if err := m.CreatorallowlistMap[len(m.CreatorallowlistMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeTokenList = append(m.FeeTokenList, FeeToken{})
+			if err := m.FeeTokenList[len(m.FeeTokenList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedtokenMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPoolList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -473,14 +2326,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerifiedtokenMap = append(m.VerifiedtokenMap, Verifiedtoken{})
-			if err := m.VerifiedtokenMap[len(m.VerifiedtokenMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasPoolList = append(m.GasPoolList, GasPool{})
+			if err := m.GasPoolList[len(m.GasPoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardaccrualMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimSponsorshipList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -507,14 +2360,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardaccrualMap = append(m.RewardaccrualMap, Rewardaccrual{})
-			if err := m.RewardaccrualMap[len(m.RewardaccrualMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimSponsorshipList = append(m.ClaimSponsorshipList, ClaimSponsorship{})
+			if err := m.ClaimSponsorshipList[len(m.ClaimSponsorshipList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryoperationList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimSponsorshipUsageList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -541,16 +2394,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveryoperationList = append(m.RecoveryoperationList, Recoveryoperation{})
-			if err := m.RecoveryoperationList[len(m.RecoveryoperationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimSponsorshipUsageList = append(m.ClaimSponsorshipUsageList, ClaimSponsorshipUsage{})
+			if err := m.ClaimSponsorshipUsageList[len(m.ClaimSponsorshipUsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryoperationCount", wireType)
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredAllowanceList", wireType)
 			}
-			m.RecoveryoperationCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -560,16 +2413,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecoveryoperationCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredAllowanceList = append(m.SponsoredAllowanceList, SponsoredAllowance{})
+			if err := m.SponsoredAllowanceList[len(m.SponsoredAllowanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDailyRollupDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiabilityCheckpointList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -579,27 +2447,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastDailyRollupDate = string(dAtA[iNdEx:postIndex])
+			m.LiabilityCheckpointList = append(m.LiabilityCheckpointList, LiabilityCheckpoint{})
+			if err := m.LiabilityCheckpointList[len(m.LiabilityCheckpointList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerchantallocationMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorUsageList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -626,8 +2496,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerchantallocationMap = append(m.MerchantallocationMap, Merchantallocation{})
-			if err := m.MerchantallocationMap[len(m.MerchantallocationMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CreatorUsageList = append(m.CreatorUsageList, CreatorUsage{})
+			if err := m.CreatorUsageList[len(m.CreatorUsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
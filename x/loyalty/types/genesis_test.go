@@ -112,6 +112,33 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid network mode",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultCreationMode,
+					types.DefaultDailyRollupTimezone,
+					types.DefaultTestnetTimelockHours,
+					types.DefaultMainnetTimelockHours,
+					types.DefaultFeeSplitValidatorBps,
+					types.DefaultFeeSplitTokenStakersBps,
+					types.DefaultFeeSplitMerchantPoolBps,
+					types.DefaultSeizureOptInDefault,
+					"tokenchain-1-localnet-fork",
+					types.DefaultMinVerifierAttestations,
+					types.DefaultMetadataChangeDelayHours,
+				),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated authority role holder",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Authorities: types.Authorities{RecoveryOverseers: []string{"0", "0"}},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid recoveryoperation count",
 			genState: &types.GenesisState{
@@ -120,6 +147,300 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "merchant id beyond merchant count",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				MerchantList:  []types.Merchant{{Id: 2, LegalName: "Shop", VerificationTier: types.MerchantTierUnverified}},
+				MerchantCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "verified flag without attestations",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop", Verified: true}},
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation for unknown token",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				AttestationList: []types.Attestation{{Denom: "factory/a/shop", Verifier: "0", EvidenceUri: "https://verifier.example"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "verified token with out of range decimals",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop", Decimals: types.MaxTokenDecimals + 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending metadata change for unknown token",
+			genState: &types.GenesisState{
+				Params:                    types.DefaultParams(),
+				PendingMetadataChangeList: []types.PendingMetadataChange{{Denom: "factory/a/shop", Name: "Shop", EffectiveAt: 10}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated metadata version",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop", MetadataVersion: 1}},
+				MetadataVersionList: []types.MetadataVersion{
+					{Denom: "factory/a/shop", Version: 1},
+					{Denom: "factory/a/shop", Version: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "mint schedule for unknown token",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				MintScheduleList:  []types.MintSchedule{{Id: 0, Denom: "factory/a/shop", TrancheAmount: 1, TotalAmount: 2, Cadence: types.MintCadenceDaily, Interval: 1, StartDate: "2027-01-01", NextReleaseDate: "2027-01-01"}},
+				MintScheduleCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "scheduled supply does not match mint schedules",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				VerifiedtokenMap:  []types.Verifiedtoken{{Denom: "factory/a/shop", MaxSupply: 10, ScheduledSupply: 1}},
+				MintScheduleList:  []types.MintSchedule{{Id: 0, Denom: "factory/a/shop", TrancheAmount: 1, TotalAmount: 2, Cadence: types.MintCadenceDaily, Interval: 1, StartDate: "2027-01-01", NextReleaseDate: "2027-01-01"}},
+				MintScheduleCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "mint rate limit for unknown token",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				MintRateLimitList: []types.MintRateLimit{{Denom: "factory/a/shop", MaxPerTx: 10}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pending mint rate limit",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				PendingMintRateLimitList: []types.PendingMintRateLimit{
+					{Limit: types.MintRateLimit{Denom: "factory/a/shop"}, EffectiveAt: 10},
+					{Limit: types.MintRateLimit{Denom: "factory/a/shop"}, EffectiveAt: 20},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "token pause with unknown scope",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				TokenPauseList:   []types.TokenPause{{Denom: "factory/a/shop", Scopes: []string{"burn"}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated token pause",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				TokenPauseList: []types.TokenPause{
+					{Denom: "factory/a/shop", Scopes: []string{types.PauseScopeMint}},
+					{Denom: "factory/a/shop", Scopes: []string{types.PauseScopeClaim}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "address freeze without expiry",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				VerifiedtokenMap:  []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				AddressFreezeList: []types.AddressFreeze{{Denom: "factory/a/shop", Address: "cosmos1holder"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated address freeze",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				AddressFreezeList: []types.AddressFreeze{
+					{Denom: "factory/a/shop", Address: "cosmos1holder", ExpiresAt: 10},
+					{Denom: "factory/a/shop", Address: "cosmos1holder", ExpiresAt: 20},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "ibc policy with unknown mode",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				VerifiedtokenMap:   []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				TokenIbcPolicyList: []types.TokenIBCPolicy{{Denom: "factory/a/shop", Mode: "sometimes"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowlisted ibc policy without channels",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				VerifiedtokenMap:   []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				TokenIbcPolicyList: []types.TokenIBCPolicy{{Denom: "factory/a/shop", Mode: types.IBCPolicyAllowlisted}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ibc escrow",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				IbcEscrowList:    []types.IBCEscrow{{Denom: "factory/a/shop", Amount: 1}, {Denom: "factory/a/shop", Amount: 2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ibc recorder",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				IbcRecorderList: []types.IBCRecorder{
+					{ChannelId: "channel-0", Sender: "osmo1partner"},
+					{ChannelId: "channel-0", Sender: "osmo1partner"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "active merchant ica without address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MerchantIcaList: []types.MerchantICA{{
+					MerchantId:   1,
+					ConnectionId: "connection-0",
+					PortId:       types.MerchantICAPortID(1),
+					ChannelId:    "channel-1",
+					Status:       types.MerchantICAStatusActive,
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated merchant ica packet",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MerchantIcaPacketList: []types.MerchantICAPacket{
+					{ChannelId: "channel-1", Sequence: 1, MerchantId: 1, Kind: types.MerchantPacketKindTx},
+					{ChannelId: "channel-1", Sequence: 1, MerchantId: 2, Kind: types.MerchantPacketKindTx},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "ibc rate limit on a voucher",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				IbcRateLimitList: []types.IBCRateLimit{{
+					Denom:         "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+					ChannelId:     "channel-0",
+					MaxOutflow:    100,
+					WindowSeconds: 3_600,
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "ibc rate limit flow without rate limit",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				IbcRateLimitFlowList: []types.IBCRateLimitFlow{{Denom: "utoken", ChannelId: "channel-0", WindowStart: 1, Outflow: 5}},
+			},
+			valid: false,
+		},
+		{
+			desc: "fee token of unknown verified token",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				FeeTokenList: []types.FeeToken{{Denom: "factory/a/shop", TokenUnits: 1, UtokenUnits: 1, Enabled: true}},
+			},
+			valid: false,
+		},
+		{
+			desc: "fee token without rate",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				FeeTokenList:     []types.FeeToken{{Denom: "factory/a/shop", Enabled: true}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated gas pool",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				GasPoolList:      []types.GasPool{{Denom: "factory/a/shop", Balance: 5}, {Denom: "factory/a/shop"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "claim sponsorship budget below per-user cap",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				VerifiedtokenMap:     []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				ClaimSponsorshipList: []types.ClaimSponsorship{{Denom: "factory/a/shop", Sponsor: "a", DailyBudget: 5, PerUserCap: 10}},
+			},
+			valid: false,
+		},
+		{
+			desc: "sponsor with two enabled claim sponsorships",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}, {Denom: "factory/a/cafe"}},
+				ClaimSponsorshipList: []types.ClaimSponsorship{
+					{Denom: "factory/a/shop", Sponsor: "a", DailyBudget: 10, PerUserCap: 5, Enabled: true},
+					{Denom: "factory/a/cafe", Sponsor: "a", DailyBudget: 10, PerUserCap: 5, Enabled: true},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "sponsored allowance of unknown claim sponsorship",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				VerifiedtokenMap:       []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				SponsoredAllowanceList: []types.SponsoredAllowance{{Sponsor: "a", Grantee: "b", Denom: "factory/a/shop"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated liability checkpoint",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop"}},
+				LiabilityCheckpointList: []types.LiabilityCheckpoint{
+					{Denom: "factory/a/shop", Date: "2026-03-01", Outstanding: 5},
+					{Denom: "factory/a/shop", Date: "2026-03-01"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "verified token linked to unknown merchant",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				VerifiedtokenMap: []types.Verifiedtoken{{Denom: "factory/a/shop", MerchantId: 3}},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// IBCMemoKey is the top-level key of a loyalty memo on an ICS-20 transfer. Memos
+	// without it are left to other middlewares.
+	IBCMemoKey = "loyalty"
+
+	// IBCMemoActionAccrue records a reward accrual for address.
+	IBCMemoActionAccrue = "accrue"
+	// IBCMemoActionFundPool adds the transferred tokens to the reward pool of denom.
+	// The transfer receiver must be the loyalty module account.
+	IBCMemoActionFundPool = "fund_pool"
+)
+
+// IBCLoyaltyMemo is the value under the loyalty key of an ICS-20 memo:
+//
+//	{"loyalty": {"action": "accrue", "address": "tc1...", "denom": "factory/...", "amount": "100"}}
+//	{"loyalty": {"action": "fund_pool", "denom": "factory/..."}}
+type IBCLoyaltyMemo struct {
+	Action  string `json:"action"`
+	Address string `json:"address,omitempty"`
+	Denom   string `json:"denom"`
+	// Amount is a decimal string, as in ICS-20 packet data.
+	Amount string `json:"amount,omitempty"`
+	// Date optionally sets the rollup date (YYYY-MM-DD) of an accrual.
+	Date string `json:"date,omitempty"`
+}
+
+// ParseIBCLoyaltyMemo extracts the loyalty memo from an ICS-20 memo. found is false
+// when the memo is not a JSON object or has no loyalty key; a loyalty key holding
+// anything but a well-formed memo is an error.
+func ParseIBCLoyaltyMemo(memo string) (IBCLoyaltyMemo, bool, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return IBCLoyaltyMemo{}, false, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return IBCLoyaltyMemo{}, false, nil
+	}
+	raw, ok := fields[IBCMemoKey]
+	if !ok {
+		return IBCLoyaltyMemo{}, false, nil
+	}
+
+	var parsed IBCLoyaltyMemo
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&parsed); err != nil {
+		return IBCLoyaltyMemo{}, true, fmt.Errorf("malformed loyalty memo: %w", err)
+	}
+	if err := parsed.ValidateBasic(); err != nil {
+		return IBCLoyaltyMemo{}, true, err
+	}
+	return parsed, true, nil
+}
+
+// ValidateBasic checks the memo fields that do not depend on state.
+func (m IBCLoyaltyMemo) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	switch m.Action {
+	case IBCMemoActionAccrue:
+		if m.Address == "" {
+			return fmt.Errorf("address is required to %s", m.Action)
+		}
+		if _, err := m.AccrualAmount(); err != nil {
+			return err
+		}
+	case IBCMemoActionFundPool:
+		if m.Address != "" || m.Amount != "" || m.Date != "" {
+			return fmt.Errorf("%s takes only a denom; the transferred tokens fund the pool", m.Action)
+		}
+	default:
+		return fmt.Errorf("unknown loyalty memo action %q", m.Action)
+	}
+	return nil
+}
+
+// AccrualAmount parses the amount of an accrue memo.
+func (m IBCLoyaltyMemo) AccrualAmount() (uint64, error) {
+	amount, err := strconv.ParseUint(m.Amount, 10, 64)
+	if err != nil || amount == 0 {
+		return 0, fmt.Errorf("amount must be a positive integer, got %q", m.Amount)
+	}
+	return amount, nil
+}
+
+// IBCMemoIntermediaryAddress is the keyless account that receives the tokens of a
+// fund_pool transfer before they are moved into the reward pool. It is derived per
+// channel and sender, so no one else can reach tokens left there.
+func IBCMemoIntermediaryAddress(channel, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("ibc-memo-intermediary"), []byte(channel), []byte(sender))
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tokenchain/x/loyalty/types"
+)
+
+func TestParseIBCLoyaltyMemo(t *testing.T) {
+	tests := []struct {
+		desc  string
+		memo  string
+		found bool
+		valid bool
+	}{
+		{desc: "empty", memo: "", found: false, valid: true},
+		{desc: "plain text", memo: "thanks for shopping", found: false, valid: true},
+		{desc: "other middleware", memo: `{"forward":{"receiver":"osmo1x"}}`, found: false, valid: true},
+		{desc: "accrue", memo: `{"loyalty":{"action":"accrue","address":"cosmos1holder","denom":"factory/a/shop","amount":"10"}}`, found: true, valid: true},
+		{desc: "accrue with date", memo: `{"loyalty":{"action":"accrue","address":"cosmos1holder","denom":"factory/a/shop","amount":"10","date":"2026-01-02"}}`, found: true, valid: true},
+		{desc: "fund pool", memo: `{"loyalty":{"action":"fund_pool","denom":"factory/a/shop"}}`, found: true, valid: true},
+		{desc: "fund pool with amount", memo: `{"loyalty":{"action":"fund_pool","denom":"factory/a/shop","amount":"10"}}`, found: true, valid: false},
+		{desc: "accrue without address", memo: `{"loyalty":{"action":"accrue","denom":"factory/a/shop","amount":"10"}}`, found: true, valid: false},
+		{desc: "zero amount", memo: `{"loyalty":{"action":"accrue","address":"cosmos1holder","denom":"factory/a/shop","amount":"0"}}`, found: true, valid: false},
+		{desc: "numeric amount", memo: `{"loyalty":{"action":"accrue","address":"cosmos1holder","denom":"factory/a/shop","amount":10}}`, found: true, valid: false},
+		{desc: "unknown field", memo: `{"loyalty":{"action":"fund_pool","denom":"factory/a/shop","note":"hi"}}`, found: true, valid: false},
+		{desc: "unknown action", memo: `{"loyalty":{"action":"burn","denom":"factory/a/shop"}}`, found: true, valid: false},
+		{desc: "not an object", memo: `{"loyalty":"accrue"}`, found: true, valid: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, found, err := types.ParseIBCLoyaltyMemo(tc.memo)
+			require.Equal(t, tc.found, found)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+const (
+	// IBCPolicyAllowed lets the token be sent over any channel.
+	IBCPolicyAllowed = "allowed"
+	// IBCPolicyBlocked keeps the token on this chain.
+	IBCPolicyBlocked = "blocked"
+	// IBCPolicyAllowlisted lets the token be sent over the listed channels only.
+	IBCPolicyAllowlisted = "allowlisted"
+
+	// MaxIBCPolicyChannels bounds the channel allowlist of one token.
+	MaxIBCPolicyChannels = 32
+)
+
+// DefaultTokenIBCPolicy is the policy of a token that has none stored. Seizure and
+// recovery cannot follow a token to another chain, so recovery-enabled tokens stay
+// home until their owner allowlists channels.
+func DefaultTokenIBCPolicy(token Verifiedtoken) TokenIBCPolicy {
+	mode := IBCPolicyAllowed
+	if token.SeizureOptIn {
+		mode = IBCPolicyBlocked
+	}
+	return TokenIBCPolicy{Denom: token.Denom, Mode: mode}
+}
+
+// NormalizeIBCPolicyChannels validates the channel allowlist for mode and returns it
+// sorted and deduplicated. Both IBC v1 channel ids and IBC v2 client ids are accepted.
+func NormalizeIBCPolicyChannels(mode string, channels []string) ([]string, error) {
+	switch mode {
+	case IBCPolicyAllowed, IBCPolicyBlocked:
+		if len(channels) != 0 {
+			return nil, fmt.Errorf("allowed_channels must be empty in %s mode", mode)
+		}
+		return nil, nil
+	case IBCPolicyAllowlisted:
+		if len(channels) == 0 {
+			return nil, fmt.Errorf("allowed_channels must not be empty in %s mode", mode)
+		}
+	default:
+		return nil, fmt.Errorf("unknown ibc policy mode %q", mode)
+	}
+
+	normalized := slices.Clone(channels)
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+	if len(normalized) > MaxIBCPolicyChannels {
+		return nil, fmt.Errorf("at most %d allowed channels", MaxIBCPolicyChannels)
+	}
+	for _, channel := range normalized {
+		if !IsValidIBCChannelID(channel) {
+			return nil, fmt.Errorf("invalid channel id %q", channel)
+		}
+	}
+	return normalized, nil
+}
+
+// IsValidIBCChannelID reports whether id is an IBC v1 channel id or an IBC v2
+// client id.
+func IsValidIBCChannelID(id string) bool {
+	return channeltypes.IsValidChannelID(id) || clienttypes.IsValidClientID(id)
+}
+
+// AllowsChannel reports whether the policy lets the token leave over channel.
+func (p TokenIBCPolicy) AllowsChannel(channel string) bool {
+	switch p.Mode {
+	case IBCPolicyAllowed:
+		return true
+	case IBCPolicyAllowlisted:
+		return slices.Contains(p.AllowedChannels, channel)
+	default:
+		return false
+	}
+}
+
+const (
+	// MinIBCRateLimitWindowSeconds and MaxIBCRateLimitWindowSeconds bound the window
+	// of an IBC rate limit.
+	MinIBCRateLimitWindowSeconds = 60
+	MaxIBCRateLimitWindowSeconds = 30 * 24 * 60 * 60
+)
+
+// ValidateIBCRateLimitDenom checks that denom can be rate limited: only tokens
+// native to this chain are escrowed here, so IBC vouchers are refused.
+func ValidateIBCRateLimitDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+		return fmt.Errorf("%s is not native to this chain", denom)
+	}
+	return nil
+}
+
+// Validate checks the denom, channel, caps and window of a rate limit.
+func (l IBCRateLimit) Validate() error {
+	if err := ValidateIBCRateLimitDenom(l.Denom); err != nil {
+		return err
+	}
+	if !IsValidIBCChannelID(l.ChannelId) {
+		return fmt.Errorf("invalid channel id %q", l.ChannelId)
+	}
+	if l.MaxOutflow == 0 && l.MaxInflow == 0 {
+		return fmt.Errorf("max_outflow or max_inflow must be set")
+	}
+	if l.WindowSeconds < MinIBCRateLimitWindowSeconds || l.WindowSeconds > MaxIBCRateLimitWindowSeconds {
+		return fmt.Errorf("window must be %d to %d seconds", MinIBCRateLimitWindowSeconds, MaxIBCRateLimitWindowSeconds)
+	}
+	return nil
+}
+
+// InWindow returns the flow as of now: once the window of limit has passed, a new
+// one starts empty.
+func (f IBCRateLimitFlow) InWindow(limit IBCRateLimit, now uint64) IBCRateLimitFlow {
+	if f.WindowStart == 0 || now >= f.WindowStart+limit.WindowSeconds {
+		return IBCRateLimitFlow{Denom: limit.Denom, ChannelId: limit.ChannelId, WindowStart: now}
+	}
+	return f
+}
+
+// Usage reports limit against flow, which must be in the window in force.
+func (l IBCRateLimit) Usage(flow IBCRateLimitFlow) IBCRateLimitUsage {
+	usage := IBCRateLimitUsage{RateLimit: l, Flow: flow, WindowEndsAt: flow.WindowStart + l.WindowSeconds}
+	if l.MaxOutflow > 0 {
+		usage.RemainingOutflow = l.MaxOutflow - min(l.MaxOutflow, flow.Outflow)
+	}
+	if l.MaxInflow > 0 {
+		usage.RemainingInflow = l.MaxInflow - min(l.MaxInflow, flow.Inflow)
+	}
+	return usage
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// AddressFreezeKey is the prefix to retrieve address freezes by (denom, address).
+	AddressFreezeKey = collections.NewPrefix("freeze/value/")
+	// AddressFreezeExpiryKey is the prefix of the (expires_at, denom, address) expiry queue.
+	AddressFreezeExpiryKey = collections.NewPrefix("freeze/expiry/")
+)
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// AttestationKey is the prefix to retrieve current attestations by (denom, verifier).
+	AttestationKey = collections.NewPrefix("attestation/value/")
+	// AttestationExpiryKey is the prefix of the (expires_at, denom, verifier) expiry queue.
+	AttestationExpiryKey = collections.NewPrefix("attestation/expiry/")
+	// AttestationHistoryKey is the prefix to retrieve attestation history by (denom, id).
+	AttestationHistoryKey = collections.NewPrefix("attestation/history/")
+	// AttestationHistoryCountKey is the prefix of the attestation history id sequence.
+	AttestationHistoryCountKey = collections.NewPrefix("attestation/count/")
+)
@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ClaimSponsorshipKey is the prefix to retrieve claim sponsorships by denom.
+	ClaimSponsorshipKey = collections.NewPrefix("claimsponsorship/value/")
+	// ClaimSponsorshipUsageKey is the prefix to retrieve the daily usage of claim sponsorships by denom.
+	ClaimSponsorshipUsageKey = collections.NewPrefix("claimsponsorshipusage/value/")
+	// SponsoredAllowanceKey is the prefix to retrieve sponsored allowances by sponsor and grantee.
+	SponsoredAllowanceKey = collections.NewPrefix("sponsoredallowance/value/")
+	// ClaimSponsorshipRevocationKey is the prefix of the (denom, sponsor) queue of
+	// spent claim sponsorships whose allowances are revoked in EndBlock.
+	ClaimSponsorshipRevocationKey = collections.NewPrefix("claimsponsorship/revoke/")
+)
@@ -4,3 +4,6 @@ import "cosmossdk.io/collections"
 
 // CreatorallowlistKey is the prefix to retrieve all Creatorallowlist
 var CreatorallowlistKey = collections.NewPrefix("creatorallowlist/value/")
+
+// CreatorUsageKey is the prefix to retrieve creator usage by address.
+var CreatorUsageKey = collections.NewPrefix("creatorusage/value/")
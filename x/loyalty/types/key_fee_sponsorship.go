@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// FeeTokenKey is the prefix to retrieve fee tokens by denom.
+	FeeTokenKey = collections.NewPrefix("feetoken/value/")
+	// GasPoolKey is the prefix to retrieve gas pools by denom.
+	GasPoolKey = collections.NewPrefix("gaspool/value/")
+)
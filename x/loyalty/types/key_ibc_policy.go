@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// TokenIBCPolicyKey is the prefix to retrieve token IBC policies by denom.
+	TokenIBCPolicyKey = collections.NewPrefix("ibcpolicy/value/")
+	// IBCEscrowKey is the prefix of the escrowed amount per denom.
+	IBCEscrowKey = collections.NewPrefix("ibcescrow/value/")
+	// IBCRecorderKey is the prefix to retrieve IBC memo recorders by (channel, sender).
+	IBCRecorderKey = collections.NewPrefix("ibcrecorder/value/")
+	// IBCRateLimitKey is the prefix to retrieve IBC rate limits by (denom, channel).
+	IBCRateLimitKey = collections.NewPrefix("ibcratelimit/value/")
+	// IBCRateLimitFlowKey is the prefix of the flow counted against each rate limit.
+	IBCRateLimitFlowKey = collections.NewPrefix("ibcratelimitflow/value/")
+)
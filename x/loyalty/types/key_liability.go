@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// RewardLiabilityKey is the prefix to retrieve the running reward liability by denom.
+	RewardLiabilityKey = collections.NewPrefix("rewardliability/value/")
+	// LiabilityCheckpointKey is the prefix to retrieve liability checkpoints by denom and date.
+	LiabilityCheckpointKey = collections.NewPrefix("liabilitycheckpoint/value/")
+)
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// MerchantKey is the prefix to retrieve all Merchant
+	MerchantKey = collections.NewPrefix("merchant/value/")
+	// MerchantCountKey is the prefix of the merchant id sequence.
+	MerchantCountKey = collections.NewPrefix("merchant/count/")
+)
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// MerchantICAKey is the prefix to retrieve merchant interchain accounts by
+	// (merchant_id, connection_id).
+	MerchantICAKey = collections.NewPrefix("merchantica/value/")
+	// MerchantICAPacketKey is the prefix of in-flight merchant packets by
+	// (channel_id, sequence).
+	MerchantICAPacketKey = collections.NewPrefix("merchanticapacket/value/")
+	// TreasuryForwardKey is the prefix of treasury forwards by (merchant_id, allocation_key).
+	TreasuryForwardKey = collections.NewPrefix("treasuryforward/value/")
+)
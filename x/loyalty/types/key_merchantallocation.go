@@ -2,5 +2,9 @@ package types
 
 import "cosmossdk.io/collections"
 
-// MerchantallocationKey is the prefix to retrieve all Merchantallocation.
-var MerchantallocationKey = collections.NewPrefix("merchantallocation/value/")
+var (
+	// MerchantallocationKey is the prefix to retrieve all Merchantallocation.
+	MerchantallocationKey = collections.NewPrefix("merchantallocation/value/")
+	// TokenStakerStatsKey is the prefix to retrieve the allocation totals of a token by denom.
+	TokenStakerStatsKey = collections.NewPrefix("tokenstakerstats/value/")
+)
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// PendingMetadataChangeKey is the prefix to retrieve pending metadata changes by denom.
+	PendingMetadataChangeKey = collections.NewPrefix("metadata/pending/")
+	// MetadataChangeQueueKey is the prefix of the (effective_at, denom) apply queue.
+	MetadataChangeQueueKey = collections.NewPrefix("metadata/queue/")
+	// MetadataVersionKey is the prefix to retrieve metadata versions by (denom, version).
+	MetadataVersionKey = collections.NewPrefix("metadata/version/")
+)
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// MintRateLimitKey is the prefix to retrieve mint rate limits by denom.
+	MintRateLimitKey = collections.NewPrefix("mintlimit/value/")
+	// PendingMintRateLimitKey is the prefix to retrieve pending mint rate limits by denom.
+	PendingMintRateLimitKey = collections.NewPrefix("mintlimit/pending/")
+	// MintRateLimitQueueKey is the prefix of the (effective_at, denom) apply queue.
+	MintRateLimitQueueKey = collections.NewPrefix("mintlimit/queue/")
+	// MintUsageKey is the prefix to retrieve mint usage by denom.
+	MintUsageKey = collections.NewPrefix("mintlimit/usage/")
+)
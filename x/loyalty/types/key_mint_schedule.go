@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// MintScheduleKey is the prefix to retrieve mint schedules by (denom, id).
+	MintScheduleKey = collections.NewPrefix("mintschedule/value/")
+	// MintScheduleQueueKey is the prefix of the (next_release_date, denom, id) release queue.
+	MintScheduleQueueKey = collections.NewPrefix("mintschedule/queue/")
+	// MintScheduleCountKey is the prefix of the mint schedule id sequence.
+	MintScheduleCountKey = collections.NewPrefix("mintschedule/count/")
+)
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// TokenPauseKey is the prefix to retrieve token pauses by denom.
+	TokenPauseKey = collections.NewPrefix("pause/value/")
+	// TokenPauseExpiryKey is the prefix of the (expires_at, denom) expiry queue.
+	TokenPauseExpiryKey = collections.NewPrefix("pause/expiry/")
+)
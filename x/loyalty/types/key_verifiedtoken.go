@@ -2,5 +2,11 @@ package types
 
 import "cosmossdk.io/collections"
 
-// VerifiedtokenKey is the prefix to retrieve all Verifiedtoken
-var VerifiedtokenKey = collections.NewPrefix("verifiedtoken/value/")
+var (
+	// VerifiedtokenKey is the prefix to retrieve all Verifiedtoken
+	VerifiedtokenKey = collections.NewPrefix("verifiedtoken/value/")
+	// VerifiedtokenIssuerIndexKey is the prefix of the index of verified tokens by issuer.
+	VerifiedtokenIssuerIndexKey = collections.NewPrefix("verifiedtoken/issuer/")
+	// VerifiedtokenSymbolIndexKey is the prefix of the index of verified tokens by lowercased symbol.
+	VerifiedtokenSymbolIndexKey = collections.NewPrefix("verifiedtoken/symbol/")
+)
@@ -23,3 +23,6 @@ var (
 	RecoveryoperationCountKey = collections.NewPrefix("recoveryoperation/count/")
 	LastDailyRollupDateKey    = collections.NewPrefix("daily_rollup/date/")
 )
+
+// AuthoritiesKey is the prefix to retrieve the scoped role holders.
+var AuthoritiesKey = collections.NewPrefix("authorities/value/")
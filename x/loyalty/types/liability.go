@@ -0,0 +1,30 @@
+package types
+
+// Credit adds the accrual of one holder to l.
+func (l RewardLiability) Credit(amount uint64) RewardLiability {
+	if amount == 0 {
+		return l
+	}
+	l.Outstanding += amount
+	l.Holders++
+	return l
+}
+
+// Debit removes the accrual of one holder from l.
+func (l RewardLiability) Debit(amount uint64) RewardLiability {
+	if amount == 0 {
+		return l
+	}
+	l.Outstanding -= min(amount, l.Outstanding)
+	l.Holders -= min(1, l.Holders)
+	return l
+}
+
+// SameTotals reports whether c and other hold the same figures, whatever their
+// dates.
+func (c LiabilityCheckpoint) SameTotals(other LiabilityCheckpoint) bool {
+	return c.Outstanding == other.Outstanding &&
+		c.PoolBalance == other.PoolBalance &&
+		c.MintedSupply == other.MintedSupply &&
+		c.Holders == other.Holders
+}
@@ -0,0 +1,803 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/liability.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardLiability is the running total of the outstanding reward accruals of a
+// denom. It is kept up to date as accruals are credited, edited and claimed.
+type RewardLiability struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// outstanding is the sum of the unclaimed accruals.
+	Outstanding uint64 `protobuf:"varint,2,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	// holders is the number of addresses with an unclaimed accrual.
+	Holders uint64 `protobuf:"varint,3,opt,name=holders,proto3" json:"holders,omitempty"`
+}
+
+func (m *RewardLiability) Reset()         { *m = RewardLiability{} }
+func (m *RewardLiability) String() string { return proto.CompactTextString(m) }
+func (*RewardLiability) ProtoMessage()    {}
+func (*RewardLiability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7493f0b023aefc70, []int{0}
+}
+func (m *RewardLiability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardLiability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardLiability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardLiability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardLiability.Merge(m, src)
+}
+func (m *RewardLiability) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardLiability) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardLiability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardLiability proto.InternalMessageInfo
+
+func (m *RewardLiability) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RewardLiability) GetOutstanding() uint64 {
+	if m != nil {
+		return m.Outstanding
+	}
+	return 0
+}
+
+func (m *RewardLiability) GetHolders() uint64 {
+	if m != nil {
+		return m.Holders
+	}
+	return 0
+}
+
+// LiabilityCheckpoint is the reward liability of a verified token at the close of
+// a rollup date. Checkpoints are written by the daily rollup and only when
+// something changed since the previous one.
+type LiabilityCheckpoint struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// date is the rollup date (YYYY-MM-DD) the checkpoint closes.
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// outstanding is the sum of the unclaimed accruals.
+	Outstanding uint64 `protobuf:"varint,3,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	// pool_balance is the balance of the reward pool that pays claims.
+	PoolBalance  uint64 `protobuf:"varint,4,opt,name=pool_balance,json=poolBalance,proto3" json:"pool_balance,omitempty"`
+	MintedSupply uint64 `protobuf:"varint,5,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
+	// holders is the number of addresses with an unclaimed accrual.
+	Holders uint64 `protobuf:"varint,6,opt,name=holders,proto3" json:"holders,omitempty"`
+	// height is the block that wrote the checkpoint, the first of the next date.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *LiabilityCheckpoint) Reset()         { *m = LiabilityCheckpoint{} }
+func (m *LiabilityCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*LiabilityCheckpoint) ProtoMessage()    {}
+func (*LiabilityCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7493f0b023aefc70, []int{1}
+}
+func (m *LiabilityCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiabilityCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiabilityCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiabilityCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiabilityCheckpoint.Merge(m, src)
+}
+func (m *LiabilityCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiabilityCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiabilityCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiabilityCheckpoint proto.InternalMessageInfo
+
+func (m *LiabilityCheckpoint) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LiabilityCheckpoint) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *LiabilityCheckpoint) GetOutstanding() uint64 {
+	if m != nil {
+		return m.Outstanding
+	}
+	return 0
+}
+
+func (m *LiabilityCheckpoint) GetPoolBalance() uint64 {
+	if m != nil {
+		return m.PoolBalance
+	}
+	return 0
+}
+
+func (m *LiabilityCheckpoint) GetMintedSupply() uint64 {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return 0
+}
+
+func (m *LiabilityCheckpoint) GetHolders() uint64 {
+	if m != nil {
+		return m.Holders
+	}
+	return 0
+}
+
+func (m *LiabilityCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RewardLiability)(nil), "tokenchain.loyalty.v1.RewardLiability")
+	proto.RegisterType((*LiabilityCheckpoint)(nil), "tokenchain.loyalty.v1.LiabilityCheckpoint")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/liability.proto", fileDescriptor_7493f0b023aefc70)
+}
+
+var fileDescriptor_7493f0b023aefc70 = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
+	0x1c, 0x86, 0x17, 0xb7, 0x75, 0x2c, 0x4e, 0x84, 0xf8, 0x87, 0xe0, 0x21, 0xd4, 0x89, 0xd0, 0x53,
+	0xcb, 0xd0, 0x4f, 0x30, 0xaf, 0x9e, 0xea, 0xcd, 0xcb, 0x48, 0x9b, 0xb0, 0x86, 0x65, 0x49, 0x68,
+	0xb3, 0x69, 0xbf, 0x85, 0x1f, 0xcb, 0xe3, 0x8e, 0x82, 0x17, 0x69, 0xbf, 0x88, 0x98, 0x6e, 0xb3,
+	0x2a, 0xde, 0xfa, 0x3e, 0x7d, 0xc8, 0xcb, 0x8f, 0x17, 0x5e, 0x5b, 0xbd, 0xe0, 0x2a, 0xcd, 0xa8,
+	0x50, 0x91, 0xd4, 0x25, 0x95, 0xb6, 0x8c, 0xd6, 0x93, 0x48, 0x0a, 0x9a, 0x08, 0x29, 0x6c, 0x19,
+	0x9a, 0x5c, 0x5b, 0x8d, 0xce, 0xbe, 0xb5, 0x70, 0xab, 0x85, 0xeb, 0xc9, 0x38, 0x85, 0xc7, 0x31,
+	0x7f, 0xa2, 0x39, 0xbb, 0xdf, 0xf9, 0xe8, 0x14, 0xf6, 0x19, 0x57, 0x7a, 0x89, 0x81, 0x0f, 0x82,
+	0x61, 0xdc, 0x04, 0xe4, 0xc3, 0x43, 0xbd, 0xb2, 0x85, 0xa5, 0x8a, 0x09, 0x35, 0xc7, 0x07, 0x3e,
+	0x08, 0x7a, 0x71, 0x1b, 0x21, 0x0c, 0x07, 0x99, 0x96, 0x8c, 0xe7, 0x05, 0xee, 0xba, 0xbf, 0xbb,
+	0x38, 0x7e, 0x07, 0xf0, 0x64, 0xff, 0xfe, 0x5d, 0xc6, 0xd3, 0x85, 0xd1, 0x42, 0xd9, 0x7f, 0x9a,
+	0x10, 0xec, 0x31, 0x6a, 0xb9, 0xab, 0x18, 0xc6, 0xee, 0xfb, 0x77, 0x7b, 0xf7, 0x6f, 0xfb, 0x25,
+	0x1c, 0x19, 0xad, 0xe5, 0x2c, 0xa1, 0x92, 0xaa, 0x94, 0xe3, 0x5e, 0xa3, 0x7c, 0xb1, 0x69, 0x83,
+	0xd0, 0x15, 0x3c, 0x5a, 0x0a, 0x65, 0x39, 0x9b, 0x15, 0x2b, 0x63, 0x64, 0x89, 0xfb, 0xce, 0x19,
+	0x35, 0xf0, 0xc1, 0xb1, 0xf6, 0x15, 0xde, 0x8f, 0x2b, 0xd0, 0x39, 0xf4, 0x32, 0x2e, 0xe6, 0x99,
+	0xc5, 0x03, 0x1f, 0x04, 0xdd, 0x78, 0x9b, 0xa6, 0xb7, 0xaf, 0x15, 0x01, 0x9b, 0x8a, 0x80, 0x8f,
+	0x8a, 0x80, 0x97, 0x9a, 0x74, 0x36, 0x35, 0xe9, 0xbc, 0xd5, 0xa4, 0xf3, 0x78, 0xd1, 0x9a, 0xe6,
+	0x79, 0x3f, 0x8e, 0x2d, 0x0d, 0x2f, 0x12, 0xcf, 0xcd, 0x72, 0xf3, 0x39, 0x00, 0x0d, 0xed, 0x59,
+	0xa5, 0xbf, 0x01, 0x00, 0x00,
+}
+
+func (m *RewardLiability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardLiability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardLiability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Holders != 0 {
+		i = encodeVarintLiability(dAtA, i, uint64(m.Holders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Outstanding != 0 {
+		i = encodeVarintLiability(dAtA, i, uint64(m.Outstanding))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiability(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiabilityCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiabilityCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiabilityCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintLiability(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Holders != 0 {
+		i = encodeVarintLiability(dAtA, i, uint64(m.Holders))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MintedSupply != 0 {
+		i = encodeVarintLiability(dAtA, i, uint64(m.MintedSupply))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolBalance != 0 {
+		i = encodeVarintLiability(dAtA, i, uint64(m.PoolBalance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Outstanding != 0 {
+		i = encodeVarintLiability(dAtA, i, uint64(m.Outstanding))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintLiability(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiability(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiability(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiability(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardLiability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiability(uint64(l))
+	}
+	if m.Outstanding != 0 {
+		n += 1 + sovLiability(uint64(m.Outstanding))
+	}
+	if m.Holders != 0 {
+		n += 1 + sovLiability(uint64(m.Holders))
+	}
+	return n
+}
+
+func (m *LiabilityCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiability(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovLiability(uint64(l))
+	}
+	if m.Outstanding != 0 {
+		n += 1 + sovLiability(uint64(m.Outstanding))
+	}
+	if m.PoolBalance != 0 {
+		n += 1 + sovLiability(uint64(m.PoolBalance))
+	}
+	if m.MintedSupply != 0 {
+		n += 1 + sovLiability(uint64(m.MintedSupply))
+	}
+	if m.Holders != 0 {
+		n += 1 + sovLiability(uint64(m.Holders))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiability(uint64(m.Height))
+	}
+	return n
+}
+
+func sovLiability(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiability(x uint64) (n int) {
+	return sovLiability(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardLiability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiability
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardLiability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardLiability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiability
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			m.Outstanding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outstanding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			m.Holders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiability(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiability
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiabilityCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiability
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiabilityCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiabilityCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiability
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiability
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			m.Outstanding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outstanding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBalance", wireType)
+			}
+			m.PoolBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			m.MintedSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			m.Holders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiability(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiability
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiability(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiability
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiability
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiability
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiability
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiability
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiability        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiability          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiability = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	MerchantTierUnverified = "unverified"
+	MerchantTierBasic      = "basic"
+	MerchantTierEnhanced   = "enhanced"
+
+	maxMerchantLegalNameLength  = 256
+	maxMerchantContactURILength = 512
+	merchantLogoHashLength      = 64
+)
+
+// ValidateMerchantTier checks that tier is a known verification tier.
+func ValidateMerchantTier(tier string) error {
+	switch tier {
+	case MerchantTierUnverified, MerchantTierBasic, MerchantTierEnhanced:
+		return nil
+	default:
+		return fmt.Errorf("unknown verification tier %q", tier)
+	}
+}
+
+// ValidateMerchantProfile checks the free-form merchant profile fields. Address
+// fields are validated by the keeper against its address codec.
+func ValidateMerchantProfile(legalName, contactURI, logoHash string) error {
+	legalName = strings.TrimSpace(legalName)
+	if legalName == "" {
+		return fmt.Errorf("legal name cannot be blank")
+	}
+	if len(legalName) > maxMerchantLegalNameLength {
+		return fmt.Errorf("legal name exceeds %d characters", maxMerchantLegalNameLength)
+	}
+	if len(contactURI) > maxMerchantContactURILength {
+		return fmt.Errorf("contact uri exceeds %d characters", maxMerchantContactURILength)
+	}
+	if logoHash != "" {
+		if len(logoHash) != merchantLogoHashLength {
+			return fmt.Errorf("logo hash must be a %d character hex-encoded sha256", merchantLogoHashLength)
+		}
+		if _, err := hex.DecodeString(logoHash); err != nil {
+			return fmt.Errorf("logo hash must be hex-encoded: %w", err)
+		}
+	}
+	return nil
+}
+
+// AddDenom links denom to the merchant if it is not linked yet.
+func (m *Merchant) AddDenom(denom string) {
+	if !slices.Contains(m.Denoms, denom) {
+		m.Denoms = append(m.Denoms, denom)
+	}
+}
+
+// RemoveDenom unlinks denom from the merchant.
+func (m *Merchant) RemoveDenom(denom string) {
+	m.Denoms = slices.DeleteFunc(m.Denoms, func(d string) bool { return d == denom })
+}
@@ -0,0 +1,827 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/merchant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Merchant is a business registered with the loyalty module. It carries the identity
+// and payout details shared by the verified tokens it owns.
+type Merchant struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the account that registered the merchant and manages its profile.
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	LegalName string `protobuf:"bytes,3,opt,name=legal_name,json=legalName,proto3" json:"legal_name,omitempty"`
+	// payout_address receives merchant-side settlements.
+	PayoutAddress string `protobuf:"bytes,4,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	ContactUri    string `protobuf:"bytes,5,opt,name=contact_uri,json=contactUri,proto3" json:"contact_uri,omitempty"`
+	// verification_tier is assigned by allowlist admins: unverified, basic or enhanced.
+	VerificationTier string `protobuf:"bytes,6,opt,name=verification_tier,json=verificationTier,proto3" json:"verification_tier,omitempty"`
+	// logo_hash is the hex-encoded SHA-256 of the merchant logo.
+	LogoHash string `protobuf:"bytes,7,opt,name=logo_hash,json=logoHash,proto3" json:"logo_hash,omitempty"`
+	// denoms lists the verified tokens linked to this merchant.
+	Denoms        []string `protobuf:"bytes,8,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	Active        bool     `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     uint64   `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     uint64   `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeactivatedAt uint64   `protobuf:"varint,12,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+}
+
+func (m *Merchant) Reset()         { *m = Merchant{} }
+func (m *Merchant) String() string { return proto.CompactTextString(m) }
+func (*Merchant) ProtoMessage()    {}
+func (*Merchant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94d926a3a88e17c, []int{0}
+}
+func (m *Merchant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Merchant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Merchant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Merchant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Merchant.Merge(m, src)
+}
+func (m *Merchant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Merchant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Merchant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Merchant proto.InternalMessageInfo
+
+func (m *Merchant) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Merchant) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Merchant) GetLegalName() string {
+	if m != nil {
+		return m.LegalName
+	}
+	return ""
+}
+
+func (m *Merchant) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+func (m *Merchant) GetContactUri() string {
+	if m != nil {
+		return m.ContactUri
+	}
+	return ""
+}
+
+func (m *Merchant) GetVerificationTier() string {
+	if m != nil {
+		return m.VerificationTier
+	}
+	return ""
+}
+
+func (m *Merchant) GetLogoHash() string {
+	if m != nil {
+		return m.LogoHash
+	}
+	return ""
+}
+
+func (m *Merchant) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *Merchant) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Merchant) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Merchant) GetUpdatedAt() uint64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Merchant) GetDeactivatedAt() uint64 {
+	if m != nil {
+		return m.DeactivatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Merchant)(nil), "tokenchain.loyalty.v1.Merchant")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/merchant.proto", fileDescriptor_e94d926a3a88e17c)
+}
+
+var fileDescriptor_e94d926a3a88e17c = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcd, 0x8e, 0xd3, 0x30,
+	0x14, 0x85, 0x9b, 0xf4, 0x87, 0xc4, 0xa5, 0x15, 0x58, 0x80, 0x2c, 0x10, 0x21, 0x42, 0x20, 0x45,
+	0x42, 0x6a, 0x55, 0xc1, 0x0b, 0x84, 0x15, 0x1b, 0x58, 0x44, 0xb0, 0x61, 0x13, 0x99, 0xf8, 0xd2,
+	0x58, 0x93, 0xd8, 0x91, 0x73, 0x9b, 0x99, 0xbc, 0xc5, 0x3c, 0xd6, 0x2c, 0xbb, 0x9c, 0xe5, 0xa8,
+	0x7d, 0x87, 0x59, 0x8f, 0xe2, 0xa4, 0x33, 0x5d, 0x9e, 0xef, 0x7c, 0xb2, 0x74, 0x7d, 0xc8, 0x27,
+	0xd4, 0x17, 0xa0, 0xb2, 0x9c, 0x4b, 0xb5, 0x2e, 0x74, 0xcb, 0x0b, 0x6c, 0xd7, 0xcd, 0x66, 0x5d,
+	0x82, 0xc9, 0x72, 0xae, 0x70, 0x55, 0x19, 0x8d, 0x9a, 0xbe, 0x7e, 0xb2, 0x56, 0x83, 0xb5, 0x6a,
+	0x36, 0x1f, 0xef, 0x5d, 0xe2, 0xfd, 0x1c, 0x4c, 0xba, 0x24, 0xae, 0x14, 0xcc, 0x09, 0x9d, 0x68,
+	0x92, 0xb8, 0x52, 0xd0, 0x57, 0x64, 0xaa, 0x2f, 0x15, 0x18, 0xe6, 0x86, 0x4e, 0xe4, 0x27, 0x7d,
+	0xa0, 0xef, 0x09, 0x29, 0x60, 0xcb, 0x8b, 0x54, 0xf1, 0x12, 0xd8, 0xd8, 0x56, 0xbe, 0x25, 0xbf,
+	0x78, 0x09, 0xf4, 0x33, 0x59, 0x56, 0xbc, 0xd5, 0x3b, 0x4c, 0xb9, 0x10, 0x06, 0xea, 0x9a, 0x4d,
+	0xac, 0xb2, 0xe8, 0x69, 0xdc, 0x43, 0xfa, 0x81, 0xcc, 0x33, 0xad, 0x90, 0x67, 0x98, 0xee, 0x8c,
+	0x64, 0x53, 0xeb, 0x90, 0x01, 0xfd, 0x31, 0x92, 0x7e, 0x21, 0x2f, 0x1b, 0x30, 0xf2, 0xbf, 0xcc,
+	0x38, 0x4a, 0xad, 0x52, 0x94, 0x60, 0xd8, 0xcc, 0x6a, 0x2f, 0xce, 0x8b, 0xdf, 0x12, 0x0c, 0x7d,
+	0x47, 0xfc, 0x42, 0x6f, 0x75, 0x9a, 0xf3, 0x3a, 0x67, 0xcf, 0xac, 0xe4, 0x75, 0xe0, 0x07, 0xaf,
+	0x73, 0xfa, 0x86, 0xcc, 0x04, 0x28, 0x5d, 0xd6, 0xcc, 0x0b, 0xc7, 0x91, 0x9f, 0x0c, 0xa9, 0xe3,
+	0x3c, 0x43, 0xd9, 0x00, 0xf3, 0x43, 0x27, 0xf2, 0x92, 0x21, 0x75, 0x07, 0x66, 0x06, 0x38, 0x82,
+	0x48, 0x39, 0x32, 0x62, 0xbf, 0xc3, 0x1f, 0x48, 0x8c, 0x5d, 0xbd, 0xab, 0xc4, 0xa9, 0x9e, 0xf7,
+	0xf5, 0x40, 0x62, 0xec, 0xee, 0x17, 0x60, 0x5f, 0x3a, 0x29, 0xcf, 0xad, 0xb2, 0x38, 0xa3, 0x31,
+	0x7e, 0xff, 0x76, 0x73, 0x08, 0x9c, 0xfd, 0x21, 0x70, 0xee, 0x0e, 0x81, 0x73, 0x7d, 0x0c, 0x46,
+	0xfb, 0x63, 0x30, 0xba, 0x3d, 0x06, 0xa3, 0xbf, 0x6f, 0xcf, 0xf6, 0xbc, 0x7a, 0x5c, 0x14, 0xdb,
+	0x0a, 0xea, 0x7f, 0x33, 0x3b, 0xe6, 0xd7, 0x87, 0x01, 0x00, 0x70, 0xc6, 0x17, 0x4d, 0xf4, 0x01,
+	0x00, 0x00,
+}
+
+func (m *Merchant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Merchant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Merchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeactivatedAt != 0 {
+		i = encodeVarintMerchant(dAtA, i, uint64(m.DeactivatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintMerchant(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintMerchant(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintMerchant(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogoHash) > 0 {
+		i -= len(m.LogoHash)
+		copy(dAtA[i:], m.LogoHash)
+		i = encodeVarintMerchant(dAtA, i, uint64(len(m.LogoHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VerificationTier) > 0 {
+		i -= len(m.VerificationTier)
+		copy(dAtA[i:], m.VerificationTier)
+		i = encodeVarintMerchant(dAtA, i, uint64(len(m.VerificationTier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ContactUri) > 0 {
+		i -= len(m.ContactUri)
+		copy(dAtA[i:], m.ContactUri)
+		i = encodeVarintMerchant(dAtA, i, uint64(len(m.ContactUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintMerchant(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LegalName) > 0 {
+		i -= len(m.LegalName)
+		copy(dAtA[i:], m.LegalName)
+		i = encodeVarintMerchant(dAtA, i, uint64(len(m.LegalName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMerchant(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMerchant(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMerchant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMerchant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Merchant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMerchant(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMerchant(uint64(l))
+	}
+	l = len(m.LegalName)
+	if l > 0 {
+		n += 1 + l + sovMerchant(uint64(l))
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovMerchant(uint64(l))
+	}
+	l = len(m.ContactUri)
+	if l > 0 {
+		n += 1 + l + sovMerchant(uint64(l))
+	}
+	l = len(m.VerificationTier)
+	if l > 0 {
+		n += 1 + l + sovMerchant(uint64(l))
+	}
+	l = len(m.LogoHash)
+	if l > 0 {
+		n += 1 + l + sovMerchant(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovMerchant(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovMerchant(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovMerchant(uint64(m.UpdatedAt))
+	}
+	if m.DeactivatedAt != 0 {
+		n += 1 + sovMerchant(uint64(m.DeactivatedAt))
+	}
+	return n
+}
+
+func sovMerchant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMerchant(x uint64) (n int) {
+	return sovMerchant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Merchant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMerchant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Merchant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Merchant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationTier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationTier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogoHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedAt", wireType)
+			}
+			m.DeactivatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMerchant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMerchant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMerchant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMerchant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMerchant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMerchant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMerchant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMerchant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMerchant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMerchant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMerchant = fmt.Errorf("proto: unexpected end of group")
+)
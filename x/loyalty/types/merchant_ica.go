@@ -0,0 +1,132 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+const (
+	// MerchantICAStatusPending is the status of an account whose channel handshake
+	// has not completed.
+	MerchantICAStatusPending = "pending"
+	// MerchantICAStatusActive is the status of an account with an open channel.
+	MerchantICAStatusActive = "active"
+	// MerchantICAStatusClosed is the status of an account whose channel closed. It can
+	// be registered again, which reopens the same account.
+	MerchantICAStatusClosed = "closed"
+
+	// MerchantPacketKindTx is an interchain account transaction.
+	MerchantPacketKindTx = "tx"
+	// MerchantPacketKindTreasuryForward is an ICS-20 transfer of treasury funds to
+	// an interchain account.
+	MerchantPacketKindTreasuryForward = "treasury_forward"
+
+	// MerchantPacketAcknowledged, MerchantPacketFailed and MerchantPacketTimedOut
+	// are the outcomes a merchant packet settles with.
+	MerchantPacketAcknowledged = "acknowledged"
+	MerchantPacketFailed       = "failed"
+	MerchantPacketTimedOut     = "timed_out"
+
+	// TreasuryForwardInFlight and TreasuryForwardCompleted are the statuses of a
+	// treasury forward.
+	TreasuryForwardInFlight  = "in_flight"
+	TreasuryForwardCompleted = "completed"
+
+	merchantICAOwnerPrefix = "loyalty-merchant-"
+)
+
+// MerchantICAOwner is the interchain account owner of a merchant. The owner is not
+// an address, so only the loyalty module can use the merchant's controller port.
+func MerchantICAOwner(merchantID uint64) string {
+	return merchantICAOwnerPrefix + strconv.FormatUint(merchantID, 10)
+}
+
+// MerchantICAPortID is the controller port of a merchant's interchain accounts.
+func MerchantICAPortID(merchantID uint64) string {
+	return icatypes.ControllerPortPrefix + MerchantICAOwner(merchantID)
+}
+
+// MerchantIDFromICAPort returns the merchant owning a controller port, and false
+// for ports that belong to no merchant.
+func MerchantIDFromICAPort(portID string) (uint64, bool) {
+	raw, ok := strings.CutPrefix(portID, icatypes.ControllerPortPrefix+merchantICAOwnerPrefix)
+	if !ok {
+		return 0, false
+	}
+	merchantID, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil || merchantID == 0 || MerchantICAPortID(merchantID) != portID {
+		return 0, false
+	}
+	return merchantID, true
+}
+
+// Validate checks a merchant interchain account record.
+func (ica MerchantICA) Validate() error {
+	if ica.MerchantId == 0 {
+		return fmt.Errorf("merchant id is required")
+	}
+	if !connectiontypes.IsValidConnectionID(ica.ConnectionId) {
+		return fmt.Errorf("invalid connection id %q", ica.ConnectionId)
+	}
+	if ica.PortId != MerchantICAPortID(ica.MerchantId) {
+		return fmt.Errorf("port id must be %s", MerchantICAPortID(ica.MerchantId))
+	}
+	if ica.ChannelId != "" && !channeltypes.IsValidChannelID(ica.ChannelId) {
+		return fmt.Errorf("invalid channel id %q", ica.ChannelId)
+	}
+	switch ica.Status {
+	case MerchantICAStatusPending, MerchantICAStatusClosed:
+	case MerchantICAStatusActive:
+		if ica.Address == "" || ica.ChannelId == "" {
+			return fmt.Errorf("active account needs a channel and an address")
+		}
+	default:
+		return fmt.Errorf("unknown status %q", ica.Status)
+	}
+	return nil
+}
+
+// Validate checks an in-flight merchant packet record.
+func (p MerchantICAPacket) Validate() error {
+	if !channeltypes.IsValidChannelID(p.ChannelId) {
+		return fmt.Errorf("invalid channel id %q", p.ChannelId)
+	}
+	if p.Sequence == 0 || p.MerchantId == 0 {
+		return fmt.Errorf("sequence and merchant id are required")
+	}
+	switch p.Kind {
+	case MerchantPacketKindTx:
+		if p.AllocationKey != "" {
+			return fmt.Errorf("%s packets carry no allocation key", p.Kind)
+		}
+	case MerchantPacketKindTreasuryForward:
+		if p.AllocationKey == "" {
+			return fmt.Errorf("%s packets need an allocation key", p.Kind)
+		}
+	default:
+		return fmt.Errorf("unknown packet kind %q", p.Kind)
+	}
+	return nil
+}
+
+// Validate checks a treasury forward record.
+func (f TreasuryForward) Validate() error {
+	if f.MerchantId == 0 || f.AllocationKey == "" {
+		return fmt.Errorf("merchant id and allocation key are required")
+	}
+	if f.Amount == 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	if !channeltypes.IsValidChannelID(f.ChannelId) {
+		return fmt.Errorf("invalid channel id %q", f.ChannelId)
+	}
+	if f.Status != TreasuryForwardInFlight && f.Status != TreasuryForwardCompleted {
+		return fmt.Errorf("unknown status %q", f.Status)
+	}
+	return nil
+}
@@ -0,0 +1,2086 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/merchant_ica.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MerchantICA is an interchain account a merchant controls on another chain. The
+// account is registered by the owner of one of the merchant's verified tokens and
+// is keyed by merchant and connection.
+type MerchantICA struct {
+	MerchantId   uint64 `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// port_id is the controller port owned by the merchant.
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel of the latest handshake.
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// address is the interchain account on the host chain, set once the channel opens.
+	Address string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// status is "pending" during the handshake, then "active" or "closed".
+	Status              string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	RegisteredBy        string `protobuf:"bytes,7,opt,name=registered_by,json=registeredBy,proto3" json:"registered_by,omitempty"`
+	RegisteredAt        uint64 `protobuf:"varint,8,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	OpenedAt            uint64 `protobuf:"varint,9,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	PacketsSent         uint64 `protobuf:"varint,10,opt,name=packets_sent,json=packetsSent,proto3" json:"packets_sent,omitempty"`
+	PacketsAcknowledged uint64 `protobuf:"varint,11,opt,name=packets_acknowledged,json=packetsAcknowledged,proto3" json:"packets_acknowledged,omitempty"`
+	PacketsFailed       uint64 `protobuf:"varint,12,opt,name=packets_failed,json=packetsFailed,proto3" json:"packets_failed,omitempty"`
+	PacketsTimedOut     uint64 `protobuf:"varint,13,opt,name=packets_timed_out,json=packetsTimedOut,proto3" json:"packets_timed_out,omitempty"`
+}
+
+func (m *MerchantICA) Reset()         { *m = MerchantICA{} }
+func (m *MerchantICA) String() string { return proto.CompactTextString(m) }
+func (*MerchantICA) ProtoMessage()    {}
+func (*MerchantICA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9da6aac30dde6415, []int{0}
+}
+func (m *MerchantICA) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerchantICA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerchantICA.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerchantICA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantICA.Merge(m, src)
+}
+func (m *MerchantICA) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerchantICA) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantICA.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantICA proto.InternalMessageInfo
+
+func (m *MerchantICA) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+func (m *MerchantICA) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MerchantICA) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MerchantICA) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MerchantICA) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MerchantICA) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *MerchantICA) GetRegisteredBy() string {
+	if m != nil {
+		return m.RegisteredBy
+	}
+	return ""
+}
+
+func (m *MerchantICA) GetRegisteredAt() uint64 {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return 0
+}
+
+func (m *MerchantICA) GetOpenedAt() uint64 {
+	if m != nil {
+		return m.OpenedAt
+	}
+	return 0
+}
+
+func (m *MerchantICA) GetPacketsSent() uint64 {
+	if m != nil {
+		return m.PacketsSent
+	}
+	return 0
+}
+
+func (m *MerchantICA) GetPacketsAcknowledged() uint64 {
+	if m != nil {
+		return m.PacketsAcknowledged
+	}
+	return 0
+}
+
+func (m *MerchantICA) GetPacketsFailed() uint64 {
+	if m != nil {
+		return m.PacketsFailed
+	}
+	return 0
+}
+
+func (m *MerchantICA) GetPacketsTimedOut() uint64 {
+	if m != nil {
+		return m.PacketsTimedOut
+	}
+	return 0
+}
+
+// MerchantICAPacket is a packet sent for a merchant that has not been acknowledged
+// or timed out yet.
+type MerchantICAPacket struct {
+	// channel_id is the source channel: the ICA channel for transactions, or the
+	// transfer channel for treasury forwards.
+	ChannelId    string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence     uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	MerchantId   uint64 `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// kind is "tx" for an interchain account transaction or "treasury_forward".
+	Kind string `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	// msg_type_urls lists the messages of a transaction.
+	MsgTypeUrls []string `protobuf:"bytes,6,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// allocation_key is the forwarded merchant allocation of a treasury forward.
+	AllocationKey string `protobuf:"bytes,7,opt,name=allocation_key,json=allocationKey,proto3" json:"allocation_key,omitempty"`
+	SentBy        string `protobuf:"bytes,8,opt,name=sent_by,json=sentBy,proto3" json:"sent_by,omitempty"`
+	SentAt        uint64 `protobuf:"varint,9,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	// timeout_timestamp is in nanoseconds, as on the packet.
+	TimeoutTimestamp uint64 `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *MerchantICAPacket) Reset()         { *m = MerchantICAPacket{} }
+func (m *MerchantICAPacket) String() string { return proto.CompactTextString(m) }
+func (*MerchantICAPacket) ProtoMessage()    {}
+func (*MerchantICAPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9da6aac30dde6415, []int{1}
+}
+func (m *MerchantICAPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerchantICAPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerchantICAPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerchantICAPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantICAPacket.Merge(m, src)
+}
+func (m *MerchantICAPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerchantICAPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantICAPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantICAPacket proto.InternalMessageInfo
+
+func (m *MerchantICAPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MerchantICAPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MerchantICAPacket) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+func (m *MerchantICAPacket) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MerchantICAPacket) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *MerchantICAPacket) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MerchantICAPacket) GetAllocationKey() string {
+	if m != nil {
+		return m.AllocationKey
+	}
+	return ""
+}
+
+func (m *MerchantICAPacket) GetSentBy() string {
+	if m != nil {
+		return m.SentBy
+	}
+	return ""
+}
+
+func (m *MerchantICAPacket) GetSentAt() uint64 {
+	if m != nil {
+		return m.SentAt
+	}
+	return 0
+}
+
+func (m *MerchantICAPacket) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// TreasuryForward records the transfer of a merchant allocation's treasury amount
+// to the merchant's interchain account. A forward that fails or times out is
+// removed, so the allocation can be forwarded again.
+type TreasuryForward struct {
+	MerchantId    uint64 `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	AllocationKey string `protobuf:"bytes,2,opt,name=allocation_key,json=allocationKey,proto3" json:"allocation_key,omitempty"`
+	Denom         string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount        uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ConnectionId  string `protobuf:"bytes,5,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// channel_id is the transfer channel the tokens were sent over.
+	ChannelId string `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Receiver  string `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// status is "in_flight" until the transfer is acknowledged, then "completed".
+	Status      string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	ForwardedBy string `protobuf:"bytes,10,opt,name=forwarded_by,json=forwardedBy,proto3" json:"forwarded_by,omitempty"`
+	ForwardedAt uint64 `protobuf:"varint,11,opt,name=forwarded_at,json=forwardedAt,proto3" json:"forwarded_at,omitempty"`
+	CompletedAt uint64 `protobuf:"varint,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (m *TreasuryForward) Reset()         { *m = TreasuryForward{} }
+func (m *TreasuryForward) String() string { return proto.CompactTextString(m) }
+func (*TreasuryForward) ProtoMessage()    {}
+func (*TreasuryForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9da6aac30dde6415, []int{2}
+}
+func (m *TreasuryForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryForward.Merge(m, src)
+}
+func (m *TreasuryForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryForward proto.InternalMessageInfo
+
+func (m *TreasuryForward) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+func (m *TreasuryForward) GetAllocationKey() string {
+	if m != nil {
+		return m.AllocationKey
+	}
+	return ""
+}
+
+func (m *TreasuryForward) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TreasuryForward) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TreasuryForward) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *TreasuryForward) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TreasuryForward) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TreasuryForward) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TreasuryForward) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TreasuryForward) GetForwardedBy() string {
+	if m != nil {
+		return m.ForwardedBy
+	}
+	return ""
+}
+
+func (m *TreasuryForward) GetForwardedAt() uint64 {
+	if m != nil {
+		return m.ForwardedAt
+	}
+	return 0
+}
+
+func (m *TreasuryForward) GetCompletedAt() uint64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MerchantICA)(nil), "tokenchain.loyalty.v1.MerchantICA")
+	proto.RegisterType((*MerchantICAPacket)(nil), "tokenchain.loyalty.v1.MerchantICAPacket")
+	proto.RegisterType((*TreasuryForward)(nil), "tokenchain.loyalty.v1.TreasuryForward")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/merchant_ica.proto", fileDescriptor_9da6aac30dde6415)
+}
+
+var fileDescriptor_9da6aac30dde6415 = []byte{
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xdd, 0x6a, 0x13, 0x41,
+	0x18, 0x6d, 0x9a, 0x34, 0x3f, 0xb3, 0x89, 0xb5, 0x63, 0xd5, 0xa5, 0x62, 0x6c, 0x23, 0x42, 0x50,
+	0x68, 0x29, 0xfa, 0x02, 0x89, 0x50, 0x08, 0x22, 0x4a, 0x8c, 0x37, 0xde, 0x84, 0xe9, 0xce, 0xd7,
+	0x74, 0xc9, 0xee, 0xcc, 0x3a, 0xf3, 0x6d, 0xeb, 0xde, 0xfb, 0x00, 0x3e, 0x96, 0x37, 0x42, 0xf1,
+	0xca, 0x4b, 0x69, 0x5f, 0x44, 0x66, 0x76, 0x36, 0x9b, 0xa6, 0xc5, 0xde, 0xe5, 0x3b, 0xe7, 0xec,
+	0x30, 0x39, 0xe7, 0x3b, 0x43, 0xfa, 0x28, 0xe7, 0x20, 0x82, 0x53, 0x16, 0x8a, 0x83, 0x48, 0x66,
+	0x2c, 0xc2, 0xec, 0xe0, 0xec, 0xf0, 0x20, 0x06, 0x15, 0x9c, 0x32, 0x81, 0xd3, 0x30, 0x60, 0xfb,
+	0x89, 0x92, 0x28, 0xe9, 0xc3, 0x52, 0xb9, 0xef, 0x94, 0xfb, 0x67, 0x87, 0xbd, 0x5f, 0x55, 0xe2,
+	0xbd, 0x77, 0xea, 0xd1, 0xdb, 0x01, 0x7d, 0x46, 0xbc, 0xf2, 0x63, 0xee, 0x57, 0x76, 0x2b, 0xfd,
+	0xda, 0x98, 0x14, 0xd0, 0x88, 0xd3, 0xe7, 0xa4, 0x13, 0x48, 0x21, 0x20, 0xc0, 0x50, 0x0a, 0x23,
+	0x59, 0xdf, 0xad, 0xf4, 0x5b, 0xe3, 0x76, 0x09, 0x8e, 0x38, 0x7d, 0x4c, 0x1a, 0x89, 0x54, 0xf6,
+	0x84, 0xaa, 0xa5, 0xeb, 0x66, 0x1c, 0x71, 0xfa, 0x94, 0x10, 0x73, 0x90, 0x80, 0xc8, 0x70, 0x35,
+	0xcb, 0xb5, 0x1c, 0x32, 0xe2, 0xd4, 0x27, 0x0d, 0xc6, 0xb9, 0x02, 0xad, 0xfd, 0x0d, 0xcb, 0x15,
+	0x23, 0x7d, 0x44, 0xea, 0x1a, 0x19, 0xa6, 0xda, 0xaf, 0xe7, 0x07, 0xe6, 0x93, 0xb9, 0x8e, 0x82,
+	0x59, 0xa8, 0x11, 0x14, 0xf0, 0xe9, 0x71, 0xe6, 0x37, 0xf2, 0xeb, 0x94, 0xe0, 0x30, 0x5b, 0x11,
+	0x31, 0xf4, 0x9b, 0xf6, 0x6f, 0x2d, 0x89, 0x06, 0x48, 0x9f, 0x90, 0x96, 0x4c, 0x40, 0xe4, 0x82,
+	0x96, 0x15, 0x34, 0x73, 0x60, 0x80, 0x74, 0x8f, 0xb4, 0x13, 0x16, 0xcc, 0x01, 0xf5, 0x54, 0x83,
+	0x40, 0x9f, 0x58, 0xde, 0x73, 0xd8, 0x27, 0x10, 0x48, 0x0f, 0xc9, 0x76, 0x21, 0x61, 0xc1, 0x5c,
+	0xc8, 0xf3, 0x08, 0xf8, 0x0c, 0xb8, 0xef, 0x59, 0xe9, 0x03, 0xc7, 0x0d, 0x96, 0x28, 0xfa, 0x82,
+	0xdc, 0x2b, 0x3e, 0x39, 0x61, 0x61, 0x04, 0xdc, 0x6f, 0x5b, 0x71, 0xc7, 0xa1, 0x47, 0x16, 0xa4,
+	0x2f, 0xc9, 0x56, 0x21, 0xc3, 0x30, 0x06, 0x3e, 0x95, 0x29, 0xfa, 0x1d, 0xab, 0xdc, 0x74, 0xc4,
+	0xc4, 0xe0, 0x1f, 0x52, 0xec, 0xfd, 0x5e, 0x27, 0x5b, 0x4b, 0x79, 0x7e, 0xb4, 0xf4, 0x8a, 0xed,
+	0x95, 0x55, 0xdb, 0x77, 0x48, 0x53, 0xc3, 0xd7, 0x14, 0x44, 0x00, 0x36, 0xce, 0xda, 0x78, 0x31,
+	0xaf, 0x2e, 0x44, 0xf5, 0xee, 0x85, 0xa8, 0xdd, 0xb2, 0x10, 0x94, 0xd4, 0xe6, 0xa1, 0xe0, 0x2e,
+	0x55, 0xfb, 0x9b, 0xf6, 0x48, 0x27, 0xd6, 0xb3, 0x29, 0x66, 0x09, 0x4c, 0x53, 0x15, 0x99, 0x64,
+	0xab, 0xfd, 0xd6, 0xd8, 0x8b, 0xf5, 0x6c, 0x92, 0x25, 0xf0, 0x59, 0x45, 0xda, 0x38, 0xc4, 0xa2,
+	0x48, 0x06, 0xcc, 0x1e, 0x3e, 0x87, 0x22, 0xdf, 0x4e, 0x89, 0xbe, 0x83, 0xcc, 0xec, 0x9b, 0x89,
+	0xc5, 0xe4, 0xdf, 0x74, 0xeb, 0x01, 0x02, 0x87, 0x25, 0xb1, 0x88, 0xd4, 0x12, 0x03, 0xa4, 0xaf,
+	0xc8, 0x96, 0xf1, 0x52, 0xa6, 0x68, 0x3d, 0xd5, 0xc8, 0xe2, 0xc4, 0xa5, 0x7a, 0xdf, 0x11, 0x93,
+	0x02, 0xef, 0x7d, 0xaf, 0x92, 0xcd, 0x89, 0x02, 0xa6, 0x53, 0x95, 0x1d, 0x49, 0x75, 0xce, 0x14,
+	0xbf, 0xbb, 0x28, 0x37, 0xaf, 0xbe, 0x7e, 0xdb, 0xd5, 0xb7, 0xc9, 0x06, 0x07, 0x21, 0x63, 0x57,
+	0x94, 0x7c, 0x30, 0xeb, 0xce, 0x62, 0x99, 0x0a, 0xb4, 0x6e, 0xd6, 0xc6, 0x6e, 0xba, 0x69, 0xf6,
+	0xc6, 0x2d, 0x66, 0x5f, 0x4f, 0xbb, 0xfe, 0xbf, 0xb4, 0x1b, 0x2b, 0x69, 0xef, 0x90, 0xa6, 0x82,
+	0x00, 0xc2, 0x33, 0x50, 0xce, 0xc9, 0xc5, 0xbc, 0x54, 0xc1, 0xd6, 0xb5, 0x0a, 0xee, 0x91, 0xf6,
+	0x49, 0x6e, 0x4a, 0xde, 0x40, 0x62, 0x59, 0x6f, 0x81, 0x0d, 0xb3, 0xeb, 0x12, 0x86, 0xae, 0x13,
+	0xa5, 0x24, 0x6f, 0x58, 0x20, 0xe3, 0x24, 0x02, 0xcc, 0x25, 0x79, 0x13, 0xbc, 0x05, 0x36, 0xc0,
+	0xe1, 0x9b, 0x9f, 0x97, 0xdd, 0xca, 0xc5, 0x65, 0xb7, 0xf2, 0xf7, 0xb2, 0x5b, 0xf9, 0x71, 0xd5,
+	0x5d, 0xbb, 0xb8, 0xea, 0xae, 0xfd, 0xb9, 0xea, 0xae, 0x7d, 0xd9, 0x59, 0x7a, 0x06, 0xbf, 0x2d,
+	0x1e, 0x42, 0xb3, 0x59, 0xfa, 0xb8, 0x6e, 0xdf, 0xbf, 0xd7, 0xff, 0x06, 0x00, 0x3a, 0xa2, 0x64,
+	0xbe, 0x2b, 0x05, 0x00, 0x00,
+}
+
+func (m *MerchantICA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerchantICA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerchantICA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PacketsTimedOut != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.PacketsTimedOut))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PacketsFailed != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.PacketsFailed))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PacketsAcknowledged != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.PacketsAcknowledged))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PacketsSent != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.PacketsSent))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OpenedAt != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.OpenedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RegisteredAt != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.RegisteredAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RegisteredBy) > 0 {
+		i -= len(m.RegisteredBy)
+		copy(dAtA[i:], m.RegisteredBy)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.RegisteredBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerchantICAPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerchantICAPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerchantICAPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SentAt != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.SentAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SentBy) > 0 {
+		i -= len(m.SentBy)
+		copy(dAtA[i:], m.SentBy)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.SentBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AllocationKey) > 0 {
+		i -= len(m.AllocationKey)
+		copy(dAtA[i:], m.AllocationKey)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.AllocationKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedAt != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.CompletedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ForwardedAt != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.ForwardedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ForwardedBy) > 0 {
+		i -= len(m.ForwardedBy)
+		copy(dAtA[i:], m.ForwardedBy)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.ForwardedBy)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllocationKey) > 0 {
+		i -= len(m.AllocationKey)
+		copy(dAtA[i:], m.AllocationKey)
+		i = encodeVarintMerchantIca(dAtA, i, uint64(len(m.AllocationKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintMerchantIca(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMerchantIca(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMerchantIca(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MerchantICA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerchantId != 0 {
+		n += 1 + sovMerchantIca(uint64(m.MerchantId))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.RegisteredBy)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	if m.RegisteredAt != 0 {
+		n += 1 + sovMerchantIca(uint64(m.RegisteredAt))
+	}
+	if m.OpenedAt != 0 {
+		n += 1 + sovMerchantIca(uint64(m.OpenedAt))
+	}
+	if m.PacketsSent != 0 {
+		n += 1 + sovMerchantIca(uint64(m.PacketsSent))
+	}
+	if m.PacketsAcknowledged != 0 {
+		n += 1 + sovMerchantIca(uint64(m.PacketsAcknowledged))
+	}
+	if m.PacketsFailed != 0 {
+		n += 1 + sovMerchantIca(uint64(m.PacketsFailed))
+	}
+	if m.PacketsTimedOut != 0 {
+		n += 1 + sovMerchantIca(uint64(m.PacketsTimedOut))
+	}
+	return n
+}
+
+func (m *MerchantICAPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMerchantIca(uint64(m.Sequence))
+	}
+	if m.MerchantId != 0 {
+		n += 1 + sovMerchantIca(uint64(m.MerchantId))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovMerchantIca(uint64(l))
+		}
+	}
+	l = len(m.AllocationKey)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.SentBy)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	if m.SentAt != 0 {
+		n += 1 + sovMerchantIca(uint64(m.SentAt))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovMerchantIca(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *TreasuryForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerchantId != 0 {
+		n += 1 + sovMerchantIca(uint64(m.MerchantId))
+	}
+	l = len(m.AllocationKey)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovMerchantIca(uint64(m.Amount))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMerchantIca(uint64(m.Sequence))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	l = len(m.ForwardedBy)
+	if l > 0 {
+		n += 1 + l + sovMerchantIca(uint64(l))
+	}
+	if m.ForwardedAt != 0 {
+		n += 1 + sovMerchantIca(uint64(m.ForwardedAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovMerchantIca(uint64(m.CompletedAt))
+	}
+	return n
+}
+
+func sovMerchantIca(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMerchantIca(x uint64) (n int) {
+	return sovMerchantIca(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MerchantICA) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMerchantIca
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerchantICA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerchantICA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+			}
+			m.OpenedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsSent", wireType)
+			}
+			m.PacketsSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsAcknowledged", wireType)
+			}
+			m.PacketsAcknowledged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsAcknowledged |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsFailed", wireType)
+			}
+			m.PacketsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsTimedOut", wireType)
+			}
+			m.PacketsTimedOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsTimedOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMerchantIca(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerchantICAPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMerchantIca
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerchantICAPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerchantICAPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
+			}
+			m.SentAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMerchantIca(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMerchantIca
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedAt", wireType)
+			}
+			m.ForwardedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMerchantIca(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMerchantIca
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMerchantIca(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMerchantIca
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMerchantIca
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMerchantIca
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMerchantIca
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMerchantIca
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMerchantIca        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMerchantIca          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMerchantIca = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,20 @@
+package types
+
+// Add counts allocation in s.
+func (s TokenStakerStats) Add(allocation Merchantallocation) TokenStakerStats {
+	s.Allocations++
+	s.StakersAmount += allocation.StakersAmount
+	s.TreasuryAmount += allocation.TreasuryAmount
+	if allocation.Date > s.LastAllocationDate {
+		s.LastAllocationDate = allocation.Date
+	}
+	return s
+}
+
+// Sub takes allocation out of s. The last allocation date is kept.
+func (s TokenStakerStats) Sub(allocation Merchantallocation) TokenStakerStats {
+	s.Allocations -= min(1, s.Allocations)
+	s.StakersAmount -= min(allocation.StakersAmount, s.StakersAmount)
+	s.TreasuryAmount -= min(allocation.TreasuryAmount, s.TreasuryAmount)
+	return s
+}
@@ -34,6 +34,8 @@ type Merchantallocation struct {
 	MerchantIncentiveStakersBps  uint64 `protobuf:"varint,8,opt,name=merchant_incentive_stakers_bps,json=merchantIncentiveStakersBps,proto3" json:"merchant_incentive_stakers_bps,omitempty"`
 	MerchantIncentiveTreasuryBps uint64 `protobuf:"varint,9,opt,name=merchant_incentive_treasury_bps,json=merchantIncentiveTreasuryBps,proto3" json:"merchant_incentive_treasury_bps,omitempty"`
 	Creator                      string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// merchant_id is the token's linked merchant when the allocation was recorded.
+	MerchantId uint64 `protobuf:"varint,11,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
 }
 
 func (m *Merchantallocation) Reset()         { *m = Merchantallocation{} }
@@ -139,8 +141,94 @@ func (m *Merchantallocation) GetCreator() string {
 	return ""
 }
 
+func (m *Merchantallocation) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+// TokenStakerStats totals the merchant allocations recorded for a token.
+type TokenStakerStats struct {
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Allocations uint64 `protobuf:"varint,2,opt,name=allocations,proto3" json:"allocations,omitempty"`
+	// stakers_amount is the Bucket C routed to stakers across all allocations.
+	StakersAmount      uint64 `protobuf:"varint,3,opt,name=stakers_amount,json=stakersAmount,proto3" json:"stakers_amount,omitempty"`
+	TreasuryAmount     uint64 `protobuf:"varint,4,opt,name=treasury_amount,json=treasuryAmount,proto3" json:"treasury_amount,omitempty"`
+	LastAllocationDate string `protobuf:"bytes,5,opt,name=last_allocation_date,json=lastAllocationDate,proto3" json:"last_allocation_date,omitempty"`
+}
+
+func (m *TokenStakerStats) Reset()         { *m = TokenStakerStats{} }
+func (m *TokenStakerStats) String() string { return proto.CompactTextString(m) }
+func (*TokenStakerStats) ProtoMessage()    {}
+func (*TokenStakerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b0723b68e1123b, []int{1}
+}
+func (m *TokenStakerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenStakerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenStakerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenStakerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenStakerStats.Merge(m, src)
+}
+func (m *TokenStakerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenStakerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenStakerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenStakerStats proto.InternalMessageInfo
+
+func (m *TokenStakerStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenStakerStats) GetAllocations() uint64 {
+	if m != nil {
+		return m.Allocations
+	}
+	return 0
+}
+
+func (m *TokenStakerStats) GetStakersAmount() uint64 {
+	if m != nil {
+		return m.StakersAmount
+	}
+	return 0
+}
+
+func (m *TokenStakerStats) GetTreasuryAmount() uint64 {
+	if m != nil {
+		return m.TreasuryAmount
+	}
+	return 0
+}
+
+func (m *TokenStakerStats) GetLastAllocationDate() string {
+	if m != nil {
+		return m.LastAllocationDate
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Merchantallocation)(nil), "tokenchain.loyalty.v1.Merchantallocation")
+	proto.RegisterType((*TokenStakerStats)(nil), "tokenchain.loyalty.v1.TokenStakerStats")
 }
 
 func init() {
@@ -148,29 +236,33 @@ func init() {
 }
 
 var fileDescriptor_07b0723b68e1123b = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0x03, 0x31,
-	0x10, 0x87, 0xbb, 0xf6, 0x9f, 0x0d, 0xd8, 0x4a, 0x50, 0x08, 0x2a, 0xb1, 0x08, 0x6a, 0x4f, 0x5b,
-	0x8a, 0xbe, 0x80, 0x2d, 0x1e, 0x3c, 0x78, 0x69, 0x3d, 0x79, 0x59, 0xd2, 0x34, 0xd0, 0x65, 0xb7,
-	0xc9, 0x92, 0x4c, 0x17, 0xf7, 0x2d, 0xbc, 0xf8, 0x4e, 0x1e, 0x7b, 0xf4, 0x28, 0xdd, 0x17, 0x91,
-	0xcd, 0x6e, 0xaa, 0x50, 0x6f, 0x33, 0x1f, 0xdf, 0xfc, 0x12, 0x66, 0x90, 0x0f, 0x2a, 0x12, 0x92,
-	0x2f, 0x59, 0x28, 0x87, 0xb1, 0xca, 0x58, 0x0c, 0xd9, 0x30, 0x1d, 0x0d, 0x57, 0x42, 0xf3, 0x25,
-	0x93, 0xc0, 0xe2, 0x58, 0x71, 0x06, 0xa1, 0x92, 0x7e, 0xa2, 0x15, 0x28, 0x7c, 0xfa, 0xeb, 0xfb,
-	0x95, 0xef, 0xa7, 0xa3, 0xab, 0x8f, 0x3a, 0xc2, 0xcf, 0x7b, 0x33, 0xf8, 0x18, 0xd5, 0x23, 0x91,
-	0x11, 0xaf, 0xef, 0x0d, 0x3a, 0xd3, 0xa2, 0xc4, 0x18, 0x35, 0x16, 0x0c, 0x04, 0x39, 0xb0, 0xc8,
-	0xd6, 0xf8, 0x04, 0x35, 0x17, 0x42, 0xaa, 0x15, 0xa9, 0x5b, 0x58, 0x36, 0xf8, 0x1a, 0x75, 0x19,
-	0x87, 0x30, 0x0d, 0x21, 0x0b, 0x0c, 0x57, 0x5a, 0x90, 0x46, 0xdf, 0x1b, 0x34, 0xa6, 0x47, 0x8e,
-	0xce, 0x0a, 0x88, 0x6f, 0x50, 0x6f, 0xbe, 0xe6, 0x91, 0x80, 0x80, 0x07, 0x6c, 0xa5, 0xd6, 0x12,
-	0x48, 0xb3, 0xf4, 0x4a, 0x3c, 0x79, 0xb0, 0xb0, 0x88, 0x33, 0xc0, 0x22, 0xa1, 0x8d, 0xd3, 0x5a,
-	0xa5, 0x56, 0xd1, 0x4a, 0xbb, 0x45, 0x3d, 0xd0, 0x82, 0x99, 0xb5, 0xce, 0x9c, 0xd7, 0xb6, 0x5e,
-	0xd7, 0xe1, 0x4a, 0x9c, 0x20, 0xea, 0x96, 0x14, 0x84, 0x92, 0x0b, 0x09, 0x61, 0x2a, 0x02, 0xf7,
-	0xc4, 0x3c, 0x31, 0xe4, 0xd0, 0xce, 0x9d, 0x3b, 0xeb, 0xc9, 0x49, 0xb3, 0xd2, 0x19, 0x27, 0x06,
-	0x3f, 0xa2, 0xcb, 0x7f, 0x42, 0x76, 0x1f, 0x28, 0x52, 0x3a, 0x36, 0xe5, 0x62, 0x2f, 0xe5, 0xa5,
-	0x92, 0x8a, 0x18, 0x82, 0xda, 0x5c, 0x0b, 0x06, 0x4a, 0x13, 0x64, 0x57, 0xe8, 0xda, 0xf1, 0xfd,
-	0xe7, 0x96, 0x7a, 0x9b, 0x2d, 0xf5, 0xbe, 0xb7, 0xd4, 0x7b, 0xcf, 0x69, 0x6d, 0x93, 0xd3, 0xda,
-	0x57, 0x4e, 0x6b, 0xaf, 0x67, 0x7f, 0x0e, 0xff, 0xb6, 0x3b, 0x3d, 0x64, 0x89, 0x30, 0xf3, 0x96,
-	0xbd, 0xf5, 0xdd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x2c, 0xfe, 0xb0, 0x1d, 0x02, 0x00,
-	0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0x86, 0xd7, 0x24, 0xdb, 0xd2, 0x59, 0xd1, 0x56, 0x56, 0x91, 0x2c, 0x40, 0xe9, 0xaa, 0x12,
+	0xd0, 0x53, 0x96, 0x0a, 0x5e, 0xa0, 0x2d, 0x1c, 0x38, 0x70, 0xd9, 0xed, 0x89, 0x4b, 0xe4, 0x75,
+	0x2c, 0x35, 0x4a, 0xd6, 0x8e, 0xec, 0xd9, 0x88, 0xbc, 0x05, 0x4f, 0xc3, 0x33, 0x70, 0xe0, 0xd0,
+	0x23, 0x47, 0xb4, 0xfb, 0x22, 0x28, 0x4e, 0x9c, 0xac, 0xd4, 0x3d, 0xf4, 0x66, 0xff, 0xfe, 0xfc,
+	0xcf, 0x68, 0xfe, 0x81, 0x18, 0x75, 0x2e, 0x95, 0xb8, 0xe7, 0x99, 0x9a, 0x15, 0xba, 0xe6, 0x05,
+	0xd6, 0xb3, 0xea, 0x6a, 0xb6, 0x92, 0x46, 0xdc, 0x73, 0x85, 0xbc, 0x28, 0xb4, 0xe0, 0x98, 0x69,
+	0x15, 0x97, 0x46, 0xa3, 0xa6, 0x2f, 0x07, 0x3e, 0xee, 0xf8, 0xb8, 0xba, 0xba, 0xf8, 0x15, 0x00,
+	0xfd, 0xf6, 0xe8, 0x0f, 0x3d, 0x85, 0x20, 0x97, 0x35, 0x23, 0x53, 0x72, 0x79, 0x34, 0x6f, 0x8e,
+	0x94, 0x42, 0x98, 0x72, 0x94, 0xec, 0x99, 0x93, 0xdc, 0x99, 0x9e, 0xc1, 0x38, 0x95, 0x4a, 0xaf,
+	0x58, 0xe0, 0xc4, 0xf6, 0x42, 0xdf, 0xc2, 0x31, 0x17, 0x98, 0x55, 0x19, 0xd6, 0x89, 0x15, 0xda,
+	0x48, 0x16, 0x4e, 0xc9, 0x65, 0x38, 0x7f, 0xe1, 0xd5, 0x45, 0x23, 0xd2, 0x77, 0x70, 0xb2, 0x5c,
+	0x8b, 0x5c, 0x62, 0x22, 0x12, 0xbe, 0xd2, 0x6b, 0x85, 0x6c, 0xdc, 0x72, 0xad, 0x7c, 0x7b, 0xed,
+	0xc4, 0xc6, 0xce, 0x22, 0xcf, 0xa5, 0xb1, 0x1e, 0x3b, 0x68, 0xb1, 0x4e, 0xed, 0xb0, 0xf7, 0x70,
+	0x82, 0x46, 0x72, 0xbb, 0x36, 0xb5, 0xe7, 0x0e, 0x1d, 0x77, 0xec, 0xe5, 0x0e, 0xbc, 0x85, 0xc8,
+	0x0f, 0x29, 0xc9, 0x94, 0x90, 0x0a, 0xb3, 0x4a, 0x26, 0xbe, 0xc4, 0xb2, 0xb4, 0xec, 0xb9, 0xfb,
+	0xf7, 0xda, 0x53, 0x5f, 0x3d, 0xb4, 0x68, 0x99, 0x9b, 0xd2, 0xd2, 0x2f, 0x70, 0xbe, 0xc7, 0xa4,
+	0x6f, 0xa0, 0x71, 0x39, 0x72, 0x2e, 0x6f, 0x1e, 0xb9, 0xdc, 0x75, 0x50, 0x63, 0xc3, 0xe0, 0x50,
+	0x18, 0xc9, 0x51, 0x1b, 0x06, 0x6e, 0x84, 0xfe, 0x4a, 0xcf, 0x61, 0x32, 0x14, 0x48, 0xd9, 0xc4,
+	0x99, 0x41, 0x6f, 0x96, 0x5e, 0xfc, 0x21, 0x70, 0x7a, 0xd7, 0x44, 0xda, 0x76, 0xb5, 0x40, 0x8e,
+	0x76, 0x08, 0x84, 0xec, 0x06, 0x32, 0x85, 0xc9, 0x10, 0xad, 0x75, 0x09, 0x86, 0xf3, 0x5d, 0x69,
+	0xcf, 0x8c, 0x83, 0x27, 0xce, 0x38, 0xdc, 0x3b, 0xe3, 0x0f, 0x70, 0x56, 0x70, 0x8b, 0xc9, 0x50,
+	0x23, 0x71, 0xcb, 0x33, 0x76, 0x6d, 0xd1, 0xe6, 0xed, 0xba, 0x7f, 0xfa, 0xcc, 0x51, 0xde, 0x7c,
+	0xfa, 0xbd, 0x89, 0xc8, 0xc3, 0x26, 0x22, 0xff, 0x36, 0x11, 0xf9, 0xb9, 0x8d, 0x46, 0x0f, 0xdb,
+	0x68, 0xf4, 0x77, 0x1b, 0x8d, 0xbe, 0xbf, 0xda, 0x59, 0xf4, 0x1f, 0xfd, 0xaa, 0x63, 0x5d, 0x4a,
+	0xbb, 0x3c, 0x70, 0xbb, 0xfd, 0xf1, 0xff, 0x00, 0x77, 0xe7, 0x1c, 0xc2, 0x0d, 0x03, 0x00, 0x00,
 }
 
 func (m *Merchantallocation) Marshal() (dAtA []byte, err error) {
@@ -193,6 +285,11 @@ func (m *Merchantallocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MerchantId != 0 {
+		i = encodeVarintMerchantallocation(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -254,6 +351,58 @@ func (m *Merchantallocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenStakerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenStakerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenStakerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastAllocationDate) > 0 {
+		i -= len(m.LastAllocationDate)
+		copy(dAtA[i:], m.LastAllocationDate)
+		i = encodeVarintMerchantallocation(dAtA, i, uint64(len(m.LastAllocationDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TreasuryAmount != 0 {
+		i = encodeVarintMerchantallocation(dAtA, i, uint64(m.TreasuryAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakersAmount != 0 {
+		i = encodeVarintMerchantallocation(dAtA, i, uint64(m.StakersAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Allocations != 0 {
+		i = encodeVarintMerchantallocation(dAtA, i, uint64(m.Allocations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMerchantallocation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMerchantallocation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMerchantallocation(v)
 	base := offset
@@ -305,6 +454,35 @@ func (m *Merchantallocation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMerchantallocation(uint64(l))
 	}
+	if m.MerchantId != 0 {
+		n += 1 + sovMerchantallocation(uint64(m.MerchantId))
+	}
+	return n
+}
+
+func (m *TokenStakerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMerchantallocation(uint64(l))
+	}
+	if m.Allocations != 0 {
+		n += 1 + sovMerchantallocation(uint64(m.Allocations))
+	}
+	if m.StakersAmount != 0 {
+		n += 1 + sovMerchantallocation(uint64(m.StakersAmount))
+	}
+	if m.TreasuryAmount != 0 {
+		n += 1 + sovMerchantallocation(uint64(m.TreasuryAmount))
+	}
+	l = len(m.LastAllocationDate)
+	if l > 0 {
+		n += 1 + l + sovMerchantallocation(uint64(l))
+	}
 	return n
 }
 
@@ -585,6 +763,196 @@ func (m *Merchantallocation) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantallocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMerchantallocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMerchantallocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenStakerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMerchantallocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenStakerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenStakerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantallocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantallocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantallocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			m.Allocations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantallocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Allocations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakersAmount", wireType)
+			}
+			m.StakersAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantallocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakersAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAmount", wireType)
+			}
+			m.TreasuryAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantallocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAllocationDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerchantallocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerchantallocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerchantallocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastAllocationDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMerchantallocation(dAtA[iNdEx:])
@@ -0,0 +1,44 @@
+package types
+
+func NewMsgRegisterMerchant(
+	creator string,
+	legalName string,
+	payoutAddress string,
+	contactURI string,
+	logoHash string,
+) *MsgRegisterMerchant {
+	return &MsgRegisterMerchant{
+		Creator:       creator,
+		LegalName:     legalName,
+		PayoutAddress: payoutAddress,
+		ContactUri:    contactURI,
+		LogoHash:      logoHash,
+	}
+}
+
+func NewMsgUpdateMerchant(
+	creator string,
+	id uint64,
+	legalName string,
+	payoutAddress string,
+	contactURI string,
+	logoHash string,
+	verificationTier string,
+) *MsgUpdateMerchant {
+	return &MsgUpdateMerchant{
+		Creator:          creator,
+		Id:               id,
+		LegalName:        legalName,
+		PayoutAddress:    payoutAddress,
+		ContactUri:       contactURI,
+		LogoHash:         logoHash,
+		VerificationTier: verificationTier,
+	}
+}
+
+func NewMsgDeactivateMerchant(creator string, id uint64) *MsgDeactivateMerchant {
+	return &MsgDeactivateMerchant{
+		Creator: creator,
+		Id:      id,
+	}
+}
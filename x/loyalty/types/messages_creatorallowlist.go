@@ -4,12 +4,18 @@ func NewMsgCreateCreatorallowlist(
 	creator string,
 	address string,
 	enabled bool,
+	expiresAt uint64,
+	maxTokens uint64,
+	maxTotalSupply uint64,
 
 ) *MsgCreateCreatorallowlist {
 	return &MsgCreateCreatorallowlist{
-		Creator: creator,
-		Address: address,
-		Enabled: enabled,
+		Creator:        creator,
+		Address:        address,
+		Enabled:        enabled,
+		ExpiresAt:      expiresAt,
+		MaxTokens:      maxTokens,
+		MaxTotalSupply: maxTotalSupply,
 	}
 }
 
@@ -17,12 +23,18 @@ func NewMsgUpdateCreatorallowlist(
 	creator string,
 	address string,
 	enabled bool,
+	expiresAt uint64,
+	maxTokens uint64,
+	maxTotalSupply uint64,
 
 ) *MsgUpdateCreatorallowlist {
 	return &MsgUpdateCreatorallowlist{
-		Creator: creator,
-		Address: address,
-		Enabled: enabled,
+		Creator:        creator,
+		Address:        address,
+		Enabled:        enabled,
+		ExpiresAt:      expiresAt,
+		MaxTokens:      maxTokens,
+		MaxTotalSupply: maxTotalSupply,
 	}
 }
 
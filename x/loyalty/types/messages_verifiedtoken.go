@@ -10,11 +10,10 @@ func NewMsgCreateVerifiedtoken(
 	website string,
 	maxSupply uint64,
 	mintedSupply uint64,
-	verified bool,
 	seizureOptIn bool,
 	recoveryGroupPolicy string,
 	recoveryTimelockHours uint64,
-
+	merchantID uint64,
 ) *MsgCreateVerifiedtoken {
 	return &MsgCreateVerifiedtoken{
 		Creator:               creator,
@@ -26,10 +25,10 @@ func NewMsgCreateVerifiedtoken(
 		Website:               website,
 		MaxSupply:             maxSupply,
 		MintedSupply:          mintedSupply,
-		Verified:              verified,
 		SeizureOptIn:          seizureOptIn,
 		RecoveryGroupPolicy:   recoveryGroupPolicy,
 		RecoveryTimelockHours: recoveryTimelockHours,
+		MerchantId:            merchantID,
 	}
 }
 
@@ -43,11 +42,10 @@ func NewMsgUpdateVerifiedtoken(
 	website string,
 	maxSupply uint64,
 	mintedSupply uint64,
-	verified bool,
 	seizureOptIn bool,
 	recoveryGroupPolicy string,
 	recoveryTimelockHours uint64,
-
+	merchantID uint64,
 ) *MsgUpdateVerifiedtoken {
 	return &MsgUpdateVerifiedtoken{
 		Creator:               creator,
@@ -59,10 +57,10 @@ func NewMsgUpdateVerifiedtoken(
 		Website:               website,
 		MaxSupply:             maxSupply,
 		MintedSupply:          mintedSupply,
-		Verified:              verified,
 		SeizureOptIn:          seizureOptIn,
 		RecoveryGroupPolicy:   recoveryGroupPolicy,
 		RecoveryTimelockHours: recoveryTimelockHours,
+		MerchantId:            merchantID,
 	}
 }
 
@@ -0,0 +1,5 @@
+package types
+
+// MaxMintBatchRecipients bounds the number of recipients of a single
+// MsgMintVerifiedTokenBatch so one tx cannot exhaust the block gas limit.
+const MaxMintBatchRecipients = 1_000
@@ -0,0 +1,61 @@
+package types
+
+// MintRateLimitWindowHours is the length of the rolling mint window. Usage is kept in
+// hourly buckets and the current partial hour counts in full, so the window reaches
+// back between 24 and 25 hours.
+const MintRateLimitWindowHours = 24
+
+// IsZero reports whether l places no limit at all.
+func (l MintRateLimit) IsZero() bool {
+	return l.MaxPerTx == 0 && l.MaxPerRollingDay == 0 && l.MaxPerRollupDate == 0
+}
+
+// MaxSingleMint returns the largest amount l allows in a single mint, or zero when l
+// is unlimited.
+func (l MintRateLimit) MaxSingleMint() uint64 {
+	return tighterLimit(tighterLimit(l.MaxPerTx, l.MaxPerRollingDay), l.MaxPerRollupDate)
+}
+
+// TightenMintRateLimit returns current with every field of requested that is at least
+// as strict applied. Fields that would loosen the limit keep their current value.
+func TightenMintRateLimit(current, requested MintRateLimit) MintRateLimit {
+	current.MaxPerTx = tighterLimit(current.MaxPerTx, requested.MaxPerTx)
+	current.MaxPerRollingDay = tighterLimit(current.MaxPerRollingDay, requested.MaxPerRollingDay)
+	current.MaxPerRollupDate = tighterLimit(current.MaxPerRollupDate, requested.MaxPerRollupDate)
+	return current
+}
+
+// tighterLimit returns the stricter of two limits where zero is unlimited.
+func tighterLimit(a, b uint64) uint64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	return min(a, b)
+}
+
+// MintUsageHour returns the hourly bucket of a unix timestamp.
+func MintUsageHour(unix int64) uint64 {
+	return uint64(unix) / 3600
+}
+
+// MintedSince sums the buckets at or after hour.
+func (u MintUsage) MintedSince(hour uint64) uint64 {
+	var total uint64
+	for _, bucket := range u.Hourly {
+		if bucket.Hour >= hour {
+			total += bucket.Amount
+		}
+	}
+	return total
+}
+
+// MintedOn returns the amount minted on date in the daily rollup timezone.
+func (u MintUsage) MintedOn(date string) uint64 {
+	if u.RollupDate != date {
+		return 0
+	}
+	return u.MintedOnRollupDate
+}
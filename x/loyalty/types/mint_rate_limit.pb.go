@@ -0,0 +1,1250 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/mint_rate_limit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintRateLimit caps how fast a verified token can be minted. A zero field is
+// unlimited.
+type MintRateLimit struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxPerTx uint64 `protobuf:"varint,2,opt,name=max_per_tx,json=maxPerTx,proto3" json:"max_per_tx,omitempty"`
+	// max_per_rolling_day caps the amount minted over the last 24 hours.
+	MaxPerRollingDay uint64 `protobuf:"varint,3,opt,name=max_per_rolling_day,json=maxPerRollingDay,proto3" json:"max_per_rolling_day,omitempty"`
+	// max_per_rollup_date caps the amount minted on one date in the daily rollup timezone.
+	MaxPerRollupDate uint64 `protobuf:"varint,4,opt,name=max_per_rollup_date,json=maxPerRollupDate,proto3" json:"max_per_rollup_date,omitempty"`
+}
+
+func (m *MintRateLimit) Reset()         { *m = MintRateLimit{} }
+func (m *MintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MintRateLimit) ProtoMessage()    {}
+func (*MintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae7fe2b3a1a4f49, []int{0}
+}
+func (m *MintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateLimit.Merge(m, src)
+}
+func (m *MintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateLimit proto.InternalMessageInfo
+
+func (m *MintRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintRateLimit) GetMaxPerTx() uint64 {
+	if m != nil {
+		return m.MaxPerTx
+	}
+	return 0
+}
+
+func (m *MintRateLimit) GetMaxPerRollingDay() uint64 {
+	if m != nil {
+		return m.MaxPerRollingDay
+	}
+	return 0
+}
+
+func (m *MintRateLimit) GetMaxPerRollupDate() uint64 {
+	if m != nil {
+		return m.MaxPerRollupDate
+	}
+	return 0
+}
+
+// PendingMintRateLimit is a loosened mint rate limit waiting out the change delay.
+// It takes effect in the first end-block at or after effective_at.
+type PendingMintRateLimit struct {
+	Limit       MintRateLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	Proposer    string        `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	ProposedAt  uint64        `protobuf:"varint,3,opt,name=proposed_at,json=proposedAt,proto3" json:"proposed_at,omitempty"`
+	EffectiveAt uint64        `protobuf:"varint,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+}
+
+func (m *PendingMintRateLimit) Reset()         { *m = PendingMintRateLimit{} }
+func (m *PendingMintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*PendingMintRateLimit) ProtoMessage()    {}
+func (*PendingMintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae7fe2b3a1a4f49, []int{1}
+}
+func (m *PendingMintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMintRateLimit.Merge(m, src)
+}
+func (m *PendingMintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMintRateLimit proto.InternalMessageInfo
+
+func (m *PendingMintRateLimit) GetLimit() MintRateLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return MintRateLimit{}
+}
+
+func (m *PendingMintRateLimit) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *PendingMintRateLimit) GetProposedAt() uint64 {
+	if m != nil {
+		return m.ProposedAt
+	}
+	return 0
+}
+
+func (m *PendingMintRateLimit) GetEffectiveAt() uint64 {
+	if m != nil {
+		return m.EffectiveAt
+	}
+	return 0
+}
+
+// MintUsage tracks the recent mints of a verified token against its rate limit.
+type MintUsage struct {
+	Denom              string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RollupDate         string `protobuf:"bytes,2,opt,name=rollup_date,json=rollupDate,proto3" json:"rollup_date,omitempty"`
+	MintedOnRollupDate uint64 `protobuf:"varint,3,opt,name=minted_on_rollup_date,json=mintedOnRollupDate,proto3" json:"minted_on_rollup_date,omitempty"`
+	// hourly holds the amounts minted in each of the most recent hours, oldest first.
+	Hourly []MintUsageBucket `protobuf:"bytes,4,rep,name=hourly,proto3" json:"hourly"`
+}
+
+func (m *MintUsage) Reset()         { *m = MintUsage{} }
+func (m *MintUsage) String() string { return proto.CompactTextString(m) }
+func (*MintUsage) ProtoMessage()    {}
+func (*MintUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae7fe2b3a1a4f49, []int{2}
+}
+func (m *MintUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintUsage.Merge(m, src)
+}
+func (m *MintUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintUsage proto.InternalMessageInfo
+
+func (m *MintUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintUsage) GetRollupDate() string {
+	if m != nil {
+		return m.RollupDate
+	}
+	return ""
+}
+
+func (m *MintUsage) GetMintedOnRollupDate() uint64 {
+	if m != nil {
+		return m.MintedOnRollupDate
+	}
+	return 0
+}
+
+func (m *MintUsage) GetHourly() []MintUsageBucket {
+	if m != nil {
+		return m.Hourly
+	}
+	return nil
+}
+
+// MintUsageBucket is the amount minted during one hour, counted in hours since the
+// unix epoch.
+type MintUsageBucket struct {
+	Hour   uint64 `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MintUsageBucket) Reset()         { *m = MintUsageBucket{} }
+func (m *MintUsageBucket) String() string { return proto.CompactTextString(m) }
+func (*MintUsageBucket) ProtoMessage()    {}
+func (*MintUsageBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae7fe2b3a1a4f49, []int{3}
+}
+func (m *MintUsageBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintUsageBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintUsageBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintUsageBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintUsageBucket.Merge(m, src)
+}
+func (m *MintUsageBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintUsageBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintUsageBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintUsageBucket proto.InternalMessageInfo
+
+func (m *MintUsageBucket) GetHour() uint64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *MintUsageBucket) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MintRateLimit)(nil), "tokenchain.loyalty.v1.MintRateLimit")
+	proto.RegisterType((*PendingMintRateLimit)(nil), "tokenchain.loyalty.v1.PendingMintRateLimit")
+	proto.RegisterType((*MintUsage)(nil), "tokenchain.loyalty.v1.MintUsage")
+	proto.RegisterType((*MintUsageBucket)(nil), "tokenchain.loyalty.v1.MintUsageBucket")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/mint_rate_limit.proto", fileDescriptor_bae7fe2b3a1a4f49)
+}
+
+var fileDescriptor_bae7fe2b3a1a4f49 = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0xea, 0x46, 0xcd, 0x18, 0x04, 0x5a, 0x52, 0x64, 0x45, 0xc8, 0x09, 0x11, 0x42,
+	0x91, 0x10, 0x8e, 0x52, 0xb8, 0x22, 0xd1, 0x28, 0x47, 0x10, 0x95, 0x05, 0x17, 0x2e, 0xd6, 0x12,
+	0x4f, 0x5d, 0xab, 0xf6, 0xae, 0xb5, 0x19, 0x47, 0xf6, 0x5b, 0xf0, 0x0a, 0xbc, 0x05, 0x12, 0x2f,
+	0xd0, 0x63, 0x8f, 0x9c, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0xd7, 0x49, 0x9b, 0x08, 0x7a, 0x9b, 0xd9,
+	0xf9, 0x26, 0xf9, 0xff, 0xdf, 0x03, 0x2f, 0x49, 0x5e, 0xa2, 0x98, 0x5f, 0xf0, 0x44, 0x8c, 0x53,
+	0x59, 0xf1, 0x94, 0xaa, 0xf1, 0x72, 0x32, 0xce, 0x12, 0x41, 0xa1, 0xe2, 0x84, 0x61, 0x9a, 0x64,
+	0x09, 0xf9, 0xb9, 0x92, 0x24, 0xd9, 0xf1, 0x0d, 0xec, 0x37, 0xb0, 0xbf, 0x9c, 0xf4, 0xba, 0xb1,
+	0x8c, 0xa5, 0x26, 0xc6, 0x75, 0x65, 0xe0, 0xe1, 0x77, 0x0b, 0x1e, 0x7c, 0x48, 0x04, 0x05, 0x9c,
+	0xf0, 0x7d, 0xfd, 0x23, 0xac, 0x0b, 0x87, 0x11, 0x0a, 0x99, 0xb9, 0xd6, 0xc0, 0x1a, 0x75, 0x02,
+	0xd3, 0xb0, 0xa7, 0x00, 0x19, 0x2f, 0xc3, 0x1c, 0x55, 0x48, 0xa5, 0x7b, 0x6f, 0x60, 0x8d, 0xec,
+	0xe0, 0x28, 0xe3, 0xe5, 0x19, 0xaa, 0x4f, 0x25, 0x7b, 0x05, 0x8f, 0x37, 0x53, 0x25, 0xd3, 0x34,
+	0x11, 0x71, 0x18, 0xf1, 0xca, 0x3d, 0xd0, 0xd8, 0x23, 0x83, 0x05, 0x66, 0x30, 0xe3, 0xd5, 0x3e,
+	0x5e, 0xe4, 0x61, 0xc4, 0x09, 0x5d, 0x7b, 0x1f, 0x2f, 0xf2, 0x19, 0x27, 0x1c, 0xfe, 0xb0, 0xa0,
+	0x7b, 0x86, 0x22, 0x4a, 0x44, 0xbc, 0x2b, 0xf5, 0x1d, 0x1c, 0x6a, 0xe3, 0x5a, 0xaa, 0x73, 0xf2,
+	0xdc, 0xff, 0xa7, 0x73, 0x7f, 0x67, 0x69, 0x6a, 0x5f, 0xfd, 0xee, 0xb7, 0x02, 0xb3, 0xc8, 0x7a,
+	0x70, 0x94, 0x2b, 0x99, 0xcb, 0x05, 0x2a, 0x6d, 0xaa, 0x13, 0x6c, 0x7b, 0xd6, 0x07, 0xa7, 0xa9,
+	0xa3, 0x90, 0x53, 0x63, 0x06, 0x36, 0x4f, 0xa7, 0xc4, 0x9e, 0xc1, 0x7d, 0x3c, 0x3f, 0xc7, 0x39,
+	0x25, 0x4b, 0xac, 0x09, 0xa3, 0xdf, 0xd9, 0xbe, 0x9d, 0xd2, 0xf0, 0xa7, 0x05, 0x9d, 0xfa, 0xef,
+	0x3f, 0x2f, 0x78, 0x8c, 0xff, 0x89, 0xb6, 0x0f, 0xce, 0xed, 0x14, 0x8c, 0x0c, 0x50, 0x5b, 0xff,
+	0x6c, 0x02, 0xc7, 0xf5, 0x97, 0xc6, 0x28, 0x94, 0x62, 0x27, 0x30, 0x23, 0x89, 0x99, 0xe1, 0x47,
+	0x71, 0x13, 0x19, 0x9b, 0x41, 0xfb, 0x42, 0x16, 0x2a, 0xad, 0x5c, 0x7b, 0x70, 0x30, 0x72, 0x4e,
+	0x5e, 0xdc, 0x11, 0x8d, 0xd6, 0x36, 0x2d, 0xe6, 0x97, 0xb8, 0x09, 0xa7, 0xd9, 0x1d, 0xbe, 0x85,
+	0x87, 0x7b, 0x00, 0x63, 0x60, 0xd7, 0x43, 0xed, 0xc0, 0x0e, 0x74, 0xcd, 0x9e, 0x40, 0x9b, 0x67,
+	0xb2, 0x10, 0xd4, 0xdc, 0x45, 0xd3, 0x4d, 0xdf, 0x5c, 0xad, 0x3c, 0xeb, 0x7a, 0xe5, 0x59, 0x7f,
+	0x56, 0x9e, 0xf5, 0x6d, 0xed, 0xb5, 0xae, 0xd7, 0x5e, 0xeb, 0xd7, 0xda, 0x6b, 0x7d, 0xe9, 0xdd,
+	0xba, 0xe7, 0x72, 0x7b, 0xd1, 0x54, 0xe5, 0xb8, 0xf8, 0xda, 0xd6, 0x87, 0xf9, 0xfa, 0xef, 0x00,
+	0xb5, 0x3a, 0x23, 0x5c, 0xf4, 0x02, 0x00, 0x00,
+}
+
+func (m *MintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPerRollupDate != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.MaxPerRollupDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPerRollingDay != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.MaxPerRollingDay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPerTx != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.MaxPerTx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveAt != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.EffectiveAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposedAt != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.ProposedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hourly) > 0 {
+		for iNdEx := len(m.Hourly) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hourly[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintedOnRollupDate != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.MintedOnRollupDate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollupDate) > 0 {
+		i -= len(m.RollupDate)
+		copy(dAtA[i:], m.RollupDate)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.RollupDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintUsageBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintUsageBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintUsageBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Hour != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	if m.MaxPerTx != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.MaxPerTx))
+	}
+	if m.MaxPerRollingDay != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.MaxPerRollingDay))
+	}
+	if m.MaxPerRollupDate != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.MaxPerRollupDate))
+	}
+	return n
+}
+
+func (m *PendingMintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	if m.ProposedAt != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.ProposedAt))
+	}
+	if m.EffectiveAt != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.EffectiveAt))
+	}
+	return n
+}
+
+func (m *MintUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	l = len(m.RollupDate)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	if m.MintedOnRollupDate != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.MintedOnRollupDate))
+	}
+	if len(m.Hourly) > 0 {
+		for _, e := range m.Hourly {
+			l = e.Size()
+			n += 1 + l + sovMintRateLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintUsageBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hour != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.Hour))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.Amount))
+	}
+	return n
+}
+
+func sovMintRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintRateLimit(x uint64) (n int) {
+	return sovMintRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerTx", wireType)
+			}
+			m.MaxPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerRollingDay", wireType)
+			}
+			m.MaxPerRollingDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerRollingDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerRollupDate", wireType)
+			}
+			m.MaxPerRollupDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerRollupDate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			m.ProposedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
+			}
+			m.EffectiveAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollupDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollupDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedOnRollupDate", wireType)
+			}
+			m.MintedOnRollupDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedOnRollupDate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hourly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hourly = append(m.Hourly, MintUsageBucket{})
+			if err := m.Hourly[len(m.Hourly)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintUsageBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintUsageBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintUsageBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			m.Hour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hour |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
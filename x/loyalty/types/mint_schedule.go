@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	MintCadenceDaily   = "daily"
+	MintCadenceWeekly  = "weekly"
+	MintCadenceMonthly = "monthly"
+
+	// MintScheduleDateLayout is the calendar date format of schedule release dates.
+	MintScheduleDateLayout = "2006-01-02"
+
+	// MaxMintScheduleTranches bounds how many tranches a single schedule may release.
+	MaxMintScheduleTranches = 10_000
+	maxMintScheduleInterval = 365
+)
+
+// ValidateMintScheduleTerms checks the shape of a mint schedule independently of chain state.
+func ValidateMintScheduleTerms(trancheAmount, totalAmount uint64, cadence string, interval uint32, startDate string) error {
+	if trancheAmount == 0 || totalAmount == 0 {
+		return fmt.Errorf("tranche and total amounts must be greater than zero")
+	}
+	if trancheAmount > totalAmount {
+		return fmt.Errorf("tranche amount cannot exceed total amount")
+	}
+	if MintScheduleTranches(trancheAmount, totalAmount) > MaxMintScheduleTranches {
+		return fmt.Errorf("schedule cannot release more than %d tranches", MaxMintScheduleTranches)
+	}
+	switch cadence {
+	case MintCadenceDaily, MintCadenceWeekly, MintCadenceMonthly:
+	default:
+		return fmt.Errorf("unknown cadence %q", cadence)
+	}
+	if interval == 0 || interval > maxMintScheduleInterval {
+		return fmt.Errorf("interval must be between 1 and %d", maxMintScheduleInterval)
+	}
+	if _, err := time.Parse(MintScheduleDateLayout, startDate); err != nil {
+		return fmt.Errorf("invalid start date %q: expected YYYY-MM-DD", startDate)
+	}
+	return nil
+}
+
+// MintScheduleTranches is the number of tranches needed to release totalAmount.
+func MintScheduleTranches(trancheAmount, totalAmount uint64) uint64 {
+	return totalAmount/trancheAmount + min(totalAmount%trancheAmount, 1)
+}
+
+// MintScheduleReleaseDate returns the date of tranche n (zero-based) of a schedule that
+// starts on startDate. Monthly tranches keep the start day of month, clamped to the last
+// day of shorter months.
+func MintScheduleReleaseDate(startDate, cadence string, interval uint32, n uint64) (string, error) {
+	start, err := time.Parse(MintScheduleDateLayout, startDate)
+	if err != nil {
+		return "", err
+	}
+	periods := int(n) * int(interval)
+	var date time.Time
+	switch cadence {
+	case MintCadenceDaily:
+		date = start.AddDate(0, 0, periods)
+	case MintCadenceWeekly:
+		date = start.AddDate(0, 0, 7*periods)
+	case MintCadenceMonthly:
+		firstOfMonth := time.Date(start.Year(), start.Month()+time.Month(periods), 1, 0, 0, 0, 0, time.UTC)
+		lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+		date = firstOfMonth.AddDate(0, 0, min(start.Day(), lastDay)-1)
+	default:
+		return "", fmt.Errorf("unknown cadence %q", cadence)
+	}
+	return date.Format(MintScheduleDateLayout), nil
+}
+
+// Remaining is the amount the schedule has yet to release.
+func (s MintSchedule) Remaining() uint64 {
+	return s.TotalAmount - s.ReleasedAmount
+}
@@ -0,0 +1,835 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenchain/loyalty/v1/mint_schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintSchedule releases total_amount of a verified token to recipient in tranches on
+// a calendar cadence evaluated in the daily rollup timezone.
+type MintSchedule struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Creator        string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Recipient      string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	TrancheAmount  uint64 `protobuf:"varint,5,opt,name=tranche_amount,json=trancheAmount,proto3" json:"tranche_amount,omitempty"`
+	TotalAmount    uint64 `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	ReleasedAmount uint64 `protobuf:"varint,7,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"`
+	// cadence is "daily", "weekly" or "monthly"; interval repeats it every N periods.
+	Cadence  string `protobuf:"bytes,8,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	Interval uint32 `protobuf:"varint,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	// start_date is the first release date (YYYY-MM-DD in the rollup timezone). Later
+	// dates are derived from it, so monthly schedules keep their day of month, clamped
+	// to the month's last day.
+	StartDate        string `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	TranchesReleased uint64 `protobuf:"varint,11,opt,name=tranches_released,json=tranchesReleased,proto3" json:"tranches_released,omitempty"`
+	NextReleaseDate  string `protobuf:"bytes,12,opt,name=next_release_date,json=nextReleaseDate,proto3" json:"next_release_date,omitempty"`
+	CreatedAt        uint64 `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *MintSchedule) Reset()         { *m = MintSchedule{} }
+func (m *MintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MintSchedule) ProtoMessage()    {}
+func (*MintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a9766aed4049503, []int{0}
+}
+func (m *MintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintSchedule.Merge(m, src)
+}
+func (m *MintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintSchedule proto.InternalMessageInfo
+
+func (m *MintSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetTrancheAmount() uint64 {
+	if m != nil {
+		return m.TrancheAmount
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetTotalAmount() uint64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetReleasedAmount() uint64 {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetCadence() string {
+	if m != nil {
+		return m.Cadence
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetTranchesReleased() uint64 {
+	if m != nil {
+		return m.TranchesReleased
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetNextReleaseDate() string {
+	if m != nil {
+		return m.NextReleaseDate
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MintSchedule)(nil), "tokenchain.loyalty.v1.MintSchedule")
+}
+
+func init() {
+	proto.RegisterFile("tokenchain/loyalty/v1/mint_schedule.proto", fileDescriptor_4a9766aed4049503)
+}
+
+var fileDescriptor_4a9766aed4049503 = []byte{
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcd, 0x6a, 0x22, 0x41,
+	0x10, 0xc7, 0x1d, 0xbf, 0xa7, 0xfc, 0x5a, 0x9b, 0x5d, 0x68, 0x64, 0x1d, 0xdc, 0x85, 0x65, 0xdd,
+	0x0d, 0x28, 0x92, 0xbc, 0x80, 0x21, 0xd7, 0x5c, 0x26, 0xb7, 0x5c, 0x86, 0xce, 0x4c, 0x81, 0x4d,
+	0xc6, 0x6e, 0xe9, 0x29, 0x45, 0xdf, 0x22, 0x0f, 0x92, 0x07, 0xc9, 0xd1, 0x63, 0x8e, 0x41, 0x5f,
+	0x24, 0xd8, 0xd3, 0xa3, 0x39, 0xd6, 0xaf, 0x7e, 0xfc, 0xab, 0xba, 0x29, 0xf8, 0x47, 0xfa, 0x19,
+	0x55, 0xbc, 0x10, 0x52, 0x4d, 0x53, 0xbd, 0x13, 0x29, 0xed, 0xa6, 0x9b, 0xd9, 0x74, 0x29, 0x15,
+	0x45, 0x59, 0xbc, 0xc0, 0x64, 0x9d, 0xe2, 0x64, 0x65, 0x34, 0x69, 0xf6, 0xe3, 0xa2, 0x4e, 0x9c,
+	0x3a, 0xd9, 0xcc, 0x7e, 0xbf, 0x56, 0xa0, 0x7d, 0x2f, 0x15, 0x3d, 0x38, 0x9b, 0x75, 0xa1, 0x2c,
+	0x13, 0xee, 0x8d, 0xbc, 0x71, 0x35, 0x2c, 0xcb, 0x84, 0x7d, 0x87, 0x5a, 0x82, 0x4a, 0x2f, 0x79,
+	0x79, 0xe4, 0x8d, 0xfd, 0x30, 0x2f, 0x18, 0x87, 0x46, 0x6c, 0x50, 0x90, 0x36, 0xbc, 0x62, 0x79,
+	0x51, 0xb2, 0x9f, 0xe0, 0x1b, 0x8c, 0xe5, 0x4a, 0xa2, 0x22, 0x5e, 0xb5, 0xbd, 0x0b, 0x60, 0x7f,
+	0xa0, 0x4b, 0x46, 0xa8, 0x78, 0x81, 0x91, 0x58, 0xea, 0xb5, 0x22, 0x5e, 0xb3, 0x93, 0x3a, 0x8e,
+	0xce, 0x2d, 0x64, 0xbf, 0xa0, 0x4d, 0x9a, 0x44, 0x5a, 0x48, 0x75, 0x2b, 0xb5, 0x2c, 0x73, 0xca,
+	0x5f, 0xe8, 0x19, 0x4c, 0x51, 0x64, 0x98, 0x14, 0x56, 0xc3, 0x5a, 0xdd, 0x02, 0x3b, 0xf1, 0xb4,
+	0xaa, 0x48, 0x50, 0xc5, 0xc8, 0x9b, 0x6e, 0xd5, 0xbc, 0x64, 0x03, 0x68, 0x4a, 0x45, 0x68, 0x36,
+	0x22, 0xe5, 0xfe, 0xc8, 0x1b, 0x77, 0xc2, 0x73, 0xcd, 0x86, 0x00, 0x19, 0x09, 0x43, 0x51, 0x22,
+	0x08, 0x39, 0xe4, 0xef, 0xb0, 0xe4, 0x4e, 0x10, 0xb2, 0x2b, 0xe8, 0xbb, 0x8d, 0xb3, 0xa8, 0x98,
+	0xc7, 0x5b, 0x76, 0xfe, 0xb7, 0xa2, 0x11, 0x3a, 0xce, 0xfe, 0x43, 0x5f, 0xe1, 0x96, 0x0a, 0x31,
+	0x8f, 0x6c, 0xdb, 0xc8, 0xde, 0xa9, 0xe1, 0x44, 0x1b, 0x3c, 0x04, 0xb0, 0x3f, 0x79, 0x7a, 0x15,
+	0xf1, 0x8e, 0x4d, 0xf4, 0x1d, 0x99, 0xd3, 0xed, 0xcd, 0xdb, 0x21, 0xf0, 0xf6, 0x87, 0xc0, 0xfb,
+	0x38, 0x04, 0xde, 0xcb, 0x31, 0x28, 0xed, 0x8f, 0x41, 0xe9, 0xfd, 0x18, 0x94, 0x1e, 0x07, 0x5f,
+	0x4e, 0x61, 0x7b, 0x3e, 0x06, 0xda, 0xad, 0x30, 0x7b, 0xaa, 0xdb, 0x13, 0xb8, 0xfe, 0x1c, 0x00,
+	0x3f, 0x3e, 0x04, 0xbb, 0x2f, 0x02, 0x00, 0x00,
+}
+
+func (m *MintSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.NextReleaseDate) > 0 {
+		i -= len(m.NextReleaseDate)
+		copy(dAtA[i:], m.NextReleaseDate)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.NextReleaseDate)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.TranchesReleased != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.TranchesReleased))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Interval != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Cadence) > 0 {
+		i -= len(m.Cadence)
+		copy(dAtA[i:], m.Cadence)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.Cadence)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ReleasedAmount != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.ReleasedAmount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalAmount != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.TotalAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TrancheAmount != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.TrancheAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMintSchedule(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	if m.TrancheAmount != 0 {
+		n += 1 + sovMintSchedule(uint64(m.TrancheAmount))
+	}
+	if m.TotalAmount != 0 {
+		n += 1 + sovMintSchedule(uint64(m.TotalAmount))
+	}
+	if m.ReleasedAmount != 0 {
+		n += 1 + sovMintSchedule(uint64(m.ReleasedAmount))
+	}
+	l = len(m.Cadence)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovMintSchedule(uint64(m.Interval))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	if m.TranchesReleased != 0 {
+		n += 1 + sovMintSchedule(uint64(m.TranchesReleased))
+	}
+	l = len(m.NextReleaseDate)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovMintSchedule(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func sovMintSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintSchedule(x uint64) (n int) {
+	return sovMintSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheAmount", wireType)
+			}
+			m.TrancheAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrancheAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			m.TotalAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			m.ReleasedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cadence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cadence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TranchesReleased", wireType)
+			}
+			m.TranchesReleased = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TranchesReleased |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReleaseDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextReleaseDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintSchedule = fmt.Errorf("proto: unexpected end of group")
+)
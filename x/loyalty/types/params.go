@@ -10,6 +10,10 @@ const (
 	CreationModeAllowlisted    = "allowlisted"
 	CreationModePermissionless = "permissionless"
 
+	NetworkModeMainnet  = "mainnet"
+	NetworkModeTestnet  = "testnet"
+	NetworkModeLocalnet = "localnet"
+
 	TotalBPS uint64 = 10_000
 )
 
@@ -37,6 +41,16 @@ var DefaultFeeSplitMerchantPoolBps uint64 = 1000
 // DefaultSeizureOptInDefault represents the SeizureOptInDefault default value.
 var DefaultSeizureOptInDefault bool = false
 
+// DefaultNetworkMode represents the NetworkMode default value. Defaulting to
+// mainnet keeps the strictest timelock unless genesis opts into a test network.
+var DefaultNetworkMode string = NetworkModeMainnet
+
+// DefaultMinVerifierAttestations represents the MinVerifierAttestations default value.
+var DefaultMinVerifierAttestations uint32 = 1
+
+// DefaultMetadataChangeDelayHours represents the MetadataChangeDelayHours default value.
+var DefaultMetadataChangeDelayHours uint64 = 24
+
 // DefaultMerchantIncentiveStakersBps represents the default per-token share of Bucket C routed to token stakers.
 var DefaultMerchantIncentiveStakersBps uint64 = 5000
 
@@ -53,16 +67,22 @@ func NewParams(
 	feeSplitTokenStakersBps uint64,
 	feeSplitMerchantPoolBps uint64,
 	seizureOptInDefault bool,
+	networkMode string,
+	minVerifierAttestations uint32,
+	metadataChangeDelayHours uint64,
 ) Params {
 	return Params{
-		CreationMode:            creationMode,
-		DailyRollupTimezone:     dailyRollupTimezone,
-		TestnetTimelockHours:    testnetTimelockHours,
-		MainnetTimelockHours:    mainnetTimelockHours,
-		FeeSplitValidatorBps:    feeSplitValidatorBps,
-		FeeSplitTokenStakersBps: feeSplitTokenStakersBps,
-		FeeSplitMerchantPoolBps: feeSplitMerchantPoolBps,
-		SeizureOptInDefault:     seizureOptInDefault,
+		CreationMode:             creationMode,
+		DailyRollupTimezone:      dailyRollupTimezone,
+		TestnetTimelockHours:     testnetTimelockHours,
+		MainnetTimelockHours:     mainnetTimelockHours,
+		FeeSplitValidatorBps:     feeSplitValidatorBps,
+		FeeSplitTokenStakersBps:  feeSplitTokenStakersBps,
+		FeeSplitMerchantPoolBps:  feeSplitMerchantPoolBps,
+		SeizureOptInDefault:      seizureOptInDefault,
+		NetworkMode:              networkMode,
+		MinVerifierAttestations:  minVerifierAttestations,
+		MetadataChangeDelayHours: metadataChangeDelayHours,
 	}
 }
 
@@ -77,6 +97,9 @@ func DefaultParams() Params {
 		DefaultFeeSplitTokenStakersBps,
 		DefaultFeeSplitMerchantPoolBps,
 		DefaultSeizureOptInDefault,
+		DefaultNetworkMode,
+		DefaultMinVerifierAttestations,
+		DefaultMetadataChangeDelayHours,
 	)
 }
 
@@ -114,6 +137,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateNetworkMode(p.NetworkMode); err != nil {
+		return err
+	}
+
+	if err := validateMinVerifierAttestations(p.MinVerifierAttestations); err != nil {
+		return err
+	}
+
+	if err := validateMetadataChangeDelayHours(p.MetadataChangeDelayHours); err != nil {
+		return err
+	}
+
 	if p.MainnetTimelockHours < p.TestnetTimelockHours {
 		return fmt.Errorf("mainnet timelock must be greater than or equal to testnet timelock")
 	}
@@ -192,6 +227,26 @@ func validateSeizureOptInDefault(v bool) error {
 	return nil
 }
 
+// validateNetworkMode validates the NetworkMode parameter.
+func validateNetworkMode(v string) error {
+	switch v {
+	case NetworkModeMainnet, NetworkModeTestnet, NetworkModeLocalnet:
+		return nil
+	default:
+		return fmt.Errorf("invalid network mode: %q", v)
+	}
+}
+
+// MinimumRecoveryTimelockHours returns the recovery timelock floor for the configured network mode.
+func (p Params) MinimumRecoveryTimelockHours() uint64 {
+	switch p.NetworkMode {
+	case NetworkModeTestnet, NetworkModeLocalnet:
+		return p.TestnetTimelockHours
+	default:
+		return p.MainnetTimelockHours
+	}
+}
+
 // ValidateMerchantIncentiveRouting validates per-token Bucket C routing split.
 func ValidateMerchantIncentiveRouting(stakersBps, treasuryBps uint64) error {
 	if stakersBps > TotalBPS {
@@ -205,3 +260,19 @@ func ValidateMerchantIncentiveRouting(stakersBps, treasuryBps uint64) error {
 	}
 	return nil
 }
+
+// validateMetadataChangeDelayHours validates the MetadataChangeDelayHours parameter.
+func validateMetadataChangeDelayHours(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("metadata change delay must be greater than zero")
+	}
+	return nil
+}
+
+// validateMinVerifierAttestations validates the MinVerifierAttestations parameter.
+func validateMinVerifierAttestations(v uint32) error {
+	if v == 0 {
+		return fmt.Errorf("min verifier attestations must be greater than zero")
+	}
+	return nil
+}
@@ -34,6 +34,16 @@ type Params struct {
 	FeeSplitTokenStakersBps uint64 `protobuf:"varint,6,opt,name=fee_split_token_stakers_bps,json=feeSplitTokenStakersBps,proto3" json:"fee_split_token_stakers_bps,omitempty"`
 	FeeSplitMerchantPoolBps uint64 `protobuf:"varint,7,opt,name=fee_split_merchant_pool_bps,json=feeSplitMerchantPoolBps,proto3" json:"fee_split_merchant_pool_bps,omitempty"`
 	SeizureOptInDefault     bool   `protobuf:"varint,8,opt,name=seizure_opt_in_default,json=seizureOptInDefault,proto3" json:"seizure_opt_in_default,omitempty"`
+	// network_mode selects which recovery timelock minimum applies:
+	// "mainnet" uses mainnet_timelock_hours, "testnet" and "localnet" use testnet_timelock_hours.
+	// Only the module authority (x/gov) may change it after genesis.
+	NetworkMode string `protobuf:"bytes,9,opt,name=network_mode,json=networkMode,proto3" json:"network_mode,omitempty"`
+	// min_verifier_attestations is the number of unexpired verifier attestations a
+	// token needs before it is marked verified.
+	MinVerifierAttestations uint32 `protobuf:"varint,10,opt,name=min_verifier_attestations,json=minVerifierAttestations,proto3" json:"min_verifier_attestations,omitempty"`
+	// metadata_change_delay_hours is how long name, symbol and max_supply increases
+	// wait in public before they take effect.
+	MetadataChangeDelayHours uint64 `protobuf:"varint,11,opt,name=metadata_change_delay_hours,json=metadataChangeDelayHours,proto3" json:"metadata_change_delay_hours,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -125,6 +135,27 @@ func (m *Params) GetSeizureOptInDefault() bool {
 	return false
 }
 
+func (m *Params) GetNetworkMode() string {
+	if m != nil {
+		return m.NetworkMode
+	}
+	return ""
+}
+
+func (m *Params) GetMinVerifierAttestations() uint32 {
+	if m != nil {
+		return m.MinVerifierAttestations
+	}
+	return 0
+}
+
+func (m *Params) GetMetadataChangeDelayHours() uint64 {
+	if m != nil {
+		return m.MetadataChangeDelayHours
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "tokenchain.loyalty.v1.Params")
 }
@@ -134,33 +165,38 @@ func init() {
 }
 
 var fileDescriptor_63adabe37ef3b914 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xc0, 0x73, 0xb4, 0x84, 0x72, 0x82, 0x01, 0xf7, 0x9f, 0x95, 0x4a, 0x26, 0x2a, 0x0c, 0x11,
-	0x43, 0xac, 0xd2, 0xb2, 0x20, 0xa6, 0x8a, 0x01, 0x86, 0x8a, 0x2a, 0x8d, 0x18, 0x58, 0x4e, 0x57,
-	0xfb, 0xa5, 0x39, 0xe5, 0x7c, 0xef, 0x74, 0xf7, 0x1c, 0xe1, 0x7e, 0x04, 0x26, 0x3e, 0x02, 0x1f,
-	0x81, 0x8f, 0xc1, 0xd8, 0x11, 0x89, 0x05, 0x25, 0x03, 0x7c, 0x0c, 0xe4, 0xb3, 0x43, 0xa9, 0xe8,
-	0x62, 0x3d, 0xbd, 0xdf, 0xef, 0xe7, 0xc1, 0x7e, 0x7c, 0x9f, 0x70, 0x06, 0x26, 0x9b, 0x4a, 0x65,
-	0x52, 0x8d, 0x95, 0xd4, 0x54, 0xa5, 0xf3, 0x83, 0xd4, 0x4a, 0x27, 0x0b, 0x3f, 0xb4, 0x0e, 0x09,
-	0xa3, 0xed, 0x6b, 0x67, 0xd8, 0x3a, 0xc3, 0xf9, 0x41, 0xef, 0x91, 0x2c, 0x94, 0xc1, 0x34, 0x3c,
-	0x1b, 0xb3, 0xb7, 0x75, 0x81, 0x17, 0x18, 0xc6, 0xb4, 0x9e, 0x9a, 0xed, 0xfe, 0x8f, 0x35, 0xde,
-	0x3d, 0x0d, 0x2f, 0x8c, 0x9e, 0xf0, 0x87, 0x99, 0x03, 0x49, 0x0a, 0x8d, 0x28, 0x30, 0x87, 0x98,
-	0xf5, 0xd9, 0xe0, 0xfe, 0xe8, 0xc1, 0x6a, 0x79, 0x82, 0x39, 0x44, 0xcf, 0xf9, 0x76, 0x2e, 0x95,
-	0xae, 0x84, 0x43, 0xad, 0x4b, 0x2b, 0x48, 0x15, 0x70, 0x89, 0x06, 0xe2, 0x3b, 0x41, 0xde, 0x0c,
-	0x70, 0x14, 0xd8, 0xb8, 0x45, 0xd1, 0x11, 0xdf, 0x21, 0xf0, 0x64, 0x80, 0x82, 0xae, 0x31, 0x9b,
-	0x89, 0x29, 0x96, 0xce, 0xc7, 0x6b, 0x7d, 0x36, 0x58, 0x1f, 0x6d, 0xb5, 0x74, 0xdc, 0xc2, 0x37,
-	0x35, 0xab, 0xab, 0x42, 0x2a, 0x73, 0x4b, 0xb5, 0xde, 0x54, 0x2d, 0xbd, 0x59, 0xbd, 0xe0, 0xbb,
-	0x13, 0x00, 0xe1, 0xad, 0x56, 0x24, 0xe6, 0x52, 0xab, 0x5c, 0x12, 0x3a, 0x71, 0x6e, 0x7d, 0x7c,
-	0xb7, 0xc9, 0x26, 0x00, 0x67, 0x35, 0x7d, 0xbf, 0x82, 0xc7, 0xd6, 0x47, 0xaf, 0xf8, 0xde, 0x75,
-	0x16, 0x3e, 0xa9, 0xf0, 0x24, 0x67, 0xe0, 0x7c, 0x48, 0xbb, 0x21, 0xdd, 0x5d, 0xa5, 0xe3, 0x5a,
-	0x38, 0x6b, 0xf8, 0x7f, 0x75, 0x01, 0x2e, 0x9b, 0x4a, 0x43, 0xc2, 0x22, 0xea, 0x50, 0xdf, 0xbb,
-	0x59, 0x9f, 0xb4, 0xc2, 0x29, 0xa2, 0xae, 0xeb, 0x43, 0xbe, 0xe3, 0x41, 0x5d, 0x96, 0x0e, 0x04,
-	0x5a, 0x12, 0xca, 0x88, 0x1c, 0x26, 0xb2, 0xd4, 0x14, 0x6f, 0xf4, 0xd9, 0x60, 0x63, 0xb4, 0xd9,
-	0xd2, 0x77, 0x96, 0xde, 0x9a, 0xd7, 0x0d, 0x7a, 0xf9, 0xf4, 0xf7, 0x97, 0xc7, 0xec, 0xd3, 0xaf,
-	0xaf, 0xcf, 0xf6, 0xfe, 0x39, 0x92, 0x8f, 0x7f, 0xcf, 0xa4, 0xf9, 0xa5, 0xc7, 0x47, 0xdf, 0x16,
-	0x09, 0xbb, 0x5a, 0x24, 0xec, 0xe7, 0x22, 0x61, 0x9f, 0x97, 0x49, 0xe7, 0x6a, 0x99, 0x74, 0xbe,
-	0x2f, 0x93, 0xce, 0x87, 0xde, 0xad, 0x19, 0x55, 0x16, 0xfc, 0x79, 0x37, 0x9c, 0xc6, 0xe1, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x50, 0x58, 0x1b, 0x80, 0x02, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x6e, 0x13, 0x41,
+	0x14, 0xc6, 0xb3, 0x60, 0x4c, 0x32, 0x49, 0x0a, 0x36, 0x7f, 0xbc, 0xd8, 0xd2, 0x62, 0x02, 0x85,
+	0x45, 0x61, 0x2b, 0x24, 0x34, 0x11, 0x14, 0x84, 0x14, 0x50, 0x44, 0x44, 0x8e, 0x95, 0x82, 0x66,
+	0x34, 0xf1, 0x3e, 0xdb, 0x23, 0xcf, 0xce, 0x5b, 0xcd, 0x3c, 0x1b, 0x36, 0x47, 0xa0, 0xe2, 0x08,
+	0x1c, 0x81, 0x63, 0x50, 0xa6, 0xa4, 0x44, 0x76, 0x01, 0x57, 0xa0, 0x43, 0x3b, 0x3b, 0x26, 0x89,
+	0x48, 0xb3, 0x1a, 0xcd, 0xef, 0xfb, 0xad, 0x76, 0xdf, 0xf7, 0xd8, 0x0e, 0xe1, 0x18, 0x74, 0x7f,
+	0x24, 0xa4, 0xee, 0x28, 0xcc, 0x85, 0xa2, 0xbc, 0x33, 0xdd, 0xed, 0x64, 0xc2, 0x88, 0xd4, 0xb6,
+	0x33, 0x83, 0x84, 0xe1, 0xd6, 0x55, 0xa6, 0xed, 0x33, 0xed, 0xe9, 0x6e, 0xfd, 0x81, 0x48, 0xa5,
+	0xc6, 0x8e, 0x7b, 0x96, 0xc9, 0xfa, 0xe6, 0x10, 0x87, 0xe8, 0x8e, 0x9d, 0xe2, 0x54, 0xde, 0xee,
+	0xfc, 0xa9, 0xb0, 0xea, 0x89, 0x7b, 0x61, 0xf8, 0x84, 0xad, 0xf7, 0x0d, 0x08, 0x92, 0xa8, 0x79,
+	0x8a, 0x09, 0x44, 0x41, 0x33, 0x68, 0xad, 0x74, 0xd7, 0x16, 0x97, 0xc7, 0x98, 0x40, 0xf8, 0x9c,
+	0x6d, 0x25, 0x42, 0xaa, 0x9c, 0x1b, 0x54, 0x6a, 0x92, 0x71, 0x92, 0x29, 0x5c, 0xa0, 0x86, 0xe8,
+	0x8e, 0x0b, 0x6f, 0x38, 0xd8, 0x75, 0xac, 0xe7, 0x51, 0xb8, 0xcf, 0xb6, 0x09, 0x2c, 0x69, 0x20,
+	0x17, 0x57, 0xd8, 0x1f, 0xf3, 0x11, 0x4e, 0x8c, 0x8d, 0xee, 0x36, 0x83, 0x56, 0xa5, 0xbb, 0xe9,
+	0x69, 0xcf, 0xc3, 0xb7, 0x05, 0x2b, 0xac, 0x54, 0x48, 0x7d, 0x8b, 0x55, 0x29, 0x2d, 0x4f, 0x6f,
+	0x5a, 0x2f, 0x58, 0x6d, 0x00, 0xc0, 0x6d, 0xa6, 0x24, 0xf1, 0xa9, 0x50, 0x32, 0x11, 0x84, 0x86,
+	0x9f, 0x67, 0x36, 0xba, 0x57, 0x6a, 0x03, 0x80, 0xd3, 0x82, 0x9e, 0x2d, 0xe0, 0x61, 0x66, 0xc3,
+	0x97, 0xac, 0x71, 0xa5, 0xb9, 0x91, 0x72, 0x4b, 0x62, 0x0c, 0xc6, 0x3a, 0xb5, 0xea, 0xd4, 0xda,
+	0x42, 0xed, 0x15, 0x81, 0xd3, 0x92, 0xff, 0x67, 0xa7, 0x60, 0xfa, 0x23, 0xa1, 0x89, 0x67, 0x88,
+	0xca, 0xd9, 0xf7, 0x6f, 0xda, 0xc7, 0x3e, 0x70, 0x82, 0xa8, 0x0a, 0x7b, 0x8f, 0x6d, 0x5b, 0x90,
+	0x17, 0x13, 0x03, 0x1c, 0x33, 0xe2, 0x52, 0xf3, 0x04, 0x06, 0x62, 0xa2, 0x28, 0x5a, 0x6e, 0x06,
+	0xad, 0xe5, 0xee, 0x86, 0xa7, 0xef, 0x33, 0x7a, 0xa7, 0x8f, 0x4a, 0x14, 0x3e, 0x66, 0x6b, 0x1a,
+	0xe8, 0x23, 0x9a, 0x71, 0xd9, 0xd5, 0x8a, 0x1b, 0xff, 0xaa, 0xbf, 0x73, 0x55, 0x1d, 0xb0, 0x87,
+	0xa9, 0xd4, 0x7c, 0x0a, 0x46, 0x0e, 0x24, 0x18, 0x2e, 0xa8, 0x98, 0xb3, 0xab, 0xd2, 0x46, 0xac,
+	0x19, 0xb4, 0xd6, 0xbb, 0xb5, 0x54, 0xea, 0x33, 0xcf, 0x5f, 0x5f, 0xc3, 0xe1, 0x2b, 0xd6, 0x48,
+	0x81, 0x44, 0x22, 0x48, 0xf0, 0xe2, 0x63, 0x87, 0xc0, 0x13, 0x50, 0x22, 0xf7, 0x0d, 0xac, 0xba,
+	0x3f, 0x8a, 0x16, 0x91, 0x37, 0x2e, 0x71, 0x54, 0x04, 0x5c, 0x0b, 0x07, 0x4f, 0x7f, 0x7f, 0x7d,
+	0x14, 0x7c, 0xfe, 0xf5, 0xed, 0x59, 0xe3, 0xda, 0x0a, 0x7f, 0xfa, 0xb7, 0xc4, 0xe5, 0xc2, 0x1d,
+	0xee, 0x7f, 0x9f, 0xc5, 0xc1, 0xe5, 0x2c, 0x0e, 0x7e, 0xce, 0xe2, 0xe0, 0xcb, 0x3c, 0x5e, 0xba,
+	0x9c, 0xc7, 0x4b, 0x3f, 0xe6, 0xf1, 0xd2, 0x87, 0xfa, 0xad, 0x1a, 0xe5, 0x19, 0xd8, 0xf3, 0xaa,
+	0x5b, 0xdc, 0xbd, 0xbf, 0x03, 0x00, 0x52, 0x1b, 0xf9, 0x79, 0x1e, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -206,6 +242,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SeizureOptInDefault != that1.SeizureOptInDefault {
 		return false
 	}
+	if this.NetworkMode != that1.NetworkMode {
+		return false
+	}
+	if this.MinVerifierAttestations != that1.MinVerifierAttestations {
+		return false
+	}
+	if this.MetadataChangeDelayHours != that1.MetadataChangeDelayHours {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -228,6 +273,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MetadataChangeDelayHours != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MetadataChangeDelayHours))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinVerifierAttestations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinVerifierAttestations))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.NetworkMode) > 0 {
+		i -= len(m.NetworkMode)
+		copy(dAtA[i:], m.NetworkMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NetworkMode)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.SeizureOptInDefault {
 		i--
 		if m.SeizureOptInDefault {
@@ -323,6 +385,16 @@ func (m *Params) Size() (n int) {
 	if m.SeizureOptInDefault {
 		n += 2
 	}
+	l = len(m.NetworkMode)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinVerifierAttestations != 0 {
+		n += 1 + sovParams(uint64(m.MinVerifierAttestations))
+	}
+	if m.MetadataChangeDelayHours != 0 {
+		n += 1 + sovParams(uint64(m.MetadataChangeDelayHours))
+	}
 	return n
 }
 
@@ -540,6 +612,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SeizureOptInDefault = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVerifierAttestations", wireType)
+			}
+			m.MinVerifierAttestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVerifierAttestations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataChangeDelayHours", wireType)
+			}
+			m.MetadataChangeDelayHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataChangeDelayHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
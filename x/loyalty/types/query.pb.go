@@ -114,6 +114,330 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryCreatorQuotaRequest defines the QueryCreatorQuotaRequest message.
+type QueryCreatorQuotaRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryCreatorQuotaRequest) Reset()         { *m = QueryCreatorQuotaRequest{} }
+func (m *QueryCreatorQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCreatorQuotaRequest) ProtoMessage()    {}
+func (*QueryCreatorQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{2}
+}
+func (m *QueryCreatorQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreatorQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreatorQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreatorQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreatorQuotaRequest.Merge(m, src)
+}
+func (m *QueryCreatorQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreatorQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreatorQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreatorQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryCreatorQuotaRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryCreatorQuotaResponse defines the QueryCreatorQuotaResponse message.
+type QueryCreatorQuotaResponse struct {
+	Creatorallowlist Creatorallowlist `protobuf:"bytes,1,opt,name=creatorallowlist,proto3" json:"creatorallowlist"`
+	// expired reports whether the entry's expiry has passed at the current block time.
+	Expired bool `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+	// remaining_tokens is meaningful only when unlimited_tokens is false.
+	RemainingTokens uint64 `protobuf:"varint,3,opt,name=remaining_tokens,json=remainingTokens,proto3" json:"remaining_tokens,omitempty"`
+	UnlimitedTokens bool   `protobuf:"varint,4,opt,name=unlimited_tokens,json=unlimitedTokens,proto3" json:"unlimited_tokens,omitempty"`
+	// remaining_supply is meaningful only when unlimited_supply is false.
+	RemainingSupply uint64 `protobuf:"varint,5,opt,name=remaining_supply,json=remainingSupply,proto3" json:"remaining_supply,omitempty"`
+	UnlimitedSupply bool   `protobuf:"varint,6,opt,name=unlimited_supply,json=unlimitedSupply,proto3" json:"unlimited_supply,omitempty"`
+	// usage is the creator's registered tokens and aggregate max_supply.
+	Usage CreatorUsage `protobuf:"bytes,7,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryCreatorQuotaResponse) Reset()         { *m = QueryCreatorQuotaResponse{} }
+func (m *QueryCreatorQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreatorQuotaResponse) ProtoMessage()    {}
+func (*QueryCreatorQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{3}
+}
+func (m *QueryCreatorQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreatorQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreatorQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreatorQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreatorQuotaResponse.Merge(m, src)
+}
+func (m *QueryCreatorQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreatorQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreatorQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreatorQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryCreatorQuotaResponse) GetCreatorallowlist() Creatorallowlist {
+	if m != nil {
+		return m.Creatorallowlist
+	}
+	return Creatorallowlist{}
+}
+
+func (m *QueryCreatorQuotaResponse) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *QueryCreatorQuotaResponse) GetRemainingTokens() uint64 {
+	if m != nil {
+		return m.RemainingTokens
+	}
+	return 0
+}
+
+func (m *QueryCreatorQuotaResponse) GetUnlimitedTokens() bool {
+	if m != nil {
+		return m.UnlimitedTokens
+	}
+	return false
+}
+
+func (m *QueryCreatorQuotaResponse) GetRemainingSupply() uint64 {
+	if m != nil {
+		return m.RemainingSupply
+	}
+	return 0
+}
+
+func (m *QueryCreatorQuotaResponse) GetUnlimitedSupply() bool {
+	if m != nil {
+		return m.UnlimitedSupply
+	}
+	return false
+}
+
+func (m *QueryCreatorQuotaResponse) GetUsage() CreatorUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return CreatorUsage{}
+}
+
+// QueryEffectiveMinimumsRequest defines the QueryEffectiveMinimumsRequest message.
+type QueryEffectiveMinimumsRequest struct {
+}
+
+func (m *QueryEffectiveMinimumsRequest) Reset()         { *m = QueryEffectiveMinimumsRequest{} }
+func (m *QueryEffectiveMinimumsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinimumsRequest) ProtoMessage()    {}
+func (*QueryEffectiveMinimumsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{4}
+}
+func (m *QueryEffectiveMinimumsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMinimumsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinimumsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMinimumsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinimumsRequest.Merge(m, src)
+}
+func (m *QueryEffectiveMinimumsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMinimumsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinimumsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinimumsRequest proto.InternalMessageInfo
+
+// QueryEffectiveMinimumsResponse defines the QueryEffectiveMinimumsResponse message.
+type QueryEffectiveMinimumsResponse struct {
+	NetworkMode string `protobuf:"bytes,1,opt,name=network_mode,json=networkMode,proto3" json:"network_mode,omitempty"`
+	// recovery_timelock_hours is the minimum timelock a recovery-enabled token must use.
+	RecoveryTimelockHours uint64 `protobuf:"varint,2,opt,name=recovery_timelock_hours,json=recoveryTimelockHours,proto3" json:"recovery_timelock_hours,omitempty"`
+}
+
+func (m *QueryEffectiveMinimumsResponse) Reset()         { *m = QueryEffectiveMinimumsResponse{} }
+func (m *QueryEffectiveMinimumsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinimumsResponse) ProtoMessage()    {}
+func (*QueryEffectiveMinimumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{5}
+}
+func (m *QueryEffectiveMinimumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMinimumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinimumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMinimumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinimumsResponse.Merge(m, src)
+}
+func (m *QueryEffectiveMinimumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMinimumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinimumsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinimumsResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveMinimumsResponse) GetNetworkMode() string {
+	if m != nil {
+		return m.NetworkMode
+	}
+	return ""
+}
+
+func (m *QueryEffectiveMinimumsResponse) GetRecoveryTimelockHours() uint64 {
+	if m != nil {
+		return m.RecoveryTimelockHours
+	}
+	return 0
+}
+
+// QueryAuthoritiesRequest defines the QueryAuthoritiesRequest message.
+type QueryAuthoritiesRequest struct {
+}
+
+func (m *QueryAuthoritiesRequest) Reset()         { *m = QueryAuthoritiesRequest{} }
+func (m *QueryAuthoritiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthoritiesRequest) ProtoMessage()    {}
+func (*QueryAuthoritiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{6}
+}
+func (m *QueryAuthoritiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthoritiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthoritiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthoritiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthoritiesRequest.Merge(m, src)
+}
+func (m *QueryAuthoritiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthoritiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthoritiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthoritiesRequest proto.InternalMessageInfo
+
+// QueryAuthoritiesResponse defines the QueryAuthoritiesResponse message.
+type QueryAuthoritiesResponse struct {
+	// authority is the module authority, which implicitly holds every role.
+	Authority   string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Authorities Authorities `protobuf:"bytes,2,opt,name=authorities,proto3" json:"authorities"`
+}
+
+func (m *QueryAuthoritiesResponse) Reset()         { *m = QueryAuthoritiesResponse{} }
+func (m *QueryAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthoritiesResponse) ProtoMessage()    {}
+func (*QueryAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{7}
+}
+func (m *QueryAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthoritiesResponse.Merge(m, src)
+}
+func (m *QueryAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthoritiesResponse proto.InternalMessageInfo
+
+func (m *QueryAuthoritiesResponse) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *QueryAuthoritiesResponse) GetAuthorities() Authorities {
+	if m != nil {
+		return m.Authorities
+	}
+	return Authorities{}
+}
+
 // QueryGetCreatorallowlistRequest defines the QueryGetCreatorallowlistRequest message.
 type QueryGetCreatorallowlistRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -123,7 +447,7 @@ func (m *QueryGetCreatorallowlistRequest) Reset()         { *m = QueryGetCreator
 func (m *QueryGetCreatorallowlistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCreatorallowlistRequest) ProtoMessage()    {}
 func (*QueryGetCreatorallowlistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{2}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{8}
 }
 func (m *QueryGetCreatorallowlistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +492,7 @@ func (m *QueryGetCreatorallowlistResponse) Reset()         { *m = QueryGetCreato
 func (m *QueryGetCreatorallowlistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCreatorallowlistResponse) ProtoMessage()    {}
 func (*QueryGetCreatorallowlistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{3}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{9}
 }
 func (m *QueryGetCreatorallowlistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +537,7 @@ func (m *QueryAllCreatorallowlistRequest) Reset()         { *m = QueryAllCreator
 func (m *QueryAllCreatorallowlistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCreatorallowlistRequest) ProtoMessage()    {}
 func (*QueryAllCreatorallowlistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{4}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{10}
 }
 func (m *QueryAllCreatorallowlistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +583,7 @@ func (m *QueryAllCreatorallowlistResponse) Reset()         { *m = QueryAllCreato
 func (m *QueryAllCreatorallowlistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCreatorallowlistResponse) ProtoMessage()    {}
 func (*QueryAllCreatorallowlistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{5}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{11}
 }
 func (m *QueryAllCreatorallowlistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +635,7 @@ func (m *QueryGetVerifiedtokenRequest) Reset()         { *m = QueryGetVerifiedto
 func (m *QueryGetVerifiedtokenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetVerifiedtokenRequest) ProtoMessage()    {}
 func (*QueryGetVerifiedtokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{6}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{12}
 }
 func (m *QueryGetVerifiedtokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +680,7 @@ func (m *QueryGetVerifiedtokenByDenomRequest) Reset()         { *m = QueryGetVer
 func (m *QueryGetVerifiedtokenByDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetVerifiedtokenByDenomRequest) ProtoMessage()    {}
 func (*QueryGetVerifiedtokenByDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{7}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{13}
 }
 func (m *QueryGetVerifiedtokenByDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +725,7 @@ func (m *QueryGetVerifiedtokenResponse) Reset()         { *m = QueryGetVerifiedt
 func (m *QueryGetVerifiedtokenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetVerifiedtokenResponse) ProtoMessage()    {}
 func (*QueryGetVerifiedtokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{8}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{14}
 }
 func (m *QueryGetVerifiedtokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +770,7 @@ func (m *QueryAllVerifiedtokenRequest) Reset()         { *m = QueryAllVerifiedto
 func (m *QueryAllVerifiedtokenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllVerifiedtokenRequest) ProtoMessage()    {}
 func (*QueryAllVerifiedtokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{9}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{15}
 }
 func (m *QueryAllVerifiedtokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +816,7 @@ func (m *QueryAllVerifiedtokenResponse) Reset()         { *m = QueryAllVerifiedt
 func (m *QueryAllVerifiedtokenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllVerifiedtokenResponse) ProtoMessage()    {}
 func (*QueryAllVerifiedtokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{10}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{16}
 }
 func (m *QueryAllVerifiedtokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +868,7 @@ func (m *QueryGetRewardaccrualRequest) Reset()         { *m = QueryGetRewardaccr
 func (m *QueryGetRewardaccrualRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRewardaccrualRequest) ProtoMessage()    {}
 func (*QueryGetRewardaccrualRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{11}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{17}
 }
 func (m *QueryGetRewardaccrualRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +913,7 @@ func (m *QueryGetRewardaccrualResponse) Reset()         { *m = QueryGetRewardacc
 func (m *QueryGetRewardaccrualResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRewardaccrualResponse) ProtoMessage()    {}
 func (*QueryGetRewardaccrualResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{12}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{18}
 }
 func (m *QueryGetRewardaccrualResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +958,7 @@ func (m *QueryAllRewardaccrualRequest) Reset()         { *m = QueryAllRewardaccr
 func (m *QueryAllRewardaccrualRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllRewardaccrualRequest) ProtoMessage()    {}
 func (*QueryAllRewardaccrualRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{13}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{19}
 }
 func (m *QueryAllRewardaccrualRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,7 +1004,7 @@ func (m *QueryAllRewardaccrualResponse) Reset()         { *m = QueryAllRewardacc
 func (m *QueryAllRewardaccrualResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllRewardaccrualResponse) ProtoMessage()    {}
 func (*QueryAllRewardaccrualResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{14}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{20}
 }
 func (m *QueryAllRewardaccrualResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +1058,7 @@ func (m *QueryFilterRewardaccrualRequest) Reset()         { *m = QueryFilterRewa
 func (m *QueryFilterRewardaccrualRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFilterRewardaccrualRequest) ProtoMessage()    {}
 func (*QueryFilterRewardaccrualRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{15}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{21}
 }
 func (m *QueryFilterRewardaccrualRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,7 +1118,7 @@ func (m *QueryFilterRewardaccrualResponse) Reset()         { *m = QueryFilterRew
 func (m *QueryFilterRewardaccrualResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFilterRewardaccrualResponse) ProtoMessage()    {}
 func (*QueryFilterRewardaccrualResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{16}
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{22}
 }
 func (m *QueryFilterRewardaccrualResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,23 +1161,25 @@ func (m *QueryFilterRewardaccrualResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryGetMerchantallocationRequest defines the QueryGetMerchantallocationRequest message.
-type QueryGetMerchantallocationRequest struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+// QueryAccountPortfolioRequest defines the QueryAccountPortfolioRequest message.
+type QueryAccountPortfolioRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination takes a key and a limit; offsets and totals are not supported.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMerchantallocationRequest) Reset()         { *m = QueryGetMerchantallocationRequest{} }
-func (m *QueryGetMerchantallocationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMerchantallocationRequest) ProtoMessage()    {}
-func (*QueryGetMerchantallocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{17}
+func (m *QueryAccountPortfolioRequest) Reset()         { *m = QueryAccountPortfolioRequest{} }
+func (m *QueryAccountPortfolioRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPortfolioRequest) ProtoMessage()    {}
+func (*QueryAccountPortfolioRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{23}
 }
-func (m *QueryGetMerchantallocationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAccountPortfolioRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMerchantallocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountPortfolioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMerchantallocationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountPortfolioRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -863,42 +1189,50 @@ func (m *QueryGetMerchantallocationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMerchantallocationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMerchantallocationRequest.Merge(m, src)
+func (m *QueryAccountPortfolioRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPortfolioRequest.Merge(m, src)
 }
-func (m *QueryGetMerchantallocationRequest) XXX_Size() int {
+func (m *QueryAccountPortfolioRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMerchantallocationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMerchantallocationRequest.DiscardUnknown(m)
+func (m *QueryAccountPortfolioRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPortfolioRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMerchantallocationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAccountPortfolioRequest proto.InternalMessageInfo
 
-func (m *QueryGetMerchantallocationRequest) GetKey() string {
+func (m *QueryAccountPortfolioRequest) GetAddress() string {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return ""
 }
 
-// QueryGetMerchantallocationResponse defines the QueryGetMerchantallocationResponse message.
-type QueryGetMerchantallocationResponse struct {
-	Merchantallocation Merchantallocation `protobuf:"bytes,1,opt,name=merchantallocation,proto3" json:"merchantallocation"`
+func (m *QueryAccountPortfolioRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetMerchantallocationResponse) Reset()         { *m = QueryGetMerchantallocationResponse{} }
-func (m *QueryGetMerchantallocationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMerchantallocationResponse) ProtoMessage()    {}
-func (*QueryGetMerchantallocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{18}
+// QueryAccountPortfolioResponse defines the QueryAccountPortfolioResponse message.
+type QueryAccountPortfolioResponse struct {
+	Entries    []PortfolioEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryGetMerchantallocationResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryAccountPortfolioResponse) Reset()         { *m = QueryAccountPortfolioResponse{} }
+func (m *QueryAccountPortfolioResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPortfolioResponse) ProtoMessage()    {}
+func (*QueryAccountPortfolioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{24}
+}
+func (m *QueryAccountPortfolioResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMerchantallocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountPortfolioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMerchantallocationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountPortfolioResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -908,42 +1242,61 @@ func (m *QueryGetMerchantallocationResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMerchantallocationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMerchantallocationResponse.Merge(m, src)
+func (m *QueryAccountPortfolioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPortfolioResponse.Merge(m, src)
 }
-func (m *QueryGetMerchantallocationResponse) XXX_Size() int {
+func (m *QueryAccountPortfolioResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMerchantallocationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMerchantallocationResponse.DiscardUnknown(m)
+func (m *QueryAccountPortfolioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPortfolioResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMerchantallocationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAccountPortfolioResponse proto.InternalMessageInfo
 
-func (m *QueryGetMerchantallocationResponse) GetMerchantallocation() Merchantallocation {
+func (m *QueryAccountPortfolioResponse) GetEntries() []PortfolioEntry {
 	if m != nil {
-		return m.Merchantallocation
+		return m.Entries
 	}
-	return Merchantallocation{}
+	return nil
 }
 
-// QueryAllMerchantallocationRequest defines the QueryAllMerchantallocationRequest message.
-type QueryAllMerchantallocationRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryAccountPortfolioResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllMerchantallocationRequest) Reset()         { *m = QueryAllMerchantallocationRequest{} }
-func (m *QueryAllMerchantallocationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllMerchantallocationRequest) ProtoMessage()    {}
-func (*QueryAllMerchantallocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{19}
+// PortfolioEntry is what an address holds and is owed in one verified token.
+type PortfolioEntry struct {
+	Denom         string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Verifiedtoken Verifiedtoken `protobuf:"bytes,2,opt,name=verifiedtoken,proto3" json:"verifiedtoken"`
+	// balance is the bank balance of the address, including locked coins.
+	Balance uint64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// claimable is the unclaimed reward accrual of the address.
+	Claimable uint64 `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	// pool_balance is the balance of the reward pool that pays claims.
+	PoolBalance uint64 `protobuf:"varint,5,opt,name=pool_balance,json=poolBalance,proto3" json:"pool_balance,omitempty"`
+	// claim_funded is whether the reward pool covers the whole claimable amount.
+	ClaimFunded bool `protobuf:"varint,6,opt,name=claim_funded,json=claimFunded,proto3" json:"claim_funded,omitempty"`
+	// pending_recoveries are the queued recovery operations moving tokens out of
+	// the address.
+	PendingRecoveries []Recoveryoperation `protobuf:"bytes,7,rep,name=pending_recoveries,json=pendingRecoveries,proto3" json:"pending_recoveries"`
+}
+
+func (m *PortfolioEntry) Reset()         { *m = PortfolioEntry{} }
+func (m *PortfolioEntry) String() string { return proto.CompactTextString(m) }
+func (*PortfolioEntry) ProtoMessage()    {}
+func (*PortfolioEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{25}
 }
-func (m *QueryAllMerchantallocationRequest) XXX_Unmarshal(b []byte) error {
+func (m *PortfolioEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllMerchantallocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PortfolioEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllMerchantallocationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PortfolioEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -953,97 +1306,92 @@ func (m *QueryAllMerchantallocationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllMerchantallocationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllMerchantallocationRequest.Merge(m, src)
+func (m *PortfolioEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortfolioEntry.Merge(m, src)
 }
-func (m *QueryAllMerchantallocationRequest) XXX_Size() int {
+func (m *PortfolioEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllMerchantallocationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllMerchantallocationRequest.DiscardUnknown(m)
+func (m *PortfolioEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortfolioEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllMerchantallocationRequest proto.InternalMessageInfo
+var xxx_messageInfo_PortfolioEntry proto.InternalMessageInfo
 
-func (m *QueryAllMerchantallocationRequest) GetPagination() *query.PageRequest {
+func (m *PortfolioEntry) GetDenom() string {
 	if m != nil {
-		return m.Pagination
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-// QueryAllMerchantallocationResponse defines the QueryAllMerchantallocationResponse message.
-type QueryAllMerchantallocationResponse struct {
-	Merchantallocation []Merchantallocation `protobuf:"bytes,1,rep,name=merchantallocation,proto3" json:"merchantallocation"`
-	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *PortfolioEntry) GetVerifiedtoken() Verifiedtoken {
+	if m != nil {
+		return m.Verifiedtoken
+	}
+	return Verifiedtoken{}
 }
 
-func (m *QueryAllMerchantallocationResponse) Reset()         { *m = QueryAllMerchantallocationResponse{} }
-func (m *QueryAllMerchantallocationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllMerchantallocationResponse) ProtoMessage()    {}
-func (*QueryAllMerchantallocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{20}
-}
-func (m *QueryAllMerchantallocationResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryAllMerchantallocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryAllMerchantallocationResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *PortfolioEntry) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
 	}
+	return 0
 }
-func (m *QueryAllMerchantallocationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllMerchantallocationResponse.Merge(m, src)
-}
-func (m *QueryAllMerchantallocationResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryAllMerchantallocationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllMerchantallocationResponse.DiscardUnknown(m)
+
+func (m *PortfolioEntry) GetClaimable() uint64 {
+	if m != nil {
+		return m.Claimable
+	}
+	return 0
 }
 
-var xxx_messageInfo_QueryAllMerchantallocationResponse proto.InternalMessageInfo
+func (m *PortfolioEntry) GetPoolBalance() uint64 {
+	if m != nil {
+		return m.PoolBalance
+	}
+	return 0
+}
 
-func (m *QueryAllMerchantallocationResponse) GetMerchantallocation() []Merchantallocation {
+func (m *PortfolioEntry) GetClaimFunded() bool {
 	if m != nil {
-		return m.Merchantallocation
+		return m.ClaimFunded
 	}
-	return nil
+	return false
 }
 
-func (m *QueryAllMerchantallocationResponse) GetPagination() *query.PageResponse {
+func (m *PortfolioEntry) GetPendingRecoveries() []Recoveryoperation {
 	if m != nil {
-		return m.Pagination
+		return m.PendingRecoveries
 	}
 	return nil
 }
 
-// QueryFilterMerchantallocationRequest defines the QueryFilterMerchantallocationRequest message.
-type QueryFilterMerchantallocationRequest struct {
-	Date       string             `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QuerySearchVerifiedtokensRequest defines the QuerySearchVerifiedtokensRequest message.
+// Empty filters match every token; the flag filters take "true" or "false".
+type QuerySearchVerifiedtokensRequest struct {
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// symbol_prefix matches symbols case-insensitively.
+	SymbolPrefix   string `protobuf:"bytes,2,opt,name=symbol_prefix,json=symbolPrefix,proto3" json:"symbol_prefix,omitempty"`
+	Verified       string `protobuf:"bytes,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	SeizureOptIn   string `protobuf:"bytes,4,opt,name=seizure_opt_in,json=seizureOptIn,proto3" json:"seizure_opt_in,omitempty"`
+	AdminRenounced string `protobuf:"bytes,5,opt,name=admin_renounced,json=adminRenounced,proto3" json:"admin_renounced,omitempty"`
+	// pagination takes a key and a limit; offsets and totals are not supported.
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFilterMerchantallocationRequest) Reset()         { *m = QueryFilterMerchantallocationRequest{} }
-func (m *QueryFilterMerchantallocationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFilterMerchantallocationRequest) ProtoMessage()    {}
-func (*QueryFilterMerchantallocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{21}
+func (m *QuerySearchVerifiedtokensRequest) Reset()         { *m = QuerySearchVerifiedtokensRequest{} }
+func (m *QuerySearchVerifiedtokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchVerifiedtokensRequest) ProtoMessage()    {}
+func (*QuerySearchVerifiedtokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{26}
 }
-func (m *QueryFilterMerchantallocationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySearchVerifiedtokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFilterMerchantallocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySearchVerifiedtokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFilterMerchantallocationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySearchVerifiedtokensRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1053,57 +1401,78 @@ func (m *QueryFilterMerchantallocationRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryFilterMerchantallocationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFilterMerchantallocationRequest.Merge(m, src)
+func (m *QuerySearchVerifiedtokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchVerifiedtokensRequest.Merge(m, src)
 }
-func (m *QueryFilterMerchantallocationRequest) XXX_Size() int {
+func (m *QuerySearchVerifiedtokensRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFilterMerchantallocationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFilterMerchantallocationRequest.DiscardUnknown(m)
+func (m *QuerySearchVerifiedtokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchVerifiedtokensRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFilterMerchantallocationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySearchVerifiedtokensRequest proto.InternalMessageInfo
 
-func (m *QueryFilterMerchantallocationRequest) GetDate() string {
+func (m *QuerySearchVerifiedtokensRequest) GetIssuer() string {
 	if m != nil {
-		return m.Date
+		return m.Issuer
 	}
 	return ""
 }
 
-func (m *QueryFilterMerchantallocationRequest) GetDenom() string {
+func (m *QuerySearchVerifiedtokensRequest) GetSymbolPrefix() string {
 	if m != nil {
-		return m.Denom
+		return m.SymbolPrefix
 	}
 	return ""
 }
 
-func (m *QueryFilterMerchantallocationRequest) GetPagination() *query.PageRequest {
+func (m *QuerySearchVerifiedtokensRequest) GetVerified() string {
+	if m != nil {
+		return m.Verified
+	}
+	return ""
+}
+
+func (m *QuerySearchVerifiedtokensRequest) GetSeizureOptIn() string {
+	if m != nil {
+		return m.SeizureOptIn
+	}
+	return ""
+}
+
+func (m *QuerySearchVerifiedtokensRequest) GetAdminRenounced() string {
+	if m != nil {
+		return m.AdminRenounced
+	}
+	return ""
+}
+
+func (m *QuerySearchVerifiedtokensRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryFilterMerchantallocationResponse defines the QueryFilterMerchantallocationResponse message.
-type QueryFilterMerchantallocationResponse struct {
-	Merchantallocation []Merchantallocation `protobuf:"bytes,1,rep,name=merchantallocation,proto3" json:"merchantallocation"`
-	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QuerySearchVerifiedtokensResponse defines the QuerySearchVerifiedtokensResponse message.
+type QuerySearchVerifiedtokensResponse struct {
+	Verifiedtokens []VerifiedtokenListing `protobuf:"bytes,1,rep,name=verifiedtokens,proto3" json:"verifiedtokens"`
+	Pagination     *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFilterMerchantallocationResponse) Reset()         { *m = QueryFilterMerchantallocationResponse{} }
-func (m *QueryFilterMerchantallocationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFilterMerchantallocationResponse) ProtoMessage()    {}
-func (*QueryFilterMerchantallocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{22}
+func (m *QuerySearchVerifiedtokensResponse) Reset()         { *m = QuerySearchVerifiedtokensResponse{} }
+func (m *QuerySearchVerifiedtokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchVerifiedtokensResponse) ProtoMessage()    {}
+func (*QuerySearchVerifiedtokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{27}
 }
-func (m *QueryFilterMerchantallocationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySearchVerifiedtokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFilterMerchantallocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySearchVerifiedtokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFilterMerchantallocationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySearchVerifiedtokensResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1113,49 +1482,54 @@ func (m *QueryFilterMerchantallocationResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryFilterMerchantallocationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFilterMerchantallocationResponse.Merge(m, src)
+func (m *QuerySearchVerifiedtokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchVerifiedtokensResponse.Merge(m, src)
 }
-func (m *QueryFilterMerchantallocationResponse) XXX_Size() int {
+func (m *QuerySearchVerifiedtokensResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFilterMerchantallocationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFilterMerchantallocationResponse.DiscardUnknown(m)
+func (m *QuerySearchVerifiedtokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchVerifiedtokensResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFilterMerchantallocationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySearchVerifiedtokensResponse proto.InternalMessageInfo
 
-func (m *QueryFilterMerchantallocationResponse) GetMerchantallocation() []Merchantallocation {
+func (m *QuerySearchVerifiedtokensResponse) GetVerifiedtokens() []VerifiedtokenListing {
 	if m != nil {
-		return m.Merchantallocation
+		return m.Verifiedtokens
 	}
 	return nil
 }
 
-func (m *QueryFilterMerchantallocationResponse) GetPagination() *query.PageResponse {
+func (m *QuerySearchVerifiedtokensResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetRecoveryoperationRequest defines the QueryGetRecoveryoperationRequest message.
-type QueryGetRecoveryoperationRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+// VerifiedtokenListing is a verified token with the figures wallets show next to it.
+type VerifiedtokenListing struct {
+	Verifiedtoken Verifiedtoken `protobuf:"bytes,1,opt,name=verifiedtoken,proto3" json:"verifiedtoken"`
+	// supply is the bank supply of the token, net of burns.
+	Supply uint64 `protobuf:"varint,2,opt,name=supply,proto3" json:"supply,omitempty"`
+	// pool_balance is the balance of the reward pool that pays claims.
+	PoolBalance uint64           `protobuf:"varint,3,opt,name=pool_balance,json=poolBalance,proto3" json:"pool_balance,omitempty"`
+	StakerStats TokenStakerStats `protobuf:"bytes,4,opt,name=staker_stats,json=stakerStats,proto3" json:"staker_stats"`
 }
 
-func (m *QueryGetRecoveryoperationRequest) Reset()         { *m = QueryGetRecoveryoperationRequest{} }
-func (m *QueryGetRecoveryoperationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecoveryoperationRequest) ProtoMessage()    {}
-func (*QueryGetRecoveryoperationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{23}
+func (m *VerifiedtokenListing) Reset()         { *m = VerifiedtokenListing{} }
+func (m *VerifiedtokenListing) String() string { return proto.CompactTextString(m) }
+func (*VerifiedtokenListing) ProtoMessage()    {}
+func (*VerifiedtokenListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{28}
 }
-func (m *QueryGetRecoveryoperationRequest) XXX_Unmarshal(b []byte) error {
+func (m *VerifiedtokenListing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VerifiedtokenListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecoveryoperationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VerifiedtokenListing.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1165,42 +1539,63 @@ func (m *QueryGetRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecoveryoperationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecoveryoperationRequest.Merge(m, src)
+func (m *VerifiedtokenListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiedtokenListing.Merge(m, src)
 }
-func (m *QueryGetRecoveryoperationRequest) XXX_Size() int {
+func (m *VerifiedtokenListing) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecoveryoperationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecoveryoperationRequest.DiscardUnknown(m)
+func (m *VerifiedtokenListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiedtokenListing.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecoveryoperationRequest proto.InternalMessageInfo
+var xxx_messageInfo_VerifiedtokenListing proto.InternalMessageInfo
 
-func (m *QueryGetRecoveryoperationRequest) GetId() uint64 {
+func (m *VerifiedtokenListing) GetVerifiedtoken() Verifiedtoken {
 	if m != nil {
-		return m.Id
+		return m.Verifiedtoken
+	}
+	return Verifiedtoken{}
+}
+
+func (m *VerifiedtokenListing) GetSupply() uint64 {
+	if m != nil {
+		return m.Supply
 	}
 	return 0
 }
 
-// QueryGetRecoveryoperationResponse defines the QueryGetRecoveryoperationResponse message.
-type QueryGetRecoveryoperationResponse struct {
-	Recoveryoperation Recoveryoperation `protobuf:"bytes,1,opt,name=recoveryoperation,proto3" json:"recoveryoperation"`
+func (m *VerifiedtokenListing) GetPoolBalance() uint64 {
+	if m != nil {
+		return m.PoolBalance
+	}
+	return 0
 }
 
-func (m *QueryGetRecoveryoperationResponse) Reset()         { *m = QueryGetRecoveryoperationResponse{} }
-func (m *QueryGetRecoveryoperationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecoveryoperationResponse) ProtoMessage()    {}
-func (*QueryGetRecoveryoperationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{24}
+func (m *VerifiedtokenListing) GetStakerStats() TokenStakerStats {
+	if m != nil {
+		return m.StakerStats
+	}
+	return TokenStakerStats{}
 }
-func (m *QueryGetRecoveryoperationResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryGetMerchantallocationRequest defines the QueryGetMerchantallocationRequest message.
+type QueryGetMerchantallocationRequest struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *QueryGetMerchantallocationRequest) Reset()         { *m = QueryGetMerchantallocationRequest{} }
+func (m *QueryGetMerchantallocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMerchantallocationRequest) ProtoMessage()    {}
+func (*QueryGetMerchantallocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{29}
+}
+func (m *QueryGetMerchantallocationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMerchantallocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecoveryoperationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMerchantallocationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1210,42 +1605,42 @@ func (m *QueryGetRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecoveryoperationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecoveryoperationResponse.Merge(m, src)
+func (m *QueryGetMerchantallocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMerchantallocationRequest.Merge(m, src)
 }
-func (m *QueryGetRecoveryoperationResponse) XXX_Size() int {
+func (m *QueryGetMerchantallocationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecoveryoperationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecoveryoperationResponse.DiscardUnknown(m)
+func (m *QueryGetMerchantallocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMerchantallocationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecoveryoperationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMerchantallocationRequest proto.InternalMessageInfo
 
-func (m *QueryGetRecoveryoperationResponse) GetRecoveryoperation() Recoveryoperation {
+func (m *QueryGetMerchantallocationRequest) GetKey() string {
 	if m != nil {
-		return m.Recoveryoperation
+		return m.Key
 	}
-	return Recoveryoperation{}
+	return ""
 }
 
-// QueryAllRecoveryoperationRequest defines the QueryAllRecoveryoperationRequest message.
-type QueryAllRecoveryoperationRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetMerchantallocationResponse defines the QueryGetMerchantallocationResponse message.
+type QueryGetMerchantallocationResponse struct {
+	Merchantallocation Merchantallocation `protobuf:"bytes,1,opt,name=merchantallocation,proto3" json:"merchantallocation"`
 }
 
-func (m *QueryAllRecoveryoperationRequest) Reset()         { *m = QueryAllRecoveryoperationRequest{} }
-func (m *QueryAllRecoveryoperationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllRecoveryoperationRequest) ProtoMessage()    {}
-func (*QueryAllRecoveryoperationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{25}
+func (m *QueryGetMerchantallocationResponse) Reset()         { *m = QueryGetMerchantallocationResponse{} }
+func (m *QueryGetMerchantallocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMerchantallocationResponse) ProtoMessage()    {}
+func (*QueryGetMerchantallocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{30}
 }
-func (m *QueryAllRecoveryoperationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMerchantallocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMerchantallocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllRecoveryoperationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMerchantallocationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1255,43 +1650,42 @@ func (m *QueryAllRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllRecoveryoperationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllRecoveryoperationRequest.Merge(m, src)
+func (m *QueryGetMerchantallocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMerchantallocationResponse.Merge(m, src)
 }
-func (m *QueryAllRecoveryoperationRequest) XXX_Size() int {
+func (m *QueryGetMerchantallocationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllRecoveryoperationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllRecoveryoperationRequest.DiscardUnknown(m)
+func (m *QueryGetMerchantallocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMerchantallocationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllRecoveryoperationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMerchantallocationResponse proto.InternalMessageInfo
 
-func (m *QueryAllRecoveryoperationRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetMerchantallocationResponse) GetMerchantallocation() Merchantallocation {
 	if m != nil {
-		return m.Pagination
+		return m.Merchantallocation
 	}
-	return nil
+	return Merchantallocation{}
 }
 
-// QueryAllRecoveryoperationResponse defines the QueryAllRecoveryoperationResponse message.
-type QueryAllRecoveryoperationResponse struct {
-	Recoveryoperation []Recoveryoperation `protobuf:"bytes,1,rep,name=recoveryoperation,proto3" json:"recoveryoperation"`
-	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryAllMerchantallocationRequest defines the QueryAllMerchantallocationRequest message.
+type QueryAllMerchantallocationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRecoveryoperationResponse) Reset()         { *m = QueryAllRecoveryoperationResponse{} }
-func (m *QueryAllRecoveryoperationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllRecoveryoperationResponse) ProtoMessage()    {}
-func (*QueryAllRecoveryoperationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{26}
+func (m *QueryAllMerchantallocationRequest) Reset()         { *m = QueryAllMerchantallocationRequest{} }
+func (m *QueryAllMerchantallocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMerchantallocationRequest) ProtoMessage()    {}
+func (*QueryAllMerchantallocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{31}
 }
-func (m *QueryAllRecoveryoperationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllMerchantallocationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllMerchantallocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllRecoveryoperationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllMerchantallocationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1301,54 +1695,43 @@ func (m *QueryAllRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllRecoveryoperationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllRecoveryoperationResponse.Merge(m, src)
+func (m *QueryAllMerchantallocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMerchantallocationRequest.Merge(m, src)
 }
-func (m *QueryAllRecoveryoperationResponse) XXX_Size() int {
+func (m *QueryAllMerchantallocationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllRecoveryoperationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllRecoveryoperationResponse.DiscardUnknown(m)
+func (m *QueryAllMerchantallocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMerchantallocationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllRecoveryoperationResponse proto.InternalMessageInfo
-
-func (m *QueryAllRecoveryoperationResponse) GetRecoveryoperation() []Recoveryoperation {
-	if m != nil {
-		return m.Recoveryoperation
-	}
-	return nil
-}
+var xxx_messageInfo_QueryAllMerchantallocationRequest proto.InternalMessageInfo
 
-func (m *QueryAllRecoveryoperationResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllMerchantallocationRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryFilterRecoveryoperationRequest defines the QueryFilterRecoveryoperationRequest message.
-type QueryFilterRecoveryoperationRequest struct {
-	Status      string             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Denom       string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	RequestedBy string             `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
-	FromAddress string             `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	ToAddress   string             `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	Pagination  *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryAllMerchantallocationResponse defines the QueryAllMerchantallocationResponse message.
+type QueryAllMerchantallocationResponse struct {
+	Merchantallocation []Merchantallocation `protobuf:"bytes,1,rep,name=merchantallocation,proto3" json:"merchantallocation"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFilterRecoveryoperationRequest) Reset()         { *m = QueryFilterRecoveryoperationRequest{} }
-func (m *QueryFilterRecoveryoperationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFilterRecoveryoperationRequest) ProtoMessage()    {}
-func (*QueryFilterRecoveryoperationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{27}
+func (m *QueryAllMerchantallocationResponse) Reset()         { *m = QueryAllMerchantallocationResponse{} }
+func (m *QueryAllMerchantallocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMerchantallocationResponse) ProtoMessage()    {}
+func (*QueryAllMerchantallocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{32}
 }
-func (m *QueryFilterRecoveryoperationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllMerchantallocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFilterRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllMerchantallocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFilterRecoveryoperationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllMerchantallocationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1358,78 +1741,111 @@ func (m *QueryFilterRecoveryoperationRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryFilterRecoveryoperationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFilterRecoveryoperationRequest.Merge(m, src)
+func (m *QueryAllMerchantallocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMerchantallocationResponse.Merge(m, src)
 }
-func (m *QueryFilterRecoveryoperationRequest) XXX_Size() int {
+func (m *QueryAllMerchantallocationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFilterRecoveryoperationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFilterRecoveryoperationRequest.DiscardUnknown(m)
+func (m *QueryAllMerchantallocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMerchantallocationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFilterRecoveryoperationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllMerchantallocationResponse proto.InternalMessageInfo
 
-func (m *QueryFilterRecoveryoperationRequest) GetStatus() string {
+func (m *QueryAllMerchantallocationResponse) GetMerchantallocation() []Merchantallocation {
 	if m != nil {
-		return m.Status
+		return m.Merchantallocation
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryFilterRecoveryoperationRequest) GetDenom() string {
+func (m *QueryAllMerchantallocationResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Denom
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryFilterRecoveryoperationRequest) GetRequestedBy() string {
-	if m != nil {
-		return m.RequestedBy
+// QueryFilterMerchantallocationRequest defines the QueryFilterMerchantallocationRequest message.
+type QueryFilterMerchantallocationRequest struct {
+	Date       string             `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFilterMerchantallocationRequest) Reset()         { *m = QueryFilterMerchantallocationRequest{} }
+func (m *QueryFilterMerchantallocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFilterMerchantallocationRequest) ProtoMessage()    {}
+func (*QueryFilterMerchantallocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{33}
+}
+func (m *QueryFilterMerchantallocationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFilterMerchantallocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFilterMerchantallocationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryFilterMerchantallocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilterMerchantallocationRequest.Merge(m, src)
+}
+func (m *QueryFilterMerchantallocationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFilterMerchantallocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilterMerchantallocationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFilterRecoveryoperationRequest) GetFromAddress() string {
+var xxx_messageInfo_QueryFilterMerchantallocationRequest proto.InternalMessageInfo
+
+func (m *QueryFilterMerchantallocationRequest) GetDate() string {
 	if m != nil {
-		return m.FromAddress
+		return m.Date
 	}
 	return ""
 }
 
-func (m *QueryFilterRecoveryoperationRequest) GetToAddress() string {
+func (m *QueryFilterMerchantallocationRequest) GetDenom() string {
 	if m != nil {
-		return m.ToAddress
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *QueryFilterRecoveryoperationRequest) GetPagination() *query.PageRequest {
+func (m *QueryFilterMerchantallocationRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryFilterRecoveryoperationResponse defines the QueryFilterRecoveryoperationResponse message.
-type QueryFilterRecoveryoperationResponse struct {
-	Recoveryoperation []Recoveryoperation `protobuf:"bytes,1,rep,name=recoveryoperation,proto3" json:"recoveryoperation"`
-	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryFilterMerchantallocationResponse defines the QueryFilterMerchantallocationResponse message.
+type QueryFilterMerchantallocationResponse struct {
+	Merchantallocation []Merchantallocation `protobuf:"bytes,1,rep,name=merchantallocation,proto3" json:"merchantallocation"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFilterRecoveryoperationResponse) Reset()         { *m = QueryFilterRecoveryoperationResponse{} }
-func (m *QueryFilterRecoveryoperationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFilterRecoveryoperationResponse) ProtoMessage()    {}
-func (*QueryFilterRecoveryoperationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{28}
+func (m *QueryFilterMerchantallocationResponse) Reset()         { *m = QueryFilterMerchantallocationResponse{} }
+func (m *QueryFilterMerchantallocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFilterMerchantallocationResponse) ProtoMessage()    {}
+func (*QueryFilterMerchantallocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{34}
 }
-func (m *QueryFilterRecoveryoperationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFilterMerchantallocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFilterRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFilterMerchantallocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFilterRecoveryoperationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFilterMerchantallocationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1439,48 +1855,49 @@ func (m *QueryFilterRecoveryoperationResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryFilterRecoveryoperationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFilterRecoveryoperationResponse.Merge(m, src)
+func (m *QueryFilterMerchantallocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilterMerchantallocationResponse.Merge(m, src)
 }
-func (m *QueryFilterRecoveryoperationResponse) XXX_Size() int {
+func (m *QueryFilterMerchantallocationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFilterRecoveryoperationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFilterRecoveryoperationResponse.DiscardUnknown(m)
+func (m *QueryFilterMerchantallocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilterMerchantallocationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFilterRecoveryoperationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFilterMerchantallocationResponse proto.InternalMessageInfo
 
-func (m *QueryFilterRecoveryoperationResponse) GetRecoveryoperation() []Recoveryoperation {
+func (m *QueryFilterMerchantallocationResponse) GetMerchantallocation() []Merchantallocation {
 	if m != nil {
-		return m.Recoveryoperation
+		return m.Merchantallocation
 	}
 	return nil
 }
 
-func (m *QueryFilterRecoveryoperationResponse) GetPagination() *query.PageResponse {
+func (m *QueryFilterMerchantallocationResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDailyRollupStatusRequest defines the QueryDailyRollupStatusRequest message.
-type QueryDailyRollupStatusRequest struct {
+// QueryGetRecoveryoperationRequest defines the QueryGetRecoveryoperationRequest message.
+type QueryGetRecoveryoperationRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryDailyRollupStatusRequest) Reset()         { *m = QueryDailyRollupStatusRequest{} }
-func (m *QueryDailyRollupStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDailyRollupStatusRequest) ProtoMessage()    {}
-func (*QueryDailyRollupStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{29}
+func (m *QueryGetRecoveryoperationRequest) Reset()         { *m = QueryGetRecoveryoperationRequest{} }
+func (m *QueryGetRecoveryoperationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecoveryoperationRequest) ProtoMessage()    {}
+func (*QueryGetRecoveryoperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{35}
 }
-func (m *QueryDailyRollupStatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecoveryoperationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDailyRollupStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDailyRollupStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecoveryoperationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1490,39 +1907,42 @@ func (m *QueryDailyRollupStatusRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryDailyRollupStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDailyRollupStatusRequest.Merge(m, src)
+func (m *QueryGetRecoveryoperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecoveryoperationRequest.Merge(m, src)
 }
-func (m *QueryDailyRollupStatusRequest) XXX_Size() int {
+func (m *QueryGetRecoveryoperationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDailyRollupStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDailyRollupStatusRequest.DiscardUnknown(m)
+func (m *QueryGetRecoveryoperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecoveryoperationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDailyRollupStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecoveryoperationRequest proto.InternalMessageInfo
 
-// QueryDailyRollupStatusResponse defines the QueryDailyRollupStatusResponse message.
-type QueryDailyRollupStatusResponse struct {
-	Timezone            string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	CurrentLocalDate    string `protobuf:"bytes,2,opt,name=current_local_date,json=currentLocalDate,proto3" json:"current_local_date,omitempty"`
-	LastDailyRollupDate string `protobuf:"bytes,3,opt,name=last_daily_rollup_date,json=lastDailyRollupDate,proto3" json:"last_daily_rollup_date,omitempty"`
-	HasRolledToday      bool   `protobuf:"varint,4,opt,name=has_rolled_today,json=hasRolledToday,proto3" json:"has_rolled_today,omitempty"`
-	NextRollupDate      string `protobuf:"bytes,5,opt,name=next_rollup_date,json=nextRollupDate,proto3" json:"next_rollup_date,omitempty"`
+func (m *QueryGetRecoveryoperationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *QueryDailyRollupStatusResponse) Reset()         { *m = QueryDailyRollupStatusResponse{} }
-func (m *QueryDailyRollupStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDailyRollupStatusResponse) ProtoMessage()    {}
-func (*QueryDailyRollupStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{30}
+// QueryGetRecoveryoperationResponse defines the QueryGetRecoveryoperationResponse message.
+type QueryGetRecoveryoperationResponse struct {
+	Recoveryoperation Recoveryoperation `protobuf:"bytes,1,opt,name=recoveryoperation,proto3" json:"recoveryoperation"`
 }
-func (m *QueryDailyRollupStatusResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetRecoveryoperationResponse) Reset()         { *m = QueryGetRecoveryoperationResponse{} }
+func (m *QueryGetRecoveryoperationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecoveryoperationResponse) ProtoMessage()    {}
+func (*QueryGetRecoveryoperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{36}
+}
+func (m *QueryGetRecoveryoperationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDailyRollupStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDailyRollupStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecoveryoperationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1532,70 +1952,88 @@ func (m *QueryDailyRollupStatusResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryDailyRollupStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDailyRollupStatusResponse.Merge(m, src)
+func (m *QueryGetRecoveryoperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecoveryoperationResponse.Merge(m, src)
 }
-func (m *QueryDailyRollupStatusResponse) XXX_Size() int {
+func (m *QueryGetRecoveryoperationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDailyRollupStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDailyRollupStatusResponse.DiscardUnknown(m)
+func (m *QueryGetRecoveryoperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecoveryoperationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDailyRollupStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecoveryoperationResponse proto.InternalMessageInfo
 
-func (m *QueryDailyRollupStatusResponse) GetTimezone() string {
+func (m *QueryGetRecoveryoperationResponse) GetRecoveryoperation() Recoveryoperation {
 	if m != nil {
-		return m.Timezone
+		return m.Recoveryoperation
 	}
-	return ""
+	return Recoveryoperation{}
 }
 
-func (m *QueryDailyRollupStatusResponse) GetCurrentLocalDate() string {
-	if m != nil {
-		return m.CurrentLocalDate
-	}
-	return ""
+// QueryAllRecoveryoperationRequest defines the QueryAllRecoveryoperationRequest message.
+type QueryAllRecoveryoperationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDailyRollupStatusResponse) GetLastDailyRollupDate() string {
-	if m != nil {
-		return m.LastDailyRollupDate
-	}
-	return ""
+func (m *QueryAllRecoveryoperationRequest) Reset()         { *m = QueryAllRecoveryoperationRequest{} }
+func (m *QueryAllRecoveryoperationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRecoveryoperationRequest) ProtoMessage()    {}
+func (*QueryAllRecoveryoperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{37}
 }
-
-func (m *QueryDailyRollupStatusResponse) GetHasRolledToday() bool {
-	if m != nil {
-		return m.HasRolledToday
+func (m *QueryAllRecoveryoperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRecoveryoperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *QueryAllRecoveryoperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRecoveryoperationRequest.Merge(m, src)
+}
+func (m *QueryAllRecoveryoperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRecoveryoperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRecoveryoperationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDailyRollupStatusResponse) GetNextRollupDate() string {
+var xxx_messageInfo_QueryAllRecoveryoperationRequest proto.InternalMessageInfo
+
+func (m *QueryAllRecoveryoperationRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.NextRollupDate
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryRewardPoolBalanceRequest defines the QueryRewardPoolBalanceRequest message.
-type QueryRewardPoolBalanceRequest struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+// QueryAllRecoveryoperationResponse defines the QueryAllRecoveryoperationResponse message.
+type QueryAllRecoveryoperationResponse struct {
+	Recoveryoperation []Recoveryoperation `protobuf:"bytes,1,rep,name=recoveryoperation,proto3" json:"recoveryoperation"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRewardPoolBalanceRequest) Reset()         { *m = QueryRewardPoolBalanceRequest{} }
-func (m *QueryRewardPoolBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardPoolBalanceRequest) ProtoMessage()    {}
-func (*QueryRewardPoolBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{31}
+func (m *QueryAllRecoveryoperationResponse) Reset()         { *m = QueryAllRecoveryoperationResponse{} }
+func (m *QueryAllRecoveryoperationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRecoveryoperationResponse) ProtoMessage()    {}
+func (*QueryAllRecoveryoperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{38}
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllRecoveryoperationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardPoolBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllRecoveryoperationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1605,44 +2043,54 @@ func (m *QueryRewardPoolBalanceRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardPoolBalanceRequest.Merge(m, src)
+func (m *QueryAllRecoveryoperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRecoveryoperationResponse.Merge(m, src)
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Size() int {
+func (m *QueryAllRecoveryoperationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardPoolBalanceRequest.DiscardUnknown(m)
+func (m *QueryAllRecoveryoperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRecoveryoperationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardPoolBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllRecoveryoperationResponse proto.InternalMessageInfo
 
-func (m *QueryRewardPoolBalanceRequest) GetDenom() string {
+func (m *QueryAllRecoveryoperationResponse) GetRecoveryoperation() []Recoveryoperation {
 	if m != nil {
-		return m.Denom
+		return m.Recoveryoperation
 	}
-	return ""
+	return nil
 }
 
-// QueryRewardPoolBalanceResponse defines the QueryRewardPoolBalanceResponse message.
-type QueryRewardPoolBalanceResponse struct {
-	ModuleAddress string `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *QueryAllRecoveryoperationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryRewardPoolBalanceResponse) Reset()         { *m = QueryRewardPoolBalanceResponse{} }
-func (m *QueryRewardPoolBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardPoolBalanceResponse) ProtoMessage()    {}
-func (*QueryRewardPoolBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{32}
+// QueryFilterRecoveryoperationRequest defines the QueryFilterRecoveryoperationRequest message.
+type QueryFilterRecoveryoperationRequest struct {
+	Status      string             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Denom       string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	RequestedBy string             `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	FromAddress string             `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string             `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryFilterRecoveryoperationRequest) Reset()         { *m = QueryFilterRecoveryoperationRequest{} }
+func (m *QueryFilterRecoveryoperationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFilterRecoveryoperationRequest) ProtoMessage()    {}
+func (*QueryFilterRecoveryoperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{39}
+}
+func (m *QueryFilterRecoveryoperationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFilterRecoveryoperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardPoolBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFilterRecoveryoperationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1652,2696 +2100,17044 @@ func (m *QueryRewardPoolBalanceResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardPoolBalanceResponse.Merge(m, src)
+func (m *QueryFilterRecoveryoperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilterRecoveryoperationRequest.Merge(m, src)
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Size() int {
+func (m *QueryFilterRecoveryoperationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardPoolBalanceResponse.DiscardUnknown(m)
+func (m *QueryFilterRecoveryoperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilterRecoveryoperationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardPoolBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFilterRecoveryoperationRequest proto.InternalMessageInfo
 
-func (m *QueryRewardPoolBalanceResponse) GetModuleAddress() string {
+func (m *QueryFilterRecoveryoperationRequest) GetStatus() string {
 	if m != nil {
-		return m.ModuleAddress
+		return m.Status
 	}
 	return ""
 }
 
-func (m *QueryRewardPoolBalanceResponse) GetDenom() string {
+func (m *QueryFilterRecoveryoperationRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *QueryRewardPoolBalanceResponse) GetAmount() string {
+func (m *QueryFilterRecoveryoperationRequest) GetRequestedBy() string {
 	if m != nil {
-		return m.Amount
+		return m.RequestedBy
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "tokenchain.loyalty.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tokenchain.loyalty.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetCreatorallowlistRequest)(nil), "tokenchain.loyalty.v1.QueryGetCreatorallowlistRequest")
-	proto.RegisterType((*QueryGetCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.QueryGetCreatorallowlistResponse")
-	proto.RegisterType((*QueryAllCreatorallowlistRequest)(nil), "tokenchain.loyalty.v1.QueryAllCreatorallowlistRequest")
-	proto.RegisterType((*QueryAllCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.QueryAllCreatorallowlistResponse")
-	proto.RegisterType((*QueryGetVerifiedtokenRequest)(nil), "tokenchain.loyalty.v1.QueryGetVerifiedtokenRequest")
-	proto.RegisterType((*QueryGetVerifiedtokenByDenomRequest)(nil), "tokenchain.loyalty.v1.QueryGetVerifiedtokenByDenomRequest")
-	proto.RegisterType((*QueryGetVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.QueryGetVerifiedtokenResponse")
-	proto.RegisterType((*QueryAllVerifiedtokenRequest)(nil), "tokenchain.loyalty.v1.QueryAllVerifiedtokenRequest")
-	proto.RegisterType((*QueryAllVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.QueryAllVerifiedtokenResponse")
-	proto.RegisterType((*QueryGetRewardaccrualRequest)(nil), "tokenchain.loyalty.v1.QueryGetRewardaccrualRequest")
-	proto.RegisterType((*QueryGetRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.QueryGetRewardaccrualResponse")
-	proto.RegisterType((*QueryAllRewardaccrualRequest)(nil), "tokenchain.loyalty.v1.QueryAllRewardaccrualRequest")
-	proto.RegisterType((*QueryAllRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.QueryAllRewardaccrualResponse")
-	proto.RegisterType((*QueryFilterRewardaccrualRequest)(nil), "tokenchain.loyalty.v1.QueryFilterRewardaccrualRequest")
-	proto.RegisterType((*QueryFilterRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.QueryFilterRewardaccrualResponse")
-	proto.RegisterType((*QueryGetMerchantallocationRequest)(nil), "tokenchain.loyalty.v1.QueryGetMerchantallocationRequest")
-	proto.RegisterType((*QueryGetMerchantallocationResponse)(nil), "tokenchain.loyalty.v1.QueryGetMerchantallocationResponse")
-	proto.RegisterType((*QueryAllMerchantallocationRequest)(nil), "tokenchain.loyalty.v1.QueryAllMerchantallocationRequest")
-	proto.RegisterType((*QueryAllMerchantallocationResponse)(nil), "tokenchain.loyalty.v1.QueryAllMerchantallocationResponse")
-	proto.RegisterType((*QueryFilterMerchantallocationRequest)(nil), "tokenchain.loyalty.v1.QueryFilterMerchantallocationRequest")
-	proto.RegisterType((*QueryFilterMerchantallocationResponse)(nil), "tokenchain.loyalty.v1.QueryFilterMerchantallocationResponse")
-	proto.RegisterType((*QueryGetRecoveryoperationRequest)(nil), "tokenchain.loyalty.v1.QueryGetRecoveryoperationRequest")
-	proto.RegisterType((*QueryGetRecoveryoperationResponse)(nil), "tokenchain.loyalty.v1.QueryGetRecoveryoperationResponse")
-	proto.RegisterType((*QueryAllRecoveryoperationRequest)(nil), "tokenchain.loyalty.v1.QueryAllRecoveryoperationRequest")
-	proto.RegisterType((*QueryAllRecoveryoperationResponse)(nil), "tokenchain.loyalty.v1.QueryAllRecoveryoperationResponse")
-	proto.RegisterType((*QueryFilterRecoveryoperationRequest)(nil), "tokenchain.loyalty.v1.QueryFilterRecoveryoperationRequest")
-	proto.RegisterType((*QueryFilterRecoveryoperationResponse)(nil), "tokenchain.loyalty.v1.QueryFilterRecoveryoperationResponse")
-	proto.RegisterType((*QueryDailyRollupStatusRequest)(nil), "tokenchain.loyalty.v1.QueryDailyRollupStatusRequest")
-	proto.RegisterType((*QueryDailyRollupStatusResponse)(nil), "tokenchain.loyalty.v1.QueryDailyRollupStatusResponse")
-	proto.RegisterType((*QueryRewardPoolBalanceRequest)(nil), "tokenchain.loyalty.v1.QueryRewardPoolBalanceRequest")
-	proto.RegisterType((*QueryRewardPoolBalanceResponse)(nil), "tokenchain.loyalty.v1.QueryRewardPoolBalanceResponse")
+func (m *QueryFilterRecoveryoperationRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("tokenchain/loyalty/v1/query.proto", fileDescriptor_d3ce6a4b5ffcb3a1) }
-
-var fileDescriptor_d3ce6a4b5ffcb3a1 = []byte{
-	// 1576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc4, 0x69, 0x68, 0xa6, 0x34, 0x4a, 0x26, 0x69, 0x1a, 0x59, 0x8d, 0xd3, 0x2e, 0xfd,
-	0x93, 0x84, 0xd4, 0x53, 0xe7, 0x0f, 0x4d, 0x09, 0x07, 0x12, 0xaa, 0xf6, 0x52, 0xa4, 0x60, 0x10,
-	0x08, 0x84, 0x64, 0x4d, 0xbc, 0x93, 0x64, 0xc9, 0x7a, 0xc7, 0xdd, 0x5d, 0xa7, 0x5d, 0xa2, 0x48,
-	0x80, 0x04, 0x67, 0x24, 0x2e, 0xf0, 0x0d, 0x38, 0x70, 0x00, 0xc1, 0x01, 0x21, 0x90, 0x0a, 0x12,
-	0xa8, 0x42, 0x80, 0x8a, 0xb8, 0x70, 0x42, 0xa8, 0x41, 0xe2, 0x03, 0x70, 0xe1, 0x88, 0x76, 0x66,
-	0xd6, 0xf6, 0xda, 0x3b, 0xbb, 0x6b, 0xd7, 0x95, 0xe8, 0x25, 0xf2, 0xee, 0xbe, 0xf7, 0xe6, 0xf7,
-	0x7b, 0xef, 0xcd, 0x9b, 0x79, 0x2f, 0xf0, 0x8c, 0xcb, 0x76, 0xa9, 0x55, 0xde, 0x21, 0x86, 0x85,
-	0x4d, 0xe6, 0x11, 0xd3, 0xf5, 0xf0, 0x5e, 0x01, 0xdf, 0xac, 0x51, 0xdb, 0xcb, 0x57, 0x6d, 0xe6,
-	0x32, 0x74, 0xa2, 0x21, 0x92, 0x97, 0x22, 0xf9, 0xbd, 0x42, 0x76, 0x94, 0x54, 0x0c, 0x8b, 0x61,
-	0xfe, 0x57, 0x48, 0x66, 0xe7, 0xca, 0xcc, 0xa9, 0x30, 0x07, 0x6f, 0x12, 0x87, 0x0a, 0x13, 0x78,
-	0xaf, 0xb0, 0x49, 0x5d, 0x52, 0xc0, 0x55, 0xb2, 0x6d, 0x58, 0xc4, 0x35, 0x98, 0x25, 0x65, 0xc7,
-	0xb7, 0xd9, 0x36, 0xe3, 0x3f, 0xb1, 0xff, 0x4b, 0xbe, 0x3d, 0xb5, 0xcd, 0xd8, 0xb6, 0x49, 0x31,
-	0xa9, 0x1a, 0x98, 0x58, 0x16, 0x73, 0xb9, 0x8a, 0x23, 0xbf, 0xce, 0x47, 0x83, 0x2d, 0xdb, 0x94,
-	0xb8, 0xcc, 0x26, 0xa6, 0xc9, 0x6e, 0x99, 0x86, 0xe3, 0x4a, 0xe9, 0x7c, 0xb4, 0x74, 0x85, 0xda,
-	0xe5, 0x1d, 0x62, 0xb9, 0xbe, 0x78, 0xb9, 0x19, 0x91, 0x16, 0x2d, 0x5f, 0x25, 0x36, 0xa9, 0x04,
-	0x08, 0x2e, 0x46, 0xcb, 0xd8, 0xb4, 0xcc, 0xf6, 0xa8, 0xed, 0xb1, 0x2a, 0xb5, 0x9b, 0x4d, 0xce,
-	0xaa, 0xc4, 0x6f, 0x11, 0x5b, 0x27, 0xe5, 0xb2, 0x5d, 0x23, 0x66, 0xbc, 0xe8, 0x1e, 0xb5, 0x8d,
-	0x2d, 0x83, 0xea, 0xfc, 0xab, 0x10, 0xd5, 0xc6, 0x21, 0x7a, 0xc1, 0x77, 0xee, 0x06, 0x47, 0x56,
-	0xa4, 0x37, 0x6b, 0xd4, 0x71, 0xb5, 0x57, 0xe0, 0x58, 0xe8, 0xad, 0x53, 0x65, 0x96, 0x43, 0xd1,
-	0xb3, 0x70, 0x50, 0x30, 0x98, 0x04, 0xa7, 0xc1, 0xcc, 0xb1, 0x85, 0xa9, 0x7c, 0x64, 0x38, 0xf3,
-	0x42, 0x6d, 0x7d, 0xe8, 0xee, 0x1f, 0xd3, 0x7d, 0x1f, 0xff, 0xfd, 0xe9, 0x1c, 0x28, 0x4a, 0x3d,
-	0x6d, 0x15, 0x4e, 0x73, 0xc3, 0xd7, 0xa9, 0xfb, 0x5c, 0x8b, 0xa7, 0xe5, 0xda, 0x68, 0x12, 0x3e,
-	0x46, 0x74, 0xdd, 0xa6, 0x8e, 0x58, 0x65, 0xa8, 0x18, 0x3c, 0x6a, 0x07, 0xf0, 0xb4, 0x5a, 0x59,
-	0x42, 0x7c, 0x15, 0x8e, 0xb4, 0x86, 0x50, 0x82, 0xbd, 0xa0, 0x00, 0xdb, 0x6a, 0x6a, 0x7d, 0xc0,
-	0x87, 0x5d, 0x6c, 0x33, 0xa3, 0x19, 0x12, 0xfb, 0x9a, 0x69, 0xaa, 0xb0, 0x5f, 0x83, 0xb0, 0x91,
-	0x9c, 0x72, 0xdd, 0xf3, 0x79, 0x91, 0xc9, 0x79, 0x3f, 0x93, 0xf3, 0x62, 0x33, 0xc8, 0x4c, 0xce,
-	0x6f, 0x90, 0x6d, 0x2a, 0x75, 0x8b, 0x4d, 0x9a, 0xda, 0x0f, 0x40, 0x52, 0x8d, 0x5c, 0x2b, 0x96,
-	0x6a, 0xa6, 0x07, 0x54, 0xd1, 0xf5, 0x10, 0x8f, 0x7e, 0xe9, 0xbf, 0x24, 0x1e, 0x02, 0x57, 0x88,
-	0xc8, 0x12, 0x3c, 0x15, 0x84, 0xec, 0xe5, 0xe6, 0xec, 0x0b, 0x1c, 0x36, 0x0e, 0x8f, 0xe8, 0xd4,
-	0x62, 0x15, 0x19, 0x6a, 0xf1, 0xa0, 0xad, 0xc2, 0x27, 0x22, 0xb5, 0xd6, 0xbd, 0xab, 0xfe, 0xf7,
-	0x78, 0xe5, 0x9b, 0x70, 0x4a, 0xb1, 0xa4, 0xf4, 0xdb, 0x06, 0x3c, 0x1e, 0xda, 0x09, 0x32, 0x4e,
-	0x67, 0x15, 0x4e, 0x0b, 0x23, 0x10, 0x1e, 0x0b, 0x1b, 0xd0, 0xb6, 0x24, 0xcb, 0x35, 0xd3, 0x8c,
-	0x64, 0xd9, 0xab, 0xb4, 0xf8, 0x0a, 0x48, 0x6e, 0xed, 0x0b, 0xa9, 0xb9, 0x65, 0x1e, 0x88, 0x5b,
-	0xef, 0x52, 0xe1, 0x52, 0x23, 0x15, 0x8a, 0xcd, 0x35, 0x2b, 0x70, 0xd2, 0x08, 0xcc, 0xec, 0x52,
-	0x4f, 0xc6, 0xd2, 0xff, 0xd9, 0x1c, 0xc9, 0x16, 0x8d, 0x06, 0xdb, 0x50, 0xf9, 0x4b, 0x88, 0x64,
-	0xc8, 0x48, 0xc0, 0x36, 0x64, 0xa0, 0x39, 0x92, 0x91, 0x20, 0x1f, 0x46, 0x24, 0x53, 0x73, 0xcb,
-	0x3c, 0x10, 0xb7, 0xde, 0x45, 0xf2, 0x23, 0x20, 0x2b, 0xe1, 0x35, 0xc3, 0x74, 0xa9, 0x1d, 0xe9,
-	0x28, 0x65, 0x15, 0x6f, 0xec, 0xda, 0xfe, 0xa6, 0x5d, 0xdb, 0xe2, 0xd8, 0x4c, 0xd7, 0x8e, 0xfd,
-	0x26, 0xa8, 0x9c, 0x91, 0xd8, 0xfe, 0xff, 0xbe, 0x5d, 0x86, 0x67, 0x82, 0x9c, 0x7f, 0xbe, 0xed,
-	0x72, 0xa1, 0xde, 0x2a, 0xef, 0x02, 0xa8, 0xc5, 0xe9, 0x49, 0xe2, 0x25, 0x88, 0xda, 0xaf, 0x2c,
-	0x32, 0x8d, 0x67, 0x15, 0xec, 0xdb, 0xcd, 0x49, 0x17, 0x44, 0x98, 0xd2, 0x76, 0x25, 0xfc, 0x35,
-	0xd3, 0x54, 0xc3, 0xef, 0xd5, 0x26, 0xfa, 0x25, 0x20, 0xad, 0x58, 0x2d, 0x81, 0x74, 0xa6, 0x47,
-	0xa4, 0x7b, 0x17, 0xfc, 0x0f, 0x01, 0x3c, 0xdb, 0x94, 0xbc, 0x6a, 0x0f, 0x22, 0x38, 0xa0, 0x13,
-	0x97, 0xca, 0x0c, 0xe0, 0xbf, 0x1f, 0xf2, 0xbe, 0xfa, 0x15, 0xc0, 0x73, 0x09, 0xd0, 0x1e, 0x39,
-	0x77, 0x2f, 0x34, 0xee, 0x93, 0xc5, 0xd6, 0x4b, 0x77, 0xe0, 0xe9, 0x61, 0xd8, 0x6f, 0xe8, 0xdc,
-	0xcf, 0x03, 0xc5, 0x7e, 0x43, 0xd7, 0xde, 0x06, 0x8d, 0x0d, 0x1a, 0xa1, 0x24, 0x7d, 0xf0, 0x3a,
-	0x1c, 0x6d, 0xbb, 0xc6, 0xcb, 0x44, 0x9f, 0x51, 0x16, 0x99, 0x16, 0x79, 0xe9, 0x81, 0x76, 0x43,
-	0xda, 0x1b, 0x8d, 0xcb, 0xa1, 0x12, 0x77, 0xaf, 0xf6, 0xd8, 0x8f, 0xa0, 0xb1, 0xa3, 0x3b, 0xe6,
-	0x9b, 0xe9, 0x09, 0xdf, 0xde, 0x05, 0xfc, 0xad, 0x7e, 0x79, 0xb1, 0x0c, 0x0e, 0x07, 0x85, 0xf3,
-	0x26, 0xe0, 0xa0, 0xe3, 0x12, 0xb7, 0x16, 0x9c, 0x5d, 0xf2, 0x49, 0xb1, 0xc5, 0xce, 0xc0, 0xc7,
-	0x6d, 0xa1, 0x48, 0xf5, 0xd2, 0xa6, 0xc7, 0x37, 0xd9, 0x50, 0xf1, 0x58, 0xfd, 0xdd, 0xba, 0xe7,
-	0x8b, 0x6c, 0xd9, 0xac, 0x52, 0x0a, 0x8e, 0xc4, 0x01, 0x21, 0xe2, 0xbf, 0x5b, 0x93, 0xc7, 0xe2,
-	0x14, 0x84, 0x2e, 0xab, 0x0b, 0x1c, 0xe1, 0x02, 0x43, 0x2e, 0x0b, 0x3e, 0x87, 0xe3, 0x39, 0xd8,
-	0x75, 0x3c, 0x7f, 0x0e, 0x97, 0x98, 0x47, 0x3e, 0xa4, 0xd3, 0xf2, 0x1e, 0x75, 0x95, 0x18, 0xa6,
-	0x57, 0x64, 0xa6, 0x59, 0xab, 0xbe, 0xc8, 0x83, 0x15, 0xb4, 0xb2, 0xff, 0x00, 0x98, 0x53, 0x49,
-	0x48, 0xaa, 0x59, 0x78, 0xd4, 0x35, 0x2a, 0xf4, 0x4d, 0x66, 0x05, 0x15, 0xb5, 0xfe, 0x8c, 0xe6,
-	0x21, 0x2a, 0xd7, 0x6c, 0x9b, 0x5a, 0x6e, 0xc9, 0x2f, 0x40, 0x66, 0x89, 0xd7, 0x5d, 0x11, 0xff,
-	0x11, 0xf9, 0xe5, 0x86, 0xff, 0xe1, 0xaa, 0x5f, 0x83, 0x17, 0xe1, 0x84, 0x49, 0x1c, 0xb7, 0xa4,
-	0xfb, 0x6b, 0x95, 0x6c, 0xbe, 0x98, 0xd0, 0x10, 0x49, 0x31, 0xe6, 0x7f, 0x6d, 0x02, 0xc2, 0x95,
-	0x66, 0xe0, 0xc8, 0x0e, 0x71, 0xb8, 0x34, 0xd5, 0x4b, 0x2e, 0xd3, 0x89, 0xc7, 0x13, 0xe4, 0x68,
-	0x71, 0x78, 0x87, 0x38, 0x45, 0xfe, 0xfa, 0x25, 0xff, 0xad, 0x2f, 0x69, 0xd1, 0xdb, 0x6e, 0xc8,
-	0xb0, 0xc8, 0x94, 0x61, 0xff, 0x7d, 0xc3, 0xa6, 0xb6, 0x2c, 0xdd, 0x22, 0xae, 0x2e, 0x1b, 0x8c,
-	0x99, 0xeb, 0xc4, 0x24, 0x56, 0x99, 0xc6, 0xf7, 0x4e, 0x35, 0xe9, 0xab, 0x08, 0x35, 0xe9, 0xab,
-	0x73, 0x70, 0xb8, 0xc2, 0xf4, 0x9a, 0x49, 0x4b, 0xe1, 0xeb, 0xdd, 0x71, 0xf1, 0x76, 0x2d, 0xf6,
-	0x92, 0x37, 0x01, 0x07, 0x49, 0x85, 0xd5, 0x2c, 0x57, 0xba, 0x43, 0x3e, 0x2d, 0xfc, 0x3b, 0x09,
-	0x8f, 0xf0, 0x75, 0xd1, 0x7b, 0x00, 0x0e, 0x8a, 0xe9, 0x01, 0x52, 0x9d, 0x15, 0xed, 0xe3, 0x8a,
-	0xec, 0x5c, 0x1a, 0x51, 0x41, 0x40, 0x3b, 0xf7, 0xce, 0x6f, 0x7f, 0x7d, 0xd0, 0x3f, 0x8d, 0xa6,
-	0x70, 0xdc, 0x88, 0x06, 0x7d, 0x0b, 0xe0, 0x58, 0xc4, 0x9c, 0x01, 0x3d, 0x15, 0xb7, 0x94, 0x7a,
-	0xaa, 0x91, 0xbd, 0xdc, 0xb1, 0x9e, 0xc4, 0x7b, 0x85, 0xe3, 0x5d, 0x44, 0x05, 0x9c, 0x6e, 0x60,
-	0x85, 0xf7, 0x65, 0x60, 0x0e, 0xd0, 0x97, 0x00, 0x8e, 0xdf, 0x30, 0x9c, 0x0e, 0x49, 0xa8, 0xc7,
-	0x1b, 0xf1, 0x24, 0x62, 0x46, 0x15, 0x1a, 0xe6, 0x24, 0x66, 0xd1, 0x85, 0x94, 0x24, 0xd0, 0xe7,
-	0x00, 0x8e, 0xb4, 0x36, 0xf0, 0x68, 0x31, 0xc1, 0x87, 0x51, 0xbd, 0x77, 0x76, 0xa9, 0x33, 0x25,
-	0x09, 0x78, 0x89, 0x03, 0xce, 0xa3, 0x79, 0x9c, 0x62, 0x94, 0x86, 0xf7, 0x79, 0x7a, 0x1f, 0xa0,
-	0xef, 0x00, 0x3c, 0xa9, 0x98, 0x59, 0xa0, 0xa7, 0x3b, 0xc1, 0x11, 0x1e, 0x74, 0x74, 0xc9, 0x61,
-	0x99, 0x73, 0xc0, 0xe8, 0x62, 0x1a, 0x0e, 0xa5, 0x4d, 0xaf, 0x24, 0x36, 0xe9, 0x27, 0x00, 0x8e,
-	0xfa, 0x59, 0xd3, 0x81, 0xef, 0x15, 0x73, 0x8f, 0x78, 0xdc, 0xaa, 0x19, 0x86, 0x36, 0xcf, 0x71,
-	0x9f, 0x47, 0x67, 0xd3, 0xe0, 0x46, 0x9f, 0x89, 0x4c, 0x09, 0xf5, 0x68, 0x89, 0x99, 0x12, 0xd5,
-	0xb2, 0x26, 0x7a, 0x39, 0xb2, 0x97, 0xd4, 0x16, 0x38, 0xda, 0x79, 0x34, 0x87, 0x53, 0xcc, 0x67,
-	0xf1, 0xfe, 0x2e, 0xf5, 0x0e, 0xea, 0x2e, 0xee, 0x00, 0xb4, 0x62, 0x20, 0x91, 0xe8, 0xe2, 0x68,
-	0xd0, 0x49, 0x2e, 0x0e, 0x37, 0xb7, 0x5f, 0x03, 0x38, 0x16, 0xd1, 0x4e, 0xc7, 0x97, 0x11, 0xf5,
-	0x6c, 0x20, 0xbe, 0x8c, 0xc4, 0xf4, 0xed, 0x89, 0xbb, 0x32, 0x04, 0xdb, 0xc1, 0x5b, 0xdc, 0x14,
-	0xfa, 0x1e, 0xc0, 0x13, 0x91, 0x6d, 0x31, 0x5a, 0x49, 0x88, 0xb8, 0xb2, 0x01, 0xcb, 0x5e, 0xe9,
-	0x42, 0x53, 0x92, 0xb8, 0xcc, 0x49, 0x14, 0x10, 0xc6, 0x69, 0xff, 0xa7, 0x20, 0xb3, 0xe6, 0x0e,
-	0x80, 0x13, 0x7e, 0xd6, 0x74, 0x4a, 0x24, 0xae, 0x17, 0x8f, 0x27, 0x12, 0xdb, 0x57, 0x6b, 0x05,
-	0x4e, 0xe4, 0x49, 0x34, 0x9b, 0x9a, 0x08, 0xba, 0x07, 0xe0, 0xa4, 0xaa, 0x81, 0x44, 0xab, 0xc9,
-	0x69, 0xa1, 0xe6, 0xf1, 0x4c, 0x77, 0xca, 0x29, 0x0f, 0xd9, 0x76, 0x2a, 0xf5, 0xec, 0xba, 0x03,
-	0xe0, 0x78, 0x54, 0x2f, 0x88, 0x2e, 0x27, 0x96, 0x93, 0xe8, 0xee, 0x23, 0xbb, 0xd2, 0xb9, 0x62,
-	0xca, 0x8a, 0xdf, 0x76, 0x0f, 0xc7, 0xfb, 0x86, 0x7e, 0xe0, 0xef, 0xef, 0x13, 0xa2, 0x1c, 0x75,
-	0xc4, 0x21, 0xa6, 0xfd, 0xcc, 0xae, 0x74, 0xae, 0x28, 0x39, 0x5c, 0xe2, 0x1c, 0xe6, 0xd0, 0x4c,
-	0x5a, 0x0e, 0xe8, 0x27, 0x00, 0x4f, 0x2a, 0xba, 0x99, 0xf8, 0x53, 0x37, 0xbe, 0x0b, 0xcc, 0xae,
-	0x76, 0xa5, 0x2b, 0x69, 0xac, 0x70, 0x1a, 0x0b, 0xe8, 0x52, 0x5a, 0x1a, 0xf5, 0x84, 0xfa, 0x02,
-	0xc0, 0xd1, 0xb6, 0x5e, 0x05, 0xc5, 0xd6, 0x79, 0x55, 0xf3, 0x93, 0x5d, 0xee, 0x50, 0x2b, 0xe5,
-	0x99, 0xd6, 0xdc, 0xde, 0x60, 0xd9, 0x1b, 0xfb, 0xb0, 0xdb, 0xda, 0x86, 0x78, 0xd8, 0xaa, 0xe6,
-	0x24, 0x1e, 0xb6, 0xb2, 0x37, 0x49, 0x79, 0x14, 0x97, 0xaa, 0x8c, 0x99, 0x78, 0x53, 0xe8, 0xae,
-	0x2f, 0xdd, 0xbd, 0x9f, 0x03, 0xf7, 0xee, 0xe7, 0xc0, 0x9f, 0xf7, 0x73, 0xe0, 0xfd, 0xc3, 0x5c,
-	0xdf, 0xbd, 0xc3, 0x5c, 0xdf, 0xef, 0x87, 0xb9, 0xbe, 0xd7, 0xb2, 0x4d, 0x46, 0x6e, 0xd7, 0xcd,
-	0xb8, 0x5e, 0x95, 0x3a, 0x9b, 0x83, 0xfc, 0x9f, 0xa7, 0x8b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x41, 0x78, 0xab, 0x3e, 0xf2, 0x1e, 0x00, 0x00,
+func (m *QueryFilterRecoveryoperationRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryFilterRecoveryoperationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryFilterRecoveryoperationResponse defines the QueryFilterRecoveryoperationResponse message.
+type QueryFilterRecoveryoperationResponse struct {
+	Recoveryoperation []Recoveryoperation `protobuf:"bytes,1,rep,name=recoveryoperation,proto3" json:"recoveryoperation"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListCreatorallowlist Queries a list of Creatorallowlist items.
-	GetCreatorallowlist(ctx context.Context, in *QueryGetCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryGetCreatorallowlistResponse, error)
-	// ListCreatorallowlist defines the ListCreatorallowlist RPC.
-	ListCreatorallowlist(ctx context.Context, in *QueryAllCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryAllCreatorallowlistResponse, error)
-	// ListVerifiedtoken Queries a list of Verifiedtoken items.
-	GetVerifiedtoken(ctx context.Context, in *QueryGetVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error)
-	// GetVerifiedtokenByDenom fetches a verified token using denom as a query parameter.
-	GetVerifiedtokenByDenom(ctx context.Context, in *QueryGetVerifiedtokenByDenomRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error)
-	// ListVerifiedtoken defines the ListVerifiedtoken RPC.
-	ListVerifiedtoken(ctx context.Context, in *QueryAllVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryAllVerifiedtokenResponse, error)
-	// ListRewardaccrual Queries a list of Rewardaccrual items.
-	GetRewardaccrual(ctx context.Context, in *QueryGetRewardaccrualRequest, opts ...grpc.CallOption) (*QueryGetRewardaccrualResponse, error)
-	// ListRewardaccrual defines the ListRewardaccrual RPC.
-	ListRewardaccrual(ctx context.Context, in *QueryAllRewardaccrualRequest, opts ...grpc.CallOption) (*QueryAllRewardaccrualResponse, error)
-	// FilterRewardaccrual returns reward accrual records filtered by address/denom fields.
-	FilterRewardaccrual(ctx context.Context, in *QueryFilterRewardaccrualRequest, opts ...grpc.CallOption) (*QueryFilterRewardaccrualResponse, error)
-	// ListMerchantallocation Queries a list of Merchantallocation items.
-	GetMerchantallocation(ctx context.Context, in *QueryGetMerchantallocationRequest, opts ...grpc.CallOption) (*QueryGetMerchantallocationResponse, error)
-	// ListMerchantallocation defines the ListMerchantallocation RPC.
-	ListMerchantallocation(ctx context.Context, in *QueryAllMerchantallocationRequest, opts ...grpc.CallOption) (*QueryAllMerchantallocationResponse, error)
-	// FilterMerchantallocation returns merchant allocation records filtered by date/denom fields.
-	FilterMerchantallocation(ctx context.Context, in *QueryFilterMerchantallocationRequest, opts ...grpc.CallOption) (*QueryFilterMerchantallocationResponse, error)
-	// ListRecoveryoperation Queries a list of Recoveryoperation items.
-	GetRecoveryoperation(ctx context.Context, in *QueryGetRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryGetRecoveryoperationResponse, error)
-	// ListRecoveryoperation defines the ListRecoveryoperation RPC.
-	ListRecoveryoperation(ctx context.Context, in *QueryAllRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryAllRecoveryoperationResponse, error)
-	// FilterRecoveryoperation returns recovery operations filtered by status/token/address fields.
-	FilterRecoveryoperation(ctx context.Context, in *QueryFilterRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryFilterRecoveryoperationResponse, error)
-	// DailyRollupStatus returns rollup boundary status for the configured timezone.
-	DailyRollupStatus(ctx context.Context, in *QueryDailyRollupStatusRequest, opts ...grpc.CallOption) (*QueryDailyRollupStatusResponse, error)
-	// RewardPoolBalance returns the loyalty module spendable balance for a denom.
-	RewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFilterRecoveryoperationResponse) Reset()         { *m = QueryFilterRecoveryoperationResponse{} }
+func (m *QueryFilterRecoveryoperationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFilterRecoveryoperationResponse) ProtoMessage()    {}
+func (*QueryFilterRecoveryoperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{40}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFilterRecoveryoperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFilterRecoveryoperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFilterRecoveryoperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetCreatorallowlist(ctx context.Context, in *QueryGetCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryGetCreatorallowlistResponse, error) {
-	out := new(QueryGetCreatorallowlistResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetCreatorallowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFilterRecoveryoperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilterRecoveryoperationResponse.Merge(m, src)
 }
-
-func (c *queryClient) ListCreatorallowlist(ctx context.Context, in *QueryAllCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryAllCreatorallowlistResponse, error) {
-	out := new(QueryAllCreatorallowlistResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListCreatorallowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFilterRecoveryoperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFilterRecoveryoperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilterRecoveryoperationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetVerifiedtoken(ctx context.Context, in *QueryGetVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error) {
-	out := new(QueryGetVerifiedtokenResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetVerifiedtoken", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFilterRecoveryoperationResponse proto.InternalMessageInfo
+
+func (m *QueryFilterRecoveryoperationResponse) GetRecoveryoperation() []Recoveryoperation {
+	if m != nil {
+		return m.Recoveryoperation
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetVerifiedtokenByDenom(ctx context.Context, in *QueryGetVerifiedtokenByDenomRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error) {
-	out := new(QueryGetVerifiedtokenResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetVerifiedtokenByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFilterRecoveryoperationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListVerifiedtoken(ctx context.Context, in *QueryAllVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryAllVerifiedtokenResponse, error) {
-	out := new(QueryAllVerifiedtokenResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListVerifiedtoken", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDailyRollupStatusRequest defines the QueryDailyRollupStatusRequest message.
+type QueryDailyRollupStatusRequest struct {
 }
 
-func (c *queryClient) GetRewardaccrual(ctx context.Context, in *QueryGetRewardaccrualRequest, opts ...grpc.CallOption) (*QueryGetRewardaccrualResponse, error) {
-	out := new(QueryGetRewardaccrualResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetRewardaccrual", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDailyRollupStatusRequest) Reset()         { *m = QueryDailyRollupStatusRequest{} }
+func (m *QueryDailyRollupStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDailyRollupStatusRequest) ProtoMessage()    {}
+func (*QueryDailyRollupStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{41}
+}
+func (m *QueryDailyRollupStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDailyRollupStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDailyRollupStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDailyRollupStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDailyRollupStatusRequest.Merge(m, src)
+}
+func (m *QueryDailyRollupStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDailyRollupStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDailyRollupStatusRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListRewardaccrual(ctx context.Context, in *QueryAllRewardaccrualRequest, opts ...grpc.CallOption) (*QueryAllRewardaccrualResponse, error) {
-	out := new(QueryAllRewardaccrualResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListRewardaccrual", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryDailyRollupStatusRequest proto.InternalMessageInfo
+
+// QueryDailyRollupStatusResponse defines the QueryDailyRollupStatusResponse message.
+type QueryDailyRollupStatusResponse struct {
+	Timezone            string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CurrentLocalDate    string `protobuf:"bytes,2,opt,name=current_local_date,json=currentLocalDate,proto3" json:"current_local_date,omitempty"`
+	LastDailyRollupDate string `protobuf:"bytes,3,opt,name=last_daily_rollup_date,json=lastDailyRollupDate,proto3" json:"last_daily_rollup_date,omitempty"`
+	HasRolledToday      bool   `protobuf:"varint,4,opt,name=has_rolled_today,json=hasRolledToday,proto3" json:"has_rolled_today,omitempty"`
+	NextRollupDate      string `protobuf:"bytes,5,opt,name=next_rollup_date,json=nextRollupDate,proto3" json:"next_rollup_date,omitempty"`
 }
 
-func (c *queryClient) FilterRewardaccrual(ctx context.Context, in *QueryFilterRewardaccrualRequest, opts ...grpc.CallOption) (*QueryFilterRewardaccrualResponse, error) {
-	out := new(QueryFilterRewardaccrualResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FilterRewardaccrual", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDailyRollupStatusResponse) Reset()         { *m = QueryDailyRollupStatusResponse{} }
+func (m *QueryDailyRollupStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDailyRollupStatusResponse) ProtoMessage()    {}
+func (*QueryDailyRollupStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{42}
+}
+func (m *QueryDailyRollupStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDailyRollupStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDailyRollupStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDailyRollupStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDailyRollupStatusResponse.Merge(m, src)
+}
+func (m *QueryDailyRollupStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDailyRollupStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDailyRollupStatusResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetMerchantallocation(ctx context.Context, in *QueryGetMerchantallocationRequest, opts ...grpc.CallOption) (*QueryGetMerchantallocationResponse, error) {
-	out := new(QueryGetMerchantallocationResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetMerchantallocation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDailyRollupStatusResponse proto.InternalMessageInfo
+
+func (m *QueryDailyRollupStatusResponse) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListMerchantallocation(ctx context.Context, in *QueryAllMerchantallocationRequest, opts ...grpc.CallOption) (*QueryAllMerchantallocationResponse, error) {
-	out := new(QueryAllMerchantallocationResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListMerchantallocation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDailyRollupStatusResponse) GetCurrentLocalDate() string {
+	if m != nil {
+		return m.CurrentLocalDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FilterMerchantallocation(ctx context.Context, in *QueryFilterMerchantallocationRequest, opts ...grpc.CallOption) (*QueryFilterMerchantallocationResponse, error) {
-	out := new(QueryFilterMerchantallocationResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FilterMerchantallocation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDailyRollupStatusResponse) GetLastDailyRollupDate() string {
+	if m != nil {
+		return m.LastDailyRollupDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetRecoveryoperation(ctx context.Context, in *QueryGetRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryGetRecoveryoperationResponse, error) {
-	out := new(QueryGetRecoveryoperationResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetRecoveryoperation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDailyRollupStatusResponse) GetHasRolledToday() bool {
+	if m != nil {
+		return m.HasRolledToday
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) ListRecoveryoperation(ctx context.Context, in *QueryAllRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryAllRecoveryoperationResponse, error) {
-	out := new(QueryAllRecoveryoperationResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListRecoveryoperation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDailyRollupStatusResponse) GetNextRollupDate() string {
+	if m != nil {
+		return m.NextRollupDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FilterRecoveryoperation(ctx context.Context, in *QueryFilterRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryFilterRecoveryoperationResponse, error) {
-	out := new(QueryFilterRecoveryoperationResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FilterRecoveryoperation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRewardPoolBalanceRequest defines the QueryRewardPoolBalanceRequest message.
+type QueryRewardPoolBalanceRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (c *queryClient) DailyRollupStatus(ctx context.Context, in *QueryDailyRollupStatusRequest, opts ...grpc.CallOption) (*QueryDailyRollupStatusResponse, error) {
-	out := new(QueryDailyRollupStatusResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/DailyRollupStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRewardPoolBalanceRequest) Reset()         { *m = QueryRewardPoolBalanceRequest{} }
+func (m *QueryRewardPoolBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolBalanceRequest) ProtoMessage()    {}
+func (*QueryRewardPoolBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{43}
 }
-
-func (c *queryClient) RewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error) {
-	out := new(QueryRewardPoolBalanceResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/RewardPoolBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPoolBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolBalanceRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolBalanceRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListCreatorallowlist Queries a list of Creatorallowlist items.
-	GetCreatorallowlist(context.Context, *QueryGetCreatorallowlistRequest) (*QueryGetCreatorallowlistResponse, error)
-	// ListCreatorallowlist defines the ListCreatorallowlist RPC.
-	ListCreatorallowlist(context.Context, *QueryAllCreatorallowlistRequest) (*QueryAllCreatorallowlistResponse, error)
-	// ListVerifiedtoken Queries a list of Verifiedtoken items.
-	GetVerifiedtoken(context.Context, *QueryGetVerifiedtokenRequest) (*QueryGetVerifiedtokenResponse, error)
-	// GetVerifiedtokenByDenom fetches a verified token using denom as a query parameter.
-	GetVerifiedtokenByDenom(context.Context, *QueryGetVerifiedtokenByDenomRequest) (*QueryGetVerifiedtokenResponse, error)
-	// ListVerifiedtoken defines the ListVerifiedtoken RPC.
-	ListVerifiedtoken(context.Context, *QueryAllVerifiedtokenRequest) (*QueryAllVerifiedtokenResponse, error)
-	// ListRewardaccrual Queries a list of Rewardaccrual items.
-	GetRewardaccrual(context.Context, *QueryGetRewardaccrualRequest) (*QueryGetRewardaccrualResponse, error)
-	// ListRewardaccrual defines the ListRewardaccrual RPC.
-	ListRewardaccrual(context.Context, *QueryAllRewardaccrualRequest) (*QueryAllRewardaccrualResponse, error)
-	// FilterRewardaccrual returns reward accrual records filtered by address/denom fields.
-	FilterRewardaccrual(context.Context, *QueryFilterRewardaccrualRequest) (*QueryFilterRewardaccrualResponse, error)
-	// ListMerchantallocation Queries a list of Merchantallocation items.
-	GetMerchantallocation(context.Context, *QueryGetMerchantallocationRequest) (*QueryGetMerchantallocationResponse, error)
-	// ListMerchantallocation defines the ListMerchantallocation RPC.
-	ListMerchantallocation(context.Context, *QueryAllMerchantallocationRequest) (*QueryAllMerchantallocationResponse, error)
-	// FilterMerchantallocation returns merchant allocation records filtered by date/denom fields.
-	FilterMerchantallocation(context.Context, *QueryFilterMerchantallocationRequest) (*QueryFilterMerchantallocationResponse, error)
-	// ListRecoveryoperation Queries a list of Recoveryoperation items.
-	GetRecoveryoperation(context.Context, *QueryGetRecoveryoperationRequest) (*QueryGetRecoveryoperationResponse, error)
-	// ListRecoveryoperation defines the ListRecoveryoperation RPC.
-	ListRecoveryoperation(context.Context, *QueryAllRecoveryoperationRequest) (*QueryAllRecoveryoperationResponse, error)
-	// FilterRecoveryoperation returns recovery operations filtered by status/token/address fields.
-	FilterRecoveryoperation(context.Context, *QueryFilterRecoveryoperationRequest) (*QueryFilterRecoveryoperationResponse, error)
-	// DailyRollupStatus returns rollup boundary status for the configured timezone.
-	DailyRollupStatus(context.Context, *QueryDailyRollupStatusRequest) (*QueryDailyRollupStatusResponse, error)
-	// RewardPoolBalance returns the loyalty module spendable balance for a denom.
-	RewardPoolBalance(context.Context, *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error)
+var xxx_messageInfo_QueryRewardPoolBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryRewardPoolBalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryRewardPoolBalanceResponse defines the QueryRewardPoolBalanceResponse message.
+type QueryRewardPoolBalanceResponse struct {
+	ModuleAddress string `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRewardPoolBalanceResponse) Reset()         { *m = QueryRewardPoolBalanceResponse{} }
+func (m *QueryRewardPoolBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolBalanceResponse) ProtoMessage()    {}
+func (*QueryRewardPoolBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{44}
 }
-func (*UnimplementedQueryServer) GetCreatorallowlist(ctx context.Context, req *QueryGetCreatorallowlistRequest) (*QueryGetCreatorallowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCreatorallowlist not implemented")
+func (m *QueryRewardPoolBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListCreatorallowlist(ctx context.Context, req *QueryAllCreatorallowlistRequest) (*QueryAllCreatorallowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCreatorallowlist not implemented")
+func (m *QueryRewardPoolBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetVerifiedtoken(ctx context.Context, req *QueryGetVerifiedtokenRequest) (*QueryGetVerifiedtokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVerifiedtoken not implemented")
+func (m *QueryRewardPoolBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolBalanceResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetVerifiedtokenByDenom(ctx context.Context, req *QueryGetVerifiedtokenByDenomRequest) (*QueryGetVerifiedtokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVerifiedtokenByDenom not implemented")
+func (m *QueryRewardPoolBalanceResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListVerifiedtoken(ctx context.Context, req *QueryAllVerifiedtokenRequest) (*QueryAllVerifiedtokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVerifiedtoken not implemented")
+func (m *QueryRewardPoolBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolBalanceResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetRewardaccrual(ctx context.Context, req *QueryGetRewardaccrualRequest) (*QueryGetRewardaccrualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRewardaccrual not implemented")
+
+var xxx_messageInfo_QueryRewardPoolBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolBalanceResponse) GetModuleAddress() string {
+	if m != nil {
+		return m.ModuleAddress
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) ListRewardaccrual(ctx context.Context, req *QueryAllRewardaccrualRequest) (*QueryAllRewardaccrualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRewardaccrual not implemented")
+
+func (m *QueryRewardPoolBalanceResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) FilterRewardaccrual(ctx context.Context, req *QueryFilterRewardaccrualRequest) (*QueryFilterRewardaccrualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FilterRewardaccrual not implemented")
+
+func (m *QueryRewardPoolBalanceResponse) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetMerchantallocation(ctx context.Context, req *QueryGetMerchantallocationRequest) (*QueryGetMerchantallocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantallocation not implemented")
+
+// QueryGetMerchantRequest defines the QueryGetMerchantRequest message.
+type QueryGetMerchantRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (*UnimplementedQueryServer) ListMerchantallocation(ctx context.Context, req *QueryAllMerchantallocationRequest) (*QueryAllMerchantallocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMerchantallocation not implemented")
+
+func (m *QueryGetMerchantRequest) Reset()         { *m = QueryGetMerchantRequest{} }
+func (m *QueryGetMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMerchantRequest) ProtoMessage()    {}
+func (*QueryGetMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{45}
 }
-func (*UnimplementedQueryServer) FilterMerchantallocation(ctx context.Context, req *QueryFilterMerchantallocationRequest) (*QueryFilterMerchantallocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FilterMerchantallocation not implemented")
+func (m *QueryGetMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetRecoveryoperation(ctx context.Context, req *QueryGetRecoveryoperationRequest) (*QueryGetRecoveryoperationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryoperation not implemented")
+func (m *QueryGetMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMerchantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListRecoveryoperation(ctx context.Context, req *QueryAllRecoveryoperationRequest) (*QueryAllRecoveryoperationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRecoveryoperation not implemented")
+func (m *QueryGetMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMerchantRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FilterRecoveryoperation(ctx context.Context, req *QueryFilterRecoveryoperationRequest) (*QueryFilterRecoveryoperationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FilterRecoveryoperation not implemented")
+func (m *QueryGetMerchantRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DailyRollupStatus(ctx context.Context, req *QueryDailyRollupStatusRequest) (*QueryDailyRollupStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DailyRollupStatus not implemented")
+func (m *QueryGetMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMerchantRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) RewardPoolBalance(ctx context.Context, req *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardPoolBalance not implemented")
+
+var xxx_messageInfo_QueryGetMerchantRequest proto.InternalMessageInfo
+
+func (m *QueryGetMerchantRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryGetMerchantResponse defines the QueryGetMerchantResponse message.
+type QueryGetMerchantResponse struct {
+	Merchant Merchant `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGetMerchantResponse) Reset()         { *m = QueryGetMerchantResponse{} }
+func (m *QueryGetMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMerchantResponse) ProtoMessage()    {}
+func (*QueryGetMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{46}
+}
+func (m *QueryGetMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMerchantResponse.Merge(m, src)
+}
+func (m *QueryGetMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMerchantResponse.DiscardUnknown(m)
 }
 
-func _Query_GetCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCreatorallowlistRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCreatorallowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/GetCreatorallowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCreatorallowlist(ctx, req.(*QueryGetCreatorallowlistRequest))
+var xxx_messageInfo_QueryGetMerchantResponse proto.InternalMessageInfo
+
+func (m *QueryGetMerchantResponse) GetMerchant() Merchant {
+	if m != nil {
+		return m.Merchant
 	}
-	return interceptor(ctx, in, info, handler)
+	return Merchant{}
 }
 
-func _Query_ListCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCreatorallowlistRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListCreatorallowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/ListCreatorallowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListCreatorallowlist(ctx, req.(*QueryAllCreatorallowlistRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAllMerchantRequest defines the QueryAllMerchantRequest message.
+type QueryAllMerchantRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVerifiedtokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVerifiedtoken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/GetVerifiedtoken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVerifiedtoken(ctx, req.(*QueryGetVerifiedtokenRequest))
+func (m *QueryAllMerchantRequest) Reset()         { *m = QueryAllMerchantRequest{} }
+func (m *QueryAllMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMerchantRequest) ProtoMessage()    {}
+func (*QueryAllMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{47}
+}
+func (m *QueryAllMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMerchantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMerchantRequest.Merge(m, src)
+}
+func (m *QueryAllMerchantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMerchantRequest.DiscardUnknown(m)
 }
 
-func _Query_GetVerifiedtokenByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVerifiedtokenByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVerifiedtokenByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/GetVerifiedtokenByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVerifiedtokenByDenom(ctx, req.(*QueryGetVerifiedtokenByDenomRequest))
+var xxx_messageInfo_QueryAllMerchantRequest proto.InternalMessageInfo
+
+func (m *QueryAllMerchantRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVerifiedtokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListVerifiedtoken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/ListVerifiedtoken",
+// QueryAllMerchantResponse defines the QueryAllMerchantResponse message.
+type QueryAllMerchantResponse struct {
+	Merchant   []Merchant          `protobuf:"bytes,1,rep,name=merchant,proto3" json:"merchant"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMerchantResponse) Reset()         { *m = QueryAllMerchantResponse{} }
+func (m *QueryAllMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMerchantResponse) ProtoMessage()    {}
+func (*QueryAllMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{48}
+}
+func (m *QueryAllMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListVerifiedtoken(ctx, req.(*QueryAllVerifiedtokenRequest))
+}
+func (m *QueryAllMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMerchantResponse.Merge(m, src)
+}
+func (m *QueryAllMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMerchantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMerchantResponse proto.InternalMessageInfo
+
+func (m *QueryAllMerchantResponse) GetMerchant() []Merchant {
+	if m != nil {
+		return m.Merchant
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRewardaccrualRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryAllMerchantResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRewardaccrual(ctx, in)
+	return nil
+}
+
+// QueryVerifiedtokensByMerchantRequest defines the QueryVerifiedtokensByMerchantRequest message.
+type QueryVerifiedtokensByMerchantRequest struct {
+	MerchantId uint64             `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVerifiedtokensByMerchantRequest) Reset()         { *m = QueryVerifiedtokensByMerchantRequest{} }
+func (m *QueryVerifiedtokensByMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifiedtokensByMerchantRequest) ProtoMessage()    {}
+func (*QueryVerifiedtokensByMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{49}
+}
+func (m *QueryVerifiedtokensByMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifiedtokensByMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifiedtokensByMerchantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/GetRewardaccrual",
+}
+func (m *QueryVerifiedtokensByMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifiedtokensByMerchantRequest.Merge(m, src)
+}
+func (m *QueryVerifiedtokensByMerchantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifiedtokensByMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifiedtokensByMerchantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifiedtokensByMerchantRequest proto.InternalMessageInfo
+
+func (m *QueryVerifiedtokensByMerchantRequest) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRewardaccrual(ctx, req.(*QueryGetRewardaccrualRequest))
+	return 0
+}
+
+func (m *QueryVerifiedtokensByMerchantRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllRewardaccrualRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryVerifiedtokensByMerchantResponse defines the QueryVerifiedtokensByMerchantResponse message.
+type QueryVerifiedtokensByMerchantResponse struct {
+	Verifiedtoken []Verifiedtoken     `protobuf:"bytes,1,rep,name=verifiedtoken,proto3" json:"verifiedtoken"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVerifiedtokensByMerchantResponse) Reset()         { *m = QueryVerifiedtokensByMerchantResponse{} }
+func (m *QueryVerifiedtokensByMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifiedtokensByMerchantResponse) ProtoMessage()    {}
+func (*QueryVerifiedtokensByMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{50}
+}
+func (m *QueryVerifiedtokensByMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifiedtokensByMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifiedtokensByMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListRewardaccrual(ctx, in)
+}
+func (m *QueryVerifiedtokensByMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifiedtokensByMerchantResponse.Merge(m, src)
+}
+func (m *QueryVerifiedtokensByMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifiedtokensByMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifiedtokensByMerchantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifiedtokensByMerchantResponse proto.InternalMessageInfo
+
+func (m *QueryVerifiedtokensByMerchantResponse) GetVerifiedtoken() []Verifiedtoken {
+	if m != nil {
+		return m.Verifiedtoken
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/ListRewardaccrual",
+	return nil
+}
+
+func (m *QueryVerifiedtokensByMerchantResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListRewardaccrual(ctx, req.(*QueryAllRewardaccrualRequest))
+	return nil
+}
+
+// QueryAttestationsRequest defines the QueryAttestationsRequest message.
+type QueryAttestationsRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsRequest) Reset()         { *m = QueryAttestationsRequest{} }
+func (m *QueryAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsRequest) ProtoMessage()    {}
+func (*QueryAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{51}
+}
+func (m *QueryAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsRequest.Merge(m, src)
+}
+func (m *QueryAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsRequest.DiscardUnknown(m)
 }
 
-func _Query_FilterRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFilterRewardaccrualRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FilterRewardaccrual(ctx, in)
+	return ""
+}
+
+func (m *QueryAttestationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/FilterRewardaccrual",
+	return nil
+}
+
+// QueryAttestationsResponse defines the QueryAttestationsResponse message.
+type QueryAttestationsResponse struct {
+	Attestations []Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	// verified mirrors the token's verified flag.
+	Verified bool `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	// active_count is the number of attestations that have not yet expired.
+	ActiveCount uint32 `protobuf:"varint,3,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	// required_count is the min_verifier_attestations threshold in force.
+	RequiredCount uint32              `protobuf:"varint,4,opt,name=required_count,json=requiredCount,proto3" json:"required_count,omitempty"`
+	Pagination    *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsResponse) Reset()         { *m = QueryAttestationsResponse{} }
+func (m *QueryAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsResponse) ProtoMessage()    {}
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{52}
+}
+func (m *QueryAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FilterRewardaccrual(ctx, req.(*QueryFilterRewardaccrualRequest))
+}
+func (m *QueryAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsResponse.Merge(m, src)
+}
+func (m *QueryAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsResponse) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetMerchantallocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMerchantallocationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryAttestationsResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMerchantallocation(ctx, in)
+	return false
+}
+
+func (m *QueryAttestationsResponse) GetActiveCount() uint32 {
+	if m != nil {
+		return m.ActiveCount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/GetMerchantallocation",
+	return 0
+}
+
+func (m *QueryAttestationsResponse) GetRequiredCount() uint32 {
+	if m != nil {
+		return m.RequiredCount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMerchantallocation(ctx, req.(*QueryGetMerchantallocationRequest))
+	return 0
+}
+
+func (m *QueryAttestationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListMerchantallocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMerchantallocationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListMerchantallocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/ListMerchantallocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListMerchantallocation(ctx, req.(*QueryAllMerchantallocationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAttestationHistoryRequest defines the QueryAttestationHistoryRequest message.
+type QueryAttestationHistoryRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_FilterMerchantallocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFilterMerchantallocationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FilterMerchantallocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/FilterMerchantallocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FilterMerchantallocation(ctx, req.(*QueryFilterMerchantallocationRequest))
+func (m *QueryAttestationHistoryRequest) Reset()         { *m = QueryAttestationHistoryRequest{} }
+func (m *QueryAttestationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationHistoryRequest) ProtoMessage()    {}
+func (*QueryAttestationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{53}
+}
+func (m *QueryAttestationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAttestationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationHistoryRequest.Merge(m, src)
+}
+func (m *QueryAttestationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_GetRecoveryoperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRecoveryoperationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecoveryoperation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/GetRecoveryoperation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecoveryoperation(ctx, req.(*QueryGetRecoveryoperationRequest))
+var xxx_messageInfo_QueryAttestationHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListRecoveryoperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllRecoveryoperationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListRecoveryoperation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/ListRecoveryoperation",
+func (m *QueryAttestationHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListRecoveryoperation(ctx, req.(*QueryAllRecoveryoperationRequest))
+	return nil
+}
+
+// QueryAttestationHistoryResponse defines the QueryAttestationHistoryResponse message.
+type QueryAttestationHistoryResponse struct {
+	Records    []AttestationRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationHistoryResponse) Reset()         { *m = QueryAttestationHistoryResponse{} }
+func (m *QueryAttestationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationHistoryResponse) ProtoMessage()    {}
+func (*QueryAttestationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{54}
+}
+func (m *QueryAttestationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAttestationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationHistoryResponse.Merge(m, src)
+}
+func (m *QueryAttestationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_FilterRecoveryoperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFilterRecoveryoperationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttestationHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationHistoryResponse) GetRecords() []AttestationRecord {
+	if m != nil {
+		return m.Records
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FilterRecoveryoperation(ctx, in)
+	return nil
+}
+
+func (m *QueryAttestationHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/FilterRecoveryoperation",
+	return nil
+}
+
+// QueryPendingMetadataChangeRequest defines the QueryPendingMetadataChangeRequest message.
+type QueryPendingMetadataChangeRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPendingMetadataChangeRequest) Reset()         { *m = QueryPendingMetadataChangeRequest{} }
+func (m *QueryPendingMetadataChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMetadataChangeRequest) ProtoMessage()    {}
+func (*QueryPendingMetadataChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{55}
+}
+func (m *QueryPendingMetadataChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMetadataChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMetadataChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FilterRecoveryoperation(ctx, req.(*QueryFilterRecoveryoperationRequest))
+}
+func (m *QueryPendingMetadataChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMetadataChangeRequest.Merge(m, src)
+}
+func (m *QueryPendingMetadataChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMetadataChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMetadataChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingMetadataChangeRequest proto.InternalMessageInfo
+
+func (m *QueryPendingMetadataChangeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DailyRollupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDailyRollupStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryPendingMetadataChangeResponse defines the QueryPendingMetadataChangeResponse message.
+type QueryPendingMetadataChangeResponse struct {
+	PendingChange PendingMetadataChange `protobuf:"bytes,1,opt,name=pending_change,json=pendingChange,proto3" json:"pending_change"`
+}
+
+func (m *QueryPendingMetadataChangeResponse) Reset()         { *m = QueryPendingMetadataChangeResponse{} }
+func (m *QueryPendingMetadataChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMetadataChangeResponse) ProtoMessage()    {}
+func (*QueryPendingMetadataChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{56}
+}
+func (m *QueryPendingMetadataChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMetadataChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMetadataChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DailyRollupStatus(ctx, in)
+}
+func (m *QueryPendingMetadataChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMetadataChangeResponse.Merge(m, src)
+}
+func (m *QueryPendingMetadataChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMetadataChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMetadataChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingMetadataChangeResponse proto.InternalMessageInfo
+
+func (m *QueryPendingMetadataChangeResponse) GetPendingChange() PendingMetadataChange {
+	if m != nil {
+		return m.PendingChange
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/DailyRollupStatus",
+	return PendingMetadataChange{}
+}
+
+// QueryMetadataHistoryRequest defines the QueryMetadataHistoryRequest message.
+type QueryMetadataHistoryRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMetadataHistoryRequest) Reset()         { *m = QueryMetadataHistoryRequest{} }
+func (m *QueryMetadataHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMetadataHistoryRequest) ProtoMessage()    {}
+func (*QueryMetadataHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{57}
+}
+func (m *QueryMetadataHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMetadataHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMetadataHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DailyRollupStatus(ctx, req.(*QueryDailyRollupStatusRequest))
+}
+func (m *QueryMetadataHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMetadataHistoryRequest.Merge(m, src)
+}
+func (m *QueryMetadataHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMetadataHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMetadataHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMetadataHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMetadataHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RewardPoolBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardPoolBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryMetadataHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardPoolBalance(ctx, in)
+	return nil
+}
+
+// QueryMetadataHistoryResponse defines the QueryMetadataHistoryResponse message.
+type QueryMetadataHistoryResponse struct {
+	Versions   []MetadataVersion   `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMetadataHistoryResponse) Reset()         { *m = QueryMetadataHistoryResponse{} }
+func (m *QueryMetadataHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMetadataHistoryResponse) ProtoMessage()    {}
+func (*QueryMetadataHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{58}
+}
+func (m *QueryMetadataHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMetadataHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMetadataHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Query/RewardPoolBalance",
+}
+func (m *QueryMetadataHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMetadataHistoryResponse.Merge(m, src)
+}
+func (m *QueryMetadataHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMetadataHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMetadataHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMetadataHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryMetadataHistoryResponse) GetVersions() []MetadataVersion {
+	if m != nil {
+		return m.Versions
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardPoolBalance(ctx, req.(*QueryRewardPoolBalanceRequest))
+	return nil
+}
+
+func (m *QueryMetadataHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tokenchain.loyalty.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetCreatorallowlist",
-			Handler:    _Query_GetCreatorallowlist_Handler,
-		},
-		{
-			MethodName: "ListCreatorallowlist",
-			Handler:    _Query_ListCreatorallowlist_Handler,
-		},
-		{
-			MethodName: "GetVerifiedtoken",
-			Handler:    _Query_GetVerifiedtoken_Handler,
-		},
-		{
-			MethodName: "GetVerifiedtokenByDenom",
-			Handler:    _Query_GetVerifiedtokenByDenom_Handler,
-		},
-		{
-			MethodName: "ListVerifiedtoken",
-			Handler:    _Query_ListVerifiedtoken_Handler,
-		},
-		{
-			MethodName: "GetRewardaccrual",
-			Handler:    _Query_GetRewardaccrual_Handler,
-		},
-		{
-			MethodName: "ListRewardaccrual",
-			Handler:    _Query_ListRewardaccrual_Handler,
-		},
-		{
-			MethodName: "FilterRewardaccrual",
-			Handler:    _Query_FilterRewardaccrual_Handler,
-		},
-		{
-			MethodName: "GetMerchantallocation",
-			Handler:    _Query_GetMerchantallocation_Handler,
-		},
-		{
-			MethodName: "ListMerchantallocation",
-			Handler:    _Query_ListMerchantallocation_Handler,
-		},
-		{
-			MethodName: "FilterMerchantallocation",
-			Handler:    _Query_FilterMerchantallocation_Handler,
-		},
-		{
-			MethodName: "GetRecoveryoperation",
-			Handler:    _Query_GetRecoveryoperation_Handler,
-		},
-		{
-			MethodName: "ListRecoveryoperation",
-			Handler:    _Query_ListRecoveryoperation_Handler,
-		},
-		{
-			MethodName: "FilterRecoveryoperation",
-			Handler:    _Query_FilterRecoveryoperation_Handler,
-		},
-		{
-			MethodName: "DailyRollupStatus",
-			Handler:    _Query_DailyRollupStatus_Handler,
-		},
-		{
-			MethodName: "RewardPoolBalance",
-			Handler:    _Query_RewardPoolBalance_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tokenchain/loyalty/v1/query.proto",
+// QueryMintScheduleRequest defines the QueryMintScheduleRequest message.
+type QueryMintScheduleRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Id    uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintScheduleRequest) Reset()         { *m = QueryMintScheduleRequest{} }
+func (m *QueryMintScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintScheduleRequest) ProtoMessage()    {}
+func (*QueryMintScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{59}
+}
+func (m *QueryMintScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintScheduleRequest.Merge(m, src)
+}
+func (m *QueryMintScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintScheduleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMintScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryMintScheduleRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintScheduleResponse defines the QueryMintScheduleResponse message.
+type QueryMintScheduleResponse struct {
+	MintSchedule MintSchedule `protobuf:"bytes,1,opt,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMintScheduleResponse) Reset()         { *m = QueryMintScheduleResponse{} }
+func (m *QueryMintScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintScheduleResponse) ProtoMessage()    {}
+func (*QueryMintScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{60}
+}
+func (m *QueryMintScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetCreatorallowlistRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintScheduleResponse.Merge(m, src)
 }
-
-func (m *QueryGetCreatorallowlistRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetCreatorallowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryMintScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryMintScheduleResponse) GetMintSchedule() MintSchedule {
+	if m != nil {
+		return m.MintSchedule
 	}
-	return dAtA[:n], nil
+	return MintSchedule{}
 }
 
-func (m *QueryGetCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintSchedulesRequest defines the QueryMintSchedulesRequest message.
+type QueryMintSchedulesRequest struct {
+	// denom optionally restricts the listing to one token.
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Creatorallowlist.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMintSchedulesRequest) Reset()         { *m = QueryMintSchedulesRequest{} }
+func (m *QueryMintSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintSchedulesRequest) ProtoMessage()    {}
+func (*QueryMintSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{61}
+}
+func (m *QueryMintSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllCreatorallowlistRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintSchedulesRequest.Merge(m, src)
 }
-
-func (m *QueryAllCreatorallowlistRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintSchedulesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllCreatorallowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMintSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryMintSchedulesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintSchedulesResponse defines the QueryMintSchedulesResponse message.
+type QueryMintSchedulesResponse struct {
+	MintSchedules []MintSchedule      `protobuf:"bytes,1,rep,name=mint_schedules,json=mintSchedules,proto3" json:"mint_schedules"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creatorallowlist) > 0 {
-		for iNdEx := len(m.Creatorallowlist) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Creatorallowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMintSchedulesResponse) Reset()         { *m = QueryMintSchedulesResponse{} }
+func (m *QueryMintSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintSchedulesResponse) ProtoMessage()    {}
+func (*QueryMintSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{62}
+}
+func (m *QueryMintSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetVerifiedtokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintSchedulesResponse.Merge(m, src)
 }
-
-func (m *QueryGetVerifiedtokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintSchedulesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetVerifiedtokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMintSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryMintSchedulesResponse) GetMintSchedules() []MintSchedule {
+	if m != nil {
+		return m.MintSchedules
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetVerifiedtokenByDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetVerifiedtokenByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintRateLimitRequest defines the QueryMintRateLimitRequest message.
+type QueryMintRateLimitRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetVerifiedtokenByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryMintRateLimitRequest) Reset()         { *m = QueryMintRateLimitRequest{} }
+func (m *QueryMintRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRateLimitRequest) ProtoMessage()    {}
+func (*QueryMintRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{63}
+}
+func (m *QueryMintRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMintRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRateLimitRequest.Merge(m, src)
+}
+func (m *QueryMintRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRateLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryMintRateLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintRateLimitResponse defines the QueryMintRateLimitResponse message.
+type QueryMintRateLimitResponse struct {
+	MintRateLimit    MintRateLimit         `protobuf:"bytes,1,opt,name=mint_rate_limit,json=mintRateLimit,proto3" json:"mint_rate_limit"`
+	Pending          *PendingMintRateLimit `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	MintedRollingDay uint64                `protobuf:"varint,3,opt,name=minted_rolling_day,json=mintedRollingDay,proto3" json:"minted_rolling_day,omitempty"`
+	RollupDate       string                `protobuf:"bytes,4,opt,name=rollup_date,json=rollupDate,proto3" json:"rollup_date,omitempty"`
+	MintedRollupDate uint64                `protobuf:"varint,5,opt,name=minted_rollup_date,json=mintedRollupDate,proto3" json:"minted_rollup_date,omitempty"`
+	// available is the most that can be minted right now under both the rate limit and
+	// the remaining cap.
+	Available uint64 `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-func (m *QueryGetVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Verifiedtoken.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMintRateLimitResponse) Reset()         { *m = QueryMintRateLimitResponse{} }
+func (m *QueryMintRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRateLimitResponse) ProtoMessage()    {}
+func (*QueryMintRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{64}
+}
+func (m *QueryMintRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllVerifiedtokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRateLimitResponse.Merge(m, src)
 }
-
-func (m *QueryAllVerifiedtokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllVerifiedtokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMintRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryMintRateLimitResponse) GetMintRateLimit() MintRateLimit {
+	if m != nil {
+		return m.MintRateLimit
 	}
-	return len(dAtA) - i, nil
+	return MintRateLimit{}
 }
 
-func (m *QueryAllVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintRateLimitResponse) GetPending() *PendingMintRateLimit {
+	if m != nil {
+		return m.Pending
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintRateLimitResponse) GetMintedRollingDay() uint64 {
+	if m != nil {
+		return m.MintedRollingDay
+	}
+	return 0
 }
 
-func (m *QueryAllVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Verifiedtoken) > 0 {
-		for iNdEx := len(m.Verifiedtoken) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Verifiedtoken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryMintRateLimitResponse) GetRollupDate() string {
+	if m != nil {
+		return m.RollupDate
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetRewardaccrualRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintRateLimitResponse) GetMintedRollupDate() uint64 {
+	if m != nil {
+		return m.MintedRollupDate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetRewardaccrualRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintRateLimitResponse) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
 }
 
-func (m *QueryGetRewardaccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryPausedTokensRequest defines the QueryPausedTokensRequest message.
+type QueryPausedTokensRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPausedTokensRequest) Reset()         { *m = QueryPausedTokensRequest{} }
+func (m *QueryPausedTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedTokensRequest) ProtoMessage()    {}
+func (*QueryPausedTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{65}
 }
-
-func (m *QueryGetRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPausedTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewardaccrual.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPausedTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPausedTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedTokensRequest.Merge(m, src)
+}
+func (m *QueryPausedTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedTokensRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllRewardaccrualRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPausedTokensRequest proto.InternalMessageInfo
+
+func (m *QueryPausedTokensRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllRewardaccrualRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPausedTokensResponse defines the QueryPausedTokensResponse message.
+type QueryPausedTokensResponse struct {
+	PausedTokens []TokenPause        `protobuf:"bytes,1,rep,name=paused_tokens,json=pausedTokens,proto3" json:"paused_tokens"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRewardaccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPausedTokensResponse) Reset()         { *m = QueryPausedTokensResponse{} }
+func (m *QueryPausedTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedTokensResponse) ProtoMessage()    {}
+func (*QueryPausedTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{66}
+}
+func (m *QueryPausedTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPausedTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedTokensResponse.Merge(m, src)
 }
-
-func (m *QueryAllRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPausedTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedTokensResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Rewardaccrual) > 0 {
-		for iNdEx := len(m.Rewardaccrual) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewardaccrual[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryPausedTokensResponse proto.InternalMessageInfo
+
+func (m *QueryPausedTokensResponse) GetPausedTokens() []TokenPause {
+	if m != nil {
+		return m.PausedTokens
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFilterRewardaccrualRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPausedTokensResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFilterRewardaccrualRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAddressFreezeRequest defines the QueryAddressFreezeRequest message.
+type QueryAddressFreezeRequest struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryFilterRewardaccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAddressFreezeRequest) Reset()         { *m = QueryAddressFreezeRequest{} }
+func (m *QueryAddressFreezeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFreezeRequest) ProtoMessage()    {}
+func (*QueryAddressFreezeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{67}
+}
+func (m *QueryAddressFreezeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFreezeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFreezeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryAddressFreezeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFreezeRequest.Merge(m, src)
+}
+func (m *QueryAddressFreezeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFreezeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFreezeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressFreezeRequest proto.InternalMessageInfo
+
+func (m *QueryAddressFreezeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFilterRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAddressFreezeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFilterRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAddressFreezeResponse defines the QueryAddressFreezeResponse message.
+type QueryAddressFreezeResponse struct {
+	AddressFreeze AddressFreeze `protobuf:"bytes,1,opt,name=address_freeze,json=addressFreeze,proto3" json:"address_freeze"`
 }
 
-func (m *QueryFilterRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Rewardaccrual) > 0 {
-		for iNdEx := len(m.Rewardaccrual) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewardaccrual[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAddressFreezeResponse) Reset()         { *m = QueryAddressFreezeResponse{} }
+func (m *QueryAddressFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFreezeResponse) ProtoMessage()    {}
+func (*QueryAddressFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{68}
+}
+func (m *QueryAddressFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAddressFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFreezeResponse.Merge(m, src)
+}
+func (m *QueryAddressFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFreezeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMerchantallocationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressFreezeResponse proto.InternalMessageInfo
+
+func (m *QueryAddressFreezeResponse) GetAddressFreeze() AddressFreeze {
+	if m != nil {
+		return m.AddressFreeze
 	}
-	return dAtA[:n], nil
+	return AddressFreeze{}
 }
 
-func (m *QueryGetMerchantallocationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAddressFreezesRequest defines the QueryAddressFreezesRequest message.
+type QueryAddressFreezesRequest struct {
+	// denom optionally restricts the listing to one token.
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMerchantallocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAddressFreezesRequest) Reset()         { *m = QueryAddressFreezesRequest{} }
+func (m *QueryAddressFreezesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFreezesRequest) ProtoMessage()    {}
+func (*QueryAddressFreezesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{69}
+}
+func (m *QueryAddressFreezesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFreezesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFreezesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAddressFreezesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFreezesRequest.Merge(m, src)
+}
+func (m *QueryAddressFreezesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFreezesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFreezesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMerchantallocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressFreezesRequest proto.InternalMessageInfo
+
+func (m *QueryAddressFreezesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMerchantallocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAddressFreezesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetMerchantallocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Merchantallocation.MarshalToSizedBuffer(dAtA[:i])
+// QueryAddressFreezesResponse defines the QueryAddressFreezesResponse message.
+type QueryAddressFreezesResponse struct {
+	AddressFreezes []AddressFreeze     `protobuf:"bytes,1,rep,name=address_freezes,json=addressFreezes,proto3" json:"address_freezes"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAddressFreezesResponse) Reset()         { *m = QueryAddressFreezesResponse{} }
+func (m *QueryAddressFreezesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFreezesResponse) ProtoMessage()    {}
+func (*QueryAddressFreezesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{70}
+}
+func (m *QueryAddressFreezesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFreezesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFreezesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAddressFreezesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFreezesResponse.Merge(m, src)
+}
+func (m *QueryAddressFreezesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFreezesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFreezesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMerchantallocationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressFreezesResponse proto.InternalMessageInfo
+
+func (m *QueryAddressFreezesResponse) GetAddressFreezes() []AddressFreeze {
+	if m != nil {
+		return m.AddressFreezes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMerchantallocationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAddressFreezesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllMerchantallocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryTokenIBCPolicyRequest defines the QueryTokenIBCPolicyRequest message.
+type QueryTokenIBCPolicyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTokenIBCPolicyRequest) Reset()         { *m = QueryTokenIBCPolicyRequest{} }
+func (m *QueryTokenIBCPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenIBCPolicyRequest) ProtoMessage()    {}
+func (*QueryTokenIBCPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{71}
+}
+func (m *QueryTokenIBCPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenIBCPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenIBCPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTokenIBCPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenIBCPolicyRequest.Merge(m, src)
+}
+func (m *QueryTokenIBCPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenIBCPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenIBCPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMerchantallocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTokenIBCPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryTokenIBCPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllMerchantallocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTokenIBCPolicyResponse defines the QueryTokenIBCPolicyResponse message.
+type QueryTokenIBCPolicyResponse struct {
+	Policy TokenIBCPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// is_default is set when no policy was stored and the default applies.
+	IsDefault bool   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Escrowed  uint64 `protobuf:"varint,3,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
 }
 
-func (m *QueryAllMerchantallocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTokenIBCPolicyResponse) Reset()         { *m = QueryTokenIBCPolicyResponse{} }
+func (m *QueryTokenIBCPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenIBCPolicyResponse) ProtoMessage()    {}
+func (*QueryTokenIBCPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{72}
+}
+func (m *QueryTokenIBCPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenIBCPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenIBCPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Merchantallocation) > 0 {
-		for iNdEx := len(m.Merchantallocation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Merchantallocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryTokenIBCPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenIBCPolicyResponse.Merge(m, src)
+}
+func (m *QueryTokenIBCPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenIBCPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenIBCPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenIBCPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryTokenIBCPolicyResponse) GetPolicy() TokenIBCPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return len(dAtA) - i, nil
+	return TokenIBCPolicy{}
 }
 
-func (m *QueryFilterMerchantallocationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTokenIBCPolicyResponse) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryFilterMerchantallocationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTokenIBCPolicyResponse) GetEscrowed() uint64 {
+	if m != nil {
+		return m.Escrowed
+	}
+	return 0
 }
 
-func (m *QueryFilterMerchantallocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryIBCRecordersRequest defines the QueryIBCRecordersRequest message.
+type QueryIBCRecordersRequest struct {
+	// channel_id optionally restricts the listing to one channel.
+	ChannelId  string             `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIBCRecordersRequest) Reset()         { *m = QueryIBCRecordersRequest{} }
+func (m *QueryIBCRecordersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCRecordersRequest) ProtoMessage()    {}
+func (*QueryIBCRecordersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{73}
+}
+func (m *QueryIBCRecordersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCRecordersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCRecordersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Date) > 0 {
-		i -= len(m.Date)
-		copy(dAtA[i:], m.Date)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Date)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryIBCRecordersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCRecordersRequest.Merge(m, src)
+}
+func (m *QueryIBCRecordersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCRecordersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCRecordersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFilterMerchantallocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIBCRecordersRequest proto.InternalMessageInfo
+
+func (m *QueryIBCRecordersRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFilterMerchantallocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIBCRecordersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryFilterMerchantallocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryIBCRecordersResponse defines the QueryIBCRecordersResponse message.
+type QueryIBCRecordersResponse struct {
+	Recorders  []IBCRecorder       `protobuf:"bytes,1,rep,name=recorders,proto3" json:"recorders"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIBCRecordersResponse) Reset()         { *m = QueryIBCRecordersResponse{} }
+func (m *QueryIBCRecordersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCRecordersResponse) ProtoMessage()    {}
+func (*QueryIBCRecordersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{74}
+}
+func (m *QueryIBCRecordersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCRecordersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCRecordersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Merchantallocation) > 0 {
-		for iNdEx := len(m.Merchantallocation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Merchantallocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryIBCRecordersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCRecordersResponse.Merge(m, src)
+}
+func (m *QueryIBCRecordersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCRecordersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCRecordersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCRecordersResponse proto.InternalMessageInfo
+
+func (m *QueryIBCRecordersResponse) GetRecorders() []IBCRecorder {
+	if m != nil {
+		return m.Recorders
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetRecoveryoperationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIBCRecordersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetRecoveryoperationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryIBCRateLimitRequest defines the QueryIBCRateLimitRequest message.
+type QueryIBCRateLimitRequest struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryGetRecoveryoperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryIBCRateLimitRequest) Reset()         { *m = QueryIBCRateLimitRequest{} }
+func (m *QueryIBCRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCRateLimitRequest) ProtoMessage()    {}
+func (*QueryIBCRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{75}
+}
+func (m *QueryIBCRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryIBCRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCRateLimitRequest.Merge(m, src)
+}
+func (m *QueryIBCRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCRateLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetRecoveryoperationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIBCRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryIBCRateLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetRecoveryoperationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIBCRateLimitRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *QueryGetRecoveryoperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Recoveryoperation.MarshalToSizedBuffer(dAtA[:i])
+// QueryIBCRateLimitResponse defines the QueryIBCRateLimitResponse message.
+type QueryIBCRateLimitResponse struct {
+	Usage IBCRateLimitUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryIBCRateLimitResponse) Reset()         { *m = QueryIBCRateLimitResponse{} }
+func (m *QueryIBCRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCRateLimitResponse) ProtoMessage()    {}
+func (*QueryIBCRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{76}
+}
+func (m *QueryIBCRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryIBCRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCRateLimitResponse.Merge(m, src)
+}
+func (m *QueryIBCRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllRecoveryoperationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIBCRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryIBCRateLimitResponse) GetUsage() IBCRateLimitUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return dAtA[:n], nil
+	return IBCRateLimitUsage{}
 }
 
-func (m *QueryAllRecoveryoperationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryIBCRateLimitsRequest defines the QueryIBCRateLimitsRequest message.
+type QueryIBCRateLimitsRequest struct {
+	// denom optionally restricts the listing to one denom.
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRecoveryoperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryIBCRateLimitsRequest) Reset()         { *m = QueryIBCRateLimitsRequest{} }
+func (m *QueryIBCRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCRateLimitsRequest) ProtoMessage()    {}
+func (*QueryIBCRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{77}
+}
+func (m *QueryIBCRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryIBCRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryIBCRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCRateLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllRecoveryoperationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIBCRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryIBCRateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllRecoveryoperationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIBCRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllRecoveryoperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Recoveryoperation) > 0 {
-		for iNdEx := len(m.Recoveryoperation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Recoveryoperation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryIBCRateLimitsResponse defines the QueryIBCRateLimitsResponse message.
+type QueryIBCRateLimitsResponse struct {
+	Usages     []IBCRateLimitUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIBCRateLimitsResponse) Reset()         { *m = QueryIBCRateLimitsResponse{} }
+func (m *QueryIBCRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCRateLimitsResponse) ProtoMessage()    {}
+func (*QueryIBCRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{78}
+}
+func (m *QueryIBCRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryIBCRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryIBCRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCRateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFilterRecoveryoperationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIBCRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryIBCRateLimitsResponse) GetUsages() []IBCRateLimitUsage {
+	if m != nil {
+		return m.Usages
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFilterRecoveryoperationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIBCRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryFilterRecoveryoperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryFeeTokenRequest defines the QueryFeeTokenRequest message.
+type QueryFeeTokenRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeeTokenRequest) Reset()         { *m = QueryFeeTokenRequest{} }
+func (m *QueryFeeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenRequest) ProtoMessage()    {}
+func (*QueryFeeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{79}
+}
+func (m *QueryFeeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RequestedBy) > 0 {
-		i -= len(m.RequestedBy)
-		copy(dAtA[i:], m.RequestedBy)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestedBy)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFilterRecoveryoperationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeTokenRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFilterRecoveryoperationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeTokenResponse defines the QueryFeeTokenResponse message.
+type QueryFeeTokenResponse struct {
+	FeeToken FeeToken `protobuf:"bytes,1,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
+	GasPool  GasPool  `protobuf:"bytes,2,opt,name=gas_pool,json=gasPool,proto3" json:"gas_pool"`
 }
 
-func (m *QueryFilterRecoveryoperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFeeTokenResponse) Reset()         { *m = QueryFeeTokenResponse{} }
+func (m *QueryFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenResponse) ProtoMessage()    {}
+func (*QueryFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{80}
+}
+func (m *QueryFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Recoveryoperation) > 0 {
-		for iNdEx := len(m.Recoveryoperation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Recoveryoperation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokenResponse) GetFeeToken() FeeToken {
+	if m != nil {
+		return m.FeeToken
 	}
-	return len(dAtA) - i, nil
+	return FeeToken{}
 }
 
-func (m *QueryDailyRollupStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeTokenResponse) GetGasPool() GasPool {
+	if m != nil {
+		return m.GasPool
 	}
-	return dAtA[:n], nil
+	return GasPool{}
 }
 
-func (m *QueryDailyRollupStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeTokensRequest defines the QueryFeeTokensRequest message.
+type QueryFeeTokensRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDailyRollupStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeeTokensRequest) Reset()         { *m = QueryFeeTokensRequest{} }
+func (m *QueryFeeTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensRequest) ProtoMessage()    {}
+func (*QueryFeeTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{81}
+}
+func (m *QueryFeeTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensRequest.Merge(m, src)
+}
+func (m *QueryFeeTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDailyRollupStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeTokensRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokensRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDailyRollupStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeTokensResponse defines the QueryFeeTokensResponse message.
+type QueryFeeTokensResponse struct {
+	FeeTokens  []FeeToken          `protobuf:"bytes,1,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDailyRollupStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextRollupDate) > 0 {
-		i -= len(m.NextRollupDate)
-		copy(dAtA[i:], m.NextRollupDate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextRollupDate)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.HasRolledToday {
-		i--
-		if m.HasRolledToday {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryFeeTokensResponse) Reset()         { *m = QueryFeeTokensResponse{} }
+func (m *QueryFeeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensResponse) ProtoMessage()    {}
+func (*QueryFeeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{82}
+}
+func (m *QueryFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.LastDailyRollupDate) > 0 {
-		i -= len(m.LastDailyRollupDate)
-		copy(dAtA[i:], m.LastDailyRollupDate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastDailyRollupDate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CurrentLocalDate) > 0 {
-		i -= len(m.CurrentLocalDate)
-		copy(dAtA[i:], m.CurrentLocalDate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentLocalDate)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Timezone) > 0 {
-		i -= len(m.Timezone)
-		copy(dAtA[i:], m.Timezone)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timezone)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensResponse.Merge(m, src)
+}
+func (m *QueryFeeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardPoolBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeTokensResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokensResponse) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRewardPoolBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeTokensResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryRewardPoolBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryClaimSponsorshipRequest defines the QueryClaimSponsorshipRequest message.
+type QueryClaimSponsorshipRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is optional.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryRewardPoolBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClaimSponsorshipRequest) Reset()         { *m = QueryClaimSponsorshipRequest{} }
+func (m *QueryClaimSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimSponsorshipRequest) ProtoMessage()    {}
+func (*QueryClaimSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{83}
+}
+func (m *QueryClaimSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryRewardPoolBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClaimSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimSponsorshipRequest.Merge(m, src)
+}
+func (m *QueryClaimSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimSponsorshipRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardPoolBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryClaimSponsorshipRequest proto.InternalMessageInfo
+
+func (m *QueryClaimSponsorshipRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryClaimSponsorshipRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// QueryClaimSponsorshipResponse defines the QueryClaimSponsorshipResponse message.
+type QueryClaimSponsorshipResponse struct {
+	Sponsorship     ClaimSponsorship      `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
+	Usage           ClaimSponsorshipUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+	RemainingBudget uint64                `protobuf:"varint,3,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget,omitempty"`
+	// allowance is the sponsored allowance of address, if it has one.
+	Allowance *SponsoredAllowance `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// remaining_allowance is what address may still spend of it.
+	RemainingAllowance uint64 `protobuf:"varint,5,opt,name=remaining_allowance,json=remainingAllowance,proto3" json:"remaining_allowance,omitempty"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClaimSponsorshipResponse) Reset()         { *m = QueryClaimSponsorshipResponse{} }
+func (m *QueryClaimSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimSponsorshipResponse) ProtoMessage()    {}
+func (*QueryClaimSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{84}
+}
+func (m *QueryClaimSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryClaimSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimSponsorshipResponse.Merge(m, src)
+}
+func (m *QueryClaimSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimSponsorshipResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetCreatorallowlistRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryClaimSponsorshipResponse proto.InternalMessageInfo
+
+func (m *QueryClaimSponsorshipResponse) GetSponsorship() ClaimSponsorship {
+	if m != nil {
+		return m.Sponsorship
 	}
-	return n
+	return ClaimSponsorship{}
 }
 
-func (m *QueryGetCreatorallowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClaimSponsorshipResponse) GetUsage() ClaimSponsorshipUsage {
+	if m != nil {
+		return m.Usage
 	}
-	var l int
-	_ = l
-	l = m.Creatorallowlist.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ClaimSponsorshipUsage{}
 }
 
-func (m *QueryAllCreatorallowlistRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryClaimSponsorshipResponse) GetRemainingBudget() uint64 {
+	if m != nil {
+		return m.RemainingBudget
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllCreatorallowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Creatorallowlist) > 0 {
-		for _, e := range m.Creatorallowlist {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryClaimSponsorshipResponse) GetAllowance() *SponsoredAllowance {
+	if m != nil {
+		return m.Allowance
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetVerifiedtokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryClaimSponsorshipResponse) GetRemainingAllowance() uint64 {
+	if m != nil {
+		return m.RemainingAllowance
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetVerifiedtokenByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryLiabilitiesAtRequest defines the QueryLiabilitiesAtRequest message.
+type QueryLiabilitiesAtRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// date is a closed rollup date (YYYY-MM-DD).
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (m *QueryGetVerifiedtokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLiabilitiesAtRequest) Reset()         { *m = QueryLiabilitiesAtRequest{} }
+func (m *QueryLiabilitiesAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiabilitiesAtRequest) ProtoMessage()    {}
+func (*QueryLiabilitiesAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{85}
+}
+func (m *QueryLiabilitiesAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiabilitiesAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiabilitiesAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Verifiedtoken.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryLiabilitiesAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiabilitiesAtRequest.Merge(m, src)
+}
+func (m *QueryLiabilitiesAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiabilitiesAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiabilitiesAtRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllVerifiedtokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryLiabilitiesAtRequest proto.InternalMessageInfo
+
+func (m *QueryLiabilitiesAtRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllVerifiedtokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Verifiedtoken) > 0 {
-		for _, e := range m.Verifiedtoken {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLiabilitiesAtRequest) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetRewardaccrualRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryLiabilitiesAtResponse defines the QueryLiabilitiesAtResponse message.
+type QueryLiabilitiesAtResponse struct {
+	// checkpoint is the latest checkpoint on or before date. Its date is earlier
+	// when nothing changed since.
+	Checkpoint LiabilityCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-func (m *QueryGetRewardaccrualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLiabilitiesAtResponse) Reset()         { *m = QueryLiabilitiesAtResponse{} }
+func (m *QueryLiabilitiesAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiabilitiesAtResponse) ProtoMessage()    {}
+func (*QueryLiabilitiesAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{86}
+}
+func (m *QueryLiabilitiesAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiabilitiesAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiabilitiesAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Rewardaccrual.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryLiabilitiesAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiabilitiesAtResponse.Merge(m, src)
+}
+func (m *QueryLiabilitiesAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiabilitiesAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiabilitiesAtResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllRewardaccrualRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryLiabilitiesAtResponse proto.InternalMessageInfo
+
+func (m *QueryLiabilitiesAtResponse) GetCheckpoint() LiabilityCheckpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return n
+	return LiabilityCheckpoint{}
 }
 
-func (m *QueryAllRewardaccrualResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewardaccrual) > 0 {
-		for _, e := range m.Rewardaccrual {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryMerchantICAsRequest defines the QueryMerchantICAsRequest message.
+type QueryMerchantICAsRequest struct {
+	MerchantId uint64             `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerchantICAsRequest) Reset()         { *m = QueryMerchantICAsRequest{} }
+func (m *QueryMerchantICAsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerchantICAsRequest) ProtoMessage()    {}
+func (*QueryMerchantICAsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{87}
+}
+func (m *QueryMerchantICAsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerchantICAsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerchantICAsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryMerchantICAsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerchantICAsRequest.Merge(m, src)
+}
+func (m *QueryMerchantICAsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerchantICAsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerchantICAsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFilterRewardaccrualRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryMerchantICAsRequest proto.InternalMessageInfo
+
+func (m *QueryMerchantICAsRequest) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryFilterRewardaccrualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMerchantICAsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Rewardaccrual) > 0 {
-		for _, e := range m.Rewardaccrual {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryMerchantICAsResponse defines the QueryMerchantICAsResponse message.
+type QueryMerchantICAsResponse struct {
+	Icas       []MerchantICA       `protobuf:"bytes,1,rep,name=icas,proto3" json:"icas"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerchantICAsResponse) Reset()         { *m = QueryMerchantICAsResponse{} }
+func (m *QueryMerchantICAsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerchantICAsResponse) ProtoMessage()    {}
+func (*QueryMerchantICAsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{88}
+}
+func (m *QueryMerchantICAsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerchantICAsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerchantICAsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryMerchantICAsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerchantICAsResponse.Merge(m, src)
+}
+func (m *QueryMerchantICAsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerchantICAsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerchantICAsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMerchantallocationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryMerchantICAsResponse proto.InternalMessageInfo
+
+func (m *QueryMerchantICAsResponse) GetIcas() []MerchantICA {
+	if m != nil {
+		return m.Icas
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetMerchantallocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMerchantICAsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Merchantallocation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllMerchantallocationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryMerchantICAPacketsRequest defines the QueryMerchantICAPacketsRequest message.
+type QueryMerchantICAPacketsRequest struct {
+	MerchantId uint64             `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMerchantallocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Merchantallocation) > 0 {
-		for _, e := range m.Merchantallocation {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMerchantICAPacketsRequest) Reset()         { *m = QueryMerchantICAPacketsRequest{} }
+func (m *QueryMerchantICAPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerchantICAPacketsRequest) ProtoMessage()    {}
+func (*QueryMerchantICAPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{89}
+}
+func (m *QueryMerchantICAPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerchantICAPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerchantICAPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryMerchantICAPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerchantICAPacketsRequest.Merge(m, src)
+}
+func (m *QueryMerchantICAPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerchantICAPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerchantICAPacketsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFilterMerchantallocationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Date)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryMerchantICAPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryMerchantICAPacketsRequest) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryFilterMerchantallocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMerchantICAPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Merchantallocation) > 0 {
-		for _, e := range m.Merchantallocation {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryMerchantICAPacketsResponse defines the QueryMerchantICAPacketsResponse message.
+type QueryMerchantICAPacketsResponse struct {
+	Packets    []MerchantICAPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerchantICAPacketsResponse) Reset()         { *m = QueryMerchantICAPacketsResponse{} }
+func (m *QueryMerchantICAPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerchantICAPacketsResponse) ProtoMessage()    {}
+func (*QueryMerchantICAPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{90}
+}
+func (m *QueryMerchantICAPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerchantICAPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerchantICAPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryMerchantICAPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerchantICAPacketsResponse.Merge(m, src)
+}
+func (m *QueryMerchantICAPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerchantICAPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerchantICAPacketsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetRecoveryoperationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMerchantICAPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryMerchantICAPacketsResponse) GetPackets() []MerchantICAPacket {
+	if m != nil {
+		return m.Packets
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return nil
+}
+
+func (m *QueryMerchantICAPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetRecoveryoperationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Recoveryoperation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryTreasuryForwardsRequest defines the QueryTreasuryForwardsRequest message.
+type QueryTreasuryForwardsRequest struct {
+	MerchantId uint64             `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRecoveryoperationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTreasuryForwardsRequest) Reset()         { *m = QueryTreasuryForwardsRequest{} }
+func (m *QueryTreasuryForwardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryForwardsRequest) ProtoMessage()    {}
+func (*QueryTreasuryForwardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{91}
 }
-
-func (m *QueryAllRecoveryoperationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Recoveryoperation) > 0 {
-		for _, e := range m.Recoveryoperation {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTreasuryForwardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryForwardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryForwardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryTreasuryForwardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryForwardsRequest.Merge(m, src)
+}
+func (m *QueryTreasuryForwardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryForwardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryForwardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFilterRecoveryoperationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RequestedBy)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryTreasuryForwardsRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryForwardsRequest) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryFilterRecoveryoperationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTreasuryForwardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Recoveryoperation) > 0 {
-		for _, e := range m.Recoveryoperation {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryTreasuryForwardsResponse defines the QueryTreasuryForwardsResponse message.
+type QueryTreasuryForwardsResponse struct {
+	Forwards   []TreasuryForward   `protobuf:"bytes,1,rep,name=forwards,proto3" json:"forwards"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasuryForwardsResponse) Reset()         { *m = QueryTreasuryForwardsResponse{} }
+func (m *QueryTreasuryForwardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryForwardsResponse) ProtoMessage()    {}
+func (*QueryTreasuryForwardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3ce6a4b5ffcb3a1, []int{92}
+}
+func (m *QueryTreasuryForwardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryForwardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryForwardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryTreasuryForwardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryForwardsResponse.Merge(m, src)
+}
+func (m *QueryTreasuryForwardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryForwardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryForwardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDailyRollupStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTreasuryForwardsResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryForwardsResponse) GetForwards() []TreasuryForward {
+	if m != nil {
+		return m.Forwards
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryDailyRollupStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Timezone)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CurrentLocalDate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.LastDailyRollupDate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.HasRolledToday {
-		n += 2
-	}
-	l = len(m.NextRollupDate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTreasuryForwardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRewardPoolBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "tokenchain.loyalty.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tokenchain.loyalty.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCreatorQuotaRequest)(nil), "tokenchain.loyalty.v1.QueryCreatorQuotaRequest")
+	proto.RegisterType((*QueryCreatorQuotaResponse)(nil), "tokenchain.loyalty.v1.QueryCreatorQuotaResponse")
+	proto.RegisterType((*QueryEffectiveMinimumsRequest)(nil), "tokenchain.loyalty.v1.QueryEffectiveMinimumsRequest")
+	proto.RegisterType((*QueryEffectiveMinimumsResponse)(nil), "tokenchain.loyalty.v1.QueryEffectiveMinimumsResponse")
+	proto.RegisterType((*QueryAuthoritiesRequest)(nil), "tokenchain.loyalty.v1.QueryAuthoritiesRequest")
+	proto.RegisterType((*QueryAuthoritiesResponse)(nil), "tokenchain.loyalty.v1.QueryAuthoritiesResponse")
+	proto.RegisterType((*QueryGetCreatorallowlistRequest)(nil), "tokenchain.loyalty.v1.QueryGetCreatorallowlistRequest")
+	proto.RegisterType((*QueryGetCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.QueryGetCreatorallowlistResponse")
+	proto.RegisterType((*QueryAllCreatorallowlistRequest)(nil), "tokenchain.loyalty.v1.QueryAllCreatorallowlistRequest")
+	proto.RegisterType((*QueryAllCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.QueryAllCreatorallowlistResponse")
+	proto.RegisterType((*QueryGetVerifiedtokenRequest)(nil), "tokenchain.loyalty.v1.QueryGetVerifiedtokenRequest")
+	proto.RegisterType((*QueryGetVerifiedtokenByDenomRequest)(nil), "tokenchain.loyalty.v1.QueryGetVerifiedtokenByDenomRequest")
+	proto.RegisterType((*QueryGetVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.QueryGetVerifiedtokenResponse")
+	proto.RegisterType((*QueryAllVerifiedtokenRequest)(nil), "tokenchain.loyalty.v1.QueryAllVerifiedtokenRequest")
+	proto.RegisterType((*QueryAllVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.QueryAllVerifiedtokenResponse")
+	proto.RegisterType((*QueryGetRewardaccrualRequest)(nil), "tokenchain.loyalty.v1.QueryGetRewardaccrualRequest")
+	proto.RegisterType((*QueryGetRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.QueryGetRewardaccrualResponse")
+	proto.RegisterType((*QueryAllRewardaccrualRequest)(nil), "tokenchain.loyalty.v1.QueryAllRewardaccrualRequest")
+	proto.RegisterType((*QueryAllRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.QueryAllRewardaccrualResponse")
+	proto.RegisterType((*QueryFilterRewardaccrualRequest)(nil), "tokenchain.loyalty.v1.QueryFilterRewardaccrualRequest")
+	proto.RegisterType((*QueryFilterRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.QueryFilterRewardaccrualResponse")
+	proto.RegisterType((*QueryAccountPortfolioRequest)(nil), "tokenchain.loyalty.v1.QueryAccountPortfolioRequest")
+	proto.RegisterType((*QueryAccountPortfolioResponse)(nil), "tokenchain.loyalty.v1.QueryAccountPortfolioResponse")
+	proto.RegisterType((*PortfolioEntry)(nil), "tokenchain.loyalty.v1.PortfolioEntry")
+	proto.RegisterType((*QuerySearchVerifiedtokensRequest)(nil), "tokenchain.loyalty.v1.QuerySearchVerifiedtokensRequest")
+	proto.RegisterType((*QuerySearchVerifiedtokensResponse)(nil), "tokenchain.loyalty.v1.QuerySearchVerifiedtokensResponse")
+	proto.RegisterType((*VerifiedtokenListing)(nil), "tokenchain.loyalty.v1.VerifiedtokenListing")
+	proto.RegisterType((*QueryGetMerchantallocationRequest)(nil), "tokenchain.loyalty.v1.QueryGetMerchantallocationRequest")
+	proto.RegisterType((*QueryGetMerchantallocationResponse)(nil), "tokenchain.loyalty.v1.QueryGetMerchantallocationResponse")
+	proto.RegisterType((*QueryAllMerchantallocationRequest)(nil), "tokenchain.loyalty.v1.QueryAllMerchantallocationRequest")
+	proto.RegisterType((*QueryAllMerchantallocationResponse)(nil), "tokenchain.loyalty.v1.QueryAllMerchantallocationResponse")
+	proto.RegisterType((*QueryFilterMerchantallocationRequest)(nil), "tokenchain.loyalty.v1.QueryFilterMerchantallocationRequest")
+	proto.RegisterType((*QueryFilterMerchantallocationResponse)(nil), "tokenchain.loyalty.v1.QueryFilterMerchantallocationResponse")
+	proto.RegisterType((*QueryGetRecoveryoperationRequest)(nil), "tokenchain.loyalty.v1.QueryGetRecoveryoperationRequest")
+	proto.RegisterType((*QueryGetRecoveryoperationResponse)(nil), "tokenchain.loyalty.v1.QueryGetRecoveryoperationResponse")
+	proto.RegisterType((*QueryAllRecoveryoperationRequest)(nil), "tokenchain.loyalty.v1.QueryAllRecoveryoperationRequest")
+	proto.RegisterType((*QueryAllRecoveryoperationResponse)(nil), "tokenchain.loyalty.v1.QueryAllRecoveryoperationResponse")
+	proto.RegisterType((*QueryFilterRecoveryoperationRequest)(nil), "tokenchain.loyalty.v1.QueryFilterRecoveryoperationRequest")
+	proto.RegisterType((*QueryFilterRecoveryoperationResponse)(nil), "tokenchain.loyalty.v1.QueryFilterRecoveryoperationResponse")
+	proto.RegisterType((*QueryDailyRollupStatusRequest)(nil), "tokenchain.loyalty.v1.QueryDailyRollupStatusRequest")
+	proto.RegisterType((*QueryDailyRollupStatusResponse)(nil), "tokenchain.loyalty.v1.QueryDailyRollupStatusResponse")
+	proto.RegisterType((*QueryRewardPoolBalanceRequest)(nil), "tokenchain.loyalty.v1.QueryRewardPoolBalanceRequest")
+	proto.RegisterType((*QueryRewardPoolBalanceResponse)(nil), "tokenchain.loyalty.v1.QueryRewardPoolBalanceResponse")
+	proto.RegisterType((*QueryGetMerchantRequest)(nil), "tokenchain.loyalty.v1.QueryGetMerchantRequest")
+	proto.RegisterType((*QueryGetMerchantResponse)(nil), "tokenchain.loyalty.v1.QueryGetMerchantResponse")
+	proto.RegisterType((*QueryAllMerchantRequest)(nil), "tokenchain.loyalty.v1.QueryAllMerchantRequest")
+	proto.RegisterType((*QueryAllMerchantResponse)(nil), "tokenchain.loyalty.v1.QueryAllMerchantResponse")
+	proto.RegisterType((*QueryVerifiedtokensByMerchantRequest)(nil), "tokenchain.loyalty.v1.QueryVerifiedtokensByMerchantRequest")
+	proto.RegisterType((*QueryVerifiedtokensByMerchantResponse)(nil), "tokenchain.loyalty.v1.QueryVerifiedtokensByMerchantResponse")
+	proto.RegisterType((*QueryAttestationsRequest)(nil), "tokenchain.loyalty.v1.QueryAttestationsRequest")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "tokenchain.loyalty.v1.QueryAttestationsResponse")
+	proto.RegisterType((*QueryAttestationHistoryRequest)(nil), "tokenchain.loyalty.v1.QueryAttestationHistoryRequest")
+	proto.RegisterType((*QueryAttestationHistoryResponse)(nil), "tokenchain.loyalty.v1.QueryAttestationHistoryResponse")
+	proto.RegisterType((*QueryPendingMetadataChangeRequest)(nil), "tokenchain.loyalty.v1.QueryPendingMetadataChangeRequest")
+	proto.RegisterType((*QueryPendingMetadataChangeResponse)(nil), "tokenchain.loyalty.v1.QueryPendingMetadataChangeResponse")
+	proto.RegisterType((*QueryMetadataHistoryRequest)(nil), "tokenchain.loyalty.v1.QueryMetadataHistoryRequest")
+	proto.RegisterType((*QueryMetadataHistoryResponse)(nil), "tokenchain.loyalty.v1.QueryMetadataHistoryResponse")
+	proto.RegisterType((*QueryMintScheduleRequest)(nil), "tokenchain.loyalty.v1.QueryMintScheduleRequest")
+	proto.RegisterType((*QueryMintScheduleResponse)(nil), "tokenchain.loyalty.v1.QueryMintScheduleResponse")
+	proto.RegisterType((*QueryMintSchedulesRequest)(nil), "tokenchain.loyalty.v1.QueryMintSchedulesRequest")
+	proto.RegisterType((*QueryMintSchedulesResponse)(nil), "tokenchain.loyalty.v1.QueryMintSchedulesResponse")
+	proto.RegisterType((*QueryMintRateLimitRequest)(nil), "tokenchain.loyalty.v1.QueryMintRateLimitRequest")
+	proto.RegisterType((*QueryMintRateLimitResponse)(nil), "tokenchain.loyalty.v1.QueryMintRateLimitResponse")
+	proto.RegisterType((*QueryPausedTokensRequest)(nil), "tokenchain.loyalty.v1.QueryPausedTokensRequest")
+	proto.RegisterType((*QueryPausedTokensResponse)(nil), "tokenchain.loyalty.v1.QueryPausedTokensResponse")
+	proto.RegisterType((*QueryAddressFreezeRequest)(nil), "tokenchain.loyalty.v1.QueryAddressFreezeRequest")
+	proto.RegisterType((*QueryAddressFreezeResponse)(nil), "tokenchain.loyalty.v1.QueryAddressFreezeResponse")
+	proto.RegisterType((*QueryAddressFreezesRequest)(nil), "tokenchain.loyalty.v1.QueryAddressFreezesRequest")
+	proto.RegisterType((*QueryAddressFreezesResponse)(nil), "tokenchain.loyalty.v1.QueryAddressFreezesResponse")
+	proto.RegisterType((*QueryTokenIBCPolicyRequest)(nil), "tokenchain.loyalty.v1.QueryTokenIBCPolicyRequest")
+	proto.RegisterType((*QueryTokenIBCPolicyResponse)(nil), "tokenchain.loyalty.v1.QueryTokenIBCPolicyResponse")
+	proto.RegisterType((*QueryIBCRecordersRequest)(nil), "tokenchain.loyalty.v1.QueryIBCRecordersRequest")
+	proto.RegisterType((*QueryIBCRecordersResponse)(nil), "tokenchain.loyalty.v1.QueryIBCRecordersResponse")
+	proto.RegisterType((*QueryIBCRateLimitRequest)(nil), "tokenchain.loyalty.v1.QueryIBCRateLimitRequest")
+	proto.RegisterType((*QueryIBCRateLimitResponse)(nil), "tokenchain.loyalty.v1.QueryIBCRateLimitResponse")
+	proto.RegisterType((*QueryIBCRateLimitsRequest)(nil), "tokenchain.loyalty.v1.QueryIBCRateLimitsRequest")
+	proto.RegisterType((*QueryIBCRateLimitsResponse)(nil), "tokenchain.loyalty.v1.QueryIBCRateLimitsResponse")
+	proto.RegisterType((*QueryFeeTokenRequest)(nil), "tokenchain.loyalty.v1.QueryFeeTokenRequest")
+	proto.RegisterType((*QueryFeeTokenResponse)(nil), "tokenchain.loyalty.v1.QueryFeeTokenResponse")
+	proto.RegisterType((*QueryFeeTokensRequest)(nil), "tokenchain.loyalty.v1.QueryFeeTokensRequest")
+	proto.RegisterType((*QueryFeeTokensResponse)(nil), "tokenchain.loyalty.v1.QueryFeeTokensResponse")
+	proto.RegisterType((*QueryClaimSponsorshipRequest)(nil), "tokenchain.loyalty.v1.QueryClaimSponsorshipRequest")
+	proto.RegisterType((*QueryClaimSponsorshipResponse)(nil), "tokenchain.loyalty.v1.QueryClaimSponsorshipResponse")
+	proto.RegisterType((*QueryLiabilitiesAtRequest)(nil), "tokenchain.loyalty.v1.QueryLiabilitiesAtRequest")
+	proto.RegisterType((*QueryLiabilitiesAtResponse)(nil), "tokenchain.loyalty.v1.QueryLiabilitiesAtResponse")
+	proto.RegisterType((*QueryMerchantICAsRequest)(nil), "tokenchain.loyalty.v1.QueryMerchantICAsRequest")
+	proto.RegisterType((*QueryMerchantICAsResponse)(nil), "tokenchain.loyalty.v1.QueryMerchantICAsResponse")
+	proto.RegisterType((*QueryMerchantICAPacketsRequest)(nil), "tokenchain.loyalty.v1.QueryMerchantICAPacketsRequest")
+	proto.RegisterType((*QueryMerchantICAPacketsResponse)(nil), "tokenchain.loyalty.v1.QueryMerchantICAPacketsResponse")
+	proto.RegisterType((*QueryTreasuryForwardsRequest)(nil), "tokenchain.loyalty.v1.QueryTreasuryForwardsRequest")
+	proto.RegisterType((*QueryTreasuryForwardsResponse)(nil), "tokenchain.loyalty.v1.QueryTreasuryForwardsResponse")
 }
 
-func (m *QueryRewardPoolBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() { proto.RegisterFile("tokenchain/loyalty/v1/query.proto", fileDescriptor_d3ce6a4b5ffcb3a1) }
+
+var fileDescriptor_d3ce6a4b5ffcb3a1 = []byte{
+	// 4232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0xeb, 0x6f, 0x1d, 0x49,
+	0x56, 0x9f, 0xbe, 0x76, 0x9c, 0xf8, 0xf8, 0x11, 0xa7, 0xf2, 0x72, 0x7a, 0x27, 0x4e, 0xdc, 0x79,
+	0x39, 0x2f, 0xb7, 0xed, 0x38, 0xaf, 0xcd, 0x68, 0x77, 0x6c, 0x67, 0x92, 0x89, 0x48, 0x76, 0x3c,
+	0xd7, 0x61, 0x11, 0x88, 0x55, 0xab, 0x7d, 0x6f, 0xd9, 0x6e, 0xdc, 0xb7, 0xfb, 0x4e, 0x77, 0xdf,
+	0x4c, 0xee, 0x44, 0xe1, 0x29, 0x58, 0xed, 0xa7, 0x45, 0xec, 0x97, 0x01, 0x2d, 0x0f, 0x01, 0x62,
+	0x17, 0xb1, 0xab, 0x65, 0x97, 0x95, 0x78, 0x0e, 0x5a, 0x56, 0x62, 0x59, 0x21, 0x40, 0x03, 0x08,
+	0x09, 0x09, 0x09, 0xd0, 0x0c, 0x12, 0x7f, 0x00, 0xe2, 0x23, 0x12, 0xaa, 0xea, 0x53, 0xfd, 0x7e,
+	0x7a, 0xee, 0x44, 0xbb, 0x5f, 0x2c, 0x77, 0xf5, 0x39, 0xa7, 0x7e, 0xe7, 0xd4, 0xa9, 0x53, 0xa7,
+	0xaa, 0x4f, 0x5d, 0x98, 0xf5, 0xec, 0x5d, 0x6a, 0xb5, 0x76, 0x74, 0xc3, 0x52, 0x4d, 0xbb, 0xaf,
+	0x9b, 0x5e, 0x5f, 0x7d, 0xb2, 0xa8, 0xbe, 0xd5, 0xa3, 0x4e, 0x7f, 0xbe, 0xeb, 0xd8, 0x9e, 0x4d,
+	0x8e, 0x86, 0x24, 0xf3, 0x48, 0x32, 0xff, 0x64, 0x51, 0x3e, 0xa4, 0x77, 0x0c, 0xcb, 0x56, 0xf9,
+	0x5f, 0x9f, 0x52, 0xbe, 0xd4, 0xb2, 0xdd, 0x8e, 0xed, 0xaa, 0x9b, 0xba, 0x4b, 0x7d, 0x11, 0xea,
+	0x93, 0xc5, 0x4d, 0xea, 0xe9, 0x8b, 0x6a, 0x57, 0xdf, 0x36, 0x2c, 0xdd, 0x33, 0x6c, 0x0b, 0x69,
+	0x8f, 0x6c, 0xdb, 0xdb, 0x36, 0xff, 0x57, 0x65, 0xff, 0x61, 0xeb, 0xcb, 0xdb, 0xb6, 0xbd, 0x6d,
+	0x52, 0x55, 0xef, 0x1a, 0xaa, 0x6e, 0x59, 0xb6, 0xc7, 0x59, 0x5c, 0x21, 0x3f, 0x1b, 0xac, 0xde,
+	0x6e, 0x3b, 0xd4, 0x75, 0xb5, 0x2d, 0x87, 0xd2, 0x77, 0x28, 0xd2, 0x5e, 0xc8, 0xa1, 0xf5, 0x3c,
+	0xea, 0x7a, 0x51, 0x20, 0x79, 0x84, 0x3d, 0x6f, 0xc7, 0x76, 0x0c, 0xcf, 0xa0, 0xa2, 0xf7, 0xab,
+	0xd9, 0x84, 0x2d, 0x53, 0x37, 0x3a, 0x9a, 0xdb, 0xb5, 0x2d, 0xd7, 0x76, 0xdc, 0x1d, 0xa3, 0x8b,
+	0xe4, 0x57, 0x72, 0xc8, 0x1d, 0xaa, 0x7b, 0xb6, 0xa3, 0x9b, 0xa6, 0xfd, 0xb6, 0x69, 0xb8, 0x1e,
+	0x52, 0x5f, 0xce, 0xa6, 0xde, 0xa2, 0x34, 0x43, 0xf4, 0xf9, 0x6c, 0x62, 0x63, 0xb3, 0xa5, 0x75,
+	0x6d, 0xd3, 0x68, 0xe1, 0xc8, 0xc9, 0xe7, 0xb2, 0xe9, 0x4c, 0x43, 0xdf, 0x34, 0x4c, 0xc3, 0x13,
+	0x64, 0x67, 0xb3, 0xc9, 0x3a, 0xd4, 0x69, 0xed, 0xe8, 0x96, 0x40, 0x38, 0x57, 0x4c, 0xa5, 0x19,
+	0x2d, 0x1d, 0x29, 0xe7, 0x8b, 0x29, 0x99, 0xea, 0xad, 0xe8, 0x08, 0xe4, 0xf6, 0xef, 0xe9, 0x6d,
+	0xdd, 0xd3, 0x8b, 0x2d, 0xd4, 0x31, 0x2c, 0x4f, 0x73, 0x74, 0x8f, 0x6a, 0xa6, 0xd1, 0x31, 0x04,
+	0xd8, 0x8b, 0x05, 0xc4, 0x6e, 0x6b, 0x87, 0xb6, 0x7b, 0xa6, 0x70, 0x14, 0x25, 0x9b, 0xb4, 0xab,
+	0x3b, 0x7a, 0xa7, 0x64, 0xe8, 0x1d, 0xda, 0xb2, 0x9f, 0x50, 0xa7, 0x6f, 0x77, 0xa9, 0x13, 0x55,
+	0xe8, 0x62, 0x1e, 0xf9, 0xdb, 0xba, 0xd3, 0xd6, 0x5b, 0x2d, 0xa7, 0xa7, 0x9b, 0xc5, 0xde, 0xc7,
+	0x5b, 0xb5, 0xae, 0xde, 0x73, 0x69, 0xb1, 0xcc, 0x27, 0xd4, 0x31, 0xb6, 0x0c, 0xda, 0xe6, 0x6f,
+	0x7d, 0x52, 0xe5, 0x08, 0x90, 0x37, 0xd9, 0xe4, 0x5b, 0xe7, 0x2a, 0x34, 0xe9, 0x5b, 0x3d, 0xea,
+	0x7a, 0xca, 0x8f, 0xc1, 0xe1, 0x58, 0x2b, 0x77, 0x2b, 0x4a, 0x5e, 0x85, 0x11, 0x5f, 0xd5, 0x69,
+	0xe9, 0xb4, 0x34, 0x37, 0xb6, 0x74, 0x72, 0x3e, 0x73, 0xba, 0xcf, 0xfb, 0x6c, 0xab, 0xa3, 0xdf,
+	0xff, 0xf7, 0x53, 0x2f, 0x7d, 0xf5, 0xbf, 0xff, 0xf0, 0x92, 0xd4, 0x44, 0x3e, 0x65, 0x19, 0xa6,
+	0xb9, 0xe0, 0x35, 0xdf, 0xb3, 0xdf, 0xec, 0xd9, 0x9e, 0x8e, 0x9d, 0x92, 0x69, 0xd8, 0x8f, 0xb3,
+	0x93, 0x8b, 0x1f, 0x6d, 0x8a, 0x47, 0xe5, 0xff, 0x1a, 0x70, 0x22, 0x83, 0x0d, 0x51, 0xfd, 0x38,
+	0x4c, 0x25, 0x27, 0x0a, 0xe2, 0xbb, 0x90, 0x83, 0x6f, 0x2d, 0x41, 0xbe, 0x3a, 0xcc, 0x90, 0x36,
+	0x53, 0x62, 0x18, 0x24, 0xfa, 0xb4, 0x6b, 0x38, 0xb4, 0x3d, 0xdd, 0x38, 0x2d, 0xcd, 0x1d, 0x68,
+	0x8a, 0x47, 0x72, 0x11, 0xa6, 0x1c, 0xda, 0xd1, 0x0d, 0xcb, 0xb0, 0xb6, 0x35, 0xde, 0x8b, 0x3b,
+	0x3d, 0x74, 0x5a, 0x9a, 0x1b, 0x6e, 0x1e, 0x0c, 0xda, 0x1f, 0xf3, 0x66, 0x46, 0xda, 0xb3, 0xb8,
+	0xc3, 0xd1, 0xb6, 0x20, 0x1d, 0xe6, 0xd2, 0x0e, 0x06, 0xed, 0x21, 0x69, 0x28, 0xd5, 0xed, 0x75,
+	0xbb, 0x66, 0x7f, 0x7a, 0x5f, 0x42, 0xea, 0x06, 0x6f, 0x8e, 0x4b, 0x45, 0xd2, 0x91, 0x84, 0x54,
+	0x24, 0xfd, 0x34, 0xec, 0xeb, 0xb9, 0xfa, 0x36, 0x9d, 0xde, 0xcf, 0xad, 0x72, 0xa6, 0xd8, 0x2a,
+	0x3f, 0xca, 0x48, 0xd1, 0x22, 0x3e, 0x9f, 0x72, 0x0a, 0x4e, 0x72, 0xf3, 0xbf, 0xb6, 0xb5, 0x45,
+	0x5b, 0x9e, 0xf1, 0x84, 0x3e, 0x32, 0x2c, 0xa3, 0xd3, 0x0b, 0xfd, 0xe5, 0x19, 0xcc, 0xe4, 0x11,
+	0xe0, 0x20, 0xcd, 0xc2, 0xb8, 0x45, 0xbd, 0xb7, 0x6d, 0x67, 0x57, 0xeb, 0xd8, 0x6d, 0x8a, 0x23,
+	0x3c, 0x86, 0x6d, 0x8f, 0xec, 0x36, 0x25, 0x37, 0xe0, 0xb8, 0x98, 0x24, 0x9a, 0x67, 0x74, 0xa8,
+	0x69, 0xb7, 0x76, 0xb5, 0x1d, 0xbb, 0xe7, 0xb8, 0xdc, 0xf8, 0xc3, 0xcd, 0xa3, 0xe2, 0xf5, 0x63,
+	0x7c, 0xfb, 0x3a, 0x7b, 0xa9, 0x9c, 0x80, 0xe3, 0xbc, 0xf3, 0x95, 0x30, 0x0a, 0x0b, 0x5c, 0x5f,
+	0x90, 0x60, 0x3a, 0xfd, 0x0e, 0x21, 0xbd, 0x0c, 0xa3, 0x22, 0x70, 0xf7, 0x11, 0x4f, 0xd8, 0x40,
+	0xde, 0x80, 0xb1, 0x48, 0x58, 0xe7, 0x08, 0xc6, 0x96, 0x94, 0x1c, 0xd3, 0x45, 0xc4, 0x47, 0xbd,
+	0x3e, 0x2a, 0x41, 0xb9, 0x03, 0xa7, 0x38, 0x94, 0xfb, 0xd4, 0x4b, 0xfa, 0x5f, 0xf9, 0x0c, 0x78,
+	0x0e, 0xa7, 0xf3, 0x99, 0x3f, 0xf6, 0x79, 0xa0, 0x18, 0x88, 0x7d, 0xc5, 0x34, 0xf3, 0xb0, 0xdf,
+	0x03, 0x08, 0xd7, 0x6d, 0xec, 0xf7, 0xfc, 0xbc, 0xbf, 0xc8, 0xcf, 0xb3, 0x45, 0x7e, 0xde, 0xcf,
+	0x13, 0x70, 0x91, 0x9f, 0x5f, 0xd7, 0xb7, 0x29, 0xf2, 0x36, 0x23, 0x9c, 0xca, 0xf7, 0x24, 0x38,
+	0x9d, 0xdf, 0x57, 0xa1, 0xaa, 0x43, 0x83, 0x98, 0xf2, 0xf7, 0x63, 0x7a, 0x34, 0xd0, 0x7e, 0x65,
+	0x7a, 0xf8, 0xb8, 0x62, 0x8a, 0x2c, 0xc3, 0xcb, 0x62, 0xc8, 0x3e, 0x1b, 0x0d, 0xbc, 0xc2, 0x60,
+	0x47, 0x60, 0x5f, 0x9b, 0x5a, 0x76, 0x07, 0x87, 0xda, 0x7f, 0x50, 0xee, 0xc0, 0x99, 0x4c, 0xae,
+	0xd5, 0xfe, 0x5d, 0xf6, 0xbe, 0x98, 0xf9, 0x2d, 0x38, 0x99, 0xc9, 0x1c, 0xd8, 0x6d, 0x1d, 0x26,
+	0x62, 0x8b, 0x00, 0x8e, 0xd3, 0xd9, 0x1c, 0xa3, 0xc5, 0x11, 0xf8, 0x16, 0x8b, 0x0b, 0x50, 0xb6,
+	0x50, 0xcb, 0x15, 0xd3, 0xcc, 0xd4, 0x72, 0x50, 0x6e, 0xf1, 0x67, 0x12, 0x9c, 0xcc, 0xe9, 0x28,
+	0x5f, 0xb7, 0xa1, 0x8f, 0xa4, 0xdb, 0xe0, 0x5c, 0x61, 0x21, 0x74, 0x85, 0x66, 0x74, 0x5d, 0x17,
+	0x46, 0x9a, 0x82, 0xa1, 0x5d, 0x2a, 0x62, 0x10, 0xfb, 0x37, 0x3a, 0x92, 0x09, 0x8e, 0x50, 0xdb,
+	0x58, 0x8a, 0x50, 0x32, 0x92, 0x31, 0x21, 0x42, 0xdb, 0x98, 0x80, 0xe8, 0x48, 0x66, 0x82, 0xfc,
+	0x38, 0x46, 0xb2, 0xb2, 0x6e, 0x43, 0x1f, 0x49, 0xb7, 0xc1, 0x8d, 0xe4, 0xaf, 0x4a, 0x18, 0x09,
+	0xef, 0x19, 0xa6, 0x47, 0x9d, 0x4c, 0x43, 0xe5, 0x46, 0xf1, 0x70, 0xd6, 0x36, 0x22, 0xb3, 0x36,
+	0x61, 0xd8, 0xa1, 0x3d, 0x1b, 0xf6, 0x3d, 0x11, 0x39, 0x33, 0xb1, 0xfd, 0xe0, 0xdb, 0xf6, 0x67,
+	0x25, 0xe1, 0x81, 0xad, 0x96, 0xdd, 0xb3, 0xbc, 0x75, 0xdb, 0xf1, 0xb6, 0x6c, 0xd3, 0xb0, 0xcb,
+	0x0d, 0x7b, 0x2f, 0x03, 0xc3, 0x5e, 0x4c, 0xf8, 0x8d, 0xc0, 0x37, 0x53, 0x10, 0xd0, 0x7e, 0xaf,
+	0xc1, 0x7e, 0x6a, 0x79, 0x0e, 0x4b, 0x09, 0x7c, 0xcb, 0x9d, 0xcb, 0xcb, 0x81, 0x05, 0xeb, 0x6b,
+	0x96, 0xe7, 0xf4, 0xd1, 0x74, 0x82, 0x77, 0x70, 0x46, 0xfb, 0x97, 0x06, 0x4c, 0xc6, 0xbb, 0xca,
+	0x5e, 0x1b, 0xd2, 0xe1, 0xb1, 0xf1, 0x11, 0x43, 0x3f, 0x1b, 0x8e, 0x4d, 0xdd, 0xd4, 0xad, 0x16,
+	0xc5, 0xcc, 0x57, 0x3c, 0xb2, 0xcc, 0x8a, 0xef, 0x74, 0xf5, 0x4d, 0x93, 0xf2, 0x54, 0x77, 0xb8,
+	0x19, 0x36, 0xb0, 0x54, 0xb0, 0x6b, 0xdb, 0xa6, 0x26, 0x98, 0xfd, 0x04, 0x77, 0x8c, 0xb5, 0xad,
+	0xa2, 0x80, 0x59, 0x18, 0xf7, 0xb7, 0xca, 0x5b, 0x3d, 0xab, 0x4d, 0xdb, 0x98, 0xd8, 0x8e, 0xf1,
+	0xb6, 0x7b, 0xbc, 0x89, 0x7c, 0x0e, 0x48, 0x97, 0x5a, 0x6d, 0x96, 0x28, 0x63, 0x5a, 0xc8, 0xc6,
+	0x64, 0x3f, 0x1f, 0x93, 0xb9, 0x5c, 0x6f, 0x4e, 0xec, 0xc1, 0x50, 0xb1, 0x43, 0x28, 0xa9, 0x19,
+	0x08, 0x52, 0x7e, 0xa5, 0x81, 0x93, 0x69, 0x83, 0xea, 0x4e, 0x6b, 0x27, 0x66, 0x0e, 0x91, 0x5e,
+	0x92, 0x63, 0x30, 0x62, 0xb8, 0x6e, 0x8f, 0x3a, 0x68, 0x6a, 0x7c, 0x22, 0x67, 0x60, 0xc2, 0xed,
+	0x77, 0x36, 0x6d, 0x53, 0xeb, 0x3a, 0x74, 0xcb, 0x78, 0x8a, 0xf3, 0x7d, 0xdc, 0x6f, 0x5c, 0xe7,
+	0x6d, 0x44, 0x86, 0x03, 0xc2, 0x9e, 0xdc, 0x7e, 0xa3, 0xcd, 0xe0, 0x99, 0x9c, 0x85, 0x49, 0x97,
+	0x1a, 0xef, 0xf4, 0x1c, 0xaa, 0xd9, 0x5d, 0x4f, 0x33, 0xac, 0xe9, 0x61, 0x94, 0xe0, 0xb7, 0xbe,
+	0xd1, 0xf5, 0x1e, 0x58, 0xe4, 0x02, 0x1c, 0xd4, 0xdb, 0x1d, 0xc3, 0xd2, 0x1c, 0x6a, 0xd9, 0x3d,
+	0xab, 0x45, 0xdb, 0xdc, 0x96, 0xa3, 0xcd, 0x49, 0xde, 0xdc, 0x14, 0xad, 0x89, 0xe9, 0x31, 0xb2,
+	0xe7, 0xe9, 0xf1, 0x37, 0x12, 0xcc, 0x16, 0x18, 0x25, 0x48, 0xce, 0x26, 0x63, 0x8e, 0x22, 0x66,
+	0xca, 0xe5, 0x2a, 0xae, 0xf6, 0xd0, 0x70, 0x3d, 0xc3, 0xda, 0xc6, 0x81, 0x49, 0x08, 0x1a, 0xdc,
+	0xb4, 0xf9, 0x5f, 0x09, 0x8e, 0x64, 0xf5, 0x3b, 0xf8, 0x0c, 0x89, 0x39, 0x09, 0x6e, 0xcf, 0xfc,
+	0x5d, 0x0c, 0x3e, 0xa5, 0xa6, 0xc1, 0x50, 0x7a, 0x1a, 0xac, 0xc3, 0xb8, 0xeb, 0xe9, 0xbb, 0xd4,
+	0xd1, 0x5c, 0x4f, 0xf7, 0xfc, 0x5d, 0x63, 0x7e, 0x8a, 0xcb, 0xf7, 0x90, 0x1b, 0x9c, 0x7e, 0x83,
+	0x91, 0x23, 0x9c, 0x31, 0x37, 0x6c, 0x52, 0xae, 0xe3, 0x00, 0xde, 0xa7, 0xde, 0xa3, 0xd4, 0x11,
+	0x4b, 0x7e, 0x3a, 0xf2, 0x8b, 0x12, 0x28, 0x45, 0x7c, 0x38, 0xf2, 0x1a, 0x90, 0xf4, 0xc1, 0x0d,
+	0x5a, 0xf0, 0x62, 0x0e, 0xea, 0xb4, 0x38, 0xc4, 0x9d, 0x21, 0x4a, 0xd9, 0x45, 0xf8, 0x2b, 0xa6,
+	0x99, 0x0f, 0x7f, 0x50, 0x89, 0xca, 0x3f, 0x08, 0xa5, 0x73, 0x7a, 0x2b, 0x51, 0x7a, 0x68, 0x40,
+	0x4a, 0x0f, 0xce, 0xe9, 0xdf, 0x95, 0xe0, 0x6c, 0x24, 0x41, 0xc8, 0xb7, 0x20, 0x81, 0xe1, 0xb6,
+	0xee, 0x89, 0x4d, 0x3a, 0xff, 0xff, 0x63, 0xce, 0x5d, 0xfe, 0x51, 0x82, 0x73, 0x25, 0xd0, 0x7e,
+	0xe8, 0xcc, 0xbd, 0x14, 0xee, 0xd9, 0x53, 0x0b, 0x8f, 0xb0, 0xf4, 0x24, 0x34, 0x8c, 0x36, 0xb7,
+	0xf3, 0x70, 0xb3, 0x61, 0xb4, 0x95, 0x9f, 0x93, 0x60, 0xb6, 0x80, 0x09, 0x6d, 0xf0, 0x93, 0x70,
+	0x28, 0x75, 0x9c, 0x88, 0x8e, 0x5e, 0x7b, 0xe9, 0x4b, 0x09, 0x52, 0x7e, 0x2a, 0xdc, 0x80, 0xe7,
+	0xe2, 0x1e, 0xd4, 0x1c, 0xfb, 0x5b, 0x09, 0x66, 0x0b, 0x3a, 0x2b, 0xd6, 0x77, 0x68, 0x20, 0xfa,
+	0x0e, 0x30, 0x81, 0x6d, 0xc0, 0x99, 0x88, 0x13, 0xe7, 0x1a, 0x8f, 0xad, 0x08, 0x9e, 0xee, 0xf5,
+	0x44, 0x1a, 0x8b, 0x4f, 0x39, 0x53, 0x6c, 0x16, 0xc6, 0x1d, 0x9f, 0x91, 0xb6, 0xb5, 0xcd, 0x3e,
+	0xe6, 0x0a, 0x63, 0x41, 0xdb, 0x2a, 0x5f, 0x4a, 0xb6, 0x1c, 0xbb, 0xa3, 0x89, 0xec, 0xd8, 0x4f,
+	0x16, 0xc6, 0x58, 0xdb, 0x8a, 0xdf, 0x44, 0x4e, 0x02, 0x78, 0x76, 0x40, 0xe0, 0xa7, 0x09, 0xa3,
+	0x9e, 0xbd, 0x92, 0x99, 0x40, 0xef, 0x3d, 0x43, 0xf8, 0xfb, 0x78, 0x88, 0xf9, 0xa1, 0x1f, 0x52,
+	0x71, 0xf2, 0x79, 0x57, 0x37, 0xcc, 0x7e, 0xd3, 0x36, 0xcd, 0x5e, 0x77, 0x83, 0x0f, 0x96, 0x38,
+	0x61, 0xfc, 0x1f, 0x09, 0x66, 0xf2, 0x28, 0x50, 0x55, 0x19, 0x0e, 0xb0, 0xe3, 0xcc, 0x77, 0x6c,
+	0x4b, 0x44, 0xd4, 0xe0, 0x99, 0x5c, 0x01, 0xd2, 0xea, 0x39, 0x0e, 0xb5, 0x3c, 0x8d, 0x05, 0x20,
+	0x53, 0xe3, 0x71, 0xd7, 0x1f, 0xff, 0x29, 0x7c, 0xf3, 0x90, 0xbd, 0xb8, 0xcb, 0x62, 0xf0, 0x35,
+	0x38, 0x66, 0xea, 0xae, 0xa7, 0xb5, 0x59, 0x5f, 0x9a, 0xc3, 0x3b, 0xf3, 0x39, 0x7c, 0xa7, 0x38,
+	0xcc, 0xde, 0x46, 0x80, 0x70, 0xa6, 0x39, 0x98, 0xda, 0xd1, 0x5d, 0x4e, 0xcd, 0xcf, 0x9f, 0xdb,
+	0x7a, 0x1f, 0x8f, 0x9f, 0x27, 0x77, 0x74, 0xb7, 0xc9, 0x9b, 0x1f, 0xb3, 0x56, 0x46, 0x69, 0xd1,
+	0xa7, 0x5e, 0x4c, 0x30, 0x26, 0x94, 0xac, 0x3d, 0x94, 0xa9, 0x5c, 0x47, 0xb3, 0xf8, 0xdb, 0xc3,
+	0xf5, 0x30, 0x65, 0x29, 0x3e, 0x9f, 0xea, 0xc1, 0x4c, 0x1e, 0x1b, 0xda, 0xea, 0x1c, 0x4c, 0x76,
+	0x6c, 0xf6, 0xc1, 0x45, 0x8b, 0xef, 0xf4, 0x26, 0xfc, 0xd6, 0x95, 0xc2, 0x8d, 0xf4, 0x31, 0x18,
+	0xd1, 0x3b, 0x76, 0xcf, 0xf2, 0xd0, 0x1c, 0xf8, 0xa4, 0x5c, 0x84, 0xe3, 0xc9, 0xe4, 0x25, 0x2f,
+	0xfe, 0x7e, 0x0e, 0xa6, 0xd3, 0xa4, 0x88, 0x6d, 0x05, 0x0e, 0x88, 0xe5, 0x02, 0x23, 0xde, 0xa9,
+	0x92, 0xf5, 0x06, 0x1d, 0x34, 0x60, 0x53, 0x74, 0x38, 0x9e, 0xcc, 0x28, 0x06, 0x1d, 0x51, 0x7f,
+	0x2f, 0x38, 0xf2, 0x36, 0xcd, 0x12, 0x15, 0x86, 0xf6, 0xa0, 0xc2, 0xe0, 0xa6, 0xd6, 0x17, 0x45,
+	0xa8, 0x88, 0x6f, 0x23, 0x56, 0xfb, 0x49, 0xcb, 0x9c, 0x82, 0xb1, 0xf0, 0xc3, 0xa1, 0x18, 0x2c,
+	0x10, 0x4d, 0x0f, 0xda, 0x03, 0xdb, 0xfd, 0x7f, 0x57, 0x24, 0x21, 0xf9, 0x88, 0x7e, 0xf0, 0xcf,
+	0x1a, 0x9f, 0x8a, 0xe1, 0x0f, 0x3f, 0x5e, 0xbb, 0x85, 0xb3, 0x72, 0x60, 0xe6, 0x7b, 0x57, 0x7c,
+	0xa5, 0x8b, 0x77, 0x8d, 0x26, 0x7b, 0x08, 0xe3, 0x91, 0xef, 0xe9, 0x62, 0x4f, 0x98, 0xfb, 0x41,
+	0x25, 0x24, 0x45, 0x7b, 0xc5, 0xb8, 0x63, 0x9b, 0x67, 0xff, 0xcb, 0x5c, 0xf0, 0xcc, 0x56, 0x43,
+	0x9d, 0x7f, 0x84, 0xd2, 0x5a, 0x41, 0x30, 0x98, 0x68, 0x8e, 0xf9, 0x6d, 0x6b, 0xac, 0x89, 0x85,
+	0x19, 0xb6, 0x7e, 0xb2, 0x2f, 0x79, 0x48, 0x34, 0xcc, 0x89, 0x26, 0x44, 0xab, 0x4f, 0x16, 0x1f,
+	0x94, 0x7d, 0x7b, 0x1f, 0x94, 0x9f, 0xc6, 0xc0, 0x17, 0x51, 0xeb, 0x75, 0xc3, 0xf5, 0x6c, 0xa7,
+	0x8f, 0x86, 0xfc, 0x98, 0x87, 0xe6, 0xdb, 0xe2, 0xd8, 0x32, 0x0b, 0x00, 0x0e, 0xd0, 0xeb, 0xb0,
+	0x9f, 0x2d, 0xa4, 0x4e, 0xdb, 0x2d, 0x59, 0x87, 0x23, 0x32, 0x9a, 0x9c, 0x41, 0x1c, 0x6e, 0x21,
+	0xfb, 0xe0, 0x7c, 0xf9, 0x36, 0x26, 0x87, 0xeb, 0xfe, 0xf1, 0xcc, 0x23, 0xfc, 0xc8, 0xbf, 0xb6,
+	0xa3, 0x5b, 0xdb, 0x25, 0x4b, 0xcd, 0xcf, 0x80, 0x52, 0xc4, 0x1a, 0x1e, 0x55, 0x88, 0x43, 0xa4,
+	0x16, 0x7f, 0x83, 0x81, 0xf7, 0x4a, 0xde, 0xa1, 0x5e, 0x96, 0x34, 0x31, 0xa1, 0x51, 0x92, 0xdf,
+	0xa8, 0x3c, 0x83, 0x4f, 0x70, 0x00, 0x82, 0xf6, 0x85, 0x8e, 0xf7, 0x37, 0xc5, 0x51, 0x6a, 0xaa,
+	0xf7, 0x60, 0xb0, 0xd9, 0x7c, 0x71, 0x23, 0x33, 0xf1, 0x7c, 0xee, 0x42, 0xe0, 0x4b, 0xf8, 0xac,
+	0x4f, 0x2e, 0xd6, 0x03, 0xc1, 0x3d, 0xb8, 0xc1, 0x7e, 0x15, 0x03, 0xd7, 0x23, 0xc3, 0xf2, 0x36,
+	0xb0, 0xec, 0xa2, 0xd8, 0x5a, 0xfe, 0xe2, 0xdd, 0x08, 0x16, 0xef, 0x5d, 0x38, 0x91, 0x21, 0x01,
+	0x35, 0xfe, 0x0c, 0x4c, 0xc4, 0x2a, 0x3a, 0xa6, 0xa5, 0xc2, 0x8f, 0xe1, 0x51, 0x19, 0x22, 0x02,
+	0x75, 0x22, 0x6d, 0x4a, 0x3f, 0xa3, 0xb3, 0x17, 0x14, 0x68, 0xff, 0x58, 0x02, 0x39, 0xab, 0xef,
+	0x60, 0x71, 0x9a, 0x8c, 0x69, 0x2a, 0x46, 0xb8, 0x86, 0xaa, 0x13, 0x51, 0x55, 0x07, 0x38, 0xc6,
+	0x8b, 0x11, 0xa3, 0x35, 0x75, 0x8f, 0x3e, 0x64, 0x75, 0x0a, 0xc5, 0x13, 0xf9, 0x9f, 0x1a, 0x20,
+	0x67, 0xf1, 0xa0, 0xb2, 0x4d, 0x38, 0x98, 0xa8, 0xea, 0x29, 0x39, 0xb1, 0x8b, 0x89, 0x89, 0xaa,
+	0x1b, 0x34, 0xb2, 0x33, 0x7e, 0x9c, 0xcb, 0xa8, 0xeb, 0xe5, 0x92, 0x70, 0x10, 0x43, 0x26, 0x78,
+	0x59, 0x6e, 0xcf, 0xe4, 0xd2, 0x36, 0x4f, 0xa8, 0x59, 0x8c, 0x61, 0xa9, 0xb7, 0x7f, 0xcc, 0x37,
+	0xe5, 0xbf, 0x69, 0xfa, 0x2f, 0xee, 0xea, 0x7d, 0x96, 0xe5, 0x44, 0xf3, 0x6e, 0x7f, 0x0b, 0x07,
+	0x4e, 0x98, 0xc7, 0xc7, 0xc5, 0x45, 0xf3, 0xf3, 0x98, 0x38, 0xa4, 0x66, 0xc5, 0x0d, 0x4f, 0x74,
+	0xc3, 0xe4, 0x47, 0xf0, 0x23, 0xfe, 0x11, 0x7c, 0xd0, 0xa0, 0x6c, 0xe2, 0x5c, 0x5b, 0xd7, 0x7b,
+	0xae, 0x28, 0x3e, 0x19, 0x74, 0x22, 0xfa, 0x2d, 0x09, 0x4e, 0x64, 0x74, 0x12, 0xa4, 0x03, 0x13,
+	0xbc, 0x62, 0x29, 0xa8, 0x88, 0xf1, 0x7d, 0x74, 0xb6, 0xe8, 0x6c, 0x93, 0x0b, 0x12, 0x93, 0xb1,
+	0x1b, 0x91, 0x3a, 0x38, 0x07, 0xfd, 0x11, 0x91, 0xc2, 0xf8, 0x1b, 0x8d, 0x7b, 0xbc, 0x4a, 0xb0,
+	0x78, 0x56, 0x47, 0xbe, 0x4a, 0x35, 0xe2, 0x45, 0x1b, 0x36, 0xc8, 0x59, 0xc2, 0xd0, 0x02, 0x6f,
+	0xc2, 0x64, 0xbc, 0x18, 0xb1, 0xc4, 0x71, 0x63, 0x52, 0x84, 0xe3, 0xea, 0xd1, 0x46, 0xe5, 0x9d,
+	0xac, 0x0e, 0x5f, 0x50, 0x50, 0xfa, 0x73, 0x09, 0x3e, 0x91, 0xd9, 0x39, 0xaa, 0xbb, 0xc1, 0x3e,
+	0x56, 0x44, 0xd5, 0x75, 0x4b, 0x92, 0xe6, 0x2c, 0x7d, 0x27, 0x63, 0xfa, 0xba, 0x83, 0x3c, 0xab,
+	0xf3, 0x2d, 0xc7, 0xfd, 0xe9, 0xc1, 0xea, 0xda, 0x3a, 0x2f, 0x8d, 0x2c, 0x8e, 0x4c, 0xbf, 0x2e,
+	0x34, 0x4e, 0x32, 0xa1, 0xc6, 0x6b, 0x30, 0xe2, 0x57, 0x58, 0xe2, 0xc0, 0x9e, 0x2b, 0xf2, 0xed,
+	0x80, 0x1d, 0x35, 0x45, 0x56, 0x76, 0x6e, 0x63, 0xb8, 0x5a, 0x9b, 0x6e, 0xe9, 0x3d, 0xd3, 0xc3,
+	0x54, 0x77, 0xd4, 0x70, 0xef, 0xfa, 0x0d, 0x2c, 0x0f, 0xa6, 0x6e, 0xcb, 0xb1, 0xdf, 0xc6, 0x8f,
+	0x48, 0xc3, 0xcd, 0xe0, 0x99, 0x9d, 0x25, 0xfa, 0xb3, 0xfc, 0xc1, 0xea, 0x9a, 0x9f, 0xa8, 0x51,
+	0x27, 0x70, 0x86, 0x93, 0x00, 0x2c, 0xe3, 0xb1, 0xa8, 0x29, 0xf6, 0x54, 0xa3, 0xcd, 0x51, 0x6c,
+	0x19, 0xe0, 0x96, 0xea, 0x6b, 0x22, 0x08, 0xc4, 0x31, 0xa0, 0x85, 0xee, 0xc1, 0xa8, 0x23, 0x1a,
+	0x4b, 0x36, 0x04, 0x11, 0x7e, 0xb4, 0x50, 0xc8, 0x3a, 0x38, 0x37, 0x78, 0x23, 0x62, 0xb1, 0x4a,
+	0xcb, 0x53, 0xc2, 0x8e, 0x8d, 0x84, 0x1d, 0x15, 0x1d, 0x4e, 0x64, 0x08, 0x44, 0xf5, 0xef, 0x8a,
+	0xba, 0xbc, 0xe2, 0xa3, 0xdb, 0x28, 0x6f, 0x46, 0x71, 0x5e, 0x3f, 0xa3, 0x8b, 0x17, 0x34, 0xe7,
+	0xbf, 0x2e, 0x12, 0x91, 0x44, 0xdf, 0xc1, 0xf0, 0x8e, 0x70, 0x88, 0x65, 0x1b, 0x8a, 0x3c, 0x05,
+	0x91, 0x7b, 0x70, 0xc3, 0x7b, 0x05, 0x8e, 0xf8, 0x87, 0x93, 0x94, 0x3e, 0x2e, 0x2f, 0xc5, 0xfa,
+	0xb2, 0x04, 0x47, 0x13, 0xe4, 0xa8, 0xd8, 0x2a, 0x8c, 0xb2, 0x62, 0xeb, 0xe8, 0x07, 0xc2, 0xbc,
+	0x73, 0x14, 0xc1, 0x2b, 0xf2, 0xe6, 0x2d, 0x7c, 0x26, 0x9f, 0x86, 0x03, 0xdb, 0xba, 0xab, 0xb1,
+	0xcf, 0x7d, 0xa8, 0xd2, 0x4c, 0x8e, 0x88, 0xfb, 0xba, 0xcb, 0x8f, 0xca, 0x70, 0x97, 0xb5, 0xed,
+	0x3f, 0x2a, 0x5a, 0x02, 0xdd, 0xc0, 0x17, 0xf0, 0xaf, 0x48, 0x70, 0x2c, 0xd9, 0x43, 0xe0, 0xb9,
+	0x10, 0x18, 0xc0, 0x2d, 0x39, 0x49, 0x4a, 0x58, 0x60, 0x54, 0x58, 0x60, 0x80, 0xe3, 0xfa, 0x19,
+	0xdc, 0xed, 0xac, 0xb1, 0xfa, 0x80, 0x8d, 0xb0, 0x04, 0x7e, 0xaf, 0x0b, 0xf7, 0x7f, 0x34, 0xe0,
+	0x64, 0x8e, 0x40, 0x34, 0xc0, 0x1b, 0x30, 0x16, 0x29, 0xb5, 0x2f, 0x2b, 0xb3, 0x4c, 0x48, 0x09,
+	0x3e, 0xcc, 0x86, 0x4d, 0xe4, 0x75, 0x11, 0x0b, 0x1a, 0x85, 0x1b, 0xd0, 0xa4, 0xa8, 0x74, 0x3c,
+	0x88, 0xd7, 0x10, 0x6f, 0xf6, 0xda, 0xdb, 0xd4, 0x4b, 0x55, 0x26, 0xaf, 0xf2, 0x66, 0x72, 0x1f,
+	0x46, 0x79, 0xe1, 0x23, 0xff, 0xfe, 0x3c, 0x5c, 0xf8, 0x99, 0x16, 0xfb, 0xa4, 0xed, 0x15, 0xc1,
+	0xd0, 0x0c, 0x79, 0x89, 0x0a, 0x87, 0xc3, 0x3e, 0x43, 0x91, 0x7e, 0x72, 0x4a, 0x82, 0x57, 0x01,
+	0xaf, 0xf2, 0x1a, 0x06, 0xad, 0x87, 0x78, 0xbd, 0xc0, 0xa0, 0xee, 0x4a, 0x49, 0xa4, 0x15, 0x1f,
+	0x25, 0x1b, 0xe1, 0x47, 0x49, 0xc5, 0x02, 0x39, 0x4b, 0x4c, 0xb0, 0x11, 0x82, 0xd6, 0x0e, 0x6d,
+	0xed, 0x76, 0x6d, 0x23, 0x38, 0xb2, 0xbd, 0x94, 0xa3, 0x9f, 0x90, 0xd0, 0x5f, 0x0b, 0x38, 0xd0,
+	0xac, 0x11, 0x19, 0xca, 0x2f, 0x88, 0x25, 0x55, 0x9c, 0x08, 0x3e, 0x58, 0x5b, 0x71, 0x5f, 0xf8,
+	0x39, 0xe5, 0x6f, 0x8b, 0x45, 0x35, 0x8e, 0x02, 0xb5, 0x7e, 0x05, 0x86, 0x8d, 0x96, 0x5e, 0xb6,
+	0x9e, 0x46, 0x58, 0x51, 0x4f, 0xce, 0x35, 0xb8, 0x39, 0xf9, 0x05, 0xf1, 0x61, 0x24, 0xd2, 0xd3,
+	0xba, 0xde, 0xda, 0xa5, 0xde, 0x8b, 0x37, 0x58, 0x70, 0xfc, 0x95, 0x85, 0x25, 0x3c, 0xfe, 0xea,
+	0xfa, 0x4d, 0x25, 0xab, 0x55, 0x4a, 0x86, 0x08, 0xcc, 0xc8, 0x3e, 0x38, 0x13, 0x7e, 0x5e, 0x9c,
+	0xe2, 0x3c, 0x76, 0xa8, 0xee, 0xf6, 0x9c, 0xfe, 0x3d, 0xdb, 0x61, 0x1f, 0x4e, 0x5e, 0xbc, 0x01,
+	0xbf, 0x25, 0xea, 0xe2, 0xd2, 0x48, 0xc2, 0x03, 0xa5, 0x2d, 0x6c, 0x2b, 0x39, 0x50, 0x4a, 0x88,
+	0x08, 0x16, 0x46, 0xe4, 0x1e, 0x98, 0xf9, 0x96, 0x3e, 0xff, 0x2a, 0xec, 0xe3, 0xa0, 0xc9, 0x2f,
+	0x49, 0x30, 0xe2, 0xdf, 0x49, 0x21, 0x79, 0xf1, 0x2d, 0x7d, 0x09, 0x46, 0xbe, 0x54, 0x85, 0xd4,
+	0xef, 0x57, 0x39, 0xf7, 0xf3, 0xff, 0xfc, 0x5f, 0x5f, 0x6a, 0x9c, 0x22, 0x27, 0xd5, 0xa2, 0x1b,
+	0x42, 0xe4, 0xf7, 0x25, 0x18, 0x8f, 0xde, 0x61, 0x21, 0x6a, 0x51, 0x1f, 0x19, 0x97, 0x64, 0xe4,
+	0x85, 0xea, 0x0c, 0x08, 0xed, 0x06, 0x87, 0xb6, 0x40, 0xe6, 0xd5, 0xc2, 0x4b, 0x66, 0xda, 0x5b,
+	0x8c, 0x4b, 0x7d, 0x86, 0x6b, 0xe0, 0x73, 0xf2, 0x47, 0x12, 0x1c, 0x4a, 0xdd, 0xe7, 0x20, 0xcb,
+	0x45, 0xfd, 0xe7, 0xdd, 0x0f, 0x91, 0xaf, 0xd7, 0xe4, 0x42, 0xe8, 0x8b, 0x1c, 0xfa, 0x65, 0x72,
+	0x31, 0x07, 0x3a, 0x15, 0x9c, 0x5a, 0x47, 0xe0, 0xfb, 0x35, 0x09, 0xc6, 0x22, 0xb7, 0x31, 0xc8,
+	0x7c, 0x51, 0xcf, 0xe9, 0x1b, 0x23, 0xb2, 0x5a, 0x99, 0x1e, 0x31, 0x5e, 0xe2, 0x18, 0xcf, 0x12,
+	0x45, 0x2d, 0xbd, 0x1b, 0x48, 0xfe, 0x4a, 0x82, 0xc3, 0x19, 0x37, 0x38, 0xc8, 0x8d, 0xa2, 0x4e,
+	0xf3, 0xef, 0x8b, 0xc8, 0x37, 0x6b, 0xf3, 0x21, 0xe8, 0xdb, 0x1c, 0xf4, 0x35, 0xb2, 0xa8, 0x56,
+	0xbb, 0x78, 0x18, 0x71, 0x8b, 0x3f, 0x91, 0xe0, 0x08, 0x2b, 0x96, 0xab, 0xa7, 0x44, 0xfe, 0xc5,
+	0x11, 0xf9, 0x66, 0x6d, 0x3e, 0x54, 0x42, 0xe5, 0x4a, 0x5c, 0x24, 0x17, 0x2a, 0x2a, 0xc1, 0x3c,
+	0x7a, 0x2a, 0x79, 0x35, 0x82, 0x5c, 0x2b, 0xb1, 0x61, 0xd6, 0xad, 0x06, 0x79, 0xb9, 0x1e, 0x13,
+	0x02, 0x5e, 0xe6, 0x80, 0xe7, 0xc9, 0x15, 0xb5, 0xc2, 0xfd, 0x3c, 0xf5, 0x19, 0x4f, 0x7b, 0x9e,
+	0x93, 0xef, 0x4a, 0x70, 0x3c, 0xe7, 0x36, 0x08, 0xf9, 0x64, 0x1d, 0x1c, 0xf1, 0x2b, 0x24, 0x7b,
+	0xd4, 0xe1, 0x3a, 0xd7, 0x41, 0x25, 0x57, 0xab, 0xe8, 0xa0, 0x6d, 0xf6, 0x35, 0x3f, 0x79, 0xfb,
+	0x9a, 0x04, 0x87, 0x98, 0xd7, 0xd4, 0xb0, 0x7d, 0xce, 0x8d, 0x12, 0x79, 0xb9, 0x1e, 0x13, 0xe2,
+	0xbe, 0xc2, 0x71, 0x9f, 0x27, 0x67, 0xab, 0xe0, 0x26, 0x7f, 0x29, 0xc1, 0x91, 0xac, 0x1a, 0x57,
+	0x52, 0xe8, 0xac, 0x05, 0xa5, 0xc2, 0xf2, 0xad, 0xfa, 0x8c, 0x88, 0xfc, 0x1a, 0x47, 0x7e, 0x95,
+	0x5c, 0xae, 0x64, 0x71, 0x97, 0x8b, 0x22, 0xdf, 0xf4, 0x5d, 0x3d, 0x56, 0xbe, 0x5f, 0xea, 0xea,
+	0x59, 0xb7, 0x19, 0xe4, 0xe5, 0x7a, 0x4c, 0x08, 0x7a, 0x89, 0x83, 0xbe, 0x42, 0x2e, 0xa9, 0x15,
+	0xae, 0xb7, 0xaa, 0xcf, 0x76, 0x69, 0xff, 0x79, 0xe0, 0x23, 0x35, 0x40, 0xe7, 0xdc, 0x55, 0x91,
+	0x97, 0xeb, 0x31, 0x55, 0xf4, 0x91, 0x18, 0x68, 0xf2, 0x17, 0x12, 0x1c, 0xce, 0xb8, 0x69, 0x51,
+	0x1c, 0x07, 0xf3, 0xaf, 0x8d, 0xc8, 0x37, 0x6b, 0xf3, 0x55, 0x0c, 0x2b, 0x31, 0xd8, 0xae, 0xba,
+	0xc5, 0x45, 0x91, 0x3f, 0x95, 0x60, 0x2a, 0x79, 0xcb, 0xa1, 0xc4, 0xd8, 0xd9, 0xd7, 0x32, 0xe4,
+	0xe5, 0x7a, 0x4c, 0x88, 0xfa, 0x93, 0x1c, 0xf5, 0x32, 0x59, 0xca, 0x5b, 0x37, 0x7d, 0x46, 0xad,
+	0x2b, 0x38, 0x23, 0x6b, 0xd0, 0x5f, 0x4b, 0x70, 0x34, 0xb3, 0x12, 0x99, 0xdc, 0x2a, 0xf1, 0xd6,
+	0xdc, 0x9a, 0x57, 0xf9, 0xf6, 0x1e, 0x38, 0x51, 0x95, 0x9b, 0x5c, 0x95, 0x45, 0xa2, 0xaa, 0x55,
+	0x2f, 0xb3, 0xa3, 0xc7, 0x7f, 0x47, 0x82, 0x63, 0xcc, 0xe3, 0xeb, 0x2a, 0x52, 0x54, 0xfe, 0x2c,
+	0xdf, 0xde, 0x03, 0x67, 0xc5, 0x7c, 0x2b, 0xad, 0x08, 0x79, 0x5f, 0x82, 0xe9, 0xbc, 0x9a, 0x5d,
+	0x72, 0xa7, 0xdc, 0xa5, 0xf3, 0xf5, 0x78, 0x65, 0x6f, 0xcc, 0x15, 0x33, 0x9c, 0xb4, 0x2a, 0xc1,
+	0xcc, 0xf8, 0x8e, 0x04, 0x47, 0xb2, 0xca, 0x6f, 0xc9, 0xcd, 0xd2, 0x50, 0x98, 0x5d, 0xf0, 0x29,
+	0xdf, 0xaa, 0xcf, 0x58, 0x71, 0xb9, 0x4d, 0x95, 0x3e, 0xaa, 0xcf, 0x8c, 0xf6, 0x73, 0x16, 0x9b,
+	0x8e, 0xfa, 0xa1, 0xb4, 0x96, 0x0e, 0x05, 0x15, 0xbf, 0xf2, 0xad, 0xfa, 0x8c, 0xa8, 0xc3, 0x02,
+	0xd7, 0xe1, 0x12, 0x99, 0xab, 0xaa, 0x03, 0xf9, 0x3b, 0x09, 0x8e, 0xe7, 0x14, 0x90, 0x16, 0xa7,
+	0x3c, 0xc5, 0x85, 0xb7, 0xf2, 0x9d, 0x3d, 0xf1, 0xa2, 0x1a, 0xb7, 0xb8, 0x1a, 0x4b, 0x64, 0xa1,
+	0xaa, 0x1a, 0x81, 0x43, 0x7d, 0x5b, 0x82, 0x43, 0xa9, 0xf2, 0xd0, 0xe2, 0x9d, 0x54, 0x5e, 0xbd,
+	0xa9, 0x7c, 0xbd, 0x26, 0x57, 0xc5, 0xf5, 0x38, 0x5a, 0x51, 0xaa, 0x62, 0x39, 0x32, 0x83, 0x9d,
+	0xaa, 0xd4, 0x2c, 0x86, 0x9d, 0x57, 0x0f, 0x2a, 0x5f, 0xaf, 0xc9, 0x55, 0x2b, 0x8d, 0xe0, 0x87,
+	0xe8, 0xaa, 0xb8, 0x7c, 0xf6, 0x65, 0x09, 0xc6, 0x22, 0xf1, 0xba, 0x78, 0x07, 0x98, 0x2e, 0x09,
+	0x95, 0xd5, 0xca, 0xf4, 0x15, 0xd3, 0x06, 0x11, 0x6a, 0xfc, 0xa9, 0xf9, 0xae, 0x04, 0xe3, 0xd1,
+	0x98, 0x4f, 0xe6, 0x2b, 0xc6, 0xeb, 0x6a, 0x3b, 0xd4, 0x74, 0xd1, 0xa7, 0x72, 0x81, 0xe3, 0x9b,
+	0x25, 0xa7, 0x4a, 0xf0, 0x91, 0x7f, 0x93, 0x60, 0x3a, 0xaf, 0xf4, 0xb1, 0x38, 0x96, 0x97, 0x94,
+	0x70, 0xca, 0xaf, 0xec, 0x8d, 0x19, 0x15, 0xb8, 0xcb, 0x15, 0xf8, 0x14, 0x79, 0xa5, 0xd4, 0xc0,
+	0x91, 0xd3, 0xb0, 0xe7, 0x6a, 0xe2, 0xee, 0xd8, 0x6f, 0x48, 0x30, 0x1e, 0xad, 0x4c, 0x2c, 0x3e,
+	0x7b, 0xc9, 0x28, 0x9f, 0x94, 0x17, 0xaa, 0x33, 0x20, 0xf2, 0xcb, 0x1c, 0xf9, 0x39, 0x72, 0x46,
+	0x2d, 0xfd, 0x85, 0x21, 0x97, 0xed, 0xac, 0x49, 0xba, 0x3e, 0x8f, 0x5c, 0xaf, 0xd8, 0x6b, 0xbc,
+	0xc0, 0x4c, 0xbe, 0x51, 0x97, 0xad, 0xe2, 0x76, 0x23, 0x0a, 0x59, 0xdd, 0x41, 0x8c, 0xef, 0x49,
+	0x70, 0x34, 0xb3, 0x36, 0xae, 0x38, 0x8f, 0x29, 0xaa, 0xeb, 0x93, 0x6f, 0xef, 0x81, 0xb3, 0xe2,
+	0xc9, 0x80, 0xf8, 0xb5, 0x20, 0x55, 0x94, 0xea, 0xfc, 0x81, 0x04, 0x07, 0x13, 0xa5, 0x72, 0x64,
+	0xa9, 0xa8, 0xff, 0xec, 0xaa, 0x3e, 0xf9, 0x5a, 0x2d, 0x9e, 0xba, 0x68, 0x85, 0xb5, 0x7f, 0x53,
+	0x82, 0xf1, 0x68, 0xd1, 0x56, 0xb1, 0x27, 0x67, 0xd4, 0xd3, 0xc9, 0x0b, 0xd5, 0x19, 0xaa, 0x06,
+	0xb9, 0x68, 0xc5, 0x19, 0xf9, 0x1d, 0x09, 0x26, 0x1e, 0xc5, 0x4a, 0xc8, 0x2a, 0xf7, 0x18, 0xcc,
+	0xb6, 0xc5, 0x1a, 0x1c, 0x08, 0xf2, 0x2a, 0x07, 0x79, 0x81, 0x9c, 0xab, 0x02, 0xd2, 0x25, 0xbf,
+	0x8b, 0x28, 0xc3, 0xca, 0xaf, 0x52, 0x94, 0xc9, 0xaa, 0x00, 0x79, 0xb1, 0x06, 0x07, 0xa2, 0x9c,
+	0xe7, 0x28, 0xe7, 0xc8, 0x79, 0xb5, 0xd2, 0xaf, 0x54, 0xf1, 0xe1, 0x8e, 0xd6, 0x50, 0x15, 0x0f,
+	0x77, 0x46, 0x49, 0x97, 0xbc, 0x50, 0x9d, 0xa1, 0xe2, 0x70, 0xc7, 0x6a, 0xb7, 0xf8, 0x70, 0xc7,
+	0xca, 0x75, 0x8a, 0x0d, 0x99, 0x55, 0x5c, 0x25, 0x2f, 0xd6, 0xe0, 0xa8, 0x38, 0xdc, 0xf1, 0x7a,
+	0x23, 0xf2, 0x15, 0x09, 0x26, 0x57, 0xe2, 0xf5, 0x43, 0xd5, 0x3b, 0x0d, 0x6c, 0xb9, 0x54, 0x87,
+	0xa5, 0xe2, 0x88, 0xc7, 0x81, 0xba, 0xe4, 0xab, 0x12, 0x4c, 0xc6, 0xab, 0x82, 0x8a, 0x91, 0x66,
+	0x56, 0x2d, 0xc9, 0x4b, 0x75, 0x58, 0x2a, 0xc6, 0x22, 0xde, 0xaa, 0x85, 0x3f, 0x1e, 0xc7, 0x9d,
+	0x33, 0x5a, 0xdb, 0x53, 0xec, 0x9c, 0x19, 0x95, 0x48, 0xf2, 0x42, 0x75, 0x86, 0x8a, 0xce, 0xc9,
+	0xe0, 0x85, 0xc5, 0x41, 0xbf, 0x85, 0x08, 0x83, 0x49, 0x5e, 0x8a, 0x30, 0x39, 0xc7, 0x17, 0xaa,
+	0x33, 0x54, 0xf4, 0x4c, 0x8e, 0x30, 0x9c, 0xe1, 0x2c, 0x10, 0x45, 0xe5, 0x94, 0x84, 0xcb, 0xac,
+	0x4a, 0x1f, 0x79, 0xb1, 0x06, 0x47, 0x45, 0xb7, 0x8c, 0xa3, 0x74, 0xc9, 0x17, 0x25, 0x38, 0x20,
+	0xaa, 0x39, 0xc8, 0xe5, 0xc2, 0xbd, 0x54, 0xbc, 0xc0, 0x46, 0xbe, 0x52, 0x8d, 0x18, 0x71, 0xcd,
+	0x71, 0x5c, 0x0a, 0x39, 0xad, 0xe6, 0xff, 0xd0, 0x21, 0x7f, 0x43, 0xbe, 0x24, 0xc1, 0xe8, 0xbd,
+	0xa0, 0x9e, 0xa4, 0x52, 0x2f, 0x81, 0xc1, 0xae, 0x56, 0xa4, 0x46, 0x50, 0x17, 0x39, 0xa8, 0x33,
+	0x64, 0xb6, 0x0c, 0x94, 0x4b, 0xbe, 0x21, 0xc1, 0x54, 0xb2, 0x50, 0xa3, 0xf8, 0x68, 0x2d, 0xa7,
+	0x70, 0x45, 0x5e, 0xae, 0xc7, 0x54, 0x71, 0xc3, 0x9d, 0xfa, 0x15, 0x4a, 0x1e, 0xc0, 0x63, 0x35,
+	0x14, 0xc5, 0x0e, 0x98, 0x55, 0xb5, 0x21, 0x2f, 0xd6, 0xe0, 0xa8, 0x38, 0x4d, 0xcc, 0x90, 0x4b,
+	0xd3, 0x3d, 0xf2, 0x75, 0x96, 0xf7, 0x44, 0x4a, 0x1e, 0x4a, 0xf2, 0x9e, 0x74, 0x89, 0x86, 0xbc,
+	0x50, 0x9d, 0xa1, 0xe2, 0x31, 0x65, 0xf4, 0x27, 0x2d, 0xdd, 0xf8, 0x06, 0x84, 0x7c, 0x4f, 0x02,
+	0x92, 0xae, 0x38, 0x28, 0x4e, 0xe8, 0x73, 0xab, 0x25, 0xe4, 0x1b, 0x75, 0xd9, 0x50, 0x83, 0x15,
+	0xae, 0xc1, 0x1d, 0x72, 0xbb, 0x82, 0x06, 0x1a, 0xd6, 0x30, 0x24, 0x14, 0x79, 0x4f, 0x82, 0xa9,
+	0xe4, 0x97, 0xff, 0x62, 0x87, 0xce, 0xa9, 0x58, 0x90, 0x97, 0xeb, 0x31, 0xa1, 0x0a, 0x9f, 0xe2,
+	0x2a, 0xdc, 0x22, 0x37, 0xf2, 0x56, 0x25, 0x64, 0xd4, 0x44, 0x11, 0x41, 0x1c, 0xff, 0xea, 0xf2,
+	0xf7, 0x3f, 0x98, 0x91, 0xde, 0xff, 0x60, 0x46, 0xfa, 0xcf, 0x0f, 0x66, 0xa4, 0x5f, 0xfe, 0x70,
+	0xe6, 0xa5, 0xf7, 0x3f, 0x9c, 0x79, 0xe9, 0x5f, 0x3f, 0x9c, 0x79, 0xe9, 0x27, 0xe4, 0x88, 0xc0,
+	0xa7, 0x81, 0x48, 0xaf, 0xdf, 0xa5, 0xee, 0xe6, 0x08, 0xff, 0x85, 0xcc, 0x6b, 0xff, 0x3f, 0x00,
+	0x11, 0x3c, 0xba, 0x92, 0xf7, 0x56, 0x00, 0x00,
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CreatorQuota reports an allowlisted creator's remaining token creation quota.
+	CreatorQuota(ctx context.Context, in *QueryCreatorQuotaRequest, opts ...grpc.CallOption) (*QueryCreatorQuotaResponse, error)
+	// EffectiveMinimums reports the minimums enforced for the configured network mode.
+	EffectiveMinimums(ctx context.Context, in *QueryEffectiveMinimumsRequest, opts ...grpc.CallOption) (*QueryEffectiveMinimumsResponse, error)
+	// Authorities returns the module authority and the scoped loyalty role holders.
+	Authorities(ctx context.Context, in *QueryAuthoritiesRequest, opts ...grpc.CallOption) (*QueryAuthoritiesResponse, error)
+	// ListCreatorallowlist Queries a list of Creatorallowlist items.
+	GetCreatorallowlist(ctx context.Context, in *QueryGetCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryGetCreatorallowlistResponse, error)
+	// ListCreatorallowlist defines the ListCreatorallowlist RPC.
+	ListCreatorallowlist(ctx context.Context, in *QueryAllCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryAllCreatorallowlistResponse, error)
+	// ListVerifiedtoken Queries a list of Verifiedtoken items.
+	GetVerifiedtoken(ctx context.Context, in *QueryGetVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error)
+	// GetVerifiedtokenByDenom fetches a verified token using denom as a query parameter.
+	GetVerifiedtokenByDenom(ctx context.Context, in *QueryGetVerifiedtokenByDenomRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error)
+	// ListVerifiedtoken defines the ListVerifiedtoken RPC.
+	ListVerifiedtoken(ctx context.Context, in *QueryAllVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryAllVerifiedtokenResponse, error)
+	// SearchVerifiedtokens lists the verified tokens matching the given filters with
+	// their supply, reward pool and staker stats. Pages are cursor based.
+	SearchVerifiedtokens(ctx context.Context, in *QuerySearchVerifiedtokensRequest, opts ...grpc.CallOption) (*QuerySearchVerifiedtokensResponse, error)
+	// ListRewardaccrual Queries a list of Rewardaccrual items.
+	GetRewardaccrual(ctx context.Context, in *QueryGetRewardaccrualRequest, opts ...grpc.CallOption) (*QueryGetRewardaccrualResponse, error)
+	// ListRewardaccrual defines the ListRewardaccrual RPC.
+	ListRewardaccrual(ctx context.Context, in *QueryAllRewardaccrualRequest, opts ...grpc.CallOption) (*QueryAllRewardaccrualResponse, error)
+	// FilterRewardaccrual returns reward accrual records filtered by address/denom fields.
+	FilterRewardaccrual(ctx context.Context, in *QueryFilterRewardaccrualRequest, opts ...grpc.CallOption) (*QueryFilterRewardaccrualResponse, error)
+	// AccountPortfolio returns, per verified token an address holds, has accrued or
+	// faces a queued recovery in, its balance, claimable reward, reward pool cover,
+	// pending recoveries and token metadata. Pages are cursor based by denom.
+	AccountPortfolio(ctx context.Context, in *QueryAccountPortfolioRequest, opts ...grpc.CallOption) (*QueryAccountPortfolioResponse, error)
+	// ListMerchantallocation Queries a list of Merchantallocation items.
+	GetMerchantallocation(ctx context.Context, in *QueryGetMerchantallocationRequest, opts ...grpc.CallOption) (*QueryGetMerchantallocationResponse, error)
+	// ListMerchantallocation defines the ListMerchantallocation RPC.
+	ListMerchantallocation(ctx context.Context, in *QueryAllMerchantallocationRequest, opts ...grpc.CallOption) (*QueryAllMerchantallocationResponse, error)
+	// FilterMerchantallocation returns merchant allocation records filtered by date/denom fields.
+	FilterMerchantallocation(ctx context.Context, in *QueryFilterMerchantallocationRequest, opts ...grpc.CallOption) (*QueryFilterMerchantallocationResponse, error)
+	// ListRecoveryoperation Queries a list of Recoveryoperation items.
+	GetRecoveryoperation(ctx context.Context, in *QueryGetRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryGetRecoveryoperationResponse, error)
+	// ListRecoveryoperation defines the ListRecoveryoperation RPC.
+	ListRecoveryoperation(ctx context.Context, in *QueryAllRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryAllRecoveryoperationResponse, error)
+	// FilterRecoveryoperation returns recovery operations filtered by status/token/address fields.
+	FilterRecoveryoperation(ctx context.Context, in *QueryFilterRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryFilterRecoveryoperationResponse, error)
+	// DailyRollupStatus returns rollup boundary status for the configured timezone.
+	DailyRollupStatus(ctx context.Context, in *QueryDailyRollupStatusRequest, opts ...grpc.CallOption) (*QueryDailyRollupStatusResponse, error)
+	// RewardPoolBalance returns the loyalty module spendable balance for a denom.
+	RewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error)
+	// GetMerchant Queries a merchant profile by id.
+	GetMerchant(ctx context.Context, in *QueryGetMerchantRequest, opts ...grpc.CallOption) (*QueryGetMerchantResponse, error)
+	// ListMerchant Queries a list of merchant profiles.
+	ListMerchant(ctx context.Context, in *QueryAllMerchantRequest, opts ...grpc.CallOption) (*QueryAllMerchantResponse, error)
+	// VerifiedtokensByMerchant lists the verified tokens linked to a merchant.
+	VerifiedtokensByMerchant(ctx context.Context, in *QueryVerifiedtokensByMerchantRequest, opts ...grpc.CallOption) (*QueryVerifiedtokensByMerchantResponse, error)
+	// Attestations lists the current verifier attestations for a token, including
+	// whether each one still counts towards verification.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// AttestationHistory lists every attestation, revocation and expiry recorded for a token.
+	AttestationHistory(ctx context.Context, in *QueryAttestationHistoryRequest, opts ...grpc.CallOption) (*QueryAttestationHistoryResponse, error)
+	// PendingMetadataChange returns the scheduled metadata change for a token, if any.
+	PendingMetadataChange(ctx context.Context, in *QueryPendingMetadataChangeRequest, opts ...grpc.CallOption) (*QueryPendingMetadataChangeResponse, error)
+	// MetadataHistory lists the recorded metadata versions of a token, oldest first.
+	MetadataHistory(ctx context.Context, in *QueryMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryMetadataHistoryResponse, error)
+	// MintSchedule returns one mint schedule of a token.
+	MintSchedule(ctx context.Context, in *QueryMintScheduleRequest, opts ...grpc.CallOption) (*QueryMintScheduleResponse, error)
+	// MintSchedules lists the active mint schedules, optionally for one token.
+	MintSchedules(ctx context.Context, in *QueryMintSchedulesRequest, opts ...grpc.CallOption) (*QueryMintSchedulesResponse, error)
+	// MintRateLimit returns a token's mint rate limit, any pending loosening and its
+	// current usage.
+	MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error)
+	// PausedTokens lists the verified tokens with an active pause.
+	PausedTokens(ctx context.Context, in *QueryPausedTokensRequest, opts ...grpc.CallOption) (*QueryPausedTokensResponse, error)
+	// AddressFreeze returns the freeze of one address for a token, if any.
+	AddressFreeze(ctx context.Context, in *QueryAddressFreezeRequest, opts ...grpc.CallOption) (*QueryAddressFreezeResponse, error)
+	// AddressFreezes lists address freezes in force, optionally for one token.
+	AddressFreezes(ctx context.Context, in *QueryAddressFreezesRequest, opts ...grpc.CallOption) (*QueryAddressFreezesResponse, error)
+	// TokenIBCPolicy returns the IBC policy in force for a verified token and the
+	// amount of it escrowed for IBC transfers.
+	TokenIBCPolicy(ctx context.Context, in *QueryTokenIBCPolicyRequest, opts ...grpc.CallOption) (*QueryTokenIBCPolicyResponse, error)
+	// IBCRecorders lists the counterparty senders allowed to send loyalty memos,
+	// optionally for one channel.
+	IBCRecorders(ctx context.Context, in *QueryIBCRecordersRequest, opts ...grpc.CallOption) (*QueryIBCRecordersResponse, error)
+	// IBCRateLimit returns the quotas of a denom on a channel and how much of them
+	// is used in the current window.
+	IBCRateLimit(ctx context.Context, in *QueryIBCRateLimitRequest, opts ...grpc.CallOption) (*QueryIBCRateLimitResponse, error)
+	// IBCRateLimits lists rate limits with their usage, optionally for one denom.
+	IBCRateLimits(ctx context.Context, in *QueryIBCRateLimitsRequest, opts ...grpc.CallOption) (*QueryIBCRateLimitsResponse, error)
+	// FeeToken returns the fee settings and gas pool of a verified token.
+	FeeToken(ctx context.Context, in *QueryFeeTokenRequest, opts ...grpc.CallOption) (*QueryFeeTokenResponse, error)
+	// FeeTokens lists the verified tokens that can pay transaction fees.
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// ClaimSponsorship returns the claim sponsorship of a verified token, what is left
+	// of its budget today and, for an address, what is left of its allowance.
+	ClaimSponsorship(ctx context.Context, in *QueryClaimSponsorshipRequest, opts ...grpc.CallOption) (*QueryClaimSponsorshipResponse, error)
+	// LiabilitiesAt returns the reward liability of a verified token at the close of
+	// a past rollup date, from the checkpoints written by the daily rollup.
+	LiabilitiesAt(ctx context.Context, in *QueryLiabilitiesAtRequest, opts ...grpc.CallOption) (*QueryLiabilitiesAtResponse, error)
+	// MerchantICAs lists the interchain accounts of a merchant.
+	MerchantICAs(ctx context.Context, in *QueryMerchantICAsRequest, opts ...grpc.CallOption) (*QueryMerchantICAsResponse, error)
+	// MerchantICAPackets lists the packets sent for a merchant that are still in flight.
+	MerchantICAPackets(ctx context.Context, in *QueryMerchantICAPacketsRequest, opts ...grpc.CallOption) (*QueryMerchantICAPacketsResponse, error)
+	// TreasuryForwards lists the treasury forwards of a merchant.
+	TreasuryForwards(ctx context.Context, in *QueryTreasuryForwardsRequest, opts ...grpc.CallOption) (*QueryTreasuryForwardsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreatorQuota(ctx context.Context, in *QueryCreatorQuotaRequest, opts ...grpc.CallOption) (*QueryCreatorQuotaResponse, error) {
+	out := new(QueryCreatorQuotaResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/CreatorQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveMinimums(ctx context.Context, in *QueryEffectiveMinimumsRequest, opts ...grpc.CallOption) (*QueryEffectiveMinimumsResponse, error) {
+	out := new(QueryEffectiveMinimumsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/EffectiveMinimums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Authorities(ctx context.Context, in *QueryAuthoritiesRequest, opts ...grpc.CallOption) (*QueryAuthoritiesResponse, error) {
+	out := new(QueryAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/Authorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCreatorallowlist(ctx context.Context, in *QueryGetCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryGetCreatorallowlistResponse, error) {
+	out := new(QueryGetCreatorallowlistResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetCreatorallowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListCreatorallowlist(ctx context.Context, in *QueryAllCreatorallowlistRequest, opts ...grpc.CallOption) (*QueryAllCreatorallowlistResponse, error) {
+	out := new(QueryAllCreatorallowlistResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListCreatorallowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVerifiedtoken(ctx context.Context, in *QueryGetVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error) {
+	out := new(QueryGetVerifiedtokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetVerifiedtoken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVerifiedtokenByDenom(ctx context.Context, in *QueryGetVerifiedtokenByDenomRequest, opts ...grpc.CallOption) (*QueryGetVerifiedtokenResponse, error) {
+	out := new(QueryGetVerifiedtokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetVerifiedtokenByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListVerifiedtoken(ctx context.Context, in *QueryAllVerifiedtokenRequest, opts ...grpc.CallOption) (*QueryAllVerifiedtokenResponse, error) {
+	out := new(QueryAllVerifiedtokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListVerifiedtoken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchVerifiedtokens(ctx context.Context, in *QuerySearchVerifiedtokensRequest, opts ...grpc.CallOption) (*QuerySearchVerifiedtokensResponse, error) {
+	out := new(QuerySearchVerifiedtokensResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/SearchVerifiedtokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRewardaccrual(ctx context.Context, in *QueryGetRewardaccrualRequest, opts ...grpc.CallOption) (*QueryGetRewardaccrualResponse, error) {
+	out := new(QueryGetRewardaccrualResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetRewardaccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRewardaccrual(ctx context.Context, in *QueryAllRewardaccrualRequest, opts ...grpc.CallOption) (*QueryAllRewardaccrualResponse, error) {
+	out := new(QueryAllRewardaccrualResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListRewardaccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FilterRewardaccrual(ctx context.Context, in *QueryFilterRewardaccrualRequest, opts ...grpc.CallOption) (*QueryFilterRewardaccrualResponse, error) {
+	out := new(QueryFilterRewardaccrualResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FilterRewardaccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountPortfolio(ctx context.Context, in *QueryAccountPortfolioRequest, opts ...grpc.CallOption) (*QueryAccountPortfolioResponse, error) {
+	out := new(QueryAccountPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/AccountPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMerchantallocation(ctx context.Context, in *QueryGetMerchantallocationRequest, opts ...grpc.CallOption) (*QueryGetMerchantallocationResponse, error) {
+	out := new(QueryGetMerchantallocationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetMerchantallocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListMerchantallocation(ctx context.Context, in *QueryAllMerchantallocationRequest, opts ...grpc.CallOption) (*QueryAllMerchantallocationResponse, error) {
+	out := new(QueryAllMerchantallocationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListMerchantallocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FilterMerchantallocation(ctx context.Context, in *QueryFilterMerchantallocationRequest, opts ...grpc.CallOption) (*QueryFilterMerchantallocationResponse, error) {
+	out := new(QueryFilterMerchantallocationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FilterMerchantallocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecoveryoperation(ctx context.Context, in *QueryGetRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryGetRecoveryoperationResponse, error) {
+	out := new(QueryGetRecoveryoperationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetRecoveryoperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRecoveryoperation(ctx context.Context, in *QueryAllRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryAllRecoveryoperationResponse, error) {
+	out := new(QueryAllRecoveryoperationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListRecoveryoperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FilterRecoveryoperation(ctx context.Context, in *QueryFilterRecoveryoperationRequest, opts ...grpc.CallOption) (*QueryFilterRecoveryoperationResponse, error) {
+	out := new(QueryFilterRecoveryoperationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FilterRecoveryoperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DailyRollupStatus(ctx context.Context, in *QueryDailyRollupStatusRequest, opts ...grpc.CallOption) (*QueryDailyRollupStatusResponse, error) {
+	out := new(QueryDailyRollupStatusResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/DailyRollupStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error) {
+	out := new(QueryRewardPoolBalanceResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/RewardPoolBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMerchant(ctx context.Context, in *QueryGetMerchantRequest, opts ...grpc.CallOption) (*QueryGetMerchantResponse, error) {
+	out := new(QueryGetMerchantResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/GetMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListMerchant(ctx context.Context, in *QueryAllMerchantRequest, opts ...grpc.CallOption) (*QueryAllMerchantResponse, error) {
+	out := new(QueryAllMerchantResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ListMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifiedtokensByMerchant(ctx context.Context, in *QueryVerifiedtokensByMerchantRequest, opts ...grpc.CallOption) (*QueryVerifiedtokensByMerchantResponse, error) {
+	out := new(QueryVerifiedtokensByMerchantResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/VerifiedtokensByMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationHistory(ctx context.Context, in *QueryAttestationHistoryRequest, opts ...grpc.CallOption) (*QueryAttestationHistoryResponse, error) {
+	out := new(QueryAttestationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/AttestationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMetadataChange(ctx context.Context, in *QueryPendingMetadataChangeRequest, opts ...grpc.CallOption) (*QueryPendingMetadataChangeResponse, error) {
+	out := new(QueryPendingMetadataChangeResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/PendingMetadataChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MetadataHistory(ctx context.Context, in *QueryMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryMetadataHistoryResponse, error) {
+	out := new(QueryMetadataHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/MetadataHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintSchedule(ctx context.Context, in *QueryMintScheduleRequest, opts ...grpc.CallOption) (*QueryMintScheduleResponse, error) {
+	out := new(QueryMintScheduleResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/MintSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintSchedules(ctx context.Context, in *QueryMintSchedulesRequest, opts ...grpc.CallOption) (*QueryMintSchedulesResponse, error) {
+	out := new(QueryMintSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/MintSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error) {
+	out := new(QueryMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/MintRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedTokens(ctx context.Context, in *QueryPausedTokensRequest, opts ...grpc.CallOption) (*QueryPausedTokensResponse, error) {
+	out := new(QueryPausedTokensResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/PausedTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressFreeze(ctx context.Context, in *QueryAddressFreezeRequest, opts ...grpc.CallOption) (*QueryAddressFreezeResponse, error) {
+	out := new(QueryAddressFreezeResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/AddressFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressFreezes(ctx context.Context, in *QueryAddressFreezesRequest, opts ...grpc.CallOption) (*QueryAddressFreezesResponse, error) {
+	out := new(QueryAddressFreezesResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/AddressFreezes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenIBCPolicy(ctx context.Context, in *QueryTokenIBCPolicyRequest, opts ...grpc.CallOption) (*QueryTokenIBCPolicyResponse, error) {
+	out := new(QueryTokenIBCPolicyResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/TokenIBCPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCRecorders(ctx context.Context, in *QueryIBCRecordersRequest, opts ...grpc.CallOption) (*QueryIBCRecordersResponse, error) {
+	out := new(QueryIBCRecordersResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/IBCRecorders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCRateLimit(ctx context.Context, in *QueryIBCRateLimitRequest, opts ...grpc.CallOption) (*QueryIBCRateLimitResponse, error) {
+	out := new(QueryIBCRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/IBCRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCRateLimits(ctx context.Context, in *QueryIBCRateLimitsRequest, opts ...grpc.CallOption) (*QueryIBCRateLimitsResponse, error) {
+	out := new(QueryIBCRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/IBCRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeToken(ctx context.Context, in *QueryFeeTokenRequest, opts ...grpc.CallOption) (*QueryFeeTokenResponse, error) {
+	out := new(QueryFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/FeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimSponsorship(ctx context.Context, in *QueryClaimSponsorshipRequest, opts ...grpc.CallOption) (*QueryClaimSponsorshipResponse, error) {
+	out := new(QueryClaimSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/ClaimSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiabilitiesAt(ctx context.Context, in *QueryLiabilitiesAtRequest, opts ...grpc.CallOption) (*QueryLiabilitiesAtResponse, error) {
+	out := new(QueryLiabilitiesAtResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/LiabilitiesAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerchantICAs(ctx context.Context, in *QueryMerchantICAsRequest, opts ...grpc.CallOption) (*QueryMerchantICAsResponse, error) {
+	out := new(QueryMerchantICAsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/MerchantICAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerchantICAPackets(ctx context.Context, in *QueryMerchantICAPacketsRequest, opts ...grpc.CallOption) (*QueryMerchantICAPacketsResponse, error) {
+	out := new(QueryMerchantICAPacketsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/MerchantICAPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryForwards(ctx context.Context, in *QueryTreasuryForwardsRequest, opts ...grpc.CallOption) (*QueryTreasuryForwardsResponse, error) {
+	out := new(QueryTreasuryForwardsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Query/TreasuryForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CreatorQuota reports an allowlisted creator's remaining token creation quota.
+	CreatorQuota(context.Context, *QueryCreatorQuotaRequest) (*QueryCreatorQuotaResponse, error)
+	// EffectiveMinimums reports the minimums enforced for the configured network mode.
+	EffectiveMinimums(context.Context, *QueryEffectiveMinimumsRequest) (*QueryEffectiveMinimumsResponse, error)
+	// Authorities returns the module authority and the scoped loyalty role holders.
+	Authorities(context.Context, *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error)
+	// ListCreatorallowlist Queries a list of Creatorallowlist items.
+	GetCreatorallowlist(context.Context, *QueryGetCreatorallowlistRequest) (*QueryGetCreatorallowlistResponse, error)
+	// ListCreatorallowlist defines the ListCreatorallowlist RPC.
+	ListCreatorallowlist(context.Context, *QueryAllCreatorallowlistRequest) (*QueryAllCreatorallowlistResponse, error)
+	// ListVerifiedtoken Queries a list of Verifiedtoken items.
+	GetVerifiedtoken(context.Context, *QueryGetVerifiedtokenRequest) (*QueryGetVerifiedtokenResponse, error)
+	// GetVerifiedtokenByDenom fetches a verified token using denom as a query parameter.
+	GetVerifiedtokenByDenom(context.Context, *QueryGetVerifiedtokenByDenomRequest) (*QueryGetVerifiedtokenResponse, error)
+	// ListVerifiedtoken defines the ListVerifiedtoken RPC.
+	ListVerifiedtoken(context.Context, *QueryAllVerifiedtokenRequest) (*QueryAllVerifiedtokenResponse, error)
+	// SearchVerifiedtokens lists the verified tokens matching the given filters with
+	// their supply, reward pool and staker stats. Pages are cursor based.
+	SearchVerifiedtokens(context.Context, *QuerySearchVerifiedtokensRequest) (*QuerySearchVerifiedtokensResponse, error)
+	// ListRewardaccrual Queries a list of Rewardaccrual items.
+	GetRewardaccrual(context.Context, *QueryGetRewardaccrualRequest) (*QueryGetRewardaccrualResponse, error)
+	// ListRewardaccrual defines the ListRewardaccrual RPC.
+	ListRewardaccrual(context.Context, *QueryAllRewardaccrualRequest) (*QueryAllRewardaccrualResponse, error)
+	// FilterRewardaccrual returns reward accrual records filtered by address/denom fields.
+	FilterRewardaccrual(context.Context, *QueryFilterRewardaccrualRequest) (*QueryFilterRewardaccrualResponse, error)
+	// AccountPortfolio returns, per verified token an address holds, has accrued or
+	// faces a queued recovery in, its balance, claimable reward, reward pool cover,
+	// pending recoveries and token metadata. Pages are cursor based by denom.
+	AccountPortfolio(context.Context, *QueryAccountPortfolioRequest) (*QueryAccountPortfolioResponse, error)
+	// ListMerchantallocation Queries a list of Merchantallocation items.
+	GetMerchantallocation(context.Context, *QueryGetMerchantallocationRequest) (*QueryGetMerchantallocationResponse, error)
+	// ListMerchantallocation defines the ListMerchantallocation RPC.
+	ListMerchantallocation(context.Context, *QueryAllMerchantallocationRequest) (*QueryAllMerchantallocationResponse, error)
+	// FilterMerchantallocation returns merchant allocation records filtered by date/denom fields.
+	FilterMerchantallocation(context.Context, *QueryFilterMerchantallocationRequest) (*QueryFilterMerchantallocationResponse, error)
+	// ListRecoveryoperation Queries a list of Recoveryoperation items.
+	GetRecoveryoperation(context.Context, *QueryGetRecoveryoperationRequest) (*QueryGetRecoveryoperationResponse, error)
+	// ListRecoveryoperation defines the ListRecoveryoperation RPC.
+	ListRecoveryoperation(context.Context, *QueryAllRecoveryoperationRequest) (*QueryAllRecoveryoperationResponse, error)
+	// FilterRecoveryoperation returns recovery operations filtered by status/token/address fields.
+	FilterRecoveryoperation(context.Context, *QueryFilterRecoveryoperationRequest) (*QueryFilterRecoveryoperationResponse, error)
+	// DailyRollupStatus returns rollup boundary status for the configured timezone.
+	DailyRollupStatus(context.Context, *QueryDailyRollupStatusRequest) (*QueryDailyRollupStatusResponse, error)
+	// RewardPoolBalance returns the loyalty module spendable balance for a denom.
+	RewardPoolBalance(context.Context, *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error)
+	// GetMerchant Queries a merchant profile by id.
+	GetMerchant(context.Context, *QueryGetMerchantRequest) (*QueryGetMerchantResponse, error)
+	// ListMerchant Queries a list of merchant profiles.
+	ListMerchant(context.Context, *QueryAllMerchantRequest) (*QueryAllMerchantResponse, error)
+	// VerifiedtokensByMerchant lists the verified tokens linked to a merchant.
+	VerifiedtokensByMerchant(context.Context, *QueryVerifiedtokensByMerchantRequest) (*QueryVerifiedtokensByMerchantResponse, error)
+	// Attestations lists the current verifier attestations for a token, including
+	// whether each one still counts towards verification.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	// AttestationHistory lists every attestation, revocation and expiry recorded for a token.
+	AttestationHistory(context.Context, *QueryAttestationHistoryRequest) (*QueryAttestationHistoryResponse, error)
+	// PendingMetadataChange returns the scheduled metadata change for a token, if any.
+	PendingMetadataChange(context.Context, *QueryPendingMetadataChangeRequest) (*QueryPendingMetadataChangeResponse, error)
+	// MetadataHistory lists the recorded metadata versions of a token, oldest first.
+	MetadataHistory(context.Context, *QueryMetadataHistoryRequest) (*QueryMetadataHistoryResponse, error)
+	// MintSchedule returns one mint schedule of a token.
+	MintSchedule(context.Context, *QueryMintScheduleRequest) (*QueryMintScheduleResponse, error)
+	// MintSchedules lists the active mint schedules, optionally for one token.
+	MintSchedules(context.Context, *QueryMintSchedulesRequest) (*QueryMintSchedulesResponse, error)
+	// MintRateLimit returns a token's mint rate limit, any pending loosening and its
+	// current usage.
+	MintRateLimit(context.Context, *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error)
+	// PausedTokens lists the verified tokens with an active pause.
+	PausedTokens(context.Context, *QueryPausedTokensRequest) (*QueryPausedTokensResponse, error)
+	// AddressFreeze returns the freeze of one address for a token, if any.
+	AddressFreeze(context.Context, *QueryAddressFreezeRequest) (*QueryAddressFreezeResponse, error)
+	// AddressFreezes lists address freezes in force, optionally for one token.
+	AddressFreezes(context.Context, *QueryAddressFreezesRequest) (*QueryAddressFreezesResponse, error)
+	// TokenIBCPolicy returns the IBC policy in force for a verified token and the
+	// amount of it escrowed for IBC transfers.
+	TokenIBCPolicy(context.Context, *QueryTokenIBCPolicyRequest) (*QueryTokenIBCPolicyResponse, error)
+	// IBCRecorders lists the counterparty senders allowed to send loyalty memos,
+	// optionally for one channel.
+	IBCRecorders(context.Context, *QueryIBCRecordersRequest) (*QueryIBCRecordersResponse, error)
+	// IBCRateLimit returns the quotas of a denom on a channel and how much of them
+	// is used in the current window.
+	IBCRateLimit(context.Context, *QueryIBCRateLimitRequest) (*QueryIBCRateLimitResponse, error)
+	// IBCRateLimits lists rate limits with their usage, optionally for one denom.
+	IBCRateLimits(context.Context, *QueryIBCRateLimitsRequest) (*QueryIBCRateLimitsResponse, error)
+	// FeeToken returns the fee settings and gas pool of a verified token.
+	FeeToken(context.Context, *QueryFeeTokenRequest) (*QueryFeeTokenResponse, error)
+	// FeeTokens lists the verified tokens that can pay transaction fees.
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// ClaimSponsorship returns the claim sponsorship of a verified token, what is left
+	// of its budget today and, for an address, what is left of its allowance.
+	ClaimSponsorship(context.Context, *QueryClaimSponsorshipRequest) (*QueryClaimSponsorshipResponse, error)
+	// LiabilitiesAt returns the reward liability of a verified token at the close of
+	// a past rollup date, from the checkpoints written by the daily rollup.
+	LiabilitiesAt(context.Context, *QueryLiabilitiesAtRequest) (*QueryLiabilitiesAtResponse, error)
+	// MerchantICAs lists the interchain accounts of a merchant.
+	MerchantICAs(context.Context, *QueryMerchantICAsRequest) (*QueryMerchantICAsResponse, error)
+	// MerchantICAPackets lists the packets sent for a merchant that are still in flight.
+	MerchantICAPackets(context.Context, *QueryMerchantICAPacketsRequest) (*QueryMerchantICAPacketsResponse, error)
+	// TreasuryForwards lists the treasury forwards of a merchant.
+	TreasuryForwards(context.Context, *QueryTreasuryForwardsRequest) (*QueryTreasuryForwardsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CreatorQuota(ctx context.Context, req *QueryCreatorQuotaRequest) (*QueryCreatorQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatorQuota not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveMinimums(ctx context.Context, req *QueryEffectiveMinimumsRequest) (*QueryEffectiveMinimumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveMinimums not implemented")
+}
+func (*UnimplementedQueryServer) Authorities(ctx context.Context, req *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorities not implemented")
+}
+func (*UnimplementedQueryServer) GetCreatorallowlist(ctx context.Context, req *QueryGetCreatorallowlistRequest) (*QueryGetCreatorallowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreatorallowlist not implemented")
+}
+func (*UnimplementedQueryServer) ListCreatorallowlist(ctx context.Context, req *QueryAllCreatorallowlistRequest) (*QueryAllCreatorallowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCreatorallowlist not implemented")
+}
+func (*UnimplementedQueryServer) GetVerifiedtoken(ctx context.Context, req *QueryGetVerifiedtokenRequest) (*QueryGetVerifiedtokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerifiedtoken not implemented")
+}
+func (*UnimplementedQueryServer) GetVerifiedtokenByDenom(ctx context.Context, req *QueryGetVerifiedtokenByDenomRequest) (*QueryGetVerifiedtokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerifiedtokenByDenom not implemented")
+}
+func (*UnimplementedQueryServer) ListVerifiedtoken(ctx context.Context, req *QueryAllVerifiedtokenRequest) (*QueryAllVerifiedtokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVerifiedtoken not implemented")
+}
+func (*UnimplementedQueryServer) SearchVerifiedtokens(ctx context.Context, req *QuerySearchVerifiedtokensRequest) (*QuerySearchVerifiedtokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchVerifiedtokens not implemented")
+}
+func (*UnimplementedQueryServer) GetRewardaccrual(ctx context.Context, req *QueryGetRewardaccrualRequest) (*QueryGetRewardaccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardaccrual not implemented")
+}
+func (*UnimplementedQueryServer) ListRewardaccrual(ctx context.Context, req *QueryAllRewardaccrualRequest) (*QueryAllRewardaccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRewardaccrual not implemented")
+}
+func (*UnimplementedQueryServer) FilterRewardaccrual(ctx context.Context, req *QueryFilterRewardaccrualRequest) (*QueryFilterRewardaccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterRewardaccrual not implemented")
+}
+func (*UnimplementedQueryServer) AccountPortfolio(ctx context.Context, req *QueryAccountPortfolioRequest) (*QueryAccountPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPortfolio not implemented")
+}
+func (*UnimplementedQueryServer) GetMerchantallocation(ctx context.Context, req *QueryGetMerchantallocationRequest) (*QueryGetMerchantallocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantallocation not implemented")
+}
+func (*UnimplementedQueryServer) ListMerchantallocation(ctx context.Context, req *QueryAllMerchantallocationRequest) (*QueryAllMerchantallocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerchantallocation not implemented")
+}
+func (*UnimplementedQueryServer) FilterMerchantallocation(ctx context.Context, req *QueryFilterMerchantallocationRequest) (*QueryFilterMerchantallocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterMerchantallocation not implemented")
+}
+func (*UnimplementedQueryServer) GetRecoveryoperation(ctx context.Context, req *QueryGetRecoveryoperationRequest) (*QueryGetRecoveryoperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryoperation not implemented")
+}
+func (*UnimplementedQueryServer) ListRecoveryoperation(ctx context.Context, req *QueryAllRecoveryoperationRequest) (*QueryAllRecoveryoperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecoveryoperation not implemented")
+}
+func (*UnimplementedQueryServer) FilterRecoveryoperation(ctx context.Context, req *QueryFilterRecoveryoperationRequest) (*QueryFilterRecoveryoperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterRecoveryoperation not implemented")
+}
+func (*UnimplementedQueryServer) DailyRollupStatus(ctx context.Context, req *QueryDailyRollupStatusRequest) (*QueryDailyRollupStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DailyRollupStatus not implemented")
+}
+func (*UnimplementedQueryServer) RewardPoolBalance(ctx context.Context, req *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPoolBalance not implemented")
+}
+func (*UnimplementedQueryServer) GetMerchant(ctx context.Context, req *QueryGetMerchantRequest) (*QueryGetMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchant not implemented")
+}
+func (*UnimplementedQueryServer) ListMerchant(ctx context.Context, req *QueryAllMerchantRequest) (*QueryAllMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerchant not implemented")
+}
+func (*UnimplementedQueryServer) VerifiedtokensByMerchant(ctx context.Context, req *QueryVerifiedtokensByMerchantRequest) (*QueryVerifiedtokensByMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiedtokensByMerchant not implemented")
+}
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (*UnimplementedQueryServer) AttestationHistory(ctx context.Context, req *QueryAttestationHistoryRequest) (*QueryAttestationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingMetadataChange(ctx context.Context, req *QueryPendingMetadataChangeRequest) (*QueryPendingMetadataChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMetadataChange not implemented")
+}
+func (*UnimplementedQueryServer) MetadataHistory(ctx context.Context, req *QueryMetadataHistoryRequest) (*QueryMetadataHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetadataHistory not implemented")
+}
+func (*UnimplementedQueryServer) MintSchedule(ctx context.Context, req *QueryMintScheduleRequest) (*QueryMintScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintSchedule not implemented")
+}
+func (*UnimplementedQueryServer) MintSchedules(ctx context.Context, req *QueryMintSchedulesRequest) (*QueryMintSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintSchedules not implemented")
+}
+func (*UnimplementedQueryServer) MintRateLimit(ctx context.Context, req *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) PausedTokens(ctx context.Context, req *QueryPausedTokensRequest) (*QueryPausedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedTokens not implemented")
+}
+func (*UnimplementedQueryServer) AddressFreeze(ctx context.Context, req *QueryAddressFreezeRequest) (*QueryAddressFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressFreeze not implemented")
+}
+func (*UnimplementedQueryServer) AddressFreezes(ctx context.Context, req *QueryAddressFreezesRequest) (*QueryAddressFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressFreezes not implemented")
+}
+func (*UnimplementedQueryServer) TokenIBCPolicy(ctx context.Context, req *QueryTokenIBCPolicyRequest) (*QueryTokenIBCPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenIBCPolicy not implemented")
+}
+func (*UnimplementedQueryServer) IBCRecorders(ctx context.Context, req *QueryIBCRecordersRequest) (*QueryIBCRecordersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCRecorders not implemented")
+}
+func (*UnimplementedQueryServer) IBCRateLimit(ctx context.Context, req *QueryIBCRateLimitRequest) (*QueryIBCRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) IBCRateLimits(ctx context.Context, req *QueryIBCRateLimitsRequest) (*QueryIBCRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) FeeToken(ctx context.Context, req *QueryFeeTokenRequest) (*QueryFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeToken not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (*UnimplementedQueryServer) ClaimSponsorship(ctx context.Context, req *QueryClaimSponsorshipRequest) (*QueryClaimSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimSponsorship not implemented")
+}
+func (*UnimplementedQueryServer) LiabilitiesAt(ctx context.Context, req *QueryLiabilitiesAtRequest) (*QueryLiabilitiesAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiabilitiesAt not implemented")
+}
+func (*UnimplementedQueryServer) MerchantICAs(ctx context.Context, req *QueryMerchantICAsRequest) (*QueryMerchantICAsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerchantICAs not implemented")
+}
+func (*UnimplementedQueryServer) MerchantICAPackets(ctx context.Context, req *QueryMerchantICAPacketsRequest) (*QueryMerchantICAPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerchantICAPackets not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryForwards(ctx context.Context, req *QueryTreasuryForwardsRequest) (*QueryTreasuryForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryForwards not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreatorQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreatorQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreatorQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/CreatorQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreatorQuota(ctx, req.(*QueryCreatorQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveMinimums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveMinimumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveMinimums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/EffectiveMinimums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveMinimums(ctx, req.(*QueryEffectiveMinimumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/Authorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authorities(ctx, req.(*QueryAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCreatorallowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCreatorallowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/GetCreatorallowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCreatorallowlist(ctx, req.(*QueryGetCreatorallowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCreatorallowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListCreatorallowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/ListCreatorallowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCreatorallowlist(ctx, req.(*QueryAllCreatorallowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerifiedtokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerifiedtoken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/GetVerifiedtoken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerifiedtoken(ctx, req.(*QueryGetVerifiedtokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVerifiedtokenByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerifiedtokenByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerifiedtokenByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/GetVerifiedtokenByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerifiedtokenByDenom(ctx, req.(*QueryGetVerifiedtokenByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVerifiedtokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVerifiedtoken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/ListVerifiedtoken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVerifiedtoken(ctx, req.(*QueryAllVerifiedtokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchVerifiedtokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchVerifiedtokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchVerifiedtokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/SearchVerifiedtokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchVerifiedtokens(ctx, req.(*QuerySearchVerifiedtokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRewardaccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRewardaccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/GetRewardaccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRewardaccrual(ctx, req.(*QueryGetRewardaccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRewardaccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRewardaccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/ListRewardaccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRewardaccrual(ctx, req.(*QueryAllRewardaccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FilterRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilterRewardaccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FilterRewardaccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/FilterRewardaccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FilterRewardaccrual(ctx, req.(*QueryFilterRewardaccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/AccountPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountPortfolio(ctx, req.(*QueryAccountPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMerchantallocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMerchantallocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMerchantallocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/GetMerchantallocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMerchantallocation(ctx, req.(*QueryGetMerchantallocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListMerchantallocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMerchantallocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListMerchantallocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/ListMerchantallocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListMerchantallocation(ctx, req.(*QueryAllMerchantallocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FilterMerchantallocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilterMerchantallocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FilterMerchantallocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/FilterMerchantallocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FilterMerchantallocation(ctx, req.(*QueryFilterMerchantallocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecoveryoperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecoveryoperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecoveryoperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/GetRecoveryoperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecoveryoperation(ctx, req.(*QueryGetRecoveryoperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRecoveryoperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRecoveryoperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRecoveryoperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/ListRecoveryoperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRecoveryoperation(ctx, req.(*QueryAllRecoveryoperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FilterRecoveryoperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilterRecoveryoperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FilterRecoveryoperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/FilterRecoveryoperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FilterRecoveryoperation(ctx, req.(*QueryFilterRecoveryoperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DailyRollupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDailyRollupStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DailyRollupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/DailyRollupStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DailyRollupStatus(ctx, req.(*QueryDailyRollupStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPoolBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPoolBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/RewardPoolBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPoolBalance(ctx, req.(*QueryRewardPoolBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/GetMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMerchant(ctx, req.(*QueryGetMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/ListMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListMerchant(ctx, req.(*QueryAllMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifiedtokensByMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifiedtokensByMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifiedtokensByMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/VerifiedtokensByMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifiedtokensByMerchant(ctx, req.(*QueryVerifiedtokensByMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/AttestationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationHistory(ctx, req.(*QueryAttestationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMetadataChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMetadataChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMetadataChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/PendingMetadataChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMetadataChange(ctx, req.(*QueryPendingMetadataChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MetadataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetadataHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MetadataHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/MetadataHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MetadataHistory(ctx, req.(*QueryMetadataHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/MintSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintSchedule(ctx, req.(*QueryMintScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/MintSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintSchedules(ctx, req.(*QueryMintSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/MintRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRateLimit(ctx, req.(*QueryMintRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/PausedTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedTokens(ctx, req.(*QueryPausedTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/AddressFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressFreeze(ctx, req.(*QueryAddressFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/AddressFreezes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressFreezes(ctx, req.(*QueryAddressFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenIBCPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenIBCPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenIBCPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/TokenIBCPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenIBCPolicy(ctx, req.(*QueryTokenIBCPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCRecorders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCRecordersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCRecorders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/IBCRecorders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCRecorders(ctx, req.(*QueryIBCRecordersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/IBCRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCRateLimit(ctx, req.(*QueryIBCRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/IBCRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCRateLimits(ctx, req.(*QueryIBCRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/FeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeToken(ctx, req.(*QueryFeeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/FeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/ClaimSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimSponsorship(ctx, req.(*QueryClaimSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiabilitiesAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiabilitiesAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiabilitiesAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/LiabilitiesAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiabilitiesAt(ctx, req.(*QueryLiabilitiesAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerchantICAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerchantICAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerchantICAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/MerchantICAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerchantICAs(ctx, req.(*QueryMerchantICAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerchantICAPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerchantICAPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerchantICAPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/MerchantICAPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerchantICAPackets(ctx, req.(*QueryMerchantICAPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryForwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Query/TreasuryForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryForwards(ctx, req.(*QueryTreasuryForwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tokenchain.loyalty.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CreatorQuota",
+			Handler:    _Query_CreatorQuota_Handler,
+		},
+		{
+			MethodName: "EffectiveMinimums",
+			Handler:    _Query_EffectiveMinimums_Handler,
+		},
+		{
+			MethodName: "Authorities",
+			Handler:    _Query_Authorities_Handler,
+		},
+		{
+			MethodName: "GetCreatorallowlist",
+			Handler:    _Query_GetCreatorallowlist_Handler,
+		},
+		{
+			MethodName: "ListCreatorallowlist",
+			Handler:    _Query_ListCreatorallowlist_Handler,
+		},
+		{
+			MethodName: "GetVerifiedtoken",
+			Handler:    _Query_GetVerifiedtoken_Handler,
+		},
+		{
+			MethodName: "GetVerifiedtokenByDenom",
+			Handler:    _Query_GetVerifiedtokenByDenom_Handler,
+		},
+		{
+			MethodName: "ListVerifiedtoken",
+			Handler:    _Query_ListVerifiedtoken_Handler,
+		},
+		{
+			MethodName: "SearchVerifiedtokens",
+			Handler:    _Query_SearchVerifiedtokens_Handler,
+		},
+		{
+			MethodName: "GetRewardaccrual",
+			Handler:    _Query_GetRewardaccrual_Handler,
+		},
+		{
+			MethodName: "ListRewardaccrual",
+			Handler:    _Query_ListRewardaccrual_Handler,
+		},
+		{
+			MethodName: "FilterRewardaccrual",
+			Handler:    _Query_FilterRewardaccrual_Handler,
+		},
+		{
+			MethodName: "AccountPortfolio",
+			Handler:    _Query_AccountPortfolio_Handler,
+		},
+		{
+			MethodName: "GetMerchantallocation",
+			Handler:    _Query_GetMerchantallocation_Handler,
+		},
+		{
+			MethodName: "ListMerchantallocation",
+			Handler:    _Query_ListMerchantallocation_Handler,
+		},
+		{
+			MethodName: "FilterMerchantallocation",
+			Handler:    _Query_FilterMerchantallocation_Handler,
+		},
+		{
+			MethodName: "GetRecoveryoperation",
+			Handler:    _Query_GetRecoveryoperation_Handler,
+		},
+		{
+			MethodName: "ListRecoveryoperation",
+			Handler:    _Query_ListRecoveryoperation_Handler,
+		},
+		{
+			MethodName: "FilterRecoveryoperation",
+			Handler:    _Query_FilterRecoveryoperation_Handler,
+		},
+		{
+			MethodName: "DailyRollupStatus",
+			Handler:    _Query_DailyRollupStatus_Handler,
+		},
+		{
+			MethodName: "RewardPoolBalance",
+			Handler:    _Query_RewardPoolBalance_Handler,
+		},
+		{
+			MethodName: "GetMerchant",
+			Handler:    _Query_GetMerchant_Handler,
+		},
+		{
+			MethodName: "ListMerchant",
+			Handler:    _Query_ListMerchant_Handler,
+		},
+		{
+			MethodName: "VerifiedtokensByMerchant",
+			Handler:    _Query_VerifiedtokensByMerchant_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "AttestationHistory",
+			Handler:    _Query_AttestationHistory_Handler,
+		},
+		{
+			MethodName: "PendingMetadataChange",
+			Handler:    _Query_PendingMetadataChange_Handler,
+		},
+		{
+			MethodName: "MetadataHistory",
+			Handler:    _Query_MetadataHistory_Handler,
+		},
+		{
+			MethodName: "MintSchedule",
+			Handler:    _Query_MintSchedule_Handler,
+		},
+		{
+			MethodName: "MintSchedules",
+			Handler:    _Query_MintSchedules_Handler,
+		},
+		{
+			MethodName: "MintRateLimit",
+			Handler:    _Query_MintRateLimit_Handler,
+		},
+		{
+			MethodName: "PausedTokens",
+			Handler:    _Query_PausedTokens_Handler,
+		},
+		{
+			MethodName: "AddressFreeze",
+			Handler:    _Query_AddressFreeze_Handler,
+		},
+		{
+			MethodName: "AddressFreezes",
+			Handler:    _Query_AddressFreezes_Handler,
+		},
+		{
+			MethodName: "TokenIBCPolicy",
+			Handler:    _Query_TokenIBCPolicy_Handler,
+		},
+		{
+			MethodName: "IBCRecorders",
+			Handler:    _Query_IBCRecorders_Handler,
+		},
+		{
+			MethodName: "IBCRateLimit",
+			Handler:    _Query_IBCRateLimit_Handler,
+		},
+		{
+			MethodName: "IBCRateLimits",
+			Handler:    _Query_IBCRateLimits_Handler,
+		},
+		{
+			MethodName: "FeeToken",
+			Handler:    _Query_FeeToken_Handler,
+		},
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "ClaimSponsorship",
+			Handler:    _Query_ClaimSponsorship_Handler,
+		},
+		{
+			MethodName: "LiabilitiesAt",
+			Handler:    _Query_LiabilitiesAt_Handler,
+		},
+		{
+			MethodName: "MerchantICAs",
+			Handler:    _Query_MerchantICAs_Handler,
+		},
+		{
+			MethodName: "MerchantICAPackets",
+			Handler:    _Query_MerchantICAPackets_Handler,
+		},
+		{
+			MethodName: "TreasuryForwards",
+			Handler:    _Query_TreasuryForwards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tokenchain/loyalty/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreatorQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreatorQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreatorQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreatorQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreatorQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreatorQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.UnlimitedSupply {
+		i--
+		if m.UnlimitedSupply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RemainingSupply != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingSupply))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnlimitedTokens {
+		i--
+		if m.UnlimitedTokens {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemainingTokens != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingTokens))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Creatorallowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinimumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinimumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinimumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinimumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinimumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinimumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryTimelockHours != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecoveryTimelockHours))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NetworkMode) > 0 {
+		i -= len(m.NetworkMode)
+		copy(dAtA[i:], m.NetworkMode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NetworkMode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthoritiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthoritiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthoritiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authorities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCreatorallowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCreatorallowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCreatorallowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Creatorallowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCreatorallowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCreatorallowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCreatorallowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creatorallowlist) > 0 {
+		for iNdEx := len(m.Creatorallowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Creatorallowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifiedtokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifiedtokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifiedtokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifiedtokenByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifiedtokenByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifiedtokenByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verifiedtoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVerifiedtokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVerifiedtokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVerifiedtokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifiedtoken) > 0 {
+		for iNdEx := len(m.Verifiedtoken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifiedtoken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRewardaccrualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRewardaccrualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRewardaccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewardaccrual.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRewardaccrualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardaccrualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardaccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rewardaccrual) > 0 {
+		for iNdEx := len(m.Rewardaccrual) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewardaccrual[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilterRewardaccrualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterRewardaccrualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterRewardaccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilterRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rewardaccrual) > 0 {
+		for iNdEx := len(m.Rewardaccrual) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewardaccrual[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPortfolioRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPortfolioRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPortfolioRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPortfolioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPortfolioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPortfolioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PortfolioEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortfolioEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortfolioEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRecoveries) > 0 {
+		for iNdEx := len(m.PendingRecoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRecoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ClaimFunded {
+		i--
+		if m.ClaimFunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PoolBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolBalance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Claimable != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Claimable))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Balance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Verifiedtoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchVerifiedtokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchVerifiedtokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchVerifiedtokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AdminRenounced) > 0 {
+		i -= len(m.AdminRenounced)
+		copy(dAtA[i:], m.AdminRenounced)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminRenounced)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SeizureOptIn) > 0 {
+		i -= len(m.SeizureOptIn)
+		copy(dAtA[i:], m.SeizureOptIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SeizureOptIn)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Verified) > 0 {
+		i -= len(m.Verified)
+		copy(dAtA[i:], m.Verified)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Verified)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SymbolPrefix) > 0 {
+		i -= len(m.SymbolPrefix)
+		copy(dAtA[i:], m.SymbolPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SymbolPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchVerifiedtokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchVerifiedtokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchVerifiedtokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifiedtokens) > 0 {
+		for iNdEx := len(m.Verifiedtokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifiedtokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifiedtokenListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifiedtokenListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedtokenListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StakerStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolBalance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Supply != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Supply))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Verifiedtoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMerchantallocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMerchantallocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMerchantallocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMerchantallocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMerchantallocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMerchantallocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Merchantallocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMerchantallocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMerchantallocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMerchantallocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMerchantallocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMerchantallocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMerchantallocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchantallocation) > 0 {
+		for iNdEx := len(m.Merchantallocation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Merchantallocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilterMerchantallocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterMerchantallocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterMerchantallocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilterMerchantallocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterMerchantallocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterMerchantallocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchantallocation) > 0 {
+		for iNdEx := len(m.Merchantallocation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Merchantallocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecoveryoperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecoveryoperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecoveryoperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecoveryoperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecoveryoperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecoveryoperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Recoveryoperation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRecoveryoperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRecoveryoperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRecoveryoperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRecoveryoperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRecoveryoperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRecoveryoperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recoveryoperation) > 0 {
+		for iNdEx := len(m.Recoveryoperation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recoveryoperation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilterRecoveryoperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterRecoveryoperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterRecoveryoperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilterRecoveryoperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterRecoveryoperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterRecoveryoperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recoveryoperation) > 0 {
+		for iNdEx := len(m.Recoveryoperation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recoveryoperation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDailyRollupStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDailyRollupStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDailyRollupStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDailyRollupStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDailyRollupStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDailyRollupStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextRollupDate) > 0 {
+		i -= len(m.NextRollupDate)
+		copy(dAtA[i:], m.NextRollupDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextRollupDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HasRolledToday {
+		i--
+		if m.HasRolledToday {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LastDailyRollupDate) > 0 {
+		i -= len(m.LastDailyRollupDate)
+		copy(dAtA[i:], m.LastDailyRollupDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastDailyRollupDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentLocalDate) > 0 {
+		i -= len(m.CurrentLocalDate)
+		copy(dAtA[i:], m.CurrentLocalDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentLocalDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Timezone) > 0 {
+		i -= len(m.Timezone)
+		copy(dAtA[i:], m.Timezone)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timezone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMerchantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMerchantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMerchantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Merchant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMerchantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMerchantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMerchantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		for iNdEx := len(m.Merchant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Merchant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifiedtokensByMerchantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifiedtokensByMerchantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifiedtokensByMerchantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifiedtokensByMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifiedtokensByMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifiedtokensByMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifiedtoken) > 0 {
+		for iNdEx := len(m.Verifiedtoken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifiedtoken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RequiredCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequiredCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActiveCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMetadataChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMetadataChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMetadataChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMetadataChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMetadataChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMetadataChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingChange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMetadataHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetadataHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetadataHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMetadataHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetadataHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetadataHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MintSchedules) > 0 {
+		for iNdEx := len(m.MintSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Available != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MintedRollupDate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MintedRollupDate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RollupDate) > 0 {
+		i -= len(m.RollupDate)
+		copy(dAtA[i:], m.RollupDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollupDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MintedRollingDay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MintedRollingDay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MintRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PausedTokens) > 0 {
+		for iNdEx := len(m.PausedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressFreezeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFreezeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFreezeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AddressFreeze.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressFreezesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFreezesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFreezesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressFreezesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFreezesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFreezesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AddressFreezes) > 0 {
+		for iNdEx := len(m.AddressFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenIBCPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenIBCPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenIBCPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenIBCPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenIBCPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenIBCPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Escrowed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Escrowed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCRecordersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCRecordersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCRecordersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCRecordersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCRecordersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCRecordersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recorders) > 0 {
+		for iNdEx := len(m.Recorders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recorders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingAllowance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingAllowance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemainingBudget != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBudget))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiabilitiesAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiabilitiesAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiabilitiesAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiabilitiesAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiabilitiesAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiabilitiesAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerchantICAsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerchantICAsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerchantICAsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerchantICAsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerchantICAsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerchantICAsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Icas) > 0 {
+		for iNdEx := len(m.Icas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Icas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerchantICAPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerchantICAPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerchantICAPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerchantICAPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerchantICAPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerchantICAPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryForwardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryForwardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryForwardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryForwardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Forwards) > 0 {
+		for iNdEx := len(m.Forwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCreatorQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreatorQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Creatorallowlist.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	if m.RemainingTokens != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingTokens))
+	}
+	if m.UnlimitedTokens {
+		n += 2
+	}
+	if m.RemainingSupply != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingSupply))
+	}
+	if m.UnlimitedSupply {
+		n += 2
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEffectiveMinimumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveMinimumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NetworkMode)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecoveryTimelockHours != 0 {
+		n += 1 + sovQuery(uint64(m.RecoveryTimelockHours))
+	}
+	return n
+}
+
+func (m *QueryAuthoritiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Authorities.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCreatorallowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCreatorallowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Creatorallowlist.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCreatorallowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCreatorallowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Creatorallowlist) > 0 {
+		for _, e := range m.Creatorallowlist {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifiedtokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifiedtokenByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifiedtokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Verifiedtoken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllVerifiedtokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVerifiedtokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifiedtoken) > 0 {
+		for _, e := range m.Verifiedtoken {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRewardaccrualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRewardaccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewardaccrual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRewardaccrualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRewardaccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewardaccrual) > 0 {
+		for _, e := range m.Rewardaccrual {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterRewardaccrualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterRewardaccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewardaccrual) > 0 {
+		for _, e := range m.Rewardaccrual {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountPortfolioRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountPortfolioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PortfolioEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Verifiedtoken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Balance != 0 {
+		n += 1 + sovQuery(uint64(m.Balance))
+	}
+	if m.Claimable != 0 {
+		n += 1 + sovQuery(uint64(m.Claimable))
+	}
+	if m.PoolBalance != 0 {
+		n += 1 + sovQuery(uint64(m.PoolBalance))
+	}
+	if m.ClaimFunded {
+		n += 2
+	}
+	if len(m.PendingRecoveries) > 0 {
+		for _, e := range m.PendingRecoveries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySearchVerifiedtokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SymbolPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Verified)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SeizureOptIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AdminRenounced)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchVerifiedtokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifiedtokens) > 0 {
+		for _, e := range m.Verifiedtokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifiedtokenListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Verifiedtoken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Supply != 0 {
+		n += 1 + sovQuery(uint64(m.Supply))
+	}
+	if m.PoolBalance != 0 {
+		n += 1 + sovQuery(uint64(m.PoolBalance))
+	}
+	l = m.StakerStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMerchantallocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMerchantallocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Merchantallocation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMerchantallocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMerchantallocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Merchantallocation) > 0 {
+		for _, e := range m.Merchantallocation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterMerchantallocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterMerchantallocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Merchantallocation) > 0 {
+		for _, e := range m.Merchantallocation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecoveryoperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetRecoveryoperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Recoveryoperation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRecoveryoperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRecoveryoperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recoveryoperation) > 0 {
+		for _, e := range m.Recoveryoperation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterRecoveryoperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RequestedBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterRecoveryoperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recoveryoperation) > 0 {
+		for _, e := range m.Recoveryoperation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDailyRollupStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDailyRollupStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CurrentLocalDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LastDailyRollupDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HasRolledToday {
+		n += 2
+	}
+	l = len(m.NextRollupDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardPoolBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardPoolBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMerchantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Merchant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMerchantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Merchant) > 0 {
+		for _, e := range m.Merchant {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifiedtokensByMerchantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerchantId != 0 {
+		n += 1 + sovQuery(uint64(m.MerchantId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifiedtokensByMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifiedtoken) > 0 {
+		for _, e := range m.Verifiedtoken {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.ActiveCount != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveCount))
+	}
+	if m.RequiredCount != 0 {
+		n += 1 + sovQuery(uint64(m.RequiredCount))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingMetadataChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingMetadataChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingChange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMetadataHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMetadataHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMintScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MintSchedules) > 0 {
+		for _, e := range m.MintSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintRateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MintedRollingDay != 0 {
+		n += 1 + sovQuery(uint64(m.MintedRollingDay))
+	}
+	l = len(m.RollupDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MintedRollupDate != 0 {
+		n += 1 + sovQuery(uint64(m.MintedRollupDate))
+	}
+	if m.Available != 0 {
+		n += 1 + sovQuery(uint64(m.Available))
+	}
+	return n
+}
+
+func (m *QueryPausedTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedTokens) > 0 {
+		for _, e := range m.PausedTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressFreezeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AddressFreeze.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAddressFreezesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressFreezesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddressFreezes) > 0 {
+		for _, e := range m.AddressFreezes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenIBCPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenIBCPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsDefault {
+		n += 2
+	}
+	if m.Escrowed != 0 {
+		n += 1 + sovQuery(uint64(m.Escrowed))
+	}
+	return n
+}
+
+func (m *QueryIBCRecordersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCRecordersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recorders) > 0 {
+		for _, e := range m.Recorders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIBCRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GasPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingBudget != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBudget))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingAllowance != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingAllowance))
+	}
+	return n
+}
+
+func (m *QueryLiabilitiesAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiabilitiesAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerchantICAsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerchantId != 0 {
+		n += 1 + sovQuery(uint64(m.MerchantId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerchantICAsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Icas) > 0 {
+		for _, e := range m.Icas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerchantICAPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerchantId != 0 {
+		n += 1 + sovQuery(uint64(m.MerchantId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerchantICAPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryForwardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerchantId != 0 {
+		n += 1 + sovQuery(uint64(m.MerchantId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryForwardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Forwards) > 0 {
+		for _, e := range m.Forwards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreatorQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreatorQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreatorQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreatorQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreatorQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreatorQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creatorallowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Creatorallowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTokens", wireType)
+			}
+			m.RemainingTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlimitedTokens", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnlimitedTokens = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSupply", wireType)
+			}
+			m.RemainingSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlimitedSupply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnlimitedSupply = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMinimumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinimumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinimumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMinimumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinimumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinimumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryTimelockHours", wireType)
+			}
+			m.RecoveryTimelockHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryTimelockHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthoritiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthoritiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthoritiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCreatorallowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCreatorallowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCreatorallowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creatorallowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Creatorallowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCreatorallowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCreatorallowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCreatorallowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creatorallowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creatorallowlist = append(m.Creatorallowlist, Creatorallowlist{})
+			if err := m.Creatorallowlist[len(m.Creatorallowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerifiedtokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerifiedtokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVerifiedtokenByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerifiedtokenByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerifiedtokenByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerifiedtokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verifiedtoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVerifiedtokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVerifiedtokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVerifiedtokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifiedtoken = append(m.Verifiedtoken, Verifiedtoken{})
+			if err := m.Verifiedtoken[len(m.Verifiedtoken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRewardaccrualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRewardaccrualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRewardaccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRewardaccrualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewardaccrual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewardaccrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRewardaccrualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRewardaccrualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRewardaccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRewardaccrualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewardaccrual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewardaccrual = append(m.Rewardaccrual, Rewardaccrual{})
+			if err := m.Rewardaccrual[len(m.Rewardaccrual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFilterRewardaccrualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilterRewardaccrualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilterRewardaccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFilterRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilterRewardaccrualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilterRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewardaccrual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewardaccrual = append(m.Rewardaccrual, Rewardaccrual{})
+			if err := m.Rewardaccrual[len(m.Rewardaccrual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountPortfolioRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPortfolioRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPortfolioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountPortfolioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPortfolioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPortfolioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PortfolioEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortfolioEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortfolioEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortfolioEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verifiedtoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			m.Claimable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimable |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBalance", wireType)
+			}
+			m.PoolBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimFunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimFunded = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRecoveries = append(m.PendingRecoveries, Recoveryoperation{})
+			if err := m.PendingRecoveries[len(m.PendingRecoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchVerifiedtokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchVerifiedtokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchVerifiedtokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verified = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizureOptIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeizureOptIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminRenounced", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminRenounced = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchVerifiedtokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchVerifiedtokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchVerifiedtokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifiedtokens = append(m.Verifiedtokens, VerifiedtokenListing{})
+			if err := m.Verifiedtokens[len(m.Verifiedtokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifiedtokenListing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifiedtokenListing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifiedtokenListing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verifiedtoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			m.Supply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Supply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBalance", wireType)
+			}
+			m.PoolBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakerStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMerchantallocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMerchantallocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMerchantallocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMerchantallocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMerchantallocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMerchantallocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchantallocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Merchantallocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMerchantallocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMerchantallocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMerchantallocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMerchantallocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMerchantallocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMerchantallocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchantallocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchantallocation = append(m.Merchantallocation, Merchantallocation{})
+			if err := m.Merchantallocation[len(m.Merchantallocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFilterMerchantallocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilterMerchantallocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilterMerchantallocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFilterMerchantallocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilterMerchantallocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilterMerchantallocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchantallocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchantallocation = append(m.Merchantallocation, Merchantallocation{})
+			if err := m.Merchantallocation[len(m.Merchantallocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecoveryoperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecoveryoperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecoveryoperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecoveryoperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoveryoperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recoveryoperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRecoveryoperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRecoveryoperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRecoveryoperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRecoveryoperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoveryoperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recoveryoperation = append(m.Recoveryoperation, Recoveryoperation{})
+			if err := m.Recoveryoperation[len(m.Recoveryoperation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilterRecoveryoperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilterRecoveryoperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFilterRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilterRecoveryoperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilterRecoveryoperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoveryoperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recoveryoperation = append(m.Recoveryoperation, Recoveryoperation{})
+			if err := m.Recoveryoperation[len(m.Recoveryoperation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDailyRollupStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDailyRollupStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDailyRollupStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDailyRollupStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDailyRollupStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLocalDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentLocalDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDailyRollupDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastDailyRollupDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRolledToday", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasRolledToday = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRollupDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRollupDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMerchantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMerchantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMerchantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMerchantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Merchant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMerchantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMerchantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMerchantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMerchantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = append(m.Merchant, Merchant{})
+			if err := m.Merchant[len(m.Merchant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifiedtokensByMerchantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiedtokensByMerchantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiedtokensByMerchantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifiedtokensByMerchantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiedtokensByMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiedtokensByMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifiedtoken = append(m.Verifiedtoken, Verifiedtoken{})
+			if err := m.Verifiedtoken[len(m.Verifiedtoken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveCount", wireType)
+			}
+			m.ActiveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCount", wireType)
+			}
+			m.RequiredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, AttestationRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMetadataChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMetadataChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMetadataChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMetadataChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMetadataChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMetadataChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMetadataHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMetadataHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMetadataHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMetadataHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMetadataHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMetadataHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, MetadataVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4364,12 +19160,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4391,7 +19238,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4414,15 +19261,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4449,7 +19296,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4474,7 +19321,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4497,15 +19344,15 @@ func (m *QueryGetCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCreatorallowlistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCreatorallowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4533,7 +19380,43 @@ func (m *QueryGetCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4556,7 +19439,7 @@ func (m *QueryGetCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4579,15 +19462,15 @@ func (m *QueryGetCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCreatorallowlistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creatorallowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4614,7 +19497,44 @@ func (m *QueryGetCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Creatorallowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MintSchedules = append(m.MintSchedules, MintSchedule{})
+			if err := m.MintSchedules[len(m.MintSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4639,7 +19559,7 @@ func (m *QueryGetCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4662,17 +19582,17 @@ func (m *QueryAllCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCreatorallowlistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCreatorallowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4682,27 +19602,23 @@ func (m *QueryAllCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4725,7 +19641,7 @@ func (m *QueryAllCreatorallowlistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4748,17 +19664,105 @@ func (m *QueryAllCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCreatorallowlistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creatorallowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &PendingMintRateLimit{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedRollingDay", wireType)
+			}
+			m.MintedRollingDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedRollingDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollupDate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4768,31 +19772,29 @@ func (m *QueryAllCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creatorallowlist = append(m.Creatorallowlist, Creatorallowlist{})
-			if err := m.Creatorallowlist[len(m.Creatorallowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollupDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedRollupDate", wireType)
 			}
-			var msglen int
+			m.MintedRollupDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4802,28 +19804,30 @@ func (m *QueryAllCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MintedRollupDate |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4845,7 +19849,7 @@ func (m *QueryAllCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPausedTokensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4868,17 +19872,17 @@ func (m *QueryGetVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVerifiedtokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausedTokensRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVerifiedtokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausedTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4888,23 +19892,27 @@ func (m *QueryGetVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4927,7 +19935,7 @@ func (m *QueryGetVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVerifiedtokenByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPausedTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4950,17 +19958,17 @@ func (m *QueryGetVerifiedtokenByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVerifiedtokenByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausedTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVerifiedtokenByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4970,77 +19978,29 @@ func (m *QueryGetVerifiedtokenByDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.PausedTokens = append(m.PausedTokens, TokenPause{})
+			if err := m.PausedTokens[len(m.PausedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVerifiedtokenResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5067,7 +20027,10 @@ func (m *QueryGetVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Verifiedtoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5092,7 +20055,7 @@ func (m *QueryGetVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressFreezeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5115,17 +20078,17 @@ func (m *QueryAllVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVerifiedtokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressFreezeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVerifiedtokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressFreezeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5135,27 +20098,55 @@ func (m *QueryAllVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5178,7 +20169,7 @@ func (m *QueryAllVerifiedtokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressFreezeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5201,49 +20192,15 @@ func (m *QueryAllVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVerifiedtokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressFreezeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verifiedtoken", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Verifiedtoken = append(m.Verifiedtoken, Verifiedtoken{})
-			if err := m.Verifiedtoken[len(m.Verifiedtoken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressFreeze", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5270,10 +20227,7 @@ func (m *QueryAllVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AddressFreeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5298,7 +20252,7 @@ func (m *QueryAllVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRewardaccrualRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressFreezesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5321,17 +20275,49 @@ func (m *QueryGetRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRewardaccrualRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressFreezesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRewardaccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressFreezesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5341,23 +20327,27 @@ func (m *QueryGetRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5380,7 +20370,7 @@ func (m *QueryGetRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressFreezesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5403,15 +20393,15 @@ func (m *QueryGetRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRewardaccrualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressFreezesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressFreezesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewardaccrual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressFreezes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5438,7 +20428,44 @@ func (m *QueryGetRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewardaccrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AddressFreezes = append(m.AddressFreezes, AddressFreeze{})
+			if err := m.AddressFreezes[len(m.AddressFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5463,7 +20490,7 @@ func (m *QueryGetRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRewardaccrualRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenIBCPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5486,17 +20513,17 @@ func (m *QueryAllRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRewardaccrualRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenIBCPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRewardaccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenIBCPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5506,27 +20533,23 @@ func (m *QueryAllRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5549,7 +20572,7 @@ func (m *QueryAllRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenIBCPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5572,15 +20595,15 @@ func (m *QueryAllRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRewardaccrualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenIBCPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenIBCPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewardaccrual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5607,16 +20630,15 @@ func (m *QueryAllRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewardaccrual = append(m.Rewardaccrual, Rewardaccrual{})
-			if err := m.Rewardaccrual[len(m.Rewardaccrual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5626,28 +20648,31 @@ func (m *QueryAllRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.IsDefault = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Escrowed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Escrowed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5669,7 +20694,7 @@ func (m *QueryAllRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterRewardaccrualRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCRecordersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5692,15 +20717,15 @@ func (m *QueryFilterRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterRewardaccrualRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCRecordersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterRewardaccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCRecordersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5728,41 +20753,9 @@ func (m *QueryFilterRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5819,7 +20812,7 @@ func (m *QueryFilterRewardaccrualRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCRecordersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5842,15 +20835,15 @@ func (m *QueryFilterRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterRewardaccrualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCRecordersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCRecordersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewardaccrual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recorders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5877,8 +20870,8 @@ func (m *QueryFilterRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewardaccrual = append(m.Rewardaccrual, Rewardaccrual{})
-			if err := m.Rewardaccrual[len(m.Rewardaccrual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recorders = append(m.Recorders, IBCRecorder{})
+			if err := m.Recorders[len(m.Recorders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5939,7 +20932,7 @@ func (m *QueryFilterRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMerchantallocationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5962,15 +20955,15 @@ func (m *QueryGetMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMerchantallocationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMerchantallocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5998,7 +20991,39 @@ func (m *QueryGetMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6021,7 +21046,7 @@ func (m *QueryGetMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMerchantallocationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6044,15 +21069,15 @@ func (m *QueryGetMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMerchantallocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMerchantallocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchantallocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6079,7 +21104,7 @@ func (m *QueryGetMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Merchantallocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6104,7 +21129,7 @@ func (m *QueryGetMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMerchantallocationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6123,17 +21148,49 @@ func (m *QueryAllMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMerchantallocationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMerchantallocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6190,7 +21247,7 @@ func (m *QueryAllMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMerchantallocationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6213,15 +21270,15 @@ func (m *QueryAllMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMerchantallocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMerchantallocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchantallocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6248,8 +21305,8 @@ func (m *QueryAllMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchantallocation = append(m.Merchantallocation, Merchantallocation{})
-			if err := m.Merchantallocation[len(m.Merchantallocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Usages = append(m.Usages, IBCRateLimitUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6310,7 +21367,7 @@ func (m *QueryAllMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterMerchantallocationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6333,45 +21390,13 @@ func (m *QueryFilterMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterMerchantallocationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterMerchantallocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Date = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -6403,42 +21428,6 @@ func (m *QueryFilterMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6460,7 +21449,7 @@ func (m *QueryFilterMerchantallocationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterMerchantallocationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6483,15 +21472,15 @@ func (m *QueryFilterMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterMerchantallocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterMerchantallocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchantallocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6518,14 +21507,13 @@ func (m *QueryFilterMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchantallocation = append(m.Merchantallocation, Merchantallocation{})
-			if err := m.Merchantallocation[len(m.Merchantallocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6552,10 +21540,7 @@ func (m *QueryFilterMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6580,7 +21565,7 @@ func (m *QueryFilterMerchantallocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6603,17 +21588,17 @@ func (m *QueryGetRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecoveryoperationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecoveryoperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6623,11 +21608,28 @@ func (m *QueryGetRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6649,7 +21651,7 @@ func (m *QueryGetRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6672,15 +21674,15 @@ func (m *QueryGetRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecoveryoperationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecoveryoperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recoveryoperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6707,7 +21709,44 @@ func (m *QueryGetRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Recoveryoperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6732,7 +21771,7 @@ func (m *QueryGetRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimSponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6755,17 +21794,17 @@ func (m *QueryAllRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRecoveryoperationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimSponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRecoveryoperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6775,27 +21814,55 @@ func (m *QueryAllRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6818,7 +21885,7 @@ func (m *QueryAllRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6841,15 +21908,48 @@ func (m *QueryAllRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRecoveryoperationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRecoveryoperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recoveryoperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6876,14 +21976,32 @@ func (m *QueryAllRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recoveryoperation = append(m.Recoveryoperation, Recoveryoperation{})
-			if err := m.Recoveryoperation[len(m.Recoveryoperation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBudget", wireType)
+			}
+			m.RemainingBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6910,13 +22028,32 @@ func (m *QueryAllRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Allowance == nil {
+				m.Allowance = &SponsoredAllowance{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAllowance", wireType)
+			}
+			m.RemainingAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6938,7 +22075,7 @@ func (m *QueryAllRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiabilitiesAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6961,15 +22098,15 @@ func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterRecoveryoperationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiabilitiesAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterRecoveryoperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiabilitiesAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6997,11 +22134,11 @@ func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7029,45 +22166,63 @@ func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Date = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiabilitiesAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiabilitiesAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiabilitiesAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7077,29 +22232,80 @@ func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerchantICAsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerchantICAsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerchantICAsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7109,25 +22315,12 @@ func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MerchantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7184,7 +22377,7 @@ func (m *QueryFilterRecoveryoperationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerchantICAsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7207,15 +22400,15 @@ func (m *QueryFilterRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterRecoveryoperationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerchantICAsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterRecoveryoperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerchantICAsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recoveryoperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Icas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7242,8 +22435,8 @@ func (m *QueryFilterRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recoveryoperation = append(m.Recoveryoperation, Recoveryoperation{})
-			if err := m.Recoveryoperation[len(m.Recoveryoperation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Icas = append(m.Icas, MerchantICA{})
+			if err := m.Icas[len(m.Icas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7276,63 +22469,13 @@ func (m *QueryFilterRecoveryoperationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDailyRollupStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDailyRollupStatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDailyRollupStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7354,7 +22497,7 @@ func (m *QueryDailyRollupStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerchantICAPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7377,17 +22520,17 @@ func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDailyRollupStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerchantICAPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDailyRollupStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerchantICAPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
 			}
-			var stringLen uint64
+			m.MerchantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7397,29 +22540,16 @@ func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MerchantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLocalDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7429,29 +22559,83 @@ func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentLocalDate = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerchantICAPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerchantICAPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerchantICAPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDailyRollupDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7461,49 +22645,31 @@ func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastDailyRollupDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRolledToday", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Packets = append(m.Packets, MerchantICAPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.HasRolledToday = bool(v != 0)
-		case 5:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextRollupDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7513,23 +22679,27 @@ func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextRollupDate = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7552,7 +22722,7 @@ func (m *QueryDailyRollupStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryForwardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7575,17 +22745,36 @@ func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryForwardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryForwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7595,23 +22784,27 @@ func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7634,7 +22827,7 @@ func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryForwardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7657,17 +22850,17 @@ func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryForwardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7677,29 +22870,31 @@ func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			m.Forwards = append(m.Forwards, TreasuryForward{})
+			if err := m.Forwards[len(m.Forwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7709,55 +22904,27 @@ func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
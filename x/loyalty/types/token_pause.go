@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	// PauseScopeMint halts direct, batch and scheduled mints.
+	PauseScopeMint = "mint"
+	// PauseScopeClaim halts reward accruals, merchant allocations, pool funding and claims.
+	PauseScopeClaim = "claim"
+	// PauseScopeTransfer halts bank transfers of the denom and recovery transfer execution.
+	PauseScopeTransfer = "transfer"
+)
+
+// AllPauseScopes lists every pause scope in a stable order.
+var AllPauseScopes = []string{PauseScopeMint, PauseScopeClaim, PauseScopeTransfer}
+
+// NormalizePauseScopes validates scopes and returns them deduplicated in canonical
+// order. Empty scopes mean every scope.
+func NormalizePauseScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return slices.Clone(AllPauseScopes), nil
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(AllPauseScopes, scope) {
+			return nil, fmt.Errorf("unknown pause scope %q", scope)
+		}
+	}
+	normalized := make([]string, 0, len(AllPauseScopes))
+	for _, scope := range AllPauseScopes {
+		if slices.Contains(scopes, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+// ActiveAt reports whether the pause is still in force at unix time now.
+func (p TokenPause) ActiveAt(now uint64) bool {
+	return p.ExpiresAt == 0 || now < p.ExpiresAt
+}
+
+// Covers reports whether the pause halts scope.
+func (p TokenPause) Covers(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
@@ -124,18 +124,117 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateAuthorities is the Msg/UpdateAuthorities request type.
+type MsgUpdateAuthorities struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// authorities defines the complete set of scoped role holders.
+	Authorities Authorities `protobuf:"bytes,2,opt,name=authorities,proto3" json:"authorities"`
+}
+
+func (m *MsgUpdateAuthorities) Reset()         { *m = MsgUpdateAuthorities{} }
+func (m *MsgUpdateAuthorities) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAuthorities) ProtoMessage()    {}
+func (*MsgUpdateAuthorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{2}
+}
+func (m *MsgUpdateAuthorities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAuthorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAuthorities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAuthorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAuthorities.Merge(m, src)
+}
+func (m *MsgUpdateAuthorities) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAuthorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAuthorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAuthorities proto.InternalMessageInfo
+
+func (m *MsgUpdateAuthorities) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateAuthorities) GetAuthorities() Authorities {
+	if m != nil {
+		return m.Authorities
+	}
+	return Authorities{}
+}
+
+// MsgUpdateAuthoritiesResponse defines the response structure for executing a
+// MsgUpdateAuthorities message.
+type MsgUpdateAuthoritiesResponse struct {
+}
+
+func (m *MsgUpdateAuthoritiesResponse) Reset()         { *m = MsgUpdateAuthoritiesResponse{} }
+func (m *MsgUpdateAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAuthoritiesResponse) ProtoMessage()    {}
+func (*MsgUpdateAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{3}
+}
+func (m *MsgUpdateAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAuthoritiesResponse.Merge(m, src)
+}
+func (m *MsgUpdateAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAuthoritiesResponse proto.InternalMessageInfo
+
 // MsgCreateCreatorallowlist defines the MsgCreateCreatorallowlist message.
 type MsgCreateCreatorallowlist struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// expires_at is a unix time in seconds; zero means no expiry.
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// max_tokens caps registered tokens; zero means unlimited.
+	MaxTokens uint64 `protobuf:"varint,5,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// max_total_supply caps aggregate max_supply; zero means unlimited.
+	MaxTotalSupply uint64 `protobuf:"varint,6,opt,name=max_total_supply,json=maxTotalSupply,proto3" json:"max_total_supply,omitempty"`
 }
 
 func (m *MsgCreateCreatorallowlist) Reset()         { *m = MsgCreateCreatorallowlist{} }
 func (m *MsgCreateCreatorallowlist) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCreatorallowlist) ProtoMessage()    {}
 func (*MsgCreateCreatorallowlist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{2}
+	return fileDescriptor_241469d78c289384, []int{4}
 }
 func (m *MsgCreateCreatorallowlist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,6 +284,27 @@ func (m *MsgCreateCreatorallowlist) GetEnabled() bool {
 	return false
 }
 
+func (m *MsgCreateCreatorallowlist) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *MsgCreateCreatorallowlist) GetMaxTokens() uint64 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *MsgCreateCreatorallowlist) GetMaxTotalSupply() uint64 {
+	if m != nil {
+		return m.MaxTotalSupply
+	}
+	return 0
+}
+
 // MsgCreateCreatorallowlistResponse defines the MsgCreateCreatorallowlistResponse message.
 type MsgCreateCreatorallowlistResponse struct {
 }
@@ -193,7 +313,7 @@ func (m *MsgCreateCreatorallowlistResponse) Reset()         { *m = MsgCreateCrea
 func (m *MsgCreateCreatorallowlistResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCreatorallowlistResponse) ProtoMessage()    {}
 func (*MsgCreateCreatorallowlistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{3}
+	return fileDescriptor_241469d78c289384, []int{5}
 }
 func (m *MsgCreateCreatorallowlistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,13 +347,19 @@ type MsgUpdateCreatorallowlist struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// expires_at is a unix time in seconds; zero means no expiry.
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// max_tokens caps registered tokens; zero means unlimited.
+	MaxTokens uint64 `protobuf:"varint,5,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// max_total_supply caps aggregate max_supply; zero means unlimited.
+	MaxTotalSupply uint64 `protobuf:"varint,6,opt,name=max_total_supply,json=maxTotalSupply,proto3" json:"max_total_supply,omitempty"`
 }
 
 func (m *MsgUpdateCreatorallowlist) Reset()         { *m = MsgUpdateCreatorallowlist{} }
 func (m *MsgUpdateCreatorallowlist) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCreatorallowlist) ProtoMessage()    {}
 func (*MsgUpdateCreatorallowlist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{4}
+	return fileDescriptor_241469d78c289384, []int{6}
 }
 func (m *MsgUpdateCreatorallowlist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,6 +409,27 @@ func (m *MsgUpdateCreatorallowlist) GetEnabled() bool {
 	return false
 }
 
+func (m *MsgUpdateCreatorallowlist) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *MsgUpdateCreatorallowlist) GetMaxTokens() uint64 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *MsgUpdateCreatorallowlist) GetMaxTotalSupply() uint64 {
+	if m != nil {
+		return m.MaxTotalSupply
+	}
+	return 0
+}
+
 // MsgUpdateCreatorallowlistResponse defines the MsgUpdateCreatorallowlistResponse message.
 type MsgUpdateCreatorallowlistResponse struct {
 }
@@ -291,7 +438,7 @@ func (m *MsgUpdateCreatorallowlistResponse) Reset()         { *m = MsgUpdateCrea
 func (m *MsgUpdateCreatorallowlistResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCreatorallowlistResponse) ProtoMessage()    {}
 func (*MsgUpdateCreatorallowlistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{5}
+	return fileDescriptor_241469d78c289384, []int{7}
 }
 func (m *MsgUpdateCreatorallowlistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +477,7 @@ func (m *MsgDeleteCreatorallowlist) Reset()         { *m = MsgDeleteCreatorallow
 func (m *MsgDeleteCreatorallowlist) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteCreatorallowlist) ProtoMessage()    {}
 func (*MsgDeleteCreatorallowlist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{6}
+	return fileDescriptor_241469d78c289384, []int{8}
 }
 func (m *MsgDeleteCreatorallowlist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +528,7 @@ func (m *MsgDeleteCreatorallowlistResponse) Reset()         { *m = MsgDeleteCrea
 func (m *MsgDeleteCreatorallowlistResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteCreatorallowlistResponse) ProtoMessage()    {}
 func (*MsgDeleteCreatorallowlistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{7}
+	return fileDescriptor_241469d78c289384, []int{9}
 }
 func (m *MsgDeleteCreatorallowlistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,17 +568,22 @@ type MsgCreateVerifiedtoken struct {
 	Website               string `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
 	MaxSupply             uint64 `protobuf:"varint,8,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
 	MintedSupply          uint64 `protobuf:"varint,9,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
-	Verified              bool   `protobuf:"varint,10,opt,name=verified,proto3" json:"verified,omitempty"`
 	SeizureOptIn          bool   `protobuf:"varint,11,opt,name=seizure_opt_in,json=seizureOptIn,proto3" json:"seizure_opt_in,omitempty"`
 	RecoveryGroupPolicy   string `protobuf:"bytes,12,opt,name=recovery_group_policy,json=recoveryGroupPolicy,proto3" json:"recovery_group_policy,omitempty"`
 	RecoveryTimelockHours uint64 `protobuf:"varint,13,opt,name=recovery_timelock_hours,json=recoveryTimelockHours,proto3" json:"recovery_timelock_hours,omitempty"`
+	// merchant_id optionally links the token to an active merchant owned by the signer.
+	MerchantId uint64 `protobuf:"varint,14,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	// decimals is the display exponent; it is fixed once minting has started.
+	Decimals uint32 `protobuf:"varint,15,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// denom_units are extra bank denom units; they are fixed once minting has started.
+	DenomUnits []TokenDenomUnit `protobuf:"bytes,16,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units"`
 }
 
 func (m *MsgCreateVerifiedtoken) Reset()         { *m = MsgCreateVerifiedtoken{} }
 func (m *MsgCreateVerifiedtoken) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateVerifiedtoken) ProtoMessage()    {}
 func (*MsgCreateVerifiedtoken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{8}
+	return fileDescriptor_241469d78c289384, []int{10}
 }
 func (m *MsgCreateVerifiedtoken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,13 +675,6 @@ func (m *MsgCreateVerifiedtoken) GetMintedSupply() uint64 {
 	return 0
 }
 
-func (m *MsgCreateVerifiedtoken) GetVerified() bool {
-	if m != nil {
-		return m.Verified
-	}
-	return false
-}
-
 func (m *MsgCreateVerifiedtoken) GetSeizureOptIn() bool {
 	if m != nil {
 		return m.SeizureOptIn
@@ -551,6 +696,27 @@ func (m *MsgCreateVerifiedtoken) GetRecoveryTimelockHours() uint64 {
 	return 0
 }
 
+func (m *MsgCreateVerifiedtoken) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+func (m *MsgCreateVerifiedtoken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *MsgCreateVerifiedtoken) GetDenomUnits() []TokenDenomUnit {
+	if m != nil {
+		return m.DenomUnits
+	}
+	return nil
+}
+
 // MsgCreateVerifiedtokenResponse defines the MsgCreateVerifiedtokenResponse message.
 type MsgCreateVerifiedtokenResponse struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -560,7 +726,7 @@ func (m *MsgCreateVerifiedtokenResponse) Reset()         { *m = MsgCreateVerifie
 func (m *MsgCreateVerifiedtokenResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateVerifiedtokenResponse) ProtoMessage()    {}
 func (*MsgCreateVerifiedtokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{9}
+	return fileDescriptor_241469d78c289384, []int{11}
 }
 func (m *MsgCreateVerifiedtokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,17 +773,22 @@ type MsgUpdateVerifiedtoken struct {
 	Website               string `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
 	MaxSupply             uint64 `protobuf:"varint,8,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
 	MintedSupply          uint64 `protobuf:"varint,9,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
-	Verified              bool   `protobuf:"varint,10,opt,name=verified,proto3" json:"verified,omitempty"`
 	SeizureOptIn          bool   `protobuf:"varint,11,opt,name=seizure_opt_in,json=seizureOptIn,proto3" json:"seizure_opt_in,omitempty"`
 	RecoveryGroupPolicy   string `protobuf:"bytes,12,opt,name=recovery_group_policy,json=recoveryGroupPolicy,proto3" json:"recovery_group_policy,omitempty"`
 	RecoveryTimelockHours uint64 `protobuf:"varint,13,opt,name=recovery_timelock_hours,json=recoveryTimelockHours,proto3" json:"recovery_timelock_hours,omitempty"`
+	// merchant_id optionally links the token to an active merchant owned by the signer.
+	MerchantId uint64 `protobuf:"varint,14,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	// decimals is the display exponent; it is fixed once minting has started.
+	Decimals uint32 `protobuf:"varint,15,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// denom_units are extra bank denom units; they are fixed once minting has started.
+	DenomUnits []TokenDenomUnit `protobuf:"bytes,16,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units"`
 }
 
 func (m *MsgUpdateVerifiedtoken) Reset()         { *m = MsgUpdateVerifiedtoken{} }
 func (m *MsgUpdateVerifiedtoken) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVerifiedtoken) ProtoMessage()    {}
 func (*MsgUpdateVerifiedtoken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{10}
+	return fileDescriptor_241469d78c289384, []int{12}
 }
 func (m *MsgUpdateVerifiedtoken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,13 +880,6 @@ func (m *MsgUpdateVerifiedtoken) GetMintedSupply() uint64 {
 	return 0
 }
 
-func (m *MsgUpdateVerifiedtoken) GetVerified() bool {
-	if m != nil {
-		return m.Verified
-	}
-	return false
-}
-
 func (m *MsgUpdateVerifiedtoken) GetSeizureOptIn() bool {
 	if m != nil {
 		return m.SeizureOptIn
@@ -737,15 +901,40 @@ func (m *MsgUpdateVerifiedtoken) GetRecoveryTimelockHours() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateVerifiedtoken) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+func (m *MsgUpdateVerifiedtoken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *MsgUpdateVerifiedtoken) GetDenomUnits() []TokenDenomUnit {
+	if m != nil {
+		return m.DenomUnits
+	}
+	return nil
+}
+
 // MsgUpdateVerifiedtokenResponse defines the MsgUpdateVerifiedtokenResponse message.
 type MsgUpdateVerifiedtokenResponse struct {
+	// metadata_change_pending is set when a name, symbol or max_supply increase was
+	// scheduled rather than applied.
+	MetadataChangePending bool   `protobuf:"varint,1,opt,name=metadata_change_pending,json=metadataChangePending,proto3" json:"metadata_change_pending,omitempty"`
+	EffectiveAt           uint64 `protobuf:"varint,2,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
 }
 
 func (m *MsgUpdateVerifiedtokenResponse) Reset()         { *m = MsgUpdateVerifiedtokenResponse{} }
 func (m *MsgUpdateVerifiedtokenResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVerifiedtokenResponse) ProtoMessage()    {}
 func (*MsgUpdateVerifiedtokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{11}
+	return fileDescriptor_241469d78c289384, []int{13}
 }
 func (m *MsgUpdateVerifiedtokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,6 +963,20 @@ func (m *MsgUpdateVerifiedtokenResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateVerifiedtokenResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateVerifiedtokenResponse) GetMetadataChangePending() bool {
+	if m != nil {
+		return m.MetadataChangePending
+	}
+	return false
+}
+
+func (m *MsgUpdateVerifiedtokenResponse) GetEffectiveAt() uint64 {
+	if m != nil {
+		return m.EffectiveAt
+	}
+	return 0
+}
+
 // MsgRenounceTokenAdmin defines the MsgRenounceTokenAdmin message.
 type MsgRenounceTokenAdmin struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -784,7 +987,7 @@ func (m *MsgRenounceTokenAdmin) Reset()         { *m = MsgRenounceTokenAdmin{} }
 func (m *MsgRenounceTokenAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgRenounceTokenAdmin) ProtoMessage()    {}
 func (*MsgRenounceTokenAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{12}
+	return fileDescriptor_241469d78c289384, []int{14}
 }
 func (m *MsgRenounceTokenAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +1040,7 @@ func (m *MsgRenounceTokenAdminResponse) Reset()         { *m = MsgRenounceTokenA
 func (m *MsgRenounceTokenAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRenounceTokenAdminResponse) ProtoMessage()    {}
 func (*MsgRenounceTokenAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{13}
+	return fileDescriptor_241469d78c289384, []int{15}
 }
 func (m *MsgRenounceTokenAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,7 +1095,7 @@ func (m *MsgSetMerchantIncentiveRouting) Reset()         { *m = MsgSetMerchantIn
 func (m *MsgSetMerchantIncentiveRouting) String() string { return proto.CompactTextString(m) }
 func (*MsgSetMerchantIncentiveRouting) ProtoMessage()    {}
 func (*MsgSetMerchantIncentiveRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{14}
+	return fileDescriptor_241469d78c289384, []int{16}
 }
 func (m *MsgSetMerchantIncentiveRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -962,7 +1165,7 @@ func (m *MsgSetMerchantIncentiveRoutingResponse) Reset() {
 func (m *MsgSetMerchantIncentiveRoutingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetMerchantIncentiveRoutingResponse) ProtoMessage()    {}
 func (*MsgSetMerchantIncentiveRoutingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{15}
+	return fileDescriptor_241469d78c289384, []int{17}
 }
 func (m *MsgSetMerchantIncentiveRoutingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1022,7 +1225,7 @@ func (m *MsgDeleteVerifiedtoken) Reset()         { *m = MsgDeleteVerifiedtoken{}
 func (m *MsgDeleteVerifiedtoken) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteVerifiedtoken) ProtoMessage()    {}
 func (*MsgDeleteVerifiedtoken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{16}
+	return fileDescriptor_241469d78c289384, []int{18}
 }
 func (m *MsgDeleteVerifiedtoken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,7 +1276,7 @@ func (m *MsgDeleteVerifiedtokenResponse) Reset()         { *m = MsgDeleteVerifie
 func (m *MsgDeleteVerifiedtokenResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteVerifiedtokenResponse) ProtoMessage()    {}
 func (*MsgDeleteVerifiedtokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{17}
+	return fileDescriptor_241469d78c289384, []int{19}
 }
 func (m *MsgDeleteVerifiedtokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1319,7 @@ func (m *MsgCreateRewardaccrual) Reset()         { *m = MsgCreateRewardaccrual{}
 func (m *MsgCreateRewardaccrual) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRewardaccrual) ProtoMessage()    {}
 func (*MsgCreateRewardaccrual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{18}
+	return fileDescriptor_241469d78c289384, []int{20}
 }
 func (m *MsgCreateRewardaccrual) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1398,7 @@ func (m *MsgCreateRewardaccrualResponse) Reset()         { *m = MsgCreateRewarda
 func (m *MsgCreateRewardaccrualResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRewardaccrualResponse) ProtoMessage()    {}
 func (*MsgCreateRewardaccrualResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{19}
+	return fileDescriptor_241469d78c289384, []int{21}
 }
 func (m *MsgCreateRewardaccrualResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,7 +1441,7 @@ func (m *MsgUpdateRewardaccrual) Reset()         { *m = MsgUpdateRewardaccrual{}
 func (m *MsgUpdateRewardaccrual) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRewardaccrual) ProtoMessage()    {}
 func (*MsgUpdateRewardaccrual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{20}
+	return fileDescriptor_241469d78c289384, []int{22}
 }
 func (m *MsgUpdateRewardaccrual) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1317,7 +1520,7 @@ func (m *MsgUpdateRewardaccrualResponse) Reset()         { *m = MsgUpdateRewarda
 func (m *MsgUpdateRewardaccrualResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRewardaccrualResponse) ProtoMessage()    {}
 func (*MsgUpdateRewardaccrualResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{21}
+	return fileDescriptor_241469d78c289384, []int{23}
 }
 func (m *MsgUpdateRewardaccrualResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,7 +1559,7 @@ func (m *MsgDeleteRewardaccrual) Reset()         { *m = MsgDeleteRewardaccrual{}
 func (m *MsgDeleteRewardaccrual) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRewardaccrual) ProtoMessage()    {}
 func (*MsgDeleteRewardaccrual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{22}
+	return fileDescriptor_241469d78c289384, []int{24}
 }
 func (m *MsgDeleteRewardaccrual) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1610,7 @@ func (m *MsgDeleteRewardaccrualResponse) Reset()         { *m = MsgDeleteRewarda
 func (m *MsgDeleteRewardaccrualResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRewardaccrualResponse) ProtoMessage()    {}
 func (*MsgDeleteRewardaccrualResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{23}
+	return fileDescriptor_241469d78c289384, []int{25}
 }
 func (m *MsgDeleteRewardaccrualResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1448,7 +1651,7 @@ func (m *MsgMintVerifiedToken) Reset()         { *m = MsgMintVerifiedToken{} }
 func (m *MsgMintVerifiedToken) String() string { return proto.CompactTextString(m) }
 func (*MsgMintVerifiedToken) ProtoMessage()    {}
 func (*MsgMintVerifiedToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{24}
+	return fileDescriptor_241469d78c289384, []int{26}
 }
 func (m *MsgMintVerifiedToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1515,7 +1718,7 @@ func (m *MsgMintVerifiedTokenResponse) Reset()         { *m = MsgMintVerifiedTok
 func (m *MsgMintVerifiedTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintVerifiedTokenResponse) ProtoMessage()    {}
 func (*MsgMintVerifiedTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{25}
+	return fileDescriptor_241469d78c289384, []int{27}
 }
 func (m *MsgMintVerifiedTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1558,24 +1761,24 @@ func (m *MsgMintVerifiedTokenResponse) GetMintedSupply() uint64 {
 	return 0
 }
 
-// MsgClaimReward defines the MsgClaimReward message.
-type MsgClaimReward struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+// MintRecipient is one (recipient, amount) pair of a batch mint.
+type MintRecipient struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgClaimReward) Reset()         { *m = MsgClaimReward{} }
-func (m *MsgClaimReward) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimReward) ProtoMessage()    {}
-func (*MsgClaimReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{26}
+func (m *MintRecipient) Reset()         { *m = MintRecipient{} }
+func (m *MintRecipient) String() string { return proto.CompactTextString(m) }
+func (*MintRecipient) ProtoMessage()    {}
+func (*MintRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{28}
 }
-func (m *MsgClaimReward) XXX_Unmarshal(b []byte) error {
+func (m *MintRecipient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MintRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimReward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MintRecipient.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1585,51 +1788,52 @@ func (m *MsgClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimReward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimReward.Merge(m, src)
+func (m *MintRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecipient.Merge(m, src)
 }
-func (m *MsgClaimReward) XXX_Size() int {
+func (m *MintRecipient) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimReward) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimReward.DiscardUnknown(m)
+func (m *MintRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecipient.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimReward proto.InternalMessageInfo
+var xxx_messageInfo_MintRecipient proto.InternalMessageInfo
 
-func (m *MsgClaimReward) GetCreator() string {
+func (m *MintRecipient) GetRecipient() string {
 	if m != nil {
-		return m.Creator
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *MsgClaimReward) GetDenom() string {
+func (m *MintRecipient) GetAmount() uint64 {
 	if m != nil {
-		return m.Denom
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-// MsgClaimRewardResponse defines the MsgClaimRewardResponse message.
-type MsgClaimRewardResponse struct {
-	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	AmountClaimed uint64 `protobuf:"varint,3,opt,name=amount_claimed,json=amountClaimed,proto3" json:"amount_claimed,omitempty"`
+// MsgMintVerifiedTokenBatch mints denom to every recipient in a single bank multisend.
+// The aggregate amount is checked against the token cap once.
+type MsgMintVerifiedTokenBatch struct {
+	Creator    string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom      string          `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Recipients []MintRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
 }
 
-func (m *MsgClaimRewardResponse) Reset()         { *m = MsgClaimRewardResponse{} }
-func (m *MsgClaimRewardResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimRewardResponse) ProtoMessage()    {}
-func (*MsgClaimRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{27}
+func (m *MsgMintVerifiedTokenBatch) Reset()         { *m = MsgMintVerifiedTokenBatch{} }
+func (m *MsgMintVerifiedTokenBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgMintVerifiedTokenBatch) ProtoMessage()    {}
+func (*MsgMintVerifiedTokenBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{29}
 }
-func (m *MsgClaimRewardResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMintVerifiedTokenBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMintVerifiedTokenBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimRewardResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMintVerifiedTokenBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1639,56 +1843,240 @@ func (m *MsgClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimRewardResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimRewardResponse.Merge(m, src)
+func (m *MsgMintVerifiedTokenBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintVerifiedTokenBatch.Merge(m, src)
 }
-func (m *MsgClaimRewardResponse) XXX_Size() int {
+func (m *MsgMintVerifiedTokenBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimRewardResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimRewardResponse.DiscardUnknown(m)
+func (m *MsgMintVerifiedTokenBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintVerifiedTokenBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimRewardResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMintVerifiedTokenBatch proto.InternalMessageInfo
 
-func (m *MsgClaimRewardResponse) GetAddress() string {
+func (m *MsgMintVerifiedTokenBatch) GetCreator() string {
 	if m != nil {
-		return m.Address
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgClaimRewardResponse) GetDenom() string {
+func (m *MsgMintVerifiedTokenBatch) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *MsgClaimRewardResponse) GetAmountClaimed() uint64 {
+func (m *MsgMintVerifiedTokenBatch) GetRecipients() []MintRecipient {
 	if m != nil {
-		return m.AmountClaimed
+		return m.Recipients
 	}
-	return 0
+	return nil
 }
 
-// MsgFundRewardPool defines the MsgFundRewardPool message.
-type MsgFundRewardPool struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+// MsgMintVerifiedTokenBatchResponse defines the MsgMintVerifiedTokenBatchResponse message.
+type MsgMintVerifiedTokenBatchResponse struct {
+	Denom        string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MintedSupply uint64 `protobuf:"varint,2,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
+	TotalAmount  uint64 `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// minted lists what each recipient received, in request order.
+	Minted []MintRecipient `protobuf:"bytes,4,rep,name=minted,proto3" json:"minted"`
 }
 
-func (m *MsgFundRewardPool) Reset()         { *m = MsgFundRewardPool{} }
-func (m *MsgFundRewardPool) String() string { return proto.CompactTextString(m) }
-func (*MsgFundRewardPool) ProtoMessage()    {}
-func (*MsgFundRewardPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{28}
+func (m *MsgMintVerifiedTokenBatchResponse) Reset()         { *m = MsgMintVerifiedTokenBatchResponse{} }
+func (m *MsgMintVerifiedTokenBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintVerifiedTokenBatchResponse) ProtoMessage()    {}
+func (*MsgMintVerifiedTokenBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{30}
 }
-func (m *MsgFundRewardPool) XXX_Unmarshal(b []byte) error {
+func (m *MsgMintVerifiedTokenBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFundRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMintVerifiedTokenBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintVerifiedTokenBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintVerifiedTokenBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintVerifiedTokenBatchResponse.Merge(m, src)
+}
+func (m *MsgMintVerifiedTokenBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintVerifiedTokenBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintVerifiedTokenBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintVerifiedTokenBatchResponse proto.InternalMessageInfo
+
+func (m *MsgMintVerifiedTokenBatchResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgMintVerifiedTokenBatchResponse) GetMintedSupply() uint64 {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return 0
+}
+
+func (m *MsgMintVerifiedTokenBatchResponse) GetTotalAmount() uint64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *MsgMintVerifiedTokenBatchResponse) GetMinted() []MintRecipient {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+// MsgClaimReward defines the MsgClaimReward message.
+type MsgClaimReward struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgClaimReward) Reset()         { *m = MsgClaimReward{} }
+func (m *MsgClaimReward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReward) ProtoMessage()    {}
+func (*MsgClaimReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{31}
+}
+func (m *MsgClaimReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReward.Merge(m, src)
+}
+func (m *MsgClaimReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimReward proto.InternalMessageInfo
+
+func (m *MsgClaimReward) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgClaimReward) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgClaimRewardResponse defines the MsgClaimRewardResponse message.
+type MsgClaimRewardResponse struct {
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	AmountClaimed uint64 `protobuf:"varint,3,opt,name=amount_claimed,json=amountClaimed,proto3" json:"amount_claimed,omitempty"`
+}
+
+func (m *MsgClaimRewardResponse) Reset()         { *m = MsgClaimRewardResponse{} }
+func (m *MsgClaimRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardResponse) ProtoMessage()    {}
+func (*MsgClaimRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{32}
+}
+func (m *MsgClaimRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgClaimRewardResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgClaimRewardResponse) GetAmountClaimed() uint64 {
+	if m != nil {
+		return m.AmountClaimed
+	}
+	return 0
+}
+
+// MsgFundRewardPool defines the MsgFundRewardPool message.
+type MsgFundRewardPool struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgFundRewardPool) Reset()         { *m = MsgFundRewardPool{} }
+func (m *MsgFundRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPool) ProtoMessage()    {}
+func (*MsgFundRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{33}
+}
+func (m *MsgFundRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_MsgFundRewardPool.Marshal(b, m, deterministic)
 	} else {
@@ -1745,7 +2133,7 @@ func (m *MsgFundRewardPoolResponse) Reset()         { *m = MsgFundRewardPoolResp
 func (m *MsgFundRewardPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundRewardPoolResponse) ProtoMessage()    {}
 func (*MsgFundRewardPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{29}
+	return fileDescriptor_241469d78c289384, []int{34}
 }
 func (m *MsgFundRewardPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +2203,7 @@ func (m *MsgRecordRewardAccrual) Reset()         { *m = MsgRecordRewardAccrual{}
 func (m *MsgRecordRewardAccrual) String() string { return proto.CompactTextString(m) }
 func (*MsgRecordRewardAccrual) ProtoMessage()    {}
 func (*MsgRecordRewardAccrual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{30}
+	return fileDescriptor_241469d78c289384, []int{35}
 }
 func (m *MsgRecordRewardAccrual) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1893,7 +2281,7 @@ func (m *MsgRecordRewardAccrualResponse) Reset()         { *m = MsgRecordRewardA
 func (m *MsgRecordRewardAccrualResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRecordRewardAccrualResponse) ProtoMessage()    {}
 func (*MsgRecordRewardAccrualResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{31}
+	return fileDescriptor_241469d78c289384, []int{36}
 }
 func (m *MsgRecordRewardAccrualResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1977,7 +2365,7 @@ func (m *MsgRecordMerchantAllocation) Reset()         { *m = MsgRecordMerchantAl
 func (m *MsgRecordMerchantAllocation) String() string { return proto.CompactTextString(m) }
 func (*MsgRecordMerchantAllocation) ProtoMessage()    {}
 func (*MsgRecordMerchantAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{32}
+	return fileDescriptor_241469d78c289384, []int{37}
 }
 func (m *MsgRecordMerchantAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2059,7 +2447,7 @@ func (m *MsgRecordMerchantAllocationResponse) Reset()         { *m = MsgRecordMe
 func (m *MsgRecordMerchantAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRecordMerchantAllocationResponse) ProtoMessage()    {}
 func (*MsgRecordMerchantAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{33}
+	return fileDescriptor_241469d78c289384, []int{38}
 }
 func (m *MsgRecordMerchantAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2171,7 +2559,7 @@ func (m *MsgQueueRecoveryTransfer) Reset()         { *m = MsgQueueRecoveryTransf
 func (m *MsgQueueRecoveryTransfer) String() string { return proto.CompactTextString(m) }
 func (*MsgQueueRecoveryTransfer) ProtoMessage()    {}
 func (*MsgQueueRecoveryTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{34}
+	return fileDescriptor_241469d78c289384, []int{39}
 }
 func (m *MsgQueueRecoveryTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2246,7 +2634,7 @@ func (m *MsgQueueRecoveryTransferResponse) Reset()         { *m = MsgQueueRecove
 func (m *MsgQueueRecoveryTransferResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgQueueRecoveryTransferResponse) ProtoMessage()    {}
 func (*MsgQueueRecoveryTransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{35}
+	return fileDescriptor_241469d78c289384, []int{40}
 }
 func (m *MsgQueueRecoveryTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2306,7 +2694,7 @@ func (m *MsgExecuteRecoveryTransfer) Reset()         { *m = MsgExecuteRecoveryTr
 func (m *MsgExecuteRecoveryTransfer) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteRecoveryTransfer) ProtoMessage()    {}
 func (*MsgExecuteRecoveryTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{36}
+	return fileDescriptor_241469d78c289384, []int{41}
 }
 func (m *MsgExecuteRecoveryTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2360,7 +2748,7 @@ func (m *MsgExecuteRecoveryTransferResponse) Reset()         { *m = MsgExecuteRe
 func (m *MsgExecuteRecoveryTransferResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteRecoveryTransferResponse) ProtoMessage()    {}
 func (*MsgExecuteRecoveryTransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{37}
+	return fileDescriptor_241469d78c289384, []int{42}
 }
 func (m *MsgExecuteRecoveryTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2421,7 +2809,7 @@ func (m *MsgCancelRecoveryTransfer) Reset()         { *m = MsgCancelRecoveryTran
 func (m *MsgCancelRecoveryTransfer) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRecoveryTransfer) ProtoMessage()    {}
 func (*MsgCancelRecoveryTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{38}
+	return fileDescriptor_241469d78c289384, []int{43}
 }
 func (m *MsgCancelRecoveryTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2482,7 +2870,7 @@ func (m *MsgCancelRecoveryTransferResponse) Reset()         { *m = MsgCancelReco
 func (m *MsgCancelRecoveryTransferResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRecoveryTransferResponse) ProtoMessage()    {}
 func (*MsgCancelRecoveryTransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241469d78c289384, []int{39}
+	return fileDescriptor_241469d78c289384, []int{44}
 }
 func (m *MsgCancelRecoveryTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2532,3581 +2920,17345 @@ func (m *MsgCancelRecoveryTransferResponse) GetCancelledAt() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "tokenchain.loyalty.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateCreatorallowlist)(nil), "tokenchain.loyalty.v1.MsgCreateCreatorallowlist")
-	proto.RegisterType((*MsgCreateCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.MsgCreateCreatorallowlistResponse")
-	proto.RegisterType((*MsgUpdateCreatorallowlist)(nil), "tokenchain.loyalty.v1.MsgUpdateCreatorallowlist")
-	proto.RegisterType((*MsgUpdateCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateCreatorallowlistResponse")
-	proto.RegisterType((*MsgDeleteCreatorallowlist)(nil), "tokenchain.loyalty.v1.MsgDeleteCreatorallowlist")
-	proto.RegisterType((*MsgDeleteCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.MsgDeleteCreatorallowlistResponse")
-	proto.RegisterType((*MsgCreateVerifiedtoken)(nil), "tokenchain.loyalty.v1.MsgCreateVerifiedtoken")
-	proto.RegisterType((*MsgCreateVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.MsgCreateVerifiedtokenResponse")
-	proto.RegisterType((*MsgUpdateVerifiedtoken)(nil), "tokenchain.loyalty.v1.MsgUpdateVerifiedtoken")
-	proto.RegisterType((*MsgUpdateVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateVerifiedtokenResponse")
-	proto.RegisterType((*MsgRenounceTokenAdmin)(nil), "tokenchain.loyalty.v1.MsgRenounceTokenAdmin")
-	proto.RegisterType((*MsgRenounceTokenAdminResponse)(nil), "tokenchain.loyalty.v1.MsgRenounceTokenAdminResponse")
-	proto.RegisterType((*MsgSetMerchantIncentiveRouting)(nil), "tokenchain.loyalty.v1.MsgSetMerchantIncentiveRouting")
-	proto.RegisterType((*MsgSetMerchantIncentiveRoutingResponse)(nil), "tokenchain.loyalty.v1.MsgSetMerchantIncentiveRoutingResponse")
-	proto.RegisterType((*MsgDeleteVerifiedtoken)(nil), "tokenchain.loyalty.v1.MsgDeleteVerifiedtoken")
-	proto.RegisterType((*MsgDeleteVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.MsgDeleteVerifiedtokenResponse")
-	proto.RegisterType((*MsgCreateRewardaccrual)(nil), "tokenchain.loyalty.v1.MsgCreateRewardaccrual")
-	proto.RegisterType((*MsgCreateRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.MsgCreateRewardaccrualResponse")
-	proto.RegisterType((*MsgUpdateRewardaccrual)(nil), "tokenchain.loyalty.v1.MsgUpdateRewardaccrual")
-	proto.RegisterType((*MsgUpdateRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateRewardaccrualResponse")
-	proto.RegisterType((*MsgDeleteRewardaccrual)(nil), "tokenchain.loyalty.v1.MsgDeleteRewardaccrual")
-	proto.RegisterType((*MsgDeleteRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.MsgDeleteRewardaccrualResponse")
-	proto.RegisterType((*MsgMintVerifiedToken)(nil), "tokenchain.loyalty.v1.MsgMintVerifiedToken")
-	proto.RegisterType((*MsgMintVerifiedTokenResponse)(nil), "tokenchain.loyalty.v1.MsgMintVerifiedTokenResponse")
-	proto.RegisterType((*MsgClaimReward)(nil), "tokenchain.loyalty.v1.MsgClaimReward")
-	proto.RegisterType((*MsgClaimRewardResponse)(nil), "tokenchain.loyalty.v1.MsgClaimRewardResponse")
-	proto.RegisterType((*MsgFundRewardPool)(nil), "tokenchain.loyalty.v1.MsgFundRewardPool")
-	proto.RegisterType((*MsgFundRewardPoolResponse)(nil), "tokenchain.loyalty.v1.MsgFundRewardPoolResponse")
-	proto.RegisterType((*MsgRecordRewardAccrual)(nil), "tokenchain.loyalty.v1.MsgRecordRewardAccrual")
-	proto.RegisterType((*MsgRecordRewardAccrualResponse)(nil), "tokenchain.loyalty.v1.MsgRecordRewardAccrualResponse")
-	proto.RegisterType((*MsgRecordMerchantAllocation)(nil), "tokenchain.loyalty.v1.MsgRecordMerchantAllocation")
-	proto.RegisterType((*MsgRecordMerchantAllocationResponse)(nil), "tokenchain.loyalty.v1.MsgRecordMerchantAllocationResponse")
-	proto.RegisterType((*MsgQueueRecoveryTransfer)(nil), "tokenchain.loyalty.v1.MsgQueueRecoveryTransfer")
-	proto.RegisterType((*MsgQueueRecoveryTransferResponse)(nil), "tokenchain.loyalty.v1.MsgQueueRecoveryTransferResponse")
-	proto.RegisterType((*MsgExecuteRecoveryTransfer)(nil), "tokenchain.loyalty.v1.MsgExecuteRecoveryTransfer")
-	proto.RegisterType((*MsgExecuteRecoveryTransferResponse)(nil), "tokenchain.loyalty.v1.MsgExecuteRecoveryTransferResponse")
-	proto.RegisterType((*MsgCancelRecoveryTransfer)(nil), "tokenchain.loyalty.v1.MsgCancelRecoveryTransfer")
-	proto.RegisterType((*MsgCancelRecoveryTransferResponse)(nil), "tokenchain.loyalty.v1.MsgCancelRecoveryTransferResponse")
+// MsgRegisterMerchant defines the MsgRegisterMerchant message.
+type MsgRegisterMerchant struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LegalName     string `protobuf:"bytes,2,opt,name=legal_name,json=legalName,proto3" json:"legal_name,omitempty"`
+	PayoutAddress string `protobuf:"bytes,3,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	ContactUri    string `protobuf:"bytes,4,opt,name=contact_uri,json=contactUri,proto3" json:"contact_uri,omitempty"`
+	LogoHash      string `protobuf:"bytes,5,opt,name=logo_hash,json=logoHash,proto3" json:"logo_hash,omitempty"`
 }
 
-func init() { proto.RegisterFile("tokenchain/loyalty/v1/tx.proto", fileDescriptor_241469d78c289384) }
-
-var fileDescriptor_241469d78c289384 = []byte{
-	// 1875 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0x7b, 0x26, 0x8e, 0xe7, 0xcd, 0x78, 0xb2, 0xe9, 0x7c, 0x75, 0x3a, 0xc9, 0xd8, 0x99,
-	0x6c, 0xb2, 0x56, 0xc0, 0x76, 0x92, 0x5d, 0xb2, 0x10, 0x09, 0x89, 0x71, 0x76, 0x17, 0xf6, 0x30,
-	0x22, 0xb4, 0x03, 0x12, 0x1c, 0x68, 0x95, 0xbb, 0xcb, 0xe3, 0x96, 0xbb, 0xbb, 0x5a, 0x5d, 0xd5,
-	0xb6, 0x07, 0x2e, 0x7c, 0x2c, 0x42, 0xda, 0x13, 0x88, 0x3b, 0x27, 0x84, 0x38, 0xe6, 0x80, 0xf8,
-	0x0b, 0x38, 0x2c, 0x88, 0xc3, 0x8a, 0xd3, 0x9e, 0x56, 0x28, 0x39, 0xe4, 0xca, 0x9f, 0x80, 0xba,
-	0xaa, 0xba, 0xe7, 0xab, 0xba, 0xdb, 0x13, 0x6c, 0x81, 0x56, 0x7b, 0xb1, 0xa6, 0x5e, 0xbd, 0x7a,
-	0xef, 0xf7, 0x3e, 0xfa, 0xd5, 0xab, 0x27, 0x43, 0x87, 0x91, 0x7d, 0x1c, 0x3a, 0x7b, 0xc8, 0x0b,
-	0x37, 0x7d, 0x32, 0x44, 0x3e, 0x1b, 0x6e, 0x1e, 0x3c, 0xd8, 0x64, 0x47, 0x1b, 0x51, 0x4c, 0x18,
-	0xd1, 0x2f, 0x8f, 0xf6, 0x37, 0xe4, 0xfe, 0xc6, 0xc1, 0x03, 0xf3, 0x02, 0x0a, 0xbc, 0x90, 0x6c,
-	0xf2, 0xbf, 0x82, 0xd3, 0xbc, 0xea, 0x10, 0x1a, 0x10, 0xba, 0x19, 0xd0, 0x41, 0x2a, 0x21, 0xa0,
-	0x03, 0xb9, 0x71, 0x4d, 0x6c, 0xd8, 0x7c, 0xb5, 0x29, 0x16, 0x72, 0xeb, 0xd2, 0x80, 0x0c, 0x88,
-	0xa0, 0xa7, 0xbf, 0x24, 0xb5, 0xab, 0xc6, 0x14, 0xa1, 0x18, 0x05, 0xf2, 0x64, 0xf7, 0xaf, 0x1a,
-	0x9c, 0xef, 0xd3, 0xc1, 0xf7, 0x23, 0x17, 0x31, 0xfc, 0x94, 0xef, 0xe8, 0x8f, 0xa0, 0x81, 0x12,
-	0xb6, 0x47, 0x62, 0x8f, 0x0d, 0x0d, 0x6d, 0x55, 0x5b, 0x6b, 0x6c, 0x19, 0xff, 0xfc, 0xf3, 0xfa,
-	0x25, 0xa9, 0xb2, 0xe7, 0xba, 0x31, 0xa6, 0x74, 0x9b, 0xc5, 0x5e, 0x38, 0xb0, 0x46, 0xac, 0xfa,
-	0xb7, 0x60, 0x51, 0xc8, 0x36, 0x16, 0x56, 0xb5, 0xb5, 0xe6, 0xc3, 0x9b, 0x1b, 0x4a, 0xa3, 0x37,
-	0x84, 0x9a, 0xad, 0xc6, 0x27, 0x9f, 0xaf, 0x9c, 0xf9, 0xd3, 0xab, 0xe7, 0xf7, 0x34, 0x4b, 0x9e,
-	0x7b, 0xfc, 0xee, 0x2f, 0x5e, 0x3d, 0xbf, 0x37, 0x92, 0xf8, 0xf1, 0xab, 0xe7, 0xf7, 0xde, 0x1c,
-	0x33, 0xe2, 0x28, 0x37, 0x63, 0x0a, 0x72, 0xf7, 0x1a, 0x5c, 0x9d, 0x22, 0x59, 0x98, 0x46, 0x24,
-	0xa4, 0xb8, 0xfb, 0x5b, 0x0d, 0xae, 0xf5, 0xe9, 0xe0, 0x49, 0x8c, 0x11, 0xc3, 0xfc, 0x2f, 0x89,
-	0x91, 0xef, 0x93, 0x43, 0xdf, 0xa3, 0x4c, 0x7f, 0x08, 0xe7, 0x1c, 0x41, 0xab, 0xb4, 0x34, 0x63,
-	0xd4, 0x0d, 0x38, 0x87, 0xc4, 0x0e, 0x37, 0xb4, 0x61, 0x65, 0xcb, 0x74, 0x07, 0x87, 0x68, 0xc7,
-	0xc7, 0xae, 0x51, 0x5b, 0xd5, 0xd6, 0x96, 0xac, 0x6c, 0xf9, 0xb8, 0x95, 0x5a, 0x96, 0x49, 0xe8,
-	0xde, 0x86, 0x5b, 0x85, 0x90, 0xa6, 0x81, 0x0b, 0xa3, 0xfe, 0xaf, 0x80, 0xab, 0x21, 0xe5, 0xc0,
-	0x0f, 0x39, 0xee, 0xf7, 0xb0, 0x8f, 0x4f, 0x1b, 0xb7, 0x12, 0x9d, 0x5a, 0x71, 0x8e, 0xee, 0x45,
-	0x0d, 0xae, 0xe4, 0xce, 0xff, 0x01, 0x8e, 0xbd, 0x5d, 0x0f, 0xbb, 0x3c, 0xc9, 0x5e, 0x0b, 0xdb,
-	0x25, 0x38, 0xeb, 0xe2, 0x90, 0x04, 0x12, 0x99, 0x58, 0xe8, 0x57, 0x60, 0xd1, 0xa3, 0x34, 0xc1,
-	0x31, 0x77, 0x67, 0xc3, 0x92, 0x2b, 0x5d, 0x87, 0x7a, 0x88, 0x02, 0x6c, 0xd4, 0x39, 0x95, 0xff,
-	0x4e, 0x79, 0xe9, 0x30, 0xd8, 0x21, 0xbe, 0x71, 0x56, 0xf0, 0x8a, 0x95, 0xbe, 0x0a, 0x4d, 0x17,
-	0x53, 0x27, 0xf6, 0x22, 0xe6, 0x91, 0xd0, 0x58, 0xe4, 0x9b, 0xe3, 0xa4, 0xd4, 0x2f, 0x87, 0x78,
-	0x87, 0x7a, 0x0c, 0x1b, 0xe7, 0x84, 0x5f, 0xe4, 0x52, 0xbf, 0x09, 0x10, 0xa0, 0x23, 0x9b, 0x26,
-	0x51, 0xe4, 0x0f, 0x8d, 0xa5, 0x55, 0x6d, 0xad, 0x6e, 0x35, 0x02, 0x74, 0xb4, 0xcd, 0x09, 0xfa,
-	0x6d, 0x58, 0x0e, 0xbc, 0x90, 0x61, 0x37, 0xe3, 0x68, 0x70, 0x8e, 0x96, 0x20, 0x4a, 0x26, 0x13,
-	0x96, 0x0e, 0xa4, 0x7b, 0x0c, 0xe0, 0x49, 0x91, 0xaf, 0xf5, 0x37, 0xa1, 0x4d, 0xb1, 0xf7, 0x93,
-	0x24, 0xc6, 0x36, 0x89, 0x98, 0xed, 0x85, 0x46, 0x93, 0x73, 0xb4, 0x24, 0xf5, 0xbb, 0x11, 0xfb,
-	0x30, 0xf5, 0xe7, 0xe5, 0x18, 0x3b, 0xe4, 0x00, 0xc7, 0x43, 0x7b, 0x10, 0x93, 0x24, 0xb2, 0x23,
-	0xe2, 0x7b, 0xce, 0xd0, 0x68, 0x71, 0xb4, 0x17, 0xb3, 0xcd, 0x6f, 0xa7, 0x7b, 0x4f, 0xf9, 0x96,
-	0xfe, 0x08, 0xae, 0xe6, 0x67, 0x98, 0x17, 0x60, 0x9f, 0x38, 0xfb, 0xf6, 0x1e, 0x49, 0x62, 0x6a,
-	0x2c, 0x73, 0x90, 0xb9, 0xc8, 0x67, 0x72, 0xf7, 0x3b, 0xe9, 0xe6, 0x54, 0x26, 0x3c, 0x82, 0x8e,
-	0x3a, 0xc6, 0x59, 0x1a, 0x8c, 0xe2, 0xa6, 0x8d, 0xc5, 0x2d, 0x4b, 0x0e, 0x91, 0xe0, 0x5f, 0x26,
-	0xc7, 0x17, 0x33, 0x39, 0x56, 0x79, 0x72, 0x28, 0x62, 0x9c, 0xd7, 0x08, 0x02, 0x97, 0xfb, 0x74,
-	0x60, 0xe1, 0x90, 0x24, 0xa1, 0x83, 0x9f, 0xa5, 0x7b, 0x3d, 0x37, 0xf0, 0x4e, 0x30, 0x09, 0xa6,
-	0x20, 0xfd, 0x18, 0x6e, 0x2a, 0x15, 0x96, 0xa7, 0xab, 0xfe, 0x16, 0x9c, 0x47, 0x29, 0x9b, 0x1d,
-	0xcb, 0x93, 0x2e, 0x57, 0xb2, 0x64, 0xb5, 0x91, 0x38, 0x2d, 0xa9, 0xdd, 0x8f, 0x16, 0xb8, 0xcd,
-	0xdb, 0x98, 0xf5, 0x71, 0xec, 0xec, 0xa1, 0x90, 0x7d, 0x18, 0x3a, 0x38, 0x64, 0xde, 0x01, 0xb6,
-	0x48, 0xc2, 0xbc, 0x70, 0x70, 0x82, 0xf9, 0xfd, 0x04, 0x3a, 0x81, 0xd4, 0x62, 0x7b, 0x99, 0x1a,
-	0x9b, 0x32, 0xb4, 0x8f, 0x63, 0x6a, 0xef, 0x44, 0x94, 0xe7, 0x7d, 0xdd, 0xba, 0x1e, 0x4c, 0x63,
-	0xd9, 0x16, 0x3c, 0x5b, 0x11, 0xd5, 0xdf, 0x87, 0x15, 0x85, 0x10, 0x16, 0x63, 0x44, 0x93, 0x78,
-	0xc8, 0xa5, 0xd4, 0xb9, 0x94, 0x1b, 0x33, 0x52, 0x9e, 0x49, 0xa6, 0xad, 0x68, 0x3a, 0xf2, 0x7f,
-	0xd7, 0xe0, 0x6e, 0xb9, 0x1b, 0x2a, 0x1c, 0x5e, 0x6d, 0xda, 0xc2, 0x89, 0x98, 0x56, 0xab, 0x36,
-	0xad, 0x1b, 0xf1, 0x52, 0x25, 0x6e, 0xbb, 0x53, 0x2a, 0x55, 0xca, 0x0f, 0x47, 0xa1, 0x31, 0xff,
-	0x70, 0x3e, 0xd7, 0xc6, 0x2e, 0x57, 0x0b, 0x1f, 0xa2, 0xd8, 0x45, 0x8e, 0x13, 0x27, 0xc8, 0x7f,
-	0x2d, 0x50, 0x6f, 0x40, 0x6d, 0x1f, 0x0f, 0x25, 0xa4, 0xf4, 0xe7, 0x78, 0x2b, 0x50, 0x9b, 0x6c,
-	0x61, 0x72, 0x03, 0xea, 0x53, 0xb5, 0x16, 0x05, 0x24, 0x09, 0x19, 0xaf, 0x9f, 0x75, 0x4b, 0xae,
-	0xf4, 0x35, 0x78, 0xc3, 0x47, 0x94, 0xd9, 0x31, 0xf1, 0xfd, 0x24, 0xb2, 0xd3, 0x52, 0x20, 0x8b,
-	0x68, 0x3b, 0xa5, 0x5b, 0x9c, 0xfc, 0x1e, 0x62, 0x58, 0xe9, 0x02, 0x85, 0x7d, 0xd3, 0x2e, 0x10,
-	0xe5, 0xe5, 0x8b, 0xeb, 0x02, 0x85, 0x7d, 0xb9, 0x0b, 0xfc, 0xb1, 0xcc, 0x3c, 0x05, 0x0f, 0x94,
-	0x64, 0xa5, 0x1a, 0xcf, 0x1f, 0x34, 0xb8, 0xd4, 0xa7, 0x83, 0xbe, 0x17, 0xb2, 0x2c, 0x6d, 0x9f,
-	0x9d, 0xf0, 0x9d, 0x7e, 0x03, 0x1a, 0x31, 0x76, 0xbc, 0xc8, 0xc3, 0x21, 0x93, 0x61, 0x19, 0x11,
-	0xc6, 0x42, 0x50, 0x1f, 0x0f, 0xc1, 0x94, 0x21, 0x3f, 0x84, 0x1b, 0x2a, 0x94, 0x15, 0x25, 0x69,
-	0xe6, 0xba, 0x5e, 0x98, 0xbd, 0xae, 0xbb, 0x7b, 0xd0, 0x4e, 0xd3, 0xd6, 0x47, 0x5e, 0x20, 0x5c,
-	0x74, 0x6a, 0x35, 0x82, 0x88, 0x02, 0x30, 0xd2, 0x94, 0xc3, 0x1f, 0xcb, 0x5b, 0xad, 0x20, 0x6f,
-	0x27, 0x5c, 0x7a, 0x07, 0xda, 0xc2, 0x4d, 0xb6, 0x93, 0x4a, 0x93, 0x4f, 0x93, 0xba, 0xb5, 0x2c,
-	0xa8, 0x4f, 0x04, 0xb1, 0xfb, 0x4b, 0x0d, 0x2e, 0xf4, 0xe9, 0xe0, 0x83, 0x24, 0x74, 0x85, 0xc2,
-	0xa7, 0x84, 0xf8, 0x27, 0xdb, 0xad, 0xc9, 0xd8, 0xd5, 0x4a, 0x62, 0xf7, 0x7b, 0xf1, 0x58, 0x9b,
-	0x44, 0x91, 0x9b, 0x7e, 0x07, 0xda, 0x01, 0x71, 0x13, 0x1f, 0xdb, 0x93, 0x1e, 0x58, 0x16, 0xd4,
-	0x5e, 0xa9, 0x1f, 0x6e, 0x83, 0xb4, 0xd8, 0xde, 0x4d, 0x42, 0x37, 0x77, 0x43, 0x4b, 0x10, 0x3f,
-	0xe0, 0x34, 0x7d, 0x05, 0x9a, 0x21, 0x3e, 0xb4, 0x77, 0x90, 0x8f, 0x42, 0x27, 0x6b, 0x21, 0x21,
-	0xc4, 0x87, 0x5b, 0x82, 0xd2, 0xfd, 0x8b, 0x28, 0x4b, 0x16, 0x76, 0x48, 0x2c, 0x21, 0xf6, 0xfe,
-	0x8b, 0x8f, 0xb2, 0xf8, 0x29, 0x99, 0x1b, 0x51, 0x53, 0x7b, 0x71, 0xe2, 0x0b, 0x48, 0x7b, 0x5e,
-	0x5e, 0x78, 0x44, 0x77, 0xcb, 0x7f, 0x4f, 0x79, 0xf6, 0x6f, 0x1a, 0xff, 0xbe, 0x15, 0xc0, 0x73,
-	0xf7, 0xca, 0x0a, 0xa1, 0x29, 0x6b, 0xe4, 0xb1, 0xe0, 0xdd, 0x02, 0xe9, 0xce, 0x34, 0x40, 0xd8,
-	0x95, 0x20, 0x9b, 0x82, 0xd6, 0x4b, 0x49, 0x29, 0x0b, 0x23, 0x0c, 0xf9, 0xf6, 0x44, 0x31, 0x6d,
-	0x72, 0x5a, 0x4f, 0x18, 0xb3, 0x02, 0xcd, 0xd9, 0x62, 0x0a, 0x71, 0x5e, 0x48, 0xbb, 0x9f, 0x69,
-	0x70, 0x3d, 0xb7, 0x25, 0x6b, 0x41, 0x7a, 0xbe, 0x4f, 0x1c, 0xc4, 0x7b, 0xf6, 0xd7, 0x89, 0x44,
-	0xe6, 0xc1, 0x85, 0x91, 0x07, 0x0b, 0x8c, 0x4c, 0x3f, 0x28, 0x87, 0x79, 0x07, 0x1e, 0x1b, 0xda,
-	0xd4, 0x21, 0x31, 0x96, 0x66, 0x2e, 0x67, 0xd4, 0xed, 0x94, 0xa8, 0xdf, 0x85, 0xf3, 0x3b, 0x89,
-	0xb3, 0x8f, 0x99, 0xed, 0x4c, 0xda, 0xba, 0x2c, 0xc8, 0x4f, 0x7a, 0xaa, 0x0f, 0xe0, 0x8f, 0x35,
-	0xb8, 0x5d, 0x62, 0x5a, 0x49, 0xac, 0xfe, 0x57, 0x06, 0xa4, 0xe2, 0xb2, 0xce, 0x4d, 0xb2, 0x2d,
-	0x0a, 0x36, 0x49, 0x95, 0x6c, 0x6f, 0xc1, 0xf9, 0xbc, 0x37, 0x93, 0x7c, 0xe7, 0x38, 0x5f, 0x3b,
-	0x23, 0x4b, 0xc6, 0xea, 0xe6, 0x70, 0xe9, 0x44, 0x9a, 0xc3, 0x46, 0x75, 0x73, 0x98, 0x7e, 0x00,
-	0x09, 0xbf, 0xa1, 0xb3, 0xe7, 0x59, 0xb6, 0xec, 0xfe, 0x43, 0x03, 0xa3, 0x4f, 0x07, 0xdf, 0x4b,
-	0x70, 0x82, 0xad, 0xec, 0xb5, 0x14, 0xa3, 0x90, 0xee, 0xe2, 0xf8, 0x04, 0xcb, 0xe6, 0x2d, 0x68,
-	0xed, 0xc6, 0x24, 0xb0, 0x27, 0x5b, 0x95, 0x66, 0x4a, 0xcb, 0xca, 0xdd, 0x4d, 0x00, 0x46, 0x72,
-	0x06, 0x51, 0xb2, 0x1a, 0x8c, 0x64, 0xdb, 0x05, 0x7d, 0xcb, 0xcc, 0x7d, 0xb3, 0x5a, 0x64, 0x4d,
-	0x9e, 0x73, 0x6d, 0x58, 0xf0, 0x5c, 0x6e, 0x50, 0xdd, 0x5a, 0xf0, 0x5c, 0xfe, 0xa8, 0x66, 0x88,
-	0x25, 0x59, 0x71, 0x90, 0xab, 0xb4, 0xd2, 0xe2, 0x23, 0xec, 0x24, 0x0c, 0xdb, 0x68, 0x97, 0xc9,
-	0xf7, 0x79, 0xdd, 0x6a, 0x49, 0x62, 0x2f, 0xa5, 0x75, 0x43, 0x30, 0xfb, 0x74, 0xf0, 0xbe, 0x20,
-	0x9d, 0x88, 0x03, 0x05, 0xbc, 0x85, 0x0c, 0xde, 0x94, 0x81, 0x01, 0x74, 0x8b, 0xf5, 0xcd, 0x6d,
-	0xe2, 0x0a, 0x34, 0xa5, 0x35, 0xae, 0x8d, 0xb2, 0x2b, 0x0d, 0x32, 0x52, 0x8f, 0x75, 0x7f, 0x25,
-	0xc7, 0xa5, 0xe9, 0xa5, 0xe1, 0x9f, 0x86, 0x79, 0x29, 0xb4, 0x34, 0x51, 0x49, 0x98, 0x8d, 0x3f,
-	0xc4, 0x6a, 0xca, 0xec, 0x50, 0x8c, 0x48, 0x95, 0x30, 0xe6, 0xb6, 0xfa, 0x16, 0xb4, 0x1c, 0x2e,
-	0xc9, 0x1f, 0x37, 0xbb, 0x99, 0xd3, 0x7a, 0xec, 0xe1, 0xbf, 0x2f, 0x42, 0xad, 0x4f, 0x07, 0xfa,
-	0x2e, 0xb4, 0x26, 0x86, 0xe1, 0x77, 0x0b, 0x86, 0xd8, 0x53, 0xe3, 0x66, 0x73, 0xe3, 0x78, 0x7c,
-	0x39, 0xf4, 0x8f, 0x34, 0xb8, 0x52, 0x30, 0x93, 0xbe, 0x5f, 0x2c, 0x4a, 0x7d, 0xc2, 0xfc, 0xfa,
-	0xbc, 0x27, 0x26, 0x60, 0x14, 0x4c, 0x98, 0xef, 0x57, 0x59, 0x34, 0x0f, 0x8c, 0xf2, 0x91, 0x31,
-	0x87, 0x51, 0x30, 0x30, 0x2e, 0x81, 0xa1, 0x3e, 0x51, 0x06, 0xa3, 0x7c, 0x36, 0xac, 0xff, 0x14,
-	0x2e, 0xaa, 0xe6, 0xc2, 0xeb, 0x55, 0xee, 0x9d, 0x60, 0x37, 0xbf, 0x36, 0x17, 0xfb, 0xb8, 0x72,
-	0xd5, 0xdc, 0x71, 0xbd, 0xca, 0xa9, 0xc7, 0x56, 0x5e, 0x32, 0xf1, 0xd2, 0x8f, 0x40, 0x57, 0x8c,
-	0xbb, 0xbe, 0x5a, 0x2c, 0x6c, 0x96, 0xdb, 0x7c, 0x67, 0x1e, 0xee, 0x5c, 0xf3, 0xef, 0x34, 0xb8,
-	0x5e, 0x36, 0x97, 0x2a, 0x31, 0xa8, 0xe4, 0x98, 0xf9, 0xcd, 0xd7, 0x3a, 0x36, 0x1e, 0x0c, 0xd5,
-	0x64, 0x65, 0xbd, 0x2a, 0xb5, 0x8e, 0x1d, 0x8c, 0x92, 0x29, 0xca, 0x28, 0x0d, 0x27, 0x1f, 0xcf,
-	0x95, 0x69, 0x38, 0xc1, 0x5e, 0x9d, 0x86, 0xca, 0xc7, 0xf2, 0x28, 0x0d, 0x8f, 0xad, 0x5c, 0xc1,
-	0x5e, 0x9d, 0x86, 0x85, 0xca, 0x55, 0x63, 0x83, 0x4a, 0xb7, 0x1f, 0x5b, 0x79, 0xc9, 0x98, 0x40,
-	0x4f, 0xe0, 0xc2, 0xec, 0x88, 0xe0, 0x2b, 0xc5, 0xb2, 0x66, 0x98, 0xcd, 0xb7, 0xe7, 0x60, 0xce,
-	0xd5, 0x3a, 0xd0, 0x1c, 0x7f, 0x98, 0xdf, 0x29, 0x09, 0xdb, 0x88, 0xcd, 0x5c, 0x3f, 0x16, 0x5b,
-	0xae, 0xc4, 0x87, 0xf6, 0xd4, 0x0b, 0x79, 0xad, 0x58, 0xc0, 0x24, 0xa7, 0x79, 0xff, 0xb8, 0x9c,
-	0xe3, 0x61, 0x54, 0x3d, 0x34, 0xd7, 0xcb, 0x0a, 0xc4, 0x0c, 0x7b, 0x59, 0x18, 0xcb, 0x5e, 0x83,
-	0x1f, 0x6b, 0x60, 0x14, 0xbf, 0xb0, 0xaa, 0x64, 0xce, 0x9e, 0x31, 0x1f, 0xcf, 0x7f, 0x26, 0x07,
-	0xf3, 0x73, 0x0d, 0x2e, 0xab, 0x5b, 0xed, 0xcd, 0x62, 0xa9, 0xca, 0x03, 0xe6, 0xbb, 0x73, 0x1e,
-	0xc8, 0x31, 0xfc, 0x5a, 0x83, 0xab, 0x45, 0xfd, 0xea, 0x83, 0x62, 0xa1, 0x05, 0x47, 0xcc, 0x6f,
-	0xcc, 0x7d, 0x64, 0xb2, 0xe9, 0x51, 0x77, 0x96, 0x65, 0x4d, 0x8f, 0xf2, 0x44, 0x69, 0xd3, 0x53,
-	0xda, 0x36, 0x9a, 0x67, 0x7f, 0xf6, 0xea, 0xf9, 0x3d, 0x6d, 0xeb, 0x9d, 0x4f, 0x5e, 0x74, 0xb4,
-	0x4f, 0x5f, 0x74, 0xb4, 0x7f, 0xbd, 0xe8, 0x68, 0xbf, 0x79, 0xd9, 0x39, 0xf3, 0xe9, 0xcb, 0xce,
-	0x99, 0xcf, 0x5e, 0x76, 0xce, 0xfc, 0xc8, 0x54, 0xfe, 0xd3, 0x01, 0x1b, 0x46, 0x98, 0xee, 0x2c,
-	0xf2, 0x7f, 0x9c, 0x78, 0xfb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69, 0x38, 0xfb, 0x26, 0xf2,
-	0x21, 0x00, 0x00,
+func (m *MsgRegisterMerchant) Reset()         { *m = MsgRegisterMerchant{} }
+func (m *MsgRegisterMerchant) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMerchant) ProtoMessage()    {}
+func (*MsgRegisterMerchant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{45}
+}
+func (m *MsgRegisterMerchant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMerchant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMerchant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterMerchant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMerchant.Merge(m, src)
+}
+func (m *MsgRegisterMerchant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMerchant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMerchant.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterMerchant proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateCreatorallowlist defines the CreateCreatorallowlist RPC.
-	CreateCreatorallowlist(ctx context.Context, in *MsgCreateCreatorallowlist, opts ...grpc.CallOption) (*MsgCreateCreatorallowlistResponse, error)
-	// UpdateCreatorallowlist defines the UpdateCreatorallowlist RPC.
-	UpdateCreatorallowlist(ctx context.Context, in *MsgUpdateCreatorallowlist, opts ...grpc.CallOption) (*MsgUpdateCreatorallowlistResponse, error)
-	// DeleteCreatorallowlist defines the DeleteCreatorallowlist RPC.
-	DeleteCreatorallowlist(ctx context.Context, in *MsgDeleteCreatorallowlist, opts ...grpc.CallOption) (*MsgDeleteCreatorallowlistResponse, error)
-	// CreateVerifiedtoken defines the CreateVerifiedtoken RPC.
-	CreateVerifiedtoken(ctx context.Context, in *MsgCreateVerifiedtoken, opts ...grpc.CallOption) (*MsgCreateVerifiedtokenResponse, error)
-	// UpdateVerifiedtoken defines the UpdateVerifiedtoken RPC.
-	UpdateVerifiedtoken(ctx context.Context, in *MsgUpdateVerifiedtoken, opts ...grpc.CallOption) (*MsgUpdateVerifiedtokenResponse, error)
-	// RenounceTokenAdmin defines the RenounceTokenAdmin RPC.
-	RenounceTokenAdmin(ctx context.Context, in *MsgRenounceTokenAdmin, opts ...grpc.CallOption) (*MsgRenounceTokenAdminResponse, error)
-	// SetMerchantIncentiveRouting defines the SetMerchantIncentiveRouting RPC.
-	SetMerchantIncentiveRouting(ctx context.Context, in *MsgSetMerchantIncentiveRouting, opts ...grpc.CallOption) (*MsgSetMerchantIncentiveRoutingResponse, error)
-	// DeleteVerifiedtoken defines the DeleteVerifiedtoken RPC.
-	DeleteVerifiedtoken(ctx context.Context, in *MsgDeleteVerifiedtoken, opts ...grpc.CallOption) (*MsgDeleteVerifiedtokenResponse, error)
-	// CreateRewardaccrual defines the CreateRewardaccrual RPC.
-	CreateRewardaccrual(ctx context.Context, in *MsgCreateRewardaccrual, opts ...grpc.CallOption) (*MsgCreateRewardaccrualResponse, error)
-	// UpdateRewardaccrual defines the UpdateRewardaccrual RPC.
-	UpdateRewardaccrual(ctx context.Context, in *MsgUpdateRewardaccrual, opts ...grpc.CallOption) (*MsgUpdateRewardaccrualResponse, error)
-	// DeleteRewardaccrual defines the DeleteRewardaccrual RPC.
-	DeleteRewardaccrual(ctx context.Context, in *MsgDeleteRewardaccrual, opts ...grpc.CallOption) (*MsgDeleteRewardaccrualResponse, error)
-	// MintVerifiedToken defines the MintVerifiedToken RPC.
-	MintVerifiedToken(ctx context.Context, in *MsgMintVerifiedToken, opts ...grpc.CallOption) (*MsgMintVerifiedTokenResponse, error)
-	// ClaimReward defines the ClaimReward RPC.
-	ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error)
-	// FundRewardPool defines the FundRewardPool RPC.
-	FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error)
-	// RecordRewardAccrual defines the RecordRewardAccrual RPC.
-	RecordRewardAccrual(ctx context.Context, in *MsgRecordRewardAccrual, opts ...grpc.CallOption) (*MsgRecordRewardAccrualResponse, error)
-	// RecordMerchantAllocation defines the RecordMerchantAllocation RPC.
-	RecordMerchantAllocation(ctx context.Context, in *MsgRecordMerchantAllocation, opts ...grpc.CallOption) (*MsgRecordMerchantAllocationResponse, error)
-	// QueueRecoveryTransfer defines the QueueRecoveryTransfer RPC.
-	QueueRecoveryTransfer(ctx context.Context, in *MsgQueueRecoveryTransfer, opts ...grpc.CallOption) (*MsgQueueRecoveryTransferResponse, error)
-	// ExecuteRecoveryTransfer defines the ExecuteRecoveryTransfer RPC.
-	ExecuteRecoveryTransfer(ctx context.Context, in *MsgExecuteRecoveryTransfer, opts ...grpc.CallOption) (*MsgExecuteRecoveryTransferResponse, error)
-	// CancelRecoveryTransfer defines the CancelRecoveryTransfer RPC.
-	CancelRecoveryTransfer(ctx context.Context, in *MsgCancelRecoveryTransfer, opts ...grpc.CallOption) (*MsgCancelRecoveryTransferResponse, error)
+func (m *MsgRegisterMerchant) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterMerchant) GetLegalName() string {
+	if m != nil {
+		return m.LegalName
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterMerchant) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterMerchant) GetContactUri() string {
+	if m != nil {
+		return m.ContactUri
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateCreatorallowlist(ctx context.Context, in *MsgCreateCreatorallowlist, opts ...grpc.CallOption) (*MsgCreateCreatorallowlistResponse, error) {
-	out := new(MsgCreateCreatorallowlistResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CreateCreatorallowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterMerchant) GetLogoHash() string {
+	if m != nil {
+		return m.LogoHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateCreatorallowlist(ctx context.Context, in *MsgUpdateCreatorallowlist, opts ...grpc.CallOption) (*MsgUpdateCreatorallowlistResponse, error) {
-	out := new(MsgUpdateCreatorallowlistResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateCreatorallowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRegisterMerchantResponse defines the MsgRegisterMerchantResponse message.
+type MsgRegisterMerchantResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *msgClient) DeleteCreatorallowlist(ctx context.Context, in *MsgDeleteCreatorallowlist, opts ...grpc.CallOption) (*MsgDeleteCreatorallowlistResponse, error) {
-	out := new(MsgDeleteCreatorallowlistResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/DeleteCreatorallowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterMerchantResponse) Reset()         { *m = MsgRegisterMerchantResponse{} }
+func (m *MsgRegisterMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMerchantResponse) ProtoMessage()    {}
+func (*MsgRegisterMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{46}
+}
+func (m *MsgRegisterMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMerchantResponse.Merge(m, src)
+}
+func (m *MsgRegisterMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMerchantResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateVerifiedtoken(ctx context.Context, in *MsgCreateVerifiedtoken, opts ...grpc.CallOption) (*MsgCreateVerifiedtokenResponse, error) {
-	out := new(MsgCreateVerifiedtokenResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CreateVerifiedtoken", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterMerchantResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterMerchantResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateVerifiedtoken(ctx context.Context, in *MsgUpdateVerifiedtoken, opts ...grpc.CallOption) (*MsgUpdateVerifiedtokenResponse, error) {
-	out := new(MsgUpdateVerifiedtokenResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateVerifiedtoken", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUpdateMerchant defines the MsgUpdateMerchant message.
+type MsgUpdateMerchant struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id            uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	LegalName     string `protobuf:"bytes,3,opt,name=legal_name,json=legalName,proto3" json:"legal_name,omitempty"`
+	PayoutAddress string `protobuf:"bytes,4,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	ContactUri    string `protobuf:"bytes,5,opt,name=contact_uri,json=contactUri,proto3" json:"contact_uri,omitempty"`
+	LogoHash      string `protobuf:"bytes,6,opt,name=logo_hash,json=logoHash,proto3" json:"logo_hash,omitempty"`
+	// verification_tier is left unchanged when empty.
+	VerificationTier string `protobuf:"bytes,7,opt,name=verification_tier,json=verificationTier,proto3" json:"verification_tier,omitempty"`
+}
+
+func (m *MsgUpdateMerchant) Reset()         { *m = MsgUpdateMerchant{} }
+func (m *MsgUpdateMerchant) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMerchant) ProtoMessage()    {}
+func (*MsgUpdateMerchant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{47}
+}
+func (m *MsgUpdateMerchant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMerchant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMerchant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateMerchant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMerchant.Merge(m, src)
+}
+func (m *MsgUpdateMerchant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMerchant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMerchant.DiscardUnknown(m)
 }
 
-func (c *msgClient) RenounceTokenAdmin(ctx context.Context, in *MsgRenounceTokenAdmin, opts ...grpc.CallOption) (*MsgRenounceTokenAdminResponse, error) {
-	out := new(MsgRenounceTokenAdminResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RenounceTokenAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateMerchant proto.InternalMessageInfo
+
+func (m *MsgUpdateMerchant) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetMerchantIncentiveRouting(ctx context.Context, in *MsgSetMerchantIncentiveRouting, opts ...grpc.CallOption) (*MsgSetMerchantIncentiveRoutingResponse, error) {
-	out := new(MsgSetMerchantIncentiveRoutingResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetMerchantIncentiveRouting", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMerchant) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DeleteVerifiedtoken(ctx context.Context, in *MsgDeleteVerifiedtoken, opts ...grpc.CallOption) (*MsgDeleteVerifiedtokenResponse, error) {
-	out := new(MsgDeleteVerifiedtokenResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/DeleteVerifiedtoken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMerchant) GetLegalName() string {
+	if m != nil {
+		return m.LegalName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateRewardaccrual(ctx context.Context, in *MsgCreateRewardaccrual, opts ...grpc.CallOption) (*MsgCreateRewardaccrualResponse, error) {
-	out := new(MsgCreateRewardaccrualResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CreateRewardaccrual", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMerchant) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateRewardaccrual(ctx context.Context, in *MsgUpdateRewardaccrual, opts ...grpc.CallOption) (*MsgUpdateRewardaccrualResponse, error) {
-	out := new(MsgUpdateRewardaccrualResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateRewardaccrual", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMerchant) GetContactUri() string {
+	if m != nil {
+		return m.ContactUri
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteRewardaccrual(ctx context.Context, in *MsgDeleteRewardaccrual, opts ...grpc.CallOption) (*MsgDeleteRewardaccrualResponse, error) {
-	out := new(MsgDeleteRewardaccrualResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/DeleteRewardaccrual", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMerchant) GetLogoHash() string {
+	if m != nil {
+		return m.LogoHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MintVerifiedToken(ctx context.Context, in *MsgMintVerifiedToken, opts ...grpc.CallOption) (*MsgMintVerifiedTokenResponse, error) {
-	out := new(MsgMintVerifiedTokenResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/MintVerifiedToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMerchant) GetVerificationTier() string {
+	if m != nil {
+		return m.VerificationTier
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error) {
-	out := new(MsgClaimRewardResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/ClaimReward", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateMerchantResponse defines the MsgUpdateMerchantResponse message.
+type MsgUpdateMerchantResponse struct {
 }
 
-func (c *msgClient) FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error) {
-	out := new(MsgFundRewardPoolResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/FundRewardPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMerchantResponse) Reset()         { *m = MsgUpdateMerchantResponse{} }
+func (m *MsgUpdateMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMerchantResponse) ProtoMessage()    {}
+func (*MsgUpdateMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{48}
+}
+func (m *MsgUpdateMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMerchantResponse.Merge(m, src)
+}
+func (m *MsgUpdateMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMerchantResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RecordRewardAccrual(ctx context.Context, in *MsgRecordRewardAccrual, opts ...grpc.CallOption) (*MsgRecordRewardAccrualResponse, error) {
-	out := new(MsgRecordRewardAccrualResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RecordRewardAccrual", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateMerchantResponse proto.InternalMessageInfo
+
+// MsgDeactivateMerchant defines the MsgDeactivateMerchant message.
+type MsgDeactivateMerchant struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgDeactivateMerchant) Reset()         { *m = MsgDeactivateMerchant{} }
+func (m *MsgDeactivateMerchant) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateMerchant) ProtoMessage()    {}
+func (*MsgDeactivateMerchant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{49}
+}
+func (m *MsgDeactivateMerchant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateMerchant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateMerchant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeactivateMerchant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateMerchant.Merge(m, src)
+}
+func (m *MsgDeactivateMerchant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateMerchant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateMerchant.DiscardUnknown(m)
 }
 
-func (c *msgClient) RecordMerchantAllocation(ctx context.Context, in *MsgRecordMerchantAllocation, opts ...grpc.CallOption) (*MsgRecordMerchantAllocationResponse, error) {
-	out := new(MsgRecordMerchantAllocationResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RecordMerchantAllocation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeactivateMerchant proto.InternalMessageInfo
+
+func (m *MsgDeactivateMerchant) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) QueueRecoveryTransfer(ctx context.Context, in *MsgQueueRecoveryTransfer, opts ...grpc.CallOption) (*MsgQueueRecoveryTransferResponse, error) {
-	out := new(MsgQueueRecoveryTransferResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/QueueRecoveryTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeactivateMerchant) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ExecuteRecoveryTransfer(ctx context.Context, in *MsgExecuteRecoveryTransfer, opts ...grpc.CallOption) (*MsgExecuteRecoveryTransferResponse, error) {
-	out := new(MsgExecuteRecoveryTransferResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/ExecuteRecoveryTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDeactivateMerchantResponse defines the MsgDeactivateMerchantResponse message.
+type MsgDeactivateMerchantResponse struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeactivatedAt uint64 `protobuf:"varint,2,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
 }
 
-func (c *msgClient) CancelRecoveryTransfer(ctx context.Context, in *MsgCancelRecoveryTransfer, opts ...grpc.CallOption) (*MsgCancelRecoveryTransferResponse, error) {
-	out := new(MsgCancelRecoveryTransferResponse)
-	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CancelRecoveryTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeactivateMerchantResponse) Reset()         { *m = MsgDeactivateMerchantResponse{} }
+func (m *MsgDeactivateMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateMerchantResponse) ProtoMessage()    {}
+func (*MsgDeactivateMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{50}
+}
+func (m *MsgDeactivateMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateCreatorallowlist defines the CreateCreatorallowlist RPC.
-	CreateCreatorallowlist(context.Context, *MsgCreateCreatorallowlist) (*MsgCreateCreatorallowlistResponse, error)
-	// UpdateCreatorallowlist defines the UpdateCreatorallowlist RPC.
-	UpdateCreatorallowlist(context.Context, *MsgUpdateCreatorallowlist) (*MsgUpdateCreatorallowlistResponse, error)
-	// DeleteCreatorallowlist defines the DeleteCreatorallowlist RPC.
-	DeleteCreatorallowlist(context.Context, *MsgDeleteCreatorallowlist) (*MsgDeleteCreatorallowlistResponse, error)
-	// CreateVerifiedtoken defines the CreateVerifiedtoken RPC.
-	CreateVerifiedtoken(context.Context, *MsgCreateVerifiedtoken) (*MsgCreateVerifiedtokenResponse, error)
-	// UpdateVerifiedtoken defines the UpdateVerifiedtoken RPC.
-	UpdateVerifiedtoken(context.Context, *MsgUpdateVerifiedtoken) (*MsgUpdateVerifiedtokenResponse, error)
-	// RenounceTokenAdmin defines the RenounceTokenAdmin RPC.
-	RenounceTokenAdmin(context.Context, *MsgRenounceTokenAdmin) (*MsgRenounceTokenAdminResponse, error)
-	// SetMerchantIncentiveRouting defines the SetMerchantIncentiveRouting RPC.
-	SetMerchantIncentiveRouting(context.Context, *MsgSetMerchantIncentiveRouting) (*MsgSetMerchantIncentiveRoutingResponse, error)
-	// DeleteVerifiedtoken defines the DeleteVerifiedtoken RPC.
-	DeleteVerifiedtoken(context.Context, *MsgDeleteVerifiedtoken) (*MsgDeleteVerifiedtokenResponse, error)
-	// CreateRewardaccrual defines the CreateRewardaccrual RPC.
-	CreateRewardaccrual(context.Context, *MsgCreateRewardaccrual) (*MsgCreateRewardaccrualResponse, error)
-	// UpdateRewardaccrual defines the UpdateRewardaccrual RPC.
-	UpdateRewardaccrual(context.Context, *MsgUpdateRewardaccrual) (*MsgUpdateRewardaccrualResponse, error)
-	// DeleteRewardaccrual defines the DeleteRewardaccrual RPC.
-	DeleteRewardaccrual(context.Context, *MsgDeleteRewardaccrual) (*MsgDeleteRewardaccrualResponse, error)
-	// MintVerifiedToken defines the MintVerifiedToken RPC.
-	MintVerifiedToken(context.Context, *MsgMintVerifiedToken) (*MsgMintVerifiedTokenResponse, error)
-	// ClaimReward defines the ClaimReward RPC.
-	ClaimReward(context.Context, *MsgClaimReward) (*MsgClaimRewardResponse, error)
-	// FundRewardPool defines the FundRewardPool RPC.
-	FundRewardPool(context.Context, *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error)
-	// RecordRewardAccrual defines the RecordRewardAccrual RPC.
-	RecordRewardAccrual(context.Context, *MsgRecordRewardAccrual) (*MsgRecordRewardAccrualResponse, error)
-	// RecordMerchantAllocation defines the RecordMerchantAllocation RPC.
-	RecordMerchantAllocation(context.Context, *MsgRecordMerchantAllocation) (*MsgRecordMerchantAllocationResponse, error)
-	// QueueRecoveryTransfer defines the QueueRecoveryTransfer RPC.
-	QueueRecoveryTransfer(context.Context, *MsgQueueRecoveryTransfer) (*MsgQueueRecoveryTransferResponse, error)
-	// ExecuteRecoveryTransfer defines the ExecuteRecoveryTransfer RPC.
-	ExecuteRecoveryTransfer(context.Context, *MsgExecuteRecoveryTransfer) (*MsgExecuteRecoveryTransferResponse, error)
-	// CancelRecoveryTransfer defines the CancelRecoveryTransfer RPC.
-	CancelRecoveryTransfer(context.Context, *MsgCancelRecoveryTransfer) (*MsgCancelRecoveryTransferResponse, error)
+func (m *MsgDeactivateMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateMerchantResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgDeactivateMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateMerchantResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgDeactivateMerchantResponse proto.InternalMessageInfo
+
+func (m *MsgDeactivateMerchantResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) CreateCreatorallowlist(ctx context.Context, req *MsgCreateCreatorallowlist) (*MsgCreateCreatorallowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCreatorallowlist not implemented")
+
+func (m *MsgDeactivateMerchantResponse) GetDeactivatedAt() uint64 {
+	if m != nil {
+		return m.DeactivatedAt
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) UpdateCreatorallowlist(ctx context.Context, req *MsgUpdateCreatorallowlist) (*MsgUpdateCreatorallowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreatorallowlist not implemented")
+
+// MsgSubmitAttestation defines the MsgSubmitAttestation message.
+type MsgSubmitAttestation struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	EvidenceUri string `protobuf:"bytes,3,opt,name=evidence_uri,json=evidenceUri,proto3" json:"evidence_uri,omitempty"`
+	// expires_at is the unix time after which the attestation lapses.
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
-func (*UnimplementedMsgServer) DeleteCreatorallowlist(ctx context.Context, req *MsgDeleteCreatorallowlist) (*MsgDeleteCreatorallowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCreatorallowlist not implemented")
+
+func (m *MsgSubmitAttestation) Reset()         { *m = MsgSubmitAttestation{} }
+func (m *MsgSubmitAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestation) ProtoMessage()    {}
+func (*MsgSubmitAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{51}
 }
-func (*UnimplementedMsgServer) CreateVerifiedtoken(ctx context.Context, req *MsgCreateVerifiedtoken) (*MsgCreateVerifiedtokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVerifiedtoken not implemented")
+func (m *MsgSubmitAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateVerifiedtoken(ctx context.Context, req *MsgUpdateVerifiedtoken) (*MsgUpdateVerifiedtokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVerifiedtoken not implemented")
+func (m *MsgSubmitAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RenounceTokenAdmin(ctx context.Context, req *MsgRenounceTokenAdmin) (*MsgRenounceTokenAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenounceTokenAdmin not implemented")
+func (m *MsgSubmitAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestation.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetMerchantIncentiveRouting(ctx context.Context, req *MsgSetMerchantIncentiveRouting) (*MsgSetMerchantIncentiveRoutingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMerchantIncentiveRouting not implemented")
+func (m *MsgSubmitAttestation) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeleteVerifiedtoken(ctx context.Context, req *MsgDeleteVerifiedtoken) (*MsgDeleteVerifiedtokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVerifiedtoken not implemented")
+func (m *MsgSubmitAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestation.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CreateRewardaccrual(ctx context.Context, req *MsgCreateRewardaccrual) (*MsgCreateRewardaccrualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRewardaccrual not implemented")
+
+var xxx_messageInfo_MsgSubmitAttestation proto.InternalMessageInfo
+
+func (m *MsgSubmitAttestation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) UpdateRewardaccrual(ctx context.Context, req *MsgUpdateRewardaccrual) (*MsgUpdateRewardaccrualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRewardaccrual not implemented")
+
+func (m *MsgSubmitAttestation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) DeleteRewardaccrual(ctx context.Context, req *MsgDeleteRewardaccrual) (*MsgDeleteRewardaccrualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRewardaccrual not implemented")
+
+func (m *MsgSubmitAttestation) GetEvidenceUri() string {
+	if m != nil {
+		return m.EvidenceUri
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) MintVerifiedToken(ctx context.Context, req *MsgMintVerifiedToken) (*MsgMintVerifiedTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintVerifiedToken not implemented")
+
+func (m *MsgSubmitAttestation) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) ClaimReward(ctx context.Context, req *MsgClaimReward) (*MsgClaimRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimReward not implemented")
+
+// MsgSubmitAttestationResponse defines the MsgSubmitAttestationResponse message.
+type MsgSubmitAttestationResponse struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Verified         bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	AttestationCount uint32 `protobuf:"varint,3,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
 }
-func (*UnimplementedMsgServer) FundRewardPool(ctx context.Context, req *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundRewardPool not implemented")
+
+func (m *MsgSubmitAttestationResponse) Reset()         { *m = MsgSubmitAttestationResponse{} }
+func (m *MsgSubmitAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestationResponse) ProtoMessage()    {}
+func (*MsgSubmitAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{52}
 }
-func (*UnimplementedMsgServer) RecordRewardAccrual(ctx context.Context, req *MsgRecordRewardAccrual) (*MsgRecordRewardAccrualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordRewardAccrual not implemented")
+func (m *MsgSubmitAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RecordMerchantAllocation(ctx context.Context, req *MsgRecordMerchantAllocation) (*MsgRecordMerchantAllocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordMerchantAllocation not implemented")
+func (m *MsgSubmitAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) QueueRecoveryTransfer(ctx context.Context, req *MsgQueueRecoveryTransfer) (*MsgQueueRecoveryTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueueRecoveryTransfer not implemented")
+func (m *MsgSubmitAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestationResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ExecuteRecoveryTransfer(ctx context.Context, req *MsgExecuteRecoveryTransfer) (*MsgExecuteRecoveryTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRecoveryTransfer not implemented")
+func (m *MsgSubmitAttestationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelRecoveryTransfer(ctx context.Context, req *MsgCancelRecoveryTransfer) (*MsgCancelRecoveryTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRecoveryTransfer not implemented")
+func (m *MsgSubmitAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestationResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgSubmitAttestationResponse proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgSubmitAttestationResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateCreatorallowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateCreatorallowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/CreateCreatorallowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateCreatorallowlist(ctx, req.(*MsgCreateCreatorallowlist))
+func (m *MsgSubmitAttestationResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_UpdateCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCreatorallowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCreatorallowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateCreatorallowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCreatorallowlist(ctx, req.(*MsgUpdateCreatorallowlist))
+func (m *MsgSubmitAttestationResponse) GetAttestationCount() uint32 {
+	if m != nil {
+		return m.AttestationCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DeleteCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteCreatorallowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteCreatorallowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/DeleteCreatorallowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteCreatorallowlist(ctx, req.(*MsgDeleteCreatorallowlist))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRevokeAttestation defines the MsgRevokeAttestation message.
+type MsgRevokeAttestation struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Msg_CreateVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateVerifiedtoken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateVerifiedtoken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/CreateVerifiedtoken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateVerifiedtoken(ctx, req.(*MsgCreateVerifiedtoken))
+func (m *MsgRevokeAttestation) Reset()         { *m = MsgRevokeAttestation{} }
+func (m *MsgRevokeAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttestation) ProtoMessage()    {}
+func (*MsgRevokeAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{53}
+}
+func (m *MsgRevokeAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevokeAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttestation.Merge(m, src)
+}
+func (m *MsgRevokeAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttestation.DiscardUnknown(m)
 }
 
-func _Msg_UpdateVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateVerifiedtoken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateVerifiedtoken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateVerifiedtoken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateVerifiedtoken(ctx, req.(*MsgUpdateVerifiedtoken))
+var xxx_messageInfo_MsgRevokeAttestation proto.InternalMessageInfo
+
+func (m *MsgRevokeAttestation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RenounceTokenAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRenounceTokenAdmin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RenounceTokenAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/RenounceTokenAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RenounceTokenAdmin(ctx, req.(*MsgRenounceTokenAdmin))
+func (m *MsgRevokeAttestation) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetMerchantIncentiveRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetMerchantIncentiveRouting)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetMerchantIncentiveRouting(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/SetMerchantIncentiveRouting",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetMerchantIncentiveRouting(ctx, req.(*MsgSetMerchantIncentiveRouting))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRevokeAttestationResponse defines the MsgRevokeAttestationResponse message.
+type MsgRevokeAttestationResponse struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Verified         bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	AttestationCount uint32 `protobuf:"varint,3,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
 }
 
-func _Msg_DeleteVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteVerifiedtoken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteVerifiedtoken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/DeleteVerifiedtoken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteVerifiedtoken(ctx, req.(*MsgDeleteVerifiedtoken))
+func (m *MsgRevokeAttestationResponse) Reset()         { *m = MsgRevokeAttestationResponse{} }
+func (m *MsgRevokeAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttestationResponse) ProtoMessage()    {}
+func (*MsgRevokeAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{54}
+}
+func (m *MsgRevokeAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevokeAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttestationResponse.Merge(m, src)
+}
+func (m *MsgRevokeAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttestationResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRewardaccrual)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRewardaccrual(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/CreateRewardaccrual",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRewardaccrual(ctx, req.(*MsgCreateRewardaccrual))
+var xxx_messageInfo_MsgRevokeAttestationResponse proto.InternalMessageInfo
+
+func (m *MsgRevokeAttestationResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRewardaccrual)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRewardaccrual(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateRewardaccrual",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRewardaccrual(ctx, req.(*MsgUpdateRewardaccrual))
+func (m *MsgRevokeAttestationResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_DeleteRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRewardaccrual)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRewardaccrual(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/DeleteRewardaccrual",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRewardaccrual(ctx, req.(*MsgDeleteRewardaccrual))
+func (m *MsgRevokeAttestationResponse) GetAttestationCount() uint32 {
+	if m != nil {
+		return m.AttestationCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_MintVerifiedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintVerifiedToken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MintVerifiedToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/MintVerifiedToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintVerifiedToken(ctx, req.(*MsgMintVerifiedToken))
+// MsgCreateMintSchedule defines the MsgCreateMintSchedule message.
+type MsgCreateMintSchedule struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Recipient     string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	TrancheAmount uint64 `protobuf:"varint,4,opt,name=tranche_amount,json=trancheAmount,proto3" json:"tranche_amount,omitempty"`
+	TotalAmount   uint64 `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// cadence is "daily", "weekly" or "monthly".
+	Cadence string `protobuf:"bytes,6,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	// interval repeats the cadence every N periods; zero means 1.
+	Interval uint32 `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	// start_date is the first release date (YYYY-MM-DD in the rollup timezone), today or later.
+	StartDate string `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+}
+
+func (m *MsgCreateMintSchedule) Reset()         { *m = MsgCreateMintSchedule{} }
+func (m *MsgCreateMintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMintSchedule) ProtoMessage()    {}
+func (*MsgCreateMintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{55}
+}
+func (m *MsgCreateMintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreateMintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMintSchedule.Merge(m, src)
+}
+func (m *MsgCreateMintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMintSchedule.DiscardUnknown(m)
 }
 
-func _Msg_ClaimReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimReward)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/ClaimReward",
+var xxx_messageInfo_MsgCreateMintSchedule proto.InternalMessageInfo
+
+func (m *MsgCreateMintSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimReward(ctx, req.(*MsgClaimReward))
+	return ""
+}
+
+func (m *MsgCreateMintSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FundRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundRewardPool)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateMintSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundRewardPool(ctx, in)
+	return ""
+}
+
+func (m *MsgCreateMintSchedule) GetTrancheAmount() uint64 {
+	if m != nil {
+		return m.TrancheAmount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/FundRewardPool",
+	return 0
+}
+
+func (m *MsgCreateMintSchedule) GetTotalAmount() uint64 {
+	if m != nil {
+		return m.TotalAmount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundRewardPool(ctx, req.(*MsgFundRewardPool))
+	return 0
+}
+
+func (m *MsgCreateMintSchedule) GetCadence() string {
+	if m != nil {
+		return m.Cadence
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RecordRewardAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRecordRewardAccrual)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateMintSchedule) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RecordRewardAccrual(ctx, in)
+	return 0
+}
+
+func (m *MsgCreateMintSchedule) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/RecordRewardAccrual",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RecordRewardAccrual(ctx, req.(*MsgRecordRewardAccrual))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RecordMerchantAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRecordMerchantAllocation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RecordMerchantAllocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/RecordMerchantAllocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RecordMerchantAllocation(ctx, req.(*MsgRecordMerchantAllocation))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCreateMintScheduleResponse defines the MsgCreateMintScheduleResponse message.
+type MsgCreateMintScheduleResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_QueueRecoveryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgQueueRecoveryTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).QueueRecoveryTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/QueueRecoveryTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).QueueRecoveryTransfer(ctx, req.(*MsgQueueRecoveryTransfer))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCreateMintScheduleResponse) Reset()         { *m = MsgCreateMintScheduleResponse{} }
+func (m *MsgCreateMintScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMintScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateMintScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{56}
 }
-
-func _Msg_ExecuteRecoveryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteRecoveryTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteRecoveryTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/ExecuteRecoveryTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteRecoveryTransfer(ctx, req.(*MsgExecuteRecoveryTransfer))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCreateMintScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_CancelRecoveryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRecoveryTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRecoveryTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tokenchain.loyalty.v1.Msg/CancelRecoveryTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRecoveryTransfer(ctx, req.(*MsgCancelRecoveryTransfer))
+func (m *MsgCreateMintScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMintScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tokenchain.loyalty.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateCreatorallowlist",
-			Handler:    _Msg_CreateCreatorallowlist_Handler,
-		},
-		{
-			MethodName: "UpdateCreatorallowlist",
-			Handler:    _Msg_UpdateCreatorallowlist_Handler,
-		},
-		{
-			MethodName: "DeleteCreatorallowlist",
-			Handler:    _Msg_DeleteCreatorallowlist_Handler,
-		},
-		{
-			MethodName: "CreateVerifiedtoken",
-			Handler:    _Msg_CreateVerifiedtoken_Handler,
-		},
-		{
-			MethodName: "UpdateVerifiedtoken",
-			Handler:    _Msg_UpdateVerifiedtoken_Handler,
-		},
-		{
-			MethodName: "RenounceTokenAdmin",
-			Handler:    _Msg_RenounceTokenAdmin_Handler,
-		},
-		{
-			MethodName: "SetMerchantIncentiveRouting",
-			Handler:    _Msg_SetMerchantIncentiveRouting_Handler,
-		},
-		{
-			MethodName: "DeleteVerifiedtoken",
-			Handler:    _Msg_DeleteVerifiedtoken_Handler,
-		},
-		{
-			MethodName: "CreateRewardaccrual",
-			Handler:    _Msg_CreateRewardaccrual_Handler,
-		},
-		{
-			MethodName: "UpdateRewardaccrual",
-			Handler:    _Msg_UpdateRewardaccrual_Handler,
-		},
-		{
-			MethodName: "DeleteRewardaccrual",
-			Handler:    _Msg_DeleteRewardaccrual_Handler,
-		},
-		{
-			MethodName: "MintVerifiedToken",
-			Handler:    _Msg_MintVerifiedToken_Handler,
-		},
-		{
-			MethodName: "ClaimReward",
-			Handler:    _Msg_ClaimReward_Handler,
-		},
-		{
-			MethodName: "FundRewardPool",
-			Handler:    _Msg_FundRewardPool_Handler,
-		},
-		{
-			MethodName: "RecordRewardAccrual",
-			Handler:    _Msg_RecordRewardAccrual_Handler,
-		},
-		{
-			MethodName: "RecordMerchantAllocation",
-			Handler:    _Msg_RecordMerchantAllocation_Handler,
-		},
-		{
-			MethodName: "QueueRecoveryTransfer",
-			Handler:    _Msg_QueueRecoveryTransfer_Handler,
-		},
-		{
-			MethodName: "ExecuteRecoveryTransfer",
-			Handler:    _Msg_ExecuteRecoveryTransfer_Handler,
-		},
-		{
-			MethodName: "CancelRecoveryTransfer",
-			Handler:    _Msg_CancelRecoveryTransfer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tokenchain/loyalty/v1/tx.proto",
+func (m *MsgCreateMintScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMintScheduleResponse.Merge(m, src)
+}
+func (m *MsgCreateMintScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMintScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMintScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateMintScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMintScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelMintSchedule defines the MsgCancelMintSchedule message.
+type MsgCancelMintSchedule struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Id      uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelMintSchedule) Reset()         { *m = MsgCancelMintSchedule{} }
+func (m *MsgCancelMintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMintSchedule) ProtoMessage()    {}
+func (*MsgCancelMintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{57}
+}
+func (m *MsgCancelMintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCancelMintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMintSchedule.Merge(m, src)
+}
+func (m *MsgCancelMintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMintSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMintSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelMintSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelMintSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelMintSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelMintScheduleResponse defines the MsgCancelMintScheduleResponse message.
+type MsgCancelMintScheduleResponse struct {
+	ReleasedAmount  uint64 `protobuf:"varint,1,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"`
+	CancelledAmount uint64 `protobuf:"varint,2,opt,name=cancelled_amount,json=cancelledAmount,proto3" json:"cancelled_amount,omitempty"`
 }
 
-func (m *MsgCreateCreatorallowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelMintScheduleResponse) Reset()         { *m = MsgCancelMintScheduleResponse{} }
+func (m *MsgCancelMintScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMintScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelMintScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{58}
+}
+func (m *MsgCancelMintScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMintScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMintScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelMintScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMintScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelMintScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMintScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMintScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateCreatorallowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelMintScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCancelMintScheduleResponse) GetReleasedAmount() uint64 {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return 0
 }
 
-func (m *MsgCreateCreatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func (m *MsgCancelMintScheduleResponse) GetCancelledAmount() uint64 {
+	if m != nil {
+		return m.CancelledAmount
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// MsgSetMintRateLimit defines the MsgSetMintRateLimit message. A zero limit is
+// unlimited.
+type MsgSetMintRateLimit struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxPerTx         uint64 `protobuf:"varint,3,opt,name=max_per_tx,json=maxPerTx,proto3" json:"max_per_tx,omitempty"`
+	MaxPerRollingDay uint64 `protobuf:"varint,4,opt,name=max_per_rolling_day,json=maxPerRollingDay,proto3" json:"max_per_rolling_day,omitempty"`
+	MaxPerRollupDate uint64 `protobuf:"varint,5,opt,name=max_per_rollup_date,json=maxPerRollupDate,proto3" json:"max_per_rollup_date,omitempty"`
+}
+
+func (m *MsgSetMintRateLimit) Reset()         { *m = MsgSetMintRateLimit{} }
+func (m *MsgSetMintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintRateLimit) ProtoMessage()    {}
+func (*MsgSetMintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{59}
+}
+func (m *MsgSetMintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetMintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintRateLimit.Merge(m, src)
+}
+func (m *MsgSetMintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetMintRateLimit) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMintRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMintRateLimit) GetMaxPerTx() uint64 {
+	if m != nil {
+		return m.MaxPerTx
+	}
+	return 0
 }
 
-func (m *MsgCreateCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetMintRateLimit) GetMaxPerRollingDay() uint64 {
+	if m != nil {
+		return m.MaxPerRollingDay
+	}
+	return 0
 }
 
-func (m *MsgUpdateCreatorallowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMintRateLimit) GetMaxPerRollupDate() uint64 {
+	if m != nil {
+		return m.MaxPerRollupDate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateCreatorallowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetMintRateLimitResponse defines the MsgSetMintRateLimitResponse message.
+type MsgSetMintRateLimitResponse struct {
+	// mint_rate_limit is the limit in force after the message.
+	MintRateLimit MintRateLimit `protobuf:"bytes,1,opt,name=mint_rate_limit,json=mintRateLimit,proto3" json:"mint_rate_limit"`
+	// pending is set when part of the request loosens the limit and was scheduled.
+	Pending     bool   `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	EffectiveAt uint64 `protobuf:"varint,3,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
 }
 
-func (m *MsgUpdateCreatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSetMintRateLimitResponse) Reset()         { *m = MsgSetMintRateLimitResponse{} }
+func (m *MsgSetMintRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetMintRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{60}
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintRateLimitResponse proto.InternalMessageInfo
+
+func (m *MsgSetMintRateLimitResponse) GetMintRateLimit() MintRateLimit {
+	if m != nil {
+		return m.MintRateLimit
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return MintRateLimit{}
+}
+
+func (m *MsgSetMintRateLimitResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgUpdateCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMintRateLimitResponse) GetEffectiveAt() uint64 {
+	if m != nil {
+		return m.EffectiveAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPauseToken defines the MsgPauseToken message. Empty scopes pause every scope.
+type MsgPauseToken struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Scopes  []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Reason  string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expires_at lifts the pause automatically (unix seconds); zero keeps it until unpaused.
+	ExpiresAt uint64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (m *MsgUpdateCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPauseToken) Reset()         { *m = MsgPauseToken{} }
+func (m *MsgPauseToken) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseToken) ProtoMessage()    {}
+func (*MsgPauseToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{61}
+}
+func (m *MsgPauseToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseToken.Merge(m, src)
+}
+func (m *MsgPauseToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseToken.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteCreatorallowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPauseToken proto.InternalMessageInfo
+
+func (m *MsgPauseToken) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteCreatorallowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgDeleteCreatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgPauseToken) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgPauseToken) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseToken) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPauseTokenResponse defines the MsgPauseTokenResponse message.
+type MsgPauseTokenResponse struct {
 }
 
-func (m *MsgDeleteCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPauseTokenResponse) Reset()         { *m = MsgPauseTokenResponse{} }
+func (m *MsgPauseTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseTokenResponse) ProtoMessage()    {}
+func (*MsgPauseTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{62}
 }
-
-func (m *MsgCreateVerifiedtoken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPauseTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseTokenResponse.Merge(m, src)
+}
+func (m *MsgPauseTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseTokenResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateVerifiedtoken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPauseTokenResponse proto.InternalMessageInfo
+
+// MsgUnpauseToken defines the MsgUnpauseToken message. Empty scopes lift the whole pause.
+type MsgUnpauseToken struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Scopes  []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
-func (m *MsgCreateVerifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecoveryTimelockHours != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryTimelockHours))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.RecoveryGroupPolicy) > 0 {
-		i -= len(m.RecoveryGroupPolicy)
-		copy(dAtA[i:], m.RecoveryGroupPolicy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveryGroupPolicy)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.SeizureOptIn {
-		i--
-		if m.SeizureOptIn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Verified {
-		i--
-		if m.Verified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUnpauseToken) Reset()         { *m = MsgUnpauseToken{} }
+func (m *MsgUnpauseToken) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseToken) ProtoMessage()    {}
+func (*MsgUnpauseToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{63}
+}
+func (m *MsgUnpauseToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.MintedSupply != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MintedSupply))
-		i--
-		dAtA[i] = 0x48
+}
+func (m *MsgUnpauseToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseToken.Merge(m, src)
+}
+func (m *MsgUnpauseToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseToken proto.InternalMessageInfo
+
+func (m *MsgUnpauseToken) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.MaxSupply != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxSupply))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *MsgUnpauseToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *MsgUnpauseToken) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+// MsgUnpauseTokenResponse defines the MsgUnpauseTokenResponse message.
+type MsgUnpauseTokenResponse struct {
+	// remaining_scopes lists the scopes still paused.
+	RemainingScopes []string `protobuf:"bytes,1,rep,name=remaining_scopes,json=remainingScopes,proto3" json:"remaining_scopes,omitempty"`
+}
+
+func (m *MsgUnpauseTokenResponse) Reset()         { *m = MsgUnpauseTokenResponse{} }
+func (m *MsgUnpauseTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseTokenResponse) ProtoMessage()    {}
+func (*MsgUnpauseTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{64}
+}
+func (m *MsgUnpauseTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *MsgUnpauseTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseTokenResponse.Merge(m, src)
+}
+func (m *MsgUnpauseTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseTokenResponse proto.InternalMessageInfo
+
+func (m *MsgUnpauseTokenResponse) GetRemainingScopes() []string {
+	if m != nil {
+		return m.RemainingScopes
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+// MsgFreezeAddress defines the MsgFreezeAddress message. Freezing an address that is
+// already frozen replaces its freeze.
+type MsgFreezeAddress struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// recovery_id is the queued recovery operation from address to link the freeze
+	// to; it is only read when link_recovery is set, since operation ids start at 0.
+	RecoveryId uint64 `protobuf:"varint,4,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expires_at (unix seconds) must be within the token's recovery timelock plus
+	// the freeze grace period.
+	ExpiresAt    uint64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LinkRecovery bool   `protobuf:"varint,7,opt,name=link_recovery,json=linkRecovery,proto3" json:"link_recovery,omitempty"`
+}
+
+func (m *MsgFreezeAddress) Reset()         { *m = MsgFreezeAddress{} }
+func (m *MsgFreezeAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAddress) ProtoMessage()    {}
+func (*MsgFreezeAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{65}
+}
+func (m *MsgFreezeAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgFreezeAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAddress.Merge(m, src)
+}
+func (m *MsgFreezeAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeAddress proto.InternalMessageInfo
+
+func (m *MsgFreezeAddress) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgFreezeAddress) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgFreezeAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeAddress) GetRecoveryId() uint64 {
+	if m != nil {
+		return m.RecoveryId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFreezeAddress) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgCreateVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgFreezeAddress) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateVerifiedtoken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeAddress) GetLinkRecovery() bool {
+	if m != nil {
+		return m.LinkRecovery
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateVerifiedtoken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFreezeAddressResponse defines the MsgFreezeAddressResponse message.
+type MsgFreezeAddressResponse struct {
+	ExpiresAt uint64 `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (m *MsgUpdateVerifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecoveryTimelockHours != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryTimelockHours))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.RecoveryGroupPolicy) > 0 {
-		i -= len(m.RecoveryGroupPolicy)
-		copy(dAtA[i:], m.RecoveryGroupPolicy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveryGroupPolicy)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.SeizureOptIn {
-		i--
-		if m.SeizureOptIn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Verified {
-		i--
-		if m.Verified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgFreezeAddressResponse) Reset()         { *m = MsgFreezeAddressResponse{} }
+func (m *MsgFreezeAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAddressResponse) ProtoMessage()    {}
+func (*MsgFreezeAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{66}
+}
+func (m *MsgFreezeAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.MintedSupply != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MintedSupply))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.MaxSupply != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxSupply))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFreezeAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAddressResponse.Merge(m, src)
+}
+func (m *MsgFreezeAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFreezeAddressResponse proto.InternalMessageInfo
+
+func (m *MsgFreezeAddressResponse) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnfreezeAddress defines the MsgUnfreezeAddress message.
+type MsgUnfreezeAddress struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgUpdateVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUnfreezeAddress) Reset()         { *m = MsgUnfreezeAddress{} }
+func (m *MsgUnfreezeAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAddress) ProtoMessage()    {}
+func (*MsgUnfreezeAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{67}
 }
-
-func (m *MsgRenounceTokenAdmin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnfreezeAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRenounceTokenAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnfreezeAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAddress.Merge(m, src)
+}
+func (m *MsgUnfreezeAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAddress.DiscardUnknown(m)
 }
 
-func (m *MsgRenounceTokenAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgUnfreezeAddress proto.InternalMessageInfo
+
+func (m *MsgUnfreezeAddress) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUnfreezeAddress) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRenounceTokenAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnfreezeAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRenounceTokenAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnfreezeAddressResponse defines the MsgUnfreezeAddressResponse message.
+type MsgUnfreezeAddressResponse struct {
 }
 
-func (m *MsgRenounceTokenAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AdminRenounced {
-		i--
-		if m.AdminRenounced {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUnfreezeAddressResponse) Reset()         { *m = MsgUnfreezeAddressResponse{} }
+func (m *MsgUnfreezeAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAddressResponse) ProtoMessage()    {}
+func (*MsgUnfreezeAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{68}
+}
+func (m *MsgUnfreezeAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnfreezeAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAddressResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetMerchantIncentiveRouting) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnfreezeAddressResponse proto.InternalMessageInfo
+
+// MsgSetTokenIBCPolicy defines the MsgSetTokenIBCPolicy message.
+type MsgSetTokenIBCPolicy struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// mode is "allowed", "blocked" or "allowlisted".
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// allowed_channels is required in allowlisted mode and must be empty otherwise.
+	AllowedChannels []string `protobuf:"bytes,4,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+}
+
+func (m *MsgSetTokenIBCPolicy) Reset()         { *m = MsgSetTokenIBCPolicy{} }
+func (m *MsgSetTokenIBCPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTokenIBCPolicy) ProtoMessage()    {}
+func (*MsgSetTokenIBCPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{69}
+}
+func (m *MsgSetTokenIBCPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTokenIBCPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenIBCPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetTokenIBCPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenIBCPolicy.Merge(m, src)
+}
+func (m *MsgSetTokenIBCPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenIBCPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenIBCPolicy.DiscardUnknown(m)
 }
 
-func (m *MsgSetMerchantIncentiveRouting) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetTokenIBCPolicy proto.InternalMessageInfo
+
+func (m *MsgSetTokenIBCPolicy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgSetMerchantIncentiveRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MerchantIncentiveTreasuryBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveTreasuryBps))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MerchantIncentiveStakersBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveStakersBps))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetTokenIBCPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetTokenIBCPolicy) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetMerchantIncentiveRoutingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTokenIBCPolicy) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetMerchantIncentiveRoutingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetTokenIBCPolicyResponse defines the MsgSetTokenIBCPolicyResponse message.
+type MsgSetTokenIBCPolicyResponse struct {
+	Policy TokenIBCPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
 }
 
-func (m *MsgSetMerchantIncentiveRoutingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MerchantIncentiveTreasuryBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveTreasuryBps))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MerchantIncentiveStakersBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveStakersBps))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetTokenIBCPolicyResponse) Reset()         { *m = MsgSetTokenIBCPolicyResponse{} }
+func (m *MsgSetTokenIBCPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTokenIBCPolicyResponse) ProtoMessage()    {}
+func (*MsgSetTokenIBCPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{70}
+}
+func (m *MsgSetTokenIBCPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTokenIBCPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenIBCPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetTokenIBCPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenIBCPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetTokenIBCPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenIBCPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenIBCPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteVerifiedtoken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTokenIBCPolicyResponse proto.InternalMessageInfo
+
+func (m *MsgSetTokenIBCPolicyResponse) GetPolicy() TokenIBCPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return dAtA[:n], nil
+	return TokenIBCPolicy{}
 }
 
-func (m *MsgDeleteVerifiedtoken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetIBCRecorder defines the MsgSetIBCRecorder message.
+type MsgSetIBCRecorder struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sender is the transfer sender on the counterparty chain; it is not
+	// validated as an address of this chain.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// enabled adds the recorder when set and removes it otherwise.
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgDeleteVerifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetIBCRecorder) Reset()         { *m = MsgSetIBCRecorder{} }
+func (m *MsgSetIBCRecorder) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCRecorder) ProtoMessage()    {}
+func (*MsgSetIBCRecorder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{71}
 }
-
-func (m *MsgDeleteVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetIBCRecorder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCRecorder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCRecorder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetIBCRecorder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCRecorder.Merge(m, src)
 }
-
-func (m *MsgDeleteVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetIBCRecorder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCRecorder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCRecorder.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRewardaccrual) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetIBCRecorder proto.InternalMessageInfo
+
+func (m *MsgSetIBCRecorder) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateRewardaccrual) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetIBCRecorder) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *MsgCreateRewardaccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LastRollupDate) > 0 {
-		i -= len(m.LastRollupDate)
-		copy(dAtA[i:], m.LastRollupDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LastRollupDate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetIBCRecorder) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetIBCRecorder) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCreateRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetIBCRecorderResponse defines the MsgSetIBCRecorderResponse message.
+type MsgSetIBCRecorderResponse struct {
 }
 
-func (m *MsgCreateRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetIBCRecorderResponse) Reset()         { *m = MsgSetIBCRecorderResponse{} }
+func (m *MsgSetIBCRecorderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCRecorderResponse) ProtoMessage()    {}
+func (*MsgSetIBCRecorderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{72}
 }
-
-func (m *MsgUpdateRewardaccrual) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetIBCRecorderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCRecorderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCRecorderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetIBCRecorderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCRecorderResponse.Merge(m, src)
+}
+func (m *MsgSetIBCRecorderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCRecorderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCRecorderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRewardaccrual) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetIBCRecorderResponse proto.InternalMessageInfo
+
+// MsgRegisterMerchantICA defines the MsgRegisterMerchantICA message.
+type MsgRegisterMerchantICA struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// denom is a verified token linked to the merchant; creator must own it.
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 }
 
-func (m *MsgUpdateRewardaccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LastRollupDate) > 0 {
-		i -= len(m.LastRollupDate)
-		copy(dAtA[i:], m.LastRollupDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LastRollupDate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRegisterMerchantICA) Reset()         { *m = MsgRegisterMerchantICA{} }
+func (m *MsgRegisterMerchantICA) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMerchantICA) ProtoMessage()    {}
+func (*MsgRegisterMerchantICA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{73}
 }
-
-func (m *MsgUpdateRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterMerchantICA) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMerchantICA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMerchantICA.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterMerchantICA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMerchantICA.Merge(m, src)
+}
+func (m *MsgRegisterMerchantICA) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMerchantICA) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMerchantICA.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterMerchantICA proto.InternalMessageInfo
+
+func (m *MsgRegisterMerchantICA) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgUpdateRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRegisterMerchantICA) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgDeleteRewardaccrual) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterMerchantICA) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteRewardaccrual) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRegisterMerchantICAResponse defines the MsgRegisterMerchantICAResponse message.
+type MsgRegisterMerchantICAResponse struct {
+	Ica MerchantICA `protobuf:"bytes,1,opt,name=ica,proto3" json:"ica"`
 }
 
-func (m *MsgDeleteRewardaccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRegisterMerchantICAResponse) Reset()         { *m = MsgRegisterMerchantICAResponse{} }
+func (m *MsgRegisterMerchantICAResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMerchantICAResponse) ProtoMessage()    {}
+func (*MsgRegisterMerchantICAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{74}
+}
+func (m *MsgRegisterMerchantICAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMerchantICAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMerchantICAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRegisterMerchantICAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMerchantICAResponse.Merge(m, src)
+}
+func (m *MsgRegisterMerchantICAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMerchantICAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMerchantICAResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterMerchantICAResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterMerchantICAResponse) GetIca() MerchantICA {
+	if m != nil {
+		return m.Ica
 	}
-	return dAtA[:n], nil
+	return MerchantICA{}
 }
 
-func (m *MsgDeleteRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSendMerchantICATx defines the MsgSendMerchantICATx message.
+type MsgSendMerchantICATx struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// cosmos_tx is a proto-encoded ibc.applications.interchain_accounts.v1.CosmosTx
+	// whose messages the interchain account executes on the host chain, for example
+	// to provide liquidity with forwarded treasury funds. Host messages are kept
+	// encoded because this chain does not know their types.
+	CosmosTx []byte `protobuf:"bytes,4,opt,name=cosmos_tx,json=cosmosTx,proto3" json:"cosmos_tx,omitempty"`
+	Memo     string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	// timeout_seconds defaults to ten minutes when zero.
+	TimeoutSeconds uint64 `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 }
 
-func (m *MsgDeleteRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSendMerchantICATx) Reset()         { *m = MsgSendMerchantICATx{} }
+func (m *MsgSendMerchantICATx) String() string { return proto.CompactTextString(m) }
+func (*MsgSendMerchantICATx) ProtoMessage()    {}
+func (*MsgSendMerchantICATx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{75}
 }
-
-func (m *MsgMintVerifiedToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendMerchantICATx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendMerchantICATx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendMerchantICATx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgMintVerifiedToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendMerchantICATx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendMerchantICATx.Merge(m, src)
+}
+func (m *MsgSendMerchantICATx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendMerchantICATx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendMerchantICATx.DiscardUnknown(m)
 }
 
-func (m *MsgMintVerifiedToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSendMerchantICATx proto.InternalMessageInfo
+
+func (m *MsgSendMerchantICATx) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMintVerifiedTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendMerchantICATx) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMintVerifiedTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendMerchantICATx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
 }
 
-func (m *MsgMintVerifiedTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MintedSupply != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MintedSupply))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgSendMerchantICATx) GetCosmosTx() []byte {
+	if m != nil {
+		return m.CosmosTx
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgSendMerchantICATx) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClaimReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendMerchantICATx) GetTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TimeoutSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgClaimReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSendMerchantICATxResponse defines the MsgSendMerchantICATxResponse message.
+type MsgSendMerchantICATxResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *MsgClaimReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSendMerchantICATxResponse) Reset()         { *m = MsgSendMerchantICATxResponse{} }
+func (m *MsgSendMerchantICATxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendMerchantICATxResponse) ProtoMessage()    {}
+func (*MsgSendMerchantICATxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{76}
+}
+func (m *MsgSendMerchantICATxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendMerchantICATxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendMerchantICATxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSendMerchantICATxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendMerchantICATxResponse.Merge(m, src)
+}
+func (m *MsgSendMerchantICATxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendMerchantICATxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendMerchantICATxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendMerchantICATxResponse proto.InternalMessageInfo
+
+func (m *MsgSendMerchantICATxResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgClaimRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgForwardMerchantTreasury defines the MsgForwardMerchantTreasury message.
+type MsgForwardMerchantTreasury struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// allocation_key is the merchant allocation whose treasury amount is sent from
+	// creator to the interchain account.
+	AllocationKey string `protobuf:"bytes,2,opt,name=allocation_key,json=allocationKey,proto3" json:"allocation_key,omitempty"`
+	ConnectionId  string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// transfer_channel is a transfer channel over connection_id.
+	TransferChannel string `protobuf:"bytes,4,opt,name=transfer_channel,json=transferChannel,proto3" json:"transfer_channel,omitempty"`
+	// timeout_seconds defaults to ten minutes when zero.
+	TimeoutSeconds uint64 `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (m *MsgForwardMerchantTreasury) Reset()         { *m = MsgForwardMerchantTreasury{} }
+func (m *MsgForwardMerchantTreasury) String() string { return proto.CompactTextString(m) }
+func (*MsgForwardMerchantTreasury) ProtoMessage()    {}
+func (*MsgForwardMerchantTreasury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{77}
+}
+func (m *MsgForwardMerchantTreasury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForwardMerchantTreasury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForwardMerchantTreasury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgForwardMerchantTreasury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForwardMerchantTreasury.Merge(m, src)
+}
+func (m *MsgForwardMerchantTreasury) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForwardMerchantTreasury) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForwardMerchantTreasury.DiscardUnknown(m)
 }
 
-func (m *MsgClaimRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgForwardMerchantTreasury proto.InternalMessageInfo
+
+func (m *MsgForwardMerchantTreasury) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgClaimRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AmountClaimed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AmountClaimed))
-		i--
-		dAtA[i] = 0x18
+func (m *MsgForwardMerchantTreasury) GetAllocationKey() string {
+	if m != nil {
+		return m.AllocationKey
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgForwardMerchantTreasury) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgForwardMerchantTreasury) GetTransferChannel() string {
+	if m != nil {
+		return m.TransferChannel
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFundRewardPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgForwardMerchantTreasury) GetTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TimeoutSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgFundRewardPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgForwardMerchantTreasuryResponse defines the MsgForwardMerchantTreasuryResponse message.
+type MsgForwardMerchantTreasuryResponse struct {
+	Forward TreasuryForward `protobuf:"bytes,1,opt,name=forward,proto3" json:"forward"`
 }
 
-func (m *MsgFundRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgForwardMerchantTreasuryResponse) Reset()         { *m = MsgForwardMerchantTreasuryResponse{} }
+func (m *MsgForwardMerchantTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForwardMerchantTreasuryResponse) ProtoMessage()    {}
+func (*MsgForwardMerchantTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{78}
+}
+func (m *MsgForwardMerchantTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForwardMerchantTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForwardMerchantTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgForwardMerchantTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForwardMerchantTreasuryResponse.Merge(m, src)
+}
+func (m *MsgForwardMerchantTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForwardMerchantTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForwardMerchantTreasuryResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFundRewardPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgForwardMerchantTreasuryResponse proto.InternalMessageInfo
+
+func (m *MsgForwardMerchantTreasuryResponse) GetForward() TreasuryForward {
+	if m != nil {
+		return m.Forward
 	}
-	return dAtA[:n], nil
+	return TreasuryForward{}
 }
 
-func (m *MsgFundRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetIBCRateLimit defines the MsgSetIBCRateLimit message.
+type MsgSetIBCRateLimit struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is a denom native to this chain, such as the staking token or a
+	// verified token.
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// max_outflow and max_inflow cap each direction per window; zero leaves it
+	// unlimited, but not both.
+	MaxOutflow    uint64 `protobuf:"varint,4,opt,name=max_outflow,json=maxOutflow,proto3" json:"max_outflow,omitempty"`
+	MaxInflow     uint64 `protobuf:"varint,5,opt,name=max_inflow,json=maxInflow,proto3" json:"max_inflow,omitempty"`
+	WindowSeconds uint64 `protobuf:"varint,6,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
 }
 
-func (m *MsgFundRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewBalance) > 0 {
-		i -= len(m.NewBalance)
-		copy(dAtA[i:], m.NewBalance)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBalance)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgSetIBCRateLimit) Reset()         { *m = MsgSetIBCRateLimit{} }
+func (m *MsgSetIBCRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCRateLimit) ProtoMessage()    {}
+func (*MsgSetIBCRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{79}
+}
+func (m *MsgSetIBCRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AmountFunded != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AmountFunded))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *MsgSetIBCRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCRateLimit.Merge(m, src)
+}
+func (m *MsgSetIBCRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIBCRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetIBCRateLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgSetIBCRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetIBCRateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRecordRewardAccrual) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetIBCRateLimit) GetMaxOutflow() uint64 {
+	if m != nil {
+		return m.MaxOutflow
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRecordRewardAccrual) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetIBCRateLimit) GetMaxInflow() uint64 {
+	if m != nil {
+		return m.MaxInflow
+	}
+	return 0
 }
 
-func (m *MsgRecordRewardAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Date) > 0 {
-		i -= len(m.Date)
-		copy(dAtA[i:], m.Date)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Date)))
-		i--
-		dAtA[i] = 0x2a
+func (m *MsgSetIBCRateLimit) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// MsgSetIBCRateLimitResponse defines the MsgSetIBCRateLimitResponse message.
+type MsgSetIBCRateLimitResponse struct {
+	RateLimit IBCRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *MsgSetIBCRateLimitResponse) Reset()         { *m = MsgSetIBCRateLimitResponse{} }
+func (m *MsgSetIBCRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetIBCRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{80}
+}
+func (m *MsgSetIBCRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetIBCRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetIBCRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRecordRewardAccrualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetIBCRateLimitResponse proto.InternalMessageInfo
+
+func (m *MsgSetIBCRateLimitResponse) GetRateLimit() IBCRateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return dAtA[:n], nil
+	return IBCRateLimit{}
 }
 
-func (m *MsgRecordRewardAccrualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveIBCRateLimit defines the MsgRemoveIBCRateLimit message.
+type MsgRemoveIBCRateLimit struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *MsgRecordRewardAccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollupDate) > 0 {
-		i -= len(m.RollupDate)
-		copy(dAtA[i:], m.RollupDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollupDate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TotalAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TotalAmount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.AmountAdded != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AmountAdded))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRemoveIBCRateLimit) Reset()         { *m = MsgRemoveIBCRateLimit{} }
+func (m *MsgRemoveIBCRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveIBCRateLimit) ProtoMessage()    {}
+func (*MsgRemoveIBCRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{81}
+}
+func (m *MsgRemoveIBCRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveIBCRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveIBCRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgRemoveIBCRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveIBCRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveIBCRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveIBCRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveIBCRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveIBCRateLimit proto.InternalMessageInfo
+
+func (m *MsgRemoveIBCRateLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRemoveIBCRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRecordMerchantAllocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveIBCRateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRecordMerchantAllocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveIBCRateLimitResponse defines the MsgRemoveIBCRateLimitResponse message.
+type MsgRemoveIBCRateLimitResponse struct {
 }
 
-func (m *MsgRecordMerchantAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BucketCAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BucketCAmount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ActivityScore != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ActivityScore))
-		i--
-		dAtA[i] = 0x20
+func (m *MsgRemoveIBCRateLimitResponse) Reset()         { *m = MsgRemoveIBCRateLimitResponse{} }
+func (m *MsgRemoveIBCRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveIBCRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveIBCRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{82}
+}
+func (m *MsgRemoveIBCRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveIBCRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveIBCRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgRemoveIBCRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveIBCRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveIBCRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveIBCRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveIBCRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveIBCRateLimitResponse proto.InternalMessageInfo
+
+// MsgResetIBCRateLimit defines the MsgResetIBCRateLimit message.
+type MsgResetIBCRateLimit struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgResetIBCRateLimit) Reset()         { *m = MsgResetIBCRateLimit{} }
+func (m *MsgResetIBCRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgResetIBCRateLimit) ProtoMessage()    {}
+func (*MsgResetIBCRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{83}
+}
+func (m *MsgResetIBCRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetIBCRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetIBCRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Date) > 0 {
-		i -= len(m.Date)
-		copy(dAtA[i:], m.Date)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Date)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgResetIBCRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetIBCRateLimit.Merge(m, src)
+}
+func (m *MsgResetIBCRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetIBCRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetIBCRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetIBCRateLimit proto.InternalMessageInfo
+
+func (m *MsgResetIBCRateLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgResetIBCRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRecordMerchantAllocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResetIBCRateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRecordMerchantAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgResetIBCRateLimitResponse defines the MsgResetIBCRateLimitResponse message.
+type MsgResetIBCRateLimitResponse struct {
 }
 
-func (m *MsgRecordMerchantAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Updated {
-		i--
-		if m.Updated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgResetIBCRateLimitResponse) Reset()         { *m = MsgResetIBCRateLimitResponse{} }
+func (m *MsgResetIBCRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetIBCRateLimitResponse) ProtoMessage()    {}
+func (*MsgResetIBCRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{84}
+}
+func (m *MsgResetIBCRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetIBCRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetIBCRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.MerchantIncentiveTreasuryBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveTreasuryBps))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.MerchantIncentiveStakersBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveStakersBps))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.TreasuryAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TreasuryAmount))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.StakersAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StakersAmount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.BucketCAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BucketCAmount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ActivityScore != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ActivityScore))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Date) > 0 {
-		i -= len(m.Date)
-		copy(dAtA[i:], m.Date)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Date)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResetIBCRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetIBCRateLimitResponse.Merge(m, src)
+}
+func (m *MsgResetIBCRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetIBCRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetIBCRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgQueueRecoveryTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResetIBCRateLimitResponse proto.InternalMessageInfo
+
+// MsgSetFeeToken defines the MsgSetFeeToken message.
+type MsgSetFeeToken struct {
+	Creator        string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom          string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	TokenUnits     uint64   `protobuf:"varint,3,opt,name=token_units,json=tokenUnits,proto3" json:"token_units,omitempty"`
+	UtokenUnits    uint64   `protobuf:"varint,4,opt,name=utoken_units,json=utokenUnits,proto3" json:"utoken_units,omitempty"`
+	MaxUtokenPerTx uint64   `protobuf:"varint,5,opt,name=max_utoken_per_tx,json=maxUtokenPerTx,proto3" json:"max_utoken_per_tx,omitempty"`
+	MsgTypes       []string `protobuf:"bytes,6,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// enabled may only be changed by the module authority.
+	Enabled bool `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetFeeToken) Reset()         { *m = MsgSetFeeToken{} }
+func (m *MsgSetFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeToken) ProtoMessage()    {}
+func (*MsgSetFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{85}
+}
+func (m *MsgSetFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeToken.Merge(m, src)
+}
+func (m *MsgSetFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeToken.DiscardUnknown(m)
 }
 
-func (m *MsgQueueRecoveryTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetFeeToken proto.InternalMessageInfo
+
+func (m *MsgSetFeeToken) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgQueueRecoveryTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x28
+func (m *MsgSetFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *MsgSetFeeToken) GetTokenUnits() uint64 {
+	if m != nil {
+		return m.TokenUnits
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *MsgSetFeeToken) GetUtokenUnits() uint64 {
+	if m != nil {
+		return m.UtokenUnits
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgSetFeeToken) GetMaxUtokenPerTx() uint64 {
+	if m != nil {
+		return m.MaxUtokenPerTx
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgSetFeeToken) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgQueueRecoveryTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeToken) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgQueueRecoveryTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetFeeTokenResponse defines the MsgSetFeeTokenResponse message.
+type MsgSetFeeTokenResponse struct {
+	FeeToken FeeToken `protobuf:"bytes,1,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
 }
 
-func (m *MsgQueueRecoveryTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecuteAfter != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteAfter))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSetFeeTokenResponse) Reset()         { *m = MsgSetFeeTokenResponse{} }
+func (m *MsgSetFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{86}
+}
+func (m *MsgSetFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExecuteRecoveryTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFeeTokenResponse proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokenResponse) GetFeeToken() FeeToken {
+	if m != nil {
+		return m.FeeToken
 	}
-	return dAtA[:n], nil
+	return FeeToken{}
 }
 
-func (m *MsgExecuteRecoveryTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFundGasPool defines the MsgFundGasPool message. The creator must be the
+// token owner or the module authority.
+type MsgFundGasPool struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is in the staking denom.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgExecuteRecoveryTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgFundGasPool) Reset()         { *m = MsgFundGasPool{} }
+func (m *MsgFundGasPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundGasPool) ProtoMessage()    {}
+func (*MsgFundGasPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{87}
+}
+func (m *MsgFundGasPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundGasPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundGasPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgFundGasPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundGasPool.Merge(m, src)
+}
+func (m *MsgFundGasPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundGasPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundGasPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundGasPool proto.InternalMessageInfo
+
+func (m *MsgFundGasPool) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgExecuteRecoveryTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFundGasPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgExecuteRecoveryTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFundGasPool) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *MsgExecuteRecoveryTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecutedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExecutedAt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+// MsgFundGasPoolResponse defines the MsgFundGasPoolResponse message.
+type MsgFundGasPoolResponse struct {
+	GasPool GasPool `protobuf:"bytes,1,opt,name=gas_pool,json=gasPool,proto3" json:"gas_pool"`
+}
+
+func (m *MsgFundGasPoolResponse) Reset()         { *m = MsgFundGasPoolResponse{} }
+func (m *MsgFundGasPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundGasPoolResponse) ProtoMessage()    {}
+func (*MsgFundGasPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{88}
+}
+func (m *MsgFundGasPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundGasPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundGasPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFundGasPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundGasPoolResponse.Merge(m, src)
+}
+func (m *MsgFundGasPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundGasPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundGasPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelRecoveryTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFundGasPoolResponse proto.InternalMessageInfo
+
+func (m *MsgFundGasPoolResponse) GetGasPool() GasPool {
+	if m != nil {
+		return m.GasPool
 	}
-	return dAtA[:n], nil
+	return GasPool{}
 }
 
-func (m *MsgCancelRecoveryTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawGasPool defines the MsgWithdrawGasPool message.
+type MsgWithdrawGasPool struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// recipient defaults to creator.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgCancelRecoveryTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgWithdrawGasPool) Reset()         { *m = MsgWithdrawGasPool{} }
+func (m *MsgWithdrawGasPool) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawGasPool) ProtoMessage()    {}
+func (*MsgWithdrawGasPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{89}
+}
+func (m *MsgWithdrawGasPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawGasPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawGasPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawGasPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawGasPool.Merge(m, src)
+}
+func (m *MsgWithdrawGasPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawGasPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawGasPool.DiscardUnknown(m)
 }
 
-func (m *MsgCancelRecoveryTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawGasPool proto.InternalMessageInfo
+
+func (m *MsgWithdrawGasPool) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelRecoveryTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawGasPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgCancelRecoveryTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CancelledAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CancelledAt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgWithdrawGasPool) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWithdrawGasPool) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+// MsgWithdrawGasPoolResponse defines the MsgWithdrawGasPoolResponse message.
+type MsgWithdrawGasPoolResponse struct {
+	GasPool GasPool `protobuf:"bytes,1,opt,name=gas_pool,json=gasPool,proto3" json:"gas_pool"`
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawGasPoolResponse) Reset()         { *m = MsgWithdrawGasPoolResponse{} }
+func (m *MsgWithdrawGasPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawGasPoolResponse) ProtoMessage()    {}
+func (*MsgWithdrawGasPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{90}
+}
+func (m *MsgWithdrawGasPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawGasPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawGasPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgWithdrawGasPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawGasPoolResponse.Merge(m, src)
+}
+func (m *MsgWithdrawGasPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawGasPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawGasPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateCreatorallowlist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
+var xxx_messageInfo_MsgWithdrawGasPoolResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawGasPoolResponse) GetGasPool() GasPool {
+	if m != nil {
+		return m.GasPool
 	}
-	return n
+	return GasPool{}
 }
 
-func (m *MsgCreateCreatorallowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgSetClaimSponsorship defines the MsgSetClaimSponsorship message.
+type MsgSetClaimSponsorship struct {
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom       string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	DailyBudget uint64   `protobuf:"varint,3,opt,name=daily_budget,json=dailyBudget,proto3" json:"daily_budget,omitempty"`
+	PerUserCap  uint64   `protobuf:"varint,4,opt,name=per_user_cap,json=perUserCap,proto3" json:"per_user_cap,omitempty"`
+	MsgTypes    []string `protobuf:"bytes,5,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	Enabled     bool     `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgUpdateCreatorallowlist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
+func (m *MsgSetClaimSponsorship) Reset()         { *m = MsgSetClaimSponsorship{} }
+func (m *MsgSetClaimSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimSponsorship) ProtoMessage()    {}
+func (*MsgSetClaimSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{91}
+}
+func (m *MsgSetClaimSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetClaimSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimSponsorship.Merge(m, src)
+}
+func (m *MsgSetClaimSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimSponsorship.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCreatorallowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetClaimSponsorship proto.InternalMessageInfo
+
+func (m *MsgSetClaimSponsorship) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgDeleteCreatorallowlist) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetClaimSponsorship) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return ""
 }
 
-func (m *MsgDeleteCreatorallowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetClaimSponsorship) GetDailyBudget() uint64 {
+	if m != nil {
+		return m.DailyBudget
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgCreateVerifiedtoken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MaxSupply != 0 {
-		n += 1 + sovTx(uint64(m.MaxSupply))
-	}
-	if m.MintedSupply != 0 {
-		n += 1 + sovTx(uint64(m.MintedSupply))
-	}
-	if m.Verified {
-		n += 2
-	}
-	if m.SeizureOptIn {
-		n += 2
-	}
-	l = len(m.RecoveryGroupPolicy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RecoveryTimelockHours != 0 {
-		n += 1 + sovTx(uint64(m.RecoveryTimelockHours))
+func (m *MsgSetClaimSponsorship) GetPerUserCap() uint64 {
+	if m != nil {
+		return m.PerUserCap
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCreateVerifiedtokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetClaimSponsorship) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
 	}
-	return n
+	return nil
 }
 
-func (m *MsgUpdateVerifiedtoken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MaxSupply != 0 {
-		n += 1 + sovTx(uint64(m.MaxSupply))
-	}
-	if m.MintedSupply != 0 {
-		n += 1 + sovTx(uint64(m.MintedSupply))
-	}
-	if m.Verified {
-		n += 2
-	}
-	if m.SeizureOptIn {
-		n += 2
-	}
-	l = len(m.RecoveryGroupPolicy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RecoveryTimelockHours != 0 {
-		n += 1 + sovTx(uint64(m.RecoveryTimelockHours))
+func (m *MsgSetClaimSponsorship) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return n
+	return false
 }
 
-func (m *MsgUpdateVerifiedtokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgSetClaimSponsorshipResponse defines the MsgSetClaimSponsorshipResponse message.
+type MsgSetClaimSponsorshipResponse struct {
+	Sponsorship ClaimSponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
 }
 
-func (m *MsgRenounceTokenAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetClaimSponsorshipResponse) Reset()         { *m = MsgSetClaimSponsorshipResponse{} }
+func (m *MsgSetClaimSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetClaimSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241469d78c289384, []int{92}
 }
-
-func (m *MsgRenounceTokenAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AdminRenounced {
-		n += 2
-	}
-	return n
+func (m *MsgSetClaimSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSetMerchantIncentiveRouting) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MerchantIncentiveStakersBps != 0 {
-		n += 1 + sovTx(uint64(m.MerchantIncentiveStakersBps))
-	}
-	if m.MerchantIncentiveTreasuryBps != 0 {
-		n += 1 + sovTx(uint64(m.MerchantIncentiveTreasuryBps))
+func (m *MsgSetClaimSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *MsgSetMerchantIncentiveRoutingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MerchantIncentiveStakersBps != 0 {
-		n += 1 + sovTx(uint64(m.MerchantIncentiveStakersBps))
-	}
-	if m.MerchantIncentiveTreasuryBps != 0 {
-		n += 1 + sovTx(uint64(m.MerchantIncentiveTreasuryBps))
-	}
-	return n
+func (m *MsgSetClaimSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimSponsorshipResponse.Merge(m, src)
 }
-
-func (m *MsgDeleteVerifiedtoken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetClaimSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimSponsorshipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteVerifiedtokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetClaimSponsorshipResponse proto.InternalMessageInfo
+
+func (m *MsgSetClaimSponsorshipResponse) GetSponsorship() ClaimSponsorship {
+	if m != nil {
+		return m.Sponsorship
 	}
-	var l int
-	_ = l
-	return n
+	return ClaimSponsorship{}
 }
 
-func (m *MsgCreateRewardaccrual) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.LastRollupDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "tokenchain.loyalty.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateAuthorities)(nil), "tokenchain.loyalty.v1.MsgUpdateAuthorities")
+	proto.RegisterType((*MsgUpdateAuthoritiesResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateAuthoritiesResponse")
+	proto.RegisterType((*MsgCreateCreatorallowlist)(nil), "tokenchain.loyalty.v1.MsgCreateCreatorallowlist")
+	proto.RegisterType((*MsgCreateCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.MsgCreateCreatorallowlistResponse")
+	proto.RegisterType((*MsgUpdateCreatorallowlist)(nil), "tokenchain.loyalty.v1.MsgUpdateCreatorallowlist")
+	proto.RegisterType((*MsgUpdateCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateCreatorallowlistResponse")
+	proto.RegisterType((*MsgDeleteCreatorallowlist)(nil), "tokenchain.loyalty.v1.MsgDeleteCreatorallowlist")
+	proto.RegisterType((*MsgDeleteCreatorallowlistResponse)(nil), "tokenchain.loyalty.v1.MsgDeleteCreatorallowlistResponse")
+	proto.RegisterType((*MsgCreateVerifiedtoken)(nil), "tokenchain.loyalty.v1.MsgCreateVerifiedtoken")
+	proto.RegisterType((*MsgCreateVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.MsgCreateVerifiedtokenResponse")
+	proto.RegisterType((*MsgUpdateVerifiedtoken)(nil), "tokenchain.loyalty.v1.MsgUpdateVerifiedtoken")
+	proto.RegisterType((*MsgUpdateVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateVerifiedtokenResponse")
+	proto.RegisterType((*MsgRenounceTokenAdmin)(nil), "tokenchain.loyalty.v1.MsgRenounceTokenAdmin")
+	proto.RegisterType((*MsgRenounceTokenAdminResponse)(nil), "tokenchain.loyalty.v1.MsgRenounceTokenAdminResponse")
+	proto.RegisterType((*MsgSetMerchantIncentiveRouting)(nil), "tokenchain.loyalty.v1.MsgSetMerchantIncentiveRouting")
+	proto.RegisterType((*MsgSetMerchantIncentiveRoutingResponse)(nil), "tokenchain.loyalty.v1.MsgSetMerchantIncentiveRoutingResponse")
+	proto.RegisterType((*MsgDeleteVerifiedtoken)(nil), "tokenchain.loyalty.v1.MsgDeleteVerifiedtoken")
+	proto.RegisterType((*MsgDeleteVerifiedtokenResponse)(nil), "tokenchain.loyalty.v1.MsgDeleteVerifiedtokenResponse")
+	proto.RegisterType((*MsgCreateRewardaccrual)(nil), "tokenchain.loyalty.v1.MsgCreateRewardaccrual")
+	proto.RegisterType((*MsgCreateRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.MsgCreateRewardaccrualResponse")
+	proto.RegisterType((*MsgUpdateRewardaccrual)(nil), "tokenchain.loyalty.v1.MsgUpdateRewardaccrual")
+	proto.RegisterType((*MsgUpdateRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateRewardaccrualResponse")
+	proto.RegisterType((*MsgDeleteRewardaccrual)(nil), "tokenchain.loyalty.v1.MsgDeleteRewardaccrual")
+	proto.RegisterType((*MsgDeleteRewardaccrualResponse)(nil), "tokenchain.loyalty.v1.MsgDeleteRewardaccrualResponse")
+	proto.RegisterType((*MsgMintVerifiedToken)(nil), "tokenchain.loyalty.v1.MsgMintVerifiedToken")
+	proto.RegisterType((*MsgMintVerifiedTokenResponse)(nil), "tokenchain.loyalty.v1.MsgMintVerifiedTokenResponse")
+	proto.RegisterType((*MintRecipient)(nil), "tokenchain.loyalty.v1.MintRecipient")
+	proto.RegisterType((*MsgMintVerifiedTokenBatch)(nil), "tokenchain.loyalty.v1.MsgMintVerifiedTokenBatch")
+	proto.RegisterType((*MsgMintVerifiedTokenBatchResponse)(nil), "tokenchain.loyalty.v1.MsgMintVerifiedTokenBatchResponse")
+	proto.RegisterType((*MsgClaimReward)(nil), "tokenchain.loyalty.v1.MsgClaimReward")
+	proto.RegisterType((*MsgClaimRewardResponse)(nil), "tokenchain.loyalty.v1.MsgClaimRewardResponse")
+	proto.RegisterType((*MsgFundRewardPool)(nil), "tokenchain.loyalty.v1.MsgFundRewardPool")
+	proto.RegisterType((*MsgFundRewardPoolResponse)(nil), "tokenchain.loyalty.v1.MsgFundRewardPoolResponse")
+	proto.RegisterType((*MsgRecordRewardAccrual)(nil), "tokenchain.loyalty.v1.MsgRecordRewardAccrual")
+	proto.RegisterType((*MsgRecordRewardAccrualResponse)(nil), "tokenchain.loyalty.v1.MsgRecordRewardAccrualResponse")
+	proto.RegisterType((*MsgRecordMerchantAllocation)(nil), "tokenchain.loyalty.v1.MsgRecordMerchantAllocation")
+	proto.RegisterType((*MsgRecordMerchantAllocationResponse)(nil), "tokenchain.loyalty.v1.MsgRecordMerchantAllocationResponse")
+	proto.RegisterType((*MsgQueueRecoveryTransfer)(nil), "tokenchain.loyalty.v1.MsgQueueRecoveryTransfer")
+	proto.RegisterType((*MsgQueueRecoveryTransferResponse)(nil), "tokenchain.loyalty.v1.MsgQueueRecoveryTransferResponse")
+	proto.RegisterType((*MsgExecuteRecoveryTransfer)(nil), "tokenchain.loyalty.v1.MsgExecuteRecoveryTransfer")
+	proto.RegisterType((*MsgExecuteRecoveryTransferResponse)(nil), "tokenchain.loyalty.v1.MsgExecuteRecoveryTransferResponse")
+	proto.RegisterType((*MsgCancelRecoveryTransfer)(nil), "tokenchain.loyalty.v1.MsgCancelRecoveryTransfer")
+	proto.RegisterType((*MsgCancelRecoveryTransferResponse)(nil), "tokenchain.loyalty.v1.MsgCancelRecoveryTransferResponse")
+	proto.RegisterType((*MsgRegisterMerchant)(nil), "tokenchain.loyalty.v1.MsgRegisterMerchant")
+	proto.RegisterType((*MsgRegisterMerchantResponse)(nil), "tokenchain.loyalty.v1.MsgRegisterMerchantResponse")
+	proto.RegisterType((*MsgUpdateMerchant)(nil), "tokenchain.loyalty.v1.MsgUpdateMerchant")
+	proto.RegisterType((*MsgUpdateMerchantResponse)(nil), "tokenchain.loyalty.v1.MsgUpdateMerchantResponse")
+	proto.RegisterType((*MsgDeactivateMerchant)(nil), "tokenchain.loyalty.v1.MsgDeactivateMerchant")
+	proto.RegisterType((*MsgDeactivateMerchantResponse)(nil), "tokenchain.loyalty.v1.MsgDeactivateMerchantResponse")
+	proto.RegisterType((*MsgSubmitAttestation)(nil), "tokenchain.loyalty.v1.MsgSubmitAttestation")
+	proto.RegisterType((*MsgSubmitAttestationResponse)(nil), "tokenchain.loyalty.v1.MsgSubmitAttestationResponse")
+	proto.RegisterType((*MsgRevokeAttestation)(nil), "tokenchain.loyalty.v1.MsgRevokeAttestation")
+	proto.RegisterType((*MsgRevokeAttestationResponse)(nil), "tokenchain.loyalty.v1.MsgRevokeAttestationResponse")
+	proto.RegisterType((*MsgCreateMintSchedule)(nil), "tokenchain.loyalty.v1.MsgCreateMintSchedule")
+	proto.RegisterType((*MsgCreateMintScheduleResponse)(nil), "tokenchain.loyalty.v1.MsgCreateMintScheduleResponse")
+	proto.RegisterType((*MsgCancelMintSchedule)(nil), "tokenchain.loyalty.v1.MsgCancelMintSchedule")
+	proto.RegisterType((*MsgCancelMintScheduleResponse)(nil), "tokenchain.loyalty.v1.MsgCancelMintScheduleResponse")
+	proto.RegisterType((*MsgSetMintRateLimit)(nil), "tokenchain.loyalty.v1.MsgSetMintRateLimit")
+	proto.RegisterType((*MsgSetMintRateLimitResponse)(nil), "tokenchain.loyalty.v1.MsgSetMintRateLimitResponse")
+	proto.RegisterType((*MsgPauseToken)(nil), "tokenchain.loyalty.v1.MsgPauseToken")
+	proto.RegisterType((*MsgPauseTokenResponse)(nil), "tokenchain.loyalty.v1.MsgPauseTokenResponse")
+	proto.RegisterType((*MsgUnpauseToken)(nil), "tokenchain.loyalty.v1.MsgUnpauseToken")
+	proto.RegisterType((*MsgUnpauseTokenResponse)(nil), "tokenchain.loyalty.v1.MsgUnpauseTokenResponse")
+	proto.RegisterType((*MsgFreezeAddress)(nil), "tokenchain.loyalty.v1.MsgFreezeAddress")
+	proto.RegisterType((*MsgFreezeAddressResponse)(nil), "tokenchain.loyalty.v1.MsgFreezeAddressResponse")
+	proto.RegisterType((*MsgUnfreezeAddress)(nil), "tokenchain.loyalty.v1.MsgUnfreezeAddress")
+	proto.RegisterType((*MsgUnfreezeAddressResponse)(nil), "tokenchain.loyalty.v1.MsgUnfreezeAddressResponse")
+	proto.RegisterType((*MsgSetTokenIBCPolicy)(nil), "tokenchain.loyalty.v1.MsgSetTokenIBCPolicy")
+	proto.RegisterType((*MsgSetTokenIBCPolicyResponse)(nil), "tokenchain.loyalty.v1.MsgSetTokenIBCPolicyResponse")
+	proto.RegisterType((*MsgSetIBCRecorder)(nil), "tokenchain.loyalty.v1.MsgSetIBCRecorder")
+	proto.RegisterType((*MsgSetIBCRecorderResponse)(nil), "tokenchain.loyalty.v1.MsgSetIBCRecorderResponse")
+	proto.RegisterType((*MsgRegisterMerchantICA)(nil), "tokenchain.loyalty.v1.MsgRegisterMerchantICA")
+	proto.RegisterType((*MsgRegisterMerchantICAResponse)(nil), "tokenchain.loyalty.v1.MsgRegisterMerchantICAResponse")
+	proto.RegisterType((*MsgSendMerchantICATx)(nil), "tokenchain.loyalty.v1.MsgSendMerchantICATx")
+	proto.RegisterType((*MsgSendMerchantICATxResponse)(nil), "tokenchain.loyalty.v1.MsgSendMerchantICATxResponse")
+	proto.RegisterType((*MsgForwardMerchantTreasury)(nil), "tokenchain.loyalty.v1.MsgForwardMerchantTreasury")
+	proto.RegisterType((*MsgForwardMerchantTreasuryResponse)(nil), "tokenchain.loyalty.v1.MsgForwardMerchantTreasuryResponse")
+	proto.RegisterType((*MsgSetIBCRateLimit)(nil), "tokenchain.loyalty.v1.MsgSetIBCRateLimit")
+	proto.RegisterType((*MsgSetIBCRateLimitResponse)(nil), "tokenchain.loyalty.v1.MsgSetIBCRateLimitResponse")
+	proto.RegisterType((*MsgRemoveIBCRateLimit)(nil), "tokenchain.loyalty.v1.MsgRemoveIBCRateLimit")
+	proto.RegisterType((*MsgRemoveIBCRateLimitResponse)(nil), "tokenchain.loyalty.v1.MsgRemoveIBCRateLimitResponse")
+	proto.RegisterType((*MsgResetIBCRateLimit)(nil), "tokenchain.loyalty.v1.MsgResetIBCRateLimit")
+	proto.RegisterType((*MsgResetIBCRateLimitResponse)(nil), "tokenchain.loyalty.v1.MsgResetIBCRateLimitResponse")
+	proto.RegisterType((*MsgSetFeeToken)(nil), "tokenchain.loyalty.v1.MsgSetFeeToken")
+	proto.RegisterType((*MsgSetFeeTokenResponse)(nil), "tokenchain.loyalty.v1.MsgSetFeeTokenResponse")
+	proto.RegisterType((*MsgFundGasPool)(nil), "tokenchain.loyalty.v1.MsgFundGasPool")
+	proto.RegisterType((*MsgFundGasPoolResponse)(nil), "tokenchain.loyalty.v1.MsgFundGasPoolResponse")
+	proto.RegisterType((*MsgWithdrawGasPool)(nil), "tokenchain.loyalty.v1.MsgWithdrawGasPool")
+	proto.RegisterType((*MsgWithdrawGasPoolResponse)(nil), "tokenchain.loyalty.v1.MsgWithdrawGasPoolResponse")
+	proto.RegisterType((*MsgSetClaimSponsorship)(nil), "tokenchain.loyalty.v1.MsgSetClaimSponsorship")
+	proto.RegisterType((*MsgSetClaimSponsorshipResponse)(nil), "tokenchain.loyalty.v1.MsgSetClaimSponsorshipResponse")
 }
 
-func (m *MsgCreateRewardaccrualResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func init() { proto.RegisterFile("tokenchain/loyalty/v1/tx.proto", fileDescriptor_241469d78c289384) }
+
+var fileDescriptor_241469d78c289384 = []byte{
+	// 4105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x24, 0x49,
+	0x56, 0x9e, 0xb4, 0xcb, 0xee, 0xaa, 0x57, 0x2e, 0xdb, 0x5d, 0x33, 0xdd, 0x5d, 0x93, 0xdd, 0xfe,
+	0xe9, 0xec, 0xe9, 0x69, 0xf7, 0x8f, 0xdb, 0xfd, 0xbf, 0x33, 0x2d, 0x56, 0x4b, 0xd9, 0xbd, 0xbd,
+	0xe3, 0x65, 0xcd, 0x34, 0x69, 0xf7, 0xa2, 0x45, 0x0b, 0x49, 0x38, 0x33, 0x5c, 0x4e, 0x75, 0xfe,
+	0xd4, 0x66, 0x46, 0xf9, 0x67, 0x57, 0x88, 0x5d, 0x58, 0x84, 0xb4, 0x07, 0x84, 0x84, 0x38, 0x21,
+	0x21, 0x0e, 0xab, 0x15, 0x07, 0x40, 0x7d, 0x40, 0x7b, 0x42, 0x42, 0x68, 0x41, 0x5a, 0x10, 0x87,
+	0x15, 0xa7, 0xbd, 0xb0, 0x82, 0x99, 0xc3, 0x08, 0x09, 0x8e, 0x1c, 0xb8, 0x20, 0x14, 0x3f, 0x19,
+	0x95, 0xff, 0x55, 0xe5, 0x29, 0x77, 0x23, 0x98, 0x8b, 0x55, 0xf1, 0xe2, 0x8b, 0x88, 0xf7, 0x5e,
+	0xbc, 0x88, 0x78, 0xf1, 0xe2, 0xa5, 0x61, 0x91, 0xf8, 0x2f, 0xb0, 0x67, 0xee, 0x23, 0xdb, 0x5b,
+	0x73, 0xfc, 0x63, 0xe4, 0x90, 0xe3, 0xb5, 0x83, 0xbb, 0x6b, 0xe4, 0xe8, 0x76, 0x37, 0xf0, 0x89,
+	0xdf, 0x3c, 0xd7, 0xaf, 0xbf, 0x2d, 0xea, 0x6f, 0x1f, 0xdc, 0x55, 0xcf, 0x22, 0xd7, 0xf6, 0xfc,
+	0x35, 0xf6, 0x97, 0x23, 0xd5, 0x0b, 0xa6, 0x1f, 0xba, 0x7e, 0xb8, 0xe6, 0x86, 0x1d, 0xda, 0x83,
+	0x1b, 0x76, 0x44, 0xc5, 0xdb, 0xbc, 0xc2, 0x60, 0xa5, 0x35, 0x5e, 0x10, 0x55, 0x6f, 0x75, 0xfc,
+	0x8e, 0xcf, 0xe9, 0xf4, 0x97, 0xa0, 0x5e, 0xcb, 0xe7, 0x09, 0xf5, 0xc8, 0xbe, 0x1f, 0xd8, 0xc4,
+	0xc6, 0x51, 0xf3, 0xd5, 0x7c, 0xa0, 0xe9, 0x20, 0xdb, 0x35, 0xc2, 0xae, 0xef, 0x85, 0x7e, 0x10,
+	0xee, 0xdb, 0x5d, 0x01, 0xbf, 0x99, 0x0f, 0xdf, 0xc3, 0x38, 0x07, 0xfc, 0x6e, 0x3e, 0xd8, 0xde,
+	0x35, 0x8d, 0xae, 0xef, 0xd8, 0xe6, 0xb1, 0xc0, 0xad, 0xe4, 0xe3, 0x5c, 0x1c, 0x98, 0xfb, 0xc8,
+	0x23, 0x86, 0x6d, 0xa2, 0xf2, 0xe1, 0x5d, 0xdb, 0x23, 0x46, 0x80, 0x08, 0x36, 0x1c, 0xdb, 0xb5,
+	0x89, 0x00, 0x6b, 0xf9, 0xe0, 0x2e, 0x0a, 0x90, 0x1b, 0x89, 0x7f, 0x3d, 0x1f, 0x73, 0x80, 0x03,
+	0x7b, 0xcf, 0xc6, 0x16, 0xab, 0xe5, 0x50, 0xed, 0x6f, 0x15, 0x98, 0xdb, 0x0a, 0x3b, 0xcf, 0xbb,
+	0x16, 0x22, 0xf8, 0x19, 0xeb, 0xa4, 0xf9, 0x08, 0x6a, 0x91, 0x4a, 0x8f, 0x5b, 0xca, 0xb2, 0xb2,
+	0x52, 0x5b, 0x6f, 0xfd, 0xd3, 0x5f, 0xae, 0xbe, 0x25, 0x66, 0xa8, 0x6d, 0x59, 0x01, 0x0e, 0xc3,
+	0x6d, 0x12, 0xd8, 0x5e, 0x47, 0xef, 0x43, 0x9b, 0x3f, 0x0f, 0xd3, 0x9c, 0x8d, 0xd6, 0xc4, 0xb2,
+	0xb2, 0x52, 0xbf, 0xb7, 0x70, 0x3b, 0xd7, 0x46, 0x6e, 0xf3, 0x61, 0xd6, 0x6b, 0x3f, 0xfe, 0xd9,
+	0xd2, 0x1b, 0x7f, 0xfa, 0xc9, 0xcb, 0x1b, 0x8a, 0x2e, 0xda, 0x3d, 0xfe, 0xdc, 0x6f, 0x7d, 0xf2,
+	0xf2, 0x46, 0xbf, 0xc7, 0xef, 0x7d, 0xf2, 0xf2, 0xc6, 0x3b, 0x31, 0x59, 0x8e, 0xa4, 0x34, 0x29,
+	0x96, 0xb5, 0xb7, 0xe1, 0x42, 0x8a, 0xa4, 0x63, 0x36, 0x75, 0x58, 0xfb, 0x67, 0x05, 0xde, 0x92,
+	0x75, 0xed, 0xbe, 0xa9, 0x9c, 0x58, 0xcc, 0x0f, 0xa1, 0x1e, 0xb3, 0x38, 0x21, 0xab, 0x56, 0x20,
+	0x6b, 0x6c, 0xc0, 0xb8, 0xc0, 0xf1, 0x1e, 0x1e, 0xff, 0x5c, 0x56, 0xea, 0xeb, 0xe5, 0x52, 0xc7,
+	0x7a, 0xd5, 0x16, 0xe1, 0x52, 0x1e, 0x5d, 0xca, 0xff, 0x5f, 0x0a, 0xbc, 0xbd, 0x15, 0x76, 0x36,
+	0x02, 0x8c, 0x08, 0x66, 0x7f, 0xfd, 0x00, 0x39, 0x8e, 0x7f, 0xe8, 0xd8, 0x21, 0x69, 0xde, 0x83,
+	0x33, 0x26, 0xa7, 0x0d, 0x54, 0x41, 0x04, 0x6c, 0xb6, 0xe0, 0x0c, 0xe2, 0x35, 0x4c, 0xf8, 0x9a,
+	0x1e, 0x15, 0x69, 0x0d, 0xf6, 0xd0, 0xae, 0x83, 0xad, 0xd6, 0xe4, 0xb2, 0xb2, 0x52, 0xd5, 0xa3,
+	0x62, 0x73, 0x01, 0x00, 0x1f, 0x75, 0xed, 0x00, 0x87, 0x06, 0x22, 0xad, 0xca, 0xb2, 0xb2, 0x52,
+	0xd1, 0x6b, 0x82, 0xd2, 0x26, 0xb4, 0xda, 0x45, 0x47, 0x06, 0x93, 0x3a, 0x6c, 0x4d, 0xf1, 0x6a,
+	0x17, 0x1d, 0xed, 0x30, 0x42, 0x73, 0x05, 0xe6, 0x79, 0x35, 0x41, 0x8e, 0x11, 0xf6, 0xba, 0x5d,
+	0xe7, 0xb8, 0x35, 0xcd, 0x40, 0xb3, 0x0c, 0x44, 0x90, 0xb3, 0xcd, 0xa8, 0x8f, 0x67, 0xa8, 0x2e,
+	0x23, 0x4e, 0xb5, 0x2b, 0x70, 0xb9, 0x50, 0xf4, 0xb4, 0x82, 0xb8, 0x06, 0xff, 0x5f, 0x2a, 0x28,
+	0x5f, 0x74, 0xa9, 0xa0, 0x43, 0xa6, 0x9f, 0x27, 0xd8, 0xc1, 0xa7, 0xad, 0x9f, 0x5c, 0xee, 0xf2,
+	0x07, 0x96, 0xdc, 0xfd, 0x6b, 0x05, 0xce, 0xcb, 0x49, 0xfe, 0x6a, 0x7c, 0x8b, 0x3b, 0x11, 0x6f,
+	0x6f, 0xc1, 0x94, 0x85, 0x3d, 0xdf, 0x15, 0x9c, 0xf1, 0x42, 0xf3, 0x3c, 0x4c, 0xdb, 0x61, 0xd8,
+	0xc3, 0x01, 0x9b, 0xb6, 0x9a, 0x2e, 0x4a, 0xcd, 0x26, 0x54, 0x3c, 0xe4, 0x62, 0x36, 0x5f, 0x35,
+	0x9d, 0xfd, 0xa6, 0xd8, 0xf0, 0xd8, 0xdd, 0xf5, 0x1d, 0x36, 0x4d, 0x35, 0x5d, 0x94, 0x9a, 0xcb,
+	0x50, 0xb7, 0x70, 0x68, 0x06, 0x76, 0x97, 0xd8, 0xbe, 0xc7, 0xa6, 0xa7, 0xa6, 0xc7, 0x49, 0x54,
+	0x2f, 0x87, 0x78, 0x37, 0xb4, 0x09, 0x6e, 0x9d, 0xe1, 0x7a, 0x11, 0xc5, 0x68, 0xfa, 0xc5, 0xcc,
+	0x56, 0xe5, 0xf4, 0xf3, 0x49, 0x6d, 0x5e, 0x81, 0x06, 0x3d, 0x2d, 0xb0, 0x15, 0x21, 0x6a, 0x0c,
+	0x31, 0xc3, 0x89, 0x02, 0xf4, 0x0e, 0xcc, 0x86, 0xd8, 0xfe, 0x66, 0x2f, 0xc0, 0x86, 0xdf, 0x25,
+	0x86, 0xed, 0xb5, 0xea, 0xcc, 0x04, 0x67, 0x04, 0xf5, 0xc3, 0x2e, 0xd9, 0xa4, 0x3a, 0x3b, 0x17,
+	0x60, 0xd3, 0x3f, 0xc0, 0xc1, 0xb1, 0xd1, 0x09, 0xfc, 0x5e, 0x57, 0x9c, 0x6a, 0xad, 0x19, 0xc6,
+	0xd1, 0x9b, 0x51, 0xe5, 0x97, 0x68, 0xdd, 0x33, 0x56, 0xd5, 0x7c, 0x04, 0x17, 0x64, 0x1b, 0x62,
+	0xbb, 0xd8, 0xf1, 0xcd, 0x17, 0xc6, 0xbe, 0xdf, 0x0b, 0xc2, 0x56, 0x83, 0x31, 0x22, 0xbb, 0xdc,
+	0x11, 0xb5, 0x1f, 0xd0, 0xca, 0xe6, 0x12, 0xd4, 0xfb, 0xc7, 0xa1, 0xd5, 0x9a, 0x65, 0x58, 0x88,
+	0x48, 0x9b, 0x56, 0x53, 0x85, 0xaa, 0x85, 0x4d, 0xdb, 0x45, 0x4e, 0xd8, 0x9a, 0x5b, 0x56, 0x56,
+	0x1a, 0xba, 0x2c, 0x37, 0xbf, 0x42, 0xd5, 0xe9, 0xf9, 0xae, 0xd1, 0xf3, 0x6c, 0x12, 0xb6, 0xe6,
+	0x97, 0x27, 0x57, 0xea, 0xf7, 0xae, 0x16, 0x6c, 0xc3, 0x6c, 0x99, 0x3c, 0xa1, 0xf0, 0xe7, 0x9e,
+	0x4d, 0xd6, 0x2b, 0x74, 0x27, 0xd6, 0xc1, 0x8a, 0x08, 0x29, 0xc3, 0xfb, 0x72, 0xa5, 0x0a, 0xf3,
+	0x75, 0xbd, 0x1a, 0x1d, 0x98, 0xda, 0x23, 0x58, 0xcc, 0x37, 0xb1, 0xc8, 0x0a, 0xfb, 0x66, 0xa3,
+	0xc4, 0xcc, 0x26, 0xb2, 0x4d, 0xbe, 0xbe, 0x3e, 0xb3, 0xcd, 0xcf, 0x6c, 0xf3, 0x14, 0x6c, 0xf3,
+	0x5b, 0xb0, 0x98, 0x6f, 0x62, 0xd2, 0x36, 0x1f, 0xc1, 0x05, 0x17, 0x13, 0x64, 0x21, 0x82, 0x0c,
+	0xca, 0x7d, 0x07, 0x1b, 0x5d, 0xec, 0x59, 0xb6, 0xd7, 0x61, 0xa6, 0x57, 0xd5, 0xcf, 0x45, 0xd5,
+	0x1b, 0xac, 0xf6, 0x19, 0xaf, 0x6c, 0x5e, 0x86, 0x19, 0xbc, 0xb7, 0x87, 0x4d, 0x62, 0x1f, 0x60,
+	0x7a, 0x28, 0x4d, 0x30, 0x1d, 0xd4, 0x25, 0xad, 0x4d, 0x34, 0x1f, 0xce, 0x6d, 0x85, 0x1d, 0x1d,
+	0x7b, 0x7e, 0xcf, 0x33, 0x31, 0x93, 0xa4, 0x6d, 0xb9, 0xf6, 0x18, 0xcd, 0x3b, 0x75, 0x24, 0xfc,
+	0x1a, 0x2c, 0xe4, 0x0e, 0x58, 0xbe, 0x10, 0x9b, 0xd7, 0x60, 0x0e, 0x51, 0x98, 0x11, 0x88, 0x96,
+	0x16, 0x1b, 0xa4, 0xaa, 0xcf, 0x22, 0xde, 0x5a, 0x50, 0xb5, 0xef, 0x4e, 0x30, 0x75, 0x6e, 0x63,
+	0xb2, 0x15, 0x4d, 0xb5, 0x67, 0x62, 0x8f, 0x8a, 0xab, 0xfb, 0x3d, 0x42, 0xd5, 0x32, 0xbe, 0x95,
+	0xbb, 0x01, 0x8b, 0x7d, 0x1b, 0x8b, 0x86, 0x31, 0x42, 0x82, 0x5e, 0xe0, 0x20, 0x34, 0x76, 0xbb,
+	0x21, 0x5b, 0xd1, 0x15, 0xfd, 0xa2, 0x9b, 0xe6, 0x65, 0x9b, 0x63, 0xd6, 0xbb, 0x61, 0xf3, 0x8b,
+	0xb0, 0x94, 0xd3, 0x09, 0x09, 0x30, 0x0a, 0x7b, 0xc1, 0x31, 0xeb, 0x85, 0x7b, 0x13, 0x97, 0x32,
+	0xbd, 0xec, 0x08, 0xd0, 0x7a, 0x37, 0x7d, 0xf2, 0xfe, 0x83, 0x02, 0xef, 0x96, 0xab, 0x61, 0x80,
+	0xc2, 0x07, 0x8b, 0x36, 0x31, 0x16, 0xd1, 0x26, 0x07, 0x8b, 0xa6, 0x75, 0xe1, 0xbc, 0x74, 0x23,
+	0x4e, 0x69, 0x13, 0x4e, 0xa9, 0x6f, 0x19, 0x16, 0xf3, 0x47, 0x94, 0x5e, 0xcb, 0xcf, 0x94, 0x98,
+	0xd7, 0xa2, 0xe3, 0x43, 0x14, 0x58, 0xc8, 0x34, 0x83, 0x1e, 0x72, 0x4e, 0xc4, 0xd4, 0x3c, 0x4c,
+	0xbe, 0xc0, 0xc7, 0x82, 0x25, 0xfa, 0x33, 0xee, 0x63, 0x4d, 0x26, 0x7d, 0x50, 0x29, 0x40, 0x25,
+	0x75, 0x8a, 0x20, 0xd7, 0xef, 0x79, 0x44, 0x38, 0x97, 0xa2, 0x44, 0x3d, 0x4b, 0x07, 0x85, 0xc4,
+	0x08, 0x7c, 0xc7, 0xe9, 0x75, 0x0d, 0xba, 0xcb, 0x88, 0xe3, 0x61, 0x96, 0xd2, 0x75, 0x46, 0x7e,
+	0x82, 0x08, 0xce, 0x55, 0x41, 0x8e, 0x7c, 0x69, 0x15, 0xf0, 0x9d, 0xeb, 0xff, 0xae, 0x0a, 0x72,
+	0xe4, 0x93, 0x2a, 0x70, 0x62, 0x96, 0x79, 0x0a, 0x1a, 0x28, 0xb1, 0xca, 0x7c, 0x7e, 0xbe, 0xcf,
+	0xef, 0xca, 0x5b, 0xb6, 0x47, 0x22, 0xb3, 0xdd, 0x19, 0xb3, 0xb7, 0x72, 0x09, 0x6a, 0x01, 0x36,
+	0xed, 0xae, 0x8d, 0x3d, 0x22, 0xa6, 0xa5, 0x4f, 0x88, 0x4d, 0x41, 0x25, 0x3e, 0x05, 0x29, 0x41,
+	0xbe, 0x06, 0x97, 0xf2, 0xb8, 0x1c, 0xb0, 0x25, 0x65, 0x1c, 0x91, 0x89, 0xac, 0x23, 0xa2, 0x19,
+	0xd0, 0xa0, 0xfd, 0xea, 0x92, 0xa3, 0x47, 0x71, 0x7e, 0x07, 0x46, 0x09, 0xf2, 0x24, 0x99, 0x88,
+	0x4b, 0xa2, 0xfd, 0x35, 0xbf, 0x6d, 0x66, 0x98, 0x5f, 0x47, 0xc4, 0xdc, 0x1f, 0xa3, 0x9e, 0xbf,
+	0x0c, 0x20, 0x99, 0xa1, 0xf6, 0x4f, 0x3d, 0x90, 0x77, 0x0a, 0x3c, 0x90, 0x84, 0xc4, 0x91, 0x03,
+	0xd2, 0x6f, 0x9d, 0xd2, 0xfe, 0xdf, 0x29, 0xec, 0x5a, 0x96, 0x2f, 0xc1, 0x18, 0xe6, 0x80, 0xfa,
+	0x1d, 0xfc, 0x22, 0x2b, 0x14, 0xc8, 0xf7, 0xf8, 0x3a, 0xa3, 0xb5, 0xf9, 0x92, 0x5c, 0x87, 0x69,
+	0xde, 0xa4, 0x55, 0x19, 0x59, 0x32, 0xd1, 0x52, 0xdb, 0x87, 0x59, 0xba, 0x43, 0xd1, 0x98, 0x20,
+	0x5f, 0x0d, 0xa7, 0x76, 0x1c, 0xf8, 0x70, 0x3e, 0x39, 0x92, 0xd4, 0x52, 0x6c, 0x8b, 0x52, 0x0a,
+	0xb6, 0xa8, 0xc4, 0xac, 0x5e, 0x85, 0x59, 0xae, 0x14, 0x83, 0xc5, 0x32, 0x45, 0x18, 0xa1, 0xa2,
+	0x37, 0x38, 0x75, 0x83, 0x13, 0xb5, 0xdf, 0x56, 0xe0, 0xec, 0x56, 0xd8, 0x79, 0xda, 0xf3, 0x2c,
+	0x3e, 0xe0, 0x33, 0xdf, 0x77, 0xc6, 0x7b, 0xe5, 0x48, 0xcc, 0x4d, 0xfe, 0x32, 0xfd, 0x63, 0x6e,
+	0xea, 0x49, 0x2e, 0xa4, 0xe8, 0x57, 0x61, 0xd6, 0xf5, 0xad, 0x9e, 0x83, 0x8d, 0xa4, 0x06, 0x1a,
+	0x9c, 0xda, 0x2e, 0xd5, 0xc3, 0x15, 0x10, 0x12, 0x1b, 0x7b, 0x3d, 0xcf, 0x92, 0x6a, 0x98, 0xe1,
+	0xc4, 0xa7, 0x8c, 0x46, 0x5d, 0x78, 0x0f, 0x1f, 0x1a, 0xbb, 0xc8, 0x41, 0x9e, 0x19, 0xdd, 0x83,
+	0xc0, 0xc3, 0x87, 0xeb, 0x9c, 0xa2, 0xfd, 0x90, 0x9f, 0x40, 0x3a, 0x36, 0xfd, 0x40, 0xb0, 0xd8,
+	0xfe, 0x14, 0xfb, 0x6f, 0x71, 0xd8, 0x47, 0x0a, 0x31, 0x99, 0xaf, 0xc5, 0xc4, 0x66, 0x47, 0x2f,
+	0x6e, 0xec, 0x8c, 0xe1, 0x57, 0x34, 0xf6, 0x3b, 0xa5, 0xd9, 0xbf, 0x57, 0x60, 0x31, 0x9f, 0x71,
+	0xa9, 0x5e, 0x71, 0x18, 0x28, 0xb9, 0xc7, 0xe1, 0x50, 0xec, 0x5d, 0x06, 0xa1, 0x4e, 0x3a, 0x41,
+	0xd8, 0x12, 0x4c, 0xd6, 0x39, 0xad, 0x4d, 0x49, 0x99, 0x95, 0x3a, 0x95, 0x5d, 0xa9, 0x4b, 0x50,
+	0xcf, 0x9e, 0x9b, 0x10, 0xc8, 0x33, 0x53, 0xfb, 0xa9, 0x02, 0x17, 0xa5, 0x2c, 0x91, 0xb7, 0xd9,
+	0x76, 0x1c, 0xdf, 0x44, 0xec, 0xe2, 0x79, 0x92, 0x99, 0x88, 0x34, 0x38, 0xd1, 0xd7, 0x60, 0x81,
+	0x90, 0x74, 0x41, 0xd1, 0xcb, 0x8c, 0x4d, 0x8e, 0x8d, 0xd0, 0xf4, 0x03, 0x2c, 0xc4, 0x6c, 0x44,
+	0xd4, 0x6d, 0x4a, 0x6c, 0xbe, 0x0b, 0x73, 0xbb, 0x3d, 0xf3, 0x05, 0x26, 0x86, 0x99, 0x94, 0xb5,
+	0xc1, 0xc9, 0x1b, 0xed, 0xbc, 0x05, 0xf0, 0x83, 0x49, 0xb8, 0x52, 0x22, 0x5a, 0xc9, 0x5c, 0xbd,
+	0x2e, 0x01, 0x68, 0x77, 0x91, 0x93, 0x2e, 0x60, 0x3c, 0x8c, 0xd8, 0x10, 0x54, 0x01, 0xbb, 0x06,
+	0x73, 0xd2, 0x0d, 0x17, 0xb8, 0x33, 0x3c, 0xdc, 0x18, 0x91, 0x05, 0x70, 0xf0, 0x3d, 0xa0, 0x3a,
+	0x96, 0x7b, 0x40, 0x6d, 0xf0, 0x3d, 0x80, 0x2e, 0x80, 0x1e, 0x73, 0xc6, 0xac, 0x16, 0xf0, 0xe0,
+	0xab, 0x28, 0x6a, 0xff, 0xa8, 0x40, 0x6b, 0x2b, 0xec, 0xfc, 0x52, 0x0f, 0xf7, 0xb0, 0x1e, 0x85,
+	0x03, 0x02, 0xe4, 0x85, 0x7b, 0x38, 0x18, 0xe3, 0xb6, 0x79, 0x19, 0x66, 0xf6, 0x02, 0xdf, 0x35,
+	0x92, 0x5e, 0x69, 0x9d, 0xd2, 0xa2, 0xed, 0x6e, 0x01, 0x80, 0xf8, 0x12, 0xc0, 0xb7, 0xac, 0x1a,
+	0xf1, 0xa3, 0xea, 0x02, 0x17, 0x35, 0x73, 0xde, 0x2c, 0x17, 0x49, 0x23, 0x6d, 0x6e, 0x16, 0x26,
+	0x6c, 0x8b, 0x09, 0x54, 0xd1, 0x27, 0x6c, 0x8b, 0xf6, 0x1c, 0x12, 0x44, 0x7a, 0xd1, 0xe6, 0x20,
+	0x4a, 0x74, 0xa7, 0xc5, 0x47, 0xd8, 0xec, 0x11, 0x6c, 0xa0, 0x3d, 0x22, 0x82, 0x4c, 0x15, 0x7d,
+	0x46, 0x10, 0xdb, 0x94, 0xa6, 0x79, 0xa0, 0x6e, 0x85, 0x9d, 0x2f, 0x72, 0xd2, 0x58, 0x14, 0xc8,
+	0xd9, 0x9b, 0x88, 0xd8, 0x4b, 0x09, 0xe8, 0x82, 0x56, 0x3c, 0xde, 0xc8, 0x22, 0x2e, 0x41, 0x5d,
+	0x48, 0x63, 0xd1, 0x30, 0x07, 0x17, 0x10, 0x22, 0x52, 0x9b, 0x68, 0xbf, 0x23, 0x9e, 0x50, 0xe8,
+	0xa1, 0xe1, 0x9c, 0x86, 0x78, 0x94, 0x35, 0x6a, 0xa8, 0xbe, 0x17, 0xc5, 0xf0, 0x78, 0x29, 0x25,
+	0xb6, 0x07, 0x97, 0x0b, 0xd9, 0x18, 0x59, 0xea, 0xcb, 0x30, 0x63, 0xb2, 0x9e, 0x9c, 0xb8, 0xd8,
+	0x75, 0x49, 0x6b, 0x13, 0xed, 0x3f, 0x15, 0x78, 0x93, 0xed, 0x5f, 0x1d, 0x3b, 0x24, 0x38, 0x88,
+	0x76, 0xb0, 0x13, 0x49, 0xbc, 0x00, 0xe0, 0xe0, 0x0e, 0x72, 0x0c, 0x16, 0x93, 0xe4, 0xac, 0xd4,
+	0x18, 0xe5, 0x17, 0x69, 0x60, 0xf2, 0x0b, 0x30, 0xdb, 0x45, 0xc7, 0x7e, 0x8f, 0x24, 0x17, 0x47,
+	0x49, 0xcf, 0x0d, 0x8e, 0x8f, 0x56, 0xc6, 0x12, 0xd4, 0x4d, 0xdf, 0x23, 0xc8, 0x24, 0x46, 0x2f,
+	0xb0, 0xa3, 0xc3, 0x5e, 0x90, 0x9e, 0x07, 0x76, 0xf3, 0x22, 0xd4, 0x1c, 0xbf, 0xe3, 0x1b, 0xfb,
+	0x28, 0xdc, 0x17, 0x47, 0x6b, 0x95, 0x12, 0x3e, 0x40, 0xe1, 0x7e, 0x4a, 0xcf, 0xab, 0x70, 0x31,
+	0x47, 0xec, 0x22, 0x0d, 0x6b, 0x2f, 0x27, 0xe0, 0xac, 0xbc, 0xe8, 0x7d, 0x2a, 0x25, 0xa5, 0xcd,
+	0x22, 0xa9, 0xb4, 0xc9, 0xc1, 0x4a, 0xab, 0x7c, 0x2a, 0xa5, 0x4d, 0x95, 0x2b, 0x6d, 0x3a, 0xa9,
+	0xb4, 0xe6, 0x4d, 0x38, 0xcb, 0xa3, 0x90, 0xfc, 0x38, 0x33, 0x88, 0x8d, 0x03, 0x11, 0x1c, 0x9e,
+	0x8f, 0x57, 0xec, 0xd8, 0x38, 0x48, 0x69, 0xf8, 0x22, 0xbc, 0x9d, 0xd1, 0x98, 0xbc, 0x85, 0xda,
+	0x2c, 0xa8, 0xf8, 0x04, 0xb3, 0x13, 0x6c, 0xcc, 0x2a, 0x4d, 0xf1, 0xf1, 0x55, 0x58, 0xc8, 0x1d,
+	0xaa, 0x70, 0x35, 0x5d, 0x85, 0x59, 0x4b, 0xa2, 0xad, 0x7e, 0x54, 0xb4, 0x11, 0xa3, 0xb6, 0x89,
+	0xf6, 0x92, 0x5f, 0xa4, 0xb7, 0x7b, 0xbb, 0xae, 0x4d, 0xda, 0x84, 0xe0, 0x90, 0x9c, 0xdc, 0x9b,
+	0x29, 0x3c, 0x4c, 0xf0, 0x81, 0x6d, 0x61, 0xcf, 0xc4, 0x6c, 0xf2, 0xc4, 0x61, 0x12, 0xd1, 0xe8,
+	0xec, 0x95, 0xbf, 0x29, 0xa6, 0x54, 0xf1, 0x1b, 0x70, 0x29, 0x8f, 0xe3, 0x01, 0x17, 0x3a, 0x15,
+	0x64, 0x24, 0x5a, 0x44, 0x54, 0x65, 0x99, 0xda, 0x07, 0xea, 0x77, 0x64, 0x98, 0xf2, 0xc2, 0xd0,
+	0xd0, 0xe7, 0x63, 0x15, 0x1b, 0xec, 0x5e, 0xec, 0x31, 0x85, 0xe9, 0xf8, 0xc0, 0x7f, 0x81, 0x4f,
+	0x45, 0x61, 0xb9, 0xe2, 0x66, 0xc6, 0x7b, 0x55, 0xe2, 0xfe, 0xd9, 0x04, 0x9c, 0x93, 0xf1, 0x31,
+	0x7a, 0x4b, 0xdd, 0x36, 0xf7, 0x31, 0xbd, 0xf7, 0xbc, 0xb2, 0x50, 0xcb, 0x55, 0x98, 0x25, 0x01,
+	0xf2, 0xcc, 0x7d, 0x6c, 0x24, 0x6e, 0x21, 0x0d, 0x41, 0x15, 0x0e, 0xdc, 0x10, 0x2e, 0x7e, 0x0b,
+	0xce, 0x98, 0x88, 0x19, 0x9d, 0xd8, 0x22, 0xa2, 0x22, 0x55, 0x97, 0xed, 0x11, 0x1c, 0x1c, 0x20,
+	0x87, 0x6d, 0x0c, 0x0d, 0x5d, 0x96, 0xa9, 0x71, 0x86, 0x04, 0x05, 0x84, 0xdf, 0x0b, 0xaa, 0x9c,
+	0x3d, 0x46, 0xc9, 0x09, 0xa5, 0xad, 0xc1, 0x42, 0xae, 0xb6, 0x0a, 0xf7, 0xe4, 0xdf, 0xe4, 0xea,
+	0x65, 0x87, 0xd9, 0x29, 0xa9, 0x97, 0x0f, 0x39, 0x59, 0xb0, 0xb3, 0x84, 0xb0, 0x90, 0xcb, 0x80,
+	0xe4, 0xf8, 0x1a, 0xcc, 0x05, 0xd8, 0xc1, 0x28, 0xa4, 0xdb, 0x08, 0xd7, 0x2d, 0x67, 0x7f, 0x36,
+	0x22, 0x0b, 0xf5, 0x5e, 0x87, 0xf9, 0xd8, 0x41, 0x1d, 0x8f, 0x29, 0xcd, 0xf5, 0x0f, 0x6b, 0x46,
+	0xd6, 0xfe, 0x8d, 0x1f, 0xd8, 0x34, 0x6c, 0x6f, 0x7b, 0x44, 0x47, 0x04, 0x7f, 0x85, 0xa6, 0x0e,
+	0x8d, 0xd5, 0xa6, 0xd8, 0x63, 0x5f, 0x17, 0x07, 0x06, 0x39, 0x12, 0xc2, 0x57, 0x5d, 0x74, 0xf4,
+	0x0c, 0x07, 0x3b, 0x47, 0xcd, 0x55, 0x78, 0x33, 0xaa, 0xa5, 0x37, 0x3c, 0xdb, 0xeb, 0x18, 0x16,
+	0x3a, 0x16, 0x86, 0x35, 0xcf, 0x61, 0x3a, 0xaf, 0x78, 0x82, 0x8e, 0xd3, 0xf0, 0xe8, 0x8e, 0x38,
+	0x95, 0x86, 0xe7, 0x46, 0x57, 0xff, 0x82, 0xdf, 0x1b, 0xd3, 0xb2, 0x4a, 0xfd, 0xea, 0x30, 0x97,
+	0xca, 0xa0, 0x62, 0xb2, 0x0f, 0x88, 0x15, 0x45, 0xdd, 0x88, 0x58, 0x51, 0xc3, 0x8d, 0x13, 0xa9,
+	0xa5, 0x47, 0x2f, 0x67, 0x7c, 0xf5, 0x47, 0xc5, 0xcc, 0x5b, 0xd9, 0x64, 0xf6, 0xad, 0xec, 0x87,
+	0x0a, 0x34, 0xb6, 0xc2, 0xce, 0x33, 0xd4, 0x0b, 0xf1, 0xce, 0xf8, 0xdf, 0x80, 0x43, 0xd3, 0xef,
+	0x62, 0x1e, 0xe9, 0xab, 0xe9, 0xa2, 0x14, 0xf3, 0x2b, 0x2b, 0x71, 0xbf, 0x32, 0x75, 0x32, 0x4c,
+	0x95, 0x9f, 0x0c, 0x17, 0xe0, 0x5c, 0x82, 0x6f, 0x79, 0x50, 0x7f, 0x47, 0x24, 0x8f, 0x79, 0xdd,
+	0x57, 0x28, 0x53, 0x8a, 0xb9, 0x27, 0x70, 0x21, 0xc5, 0x82, 0xb4, 0x80, 0xeb, 0x30, 0x1f, 0x60,
+	0x17, 0xd9, 0x1e, 0xb5, 0x43, 0xd1, 0x95, 0xc2, 0xba, 0x9a, 0x93, 0xf4, 0x6d, 0x46, 0xd6, 0xfe,
+	0x68, 0x02, 0xe6, 0x69, 0xa8, 0x2a, 0xc0, 0xf8, 0x9b, 0x32, 0xf4, 0x34, 0x3e, 0x51, 0xee, 0xa5,
+	0x5e, 0x22, 0xca, 0x7a, 0x42, 0x7d, 0xdf, 0x4c, 0x3e, 0x5c, 0xdb, 0x51, 0xf4, 0x05, 0x22, 0xd2,
+	0x66, 0xfc, 0xce, 0x30, 0x55, 0x32, 0xb7, 0xd3, 0xe9, 0x4c, 0xa2, 0x2b, 0xd0, 0x70, 0x6c, 0xef,
+	0x85, 0x11, 0xf5, 0xc4, 0x36, 0xe6, 0xaa, 0x3e, 0x43, 0x89, 0xd1, 0xa5, 0x22, 0xa5, 0xe3, 0xf7,
+	0xa1, 0x95, 0x56, 0x8e, 0x54, 0x72, 0x72, 0x34, 0x25, 0x35, 0x9a, 0xf6, 0x27, 0x0a, 0x34, 0xd9,
+	0xfc, 0xec, 0xfd, 0xef, 0x51, 0x6d, 0x4a, 0xba, 0x4b, 0xa0, 0x66, 0x39, 0x94, 0x36, 0xfe, 0xe7,
+	0xc2, 0x93, 0xc3, 0x84, 0x19, 0xd7, 0xe6, 0xfa, 0x86, 0x48, 0x2c, 0x18, 0x9f, 0x08, 0x4d, 0xa8,
+	0xb8, 0xbe, 0x15, 0xf9, 0xf7, 0xec, 0x37, 0xb5, 0x5d, 0x96, 0xea, 0x84, 0x2d, 0xf6, 0x64, 0xef,
+	0x61, 0x27, 0x64, 0xa1, 0xee, 0x9a, 0x3e, 0x27, 0xe8, 0x1b, 0x82, 0x9c, 0x92, 0xc6, 0x84, 0x4b,
+	0x79, 0xec, 0xca, 0xf9, 0xda, 0x80, 0x69, 0x91, 0x34, 0xc1, 0x77, 0xc3, 0xd2, 0xac, 0x04, 0xd9,
+	0x3c, 0x0a, 0x9d, 0xf3, 0xa6, 0xda, 0x0f, 0x78, 0x7c, 0x79, 0x1b, 0x93, 0xcd, 0xf5, 0x0d, 0x1e,
+	0xde, 0x3a, 0xe1, 0x45, 0x78, 0x01, 0x40, 0xc8, 0x67, 0x08, 0x37, 0xbd, 0xa6, 0xd7, 0x04, 0x85,
+	0xdb, 0x78, 0x88, 0x3d, 0xab, 0x9f, 0xdb, 0xc2, 0x4b, 0xf1, 0x3c, 0xba, 0x4a, 0x22, 0x8f, 0x2e,
+	0xf7, 0x9e, 0x91, 0xe4, 0x53, 0x4e, 0xed, 0x1f, 0x46, 0xe1, 0xdf, 0xe4, 0x3d, 0x6f, 0x73, 0xa3,
+	0x3d, 0xc6, 0xc9, 0xbd, 0x02, 0x0d, 0xd3, 0xf7, 0x3c, 0x7a, 0x0a, 0xf8, 0x9e, 0x21, 0x1c, 0x86,
+	0x9a, 0x3e, 0xd3, 0x27, 0x6e, 0xa6, 0x99, 0xfe, 0x3a, 0x2c, 0xe6, 0xb3, 0x25, 0x27, 0xf1, 0x31,
+	0x4c, 0xda, 0x26, 0x6a, 0x29, 0xa5, 0xa9, 0xa7, 0xb1, 0x86, 0x62, 0xfa, 0x68, 0x23, 0xed, 0x3f,
+	0x22, 0x83, 0xf6, 0xac, 0x18, 0x62, 0xe7, 0xe8, 0x15, 0xcb, 0x4c, 0xaf, 0x96, 0xbc, 0x6f, 0xea,
+	0x48, 0xd0, 0x49, 0x9c, 0xd1, 0xab, 0x9c, 0xb0, 0x73, 0xc4, 0x96, 0x04, 0x76, 0xfd, 0x28, 0x04,
+	0x4e, 0x7f, 0xb3, 0x98, 0xa3, 0xed, 0x62, 0x7a, 0xdd, 0x0d, 0xb1, 0xe9, 0x7b, 0x56, 0x18, 0xa5,
+	0x38, 0x0a, 0xf2, 0x36, 0xa7, 0xa6, 0xb4, 0xf9, 0x18, 0x2e, 0xe5, 0x89, 0x2b, 0x75, 0xa9, 0x42,
+	0x35, 0xc4, 0xdf, 0xe8, 0x31, 0xf7, 0x95, 0x6f, 0x5f, 0xb2, 0xac, 0xfd, 0xb7, 0xc2, 0xf6, 0x86,
+	0xa7, 0x7e, 0x40, 0x43, 0xec, 0x51, 0xfb, 0x28, 0xa6, 0x78, 0x22, 0x8d, 0xd1, 0x78, 0xad, 0x8c,
+	0x00, 0x1b, 0xfd, 0xf7, 0xda, 0x46, 0x9f, 0xfa, 0x0b, 0xf8, 0x78, 0x38, 0x15, 0x5e, 0x87, 0x79,
+	0x22, 0xc2, 0x3f, 0xd1, 0x2e, 0x21, 0xce, 0xf9, 0xb9, 0x88, 0x2e, 0x76, 0x89, 0x3c, 0xe5, 0x4d,
+	0x0d, 0xa1, 0x3c, 0x07, 0xb4, 0x62, 0xf9, 0xa5, 0x0a, 0x9f, 0xc2, 0x99, 0x3d, 0x0e, 0x11, 0x26,
+	0xf9, 0x6e, 0xd1, 0xa6, 0x22, 0x5a, 0x8a, 0x0e, 0x85, 0x59, 0x46, 0x8d, 0xb5, 0xef, 0x4f, 0x40,
+	0xb3, 0xbf, 0x5c, 0xa5, 0xd7, 0x75, 0xd2, 0x44, 0xed, 0x7c, 0xe3, 0x4c, 0xee, 0x38, 0x93, 0xe9,
+	0x1d, 0x87, 0xe6, 0x7d, 0xa1, 0x23, 0xc3, 0xef, 0x91, 0x3d, 0xc7, 0x3f, 0x8c, 0x8e, 0x5d, 0x17,
+	0x1d, 0x7d, 0xc8, 0x29, 0x51, 0xba, 0x9b, 0xed, 0xb1, 0xfa, 0x7e, 0x26, 0xee, 0x26, 0x23, 0xd0,
+	0xf9, 0x3d, 0xb4, 0x3d, 0xcb, 0x3f, 0x4c, 0x19, 0x69, 0x83, 0x53, 0x23, 0x35, 0x3f, 0xce, 0xe6,
+	0x7c, 0x5f, 0x2b, 0xca, 0xf9, 0x4e, 0xe9, 0x43, 0xdb, 0x03, 0x35, 0x4b, 0x95, 0x93, 0xf1, 0x01,
+	0x40, 0xc6, 0xe5, 0xbd, 0x52, 0x30, 0x1f, 0xf1, 0x0e, 0xc4, 0x64, 0xd4, 0x02, 0x39, 0xce, 0x8f,
+	0x14, 0x91, 0xdd, 0xe5, 0xfa, 0x07, 0xf8, 0xb5, 0xcd, 0xc8, 0xe3, 0xcf, 0x67, 0x55, 0x75, 0xa3,
+	0x48, 0x55, 0x59, 0x5e, 0xb5, 0x25, 0x58, 0xc8, 0xad, 0x90, 0xc7, 0xc0, 0xdf, 0x28, 0x22, 0xf4,
+	0x10, 0xbe, 0x46, 0xbb, 0x1b, 0xe9, 0x23, 0x80, 0x0c, 0xab, 0xe2, 0x23, 0x80, 0x0c, 0x5d, 0xca,
+	0xf8, 0x7b, 0x13, 0xec, 0xb1, 0x7b, 0x1b, 0x93, 0xa7, 0x78, 0xec, 0x8e, 0xfa, 0x12, 0xd4, 0x19,
+	0xa7, 0x22, 0xdb, 0x51, 0xc4, 0xcf, 0x19, 0x89, 0x25, 0x30, 0xd2, 0xcb, 0x51, 0x2f, 0x8e, 0x10,
+	0x2f, 0x89, 0xbd, 0x18, 0xe4, 0x3a, 0x9c, 0xa5, 0xab, 0x4a, 0xc0, 0xc4, 0xf5, 0x72, 0x4a, 0x66,
+	0xb0, 0x3f, 0x67, 0x74, 0x7e, 0xc9, 0xbc, 0x08, 0x35, 0x37, 0xec, 0x18, 0xe4, 0x98, 0xfa, 0xf3,
+	0xd3, 0xcc, 0x27, 0xaa, 0xba, 0x61, 0x67, 0x87, 0x96, 0xe3, 0x8e, 0xc1, 0x99, 0x32, 0xc7, 0xe0,
+	0xeb, 0x70, 0x3e, 0xa9, 0x0f, 0xb9, 0x7e, 0xd6, 0xa1, 0x46, 0x3f, 0xfc, 0x61, 0x03, 0x8a, 0xe5,
+	0xb3, 0x54, 0xb0, 0x7c, 0xa2, 0xb6, 0x62, 0xe9, 0x54, 0xf7, 0x44, 0x59, 0xfb, 0xb6, 0xc2, 0xd4,
+	0x4d, 0xdf, 0xa1, 0xbf, 0x84, 0xc2, 0xd7, 0xf2, 0xf8, 0xfe, 0x35, 0x38, 0x9f, 0xe4, 0x40, 0x0a,
+	0xf8, 0x05, 0xa8, 0x76, 0x50, 0x68, 0x74, 0x7d, 0xdf, 0x11, 0xf2, 0x2d, 0x16, 0xc8, 0x27, 0x5a,
+	0x46, 0xdb, 0x74, 0x87, 0x17, 0xb5, 0xbf, 0xe2, 0x3e, 0xfd, 0x2f, 0xdb, 0x64, 0xdf, 0x0a, 0xd0,
+	0xe1, 0x2b, 0x93, 0x30, 0x99, 0x8b, 0x53, 0x19, 0x3a, 0x17, 0x27, 0xa5, 0x99, 0x5f, 0x05, 0x35,
+	0xcb, 0xfd, 0xf8, 0xb4, 0xf3, 0xef, 0x4a, 0x64, 0x5a, 0x2c, 0x1b, 0x63, 0xbb, 0xff, 0x01, 0xd9,
+	0x78, 0x83, 0xbf, 0x16, 0xb2, 0x9d, 0x63, 0x63, 0xb7, 0x67, 0x75, 0xb0, 0x0c, 0x37, 0x30, 0xda,
+	0x3a, 0x23, 0x35, 0x97, 0x61, 0x86, 0x2e, 0xa3, 0x5e, 0x48, 0x9d, 0x03, 0xd4, 0x8d, 0x4e, 0xb2,
+	0x2e, 0x0e, 0x9e, 0x87, 0x38, 0xd8, 0x40, 0xdd, 0xe4, 0x42, 0x9a, 0x2a, 0x5e, 0x48, 0xd3, 0x65,
+	0x0b, 0xe9, 0x1b, 0xb0, 0x98, 0x2f, 0xad, 0xd4, 0xe8, 0x87, 0x50, 0x8f, 0x7d, 0x45, 0x27, 0x94,
+	0x7a, 0xad, 0x40, 0xa9, 0xe9, 0x5e, 0x84, 0x76, 0xe3, 0x3d, 0xdc, 0xfb, 0xd1, 0x4d, 0x98, 0xdc,
+	0x0a, 0x3b, 0xcd, 0x3d, 0x98, 0x49, 0x7c, 0xb7, 0x56, 0xe4, 0x75, 0xa4, 0xbe, 0x0c, 0x53, 0x6f,
+	0x0f, 0x87, 0x93, 0x02, 0xf4, 0xe0, 0x6c, 0xf6, 0xeb, 0xb1, 0x9b, 0x83, 0x3a, 0x89, 0x81, 0xd5,
+	0xfb, 0x23, 0x80, 0xe5, 0xb0, 0xdf, 0x55, 0xe0, 0x7c, 0xc1, 0x57, 0x5b, 0x77, 0x8a, 0xfb, 0xcb,
+	0x6f, 0xa1, 0xbe, 0x37, 0x6a, 0x8b, 0x04, 0x1b, 0x05, 0xdf, 0x46, 0xdd, 0x19, 0x24, 0xd6, 0x28,
+	0x6c, 0x94, 0x7f, 0x84, 0xc4, 0xd8, 0x28, 0xf8, 0x04, 0xa9, 0x84, 0x8d, 0xfc, 0x16, 0xea, 0x7b,
+	0xa3, 0xb6, 0x90, 0x6c, 0x7c, 0x0b, 0xde, 0xcc, 0xfb, 0xd2, 0x68, 0x75, 0x90, 0x7a, 0x13, 0x70,
+	0xf5, 0xe1, 0x48, 0xf0, 0xf8, 0xe0, 0x79, 0x9f, 0x92, 0xac, 0x0e, 0x52, 0xea, 0xd0, 0x83, 0x97,
+	0x7d, 0x45, 0x70, 0x04, 0xcd, 0x9c, 0x3c, 0xff, 0x5b, 0xc5, 0x9d, 0x65, 0xd1, 0xea, 0x83, 0x51,
+	0xd0, 0x72, 0xe4, 0x3f, 0x50, 0xe0, 0x62, 0x59, 0x42, 0x7e, 0x89, 0x40, 0x25, 0xcd, 0xd4, 0xcf,
+	0x9f, 0xa8, 0x59, 0x7c, 0x32, 0xf2, 0x52, 0xca, 0x57, 0x07, 0x99, 0xd6, 0xd0, 0x93, 0x51, 0x92,
+	0x3e, 0xde, 0x37, 0xc3, 0x64, 0xd6, 0xf0, 0x40, 0x33, 0x4c, 0xc0, 0xd5, 0x87, 0x23, 0xc1, 0xb3,
+	0x66, 0x38, 0xf4, 0xe0, 0x39, 0x70, 0xf5, 0xe1, 0x48, 0xf0, 0xac, 0xda, 0x87, 0x1e, 0x3c, 0x07,
+	0xae, 0x3e, 0x1c, 0x09, 0x1e, 0x3f, 0x09, 0xb2, 0xb9, 0xd1, 0x25, 0x27, 0x41, 0x06, 0xac, 0xde,
+	0x1f, 0x01, 0x9c, 0xd8, 0xfb, 0x0a, 0x12, 0x86, 0xef, 0x8c, 0xd0, 0x1f, 0x6b, 0xa1, 0xbe, 0x37,
+	0x6a, 0x0b, 0xc9, 0x86, 0x09, 0xf5, 0x78, 0xb6, 0xec, 0xd5, 0x12, 0xeb, 0xe9, 0xc3, 0xd4, 0xd5,
+	0xa1, 0x60, 0x72, 0x10, 0x07, 0x66, 0x53, 0x69, 0xab, 0x2b, 0xc5, 0x1d, 0x24, 0x91, 0xea, 0x9d,
+	0x61, 0x91, 0x71, 0x6b, 0xca, 0xcb, 0xfe, 0x5c, 0x2d, 0xdb, 0xa7, 0x32, 0x70, 0xf5, 0xe1, 0x48,
+	0x70, 0x39, 0xf8, 0xf7, 0x14, 0x68, 0x15, 0xa7, 0x3d, 0x0e, 0xea, 0x33, 0xdb, 0x46, 0x7d, 0x3c,
+	0x7a, 0x1b, 0xc9, 0xcc, 0x77, 0x14, 0x38, 0x97, 0x9f, 0xff, 0xb6, 0x56, 0xdc, 0x6b, 0x6e, 0x03,
+	0xf5, 0x73, 0x23, 0x36, 0x90, 0x3c, 0xfc, 0xae, 0x02, 0x17, 0x8a, 0x92, 0xc8, 0xee, 0x16, 0x77,
+	0x5a, 0xd0, 0x44, 0x7d, 0x7f, 0xe4, 0x26, 0x49, 0xdf, 0x2b, 0x3f, 0xdd, 0xab, 0xcc, 0xf7, 0xca,
+	0x6d, 0xa1, 0xbe, 0x37, 0x6a, 0x0b, 0xc9, 0x46, 0x00, 0xf3, 0x99, 0xe4, 0xab, 0x1b, 0x65, 0x93,
+	0x9c, 0xc4, 0xaa, 0xf7, 0x86, 0xc7, 0xc6, 0x17, 0x60, 0x2a, 0x93, 0x69, 0x65, 0xd0, 0x4e, 0x2d,
+	0xc7, 0xbb, 0x33, 0x2c, 0x32, 0xee, 0x55, 0xe4, 0x24, 0xfa, 0xdc, 0x2a, 0xdb, 0x9e, 0xd3, 0x68,
+	0xf5, 0xc1, 0x28, 0xe8, 0xf8, 0x5e, 0x9e, 0x4d, 0xcf, 0x29, 0xd9, 0xcb, 0x33, 0x60, 0xf5, 0xfe,
+	0x08, 0xe0, 0xf8, 0xb0, 0xd9, 0x24, 0x97, 0x9b, 0x65, 0xf3, 0x94, 0x02, 0xab, 0xf7, 0x47, 0x00,
+	0xc7, 0xf5, 0x9c, 0x93, 0x6b, 0x72, 0x6b, 0x90, 0x03, 0x10, 0x47, 0xab, 0x0f, 0x46, 0x41, 0x27,
+	0x46, 0xce, 0xa6, 0x61, 0xdc, 0x1a, 0xb4, 0x26, 0x86, 0x1e, 0xb9, 0x38, 0xc3, 0x22, 0x80, 0xf9,
+	0x4c, 0x26, 0xc4, 0x8d, 0x72, 0xa7, 0x2f, 0x8e, 0x55, 0xef, 0x0d, 0x8f, 0x95, 0x63, 0xfe, 0x3a,
+	0x40, 0xec, 0x81, 0xff, 0x9d, 0xe2, 0x1e, 0xfa, 0x28, 0xf5, 0xd6, 0x30, 0x28, 0x39, 0x02, 0xbd,
+	0xf5, 0xc6, 0x1f, 0xdc, 0xcb, 0x6e, 0xbd, 0x31, 0x9c, 0x7a, 0x7b, 0x38, 0x9c, 0x1c, 0xc7, 0x86,
+	0x46, 0xf2, 0x39, 0xfc, 0x5a, 0xc9, 0xe9, 0x1a, 0x07, 0xaa, 0x6b, 0x43, 0x02, 0xe5, 0x50, 0x3e,
+	0xcc, 0xa5, 0x1f, 0x88, 0xaf, 0x97, 0x71, 0x9b, 0x80, 0xaa, 0x77, 0x87, 0x86, 0x26, 0xd6, 0x7e,
+	0xe6, 0x41, 0xf7, 0x66, 0xe9, 0x74, 0x27, 0xc1, 0xea, 0xfd, 0x11, 0xc0, 0xf1, 0xad, 0x35, 0xf5,
+	0x64, 0xba, 0x52, 0xda, 0x4d, 0x0c, 0xa9, 0xde, 0x19, 0x16, 0x99, 0xf4, 0x6d, 0xb2, 0x4f, 0x9b,
+	0xab, 0xc3, 0x9f, 0x09, 0x9b, 0x1b, 0x6d, 0xf5, 0xe1, 0x48, 0xf0, 0xa4, 0x86, 0xd3, 0x2f, 0x8c,
+	0xa5, 0x1a, 0x4e, 0x81, 0xd5, 0xfb, 0x23, 0x80, 0x13, 0x1e, 0x44, 0xd1, 0x6b, 0x5d, 0x89, 0x9d,
+	0x14, 0x34, 0x51, 0xdf, 0x1f, 0xb9, 0x49, 0xdc, 0xa6, 0xd3, 0xef, 0x58, 0xd7, 0x07, 0x4e, 0xa1,
+	0xdc, 0x7a, 0xee, 0x0e, 0x0d, 0x4d, 0xde, 0xcf, 0x33, 0x2f, 0x35, 0xa5, 0xf7, 0xf3, 0x34, 0x5a,
+	0x7d, 0x30, 0x0a, 0x3a, 0x79, 0xa4, 0xa5, 0x1f, 0x4f, 0x4a, 0x8f, 0xb4, 0x14, 0x58, 0xbd, 0x3f,
+	0x02, 0x38, 0x7e, 0x1d, 0x89, 0xbf, 0x67, 0x5c, 0x2d, 0x55, 0x59, 0x04, 0x53, 0x57, 0x87, 0x82,
+	0xc5, 0x07, 0x89, 0x47, 0xf1, 0xaf, 0x96, 0xdf, 0x30, 0x04, 0x4c, 0x5d, 0x1d, 0x0a, 0x16, 0xb7,
+	0x95, 0x74, 0x30, 0xbd, 0xc4, 0x56, 0x52, 0x50, 0xf5, 0xee, 0xd0, 0xd0, 0xf8, 0xd6, 0x90, 0x17,
+	0x9f, 0x2e, 0xd7, 0x4d, 0x1a, 0xae, 0x3e, 0x1c, 0x09, 0x1e, 0x0d, 0xae, 0x4e, 0x7d, 0x9b, 0xfe,
+	0x0b, 0xac, 0xf5, 0x07, 0x3f, 0xfe, 0x68, 0x51, 0xf9, 0xc9, 0x47, 0x8b, 0xca, 0xbf, 0x7c, 0xb4,
+	0xa8, 0xfc, 0xfe, 0xc7, 0x8b, 0x6f, 0xfc, 0xe4, 0xe3, 0xc5, 0x37, 0x7e, 0xfa, 0xf1, 0xe2, 0x1b,
+	0xbf, 0xa2, 0xe6, 0xbe, 0x7b, 0xb1, 0x30, 0xf5, 0xee, 0x34, 0xfb, 0xb7, 0x65, 0xf7, 0xff, 0x67,
+	0x00, 0x82, 0x34, 0x70, 0xd6, 0x9f, 0x4e, 0x00, 0x00,
 }
 
-func (m *MsgUpdateRewardaccrual) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateAuthorities defines a (governance) operation for replacing the scoped
+	// loyalty role holders. Only the module authority may execute it.
+	UpdateAuthorities(ctx context.Context, in *MsgUpdateAuthorities, opts ...grpc.CallOption) (*MsgUpdateAuthoritiesResponse, error)
+	// CreateCreatorallowlist defines the CreateCreatorallowlist RPC.
+	CreateCreatorallowlist(ctx context.Context, in *MsgCreateCreatorallowlist, opts ...grpc.CallOption) (*MsgCreateCreatorallowlistResponse, error)
+	// UpdateCreatorallowlist defines the UpdateCreatorallowlist RPC.
+	UpdateCreatorallowlist(ctx context.Context, in *MsgUpdateCreatorallowlist, opts ...grpc.CallOption) (*MsgUpdateCreatorallowlistResponse, error)
+	// DeleteCreatorallowlist defines the DeleteCreatorallowlist RPC.
+	DeleteCreatorallowlist(ctx context.Context, in *MsgDeleteCreatorallowlist, opts ...grpc.CallOption) (*MsgDeleteCreatorallowlistResponse, error)
+	// CreateVerifiedtoken defines the CreateVerifiedtoken RPC.
+	CreateVerifiedtoken(ctx context.Context, in *MsgCreateVerifiedtoken, opts ...grpc.CallOption) (*MsgCreateVerifiedtokenResponse, error)
+	// UpdateVerifiedtoken defines the UpdateVerifiedtoken RPC.
+	UpdateVerifiedtoken(ctx context.Context, in *MsgUpdateVerifiedtoken, opts ...grpc.CallOption) (*MsgUpdateVerifiedtokenResponse, error)
+	// RenounceTokenAdmin defines the RenounceTokenAdmin RPC.
+	RenounceTokenAdmin(ctx context.Context, in *MsgRenounceTokenAdmin, opts ...grpc.CallOption) (*MsgRenounceTokenAdminResponse, error)
+	// SetMerchantIncentiveRouting defines the SetMerchantIncentiveRouting RPC.
+	SetMerchantIncentiveRouting(ctx context.Context, in *MsgSetMerchantIncentiveRouting, opts ...grpc.CallOption) (*MsgSetMerchantIncentiveRoutingResponse, error)
+	// DeleteVerifiedtoken defines the DeleteVerifiedtoken RPC.
+	DeleteVerifiedtoken(ctx context.Context, in *MsgDeleteVerifiedtoken, opts ...grpc.CallOption) (*MsgDeleteVerifiedtokenResponse, error)
+	// CreateRewardaccrual defines the CreateRewardaccrual RPC.
+	CreateRewardaccrual(ctx context.Context, in *MsgCreateRewardaccrual, opts ...grpc.CallOption) (*MsgCreateRewardaccrualResponse, error)
+	// UpdateRewardaccrual defines the UpdateRewardaccrual RPC.
+	UpdateRewardaccrual(ctx context.Context, in *MsgUpdateRewardaccrual, opts ...grpc.CallOption) (*MsgUpdateRewardaccrualResponse, error)
+	// DeleteRewardaccrual defines the DeleteRewardaccrual RPC.
+	DeleteRewardaccrual(ctx context.Context, in *MsgDeleteRewardaccrual, opts ...grpc.CallOption) (*MsgDeleteRewardaccrualResponse, error)
+	// MintVerifiedToken defines the MintVerifiedToken RPC.
+	MintVerifiedToken(ctx context.Context, in *MsgMintVerifiedToken, opts ...grpc.CallOption) (*MsgMintVerifiedTokenResponse, error)
+	// MintVerifiedTokenBatch mints a verified token to many recipients at once.
+	MintVerifiedTokenBatch(ctx context.Context, in *MsgMintVerifiedTokenBatch, opts ...grpc.CallOption) (*MsgMintVerifiedTokenBatchResponse, error)
+	// ClaimReward defines the ClaimReward RPC.
+	ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error)
+	// FundRewardPool defines the FundRewardPool RPC.
+	FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error)
+	// RecordRewardAccrual defines the RecordRewardAccrual RPC.
+	RecordRewardAccrual(ctx context.Context, in *MsgRecordRewardAccrual, opts ...grpc.CallOption) (*MsgRecordRewardAccrualResponse, error)
+	// RecordMerchantAllocation defines the RecordMerchantAllocation RPC.
+	RecordMerchantAllocation(ctx context.Context, in *MsgRecordMerchantAllocation, opts ...grpc.CallOption) (*MsgRecordMerchantAllocationResponse, error)
+	// QueueRecoveryTransfer defines the QueueRecoveryTransfer RPC.
+	QueueRecoveryTransfer(ctx context.Context, in *MsgQueueRecoveryTransfer, opts ...grpc.CallOption) (*MsgQueueRecoveryTransferResponse, error)
+	// ExecuteRecoveryTransfer defines the ExecuteRecoveryTransfer RPC.
+	ExecuteRecoveryTransfer(ctx context.Context, in *MsgExecuteRecoveryTransfer, opts ...grpc.CallOption) (*MsgExecuteRecoveryTransferResponse, error)
+	// CancelRecoveryTransfer defines the CancelRecoveryTransfer RPC.
+	CancelRecoveryTransfer(ctx context.Context, in *MsgCancelRecoveryTransfer, opts ...grpc.CallOption) (*MsgCancelRecoveryTransferResponse, error)
+	// RegisterMerchant registers a merchant profile owned by the signer.
+	RegisterMerchant(ctx context.Context, in *MsgRegisterMerchant, opts ...grpc.CallOption) (*MsgRegisterMerchantResponse, error)
+	// UpdateMerchant updates a merchant profile. Only allowlist admins may change the verification tier.
+	UpdateMerchant(ctx context.Context, in *MsgUpdateMerchant, opts ...grpc.CallOption) (*MsgUpdateMerchantResponse, error)
+	// DeactivateMerchant permanently deactivates a merchant profile.
+	DeactivateMerchant(ctx context.Context, in *MsgDeactivateMerchant, opts ...grpc.CallOption) (*MsgDeactivateMerchantResponse, error)
+	// SubmitAttestation records or refreshes a verifier's attestation for a verified token.
+	SubmitAttestation(ctx context.Context, in *MsgSubmitAttestation, opts ...grpc.CallOption) (*MsgSubmitAttestationResponse, error)
+	// RevokeAttestation withdraws a verifier's attestation for a verified token.
+	RevokeAttestation(ctx context.Context, in *MsgRevokeAttestation, opts ...grpc.CallOption) (*MsgRevokeAttestationResponse, error)
+	// CreateMintSchedule reserves supply and releases it to a recipient in tranches.
+	CreateMintSchedule(ctx context.Context, in *MsgCreateMintSchedule, opts ...grpc.CallOption) (*MsgCreateMintScheduleResponse, error)
+	// CancelMintSchedule stops a mint schedule and releases its unminted reservation.
+	CancelMintSchedule(ctx context.Context, in *MsgCancelMintSchedule, opts ...grpc.CallOption) (*MsgCancelMintScheduleResponse, error)
+	// SetMintRateLimit tightens a token's mint rate limit immediately and schedules any
+	// loosening behind the change delay.
+	SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error)
+	// PauseToken halts minting, claims and/or transfers of one verified token.
+	PauseToken(ctx context.Context, in *MsgPauseToken, opts ...grpc.CallOption) (*MsgPauseTokenResponse, error)
+	// UnpauseToken lifts some or all paused scopes of a verified token.
+	UnpauseToken(ctx context.Context, in *MsgUnpauseToken, opts ...grpc.CallOption) (*MsgUnpauseTokenResponse, error)
+	// FreezeAddress blocks outgoing transfers of a recovery-enabled token from one
+	// address for a bounded period.
+	FreezeAddress(ctx context.Context, in *MsgFreezeAddress, opts ...grpc.CallOption) (*MsgFreezeAddressResponse, error)
+	// UnfreezeAddress lifts an address freeze early.
+	UnfreezeAddress(ctx context.Context, in *MsgUnfreezeAddress, opts ...grpc.CallOption) (*MsgUnfreezeAddressResponse, error)
+	// SetTokenIBCPolicy sets whether a verified token may be sent to other chains.
+	SetTokenIBCPolicy(ctx context.Context, in *MsgSetTokenIBCPolicy, opts ...grpc.CallOption) (*MsgSetTokenIBCPolicyResponse, error)
+	// SetIBCRecorder adds or removes a counterparty sender allowed to record
+	// accruals and fund reward pools through memos on transfers over a channel.
+	SetIBCRecorder(ctx context.Context, in *MsgSetIBCRecorder, opts ...grpc.CallOption) (*MsgSetIBCRecorderResponse, error)
+	// RegisterMerchantICA opens an interchain account for a token's merchant on the
+	// chain at the other end of a connection.
+	RegisterMerchantICA(ctx context.Context, in *MsgRegisterMerchantICA, opts ...grpc.CallOption) (*MsgRegisterMerchantICAResponse, error)
+	// SendMerchantICATx executes messages with a merchant's interchain account.
+	SendMerchantICATx(ctx context.Context, in *MsgSendMerchantICATx, opts ...grpc.CallOption) (*MsgSendMerchantICATxResponse, error)
+	// ForwardMerchantTreasury transfers the treasury amount of a merchant allocation
+	// to the merchant's interchain account.
+	ForwardMerchantTreasury(ctx context.Context, in *MsgForwardMerchantTreasury, opts ...grpc.CallOption) (*MsgForwardMerchantTreasuryResponse, error)
+	// SetIBCRateLimit adds or replaces the inflow and outflow quotas of a native
+	// denom on one channel. It is restricted to the module authority.
+	SetIBCRateLimit(ctx context.Context, in *MsgSetIBCRateLimit, opts ...grpc.CallOption) (*MsgSetIBCRateLimitResponse, error)
+	// RemoveIBCRateLimit lifts the quotas of a denom on a channel.
+	RemoveIBCRateLimit(ctx context.Context, in *MsgRemoveIBCRateLimit, opts ...grpc.CallOption) (*MsgRemoveIBCRateLimitResponse, error)
+	// ResetIBCRateLimit clears the flow counted against a rate limit and starts a
+	// new window.
+	ResetIBCRateLimit(ctx context.Context, in *MsgResetIBCRateLimit, opts ...grpc.CallOption) (*MsgResetIBCRateLimitResponse, error)
+	// SetFeeToken lets a verified token pay transaction fees at a rate into the
+	// staking denom. The module authority enables tokens; their owners may then
+	// adjust the rate, cap and message types.
+	SetFeeToken(ctx context.Context, in *MsgSetFeeToken, opts ...grpc.CallOption) (*MsgSetFeeTokenResponse, error)
+	// FundGasPool adds staking denom to the gas pool of a token you own. Only the
+	// token owner and the module authority may fund a pool, as only they can
+	// withdraw from it.
+	FundGasPool(ctx context.Context, in *MsgFundGasPool, opts ...grpc.CallOption) (*MsgFundGasPoolResponse, error)
+	// WithdrawGasPool takes staking denom out of the gas pool of a token you own.
+	WithdrawGasPool(ctx context.Context, in *MsgWithdrawGasPool, opts ...grpc.CallOption) (*MsgWithdrawGasPoolResponse, error)
+	// SetClaimSponsorship sets how the owner of a verified token pays the claim fees
+	// of its holders through fee allowances. Disabling it revokes the allowances.
+	SetClaimSponsorship(ctx context.Context, in *MsgSetClaimSponsorship, opts ...grpc.CallOption) (*MsgSetClaimSponsorshipResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	return out, nil
+}
+
+func (c *msgClient) UpdateAuthorities(ctx context.Context, in *MsgUpdateAuthorities, opts ...grpc.CallOption) (*MsgUpdateAuthoritiesResponse, error) {
+	out := new(MsgUpdateAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.LastRollupDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateCreatorallowlist(ctx context.Context, in *MsgCreateCreatorallowlist, opts ...grpc.CallOption) (*MsgCreateCreatorallowlistResponse, error) {
+	out := new(MsgCreateCreatorallowlistResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CreateCreatorallowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateRewardaccrualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateCreatorallowlist(ctx context.Context, in *MsgUpdateCreatorallowlist, opts ...grpc.CallOption) (*MsgUpdateCreatorallowlistResponse, error) {
+	out := new(MsgUpdateCreatorallowlistResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateCreatorallowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteRewardaccrual) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DeleteCreatorallowlist(ctx context.Context, in *MsgDeleteCreatorallowlist, opts ...grpc.CallOption) (*MsgDeleteCreatorallowlistResponse, error) {
+	out := new(MsgDeleteCreatorallowlistResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/DeleteCreatorallowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateVerifiedtoken(ctx context.Context, in *MsgCreateVerifiedtoken, opts ...grpc.CallOption) (*MsgCreateVerifiedtokenResponse, error) {
+	out := new(MsgCreateVerifiedtokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CreateVerifiedtoken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateVerifiedtoken(ctx context.Context, in *MsgUpdateVerifiedtoken, opts ...grpc.CallOption) (*MsgUpdateVerifiedtokenResponse, error) {
+	out := new(MsgUpdateVerifiedtokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateVerifiedtoken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteRewardaccrualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RenounceTokenAdmin(ctx context.Context, in *MsgRenounceTokenAdmin, opts ...grpc.CallOption) (*MsgRenounceTokenAdminResponse, error) {
+	out := new(MsgRenounceTokenAdminResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RenounceTokenAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgMintVerifiedToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetMerchantIncentiveRouting(ctx context.Context, in *MsgSetMerchantIncentiveRouting, opts ...grpc.CallOption) (*MsgSetMerchantIncentiveRoutingResponse, error) {
+	out := new(MsgSetMerchantIncentiveRoutingResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetMerchantIncentiveRouting", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMintVerifiedTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) DeleteVerifiedtoken(ctx context.Context, in *MsgDeleteVerifiedtoken, opts ...grpc.CallOption) (*MsgDeleteVerifiedtokenResponse, error) {
+	out := new(MsgDeleteVerifiedtokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/DeleteVerifiedtoken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.MintedSupply != 0 {
-		n += 1 + sovTx(uint64(m.MintedSupply))
+	return out, nil
+}
+
+func (c *msgClient) CreateRewardaccrual(ctx context.Context, in *MsgCreateRewardaccrual, opts ...grpc.CallOption) (*MsgCreateRewardaccrualResponse, error) {
+	out := new(MsgCreateRewardaccrualResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CreateRewardaccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimReward) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateRewardaccrual(ctx context.Context, in *MsgUpdateRewardaccrual, opts ...grpc.CallOption) (*MsgUpdateRewardaccrualResponse, error) {
+	out := new(MsgUpdateRewardaccrualResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateRewardaccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeleteRewardaccrual(ctx context.Context, in *MsgDeleteRewardaccrual, opts ...grpc.CallOption) (*MsgDeleteRewardaccrualResponse, error) {
+	out := new(MsgDeleteRewardaccrualResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/DeleteRewardaccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) MintVerifiedToken(ctx context.Context, in *MsgMintVerifiedToken, opts ...grpc.CallOption) (*MsgMintVerifiedTokenResponse, error) {
+	out := new(MsgMintVerifiedTokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/MintVerifiedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) MintVerifiedTokenBatch(ctx context.Context, in *MsgMintVerifiedTokenBatch, opts ...grpc.CallOption) (*MsgMintVerifiedTokenBatchResponse, error) {
+	out := new(MsgMintVerifiedTokenBatchResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/MintVerifiedTokenBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error) {
+	out := new(MsgClaimRewardResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/ClaimReward", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error) {
+	out := new(MsgFundRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/FundRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.AmountClaimed != 0 {
-		n += 1 + sovTx(uint64(m.AmountClaimed))
+	return out, nil
+}
+
+func (c *msgClient) RecordRewardAccrual(ctx context.Context, in *MsgRecordRewardAccrual, opts ...grpc.CallOption) (*MsgRecordRewardAccrualResponse, error) {
+	out := new(MsgRecordRewardAccrualResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RecordRewardAccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFundRewardPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RecordMerchantAllocation(ctx context.Context, in *MsgRecordMerchantAllocation, opts ...grpc.CallOption) (*MsgRecordMerchantAllocationResponse, error) {
+	out := new(MsgRecordMerchantAllocationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RecordMerchantAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) QueueRecoveryTransfer(ctx context.Context, in *MsgQueueRecoveryTransfer, opts ...grpc.CallOption) (*MsgQueueRecoveryTransferResponse, error) {
+	out := new(MsgQueueRecoveryTransferResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/QueueRecoveryTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ExecuteRecoveryTransfer(ctx context.Context, in *MsgExecuteRecoveryTransfer, opts ...grpc.CallOption) (*MsgExecuteRecoveryTransferResponse, error) {
+	out := new(MsgExecuteRecoveryTransferResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/ExecuteRecoveryTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	return out, nil
+}
+
+func (c *msgClient) CancelRecoveryTransfer(ctx context.Context, in *MsgCancelRecoveryTransfer, opts ...grpc.CallOption) (*MsgCancelRecoveryTransferResponse, error) {
+	out := new(MsgCancelRecoveryTransferResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CancelRecoveryTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFundRewardPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RegisterMerchant(ctx context.Context, in *MsgRegisterMerchant, opts ...grpc.CallOption) (*MsgRegisterMerchantResponse, error) {
+	out := new(MsgRegisterMerchantResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RegisterMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateMerchant(ctx context.Context, in *MsgUpdateMerchant, opts ...grpc.CallOption) (*MsgUpdateMerchantResponse, error) {
+	out := new(MsgUpdateMerchantResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UpdateMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeactivateMerchant(ctx context.Context, in *MsgDeactivateMerchant, opts ...grpc.CallOption) (*MsgDeactivateMerchantResponse, error) {
+	out := new(MsgDeactivateMerchantResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/DeactivateMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.AmountFunded != 0 {
-		n += 1 + sovTx(uint64(m.AmountFunded))
+	return out, nil
+}
+
+func (c *msgClient) SubmitAttestation(ctx context.Context, in *MsgSubmitAttestation, opts ...grpc.CallOption) (*MsgSubmitAttestationResponse, error) {
+	out := new(MsgSubmitAttestationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SubmitAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NewBalance)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RevokeAttestation(ctx context.Context, in *MsgRevokeAttestation, opts ...grpc.CallOption) (*MsgRevokeAttestationResponse, error) {
+	out := new(MsgRevokeAttestationResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RevokeAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRecordRewardAccrual) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateMintSchedule(ctx context.Context, in *MsgCreateMintSchedule, opts ...grpc.CallOption) (*MsgCreateMintScheduleResponse, error) {
+	out := new(MsgCreateMintScheduleResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CreateMintSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelMintSchedule(ctx context.Context, in *MsgCancelMintSchedule, opts ...grpc.CallOption) (*MsgCancelMintScheduleResponse, error) {
+	out := new(MsgCancelMintScheduleResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/CancelMintSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error) {
+	out := new(MsgSetMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetMintRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) PauseToken(ctx context.Context, in *MsgPauseToken, opts ...grpc.CallOption) (*MsgPauseTokenResponse, error) {
+	out := new(MsgPauseTokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/PauseToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	return out, nil
+}
+
+func (c *msgClient) UnpauseToken(ctx context.Context, in *MsgUnpauseToken, opts ...grpc.CallOption) (*MsgUnpauseTokenResponse, error) {
+	out := new(MsgUnpauseTokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UnpauseToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Date)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FreezeAddress(ctx context.Context, in *MsgFreezeAddress, opts ...grpc.CallOption) (*MsgFreezeAddressResponse, error) {
+	out := new(MsgFreezeAddressResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/FreezeAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRecordRewardAccrualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UnfreezeAddress(ctx context.Context, in *MsgUnfreezeAddress, opts ...grpc.CallOption) (*MsgUnfreezeAddressResponse, error) {
+	out := new(MsgUnfreezeAddressResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/UnfreezeAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AmountAdded != 0 {
-		n += 1 + sovTx(uint64(m.AmountAdded))
-	}
-	if m.TotalAmount != 0 {
-		n += 1 + sovTx(uint64(m.TotalAmount))
-	}
-	l = len(m.RollupDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRecordMerchantAllocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Date)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ActivityScore != 0 {
-		n += 1 + sovTx(uint64(m.ActivityScore))
-	}
-	if m.BucketCAmount != 0 {
-		n += 1 + sovTx(uint64(m.BucketCAmount))
+func (c *msgClient) SetTokenIBCPolicy(ctx context.Context, in *MsgSetTokenIBCPolicy, opts ...grpc.CallOption) (*MsgSetTokenIBCPolicyResponse, error) {
+	out := new(MsgSetTokenIBCPolicyResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetTokenIBCPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRecordMerchantAllocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Date)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ActivityScore != 0 {
-		n += 1 + sovTx(uint64(m.ActivityScore))
-	}
-	if m.BucketCAmount != 0 {
-		n += 1 + sovTx(uint64(m.BucketCAmount))
-	}
-	if m.StakersAmount != 0 {
-		n += 1 + sovTx(uint64(m.StakersAmount))
-	}
-	if m.TreasuryAmount != 0 {
-		n += 1 + sovTx(uint64(m.TreasuryAmount))
-	}
-	if m.MerchantIncentiveStakersBps != 0 {
-		n += 1 + sovTx(uint64(m.MerchantIncentiveStakersBps))
-	}
-	if m.MerchantIncentiveTreasuryBps != 0 {
-		n += 1 + sovTx(uint64(m.MerchantIncentiveTreasuryBps))
-	}
-	if m.Updated {
-		n += 2
+func (c *msgClient) SetIBCRecorder(ctx context.Context, in *MsgSetIBCRecorder, opts ...grpc.CallOption) (*MsgSetIBCRecorderResponse, error) {
+	out := new(MsgSetIBCRecorderResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetIBCRecorder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgQueueRecoveryTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+func (c *msgClient) RegisterMerchantICA(ctx context.Context, in *MsgRegisterMerchantICA, opts ...grpc.CallOption) (*MsgRegisterMerchantICAResponse, error) {
+	out := new(MsgRegisterMerchantICAResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RegisterMerchantICA", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgQueueRecoveryTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExecuteAfter != 0 {
-		n += 1 + sovTx(uint64(m.ExecuteAfter))
+func (c *msgClient) SendMerchantICATx(ctx context.Context, in *MsgSendMerchantICATx, opts ...grpc.CallOption) (*MsgSendMerchantICATxResponse, error) {
+	out := new(MsgSendMerchantICATxResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SendMerchantICATx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgExecuteRecoveryTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (c *msgClient) ForwardMerchantTreasury(ctx context.Context, in *MsgForwardMerchantTreasury, opts ...grpc.CallOption) (*MsgForwardMerchantTreasuryResponse, error) {
+	out := new(MsgForwardMerchantTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/ForwardMerchantTreasury", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgExecuteRecoveryTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetIBCRateLimit(ctx context.Context, in *MsgSetIBCRateLimit, opts ...grpc.CallOption) (*MsgSetIBCRateLimitResponse, error) {
+	out := new(MsgSetIBCRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetIBCRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) RemoveIBCRateLimit(ctx context.Context, in *MsgRemoveIBCRateLimit, opts ...grpc.CallOption) (*MsgRemoveIBCRateLimitResponse, error) {
+	out := new(MsgRemoveIBCRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/RemoveIBCRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ResetIBCRateLimit(ctx context.Context, in *MsgResetIBCRateLimit, opts ...grpc.CallOption) (*MsgResetIBCRateLimitResponse, error) {
+	out := new(MsgResetIBCRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/ResetIBCRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ExecutedAt != 0 {
-		n += 1 + sovTx(uint64(m.ExecutedAt))
+	return out, nil
+}
+
+func (c *msgClient) SetFeeToken(ctx context.Context, in *MsgSetFeeToken, opts ...grpc.CallOption) (*MsgSetFeeTokenResponse, error) {
+	out := new(MsgSetFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelRecoveryTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) FundGasPool(ctx context.Context, in *MsgFundGasPool, opts ...grpc.CallOption) (*MsgFundGasPoolResponse, error) {
+	out := new(MsgFundGasPoolResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/FundGasPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawGasPool(ctx context.Context, in *MsgWithdrawGasPool, opts ...grpc.CallOption) (*MsgWithdrawGasPoolResponse, error) {
+	out := new(MsgWithdrawGasPoolResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/WithdrawGasPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) SetClaimSponsorship(ctx context.Context, in *MsgSetClaimSponsorship, opts ...grpc.CallOption) (*MsgSetClaimSponsorshipResponse, error) {
+	out := new(MsgSetClaimSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/tokenchain.loyalty.v1.Msg/SetClaimSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateAuthorities defines a (governance) operation for replacing the scoped
+	// loyalty role holders. Only the module authority may execute it.
+	UpdateAuthorities(context.Context, *MsgUpdateAuthorities) (*MsgUpdateAuthoritiesResponse, error)
+	// CreateCreatorallowlist defines the CreateCreatorallowlist RPC.
+	CreateCreatorallowlist(context.Context, *MsgCreateCreatorallowlist) (*MsgCreateCreatorallowlistResponse, error)
+	// UpdateCreatorallowlist defines the UpdateCreatorallowlist RPC.
+	UpdateCreatorallowlist(context.Context, *MsgUpdateCreatorallowlist) (*MsgUpdateCreatorallowlistResponse, error)
+	// DeleteCreatorallowlist defines the DeleteCreatorallowlist RPC.
+	DeleteCreatorallowlist(context.Context, *MsgDeleteCreatorallowlist) (*MsgDeleteCreatorallowlistResponse, error)
+	// CreateVerifiedtoken defines the CreateVerifiedtoken RPC.
+	CreateVerifiedtoken(context.Context, *MsgCreateVerifiedtoken) (*MsgCreateVerifiedtokenResponse, error)
+	// UpdateVerifiedtoken defines the UpdateVerifiedtoken RPC.
+	UpdateVerifiedtoken(context.Context, *MsgUpdateVerifiedtoken) (*MsgUpdateVerifiedtokenResponse, error)
+	// RenounceTokenAdmin defines the RenounceTokenAdmin RPC.
+	RenounceTokenAdmin(context.Context, *MsgRenounceTokenAdmin) (*MsgRenounceTokenAdminResponse, error)
+	// SetMerchantIncentiveRouting defines the SetMerchantIncentiveRouting RPC.
+	SetMerchantIncentiveRouting(context.Context, *MsgSetMerchantIncentiveRouting) (*MsgSetMerchantIncentiveRoutingResponse, error)
+	// DeleteVerifiedtoken defines the DeleteVerifiedtoken RPC.
+	DeleteVerifiedtoken(context.Context, *MsgDeleteVerifiedtoken) (*MsgDeleteVerifiedtokenResponse, error)
+	// CreateRewardaccrual defines the CreateRewardaccrual RPC.
+	CreateRewardaccrual(context.Context, *MsgCreateRewardaccrual) (*MsgCreateRewardaccrualResponse, error)
+	// UpdateRewardaccrual defines the UpdateRewardaccrual RPC.
+	UpdateRewardaccrual(context.Context, *MsgUpdateRewardaccrual) (*MsgUpdateRewardaccrualResponse, error)
+	// DeleteRewardaccrual defines the DeleteRewardaccrual RPC.
+	DeleteRewardaccrual(context.Context, *MsgDeleteRewardaccrual) (*MsgDeleteRewardaccrualResponse, error)
+	// MintVerifiedToken defines the MintVerifiedToken RPC.
+	MintVerifiedToken(context.Context, *MsgMintVerifiedToken) (*MsgMintVerifiedTokenResponse, error)
+	// MintVerifiedTokenBatch mints a verified token to many recipients at once.
+	MintVerifiedTokenBatch(context.Context, *MsgMintVerifiedTokenBatch) (*MsgMintVerifiedTokenBatchResponse, error)
+	// ClaimReward defines the ClaimReward RPC.
+	ClaimReward(context.Context, *MsgClaimReward) (*MsgClaimRewardResponse, error)
+	// FundRewardPool defines the FundRewardPool RPC.
+	FundRewardPool(context.Context, *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error)
+	// RecordRewardAccrual defines the RecordRewardAccrual RPC.
+	RecordRewardAccrual(context.Context, *MsgRecordRewardAccrual) (*MsgRecordRewardAccrualResponse, error)
+	// RecordMerchantAllocation defines the RecordMerchantAllocation RPC.
+	RecordMerchantAllocation(context.Context, *MsgRecordMerchantAllocation) (*MsgRecordMerchantAllocationResponse, error)
+	// QueueRecoveryTransfer defines the QueueRecoveryTransfer RPC.
+	QueueRecoveryTransfer(context.Context, *MsgQueueRecoveryTransfer) (*MsgQueueRecoveryTransferResponse, error)
+	// ExecuteRecoveryTransfer defines the ExecuteRecoveryTransfer RPC.
+	ExecuteRecoveryTransfer(context.Context, *MsgExecuteRecoveryTransfer) (*MsgExecuteRecoveryTransferResponse, error)
+	// CancelRecoveryTransfer defines the CancelRecoveryTransfer RPC.
+	CancelRecoveryTransfer(context.Context, *MsgCancelRecoveryTransfer) (*MsgCancelRecoveryTransferResponse, error)
+	// RegisterMerchant registers a merchant profile owned by the signer.
+	RegisterMerchant(context.Context, *MsgRegisterMerchant) (*MsgRegisterMerchantResponse, error)
+	// UpdateMerchant updates a merchant profile. Only allowlist admins may change the verification tier.
+	UpdateMerchant(context.Context, *MsgUpdateMerchant) (*MsgUpdateMerchantResponse, error)
+	// DeactivateMerchant permanently deactivates a merchant profile.
+	DeactivateMerchant(context.Context, *MsgDeactivateMerchant) (*MsgDeactivateMerchantResponse, error)
+	// SubmitAttestation records or refreshes a verifier's attestation for a verified token.
+	SubmitAttestation(context.Context, *MsgSubmitAttestation) (*MsgSubmitAttestationResponse, error)
+	// RevokeAttestation withdraws a verifier's attestation for a verified token.
+	RevokeAttestation(context.Context, *MsgRevokeAttestation) (*MsgRevokeAttestationResponse, error)
+	// CreateMintSchedule reserves supply and releases it to a recipient in tranches.
+	CreateMintSchedule(context.Context, *MsgCreateMintSchedule) (*MsgCreateMintScheduleResponse, error)
+	// CancelMintSchedule stops a mint schedule and releases its unminted reservation.
+	CancelMintSchedule(context.Context, *MsgCancelMintSchedule) (*MsgCancelMintScheduleResponse, error)
+	// SetMintRateLimit tightens a token's mint rate limit immediately and schedules any
+	// loosening behind the change delay.
+	SetMintRateLimit(context.Context, *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error)
+	// PauseToken halts minting, claims and/or transfers of one verified token.
+	PauseToken(context.Context, *MsgPauseToken) (*MsgPauseTokenResponse, error)
+	// UnpauseToken lifts some or all paused scopes of a verified token.
+	UnpauseToken(context.Context, *MsgUnpauseToken) (*MsgUnpauseTokenResponse, error)
+	// FreezeAddress blocks outgoing transfers of a recovery-enabled token from one
+	// address for a bounded period.
+	FreezeAddress(context.Context, *MsgFreezeAddress) (*MsgFreezeAddressResponse, error)
+	// UnfreezeAddress lifts an address freeze early.
+	UnfreezeAddress(context.Context, *MsgUnfreezeAddress) (*MsgUnfreezeAddressResponse, error)
+	// SetTokenIBCPolicy sets whether a verified token may be sent to other chains.
+	SetTokenIBCPolicy(context.Context, *MsgSetTokenIBCPolicy) (*MsgSetTokenIBCPolicyResponse, error)
+	// SetIBCRecorder adds or removes a counterparty sender allowed to record
+	// accruals and fund reward pools through memos on transfers over a channel.
+	SetIBCRecorder(context.Context, *MsgSetIBCRecorder) (*MsgSetIBCRecorderResponse, error)
+	// RegisterMerchantICA opens an interchain account for a token's merchant on the
+	// chain at the other end of a connection.
+	RegisterMerchantICA(context.Context, *MsgRegisterMerchantICA) (*MsgRegisterMerchantICAResponse, error)
+	// SendMerchantICATx executes messages with a merchant's interchain account.
+	SendMerchantICATx(context.Context, *MsgSendMerchantICATx) (*MsgSendMerchantICATxResponse, error)
+	// ForwardMerchantTreasury transfers the treasury amount of a merchant allocation
+	// to the merchant's interchain account.
+	ForwardMerchantTreasury(context.Context, *MsgForwardMerchantTreasury) (*MsgForwardMerchantTreasuryResponse, error)
+	// SetIBCRateLimit adds or replaces the inflow and outflow quotas of a native
+	// denom on one channel. It is restricted to the module authority.
+	SetIBCRateLimit(context.Context, *MsgSetIBCRateLimit) (*MsgSetIBCRateLimitResponse, error)
+	// RemoveIBCRateLimit lifts the quotas of a denom on a channel.
+	RemoveIBCRateLimit(context.Context, *MsgRemoveIBCRateLimit) (*MsgRemoveIBCRateLimitResponse, error)
+	// ResetIBCRateLimit clears the flow counted against a rate limit and starts a
+	// new window.
+	ResetIBCRateLimit(context.Context, *MsgResetIBCRateLimit) (*MsgResetIBCRateLimitResponse, error)
+	// SetFeeToken lets a verified token pay transaction fees at a rate into the
+	// staking denom. The module authority enables tokens; their owners may then
+	// adjust the rate, cap and message types.
+	SetFeeToken(context.Context, *MsgSetFeeToken) (*MsgSetFeeTokenResponse, error)
+	// FundGasPool adds staking denom to the gas pool of a token you own. Only the
+	// token owner and the module authority may fund a pool, as only they can
+	// withdraw from it.
+	FundGasPool(context.Context, *MsgFundGasPool) (*MsgFundGasPoolResponse, error)
+	// WithdrawGasPool takes staking denom out of the gas pool of a token you own.
+	WithdrawGasPool(context.Context, *MsgWithdrawGasPool) (*MsgWithdrawGasPoolResponse, error)
+	// SetClaimSponsorship sets how the owner of a verified token pays the claim fees
+	// of its holders through fee allowances. Disabling it revokes the allowances.
+	SetClaimSponsorship(context.Context, *MsgSetClaimSponsorship) (*MsgSetClaimSponsorshipResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAuthorities(ctx context.Context, req *MsgUpdateAuthorities) (*MsgUpdateAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuthorities not implemented")
+}
+func (*UnimplementedMsgServer) CreateCreatorallowlist(ctx context.Context, req *MsgCreateCreatorallowlist) (*MsgCreateCreatorallowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCreatorallowlist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCreatorallowlist(ctx context.Context, req *MsgUpdateCreatorallowlist) (*MsgUpdateCreatorallowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreatorallowlist not implemented")
+}
+func (*UnimplementedMsgServer) DeleteCreatorallowlist(ctx context.Context, req *MsgDeleteCreatorallowlist) (*MsgDeleteCreatorallowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCreatorallowlist not implemented")
+}
+func (*UnimplementedMsgServer) CreateVerifiedtoken(ctx context.Context, req *MsgCreateVerifiedtoken) (*MsgCreateVerifiedtokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVerifiedtoken not implemented")
+}
+func (*UnimplementedMsgServer) UpdateVerifiedtoken(ctx context.Context, req *MsgUpdateVerifiedtoken) (*MsgUpdateVerifiedtokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVerifiedtoken not implemented")
+}
+func (*UnimplementedMsgServer) RenounceTokenAdmin(ctx context.Context, req *MsgRenounceTokenAdmin) (*MsgRenounceTokenAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenounceTokenAdmin not implemented")
+}
+func (*UnimplementedMsgServer) SetMerchantIncentiveRouting(ctx context.Context, req *MsgSetMerchantIncentiveRouting) (*MsgSetMerchantIncentiveRoutingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMerchantIncentiveRouting not implemented")
+}
+func (*UnimplementedMsgServer) DeleteVerifiedtoken(ctx context.Context, req *MsgDeleteVerifiedtoken) (*MsgDeleteVerifiedtokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVerifiedtoken not implemented")
+}
+func (*UnimplementedMsgServer) CreateRewardaccrual(ctx context.Context, req *MsgCreateRewardaccrual) (*MsgCreateRewardaccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRewardaccrual not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRewardaccrual(ctx context.Context, req *MsgUpdateRewardaccrual) (*MsgUpdateRewardaccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRewardaccrual not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRewardaccrual(ctx context.Context, req *MsgDeleteRewardaccrual) (*MsgDeleteRewardaccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRewardaccrual not implemented")
+}
+func (*UnimplementedMsgServer) MintVerifiedToken(ctx context.Context, req *MsgMintVerifiedToken) (*MsgMintVerifiedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintVerifiedToken not implemented")
+}
+func (*UnimplementedMsgServer) MintVerifiedTokenBatch(ctx context.Context, req *MsgMintVerifiedTokenBatch) (*MsgMintVerifiedTokenBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintVerifiedTokenBatch not implemented")
+}
+func (*UnimplementedMsgServer) ClaimReward(ctx context.Context, req *MsgClaimReward) (*MsgClaimRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReward not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardPool(ctx context.Context, req *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardPool not implemented")
+}
+func (*UnimplementedMsgServer) RecordRewardAccrual(ctx context.Context, req *MsgRecordRewardAccrual) (*MsgRecordRewardAccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRewardAccrual not implemented")
+}
+func (*UnimplementedMsgServer) RecordMerchantAllocation(ctx context.Context, req *MsgRecordMerchantAllocation) (*MsgRecordMerchantAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMerchantAllocation not implemented")
+}
+func (*UnimplementedMsgServer) QueueRecoveryTransfer(ctx context.Context, req *MsgQueueRecoveryTransfer) (*MsgQueueRecoveryTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueRecoveryTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteRecoveryTransfer(ctx context.Context, req *MsgExecuteRecoveryTransfer) (*MsgExecuteRecoveryTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRecoveryTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelRecoveryTransfer(ctx context.Context, req *MsgCancelRecoveryTransfer) (*MsgCancelRecoveryTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecoveryTransfer not implemented")
+}
+func (*UnimplementedMsgServer) RegisterMerchant(ctx context.Context, req *MsgRegisterMerchant) (*MsgRegisterMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMerchant not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMerchant(ctx context.Context, req *MsgUpdateMerchant) (*MsgUpdateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchant not implemented")
+}
+func (*UnimplementedMsgServer) DeactivateMerchant(ctx context.Context, req *MsgDeactivateMerchant) (*MsgDeactivateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateMerchant not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAttestation(ctx context.Context, req *MsgSubmitAttestation) (*MsgSubmitAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestation not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAttestation(ctx context.Context, req *MsgRevokeAttestation) (*MsgRevokeAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAttestation not implemented")
+}
+func (*UnimplementedMsgServer) CreateMintSchedule(ctx context.Context, req *MsgCreateMintSchedule) (*MsgCreateMintScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMintSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelMintSchedule(ctx context.Context, req *MsgCancelMintSchedule) (*MsgCancelMintScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMintSchedule not implemented")
+}
+func (*UnimplementedMsgServer) SetMintRateLimit(ctx context.Context, req *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) PauseToken(ctx context.Context, req *MsgPauseToken) (*MsgPauseTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseToken not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseToken(ctx context.Context, req *MsgUnpauseToken) (*MsgUnpauseTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseToken not implemented")
+}
+func (*UnimplementedMsgServer) FreezeAddress(ctx context.Context, req *MsgFreezeAddress) (*MsgFreezeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAddress not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeAddress(ctx context.Context, req *MsgUnfreezeAddress) (*MsgUnfreezeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAddress not implemented")
+}
+func (*UnimplementedMsgServer) SetTokenIBCPolicy(ctx context.Context, req *MsgSetTokenIBCPolicy) (*MsgSetTokenIBCPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTokenIBCPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SetIBCRecorder(ctx context.Context, req *MsgSetIBCRecorder) (*MsgSetIBCRecorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIBCRecorder not implemented")
+}
+func (*UnimplementedMsgServer) RegisterMerchantICA(ctx context.Context, req *MsgRegisterMerchantICA) (*MsgRegisterMerchantICAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMerchantICA not implemented")
+}
+func (*UnimplementedMsgServer) SendMerchantICATx(ctx context.Context, req *MsgSendMerchantICATx) (*MsgSendMerchantICATxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMerchantICATx not implemented")
+}
+func (*UnimplementedMsgServer) ForwardMerchantTreasury(ctx context.Context, req *MsgForwardMerchantTreasury) (*MsgForwardMerchantTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMerchantTreasury not implemented")
+}
+func (*UnimplementedMsgServer) SetIBCRateLimit(ctx context.Context, req *MsgSetIBCRateLimit) (*MsgSetIBCRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIBCRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveIBCRateLimit(ctx context.Context, req *MsgRemoveIBCRateLimit) (*MsgRemoveIBCRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveIBCRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) ResetIBCRateLimit(ctx context.Context, req *MsgResetIBCRateLimit) (*MsgResetIBCRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetIBCRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeToken(ctx context.Context, req *MsgSetFeeToken) (*MsgSetFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) FundGasPool(ctx context.Context, req *MsgFundGasPool) (*MsgFundGasPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundGasPool not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawGasPool(ctx context.Context, req *MsgWithdrawGasPool) (*MsgWithdrawGasPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawGasPool not implemented")
+}
+func (*UnimplementedMsgServer) SetClaimSponsorship(ctx context.Context, req *MsgSetClaimSponsorship) (*MsgSetClaimSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimSponsorship not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAuthorities(ctx, req.(*MsgUpdateAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCreatorallowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCreatorallowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/CreateCreatorallowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCreatorallowlist(ctx, req.(*MsgCreateCreatorallowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCreatorallowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCreatorallowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateCreatorallowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCreatorallowlist(ctx, req.(*MsgUpdateCreatorallowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteCreatorallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteCreatorallowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteCreatorallowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/DeleteCreatorallowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteCreatorallowlist(ctx, req.(*MsgDeleteCreatorallowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVerifiedtoken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVerifiedtoken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/CreateVerifiedtoken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVerifiedtoken(ctx, req.(*MsgCreateVerifiedtoken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVerifiedtoken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVerifiedtoken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateVerifiedtoken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVerifiedtoken(ctx, req.(*MsgUpdateVerifiedtoken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenounceTokenAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenounceTokenAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenounceTokenAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/RenounceTokenAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenounceTokenAdmin(ctx, req.(*MsgRenounceTokenAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMerchantIncentiveRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMerchantIncentiveRouting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMerchantIncentiveRouting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SetMerchantIncentiveRouting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMerchantIncentiveRouting(ctx, req.(*MsgSetMerchantIncentiveRouting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteVerifiedtoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteVerifiedtoken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteVerifiedtoken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/DeleteVerifiedtoken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteVerifiedtoken(ctx, req.(*MsgDeleteVerifiedtoken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRewardaccrual)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRewardaccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/CreateRewardaccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRewardaccrual(ctx, req.(*MsgCreateRewardaccrual))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRewardaccrual)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRewardaccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateRewardaccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRewardaccrual(ctx, req.(*MsgUpdateRewardaccrual))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteRewardaccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRewardaccrual)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRewardaccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/DeleteRewardaccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRewardaccrual(ctx, req.(*MsgDeleteRewardaccrual))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintVerifiedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintVerifiedToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintVerifiedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/MintVerifiedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintVerifiedToken(ctx, req.(*MsgMintVerifiedToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintVerifiedTokenBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintVerifiedTokenBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintVerifiedTokenBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/MintVerifiedTokenBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintVerifiedTokenBatch(ctx, req.(*MsgMintVerifiedTokenBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/ClaimReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimReward(ctx, req.(*MsgClaimReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/FundRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardPool(ctx, req.(*MsgFundRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecordRewardAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecordRewardAccrual)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecordRewardAccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/RecordRewardAccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecordRewardAccrual(ctx, req.(*MsgRecordRewardAccrual))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecordMerchantAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecordMerchantAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecordMerchantAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/RecordMerchantAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecordMerchantAllocation(ctx, req.(*MsgRecordMerchantAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_QueueRecoveryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgQueueRecoveryTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).QueueRecoveryTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/QueueRecoveryTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).QueueRecoveryTransfer(ctx, req.(*MsgQueueRecoveryTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteRecoveryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteRecoveryTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteRecoveryTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/ExecuteRecoveryTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteRecoveryTransfer(ctx, req.(*MsgExecuteRecoveryTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecoveryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecoveryTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecoveryTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/CancelRecoveryTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecoveryTransfer(ctx, req.(*MsgCancelRecoveryTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMerchant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/RegisterMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMerchant(ctx, req.(*MsgRegisterMerchant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMerchant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UpdateMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMerchant(ctx, req.(*MsgUpdateMerchant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeactivateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateMerchant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/DeactivateMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateMerchant(ctx, req.(*MsgDeactivateMerchant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SubmitAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAttestation(ctx, req.(*MsgSubmitAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/RevokeAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAttestation(ctx, req.(*MsgRevokeAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMintSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMintSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMintSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/CreateMintSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMintSchedule(ctx, req.(*MsgCreateMintSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMintSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMintSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMintSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/CancelMintSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMintSchedule(ctx, req.(*MsgCancelMintSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SetMintRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintRateLimit(ctx, req.(*MsgSetMintRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/PauseToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseToken(ctx, req.(*MsgPauseToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UnpauseToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseToken(ctx, req.(*MsgUnpauseToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/FreezeAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeAddress(ctx, req.(*MsgFreezeAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/UnfreezeAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeAddress(ctx, req.(*MsgUnfreezeAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTokenIBCPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTokenIBCPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTokenIBCPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SetTokenIBCPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTokenIBCPolicy(ctx, req.(*MsgSetTokenIBCPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIBCRecorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIBCRecorder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIBCRecorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SetIBCRecorder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIBCRecorder(ctx, req.(*MsgSetIBCRecorder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMerchantICA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMerchantICA)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMerchantICA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/RegisterMerchantICA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMerchantICA(ctx, req.(*MsgRegisterMerchantICA))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendMerchantICATx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendMerchantICATx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendMerchantICATx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SendMerchantICATx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendMerchantICATx(ctx, req.(*MsgSendMerchantICATx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForwardMerchantTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForwardMerchantTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForwardMerchantTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/ForwardMerchantTreasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForwardMerchantTreasury(ctx, req.(*MsgForwardMerchantTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIBCRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIBCRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIBCRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SetIBCRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIBCRateLimit(ctx, req.(*MsgSetIBCRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveIBCRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveIBCRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveIBCRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/RemoveIBCRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveIBCRateLimit(ctx, req.(*MsgRemoveIBCRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetIBCRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetIBCRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetIBCRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/ResetIBCRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetIBCRateLimit(ctx, req.(*MsgResetIBCRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SetFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeToken(ctx, req.(*MsgSetFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundGasPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundGasPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundGasPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/FundGasPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundGasPool(ctx, req.(*MsgFundGasPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawGasPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawGasPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawGasPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/WithdrawGasPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawGasPool(ctx, req.(*MsgWithdrawGasPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClaimSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClaimSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClaimSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenchain.loyalty.v1.Msg/SetClaimSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClaimSponsorship(ctx, req.(*MsgSetClaimSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tokenchain.loyalty.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateAuthorities",
+			Handler:    _Msg_UpdateAuthorities_Handler,
+		},
+		{
+			MethodName: "CreateCreatorallowlist",
+			Handler:    _Msg_CreateCreatorallowlist_Handler,
+		},
+		{
+			MethodName: "UpdateCreatorallowlist",
+			Handler:    _Msg_UpdateCreatorallowlist_Handler,
+		},
+		{
+			MethodName: "DeleteCreatorallowlist",
+			Handler:    _Msg_DeleteCreatorallowlist_Handler,
+		},
+		{
+			MethodName: "CreateVerifiedtoken",
+			Handler:    _Msg_CreateVerifiedtoken_Handler,
+		},
+		{
+			MethodName: "UpdateVerifiedtoken",
+			Handler:    _Msg_UpdateVerifiedtoken_Handler,
+		},
+		{
+			MethodName: "RenounceTokenAdmin",
+			Handler:    _Msg_RenounceTokenAdmin_Handler,
+		},
+		{
+			MethodName: "SetMerchantIncentiveRouting",
+			Handler:    _Msg_SetMerchantIncentiveRouting_Handler,
+		},
+		{
+			MethodName: "DeleteVerifiedtoken",
+			Handler:    _Msg_DeleteVerifiedtoken_Handler,
+		},
+		{
+			MethodName: "CreateRewardaccrual",
+			Handler:    _Msg_CreateRewardaccrual_Handler,
+		},
+		{
+			MethodName: "UpdateRewardaccrual",
+			Handler:    _Msg_UpdateRewardaccrual_Handler,
+		},
+		{
+			MethodName: "DeleteRewardaccrual",
+			Handler:    _Msg_DeleteRewardaccrual_Handler,
+		},
+		{
+			MethodName: "MintVerifiedToken",
+			Handler:    _Msg_MintVerifiedToken_Handler,
+		},
+		{
+			MethodName: "MintVerifiedTokenBatch",
+			Handler:    _Msg_MintVerifiedTokenBatch_Handler,
+		},
+		{
+			MethodName: "ClaimReward",
+			Handler:    _Msg_ClaimReward_Handler,
+		},
+		{
+			MethodName: "FundRewardPool",
+			Handler:    _Msg_FundRewardPool_Handler,
+		},
+		{
+			MethodName: "RecordRewardAccrual",
+			Handler:    _Msg_RecordRewardAccrual_Handler,
+		},
+		{
+			MethodName: "RecordMerchantAllocation",
+			Handler:    _Msg_RecordMerchantAllocation_Handler,
+		},
+		{
+			MethodName: "QueueRecoveryTransfer",
+			Handler:    _Msg_QueueRecoveryTransfer_Handler,
+		},
+		{
+			MethodName: "ExecuteRecoveryTransfer",
+			Handler:    _Msg_ExecuteRecoveryTransfer_Handler,
+		},
+		{
+			MethodName: "CancelRecoveryTransfer",
+			Handler:    _Msg_CancelRecoveryTransfer_Handler,
+		},
+		{
+			MethodName: "RegisterMerchant",
+			Handler:    _Msg_RegisterMerchant_Handler,
+		},
+		{
+			MethodName: "UpdateMerchant",
+			Handler:    _Msg_UpdateMerchant_Handler,
+		},
+		{
+			MethodName: "DeactivateMerchant",
+			Handler:    _Msg_DeactivateMerchant_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _Msg_SubmitAttestation_Handler,
+		},
+		{
+			MethodName: "RevokeAttestation",
+			Handler:    _Msg_RevokeAttestation_Handler,
+		},
+		{
+			MethodName: "CreateMintSchedule",
+			Handler:    _Msg_CreateMintSchedule_Handler,
+		},
+		{
+			MethodName: "CancelMintSchedule",
+			Handler:    _Msg_CancelMintSchedule_Handler,
+		},
+		{
+			MethodName: "SetMintRateLimit",
+			Handler:    _Msg_SetMintRateLimit_Handler,
+		},
+		{
+			MethodName: "PauseToken",
+			Handler:    _Msg_PauseToken_Handler,
+		},
+		{
+			MethodName: "UnpauseToken",
+			Handler:    _Msg_UnpauseToken_Handler,
+		},
+		{
+			MethodName: "FreezeAddress",
+			Handler:    _Msg_FreezeAddress_Handler,
+		},
+		{
+			MethodName: "UnfreezeAddress",
+			Handler:    _Msg_UnfreezeAddress_Handler,
+		},
+		{
+			MethodName: "SetTokenIBCPolicy",
+			Handler:    _Msg_SetTokenIBCPolicy_Handler,
+		},
+		{
+			MethodName: "SetIBCRecorder",
+			Handler:    _Msg_SetIBCRecorder_Handler,
+		},
+		{
+			MethodName: "RegisterMerchantICA",
+			Handler:    _Msg_RegisterMerchantICA_Handler,
+		},
+		{
+			MethodName: "SendMerchantICATx",
+			Handler:    _Msg_SendMerchantICATx_Handler,
+		},
+		{
+			MethodName: "ForwardMerchantTreasury",
+			Handler:    _Msg_ForwardMerchantTreasury_Handler,
+		},
+		{
+			MethodName: "SetIBCRateLimit",
+			Handler:    _Msg_SetIBCRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveIBCRateLimit",
+			Handler:    _Msg_RemoveIBCRateLimit_Handler,
+		},
+		{
+			MethodName: "ResetIBCRateLimit",
+			Handler:    _Msg_ResetIBCRateLimit_Handler,
+		},
+		{
+			MethodName: "SetFeeToken",
+			Handler:    _Msg_SetFeeToken_Handler,
+		},
+		{
+			MethodName: "FundGasPool",
+			Handler:    _Msg_FundGasPool_Handler,
+		},
+		{
+			MethodName: "WithdrawGasPool",
+			Handler:    _Msg_WithdrawGasPool_Handler,
+		},
+		{
+			MethodName: "SetClaimSponsorship",
+			Handler:    _Msg_SetClaimSponsorship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tokenchain/loyalty/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authorities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCreatorallowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCreatorallowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCreatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTotalSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTotalSupply))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTokens != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCreatorallowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCreatorallowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCreatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTotalSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTotalSupply))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTokens != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteCreatorallowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteCreatorallowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteCreatorallowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteCreatorallowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteCreatorallowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteCreatorallowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVerifiedtoken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVerifiedtoken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVerifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomUnits) > 0 {
+		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RecoveryTimelockHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryTimelockHours))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.RecoveryGroupPolicy) > 0 {
+		i -= len(m.RecoveryGroupPolicy)
+		copy(dAtA[i:], m.RecoveryGroupPolicy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveryGroupPolicy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SeizureOptIn {
+		i--
+		if m.SeizureOptIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MintedSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MintedSupply))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVerifiedtoken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVerifiedtoken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVerifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomUnits) > 0 {
+		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RecoveryTimelockHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryTimelockHours))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.RecoveryGroupPolicy) > 0 {
+		i -= len(m.RecoveryGroupPolicy)
+		copy(dAtA[i:], m.RecoveryGroupPolicy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveryGroupPolicy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SeizureOptIn {
+		i--
+		if m.SeizureOptIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MintedSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MintedSupply))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MetadataChangePending {
+		i--
+		if m.MetadataChangePending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceTokenAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceTokenAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceTokenAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceTokenAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceTokenAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceTokenAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdminRenounced {
+		i--
+		if m.AdminRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMerchantIncentiveRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMerchantIncentiveRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMerchantIncentiveRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MerchantIncentiveTreasuryBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveTreasuryBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MerchantIncentiveStakersBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveStakersBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMerchantIncentiveRoutingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMerchantIncentiveRoutingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMerchantIncentiveRoutingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MerchantIncentiveTreasuryBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveTreasuryBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MerchantIncentiveStakersBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveStakersBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteVerifiedtoken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteVerifiedtoken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteVerifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteVerifiedtokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteVerifiedtokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteVerifiedtokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRewardaccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRewardaccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRewardaccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastRollupDate) > 0 {
+		i -= len(m.LastRollupDate)
+		copy(dAtA[i:], m.LastRollupDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LastRollupDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRewardaccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRewardaccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRewardaccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastRollupDate) > 0 {
+		i -= len(m.LastRollupDate)
+		copy(dAtA[i:], m.LastRollupDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LastRollupDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRewardaccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRewardaccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRewardaccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRewardaccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRewardaccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRewardaccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintVerifiedToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintVerifiedToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintVerifiedToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintVerifiedTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintVerifiedTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintVerifiedTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintedSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MintedSupply))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintVerifiedTokenBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintVerifiedTokenBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintVerifiedTokenBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintVerifiedTokenBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintVerifiedTokenBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintVerifiedTokenBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MintedSupply != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MintedSupply))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmountClaimed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AmountClaimed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewBalance) > 0 {
+		i -= len(m.NewBalance)
+		copy(dAtA[i:], m.NewBalance)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBalance)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AmountFunded != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AmountFunded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecordRewardAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecordRewardAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecordRewardAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecordRewardAccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecordRewardAccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecordRewardAccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollupDate) > 0 {
+		i -= len(m.RollupDate)
+		copy(dAtA[i:], m.RollupDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollupDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AmountAdded != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AmountAdded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecordMerchantAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecordMerchantAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecordMerchantAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketCAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BucketCAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivityScore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecordMerchantAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecordMerchantAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecordMerchantAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Updated {
+		i--
+		if m.Updated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MerchantIncentiveTreasuryBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveTreasuryBps))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MerchantIncentiveStakersBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MerchantIncentiveStakersBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TreasuryAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TreasuryAmount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StakersAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakersAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BucketCAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BucketCAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivityScore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgQueueRecoveryTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueueRecoveryTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueueRecoveryTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgQueueRecoveryTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueueRecoveryTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueueRecoveryTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteAfter != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteAfter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRecoveryTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRecoveryTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRecoveryTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRecoveryTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRecoveryTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRecoveryTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecoveryTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecoveryTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecoveryTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecoveryTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecoveryTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecoveryTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancelledAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CancelledAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMerchant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMerchant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMerchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogoHash) > 0 {
+		i -= len(m.LogoHash)
+		copy(dAtA[i:], m.LogoHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LogoHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContactUri) > 0 {
+		i -= len(m.ContactUri)
+		copy(dAtA[i:], m.ContactUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContactUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LegalName) > 0 {
+		i -= len(m.LegalName)
+		copy(dAtA[i:], m.LegalName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LegalName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMerchant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMerchant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMerchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationTier) > 0 {
+		i -= len(m.VerificationTier)
+		copy(dAtA[i:], m.VerificationTier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationTier)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LogoHash) > 0 {
+		i -= len(m.LogoHash)
+		copy(dAtA[i:], m.LogoHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LogoHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ContactUri) > 0 {
+		i -= len(m.ContactUri)
+		copy(dAtA[i:], m.ContactUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContactUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LegalName) > 0 {
+		i -= len(m.LegalName)
+		copy(dAtA[i:], m.LegalName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LegalName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateMerchant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateMerchant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateMerchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeactivatedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeactivatedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EvidenceUri) > 0 {
+		i -= len(m.EvidenceUri)
+		copy(dAtA[i:], m.EvidenceUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AttestationCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AttestationCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMintSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMintSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Cadence) > 0 {
+		i -= len(m.Cadence)
+		copy(dAtA[i:], m.Cadence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cadence)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TrancheAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TrancheAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMintScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMintScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMintScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMintSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMintSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMintScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMintScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMintScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancelledAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CancelledAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReleasedAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleasedAmount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPerRollupDate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPerRollupDate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPerRollingDay != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPerRollingDay))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPerTx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPerTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MintRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingScopes) > 0 {
+		for iNdEx := len(m.RemainingScopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemainingScopes[iNdEx])
+			copy(dAtA[i:], m.RemainingScopes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemainingScopes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LinkRecovery {
+		i--
+		if m.LinkRecovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RecoveryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTokenIBCPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenIBCPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenIBCPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTokenIBCPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenIBCPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenIBCPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCRecorder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCRecorder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCRecorder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCRecorderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCRecorderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCRecorderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMerchantICA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMerchantICA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMerchantICA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMerchantICAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMerchantICAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMerchantICAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Ica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendMerchantICATx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendMerchantICATx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendMerchantICATx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CosmosTx) > 0 {
+		i -= len(m.CosmosTx)
+		copy(dAtA[i:], m.CosmosTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosTx)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendMerchantICATxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendMerchantICATxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendMerchantICATxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForwardMerchantTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForwardMerchantTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForwardMerchantTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TransferChannel) > 0 {
+		i -= len(m.TransferChannel)
+		copy(dAtA[i:], m.TransferChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllocationKey) > 0 {
+		i -= len(m.AllocationKey)
+		copy(dAtA[i:], m.AllocationKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AllocationKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForwardMerchantTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForwardMerchantTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForwardMerchantTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Forward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxInflow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxInflow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxOutflow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxOutflow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIBCRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIBCRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIBCRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIBCRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIBCRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIBCRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetIBCRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetIBCRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetIBCRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetIBCRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetIBCRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetIBCRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxUtokenPerTx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxUtokenPerTx))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UtokenUnits != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UtokenUnits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TokenUnits != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TokenUnits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundGasPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundGasPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundGasPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundGasPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundGasPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundGasPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawGasPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawGasPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawGasPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawGasPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawGasPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawGasPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PerUserCap != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerUserCap))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DailyBudget != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DailyBudget))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Authorities.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateCreatorallowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	if m.MaxTokens != 0 {
+		n += 1 + sovTx(uint64(m.MaxTokens))
+	}
+	if m.MaxTotalSupply != 0 {
+		n += 1 + sovTx(uint64(m.MaxTotalSupply))
+	}
+	return n
+}
+
+func (m *MsgCreateCreatorallowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCreatorallowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	if m.MaxTokens != 0 {
+		n += 1 + sovTx(uint64(m.MaxTokens))
+	}
+	if m.MaxTotalSupply != 0 {
+		n += 1 + sovTx(uint64(m.MaxTotalSupply))
+	}
+	return n
+}
+
+func (m *MsgUpdateCreatorallowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteCreatorallowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteCreatorallowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateVerifiedtoken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovTx(uint64(m.MaxSupply))
+	}
+	if m.MintedSupply != 0 {
+		n += 1 + sovTx(uint64(m.MintedSupply))
+	}
+	if m.SeizureOptIn {
+		n += 2
+	}
+	l = len(m.RecoveryGroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecoveryTimelockHours != 0 {
+		n += 1 + sovTx(uint64(m.RecoveryTimelockHours))
+	}
+	if m.MerchantId != 0 {
+		n += 1 + sovTx(uint64(m.MerchantId))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if len(m.DenomUnits) > 0 {
+		for _, e := range m.DenomUnits {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateVerifiedtokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateVerifiedtoken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovTx(uint64(m.MaxSupply))
+	}
+	if m.MintedSupply != 0 {
+		n += 1 + sovTx(uint64(m.MintedSupply))
+	}
+	if m.SeizureOptIn {
+		n += 2
+	}
+	l = len(m.RecoveryGroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecoveryTimelockHours != 0 {
+		n += 1 + sovTx(uint64(m.RecoveryTimelockHours))
+	}
+	if m.MerchantId != 0 {
+		n += 1 + sovTx(uint64(m.MerchantId))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if len(m.DenomUnits) > 0 {
+		for _, e := range m.DenomUnits {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateVerifiedtokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetadataChangePending {
+		n += 2
+	}
+	if m.EffectiveAt != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveAt))
+	}
+	return n
+}
+
+func (m *MsgRenounceTokenAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenounceTokenAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AdminRenounced {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetMerchantIncentiveRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MerchantIncentiveStakersBps != 0 {
+		n += 1 + sovTx(uint64(m.MerchantIncentiveStakersBps))
+	}
+	if m.MerchantIncentiveTreasuryBps != 0 {
+		n += 1 + sovTx(uint64(m.MerchantIncentiveTreasuryBps))
+	}
+	return n
+}
+
+func (m *MsgSetMerchantIncentiveRoutingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MerchantIncentiveStakersBps != 0 {
+		n += 1 + sovTx(uint64(m.MerchantIncentiveStakersBps))
+	}
+	if m.MerchantIncentiveTreasuryBps != 0 {
+		n += 1 + sovTx(uint64(m.MerchantIncentiveTreasuryBps))
+	}
+	return n
+}
+
+func (m *MsgDeleteVerifiedtoken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteVerifiedtokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRewardaccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.LastRollupDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRewardaccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRewardaccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.LastRollupDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRewardaccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRewardaccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRewardaccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintVerifiedToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgMintVerifiedTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MintedSupply != 0 {
+		n += 1 + sovTx(uint64(m.MintedSupply))
+	}
+	return n
+}
+
+func (m *MintRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgMintVerifiedTokenBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMintVerifiedTokenBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MintedSupply != 0 {
+		n += 1 + sovTx(uint64(m.MintedSupply))
+	}
+	if m.TotalAmount != 0 {
+		n += 1 + sovTx(uint64(m.TotalAmount))
+	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountClaimed != 0 {
+		n += 1 + sovTx(uint64(m.AmountClaimed))
+	}
+	return n
+}
+
+func (m *MsgFundRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgFundRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountFunded != 0 {
+		n += 1 + sovTx(uint64(m.AmountFunded))
+	}
+	l = len(m.NewBalance)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecordRewardAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecordRewardAccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountAdded != 0 {
+		n += 1 + sovTx(uint64(m.AmountAdded))
+	}
+	if m.TotalAmount != 0 {
+		n += 1 + sovTx(uint64(m.TotalAmount))
+	}
+	l = len(m.RollupDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecordMerchantAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivityScore != 0 {
+		n += 1 + sovTx(uint64(m.ActivityScore))
+	}
+	if m.BucketCAmount != 0 {
+		n += 1 + sovTx(uint64(m.BucketCAmount))
+	}
+	return n
+}
+
+func (m *MsgRecordMerchantAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivityScore != 0 {
+		n += 1 + sovTx(uint64(m.ActivityScore))
+	}
+	if m.BucketCAmount != 0 {
+		n += 1 + sovTx(uint64(m.BucketCAmount))
+	}
+	if m.StakersAmount != 0 {
+		n += 1 + sovTx(uint64(m.StakersAmount))
+	}
+	if m.TreasuryAmount != 0 {
+		n += 1 + sovTx(uint64(m.TreasuryAmount))
+	}
+	if m.MerchantIncentiveStakersBps != 0 {
+		n += 1 + sovTx(uint64(m.MerchantIncentiveStakersBps))
+	}
+	if m.MerchantIncentiveTreasuryBps != 0 {
+		n += 1 + sovTx(uint64(m.MerchantIncentiveTreasuryBps))
+	}
+	if m.Updated {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgQueueRecoveryTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgQueueRecoveryTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecuteAfter != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteAfter))
+	}
+	return n
+}
+
+func (m *MsgExecuteRecoveryTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgExecuteRecoveryTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutedAt != 0 {
+		n += 1 + sovTx(uint64(m.ExecutedAt))
+	}
+	return n
+}
+
+func (m *MsgCancelRecoveryTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRecoveryTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CancelledAt != 0 {
+		n += 1 + sovTx(uint64(m.CancelledAt))
+	}
+	return n
+}
+
+func (m *MsgRegisterMerchant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LegalName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContactUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LogoHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateMerchant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.LegalName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContactUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LogoHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VerificationTier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateMerchant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeactivateMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.DeactivatedAt != 0 {
+		n += 1 + sovTx(uint64(m.DeactivatedAt))
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.AttestationCount != 0 {
+		n += 1 + sovTx(uint64(m.AttestationCount))
+	}
+	return n
+}
+
+func (m *MsgRevokeAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.AttestationCount != 0 {
+		n += 1 + sovTx(uint64(m.AttestationCount))
+	}
+	return n
+}
+
+func (m *MsgCreateMintSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TrancheAmount != 0 {
+		n += 1 + sovTx(uint64(m.TrancheAmount))
+	}
+	if m.TotalAmount != 0 {
+		n += 1 + sovTx(uint64(m.TotalAmount))
+	}
+	l = len(m.Cadence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateMintScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelMintSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelMintScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleasedAmount != 0 {
+		n += 1 + sovTx(uint64(m.ReleasedAmount))
+	}
+	if m.CancelledAmount != 0 {
+		n += 1 + sovTx(uint64(m.CancelledAmount))
+	}
+	return n
+}
+
+func (m *MsgSetMintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPerTx != 0 {
+		n += 1 + sovTx(uint64(m.MaxPerTx))
+	}
+	if m.MaxPerRollingDay != 0 {
+		n += 1 + sovTx(uint64(m.MaxPerRollingDay))
+	}
+	if m.MaxPerRollupDate != 0 {
+		n += 1 + sovTx(uint64(m.MaxPerRollupDate))
+	}
+	return n
+}
+
+func (m *MsgSetMintRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintRateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Pending {
+		n += 2
+	}
+	if m.EffectiveAt != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveAt))
+	}
+	return n
+}
+
+func (m *MsgPauseToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgPauseTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnpauseTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemainingScopes) > 0 {
+		for _, s := range m.RemainingScopes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFreezeAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecoveryId != 0 {
+		n += 1 + sovTx(uint64(m.RecoveryId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	if m.LinkRecovery {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgFreezeAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTokenIBCPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetTokenIBCPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetIBCRecorder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetIBCRecorderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterMerchantICA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterMerchantICAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Ica.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendMerchantICATx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *MsgSendMerchantICATxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgForwardMerchantTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AllocationKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *MsgForwardMerchantTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Forward.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetIBCRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxOutflow != 0 {
+		n += 1 + sovTx(uint64(m.MaxOutflow))
+	}
+	if m.MaxInflow != 0 {
+		n += 1 + sovTx(uint64(m.MaxInflow))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovTx(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *MsgSetIBCRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveIBCRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveIBCRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetIBCRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetIBCRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TokenUnits != 0 {
+		n += 1 + sovTx(uint64(m.TokenUnits))
+	}
+	if m.UtokenUnits != 0 {
+		n += 1 + sovTx(uint64(m.UtokenUnits))
+	}
+	if m.MaxUtokenPerTx != 0 {
+		n += 1 + sovTx(uint64(m.MaxUtokenPerTx))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundGasPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgFundGasPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasPool.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawGasPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawGasPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasPool.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetClaimSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DailyBudget != 0 {
+		n += 1 + sovTx(uint64(m.DailyBudget))
+	}
+	if m.PerUserCap != 0 {
+		n += 1 + sovTx(uint64(m.PerUserCap))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetClaimSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCreatorallowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCreatorallowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCreatorallowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			m.MaxTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			}
+			m.MaxTotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCreatorallowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCreatorallowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCreatorallowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCreatorallowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			m.MaxTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			}
+			m.MaxTotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCreatorallowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteCreatorallowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteCreatorallowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteCreatorallowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteCreatorallowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVerifiedtoken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVerifiedtoken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			m.MintedSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizureOptIn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SeizureOptIn = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryGroupPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryGroupPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryTimelockHours", wireType)
+			}
+			m.RecoveryTimelockHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryTimelockHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, TokenDenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVerifiedtokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVerifiedtoken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVerifiedtoken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			m.MintedSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizureOptIn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SeizureOptIn = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryGroupPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryGroupPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryTimelockHours", wireType)
+			}
+			m.RecoveryTimelockHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryTimelockHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, TokenDenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVerifiedtokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataChangePending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MetadataChangePending = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
+			}
+			m.EffectiveAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenounceTokenAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenounceTokenAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenounceTokenAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenounceTokenAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenounceTokenAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenounceTokenAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdminRenounced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMerchantIncentiveRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMerchantIncentiveRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMerchantIncentiveRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveStakersBps", wireType)
+			}
+			m.MerchantIncentiveStakersBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantIncentiveStakersBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveTreasuryBps", wireType)
+			}
+			m.MerchantIncentiveTreasuryBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantIncentiveTreasuryBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMerchantIncentiveRoutingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMerchantIncentiveRoutingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMerchantIncentiveRoutingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveStakersBps", wireType)
+			}
+			m.MerchantIncentiveStakersBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantIncentiveStakersBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveTreasuryBps", wireType)
+			}
+			m.MerchantIncentiveTreasuryBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantIncentiveTreasuryBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteVerifiedtoken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteVerifiedtoken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteVerifiedtoken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteVerifiedtokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRewardaccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRewardaccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRollupDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRollupDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRewardaccrualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRewardaccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRewardaccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRollupDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRollupDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRewardaccrualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRewardaccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRewardaccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRewardaccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRewardaccrualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintVerifiedToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintVerifiedToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintVerifiedTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintVerifiedTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintVerifiedTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			m.MintedSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintVerifiedTokenBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintVerifiedTokenBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintVerifiedTokenBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, MintRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintVerifiedTokenBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintVerifiedTokenBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintVerifiedTokenBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			m.MintedSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			m.TotalAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, MintRecipient{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountClaimed", wireType)
+			}
+			m.AmountClaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountClaimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountFunded", wireType)
+			}
+			m.AmountFunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountFunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordRewardAccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordRewardAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordRewardAccrualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordRewardAccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountAdded", wireType)
+			}
+			m.AmountAdded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountAdded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			m.TotalAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollupDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollupDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecordMerchantAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordMerchantAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordMerchantAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityScore", wireType)
+			}
+			m.ActivityScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivityScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketCAmount", wireType)
+			}
+			m.BucketCAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketCAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordMerchantAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordMerchantAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityScore", wireType)
+			}
+			m.ActivityScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivityScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketCAmount", wireType)
+			}
+			m.BucketCAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketCAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakersAmount", wireType)
+			}
+			m.StakersAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakersAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAmount", wireType)
+			}
+			m.TreasuryAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveStakersBps", wireType)
+			}
+			m.MerchantIncentiveStakersBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantIncentiveStakersBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveTreasuryBps", wireType)
+			}
+			m.MerchantIncentiveTreasuryBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantIncentiveTreasuryBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Updated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueueRecoveryTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueueRecoveryTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgQueueRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueueRecoveryTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueueRecoveryTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			m.ExecuteAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteRecoveryTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteRecoveryTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteRecoveryTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteRecoveryTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteRecoveryTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			m.ExecutedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecoveryTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecoveryTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecoveryTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCancelRecoveryTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CancelledAt != 0 {
-		n += 1 + sovTx(uint64(m.CancelledAt))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgCancelRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecoveryTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecoveryTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
+			}
+			m.CancelledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6129,15 +20281,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterMerchant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterMerchant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6165,13 +20317,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6181,24 +20333,119 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LogoHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6221,7 +20468,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterMerchantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6244,12 +20491,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterMerchantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6271,7 +20537,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCreatorallowlist) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6294,10 +20560,10 @@ func (m *MsgCreateCreatorallowlist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCreatorallowlist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMerchant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCreatorallowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMerchant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6333,8 +20599,27 @@ func (m *MsgCreateCreatorallowlist) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6362,13 +20647,13 @@ func (m *MsgCreateCreatorallowlist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.LegalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6378,115 +20663,27 @@ func (m *MsgCreateCreatorallowlist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCreatorallowlistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateCreatorallowlist) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCreatorallowlist: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCreatorallowlist: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6514,11 +20711,11 @@ func (m *MsgUpdateCreatorallowlist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ContactUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6546,13 +20743,13 @@ func (m *MsgUpdateCreatorallowlist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.LogoHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationTier", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6562,12 +20759,24 @@ func (m *MsgUpdateCreatorallowlist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationTier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6589,7 +20798,7 @@ func (m *MsgUpdateCreatorallowlist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMerchantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6612,10 +20821,10 @@ func (m *MsgUpdateCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCreatorallowlistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMerchantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6639,7 +20848,7 @@ func (m *MsgUpdateCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteCreatorallowlist) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivateMerchant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6662,10 +20871,10 @@ func (m *MsgDeleteCreatorallowlist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteCreatorallowlist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivateMerchant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteCreatorallowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivateMerchant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6701,10 +20910,10 @@ func (m *MsgDeleteCreatorallowlist) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6714,24 +20923,11 @@ func (m *MsgDeleteCreatorallowlist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6753,7 +20949,7 @@ func (m *MsgDeleteCreatorallowlist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivateMerchantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6772,16 +20968,54 @@ func (m *MsgDeleteCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteCreatorallowlistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteCreatorallowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedAt", wireType)
+			}
+			m.DeactivatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6803,7 +21037,7 @@ func (m *MsgDeleteCreatorallowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6826,10 +21060,10 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVerifiedtoken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVerifiedtoken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6898,7 +21132,7 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6926,13 +21160,13 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.EvidenceUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6942,91 +21176,64 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7054,47 +21261,9 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
-			}
-			m.MaxSupply = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxSupply |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
-			}
-			m.MintedSupply = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MintedSupply |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
@@ -7114,11 +21283,11 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Verified = bool(v != 0)
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeizureOptIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationCount", wireType)
 			}
-			var v int
+			m.AttestationCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7128,15 +21297,64 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AttestationCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SeizureOptIn = bool(v != 0)
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryGroupPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7164,13 +21382,13 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveryGroupPolicy = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryTimelockHours", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.RecoveryTimelockHours = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7180,11 +21398,24 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecoveryTimelockHours |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7206,7 +21437,7 @@ func (m *MsgCreateVerifiedtoken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAttestationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7229,10 +21460,10 @@ func (m *MsgCreateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVerifiedtokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAttestationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7267,6 +21498,45 @@ func (m *MsgCreateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationCount", wireType)
+			}
+			m.AttestationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7288,7 +21558,7 @@ func (m *MsgCreateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMintSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7311,10 +21581,10 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVerifiedtoken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMintSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVerifiedtoken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7383,7 +21653,7 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7411,13 +21681,13 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheAmount", wireType)
 			}
-			var stringLen uint64
+			m.TrancheAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7427,29 +21697,16 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TrancheAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
-			var stringLen uint64
+			m.TotalAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7459,27 +21716,14 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cadence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7507,11 +21751,30 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Cadence = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7539,51 +21802,63 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.StartDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxSupply = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxSupply |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MintedSupply = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MintedSupply |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMintScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMintScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMintScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7593,17 +21868,66 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Verified = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeizureOptIn", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelMintSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelMintSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelMintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7613,15 +21937,27 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SeizureOptIn = bool(v != 0)
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryGroupPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7649,13 +21985,13 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveryGroupPolicy = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryTimelockHours", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.RecoveryTimelockHours = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7665,7 +22001,7 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecoveryTimelockHours |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7691,7 +22027,7 @@ func (m *MsgUpdateVerifiedtoken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMintScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7714,12 +22050,50 @@ func (m *MsgUpdateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVerifiedtokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMintScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMintScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			m.ReleasedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+			}
+			m.CancelledAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7741,7 +22115,7 @@ func (m *MsgUpdateVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenounceTokenAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMintRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7764,10 +22138,10 @@ func (m *MsgRenounceTokenAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenounceTokenAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMintRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenounceTokenAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7834,6 +22208,63 @@ func (m *MsgRenounceTokenAdmin) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerTx", wireType)
+			}
+			m.MaxPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerRollingDay", wireType)
+			}
+			m.MaxPerRollingDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerRollingDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerRollupDate", wireType)
+			}
+			m.MaxPerRollupDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerRollupDate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7855,7 +22286,7 @@ func (m *MsgRenounceTokenAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenounceTokenAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMintRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7878,17 +22309,17 @@ func (m *MsgRenounceTokenAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenounceTokenAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMintRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenounceTokenAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMintRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7898,27 +22329,28 @@ func (m *MsgRenounceTokenAdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.MintRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminRenounced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -7935,7 +22367,26 @@ func (m *MsgRenounceTokenAdminResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AdminRenounced = bool(v != 0)
+			m.Pending = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
+			}
+			m.EffectiveAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7957,7 +22408,7 @@ func (m *MsgRenounceTokenAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMerchantIncentiveRouting) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7980,10 +22431,10 @@ func (m *MsgSetMerchantIncentiveRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMerchantIncentiveRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMerchantIncentiveRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8051,29 +22502,10 @@ func (m *MsgSetMerchantIncentiveRouting) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveStakersBps", wireType)
-			}
-			m.MerchantIncentiveStakersBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MerchantIncentiveStakersBps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveTreasuryBps", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			m.MerchantIncentiveTreasuryBps = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8083,64 +22515,27 @@ func (m *MsgSetMerchantIncentiveRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MerchantIncentiveTreasuryBps |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetMerchantIncentiveRoutingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMerchantIncentiveRoutingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMerchantIncentiveRoutingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8168,32 +22563,13 @@ func (m *MsgSetMerchantIncentiveRoutingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveStakersBps", wireType)
-			}
-			m.MerchantIncentiveStakersBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MerchantIncentiveStakersBps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveTreasuryBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.MerchantIncentiveTreasuryBps = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8203,7 +22579,7 @@ func (m *MsgSetMerchantIncentiveRoutingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MerchantIncentiveTreasuryBps |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8229,7 +22605,7 @@ func (m *MsgSetMerchantIncentiveRoutingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteVerifiedtoken) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8252,10 +22628,60 @@ func (m *MsgDeleteVerifiedtoken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteVerifiedtoken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteVerifiedtoken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8322,6 +22748,38 @@ func (m *MsgDeleteVerifiedtoken) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8343,7 +22801,7 @@ func (m *MsgDeleteVerifiedtoken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8366,12 +22824,44 @@ func (m *MsgDeleteVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteVerifiedtokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteVerifiedtokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingScopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingScopes = append(m.RemainingScopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8393,7 +22883,7 @@ func (m *MsgDeleteVerifiedtokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8416,10 +22906,10 @@ func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRewardaccrual: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRewardaccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8456,7 +22946,7 @@ func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8484,7 +22974,7 @@ func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -8519,8 +23009,27 @@ func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
+			}
+			m.RecoveryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8548,13 +23057,13 @@ func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.Amount = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8564,16 +23073,16 @@ func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRollupDate", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkRecovery", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8583,74 +23092,12 @@ func (m *MsgCreateRewardaccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LastRollupDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateRewardaccrualResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRewardaccrualResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.LinkRecovery = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8672,7 +23119,7 @@ func (m *MsgCreateRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8695,49 +23142,17 @@ func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRewardaccrual: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRewardaccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8747,27 +23162,64 @@ func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8795,9 +23247,9 @@ func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -8829,28 +23281,9 @@ func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRollupDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8878,7 +23311,7 @@ func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastRollupDate = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8901,7 +23334,7 @@ func (m *MsgUpdateRewardaccrual) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8924,10 +23357,10 @@ func (m *MsgUpdateRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRewardaccrualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8951,7 +23384,7 @@ func (m *MsgUpdateRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRewardaccrual) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTokenIBCPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8974,10 +23407,10 @@ func (m *MsgDeleteRewardaccrual) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRewardaccrual: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTokenIBCPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRewardaccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTokenIBCPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9014,7 +23447,7 @@ func (m *MsgDeleteRewardaccrual) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9042,7 +23475,71 @@ func (m *MsgDeleteRewardaccrual) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9065,7 +23562,7 @@ func (m *MsgDeleteRewardaccrual) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRewardaccrualResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTokenIBCPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9088,12 +23585,45 @@ func (m *MsgDeleteRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRewardaccrualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTokenIBCPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRewardaccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTokenIBCPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9115,7 +23645,7 @@ func (m *MsgDeleteRewardaccrualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIBCRecorder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9138,10 +23668,10 @@ func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintVerifiedToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIBCRecorder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintVerifiedToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIBCRecorder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9178,7 +23708,7 @@ func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9206,11 +23736,11 @@ func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9238,13 +23768,13 @@ func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Amount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9254,11 +23784,12 @@ func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9280,86 +23811,35 @@ func (m *MsgMintVerifiedToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintVerifiedTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIBCRecorderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintVerifiedTokenResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintVerifiedTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
-			}
-			m.MintedSupply = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MintedSupply |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIBCRecorderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIBCRecorderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9381,7 +23861,7 @@ func (m *MsgMintVerifiedTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterMerchantICA) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9404,10 +23884,10 @@ func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterMerchantICA: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterMerchantICA: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9474,6 +23954,38 @@ func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9495,7 +24007,7 @@ func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterMerchantICAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9518,17 +24030,17 @@ func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterMerchantICAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterMerchantICAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ica", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9538,75 +24050,25 @@ func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Ica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountClaimed", wireType)
-			}
-			m.AmountClaimed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AmountClaimed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9628,7 +24090,7 @@ func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSendMerchantICATx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9651,10 +24113,10 @@ func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendMerchantICATx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendMerchantICATx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9722,10 +24184,10 @@ func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9735,64 +24197,61 @@ func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosTx", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CosmosTx = append(m.CosmosTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.CosmosTx == nil {
+				m.CosmosTx = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9820,13 +24279,13 @@ func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9836,48 +24295,66 @@ func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendMerchantICATxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountFunded", wireType)
-			}
-			m.AmountFunded = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AmountFunded |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBalance", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendMerchantICATxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendMerchantICATxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9887,24 +24364,11 @@ func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewBalance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9926,7 +24390,7 @@ func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
+func (m *MsgForwardMerchantTreasury) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9949,10 +24413,10 @@ func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordRewardAccrual: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForwardMerchantTreasury: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordRewardAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForwardMerchantTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9989,7 +24453,7 @@ func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10017,11 +24481,11 @@ func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.AllocationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10049,13 +24513,13 @@ func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannel", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10065,16 +24529,98 @@ func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForwardMerchantTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForwardMerchantTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForwardMerchantTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10084,23 +24630,24 @@ func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Date = string(dAtA[iNdEx:postIndex])
+			if err := m.Forward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10123,7 +24670,7 @@ func (m *MsgRecordRewardAccrual) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIBCRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10146,15 +24693,15 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordRewardAccrualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIBCRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordRewardAccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIBCRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10182,11 +24729,11 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10214,11 +24761,11 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10246,13 +24793,13 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountAdded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
 			}
-			m.AmountAdded = 0
+			m.MaxOutflow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10262,16 +24809,16 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AmountAdded |= uint64(b&0x7F) << shift
+				m.MaxOutflow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflow", wireType)
 			}
-			m.TotalAmount = 0
+			m.MaxInflow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10281,16 +24828,85 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalAmount |= uint64(b&0x7F) << shift
+				m.MaxInflow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIBCRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIBCRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIBCRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollupDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10300,23 +24916,24 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollupDate = string(dAtA[iNdEx:postIndex])
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10339,7 +24956,7 @@ func (m *MsgRecordRewardAccrualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecordMerchantAllocation) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveIBCRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10362,15 +24979,15 @@ func (m *MsgRecordMerchantAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordMerchantAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveIBCRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordMerchantAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveIBCRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10398,11 +25015,11 @@ func (m *MsgRecordMerchantAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10430,11 +25047,11 @@ func (m *MsgRecordMerchantAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Date = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10462,46 +25079,58 @@ func (m *MsgRecordMerchantAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityScore", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ActivityScore = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActivityScore |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketCAmount", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BucketCAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BucketCAmount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveIBCRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveIBCRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveIBCRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10523,7 +25152,7 @@ func (m *MsgRecordMerchantAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetIBCRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10546,15 +25175,15 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordMerchantAllocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetIBCRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordMerchantAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetIBCRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10582,11 +25211,11 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10614,11 +25243,11 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Date = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10646,13 +25275,113 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityScore", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ActivityScore = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetIBCRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetIBCRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetIBCRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10662,16 +25391,29 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivityScore |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketCAmount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.BucketCAmount = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10681,16 +25423,29 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketCAmount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakersAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUnits", wireType)
 			}
-			m.StakersAmount = 0
+			m.TokenUnits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10700,16 +25455,16 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakersAmount |= uint64(b&0x7F) << shift
+				m.TokenUnits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UtokenUnits", wireType)
 			}
-			m.TreasuryAmount = 0
+			m.UtokenUnits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10719,16 +25474,16 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TreasuryAmount |= uint64(b&0x7F) << shift
+				m.UtokenUnits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveStakersBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUtokenPerTx", wireType)
 			}
-			m.MerchantIncentiveStakersBps = 0
+			m.MaxUtokenPerTx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10738,16 +25493,16 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MerchantIncentiveStakersBps |= uint64(b&0x7F) << shift
+				m.MaxUtokenPerTx |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerchantIncentiveTreasuryBps", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
-			m.MerchantIncentiveTreasuryBps = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10757,14 +25512,27 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MerchantIncentiveTreasuryBps |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10781,7 +25549,7 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Updated = bool(v != 0)
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10803,7 +25571,7 @@ func (m *MsgRecordMerchantAllocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10826,17 +25594,17 @@ func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgQueueRecoveryTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgQueueRecoveryTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10846,27 +25614,78 @@ func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundGasPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundGasPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundGasPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10894,11 +25713,11 @@ func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10926,13 +25745,13 @@ func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10942,29 +25761,66 @@ func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundGasPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.Amount = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundGasPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundGasPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPool", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10974,11 +25830,25 @@ func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11000,7 +25870,7 @@ func (m *MsgQueueRecoveryTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgQueueRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawGasPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11023,17 +25893,81 @@ func (m *MsgQueueRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgQueueRecoveryTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawGasPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgQueueRecoveryTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawGasPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Id = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11043,14 +25977,14 @@ func (m *MsgQueueRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11078,27 +26012,8 @@ func (m *MsgQueueRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
-			}
-			m.ExecuteAfter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecuteAfter |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11120,7 +26035,7 @@ func (m *MsgQueueRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteRecoveryTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawGasPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11143,17 +26058,17 @@ func (m *MsgExecuteRecoveryTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteRecoveryTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawGasPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteRecoveryTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawGasPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11163,43 +26078,25 @@ func (m *MsgExecuteRecoveryTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.GasPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11221,7 +26118,7 @@ func (m *MsgExecuteRecoveryTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetClaimSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11244,34 +26141,15 @@ func (m *MsgExecuteRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteRecoveryTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetClaimSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteRecoveryTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetClaimSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11299,13 +26177,13 @@ func (m *MsgExecuteRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.ExecutedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11315,66 +26193,29 @@ func (m *MsgExecuteRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutedAt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelRecoveryTransfer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRecoveryTransfer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRecoveryTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyBudget", wireType)
 			}
-			var stringLen uint64
+			m.DailyBudget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11384,29 +26225,16 @@ func (m *MsgCancelRecoveryTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DailyBudget |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserCap", wireType)
 			}
-			m.Id = 0
+			m.PerUserCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11416,14 +26244,14 @@ func (m *MsgCancelRecoveryTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PerUserCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11451,8 +26279,28 @@ func (m *MsgCancelRecoveryTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11474,7 +26322,7 @@ func (m *MsgCancelRecoveryTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetClaimSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11497,36 +26345,17 @@ func (m *MsgCancelRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRecoveryTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetClaimSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRecoveryTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetClaimSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11536,43 +26365,25 @@ func (m *MsgCancelRecoveryTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
-			}
-			m.CancelledAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CancelledAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,14 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Verifiedtoken defines the Verifiedtoken message.
 type Verifiedtoken struct {
-	Denom                        string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Issuer                       string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	Name                         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol                       string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Description                  string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Website                      string `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
-	MaxSupply                    uint64 `protobuf:"varint,7,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
-	MintedSupply                 uint64 `protobuf:"varint,8,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
+	Denom        string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Issuer       string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol       string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Description  string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Website      string `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
+	MaxSupply    uint64 `protobuf:"varint,7,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	MintedSupply uint64 `protobuf:"varint,8,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
+	// verified is maintained by the module: it is true while the token holds at least
+	// min_verifier_attestations unexpired verifier attestations.
 	Verified                     bool   `protobuf:"varint,9,opt,name=verified,proto3" json:"verified,omitempty"`
 	SeizureOptIn                 bool   `protobuf:"varint,10,opt,name=seizure_opt_in,json=seizureOptIn,proto3" json:"seizure_opt_in,omitempty"`
 	RecoveryGroupPolicy          string `protobuf:"bytes,11,opt,name=recovery_group_policy,json=recoveryGroupPolicy,proto3" json:"recovery_group_policy,omitempty"`
@@ -40,6 +43,19 @@ type Verifiedtoken struct {
 	AdminRenounced               bool   `protobuf:"varint,14,opt,name=admin_renounced,json=adminRenounced,proto3" json:"admin_renounced,omitempty"`
 	MerchantIncentiveStakersBps  uint64 `protobuf:"varint,15,opt,name=merchant_incentive_stakers_bps,json=merchantIncentiveStakersBps,proto3" json:"merchant_incentive_stakers_bps,omitempty"`
 	MerchantIncentiveTreasuryBps uint64 `protobuf:"varint,16,opt,name=merchant_incentive_treasury_bps,json=merchantIncentiveTreasuryBps,proto3" json:"merchant_incentive_treasury_bps,omitempty"`
+	// merchant_id links the token to its Merchant profile; zero means unlinked.
+	MerchantId uint64 `protobuf:"varint,17,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	// metadata_version is the latest recorded MetadataVersion for the token.
+	MetadataVersion uint64 `protobuf:"varint,18,opt,name=metadata_version,json=metadataVersion,proto3" json:"metadata_version,omitempty"`
+	// decimals is the exponent of the display unit (the subdenom) over the base denom.
+	// It cannot change once minting has started.
+	Decimals uint32 `protobuf:"varint,19,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// denom_units are optional extra bank denom units published next to the base and
+	// display units. They cannot change once minting has started.
+	DenomUnits []TokenDenomUnit `protobuf:"bytes,20,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units"`
+	// scheduled_supply is the amount still to be released by active mint schedules.
+	// It counts against max_supply together with minted_supply.
+	ScheduledSupply uint64 `protobuf:"varint,21,opt,name=scheduled_supply,json=scheduledSupply,proto3" json:"scheduled_supply,omitempty"`
 }
 
 func (m *Verifiedtoken) Reset()         { *m = Verifiedtoken{} }
@@ -187,8 +203,105 @@ func (m *Verifiedtoken) GetMerchantIncentiveTreasuryBps() uint64 {
 	return 0
 }
 
+func (m *Verifiedtoken) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+func (m *Verifiedtoken) GetMetadataVersion() uint64 {
+	if m != nil {
+		return m.MetadataVersion
+	}
+	return 0
+}
+
+func (m *Verifiedtoken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *Verifiedtoken) GetDenomUnits() []TokenDenomUnit {
+	if m != nil {
+		return m.DenomUnits
+	}
+	return nil
+}
+
+func (m *Verifiedtoken) GetScheduledSupply() uint64 {
+	if m != nil {
+		return m.ScheduledSupply
+	}
+	return 0
+}
+
+// TokenDenomUnit is an extra denomination unit of a verified token.
+type TokenDenomUnit struct {
+	Denom    string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Exponent uint32   `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Aliases  []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (m *TokenDenomUnit) Reset()         { *m = TokenDenomUnit{} }
+func (m *TokenDenomUnit) String() string { return proto.CompactTextString(m) }
+func (*TokenDenomUnit) ProtoMessage()    {}
+func (*TokenDenomUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5d0e6c0dc00e30d, []int{1}
+}
+func (m *TokenDenomUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenDenomUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenDenomUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDenomUnit.Merge(m, src)
+}
+func (m *TokenDenomUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenDenomUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDenomUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDenomUnit proto.InternalMessageInfo
+
+func (m *TokenDenomUnit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenDenomUnit) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *TokenDenomUnit) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Verifiedtoken)(nil), "tokenchain.loyalty.v1.Verifiedtoken")
+	proto.RegisterType((*TokenDenomUnit)(nil), "tokenchain.loyalty.v1.TokenDenomUnit")
 }
 
 func init() {
@@ -196,36 +309,46 @@ func init() {
 }
 
 var fileDescriptor_d5d0e6c0dc00e30d = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x63, 0x48, 0xd3, 0x64, 0x9a, 0xa4, 0x68, 0xa0, 0x30, 0x2a, 0x60, 0x22, 0x40, 0x22,
-	0x6c, 0x12, 0x15, 0x10, 0x0f, 0x10, 0x84, 0xa0, 0x2b, 0x50, 0x5a, 0xb1, 0x60, 0x63, 0x4d, 0xec,
-	0x0b, 0x19, 0xc5, 0xf3, 0xa3, 0x99, 0xb1, 0x89, 0x79, 0x0a, 0x1e, 0x8b, 0x65, 0x97, 0x2c, 0x51,
-	0x22, 0xf1, 0x1c, 0xc8, 0xd7, 0x76, 0x88, 0x44, 0x77, 0x3e, 0xe7, 0x7c, 0x3e, 0xba, 0x73, 0x75,
-	0xc9, 0x73, 0xaf, 0x57, 0xa0, 0xe2, 0x25, 0x17, 0x6a, 0x9a, 0xea, 0x82, 0xa7, 0xbe, 0x98, 0xe6,
-	0x67, 0xd3, 0x1c, 0xac, 0xf8, 0x22, 0x20, 0xc1, 0x74, 0x62, 0xac, 0xf6, 0x9a, 0x9e, 0xfc, 0x43,
-	0x27, 0x35, 0x3a, 0xc9, 0xcf, 0x1e, 0xff, 0x69, 0x93, 0xc1, 0xa7, 0x7d, 0x9c, 0xde, 0x21, 0x07,
-	0x09, 0x28, 0x2d, 0x59, 0x30, 0x0a, 0xc6, 0xbd, 0x79, 0x25, 0xe8, 0x5d, 0xd2, 0x11, 0xce, 0x65,
-	0x60, 0xd9, 0x0d, 0xb4, 0x6b, 0x45, 0x29, 0x69, 0x2b, 0x2e, 0x81, 0xdd, 0x44, 0x17, 0xbf, 0x4b,
-	0xd6, 0x15, 0x72, 0xa1, 0x53, 0xd6, 0xae, 0xd8, 0x4a, 0xd1, 0x11, 0x39, 0x4a, 0xc0, 0xc5, 0x56,
-	0x18, 0x2f, 0xb4, 0x62, 0x07, 0x18, 0xee, 0x5b, 0x94, 0x91, 0xc3, 0x6f, 0xb0, 0x70, 0xc2, 0x03,
-	0xeb, 0x60, 0xda, 0x48, 0xfa, 0x90, 0x10, 0xc9, 0xd7, 0x91, 0xcb, 0x8c, 0x49, 0x0b, 0x76, 0x38,
-	0x0a, 0xc6, 0xed, 0x79, 0x4f, 0xf2, 0xf5, 0x05, 0x1a, 0xf4, 0x09, 0x19, 0x48, 0xa1, 0x3c, 0x24,
-	0x0d, 0xd1, 0x45, 0xa2, 0x5f, 0x99, 0x35, 0x74, 0x4a, 0xba, 0xcd, 0x66, 0x58, 0x6f, 0x14, 0x8c,
-	0xbb, 0xf3, 0x9d, 0xa6, 0x4f, 0xc9, 0xd0, 0x81, 0xf8, 0x9e, 0x59, 0x88, 0xb4, 0xf1, 0x91, 0x50,
-	0x8c, 0x20, 0xd1, 0xaf, 0xdd, 0x0f, 0xc6, 0x9f, 0x2b, 0xfa, 0x82, 0x9c, 0x58, 0x88, 0x75, 0x0e,
-	0xb6, 0x88, 0xbe, 0x5a, 0x9d, 0x99, 0xc8, 0xe8, 0x54, 0xc4, 0x05, 0x3b, 0xc2, 0x69, 0x6f, 0x37,
-	0xe1, 0xbb, 0x32, 0xfb, 0x88, 0x11, 0x7d, 0x4d, 0xee, 0xed, 0xfe, 0xf1, 0x42, 0x42, 0xaa, 0xe3,
-	0x55, 0xb4, 0xd4, 0x99, 0x75, 0xac, 0x8f, 0x43, 0xee, 0x2a, 0x2f, 0xeb, 0xf4, 0x7d, 0x19, 0x96,
-	0xbb, 0x88, 0x2d, 0x70, 0xaf, 0x2d, 0x1b, 0x54, 0xbb, 0xa8, 0x25, 0x7d, 0x46, 0x8e, 0x79, 0x22,
-	0x85, 0x8a, 0x2c, 0x28, 0x9d, 0xa9, 0x18, 0x12, 0x36, 0xc4, 0x61, 0x87, 0x68, 0xcf, 0x1b, 0x97,
-	0xbe, 0x21, 0xa1, 0x04, 0x1b, 0x2f, 0xb9, 0x2a, 0x5f, 0x14, 0x83, 0xf2, 0x22, 0x87, 0xc8, 0x79,
-	0xbe, 0x02, 0xeb, 0xa2, 0x85, 0x71, 0xec, 0x18, 0x27, 0xb8, 0xdf, 0x50, 0xe7, 0x0d, 0x74, 0x51,
-	0x31, 0x33, 0xe3, 0xe8, 0x5b, 0xf2, 0xe8, 0x9a, 0x12, 0x6f, 0x81, 0xbb, 0xcc, 0x16, 0xd8, 0x72,
-	0x0b, 0x5b, 0x1e, 0xfc, 0xd7, 0x72, 0x59, 0x43, 0x33, 0xe3, 0x66, 0xaf, 0x7e, 0x6e, 0xc2, 0xe0,
-	0x6a, 0x13, 0x06, 0xbf, 0x37, 0x61, 0xf0, 0x63, 0x1b, 0xb6, 0xae, 0xb6, 0x61, 0xeb, 0xd7, 0x36,
-	0x6c, 0x7d, 0x3e, 0xdd, 0x3b, 0xe2, 0xf5, 0xee, 0x8c, 0x7d, 0x61, 0xc0, 0x2d, 0x3a, 0x78, 0xbc,
-	0x2f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xfa, 0x15, 0xf8, 0xe9, 0x02, 0x00, 0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdf, 0x6e, 0xd3, 0x3c,
+	0x18, 0xc6, 0x9b, 0xaf, 0x5d, 0xd7, 0xb9, 0x6b, 0xb7, 0xcf, 0xdb, 0xc0, 0x2a, 0x90, 0x45, 0x03,
+	0x44, 0x77, 0xd2, 0x6a, 0x03, 0x71, 0x01, 0x03, 0x04, 0x93, 0x90, 0x40, 0xd9, 0xd8, 0x01, 0x42,
+	0x8a, 0xdc, 0xe4, 0x65, 0xb5, 0x96, 0xd8, 0x91, 0xed, 0x84, 0x86, 0xab, 0xe0, 0xae, 0xd8, 0xe1,
+	0x0e, 0x39, 0x42, 0x68, 0xbb, 0x11, 0x64, 0xe7, 0xcf, 0x86, 0x18, 0x67, 0x79, 0x9e, 0xf7, 0xe7,
+	0x47, 0x6f, 0x6c, 0xbf, 0x46, 0xbb, 0x5a, 0x9c, 0x01, 0x0f, 0xe7, 0x94, 0xf1, 0x69, 0x2c, 0x0a,
+	0x1a, 0xeb, 0x62, 0x9a, 0xef, 0x4d, 0x73, 0x90, 0xec, 0x33, 0x83, 0xc8, 0x56, 0x27, 0xa9, 0x14,
+	0x5a, 0xe0, 0xad, 0x6b, 0x74, 0x52, 0xa1, 0x93, 0x7c, 0x6f, 0xb4, 0x79, 0x2a, 0x4e, 0x85, 0x25,
+	0xa6, 0xe6, 0xab, 0x84, 0x77, 0xbe, 0x77, 0xd1, 0xe0, 0xe4, 0x66, 0x08, 0xde, 0x44, 0x4b, 0x11,
+	0x70, 0x91, 0x10, 0xc7, 0x73, 0xc6, 0x2b, 0x7e, 0x29, 0xf0, 0x1d, 0xd4, 0x65, 0x4a, 0x65, 0x20,
+	0xc9, 0x7f, 0xd6, 0xae, 0x14, 0xc6, 0xa8, 0xc3, 0x69, 0x02, 0xa4, 0x6d, 0x5d, 0xfb, 0x6d, 0x58,
+	0x55, 0x24, 0x33, 0x11, 0x93, 0x4e, 0xc9, 0x96, 0x0a, 0x7b, 0xa8, 0x1f, 0x81, 0x0a, 0x25, 0x4b,
+	0x35, 0x13, 0x9c, 0x2c, 0xd9, 0xe2, 0x4d, 0x0b, 0x13, 0xb4, 0xfc, 0x05, 0x66, 0x8a, 0x69, 0x20,
+	0x5d, 0x5b, 0xad, 0x25, 0x7e, 0x80, 0x50, 0x42, 0x17, 0x81, 0xca, 0xd2, 0x34, 0x2e, 0xc8, 0xb2,
+	0xe7, 0x8c, 0x3b, 0xfe, 0x4a, 0x42, 0x17, 0x47, 0xd6, 0xc0, 0x0f, 0xd1, 0x20, 0x61, 0x5c, 0x43,
+	0x54, 0x13, 0x3d, 0x4b, 0xac, 0x96, 0x66, 0x05, 0x8d, 0x50, 0xaf, 0xde, 0x2f, 0xb2, 0xe2, 0x39,
+	0xe3, 0x9e, 0xdf, 0x68, 0xfc, 0x08, 0x0d, 0x15, 0xb0, 0xaf, 0x99, 0x84, 0x40, 0xa4, 0x3a, 0x60,
+	0x9c, 0x20, 0x4b, 0xac, 0x56, 0xee, 0xbb, 0x54, 0x1f, 0x72, 0xbc, 0x8f, 0xb6, 0x24, 0x84, 0x22,
+	0x07, 0x59, 0x04, 0xa7, 0x52, 0x64, 0x69, 0x90, 0x8a, 0x98, 0x85, 0x05, 0xe9, 0xdb, 0x6e, 0x37,
+	0xea, 0xe2, 0x6b, 0x53, 0x7b, 0x6f, 0x4b, 0xf8, 0x39, 0xba, 0xdb, 0xac, 0xd1, 0x2c, 0x81, 0x58,
+	0x84, 0x67, 0xc1, 0x5c, 0x64, 0x52, 0x91, 0x55, 0xdb, 0x64, 0x13, 0x79, 0x5c, 0x55, 0xdf, 0x98,
+	0xa2, 0xd9, 0x8b, 0x50, 0x02, 0xd5, 0x42, 0x92, 0x41, 0xb9, 0x17, 0x95, 0xc4, 0x4f, 0xd0, 0x1a,
+	0x8d, 0x12, 0xc6, 0x03, 0x09, 0x5c, 0x64, 0x3c, 0x84, 0x88, 0x0c, 0x6d, 0xb3, 0x43, 0x6b, 0xfb,
+	0xb5, 0x8b, 0x5f, 0x20, 0x37, 0x01, 0x19, 0xce, 0x29, 0x37, 0x7f, 0x14, 0x02, 0xd7, 0x2c, 0x87,
+	0x40, 0x69, 0x7a, 0x06, 0x52, 0x05, 0xb3, 0x54, 0x91, 0x35, 0xdb, 0xc1, 0xbd, 0x9a, 0x3a, 0xac,
+	0xa1, 0xa3, 0x92, 0x39, 0x48, 0x15, 0x7e, 0x85, 0xb6, 0x6f, 0x09, 0xd1, 0x12, 0xa8, 0xca, 0x64,
+	0x61, 0x53, 0xd6, 0x6d, 0xca, 0xfd, 0xbf, 0x52, 0x8e, 0x2b, 0xc8, 0xc4, 0x6c, 0xa3, 0xfe, 0x75,
+	0x4c, 0x44, 0xfe, 0xb7, 0x4b, 0x50, 0xb3, 0x24, 0xc2, 0xbb, 0x68, 0x3d, 0x01, 0x4d, 0x23, 0xaa,
+	0x69, 0x90, 0x83, 0x54, 0xe6, 0x8a, 0x60, 0x4b, 0xad, 0xd5, 0xfe, 0x49, 0x69, 0x9b, 0x83, 0x8c,
+	0x20, 0x64, 0x09, 0x8d, 0x15, 0xd9, 0xf0, 0x9c, 0xf1, 0xc0, 0x6f, 0x34, 0x7e, 0x6b, 0x2e, 0x19,
+	0x17, 0x49, 0x90, 0x71, 0xa6, 0x15, 0xd9, 0xf4, 0xda, 0xe3, 0xfe, 0xfe, 0xe3, 0xc9, 0xad, 0x33,
+	0x31, 0x39, 0x36, 0xee, 0x4b, 0x83, 0x7f, 0xe0, 0x4c, 0x1f, 0x74, 0xce, 0x7f, 0x6e, 0xb7, 0x7c,
+	0x14, 0xd5, 0x86, 0x32, 0x4d, 0xa9, 0x70, 0x0e, 0x51, 0x16, 0x5f, 0x5f, 0xad, 0xad, 0xb2, 0xa9,
+	0xc6, 0x2f, 0x6f, 0xd7, 0xce, 0x27, 0x34, 0xfc, 0x33, 0xee, 0x1f, 0x93, 0x34, 0x42, 0x3d, 0x58,
+	0xa4, 0x82, 0x03, 0xd7, 0x76, 0x96, 0x06, 0x7e, 0xa3, 0xcd, 0x99, 0xd3, 0x98, 0x51, 0x05, 0x8a,
+	0xb4, 0xbd, 0xb6, 0x39, 0xf3, 0x4a, 0x1e, 0x3c, 0x3b, 0xbf, 0x74, 0x9d, 0x8b, 0x4b, 0xd7, 0xf9,
+	0x75, 0xe9, 0x3a, 0xdf, 0xae, 0xdc, 0xd6, 0xc5, 0x95, 0xdb, 0xfa, 0x71, 0xe5, 0xb6, 0x3e, 0x8e,
+	0x6e, 0xbc, 0x0c, 0x8b, 0xe6, 0x6d, 0xd0, 0x45, 0x0a, 0x6a, 0xd6, 0xb5, 0x43, 0xfe, 0xf4, 0xf7,
+	0x00, 0xcf, 0x0e, 0x8d, 0x19, 0x3e, 0x04, 0x00, 0x00,
 }
 
 func (m *Verifiedtoken) Marshal() (dAtA []byte, err error) {
@@ -248,6 +371,50 @@ func (m *Verifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScheduledSupply != 0 {
+		i = encodeVarintVerifiedtoken(dAtA, i, uint64(m.ScheduledSupply))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.DenomUnits) > 0 {
+		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVerifiedtoken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintVerifiedtoken(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MetadataVersion != 0 {
+		i = encodeVarintVerifiedtoken(dAtA, i, uint64(m.MetadataVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintVerifiedtoken(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.MerchantIncentiveTreasuryBps != 0 {
 		i = encodeVarintVerifiedtoken(dAtA, i, uint64(m.MerchantIncentiveTreasuryBps))
 		i--
@@ -364,6 +531,50 @@ func (m *Verifiedtoken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenDenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenDenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintVerifiedtoken(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintVerifiedtoken(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVerifiedtoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVerifiedtoken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVerifiedtoken(v)
 	base := offset
@@ -437,6 +648,46 @@ func (m *Verifiedtoken) Size() (n int) {
 	if m.MerchantIncentiveTreasuryBps != 0 {
 		n += 2 + sovVerifiedtoken(uint64(m.MerchantIncentiveTreasuryBps))
 	}
+	if m.MerchantId != 0 {
+		n += 2 + sovVerifiedtoken(uint64(m.MerchantId))
+	}
+	if m.MetadataVersion != 0 {
+		n += 2 + sovVerifiedtoken(uint64(m.MetadataVersion))
+	}
+	if m.Decimals != 0 {
+		n += 2 + sovVerifiedtoken(uint64(m.Decimals))
+	}
+	if len(m.DenomUnits) > 0 {
+		for _, e := range m.DenomUnits {
+			l = e.Size()
+			n += 2 + l + sovVerifiedtoken(uint64(l))
+		}
+	}
+	if m.ScheduledSupply != 0 {
+		n += 2 + sovVerifiedtoken(uint64(m.ScheduledSupply))
+	}
+	return n
+}
+
+func (m *TokenDenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVerifiedtoken(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovVerifiedtoken(uint64(m.Exponent))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovVerifiedtoken(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -886,6 +1137,249 @@ func (m *Verifiedtoken) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataVersion", wireType)
+			}
+			m.MetadataVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifiedtoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedtoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, TokenDenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSupply", wireType)
+			}
+			m.ScheduledSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifiedtoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVerifiedtoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenDenomUnit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifiedtoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenDenomUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVerifiedtoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedtoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedtoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVerifiedtoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedtoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVerifiedtoken(dAtA[iNdEx:])
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+)
+
+// VerifiedtokenIndexes are the secondary indexes of verified tokens used by
+// filtered listings.
+type VerifiedtokenIndexes struct {
+	// Issuer indexes tokens by (issuer, denom).
+	Issuer *indexes.Multi[string, string, Verifiedtoken]
+	// Symbol indexes tokens by (lowercased symbol, denom).
+	Symbol *indexes.Multi[string, string, Verifiedtoken]
+}
+
+func NewVerifiedtokenIndexes(sb *collections.SchemaBuilder) VerifiedtokenIndexes {
+	return VerifiedtokenIndexes{
+		Issuer: indexes.NewMulti(
+			sb,
+			VerifiedtokenIssuerIndexKey,
+			"verifiedtoken_by_issuer",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, token Verifiedtoken) (string, error) {
+				return token.Issuer, nil
+			},
+		),
+		Symbol: indexes.NewMulti(
+			sb,
+			VerifiedtokenSymbolIndexKey,
+			"verifiedtoken_by_symbol",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, token Verifiedtoken) (string, error) {
+				return SymbolIndexKey(token.Symbol), nil
+			},
+		),
+	}
+}
+
+func (i VerifiedtokenIndexes) IndexesList() []collections.Index[string, Verifiedtoken] {
+	return []collections.Index[string, Verifiedtoken]{i.Issuer, i.Symbol}
+}
+
+// SymbolIndexKey is the form symbols are indexed and searched under.
+func SymbolIndexKey(symbol string) string {
+	return strings.ToLower(symbol)
+}